@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/spf13/cobra"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/config"
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/itemio"
+)
+
+func newItemsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "items",
+		Short: "Export and import items directly against the database",
+	}
+
+	cmd.AddCommand(newItemsExportCmd())
+	cmd.AddCommand(newItemsImportCmd())
+
+	return cmd
+}
+
+func newItemsExportCmd() *cobra.Command {
+	var output, ownerID, format string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a user's items as CSV or newline-delimited JSON",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			var owner pgtype.UUID
+			if err := owner.Scan(ownerID); err != nil {
+				return fmt.Errorf("parse --owner-id: %w", err)
+			}
+
+			pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+			if err != nil {
+				return fmt.Errorf("connect to database: %w", err)
+			}
+			defer pool.Close()
+
+			var w io.Writer = cmd.OutOrStdout()
+			if output != "" && output != "-" {
+				f, err := os.Create(output)
+				if err != nil {
+					return fmt.Errorf("create output file: %w", err)
+				}
+				defer f.Close()
+				w = f
+			}
+
+			written, err := itemio.Export(ctx, db.New(pool), owner, w, format)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.ErrOrStderr(), "exported %d items\n", written)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&output, "output", "o", "-", "file to write to, - for stdout")
+	cmd.Flags().StringVar(&ownerID, "owner-id", "", "id of the user whose items are exported")
+	cmd.Flags().StringVar(&format, "format", itemio.FormatNDJSON, "file format: csv, ndjson")
+	_ = cmd.MarkFlagRequired("owner-id")
+
+	return cmd
+}
+
+func newItemsImportCmd() *cobra.Command {
+	var input, ownerID, format string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import items for a user from CSV or newline-delimited JSON",
+		Long: "Import items for a user from CSV or newline-delimited JSON. Every row is " +
+			"validated first, and nothing is imported unless all of them are valid.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			cfg, err := config.Load()
+			if err != nil {
+				return err
+			}
+
+			var owner pgtype.UUID
+			if err := owner.Scan(ownerID); err != nil {
+				return fmt.Errorf("parse --owner-id: %w", err)
+			}
+
+			var r io.Reader = cmd.InOrStdin()
+			if input != "" && input != "-" {
+				f, err := os.Open(input)
+				if err != nil {
+					return fmt.Errorf("open input file: %w", err)
+				}
+				defer f.Close()
+				r = f
+			}
+
+			rows, rowErrors, err := itemio.Parse(r, format, 0)
+			if err != nil {
+				return err
+			}
+			for _, rowError := range rowErrors {
+				fmt.Fprintf(cmd.ErrOrStderr(), "line %d: %s\n", rowError.Line, rowError.Message)
+			}
+			if len(rowErrors) > 0 {
+				return fmt.Errorf("%d of %d rows are invalid, nothing was imported", len(rowErrors), len(rows)+len(rowErrors))
+			}
+			if dryRun {
+				fmt.Fprintf(cmd.OutOrStdout(), "%d rows are valid\n", len(rows))
+				return nil
+			}
+
+			pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+			if err != nil {
+				return fmt.Errorf("connect to database: %w", err)
+			}
+			defer pool.Close()
+
+			tx, err := pool.Begin(ctx)
+			if err != nil {
+				return fmt.Errorf("begin transaction: %w", err)
+			}
+			defer func() {
+				_ = tx.Rollback(ctx)
+			}()
+			if err := itemio.Import(ctx, db.New(tx), owner, rows, cfg.SearchLanguage); err != nil {
+				return err
+			}
+			if err := tx.Commit(ctx); err != nil {
+				return fmt.Errorf("commit import: %w", err)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "imported %d items\n", len(rows))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&input, "input", "i", "-", "file to read from, - for stdin")
+	cmd.Flags().StringVar(&ownerID, "owner-id", "", "id of the user who will own the items")
+	cmd.Flags().StringVar(&format, "format", itemio.FormatNDJSON, "file format: csv, ndjson")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only validate the file")
+	_ = cmd.MarkFlagRequired("owner-id")
+
+	return cmd
+}
@@ -21,6 +21,7 @@ func newRootCmd() *cobra.Command {
 
 	cmd.AddCommand(newServeCmd())
 	cmd.AddCommand(newMigrateCmd())
+	cmd.AddCommand(newItemsCmd())
 
 	return cmd
 }
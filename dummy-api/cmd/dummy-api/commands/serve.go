@@ -21,8 +21,10 @@ import (
 	grpcserver "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/grpc/dummy/server"
 	httpserver "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/http/dummy/server"
 	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/auth"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/blob"
 	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/config"
 	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/purge"
 	appservice "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/service"
 	goahttp "goa.design/goa/v3/http"
 	goahttpmiddleware "goa.design/goa/v3/http/middleware"
@@ -54,18 +56,61 @@ func newServeCmd() *cobra.Command {
 			}
 			defer conn.Close()
 
-			identityClient := auth.NewClient(conn)
+			var validator appservice.IdentityValidator = auth.NewClient(conn, cfg.TokenAudience)
+			if cfg.TokenValidationStreams > 0 {
+				streams := auth.NewStreamValidator(conn, cfg.TokenAudience, cfg.TokenValidationStreams)
+				defer streams.Close()
+				validator = streams
+			}
+			blobs, err := newBlobStore(cfg)
+			if err != nil {
+				return err
+			}
+
 			queries := db.New(pool)
-			svc := appservice.New(logger, queries, identityClient)
+			svc := appservice.New(logger, queries, validator, appservice.Options{
+				SearchLanguage:         cfg.SearchLanguage,
+				Blobs:                  blobs,
+				AttachmentMaxSize:      cfg.AttachmentMaxSize,
+				AttachmentContentTypes: cfg.AttachmentContentTypes,
+				DB:                     pool,
+			})
+
+			var purger *purge.Purger
+			if cfg.TrashRetention > 0 {
+				purger = purge.NewPurger(logger, queries, blobs, purge.Options{
+					Retention: cfg.TrashRetention,
+					Interval:  cfg.PurgeInterval,
+				})
+			}
 
-			return runServers(ctx, cfg, svc, logger)
+			return runServers(ctx, cfg, svc, purger, logger)
 		},
 	}
 
 	return cmd
 }
 
-func runServers(ctx context.Context, cfg *config.Config, svc dummy.Service, logger *slog.Logger) error {
+// newBlobStore builds the attachment storage selected by DUMMY_BLOB_BACKEND.
+func newBlobStore(cfg *config.Config) (blob.Store, error) {
+	switch cfg.BlobBackend {
+	case "local":
+		return blob.NewLocalStore(cfg.BlobDir)
+	case "s3":
+		return blob.NewS3Store(blob.S3Options{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			PathStyle:       cfg.S3PathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unknown DUMMY_BLOB_BACKEND %q", cfg.BlobBackend)
+	}
+}
+
+func runServers(ctx context.Context, cfg *config.Config, svc dummy.Service, purger *purge.Purger, logger *slog.Logger) error {
 	endpoints := dummy.NewEndpoints(svc)
 
 	mux := goahttp.NewMuxer()
@@ -80,7 +125,7 @@ func runServers(ctx context.Context, cfg *config.Config, svc dummy.Service, logg
 	httpSrv := &http.Server{Addr: cfg.HTTPAddr, Handler: mux}
 
 	grpcSrv := grpc.NewServer()
-	dummypb.RegisterDummyServer(grpcSrv, grpcserver.New(endpoints, nil))
+	dummypb.RegisterDummyServer(grpcSrv, grpcserver.New(endpoints, nil, nil))
 
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -117,5 +162,12 @@ func runServers(ctx context.Context, cfg *config.Config, svc dummy.Service, logg
 		return nil
 	})
 
+	if purger != nil {
+		g.Go(func() error {
+			logger.Info("trash purger started", "retention", cfg.TrashRetention)
+			return purger.Run(ctx)
+		})
+	}
+
 	return g.Wait()
 }
@@ -17,6 +17,14 @@ var _ = API("dummy", func() {
 	})
 })
 
+// searchLanguages are the text search configurations shipped with PostgreSQL.
+var searchLanguages = []any{
+	"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english",
+	"finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish",
+	"italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian",
+	"serbian", "spanish", "swedish", "tamil", "turkish", "yiddish",
+}
+
 var Item = ResultType("application/vnd.dummy.item", func() {
 	TypeName("Item")
 	Attributes(func() {
@@ -27,7 +35,20 @@ var Item = ResultType("application/vnd.dummy.item", func() {
 		Field(5, "created_at", String, func() {
 			Format(FormatDateTime)
 		})
-		Required("id", "name", "owner_id", "created_at")
+		Field(6, "version", Int, "Incremented by every update; send it back to update the item")
+		Field(7, "updated_at", String, func() {
+			Format(FormatDateTime)
+		})
+		Field(8, "etag", String, "Entity tag of this version, returned in the ETag header over HTTP")
+		Field(9, "language", String, "Text search configuration used to index the item")
+		Field(10, "deleted_at", String, "When the item was moved to the trash", func() {
+			Format(FormatDateTime)
+		})
+		Field(11, "permission", String, "Caller's access to the item: owner, or the permission it was shared with", func() {
+			Enum("owner", "editor", "viewer")
+		})
+		Field(12, "tags", ArrayOf(String), "Tags of the item, in alphabetical order")
+		Required("id", "name", "owner_id", "created_at", "version", "updated_at", "etag", "language", "permission", "tags")
 	})
 	View("default", func() {
 		Attribute("id")
@@ -35,14 +56,139 @@ var Item = ResultType("application/vnd.dummy.item", func() {
 		Attribute("description")
 		Attribute("owner_id")
 		Attribute("created_at")
+		Attribute("version")
+		Attribute("updated_at")
+		Attribute("etag")
+		Attribute("language")
+		Attribute("deleted_at")
+		Attribute("permission")
+		Attribute("tags")
 	})
 })
 
 var ItemsCollection = Type("ItemsCollection", func() {
 	Field(1, "items", ArrayOf(Item))
+	Field(2, "next_cursor", String, "Cursor of the next page; absent on the last page")
+	Field(3, "total", Int64, "Number of items matching the filters, when include_total is set")
 	Required("items")
 })
 
+var ItemShare = Type("ItemShare", func() {
+	Field(1, "item_id", String)
+	Field(2, "user_id", String, "User the item is shared with")
+	Field(3, "permission", String, "viewer can read the item, editor can also update it", func() {
+		Enum("viewer", "editor")
+	})
+	Field(4, "created_at", String, func() {
+		Format(FormatDateTime)
+	})
+	Required("item_id", "user_id", "permission", "created_at")
+})
+
+var ItemSharesCollection = Type("ItemSharesCollection", func() {
+	Field(1, "shares", ArrayOf(ItemShare))
+	Required("shares")
+})
+
+var TagSuggestion = Type("TagSuggestion", func() {
+	Field(1, "name", String)
+	Field(2, "item_count", Int64, "Number of items with the tag, not counting trashed ones")
+	Required("name", "item_count")
+})
+
+var TagSuggestions = Type("TagSuggestions", func() {
+	Field(1, "tags", ArrayOf(TagSuggestion))
+	Required("tags")
+})
+
+var Attachment = Type("Attachment", func() {
+	Field(1, "id", String)
+	Field(2, "item_id", String)
+	Field(3, "filename", String)
+	Field(4, "content_type", String, "Media type sniffed from the content")
+	Field(5, "size", Int64, "Size in bytes")
+	Field(6, "checksum_sha256", String, "Hex-encoded SHA-256 of the content")
+	Field(7, "created_at", String, func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "item_id", "filename", "content_type", "size", "checksum_sha256", "created_at")
+})
+
+var AttachmentsCollection = Type("AttachmentsCollection", func() {
+	Field(1, "attachments", ArrayOf(Attachment))
+	Required("attachments")
+})
+
+// exportFormats are the file formats items are exported and imported in.
+var exportFormats = []any{"csv", "ndjson"}
+
+// maxBatchSize bounds the entries of a single batch request.
+const maxBatchSize = 100
+
+var BatchItemEntry = Type("BatchItemEntry", func() {
+	Field(1, "name", String, func() {
+		MinLength(1)
+	})
+	Field(2, "description", String)
+	Field(3, "language", String, "Language used to stem the item for search; defaults to DUMMY_SEARCH_LANGUAGE", func() {
+		Enum(searchLanguages...)
+	})
+	Required("name")
+})
+
+var BatchUpdateEntry = Type("BatchUpdateEntry", func() {
+	Field(1, "id", String)
+	Field(2, "version", Int, "Version the update is based on")
+	Field(3, "name", String, "New name; omit to keep it", func() {
+		MinLength(1)
+	})
+	Field(4, "description", String, "New description; omit to keep it, send an empty string to clear it")
+	Required("id", "version")
+})
+
+var BatchEntryError = Type("BatchEntryError", func() {
+	Field(1, "name", String, "Error name, such as not_found or conflict. aborted marks entries rolled back or skipped because another entry of an atomic batch failed")
+	Field(2, "message", String)
+	Required("name", "message")
+})
+
+var BatchEntryResult = Type("BatchEntryResult", func() {
+	Field(1, "index", Int, "Position of the entry in the request")
+	Field(2, "item", Item, "The created or updated item")
+	Field(3, "error", BatchEntryError)
+	Required("index")
+})
+
+var BatchResult = Type("BatchResult", func() {
+	Field(1, "results", ArrayOf(BatchEntryResult), "One result per entry, in request order")
+	Field(2, "succeeded", Int)
+	Field(3, "failed", Int)
+	Required("results", "succeeded", "failed")
+})
+
+var ImportSummary = Type("ImportSummary", func() {
+	Field(1, "received", Int64)
+	Field(2, "created", Int64)
+	Field(3, "failed", Int64)
+	Field(4, "errors", ArrayOf(BatchEntryResult), "The first failures, with index counting from the start of the stream")
+	Required("received", "created", "failed", "errors")
+})
+
+var ImportRowError = Type("ImportRowError", func() {
+	Field(1, "line", Int, "Line of the file the rejected row starts on, counting from 1")
+	Field(2, "message", String)
+	Required("line", "message")
+})
+
+var ImportReport = Type("ImportReport", func() {
+	Field(1, "dry_run", Boolean)
+	Field(2, "rows", Int, "Rows read from the file")
+	Field(3, "failed", Int, "Rows rejected by validation")
+	Field(4, "imported", Int, "Items created; zero for dry runs and files with rejected rows")
+	Field(5, "errors", ArrayOf(ImportRowError), "The first rejected rows")
+	Required("dry_run", "rows", "failed", "imported", "errors")
+})
+
 var DummyUnauthorizedError = Type("DummyUnauthorizedError", func() {
 	Field(1, "message", String)
 	Required("message")
@@ -53,6 +199,42 @@ var DummyNotFoundError = Type("DummyNotFoundError", func() {
 	Required("message")
 })
 
+var DummyBadRequestError = Type("DummyBadRequestError", func() {
+	Field(1, "message", String)
+	Required("message")
+})
+
+var DummyForbiddenError = Type("DummyForbiddenError", func() {
+	Field(1, "message", String)
+	Required("message")
+})
+
+var DummyTooLargeError = Type("DummyTooLargeError", func() {
+	Field(1, "message", String)
+	Required("message")
+})
+
+var DummyUnsupportedMediaTypeError = Type("DummyUnsupportedMediaTypeError", func() {
+	Field(1, "message", String)
+	Required("message")
+})
+
+var DummyChecksumMismatchError = Type("DummyChecksumMismatchError", func() {
+	Field(1, "message", String)
+	Required("message")
+})
+
+var DummyConflictError = Type("DummyConflictError", func() {
+	Field(1, "message", String)
+	Field(2, "current_version", Int, "Version the item has now")
+	Required("message")
+})
+
+var DummyPreconditionRequiredError = Type("DummyPreconditionRequiredError", func() {
+	Field(1, "message", String)
+	Required("message")
+})
+
 var AuthenticatedPayload = Type("AuthenticatedPayload", func() {
 	Field(1, "token", String, "Bearer token")
 	Required("token")
@@ -62,6 +244,9 @@ var CreateItemPayload = Type("CreateItemPayload", func() {
 	Extend(AuthenticatedPayload)
 	Field(2, "name", String)
 	Field(3, "description", String)
+	Field(4, "language", String, "Language used to stem the item for search; defaults to DUMMY_SEARCH_LANGUAGE", func() {
+		Enum(searchLanguages...)
+	})
 	Required("name")
 })
 
@@ -71,8 +256,233 @@ var ItemIDPayload = Type("ItemIDPayload", func() {
 	Required("id")
 })
 
+var UpdateItemPayload = Type("UpdateItemPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "id", String)
+	Field(3, "name", String)
+	Field(4, "description", String, "New description; omit to clear it")
+	Field(5, "version", Int, "Version the update is based on; required over gRPC")
+	Field(6, "if_match", String, "ETag the update is based on; required over HTTP")
+	Required("id", "name")
+})
+
+var PatchItemPayload = Type("PatchItemPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "id", String)
+	Field(3, "name", String, "New name; omit to keep it", func() {
+		MinLength(1)
+	})
+	Field(4, "description", String, "New description; omit to keep it, send an empty string to clear it")
+	Field(5, "version", Int, "Version the update is based on; required over gRPC")
+	Field(6, "if_match", String, "ETag the update is based on; required over HTTP")
+	Required("id")
+})
+
+var ShareItemPayload = Type("ShareItemPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "id", String)
+	Field(3, "user_id", String, "User to share the item with", func() {
+		Format(FormatUUID)
+	})
+	Field(4, "permission", String, "viewer can read the item, editor can also update it", func() {
+		Enum("viewer", "editor")
+	})
+	Required("id", "user_id", "permission")
+})
+
+var UnshareItemPayload = Type("UnshareItemPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "id", String)
+	Field(3, "user_id", String, "User to stop sharing the item with")
+	Required("id", "user_id")
+})
+
+var UploadAttachmentPayload = Type("UploadAttachmentPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "id", String)
+	Field(3, "filename", String, "Name to download the attachment as", func() {
+		MinLength(1)
+		MaxLength(255)
+	})
+	Field(4, "checksum_sha256", String, "Hex-encoded SHA-256 the uploaded content must have", func() {
+		Pattern("^[0-9a-fA-F]{64}$")
+	})
+	Required("id", "filename")
+})
+
+var AttachmentIDPayload = Type("AttachmentIDPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "id", String)
+	Field(3, "attachment_id", String)
+	Required("id", "attachment_id")
+})
+
+var AttachmentDownload = Type("AttachmentDownload", func() {
+	Field(1, "content_type", String)
+	Field(2, "content_length", Int64)
+	Field(3, "content_disposition", String)
+	Field(4, "checksum_sha256", String)
+	Field(5, "content_type_options", String, "Always nosniff, so that browsers keep to content_type", func() {
+		Enum("nosniff")
+	})
+	Required("content_type", "content_length", "content_disposition", "checksum_sha256", "content_type_options")
+})
+
+var ExportItemsPayload = Type("ExportItemsPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "format", String, func() {
+		Enum(exportFormats...)
+		Default("ndjson")
+	})
+})
+
+var ItemsExport = Type("ItemsExport", func() {
+	Field(1, "content_type", String)
+	Field(2, "content_disposition", String)
+	Required("content_type", "content_disposition")
+})
+
+var ImportItemsFilePayload = Type("ImportItemsFilePayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "format", String, "Format of the request body. CSV files need a header row with a name column and may have description, language and tags columns, tags separated by semicolons", func() {
+		Enum(exportFormats...)
+		Default("ndjson")
+	})
+	Field(3, "dry_run", Boolean, "Only validate the file", func() {
+		Default(false)
+	})
+})
+
+var BatchCreateItemsPayload = Type("BatchCreateItemsPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "items", ArrayOf(BatchItemEntry), func() {
+		MinLength(1)
+		MaxLength(maxBatchSize)
+	})
+	Field(3, "atomic", Boolean, "Apply all entries in one transaction, or none if any fails; when false each entry succeeds or fails on its own", func() {
+		Default(true)
+	})
+	Required("items")
+})
+
+var BatchUpdateItemsPayload = Type("BatchUpdateItemsPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "items", ArrayOf(BatchUpdateEntry), func() {
+		MinLength(1)
+		MaxLength(maxBatchSize)
+	})
+	Field(3, "atomic", Boolean, "Apply all entries in one transaction, or none if any fails; when false each entry succeeds or fails on its own", func() {
+		Default(true)
+	})
+	Required("items")
+})
+
+var BatchDeleteItemsPayload = Type("BatchDeleteItemsPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "ids", ArrayOf(String), "Items to move to the trash", func() {
+		MinLength(1)
+		MaxLength(maxBatchSize)
+	})
+	Field(3, "atomic", Boolean, "Apply all entries in one transaction, or none if any fails; when false each entry succeeds or fails on its own", func() {
+		Default(true)
+	})
+	Required("ids")
+})
+
+var ListTrashPayload = Type("ListTrashPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "page_size", Int, "Maximum number of items to return", func() {
+		Minimum(1)
+		Maximum(200)
+		Default(50)
+	})
+	Field(3, "cursor", String, "next_cursor of the previous page")
+})
+
+var SearchItemsPayload = Type("SearchItemsPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "query", String, "Words to search for; supports quoted phrases, OR and -exclusions", func() {
+		MinLength(1)
+		MaxLength(500)
+		Example("quarterly report")
+	})
+	Field(3, "language", String, "Language used to stem the query; defaults to DUMMY_SEARCH_LANGUAGE", func() {
+		Enum(searchLanguages...)
+	})
+	Field(4, "page_size", Int, "Maximum number of results to return", func() {
+		Minimum(1)
+		Maximum(100)
+		Default(20)
+	})
+	Field(5, "cursor", String, "next_cursor of the previous page")
+	Required("query")
+})
+
+var SearchResult = Type("SearchResult", func() {
+	Field(1, "item", Item)
+	Field(2, "rank", Float32, "Relevance of the item; higher is better")
+	Field(3, "snippet", String, "Excerpt of the name and description with matches wrapped in <mark> tags. The item text is HTML-escaped, so the snippet can be inserted as markup")
+	Required("item", "rank", "snippet")
+})
+
+var SearchResultsCollection = Type("SearchResultsCollection", func() {
+	Field(1, "results", ArrayOf(SearchResult), "Results ordered by relevance")
+	Field(2, "next_cursor", String, "Cursor of the next page; absent on the last page")
+	Required("results")
+})
+
 var ListItemsPayload = Type("ListItemsPayload", func() {
 	Extend(AuthenticatedPayload)
+	Field(2, "page_size", Int, "Maximum number of items to return", func() {
+		Minimum(1)
+		Maximum(200)
+		Default(50)
+	})
+	Field(3, "cursor", String, "next_cursor of the previous page")
+	Field(4, "order", String, "Order by creation time", func() {
+		Enum("desc", "asc")
+		Default("desc")
+	})
+	Field(5, "name_prefix", String, "Only items whose name starts with this, ignoring case")
+	Field(6, "created_after", String, "Only items created at or after this time", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "created_before", String, "Only items created before this time", func() {
+		Format(FormatDateTime)
+	})
+	Field(8, "include_total", Boolean, "Count the items matching the filters", func() {
+		Default(false)
+	})
+	Field(9, "tags", ArrayOf(String), "Only items with these tags, compared case-insensitively", func() {
+		MaxLength(20)
+	})
+	Field(10, "tag_match", String, "Whether items need any or all of the given tags", func() {
+		Enum("any", "all")
+		Default("any")
+	})
+})
+
+var ItemTagPayload = Type("ItemTagPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "id", String)
+	Field(3, "tag", String, "Tag name; tags are compared case-insensitively", func() {
+		MinLength(1)
+		MaxLength(50)
+		Pattern(`^\S(.*\S)?$`)
+	})
+	Required("id", "tag")
+})
+
+var SuggestTagsPayload = Type("SuggestTagsPayload", func() {
+	Extend(AuthenticatedPayload)
+	Field(2, "prefix", String, "Beginning of the tag names to suggest, compared case-insensitively", func() {
+		Default("")
+	})
+	Field(3, "limit", Int, "Maximum number of tags to return", func() {
+		Minimum(1)
+		Maximum(50)
+		Default(10)
+	})
 })
 
 var _ = Service("dummy", func() {
@@ -80,6 +490,7 @@ var _ = Service("dummy", func() {
 
 	Error("unauthorized", DummyUnauthorizedError)
 	Error("not_found", DummyNotFoundError)
+	Error("forbidden", DummyForbiddenError)
 
 	Method("create_item", func() {
 		Payload(CreateItemPayload)
@@ -87,7 +498,9 @@ var _ = Service("dummy", func() {
 		HTTP(func() {
 			POST("/v1/dummy/items")
 			Header("token:Authorization", String, "Bearer token")
-			Response(StatusCreated)
+			Response(StatusCreated, func() {
+				Header("etag:ETag")
+			})
 		})
 		GRPC(func() {
 			Response(CodeOK)
@@ -95,41 +508,473 @@ var _ = Service("dummy", func() {
 	})
 
 	Method("list_items", func() {
+		Description("Pages through the items owned by or shared with the caller, newest first unless order is asc. Pass next_cursor as cursor to fetch the following page with the same filters and order")
 		Payload(ListItemsPayload)
 		Result(ItemsCollection)
+		Error("invalid_cursor", DummyBadRequestError, "The cursor is malformed or was issued for another order")
 		HTTP(func() {
 			GET("/v1/dummy/items")
 			Header("token:Authorization", String, "Bearer token")
+			Param("page_size")
+			Param("cursor")
+			Param("order")
+			Param("name_prefix")
+			Param("created_after")
+			Param("created_before")
+			Param("include_total")
+			Param("tags")
+			Param("tag_match")
+			Response(StatusOK)
+			Response("invalid_cursor", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_cursor", CodeInvalidArgument)
+		})
+	})
+
+	Method("search_items", func() {
+		Description("Full-text search over the name and description of the caller's items, ranked by relevance")
+		Payload(SearchItemsPayload)
+		Result(SearchResultsCollection)
+		Error("invalid_cursor", DummyBadRequestError, "The cursor is malformed")
+		HTTP(func() {
+			GET("/v1/dummy/items/search")
+			Header("token:Authorization", String, "Bearer token")
+			Param("query:q")
+			Param("language")
+			Param("page_size")
+			Param("cursor")
 			Response(StatusOK)
+			Response("invalid_cursor", StatusBadRequest)
 		})
 		GRPC(func() {
 			Response(CodeOK)
+			Response("invalid_cursor", CodeInvalidArgument)
 		})
 	})
 
 	Method("get_item", func() {
+		Description("Fetches an item owned by or shared with the caller")
 		Payload(ItemIDPayload)
 		Result(Item)
 		HTTP(func() {
 			GET("/v1/dummy/items/{id}")
 			Header("token:Authorization", String, "Bearer token")
-			Response(StatusOK)
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("update_item", func() {
+		Description("Replaces the name and description of an item. The update must name the version it is based on and fails with conflict if the item changed since")
+		Payload(UpdateItemPayload)
+		Result(Item)
+		Error("conflict", DummyConflictError, "The item was modified since the given version")
+		Error("precondition_required", DummyPreconditionRequiredError, "Neither If-Match nor version was given")
+		HTTP(func() {
+			PUT("/v1/dummy/items/{id}")
+			Header("token:Authorization", String, "Bearer token")
+			Header("if_match:If-Match")
+			Body(func() {
+				Attribute("name")
+				Attribute("description")
+			})
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("conflict", StatusPreconditionFailed)
+			Response("precondition_required", StatusPreconditionRequired)
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
 		})
 		GRPC(func() {
 			Response(CodeOK)
+			Response("conflict", CodeAborted)
+			Response("precondition_required", CodeFailedPrecondition)
+			Response("not_found", CodeNotFound)
+			Response("forbidden", CodePermissionDenied)
+		})
+	})
+
+	Method("patch_item", func() {
+		Description("Changes the given fields of an item. Like update_item it fails with conflict if the item changed since the given version")
+		Payload(PatchItemPayload)
+		Result(Item)
+		Error("conflict", DummyConflictError, "The item was modified since the given version")
+		Error("precondition_required", DummyPreconditionRequiredError, "Neither If-Match nor version was given")
+		HTTP(func() {
+			PATCH("/v1/dummy/items/{id}")
+			Header("token:Authorization", String, "Bearer token")
+			Header("if_match:If-Match")
+			Body(func() {
+				Attribute("name")
+				Attribute("description")
+			})
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("conflict", StatusPreconditionFailed)
+			Response("precondition_required", StatusPreconditionRequired)
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("conflict", CodeAborted)
+			Response("precondition_required", CodeFailedPrecondition)
+			Response("not_found", CodeNotFound)
+			Response("forbidden", CodePermissionDenied)
 		})
 	})
 
 	Method("delete_item", func() {
+		Description("Moves an item to the trash, from which it can be restored until it is purged")
 		Payload(ItemIDPayload)
 		Result(Empty)
 		HTTP(func() {
 			DELETE("/v1/dummy/items/{id}")
 			Header("token:Authorization", String, "Bearer token")
 			Response(StatusNoContent)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("share_item", func() {
+		Description("Shares an item with another user, or changes the permission it is shared with. Only the owner can share an item")
+		Payload(ShareItemPayload)
+		Result(ItemShare)
+		HTTP(func() {
+			PUT("/v1/dummy/items/{id}/shares/{user_id}")
+			Header("token:Authorization", String, "Bearer token")
+			Body(func() {
+				Attribute("permission")
+			})
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+			Response("forbidden", CodePermissionDenied)
+		})
+	})
+
+	Method("unshare_item", func() {
+		Description("Stops sharing an item with a user. The owner can remove any share and users can remove their own")
+		Payload(UnshareItemPayload)
+		Result(Empty)
+		HTTP(func() {
+			DELETE("/v1/dummy/items/{id}/shares/{user_id}")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusNoContent)
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+			Response("forbidden", CodePermissionDenied)
+		})
+	})
+
+	Method("list_item_shares", func() {
+		Description("Lists the users an item is shared with. Only the owner can list them")
+		Payload(ItemIDPayload)
+		Result(ItemSharesCollection)
+		HTTP(func() {
+			GET("/v1/dummy/items/{id}/shares")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+			Response("forbidden", CodePermissionDenied)
+		})
+	})
+
+	Method("add_item_tag", func() {
+		Description("Tags an item, creating the tag in the owner's tags if needed. Requires editor access")
+		Payload(ItemTagPayload)
+		Result(Item)
+		HTTP(func() {
+			PUT("/v1/dummy/items/{id}/tags/{tag}")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+			Response("forbidden", CodePermissionDenied)
+		})
+	})
+
+	Method("remove_item_tag", func() {
+		Description("Removes a tag from an item. Requires editor access")
+		Payload(ItemTagPayload)
+		Result(Item)
+		HTTP(func() {
+			DELETE("/v1/dummy/items/{id}/tags/{tag}")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+			Response("forbidden", CodePermissionDenied)
+		})
+	})
+
+	Method("suggest_tags", func() {
+		Description("Autocompletes the caller's tags, most used first")
+		Payload(SuggestTagsPayload)
+		Result(TagSuggestions)
+		HTTP(func() {
+			GET("/v1/dummy/tags")
+			Header("token:Authorization", String, "Bearer token")
+			Param("prefix")
+			Param("limit")
+			Response(StatusOK)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("upload_attachment", func() {
+		Description("Attaches a file to an item. The request body is the raw content, streamed to blob storage. Requires editor access")
+		Payload(UploadAttachmentPayload)
+		Result(Attachment)
+		Error("too_large", DummyTooLargeError, "The content exceeds DUMMY_ATTACHMENT_MAX_SIZE")
+		Error("unsupported_media_type", DummyUnsupportedMediaTypeError, "The sniffed content type is not allowed")
+		Error("checksum_mismatch", DummyChecksumMismatchError, "The content does not match checksum_sha256")
+		HTTP(func() {
+			POST("/v1/dummy/items/{id}/attachments")
+			Header("token:Authorization", String, "Bearer token")
+			Header("checksum_sha256:X-Checksum-SHA256")
+			Param("filename")
+			SkipRequestBodyEncodeDecode()
+			Response(StatusCreated)
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
+			Response("too_large", StatusRequestEntityTooLarge)
+			Response("unsupported_media_type", StatusUnsupportedMediaType)
+			Response("checksum_mismatch", StatusUnprocessableEntity)
+		})
+	})
+
+	Method("download_attachment", func() {
+		Description("Streams the content of an attachment as a download. HTML, XML and script content is served as application/octet-stream")
+		Payload(AttachmentIDPayload)
+		Result(AttachmentDownload)
+		HTTP(func() {
+			GET("/v1/dummy/items/{id}/attachments/{attachment_id}")
+			Header("token:Authorization", String, "Bearer token")
+			SkipResponseBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("content_type:Content-Type")
+				Header("content_length:Content-Length")
+				Header("content_disposition:Content-Disposition")
+				Header("checksum_sha256:X-Checksum-SHA256")
+				Header("content_type_options:X-Content-Type-Options")
+			})
+			Response("not_found", StatusNotFound)
+		})
+	})
+
+	Method("list_attachments", func() {
+		Description("Lists the attachments of an item, oldest first")
+		Payload(ItemIDPayload)
+		Result(AttachmentsCollection)
+		HTTP(func() {
+			GET("/v1/dummy/items/{id}/attachments")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("delete_attachment", func() {
+		Description("Deletes an attachment and its content. Requires editor access")
+		Payload(AttachmentIDPayload)
+		Result(Empty)
+		HTTP(func() {
+			DELETE("/v1/dummy/items/{id}/attachments/{attachment_id}")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusNoContent)
+			Response("not_found", StatusNotFound)
+			Response("forbidden", StatusForbidden)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+			Response("forbidden", CodePermissionDenied)
+		})
+	})
+
+	Method("batch_create_items", func() {
+		Description("Creates up to 100 items, atomically unless atomic is false")
+		Payload(BatchCreateItemsPayload)
+		Result(BatchResult)
+		HTTP(func() {
+			POST("/v1/dummy/items/batch/create")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("batch_update_items", func() {
+		Description("Changes the given fields of up to 100 items like patch_item, atomically unless atomic is false")
+		Payload(BatchUpdateItemsPayload)
+		Result(BatchResult)
+		HTTP(func() {
+			POST("/v1/dummy/items/batch/update")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("batch_delete_items", func() {
+		Description("Moves up to 100 items to the trash, atomically unless atomic is false")
+		Payload(BatchDeleteItemsPayload)
+		Result(BatchResult)
+		HTTP(func() {
+			POST("/v1/dummy/items/batch/delete")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("export_items", func() {
+		Description("Streams the caller's items, oldest first, as CSV or newline-delimited JSON. Trashed and shared items are left out")
+		Payload(ExportItemsPayload)
+		Result(ItemsExport)
+		HTTP(func() {
+			GET("/v1/dummy/items/export")
+			Header("token:Authorization", String, "Bearer token")
+			Param("format")
+			SkipResponseBodyEncodeDecode()
+			Response(StatusOK, func() {
+				Header("content_type:Content-Type")
+				Header("content_disposition:Content-Disposition")
+			})
+		})
+	})
+
+	Method("import_items_file", func() {
+		Description("Creates items from a CSV or newline-delimited JSON file sent as the request body. Every row is validated first and nothing is imported unless all of them are valid; with dry_run nothing is imported either way")
+		Payload(ImportItemsFilePayload)
+		Result(ImportReport)
+		Error("too_large", DummyTooLargeError, "The file has more rows than a single import allows")
+		HTTP(func() {
+			POST("/v1/dummy/items/import")
+			Header("token:Authorization", String, "Bearer token")
+			Param("format")
+			Param("dry_run")
+			SkipRequestBodyEncodeDecode()
+			Response(StatusOK)
+			Response("too_large", StatusRequestEntityTooLarge)
+		})
+	})
+
+	Method("import_items", func() {
+		Description("Creates items streamed by the client, each on its own, and reports a summary once the stream is closed. gRPC only; the token is sent in the authorization metadata")
+		Payload(AuthenticatedPayload)
+		StreamingPayload(BatchItemEntry)
+		Result(ImportSummary)
+		GRPC(func() {
+			Metadata(func() {
+				Attribute("token:authorization")
+			})
+			Response(CodeOK)
+		})
+	})
+
+	Method("list_trash", func() {
+		Description("Lists the caller's trashed items, most recently deleted first")
+		Payload(ListTrashPayload)
+		Result(ItemsCollection)
+		Error("invalid_cursor", DummyBadRequestError, "The cursor is malformed")
+		HTTP(func() {
+			GET("/v1/dummy/trash")
+			Header("token:Authorization", String, "Bearer token")
+			Param("page_size")
+			Param("cursor")
+			Response(StatusOK)
+			Response("invalid_cursor", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_cursor", CodeInvalidArgument)
+		})
+	})
+
+	Method("restore_item", func() {
+		Description("Moves an item out of the trash")
+		Payload(ItemIDPayload)
+		Result(Item)
+		HTTP(func() {
+			POST("/v1/dummy/trash/{id}/restore")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK, func() {
+				Header("etag:ETag")
+			})
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("purge_item", func() {
+		Description("Permanently deletes a trashed item")
+		Payload(ItemIDPayload)
+		Result(Empty)
+		HTTP(func() {
+			DELETE("/v1/dummy/trash/{id}")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusNoContent)
+			Response("not_found", StatusNotFound)
 		})
 		GRPC(func() {
 			Response(CodeOK)
+			Response("not_found", CodeNotFound)
 		})
 	})
 
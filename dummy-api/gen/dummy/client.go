@@ -9,25 +9,70 @@ package dummy
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Client is the "dummy" service client.
 type Client struct {
-	CreateItemEndpoint goa.Endpoint
-	ListItemsEndpoint  goa.Endpoint
-	GetItemEndpoint    goa.Endpoint
-	DeleteItemEndpoint goa.Endpoint
+	CreateItemEndpoint         goa.Endpoint
+	ListItemsEndpoint          goa.Endpoint
+	SearchItemsEndpoint        goa.Endpoint
+	GetItemEndpoint            goa.Endpoint
+	UpdateItemEndpoint         goa.Endpoint
+	PatchItemEndpoint          goa.Endpoint
+	DeleteItemEndpoint         goa.Endpoint
+	ShareItemEndpoint          goa.Endpoint
+	UnshareItemEndpoint        goa.Endpoint
+	ListItemSharesEndpoint     goa.Endpoint
+	AddItemTagEndpoint         goa.Endpoint
+	RemoveItemTagEndpoint      goa.Endpoint
+	SuggestTagsEndpoint        goa.Endpoint
+	UploadAttachmentEndpoint   goa.Endpoint
+	DownloadAttachmentEndpoint goa.Endpoint
+	ListAttachmentsEndpoint    goa.Endpoint
+	DeleteAttachmentEndpoint   goa.Endpoint
+	BatchCreateItemsEndpoint   goa.Endpoint
+	BatchUpdateItemsEndpoint   goa.Endpoint
+	BatchDeleteItemsEndpoint   goa.Endpoint
+	ExportItemsEndpoint        goa.Endpoint
+	ImportItemsFileEndpoint    goa.Endpoint
+	ImportItemsEndpoint        goa.Endpoint
+	ListTrashEndpoint          goa.Endpoint
+	RestoreItemEndpoint        goa.Endpoint
+	PurgeItemEndpoint          goa.Endpoint
 }
 
 // NewClient initializes a "dummy" service client given the endpoints.
-func NewClient(createItem, listItems, getItem, deleteItem goa.Endpoint) *Client {
+func NewClient(createItem, listItems, searchItems, getItem, updateItem, patchItem, deleteItem, shareItem, unshareItem, listItemShares, addItemTag, removeItemTag, suggestTags, uploadAttachment, downloadAttachment, listAttachments, deleteAttachment, batchCreateItems, batchUpdateItems, batchDeleteItems, exportItems, importItemsFile, importItems, listTrash, restoreItem, purgeItem goa.Endpoint) *Client {
 	return &Client{
-		CreateItemEndpoint: createItem,
-		ListItemsEndpoint:  listItems,
-		GetItemEndpoint:    getItem,
-		DeleteItemEndpoint: deleteItem,
+		CreateItemEndpoint:         createItem,
+		ListItemsEndpoint:          listItems,
+		SearchItemsEndpoint:        searchItems,
+		GetItemEndpoint:            getItem,
+		UpdateItemEndpoint:         updateItem,
+		PatchItemEndpoint:          patchItem,
+		DeleteItemEndpoint:         deleteItem,
+		ShareItemEndpoint:          shareItem,
+		UnshareItemEndpoint:        unshareItem,
+		ListItemSharesEndpoint:     listItemShares,
+		AddItemTagEndpoint:         addItemTag,
+		RemoveItemTagEndpoint:      removeItemTag,
+		SuggestTagsEndpoint:        suggestTags,
+		UploadAttachmentEndpoint:   uploadAttachment,
+		DownloadAttachmentEndpoint: downloadAttachment,
+		ListAttachmentsEndpoint:    listAttachments,
+		DeleteAttachmentEndpoint:   deleteAttachment,
+		BatchCreateItemsEndpoint:   batchCreateItems,
+		BatchUpdateItemsEndpoint:   batchUpdateItems,
+		BatchDeleteItemsEndpoint:   batchDeleteItems,
+		ExportItemsEndpoint:        exportItems,
+		ImportItemsFileEndpoint:    importItemsFile,
+		ImportItemsEndpoint:        importItems,
+		ListTrashEndpoint:          listTrash,
+		RestoreItemEndpoint:        restoreItem,
+		PurgeItemEndpoint:          purgeItem,
 	}
 }
 
@@ -35,6 +80,7 @@ func NewClient(createItem, listItems, getItem, deleteItem goa.Endpoint) *Client
 // CreateItem may return the following errors:
 //   - "unauthorized" (type *DummyUnauthorizedError)
 //   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
 //   - error: internal error
 func (c *Client) CreateItem(ctx context.Context, p *CreateItemPayload) (res *Item, err error) {
 	var ires any
@@ -47,8 +93,10 @@ func (c *Client) CreateItem(ctx context.Context, p *CreateItemPayload) (res *Ite
 
 // ListItems calls the "list_items" endpoint of the "dummy" service.
 // ListItems may return the following errors:
+//   - "invalid_cursor" (type *DummyBadRequestError): The cursor is malformed or was issued for another order
 //   - "unauthorized" (type *DummyUnauthorizedError)
 //   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
 //   - error: internal error
 func (c *Client) ListItems(ctx context.Context, p *ListItemsPayload) (res *ItemsCollection, err error) {
 	var ires any
@@ -59,10 +107,27 @@ func (c *Client) ListItems(ctx context.Context, p *ListItemsPayload) (res *Items
 	return ires.(*ItemsCollection), nil
 }
 
+// SearchItems calls the "search_items" endpoint of the "dummy" service.
+// SearchItems may return the following errors:
+//   - "invalid_cursor" (type *DummyBadRequestError): The cursor is malformed
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) SearchItems(ctx context.Context, p *SearchItemsPayload) (res *SearchResultsCollection, err error) {
+	var ires any
+	ires, err = c.SearchItemsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SearchResultsCollection), nil
+}
+
 // GetItem calls the "get_item" endpoint of the "dummy" service.
 // GetItem may return the following errors:
 //   - "unauthorized" (type *DummyUnauthorizedError)
 //   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
 //   - error: internal error
 func (c *Client) GetItem(ctx context.Context, p *ItemIDPayload) (res *Item, err error) {
 	var ires any
@@ -73,12 +138,334 @@ func (c *Client) GetItem(ctx context.Context, p *ItemIDPayload) (res *Item, err
 	return ires.(*Item), nil
 }
 
+// UpdateItem calls the "update_item" endpoint of the "dummy" service.
+// UpdateItem may return the following errors:
+//   - "conflict" (type *DummyConflictError): The item was modified since the given version
+//   - "precondition_required" (type *DummyPreconditionRequiredError): Neither If-Match nor version was given
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) UpdateItem(ctx context.Context, p *UpdateItemPayload) (res *Item, err error) {
+	var ires any
+	ires, err = c.UpdateItemEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Item), nil
+}
+
+// PatchItem calls the "patch_item" endpoint of the "dummy" service.
+// PatchItem may return the following errors:
+//   - "conflict" (type *DummyConflictError): The item was modified since the given version
+//   - "precondition_required" (type *DummyPreconditionRequiredError): Neither If-Match nor version was given
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) PatchItem(ctx context.Context, p *PatchItemPayload) (res *Item, err error) {
+	var ires any
+	ires, err = c.PatchItemEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Item), nil
+}
+
 // DeleteItem calls the "delete_item" endpoint of the "dummy" service.
 // DeleteItem may return the following errors:
 //   - "unauthorized" (type *DummyUnauthorizedError)
 //   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
 //   - error: internal error
 func (c *Client) DeleteItem(ctx context.Context, p *ItemIDPayload) (err error) {
 	_, err = c.DeleteItemEndpoint(ctx, p)
 	return
 }
+
+// ShareItem calls the "share_item" endpoint of the "dummy" service.
+// ShareItem may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) ShareItem(ctx context.Context, p *ShareItemPayload) (res *ItemShare, err error) {
+	var ires any
+	ires, err = c.ShareItemEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ItemShare), nil
+}
+
+// UnshareItem calls the "unshare_item" endpoint of the "dummy" service.
+// UnshareItem may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) UnshareItem(ctx context.Context, p *UnshareItemPayload) (err error) {
+	_, err = c.UnshareItemEndpoint(ctx, p)
+	return
+}
+
+// ListItemShares calls the "list_item_shares" endpoint of the "dummy" service.
+// ListItemShares may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) ListItemShares(ctx context.Context, p *ItemIDPayload) (res *ItemSharesCollection, err error) {
+	var ires any
+	ires, err = c.ListItemSharesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ItemSharesCollection), nil
+}
+
+// AddItemTag calls the "add_item_tag" endpoint of the "dummy" service.
+// AddItemTag may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) AddItemTag(ctx context.Context, p *ItemTagPayload) (res *Item, err error) {
+	var ires any
+	ires, err = c.AddItemTagEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Item), nil
+}
+
+// RemoveItemTag calls the "remove_item_tag" endpoint of the "dummy" service.
+// RemoveItemTag may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) RemoveItemTag(ctx context.Context, p *ItemTagPayload) (res *Item, err error) {
+	var ires any
+	ires, err = c.RemoveItemTagEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Item), nil
+}
+
+// SuggestTags calls the "suggest_tags" endpoint of the "dummy" service.
+// SuggestTags may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) SuggestTags(ctx context.Context, p *SuggestTagsPayload) (res *TagSuggestions, err error) {
+	var ires any
+	ires, err = c.SuggestTagsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TagSuggestions), nil
+}
+
+// UploadAttachment calls the "upload_attachment" endpoint of the "dummy"
+// service.
+// UploadAttachment may return the following errors:
+//   - "too_large" (type *DummyTooLargeError): The content exceeds DUMMY_ATTACHMENT_MAX_SIZE
+//   - "unsupported_media_type" (type *DummyUnsupportedMediaTypeError): The sniffed content type is not allowed
+//   - "checksum_mismatch" (type *DummyChecksumMismatchError): The content does not match checksum_sha256
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) UploadAttachment(ctx context.Context, p *UploadAttachmentPayload, req io.ReadCloser) (res *Attachment, err error) {
+	var ires any
+	ires, err = c.UploadAttachmentEndpoint(ctx, &UploadAttachmentRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*Attachment), nil
+}
+
+// DownloadAttachment calls the "download_attachment" endpoint of the "dummy"
+// service.
+// DownloadAttachment may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) DownloadAttachment(ctx context.Context, p *AttachmentIDPayload) (res *AttachmentDownload, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.DownloadAttachmentEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*DownloadAttachmentResponseData)
+	return o.Result, o.Body, nil
+}
+
+// ListAttachments calls the "list_attachments" endpoint of the "dummy" service.
+// ListAttachments may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) ListAttachments(ctx context.Context, p *ItemIDPayload) (res *AttachmentsCollection, err error) {
+	var ires any
+	ires, err = c.ListAttachmentsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AttachmentsCollection), nil
+}
+
+// DeleteAttachment calls the "delete_attachment" endpoint of the "dummy"
+// service.
+// DeleteAttachment may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) DeleteAttachment(ctx context.Context, p *AttachmentIDPayload) (err error) {
+	_, err = c.DeleteAttachmentEndpoint(ctx, p)
+	return
+}
+
+// BatchCreateItems calls the "batch_create_items" endpoint of the "dummy"
+// service.
+// BatchCreateItems may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) BatchCreateItems(ctx context.Context, p *BatchCreateItemsPayload) (res *BatchResult, err error) {
+	var ires any
+	ires, err = c.BatchCreateItemsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BatchResult), nil
+}
+
+// BatchUpdateItems calls the "batch_update_items" endpoint of the "dummy"
+// service.
+// BatchUpdateItems may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) BatchUpdateItems(ctx context.Context, p *BatchUpdateItemsPayload) (res *BatchResult, err error) {
+	var ires any
+	ires, err = c.BatchUpdateItemsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BatchResult), nil
+}
+
+// BatchDeleteItems calls the "batch_delete_items" endpoint of the "dummy"
+// service.
+// BatchDeleteItems may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) BatchDeleteItems(ctx context.Context, p *BatchDeleteItemsPayload) (res *BatchResult, err error) {
+	var ires any
+	ires, err = c.BatchDeleteItemsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BatchResult), nil
+}
+
+// ExportItems calls the "export_items" endpoint of the "dummy" service.
+// ExportItems may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) ExportItems(ctx context.Context, p *ExportItemsPayload) (res *ItemsExport, resp io.ReadCloser, err error) {
+	var ires any
+	ires, err = c.ExportItemsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	o := ires.(*ExportItemsResponseData)
+	return o.Result, o.Body, nil
+}
+
+// ImportItemsFile calls the "import_items_file" endpoint of the "dummy"
+// service.
+// ImportItemsFile may return the following errors:
+//   - "too_large" (type *DummyTooLargeError): The file has more rows than a single import allows
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) ImportItemsFile(ctx context.Context, p *ImportItemsFilePayload, req io.ReadCloser) (res *ImportReport, err error) {
+	var ires any
+	ires, err = c.ImportItemsFileEndpoint(ctx, &ImportItemsFileRequestData{Payload: p, Body: req})
+	if err != nil {
+		return
+	}
+	return ires.(*ImportReport), nil
+}
+
+// ImportItems calls the "import_items" endpoint of the "dummy" service.
+// ImportItems may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) ImportItems(ctx context.Context, p *AuthenticatedPayload) (res ImportItemsClientStream, err error) {
+	var ires any
+	ires, err = c.ImportItemsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(ImportItemsClientStream), nil
+}
+
+// ListTrash calls the "list_trash" endpoint of the "dummy" service.
+// ListTrash may return the following errors:
+//   - "invalid_cursor" (type *DummyBadRequestError): The cursor is malformed
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) ListTrash(ctx context.Context, p *ListTrashPayload) (res *ItemsCollection, err error) {
+	var ires any
+	ires, err = c.ListTrashEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ItemsCollection), nil
+}
+
+// RestoreItem calls the "restore_item" endpoint of the "dummy" service.
+// RestoreItem may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) RestoreItem(ctx context.Context, p *ItemIDPayload) (res *Item, err error) {
+	var ires any
+	ires, err = c.RestoreItemEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Item), nil
+}
+
+// PurgeItem calls the "purge_item" endpoint of the "dummy" service.
+// PurgeItem may return the following errors:
+//   - "unauthorized" (type *DummyUnauthorizedError)
+//   - "not_found" (type *DummyNotFoundError)
+//   - "forbidden" (type *DummyForbiddenError)
+//   - error: internal error
+func (c *Client) PurgeItem(ctx context.Context, p *ItemIDPayload) (err error) {
+	_, err = c.PurgeItemEndpoint(ctx, p)
+	return
+}
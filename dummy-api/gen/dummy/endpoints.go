@@ -9,25 +9,115 @@ package dummy
 
 import (
 	"context"
+	"io"
 
 	goa "goa.design/goa/v3/pkg"
 )
 
 // Endpoints wraps the "dummy" service endpoints.
 type Endpoints struct {
-	CreateItem goa.Endpoint
-	ListItems  goa.Endpoint
-	GetItem    goa.Endpoint
-	DeleteItem goa.Endpoint
+	CreateItem         goa.Endpoint
+	ListItems          goa.Endpoint
+	SearchItems        goa.Endpoint
+	GetItem            goa.Endpoint
+	UpdateItem         goa.Endpoint
+	PatchItem          goa.Endpoint
+	DeleteItem         goa.Endpoint
+	ShareItem          goa.Endpoint
+	UnshareItem        goa.Endpoint
+	ListItemShares     goa.Endpoint
+	AddItemTag         goa.Endpoint
+	RemoveItemTag      goa.Endpoint
+	SuggestTags        goa.Endpoint
+	UploadAttachment   goa.Endpoint
+	DownloadAttachment goa.Endpoint
+	ListAttachments    goa.Endpoint
+	DeleteAttachment   goa.Endpoint
+	BatchCreateItems   goa.Endpoint
+	BatchUpdateItems   goa.Endpoint
+	BatchDeleteItems   goa.Endpoint
+	ExportItems        goa.Endpoint
+	ImportItemsFile    goa.Endpoint
+	ImportItems        goa.Endpoint
+	ListTrash          goa.Endpoint
+	RestoreItem        goa.Endpoint
+	PurgeItem          goa.Endpoint
+}
+
+// UploadAttachmentRequestData holds both the payload and the HTTP request body
+// reader of the "upload_attachment" method.
+type UploadAttachmentRequestData struct {
+	// Payload is the method payload.
+	Payload *UploadAttachmentPayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// DownloadAttachmentResponseData holds both the result and the HTTP response
+// body reader of the "download_attachment" method.
+type DownloadAttachmentResponseData struct {
+	// Result is the method result.
+	Result *AttachmentDownload
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// ExportItemsResponseData holds both the result and the HTTP response body
+// reader of the "export_items" method.
+type ExportItemsResponseData struct {
+	// Result is the method result.
+	Result *ItemsExport
+	// Body streams the HTTP response body.
+	Body io.ReadCloser
+}
+
+// ImportItemsFileRequestData holds both the payload and the HTTP request body
+// reader of the "import_items_file" method.
+type ImportItemsFileRequestData struct {
+	// Payload is the method payload.
+	Payload *ImportItemsFilePayload
+	// Body streams the HTTP request body.
+	Body io.ReadCloser
+}
+
+// ImportItemsEndpointInput holds both the payload and the server stream of the
+// "import_items" method.
+type ImportItemsEndpointInput struct {
+	// Payload is the method payload.
+	Payload *AuthenticatedPayload
+	// Stream is the server stream used by the "import_items" method to send data.
+	Stream ImportItemsServerStream
 }
 
 // NewEndpoints wraps the methods of the "dummy" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		CreateItem: NewCreateItemEndpoint(s),
-		ListItems:  NewListItemsEndpoint(s),
-		GetItem:    NewGetItemEndpoint(s),
-		DeleteItem: NewDeleteItemEndpoint(s),
+		CreateItem:         NewCreateItemEndpoint(s),
+		ListItems:          NewListItemsEndpoint(s),
+		SearchItems:        NewSearchItemsEndpoint(s),
+		GetItem:            NewGetItemEndpoint(s),
+		UpdateItem:         NewUpdateItemEndpoint(s),
+		PatchItem:          NewPatchItemEndpoint(s),
+		DeleteItem:         NewDeleteItemEndpoint(s),
+		ShareItem:          NewShareItemEndpoint(s),
+		UnshareItem:        NewUnshareItemEndpoint(s),
+		ListItemShares:     NewListItemSharesEndpoint(s),
+		AddItemTag:         NewAddItemTagEndpoint(s),
+		RemoveItemTag:      NewRemoveItemTagEndpoint(s),
+		SuggestTags:        NewSuggestTagsEndpoint(s),
+		UploadAttachment:   NewUploadAttachmentEndpoint(s),
+		DownloadAttachment: NewDownloadAttachmentEndpoint(s),
+		ListAttachments:    NewListAttachmentsEndpoint(s),
+		DeleteAttachment:   NewDeleteAttachmentEndpoint(s),
+		BatchCreateItems:   NewBatchCreateItemsEndpoint(s),
+		BatchUpdateItems:   NewBatchUpdateItemsEndpoint(s),
+		BatchDeleteItems:   NewBatchDeleteItemsEndpoint(s),
+		ExportItems:        NewExportItemsEndpoint(s),
+		ImportItemsFile:    NewImportItemsFileEndpoint(s),
+		ImportItems:        NewImportItemsEndpoint(s),
+		ListTrash:          NewListTrashEndpoint(s),
+		RestoreItem:        NewRestoreItemEndpoint(s),
+		PurgeItem:          NewPurgeItemEndpoint(s),
 	}
 }
 
@@ -35,8 +125,30 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.CreateItem = m(e.CreateItem)
 	e.ListItems = m(e.ListItems)
+	e.SearchItems = m(e.SearchItems)
 	e.GetItem = m(e.GetItem)
+	e.UpdateItem = m(e.UpdateItem)
+	e.PatchItem = m(e.PatchItem)
 	e.DeleteItem = m(e.DeleteItem)
+	e.ShareItem = m(e.ShareItem)
+	e.UnshareItem = m(e.UnshareItem)
+	e.ListItemShares = m(e.ListItemShares)
+	e.AddItemTag = m(e.AddItemTag)
+	e.RemoveItemTag = m(e.RemoveItemTag)
+	e.SuggestTags = m(e.SuggestTags)
+	e.UploadAttachment = m(e.UploadAttachment)
+	e.DownloadAttachment = m(e.DownloadAttachment)
+	e.ListAttachments = m(e.ListAttachments)
+	e.DeleteAttachment = m(e.DeleteAttachment)
+	e.BatchCreateItems = m(e.BatchCreateItems)
+	e.BatchUpdateItems = m(e.BatchUpdateItems)
+	e.BatchDeleteItems = m(e.BatchDeleteItems)
+	e.ExportItems = m(e.ExportItems)
+	e.ImportItemsFile = m(e.ImportItemsFile)
+	e.ImportItems = m(e.ImportItems)
+	e.ListTrash = m(e.ListTrash)
+	e.RestoreItem = m(e.RestoreItem)
+	e.PurgeItem = m(e.PurgeItem)
 }
 
 // NewCreateItemEndpoint returns an endpoint function that calls the method
@@ -62,6 +174,15 @@ func NewListItemsEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewSearchItemsEndpoint returns an endpoint function that calls the method
+// "search_items" of service "dummy".
+func NewSearchItemsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SearchItemsPayload)
+		return s.SearchItems(ctx, p)
+	}
+}
+
 // NewGetItemEndpoint returns an endpoint function that calls the method
 // "get_item" of service "dummy".
 func NewGetItemEndpoint(s Service) goa.Endpoint {
@@ -76,6 +197,34 @@ func NewGetItemEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewUpdateItemEndpoint returns an endpoint function that calls the method
+// "update_item" of service "dummy".
+func NewUpdateItemEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateItemPayload)
+		res, err := s.UpdateItem(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedItem(res, "default")
+		return vres, nil
+	}
+}
+
+// NewPatchItemEndpoint returns an endpoint function that calls the method
+// "patch_item" of service "dummy".
+func NewPatchItemEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*PatchItemPayload)
+		res, err := s.PatchItem(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedItem(res, "default")
+		return vres, nil
+	}
+}
+
 // NewDeleteItemEndpoint returns an endpoint function that calls the method
 // "delete_item" of service "dummy".
 func NewDeleteItemEndpoint(s Service) goa.Endpoint {
@@ -84,3 +233,197 @@ func NewDeleteItemEndpoint(s Service) goa.Endpoint {
 		return nil, s.DeleteItem(ctx, p)
 	}
 }
+
+// NewShareItemEndpoint returns an endpoint function that calls the method
+// "share_item" of service "dummy".
+func NewShareItemEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ShareItemPayload)
+		return s.ShareItem(ctx, p)
+	}
+}
+
+// NewUnshareItemEndpoint returns an endpoint function that calls the method
+// "unshare_item" of service "dummy".
+func NewUnshareItemEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UnshareItemPayload)
+		return nil, s.UnshareItem(ctx, p)
+	}
+}
+
+// NewListItemSharesEndpoint returns an endpoint function that calls the method
+// "list_item_shares" of service "dummy".
+func NewListItemSharesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ItemIDPayload)
+		return s.ListItemShares(ctx, p)
+	}
+}
+
+// NewAddItemTagEndpoint returns an endpoint function that calls the method
+// "add_item_tag" of service "dummy".
+func NewAddItemTagEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ItemTagPayload)
+		res, err := s.AddItemTag(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedItem(res, "default")
+		return vres, nil
+	}
+}
+
+// NewRemoveItemTagEndpoint returns an endpoint function that calls the method
+// "remove_item_tag" of service "dummy".
+func NewRemoveItemTagEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ItemTagPayload)
+		res, err := s.RemoveItemTag(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedItem(res, "default")
+		return vres, nil
+	}
+}
+
+// NewSuggestTagsEndpoint returns an endpoint function that calls the method
+// "suggest_tags" of service "dummy".
+func NewSuggestTagsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SuggestTagsPayload)
+		return s.SuggestTags(ctx, p)
+	}
+}
+
+// NewUploadAttachmentEndpoint returns an endpoint function that calls the
+// method "upload_attachment" of service "dummy".
+func NewUploadAttachmentEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*UploadAttachmentRequestData)
+		return s.UploadAttachment(ctx, ep.Payload, ep.Body)
+	}
+}
+
+// NewDownloadAttachmentEndpoint returns an endpoint function that calls the
+// method "download_attachment" of service "dummy".
+func NewDownloadAttachmentEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AttachmentIDPayload)
+		res, body, err := s.DownloadAttachment(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &DownloadAttachmentResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewListAttachmentsEndpoint returns an endpoint function that calls the
+// method "list_attachments" of service "dummy".
+func NewListAttachmentsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ItemIDPayload)
+		return s.ListAttachments(ctx, p)
+	}
+}
+
+// NewDeleteAttachmentEndpoint returns an endpoint function that calls the
+// method "delete_attachment" of service "dummy".
+func NewDeleteAttachmentEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AttachmentIDPayload)
+		return nil, s.DeleteAttachment(ctx, p)
+	}
+}
+
+// NewBatchCreateItemsEndpoint returns an endpoint function that calls the
+// method "batch_create_items" of service "dummy".
+func NewBatchCreateItemsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BatchCreateItemsPayload)
+		return s.BatchCreateItems(ctx, p)
+	}
+}
+
+// NewBatchUpdateItemsEndpoint returns an endpoint function that calls the
+// method "batch_update_items" of service "dummy".
+func NewBatchUpdateItemsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BatchUpdateItemsPayload)
+		return s.BatchUpdateItems(ctx, p)
+	}
+}
+
+// NewBatchDeleteItemsEndpoint returns an endpoint function that calls the
+// method "batch_delete_items" of service "dummy".
+func NewBatchDeleteItemsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*BatchDeleteItemsPayload)
+		return s.BatchDeleteItems(ctx, p)
+	}
+}
+
+// NewExportItemsEndpoint returns an endpoint function that calls the method
+// "export_items" of service "dummy".
+func NewExportItemsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportItemsPayload)
+		res, body, err := s.ExportItems(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		return &ExportItemsResponseData{Result: res, Body: body}, nil
+	}
+}
+
+// NewImportItemsFileEndpoint returns an endpoint function that calls the
+// method "import_items_file" of service "dummy".
+func NewImportItemsFileEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*ImportItemsFileRequestData)
+		return s.ImportItemsFile(ctx, ep.Payload, ep.Body)
+	}
+}
+
+// NewImportItemsEndpoint returns an endpoint function that calls the method
+// "import_items" of service "dummy".
+func NewImportItemsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*ImportItemsEndpointInput)
+		return nil, s.ImportItems(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewListTrashEndpoint returns an endpoint function that calls the method
+// "list_trash" of service "dummy".
+func NewListTrashEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListTrashPayload)
+		return s.ListTrash(ctx, p)
+	}
+}
+
+// NewRestoreItemEndpoint returns an endpoint function that calls the method
+// "restore_item" of service "dummy".
+func NewRestoreItemEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ItemIDPayload)
+		res, err := s.RestoreItem(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedItem(res, "default")
+		return vres, nil
+	}
+}
+
+// NewPurgeItemEndpoint returns an endpoint function that calls the method
+// "purge_item" of service "dummy".
+func NewPurgeItemEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ItemIDPayload)
+		return nil, s.PurgeItem(ctx, p)
+	}
+}
@@ -9,6 +9,7 @@ package dummy
 
 import (
 	"context"
+	"io"
 
 	dummyviews "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy/views"
 )
@@ -17,12 +18,80 @@ import (
 type Service interface {
 	// CreateItem implements create_item.
 	CreateItem(context.Context, *CreateItemPayload) (res *Item, err error)
-	// ListItems implements list_items.
+	// Pages through the items owned by or shared with the caller, newest first
+	// unless order is asc. Pass next_cursor as cursor to fetch the following page
+	// with the same filters and order
 	ListItems(context.Context, *ListItemsPayload) (res *ItemsCollection, err error)
-	// GetItem implements get_item.
+	// Full-text search over the name and description of the caller's items, ranked
+	// by relevance
+	SearchItems(context.Context, *SearchItemsPayload) (res *SearchResultsCollection, err error)
+	// Fetches an item owned by or shared with the caller
 	GetItem(context.Context, *ItemIDPayload) (res *Item, err error)
-	// DeleteItem implements delete_item.
+	// Replaces the name and description of an item. The update must name the
+	// version it is based on and fails with conflict if the item changed since
+	UpdateItem(context.Context, *UpdateItemPayload) (res *Item, err error)
+	// Changes the given fields of an item. Like update_item it fails with conflict
+	// if the item changed since the given version
+	PatchItem(context.Context, *PatchItemPayload) (res *Item, err error)
+	// Moves an item to the trash, from which it can be restored until it is purged
 	DeleteItem(context.Context, *ItemIDPayload) (err error)
+	// Shares an item with another user, or changes the permission it is shared
+	// with. Only the owner can share an item
+	ShareItem(context.Context, *ShareItemPayload) (res *ItemShare, err error)
+	// Stops sharing an item with a user. The owner can remove any share and users
+	// can remove their own
+	UnshareItem(context.Context, *UnshareItemPayload) (err error)
+	// Lists the users an item is shared with. Only the owner can list them
+	ListItemShares(context.Context, *ItemIDPayload) (res *ItemSharesCollection, err error)
+	// Tags an item, creating the tag in the owner's tags if needed. Requires
+	// editor access
+	AddItemTag(context.Context, *ItemTagPayload) (res *Item, err error)
+	// Removes a tag from an item. Requires editor access
+	RemoveItemTag(context.Context, *ItemTagPayload) (res *Item, err error)
+	// Autocompletes the caller's tags, most used first
+	SuggestTags(context.Context, *SuggestTagsPayload) (res *TagSuggestions, err error)
+	// Attaches a file to an item. The request body is the raw content, streamed to
+	// blob storage. Requires editor access
+	UploadAttachment(context.Context, *UploadAttachmentPayload, io.ReadCloser) (res *Attachment, err error)
+	// Streams the content of an attachment as a download. HTML, XML and script
+	// content is served as application/octet-stream
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	DownloadAttachment(context.Context, *AttachmentIDPayload) (res *AttachmentDownload, body io.ReadCloser, err error)
+	// Lists the attachments of an item, oldest first
+	ListAttachments(context.Context, *ItemIDPayload) (res *AttachmentsCollection, err error)
+	// Deletes an attachment and its content. Requires editor access
+	DeleteAttachment(context.Context, *AttachmentIDPayload) (err error)
+	// Creates up to 100 items, atomically unless atomic is false
+	BatchCreateItems(context.Context, *BatchCreateItemsPayload) (res *BatchResult, err error)
+	// Changes the given fields of up to 100 items like patch_item, atomically
+	// unless atomic is false
+	BatchUpdateItems(context.Context, *BatchUpdateItemsPayload) (res *BatchResult, err error)
+	// Moves up to 100 items to the trash, atomically unless atomic is false
+	BatchDeleteItems(context.Context, *BatchDeleteItemsPayload) (res *BatchResult, err error)
+	// Streams the caller's items, oldest first, as CSV or newline-delimited JSON.
+	// Trashed and shared items are left out
+
+	// If body implements [io.WriterTo], that implementation will be used instead.
+	// Consider [goa.design/goa/v3/pkg.SkipResponseWriter] to adapt existing
+	// implementations.
+	ExportItems(context.Context, *ExportItemsPayload) (res *ItemsExport, body io.ReadCloser, err error)
+	// Creates items from a CSV or newline-delimited JSON file sent as the request
+	// body. Every row is validated first and nothing is imported unless all of
+	// them are valid; with dry_run nothing is imported either way
+	ImportItemsFile(context.Context, *ImportItemsFilePayload, io.ReadCloser) (res *ImportReport, err error)
+	// Creates items streamed by the client, each on its own, and reports a summary
+	// once the stream is closed. gRPC only; the token is sent in the authorization
+	// metadata
+	ImportItems(context.Context, *AuthenticatedPayload, ImportItemsServerStream) (err error)
+	// Lists the caller's trashed items, most recently deleted first
+	ListTrash(context.Context, *ListTrashPayload) (res *ItemsCollection, err error)
+	// Moves an item out of the trash
+	RestoreItem(context.Context, *ItemIDPayload) (res *Item, err error)
+	// Permanently deletes a trashed item
+	PurgeItem(context.Context, *ItemIDPayload) (err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -39,25 +108,261 @@ const ServiceName = "dummy"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [4]string{"create_item", "list_items", "get_item", "delete_item"}
+var MethodNames = [26]string{"create_item", "list_items", "search_items", "get_item", "update_item", "patch_item", "delete_item", "share_item", "unshare_item", "list_item_shares", "add_item_tag", "remove_item_tag", "suggest_tags", "upload_attachment", "download_attachment", "list_attachments", "delete_attachment", "batch_create_items", "batch_update_items", "batch_delete_items", "export_items", "import_items_file", "import_items", "list_trash", "restore_item", "purge_item"}
+
+// ImportItemsServerStream allows streaming instances of *ImportSummary to the
+// client.
+type ImportItemsServerStream interface {
+	// SendAndClose streams instances of "ImportSummary" and closes the stream.
+	SendAndClose(*ImportSummary) error
+	// SendAndCloseWithContext streams instances of "ImportSummary" and closes the
+	// stream with context.
+	SendAndCloseWithContext(context.Context, *ImportSummary) error
+	// Recv reads instances of "BatchItemEntry" from the stream.
+	Recv() (*BatchItemEntry, error)
+	// RecvWithContext reads instances of "BatchItemEntry" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*BatchItemEntry, error)
+}
+
+// ImportItemsClientStream allows streaming instances of *BatchItemEntry to the
+// client.
+type ImportItemsClientStream interface {
+	// Send streams instances of "BatchItemEntry".
+	Send(*BatchItemEntry) error
+	// SendWithContext streams instances of "BatchItemEntry" with context.
+	SendWithContext(context.Context, *BatchItemEntry) error
+	// CloseAndRecv stops sending messages to the stream and reads instances of
+	// "ImportSummary" from the stream.
+	CloseAndRecv() (*ImportSummary, error)
+	// CloseAndRecvWithContext stops sending messages to the stream and reads
+	// instances of "ImportSummary" from the stream with context.
+	CloseAndRecvWithContext(context.Context) (*ImportSummary, error)
+}
+
+// Attachment is the result type of the dummy service upload_attachment method.
+type Attachment struct {
+	ID       string
+	ItemID   string
+	Filename string
+	// Media type sniffed from the content
+	ContentType string
+	// Size in bytes
+	Size int64
+	// Hex-encoded SHA-256 of the content
+	ChecksumSha256 string
+	CreatedAt      string
+}
+
+// AttachmentDownload is the result type of the dummy service
+// download_attachment method.
+type AttachmentDownload struct {
+	ContentType        string
+	ContentLength      int64
+	ContentDisposition string
+	ChecksumSha256     string
+	// Always nosniff, so that browsers keep to content_type
+	ContentTypeOptions string
+}
+
+// AttachmentIDPayload is the payload type of the dummy service
+// download_attachment method.
+type AttachmentIDPayload struct {
+	ID           string
+	AttachmentID string
+	// Bearer token
+	Token string
+}
+
+// AttachmentsCollection is the result type of the dummy service
+// list_attachments method.
+type AttachmentsCollection struct {
+	Attachments []*Attachment
+}
+
+// AuthenticatedPayload is the payload type of the dummy service import_items
+// method.
+type AuthenticatedPayload struct {
+	// Bearer token
+	Token string
+}
+
+// BatchCreateItemsPayload is the payload type of the dummy service
+// batch_create_items method.
+type BatchCreateItemsPayload struct {
+	Items []*BatchItemEntry
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic bool
+	// Bearer token
+	Token string
+}
+
+// BatchDeleteItemsPayload is the payload type of the dummy service
+// batch_delete_items method.
+type BatchDeleteItemsPayload struct {
+	// Items to move to the trash
+	Ids []string
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic bool
+	// Bearer token
+	Token string
+}
+
+type BatchEntryError struct {
+	// Error name, such as not_found or conflict. aborted marks entries rolled back
+	// or skipped because another entry of an atomic batch failed
+	Name    string
+	Message string
+}
+
+type BatchEntryResult struct {
+	// Position of the entry in the request
+	Index int
+	// The created or updated item
+	Item  *Item
+	Error *BatchEntryError
+}
+
+// BatchItemEntry is the streaming payload type of the dummy service
+// import_items method.
+type BatchItemEntry struct {
+	Name        string
+	Description *string
+	// Language used to stem the item for search; defaults to DUMMY_SEARCH_LANGUAGE
+	Language *string
+}
+
+// BatchResult is the result type of the dummy service batch_create_items
+// method.
+type BatchResult struct {
+	// One result per entry, in request order
+	Results   []*BatchEntryResult
+	Succeeded int
+	Failed    int
+}
+
+type BatchUpdateEntry struct {
+	ID string
+	// Version the update is based on
+	Version int
+	// New name; omit to keep it
+	Name *string
+	// New description; omit to keep it, send an empty string to clear it
+	Description *string
+}
+
+// BatchUpdateItemsPayload is the payload type of the dummy service
+// batch_update_items method.
+type BatchUpdateItemsPayload struct {
+	Items []*BatchUpdateEntry
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic bool
+	// Bearer token
+	Token string
+}
 
 // CreateItemPayload is the payload type of the dummy service create_item
 // method.
 type CreateItemPayload struct {
 	Name        string
 	Description *string
+	// Language used to stem the item for search; defaults to DUMMY_SEARCH_LANGUAGE
+	Language *string
 	// Bearer token
 	Token string
 }
 
+type DummyBadRequestError struct {
+	Message string
+}
+
+type DummyChecksumMismatchError struct {
+	Message string
+}
+
+type DummyConflictError struct {
+	Message string
+	// Version the item has now
+	CurrentVersion *int
+}
+
+type DummyForbiddenError struct {
+	Message string
+}
+
 type DummyNotFoundError struct {
 	Message string
 }
 
+type DummyPreconditionRequiredError struct {
+	Message string
+}
+
+type DummyTooLargeError struct {
+	Message string
+}
+
 type DummyUnauthorizedError struct {
 	Message string
 }
 
+type DummyUnsupportedMediaTypeError struct {
+	Message string
+}
+
+// ExportItemsPayload is the payload type of the dummy service export_items
+// method.
+type ExportItemsPayload struct {
+	Format string
+	// Bearer token
+	Token string
+}
+
+// ImportItemsFilePayload is the payload type of the dummy service
+// import_items_file method.
+type ImportItemsFilePayload struct {
+	// Format of the request body. CSV files need a header row with a name column
+	// and may have description, language and tags columns, tags separated by
+	// semicolons
+	Format string
+	// Only validate the file
+	DryRun bool
+	// Bearer token
+	Token string
+}
+
+// ImportReport is the result type of the dummy service import_items_file
+// method.
+type ImportReport struct {
+	DryRun bool
+	// Rows read from the file
+	Rows int
+	// Rows rejected by validation
+	Failed int
+	// Items created; zero for dry runs and files with rejected rows
+	Imported int
+	// The first rejected rows
+	Errors []*ImportRowError
+}
+
+type ImportRowError struct {
+	// Line of the file the rejected row starts on, counting from 1
+	Line    int
+	Message string
+}
+
+// ImportSummary is the result type of the dummy service import_items method.
+type ImportSummary struct {
+	Received int64
+	Created  int64
+	Failed   int64
+	// The first failures, with index counting from the start of the stream
+	Errors []*BatchEntryResult
+}
+
 // Item is the result type of the dummy service create_item method.
 type Item struct {
 	// Item identifier
@@ -66,6 +371,19 @@ type Item struct {
 	Description *string
 	OwnerID     string
 	CreatedAt   string
+	// Incremented by every update; send it back to update the item
+	Version   int
+	UpdatedAt string
+	// Entity tag of this version, returned in the ETag header over HTTP
+	Etag string
+	// Text search configuration used to index the item
+	Language string
+	// When the item was moved to the trash
+	DeletedAt *string
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string
+	// Tags of the item, in alphabetical order
+	Tags []string
 }
 
 // ItemIDPayload is the payload type of the dummy service get_item method.
@@ -75,17 +393,266 @@ type ItemIDPayload struct {
 	Token string
 }
 
+// ItemShare is the result type of the dummy service share_item method.
+type ItemShare struct {
+	ItemID string
+	// User the item is shared with
+	UserID string
+	// viewer can read the item, editor can also update it
+	Permission string
+	CreatedAt  string
+}
+
+// ItemSharesCollection is the result type of the dummy service
+// list_item_shares method.
+type ItemSharesCollection struct {
+	Shares []*ItemShare
+}
+
+// ItemTagPayload is the payload type of the dummy service add_item_tag method.
+type ItemTagPayload struct {
+	ID string
+	// Tag name; tags are compared case-insensitively
+	Tag string
+	// Bearer token
+	Token string
+}
+
 // ItemsCollection is the result type of the dummy service list_items method.
 type ItemsCollection struct {
 	Items []*Item
+	// Cursor of the next page; absent on the last page
+	NextCursor *string
+	// Number of items matching the filters, when include_total is set
+	Total *int64
+}
+
+// ItemsExport is the result type of the dummy service export_items method.
+type ItemsExport struct {
+	ContentType        string
+	ContentDisposition string
 }
 
 // ListItemsPayload is the payload type of the dummy service list_items method.
 type ListItemsPayload struct {
+	// Maximum number of items to return
+	PageSize int
+	// next_cursor of the previous page
+	Cursor *string
+	// Order by creation time
+	Order string
+	// Only items whose name starts with this, ignoring case
+	NamePrefix *string
+	// Only items created at or after this time
+	CreatedAfter *string
+	// Only items created before this time
+	CreatedBefore *string
+	// Count the items matching the filters
+	IncludeTotal bool
+	// Only items with these tags, compared case-insensitively
+	Tags []string
+	// Whether items need any or all of the given tags
+	TagMatch string
 	// Bearer token
 	Token string
 }
 
+// ListTrashPayload is the payload type of the dummy service list_trash method.
+type ListTrashPayload struct {
+	// Maximum number of items to return
+	PageSize int
+	// next_cursor of the previous page
+	Cursor *string
+	// Bearer token
+	Token string
+}
+
+// PatchItemPayload is the payload type of the dummy service patch_item method.
+type PatchItemPayload struct {
+	ID string
+	// New name; omit to keep it
+	Name *string
+	// New description; omit to keep it, send an empty string to clear it
+	Description *string
+	// Version the update is based on; required over gRPC
+	Version *int
+	// ETag the update is based on; required over HTTP
+	IfMatch *string
+	// Bearer token
+	Token string
+}
+
+// SearchItemsPayload is the payload type of the dummy service search_items
+// method.
+type SearchItemsPayload struct {
+	// Words to search for; supports quoted phrases, OR and -exclusions
+	Query string
+	// Language used to stem the query; defaults to DUMMY_SEARCH_LANGUAGE
+	Language *string
+	// Maximum number of results to return
+	PageSize int
+	// next_cursor of the previous page
+	Cursor *string
+	// Bearer token
+	Token string
+}
+
+type SearchResult struct {
+	Item *Item
+	// Relevance of the item; higher is better
+	Rank float32
+	// Excerpt of the name and description with matches wrapped in <mark> tags. The
+	// item text is HTML-escaped, so the snippet can be inserted as markup
+	Snippet string
+}
+
+// SearchResultsCollection is the result type of the dummy service search_items
+// method.
+type SearchResultsCollection struct {
+	// Results ordered by relevance
+	Results []*SearchResult
+	// Cursor of the next page; absent on the last page
+	NextCursor *string
+}
+
+// ShareItemPayload is the payload type of the dummy service share_item method.
+type ShareItemPayload struct {
+	ID string
+	// User to share the item with
+	UserID string
+	// viewer can read the item, editor can also update it
+	Permission string
+	// Bearer token
+	Token string
+}
+
+// SuggestTagsPayload is the payload type of the dummy service suggest_tags
+// method.
+type SuggestTagsPayload struct {
+	// Beginning of the tag names to suggest, compared case-insensitively
+	Prefix string
+	// Maximum number of tags to return
+	Limit int
+	// Bearer token
+	Token string
+}
+
+type TagSuggestion struct {
+	Name string
+	// Number of items with the tag, not counting trashed ones
+	ItemCount int64
+}
+
+// TagSuggestions is the result type of the dummy service suggest_tags method.
+type TagSuggestions struct {
+	Tags []*TagSuggestion
+}
+
+// UnshareItemPayload is the payload type of the dummy service unshare_item
+// method.
+type UnshareItemPayload struct {
+	ID string
+	// User to stop sharing the item with
+	UserID string
+	// Bearer token
+	Token string
+}
+
+// UpdateItemPayload is the payload type of the dummy service update_item
+// method.
+type UpdateItemPayload struct {
+	ID   string
+	Name string
+	// New description; omit to clear it
+	Description *string
+	// Version the update is based on; required over gRPC
+	Version *int
+	// ETag the update is based on; required over HTTP
+	IfMatch *string
+	// Bearer token
+	Token string
+}
+
+// UploadAttachmentPayload is the payload type of the dummy service
+// upload_attachment method.
+type UploadAttachmentPayload struct {
+	ID string
+	// Name to download the attachment as
+	Filename string
+	// Hex-encoded SHA-256 the uploaded content must have
+	ChecksumSha256 *string
+	// Bearer token
+	Token string
+}
+
+// Error returns an error description.
+func (e *DummyBadRequestError) Error() string {
+	return ""
+}
+
+// ErrorName returns "DummyBadRequestError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *DummyBadRequestError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "DummyBadRequestError".
+func (e *DummyBadRequestError) GoaErrorName() string {
+	return "invalid_cursor"
+}
+
+// Error returns an error description.
+func (e *DummyChecksumMismatchError) Error() string {
+	return ""
+}
+
+// ErrorName returns "DummyChecksumMismatchError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *DummyChecksumMismatchError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "DummyChecksumMismatchError".
+func (e *DummyChecksumMismatchError) GoaErrorName() string {
+	return "checksum_mismatch"
+}
+
+// Error returns an error description.
+func (e *DummyConflictError) Error() string {
+	return ""
+}
+
+// ErrorName returns "DummyConflictError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *DummyConflictError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "DummyConflictError".
+func (e *DummyConflictError) GoaErrorName() string {
+	return "conflict"
+}
+
+// Error returns an error description.
+func (e *DummyForbiddenError) Error() string {
+	return ""
+}
+
+// ErrorName returns "DummyForbiddenError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *DummyForbiddenError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "DummyForbiddenError".
+func (e *DummyForbiddenError) GoaErrorName() string {
+	return "forbidden"
+}
+
 // Error returns an error description.
 func (e *DummyNotFoundError) Error() string {
 	return ""
@@ -103,6 +670,40 @@ func (e *DummyNotFoundError) GoaErrorName() string {
 	return "not_found"
 }
 
+// Error returns an error description.
+func (e *DummyPreconditionRequiredError) Error() string {
+	return ""
+}
+
+// ErrorName returns "DummyPreconditionRequiredError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *DummyPreconditionRequiredError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "DummyPreconditionRequiredError".
+func (e *DummyPreconditionRequiredError) GoaErrorName() string {
+	return "precondition_required"
+}
+
+// Error returns an error description.
+func (e *DummyTooLargeError) Error() string {
+	return ""
+}
+
+// ErrorName returns "DummyTooLargeError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *DummyTooLargeError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "DummyTooLargeError".
+func (e *DummyTooLargeError) GoaErrorName() string {
+	return "too_large"
+}
+
 // Error returns an error description.
 func (e *DummyUnauthorizedError) Error() string {
 	return ""
@@ -120,6 +721,23 @@ func (e *DummyUnauthorizedError) GoaErrorName() string {
 	return "unauthorized"
 }
 
+// Error returns an error description.
+func (e *DummyUnsupportedMediaTypeError) Error() string {
+	return ""
+}
+
+// ErrorName returns "DummyUnsupportedMediaTypeError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *DummyUnsupportedMediaTypeError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "DummyUnsupportedMediaTypeError".
+func (e *DummyUnsupportedMediaTypeError) GoaErrorName() string {
+	return "unsupported_media_type"
+}
+
 // NewItem initializes result type Item from viewed result type Item.
 func NewItem(vres *dummyviews.Item) *Item {
 	return newItem(vres.Projected)
@@ -136,6 +754,7 @@ func NewViewedItem(res *Item, view string) *dummyviews.Item {
 func newItem(vres *dummyviews.ItemView) *Item {
 	res := &Item{
 		Description: vres.Description,
+		DeletedAt:   vres.DeletedAt,
 	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
@@ -149,6 +768,27 @@ func newItem(vres *dummyviews.ItemView) *Item {
 	if vres.CreatedAt != nil {
 		res.CreatedAt = *vres.CreatedAt
 	}
+	if vres.Version != nil {
+		res.Version = *vres.Version
+	}
+	if vres.UpdatedAt != nil {
+		res.UpdatedAt = *vres.UpdatedAt
+	}
+	if vres.Etag != nil {
+		res.Etag = *vres.Etag
+	}
+	if vres.Language != nil {
+		res.Language = *vres.Language
+	}
+	if vres.Permission != nil {
+		res.Permission = *vres.Permission
+	}
+	if vres.Tags != nil {
+		res.Tags = make([]string, len(vres.Tags))
+		for i, val := range vres.Tags {
+			res.Tags[i] = val
+		}
+	}
 	return res
 }
 
@@ -161,6 +801,20 @@ func newItemView(res *Item) *dummyviews.ItemView {
 		Description: res.Description,
 		OwnerID:     &res.OwnerID,
 		CreatedAt:   &res.CreatedAt,
+		Version:     &res.Version,
+		UpdatedAt:   &res.UpdatedAt,
+		Etag:        &res.Etag,
+		Language:    &res.Language,
+		DeletedAt:   res.DeletedAt,
+		Permission:  &res.Permission,
+	}
+	if res.Tags != nil {
+		vres.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			vres.Tags[i] = val
+		}
+	} else {
+		vres.Tags = []string{}
 	}
 	return vres
 }
@@ -27,11 +27,81 @@ type ItemView struct {
 	Description *string
 	OwnerID     *string
 	CreatedAt   *string
+	// Incremented by every update; send it back to update the item
+	Version   *int
+	UpdatedAt *string
+	// Entity tag of this version, returned in the ETag header over HTTP
+	Etag *string
+	// Text search configuration used to index the item
+	Language *string
+	// When the item was moved to the trash
+	DeletedAt *string
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string
+	// Tags of the item, in alphabetical order
+	Tags []string
 }
 
 // ItemsCollectionView is a type that runs validations on a projected type.
 type ItemsCollectionView struct {
 	Items []*ItemView
+	// Cursor of the next page; absent on the last page
+	NextCursor *string
+	// Number of items matching the filters, when include_total is set
+	Total *int64
+}
+
+// SearchResultsCollectionView is a type that runs validations on a projected
+// type.
+type SearchResultsCollectionView struct {
+	// Results ordered by relevance
+	Results []*SearchResultView
+	// Cursor of the next page; absent on the last page
+	NextCursor *string
+}
+
+// SearchResultView is a type that runs validations on a projected type.
+type SearchResultView struct {
+	Item *ItemView
+	// Relevance of the item; higher is better
+	Rank *float32
+	// Excerpt of the name and description with matches wrapped in <mark> tags. The
+	// item text is HTML-escaped, so the snippet can be inserted as markup
+	Snippet *string
+}
+
+// BatchResultView is a type that runs validations on a projected type.
+type BatchResultView struct {
+	// One result per entry, in request order
+	Results   []*BatchEntryResultView
+	Succeeded *int
+	Failed    *int
+}
+
+// BatchEntryResultView is a type that runs validations on a projected type.
+type BatchEntryResultView struct {
+	// Position of the entry in the request
+	Index *int
+	// The created or updated item
+	Item  *ItemView
+	Error *BatchEntryErrorView
+}
+
+// BatchEntryErrorView is a type that runs validations on a projected type.
+type BatchEntryErrorView struct {
+	// Error name, such as not_found or conflict. aborted marks entries rolled back
+	// or skipped because another entry of an atomic batch failed
+	Name    *string
+	Message *string
+}
+
+// ImportSummaryView is a type that runs validations on a projected type.
+type ImportSummaryView struct {
+	Received *int64
+	Created  *int64
+	Failed   *int64
+	// The first failures, with index counting from the start of the stream
+	Errors []*BatchEntryResultView
 }
 
 var (
@@ -43,6 +113,13 @@ var (
 			"description",
 			"owner_id",
 			"created_at",
+			"version",
+			"updated_at",
+			"etag",
+			"language",
+			"deleted_at",
+			"permission",
+			"tags",
 		},
 	}
 )
@@ -73,9 +150,38 @@ func ValidateItemView(result *ItemView) (err error) {
 	if result.CreatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
 	}
+	if result.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "result"))
+	}
+	if result.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "result"))
+	}
+	if result.Etag == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("etag", "result"))
+	}
+	if result.Language == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("language", "result"))
+	}
+	if result.Permission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("permission", "result"))
+	}
+	if result.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "result"))
+	}
 	if result.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
 	}
+	if result.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.updated_at", *result.UpdatedAt, goa.FormatDateTime))
+	}
+	if result.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.deleted_at", *result.DeletedAt, goa.FormatDateTime))
+	}
+	if result.Permission != nil {
+		if !(*result.Permission == "owner" || *result.Permission == "editor" || *result.Permission == "viewer") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.permission", *result.Permission, []any{"owner", "editor", "viewer"}))
+		}
+	}
 	return
 }
 
@@ -94,3 +200,114 @@ func ValidateItemsCollectionView(result *ItemsCollectionView) (err error) {
 	}
 	return
 }
+
+// ValidateSearchResultsCollectionView runs the validations defined on
+// SearchResultsCollectionView.
+func ValidateSearchResultsCollectionView(result *SearchResultsCollectionView) (err error) {
+	if result.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "result"))
+	}
+	for _, e := range result.Results {
+		if e != nil {
+			if err2 := ValidateSearchResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSearchResultView runs the validations defined on SearchResultView.
+func ValidateSearchResultView(result *SearchResultView) (err error) {
+	if result.Item == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("item", "result"))
+	}
+	if result.Rank == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rank", "result"))
+	}
+	if result.Snippet == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("snippet", "result"))
+	}
+	if result.Item != nil {
+		if err2 := ValidateItemView(result.Item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateBatchResultView runs the validations defined on BatchResultView.
+func ValidateBatchResultView(result *BatchResultView) (err error) {
+	if result.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "result"))
+	}
+	if result.Succeeded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("succeeded", "result"))
+	}
+	if result.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "result"))
+	}
+	for _, e := range result.Results {
+		if e != nil {
+			if err2 := ValidateBatchEntryResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchEntryResultView runs the validations defined on
+// BatchEntryResultView.
+func ValidateBatchEntryResultView(result *BatchEntryResultView) (err error) {
+	if result.Index == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("index", "result"))
+	}
+	if result.Item != nil {
+		if err2 := ValidateItemView(result.Item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if result.Error != nil {
+		if err2 := ValidateBatchEntryErrorView(result.Error); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateBatchEntryErrorView runs the validations defined on
+// BatchEntryErrorView.
+func ValidateBatchEntryErrorView(result *BatchEntryErrorView) (err error) {
+	if result.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "result"))
+	}
+	if result.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "result"))
+	}
+	return
+}
+
+// ValidateImportSummaryView runs the validations defined on ImportSummaryView.
+func ValidateImportSummaryView(result *ImportSummaryView) (err error) {
+	if result.Received == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("received", "result"))
+	}
+	if result.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "result"))
+	}
+	if result.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "result"))
+	}
+	if result.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "result"))
+	}
+	for _, e := range result.Errors {
+		if e != nil {
+			if err2 := ValidateBatchEntryResultView(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
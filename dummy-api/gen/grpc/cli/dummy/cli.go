@@ -22,13 +22,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"dummy (create-item|list-items|get-item|delete-item)",
+		"dummy (create-item|list-items|search-items|get-item|update-item|patch-item|delete-item|share-item|unshare-item|list-item-shares|add-item-tag|remove-item-tag|suggest-tags|list-attachments|delete-attachment|batch-create-items|batch-update-items|batch-delete-items|import-items|list-trash|restore-item|purge-item)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "dummy create-item --message '{\n      \"description\": \"Quia molestias rerum.\",\n      \"name\": \"Alias iusto doloribus quia.\",\n      \"token\": \"Alias velit velit est laboriosam est.\"\n   }'" + "\n" +
+	return os.Args[0] + " " + "dummy create-item --message '{\n      \"description\": \"Excepturi possimus cum id impedit ut.\",\n      \"language\": \"french\",\n      \"name\": \"Aspernatur tempore quibusdam.\",\n      \"token\": \"Natus voluptatem at hic et est laborum.\"\n   }'" + "\n" +
 		""
 }
 
@@ -47,17 +47,89 @@ func ParseEndpoint(
 		dummyListItemsFlags       = flag.NewFlagSet("list-items", flag.ExitOnError)
 		dummyListItemsMessageFlag = dummyListItemsFlags.String("message", "", "")
 
+		dummySearchItemsFlags       = flag.NewFlagSet("search-items", flag.ExitOnError)
+		dummySearchItemsMessageFlag = dummySearchItemsFlags.String("message", "", "")
+
 		dummyGetItemFlags       = flag.NewFlagSet("get-item", flag.ExitOnError)
 		dummyGetItemMessageFlag = dummyGetItemFlags.String("message", "", "")
 
+		dummyUpdateItemFlags       = flag.NewFlagSet("update-item", flag.ExitOnError)
+		dummyUpdateItemMessageFlag = dummyUpdateItemFlags.String("message", "", "")
+
+		dummyPatchItemFlags       = flag.NewFlagSet("patch-item", flag.ExitOnError)
+		dummyPatchItemMessageFlag = dummyPatchItemFlags.String("message", "", "")
+
 		dummyDeleteItemFlags       = flag.NewFlagSet("delete-item", flag.ExitOnError)
 		dummyDeleteItemMessageFlag = dummyDeleteItemFlags.String("message", "", "")
+
+		dummyShareItemFlags       = flag.NewFlagSet("share-item", flag.ExitOnError)
+		dummyShareItemMessageFlag = dummyShareItemFlags.String("message", "", "")
+
+		dummyUnshareItemFlags       = flag.NewFlagSet("unshare-item", flag.ExitOnError)
+		dummyUnshareItemMessageFlag = dummyUnshareItemFlags.String("message", "", "")
+
+		dummyListItemSharesFlags       = flag.NewFlagSet("list-item-shares", flag.ExitOnError)
+		dummyListItemSharesMessageFlag = dummyListItemSharesFlags.String("message", "", "")
+
+		dummyAddItemTagFlags       = flag.NewFlagSet("add-item-tag", flag.ExitOnError)
+		dummyAddItemTagMessageFlag = dummyAddItemTagFlags.String("message", "", "")
+
+		dummyRemoveItemTagFlags       = flag.NewFlagSet("remove-item-tag", flag.ExitOnError)
+		dummyRemoveItemTagMessageFlag = dummyRemoveItemTagFlags.String("message", "", "")
+
+		dummySuggestTagsFlags       = flag.NewFlagSet("suggest-tags", flag.ExitOnError)
+		dummySuggestTagsMessageFlag = dummySuggestTagsFlags.String("message", "", "")
+
+		dummyListAttachmentsFlags       = flag.NewFlagSet("list-attachments", flag.ExitOnError)
+		dummyListAttachmentsMessageFlag = dummyListAttachmentsFlags.String("message", "", "")
+
+		dummyDeleteAttachmentFlags       = flag.NewFlagSet("delete-attachment", flag.ExitOnError)
+		dummyDeleteAttachmentMessageFlag = dummyDeleteAttachmentFlags.String("message", "", "")
+
+		dummyBatchCreateItemsFlags       = flag.NewFlagSet("batch-create-items", flag.ExitOnError)
+		dummyBatchCreateItemsMessageFlag = dummyBatchCreateItemsFlags.String("message", "", "")
+
+		dummyBatchUpdateItemsFlags       = flag.NewFlagSet("batch-update-items", flag.ExitOnError)
+		dummyBatchUpdateItemsMessageFlag = dummyBatchUpdateItemsFlags.String("message", "", "")
+
+		dummyBatchDeleteItemsFlags       = flag.NewFlagSet("batch-delete-items", flag.ExitOnError)
+		dummyBatchDeleteItemsMessageFlag = dummyBatchDeleteItemsFlags.String("message", "", "")
+
+		dummyImportItemsFlags     = flag.NewFlagSet("import-items", flag.ExitOnError)
+		dummyImportItemsTokenFlag = dummyImportItemsFlags.String("token", "REQUIRED", "")
+
+		dummyListTrashFlags       = flag.NewFlagSet("list-trash", flag.ExitOnError)
+		dummyListTrashMessageFlag = dummyListTrashFlags.String("message", "", "")
+
+		dummyRestoreItemFlags       = flag.NewFlagSet("restore-item", flag.ExitOnError)
+		dummyRestoreItemMessageFlag = dummyRestoreItemFlags.String("message", "", "")
+
+		dummyPurgeItemFlags       = flag.NewFlagSet("purge-item", flag.ExitOnError)
+		dummyPurgeItemMessageFlag = dummyPurgeItemFlags.String("message", "", "")
 	)
 	dummyFlags.Usage = dummyUsage
 	dummyCreateItemFlags.Usage = dummyCreateItemUsage
 	dummyListItemsFlags.Usage = dummyListItemsUsage
+	dummySearchItemsFlags.Usage = dummySearchItemsUsage
 	dummyGetItemFlags.Usage = dummyGetItemUsage
+	dummyUpdateItemFlags.Usage = dummyUpdateItemUsage
+	dummyPatchItemFlags.Usage = dummyPatchItemUsage
 	dummyDeleteItemFlags.Usage = dummyDeleteItemUsage
+	dummyShareItemFlags.Usage = dummyShareItemUsage
+	dummyUnshareItemFlags.Usage = dummyUnshareItemUsage
+	dummyListItemSharesFlags.Usage = dummyListItemSharesUsage
+	dummyAddItemTagFlags.Usage = dummyAddItemTagUsage
+	dummyRemoveItemTagFlags.Usage = dummyRemoveItemTagUsage
+	dummySuggestTagsFlags.Usage = dummySuggestTagsUsage
+	dummyListAttachmentsFlags.Usage = dummyListAttachmentsUsage
+	dummyDeleteAttachmentFlags.Usage = dummyDeleteAttachmentUsage
+	dummyBatchCreateItemsFlags.Usage = dummyBatchCreateItemsUsage
+	dummyBatchUpdateItemsFlags.Usage = dummyBatchUpdateItemsUsage
+	dummyBatchDeleteItemsFlags.Usage = dummyBatchDeleteItemsUsage
+	dummyImportItemsFlags.Usage = dummyImportItemsUsage
+	dummyListTrashFlags.Usage = dummyListTrashUsage
+	dummyRestoreItemFlags.Usage = dummyRestoreItemUsage
+	dummyPurgeItemFlags.Usage = dummyPurgeItemUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -99,12 +171,66 @@ func ParseEndpoint(
 			case "list-items":
 				epf = dummyListItemsFlags
 
+			case "search-items":
+				epf = dummySearchItemsFlags
+
 			case "get-item":
 				epf = dummyGetItemFlags
 
+			case "update-item":
+				epf = dummyUpdateItemFlags
+
+			case "patch-item":
+				epf = dummyPatchItemFlags
+
 			case "delete-item":
 				epf = dummyDeleteItemFlags
 
+			case "share-item":
+				epf = dummyShareItemFlags
+
+			case "unshare-item":
+				epf = dummyUnshareItemFlags
+
+			case "list-item-shares":
+				epf = dummyListItemSharesFlags
+
+			case "add-item-tag":
+				epf = dummyAddItemTagFlags
+
+			case "remove-item-tag":
+				epf = dummyRemoveItemTagFlags
+
+			case "suggest-tags":
+				epf = dummySuggestTagsFlags
+
+			case "list-attachments":
+				epf = dummyListAttachmentsFlags
+
+			case "delete-attachment":
+				epf = dummyDeleteAttachmentFlags
+
+			case "batch-create-items":
+				epf = dummyBatchCreateItemsFlags
+
+			case "batch-update-items":
+				epf = dummyBatchUpdateItemsFlags
+
+			case "batch-delete-items":
+				epf = dummyBatchDeleteItemsFlags
+
+			case "import-items":
+				epf = dummyImportItemsFlags
+
+			case "list-trash":
+				epf = dummyListTrashFlags
+
+			case "restore-item":
+				epf = dummyRestoreItemFlags
+
+			case "purge-item":
+				epf = dummyPurgeItemFlags
+
 			}
 
 		}
@@ -136,12 +262,66 @@ func ParseEndpoint(
 			case "list-items":
 				endpoint = c.ListItems()
 				data, err = dummyc.BuildListItemsPayload(*dummyListItemsMessageFlag)
+			case "search-items":
+				endpoint = c.SearchItems()
+				data, err = dummyc.BuildSearchItemsPayload(*dummySearchItemsMessageFlag)
 			case "get-item":
 				endpoint = c.GetItem()
 				data, err = dummyc.BuildGetItemPayload(*dummyGetItemMessageFlag)
+			case "update-item":
+				endpoint = c.UpdateItem()
+				data, err = dummyc.BuildUpdateItemPayload(*dummyUpdateItemMessageFlag)
+			case "patch-item":
+				endpoint = c.PatchItem()
+				data, err = dummyc.BuildPatchItemPayload(*dummyPatchItemMessageFlag)
 			case "delete-item":
 				endpoint = c.DeleteItem()
 				data, err = dummyc.BuildDeleteItemPayload(*dummyDeleteItemMessageFlag)
+			case "share-item":
+				endpoint = c.ShareItem()
+				data, err = dummyc.BuildShareItemPayload(*dummyShareItemMessageFlag)
+			case "unshare-item":
+				endpoint = c.UnshareItem()
+				data, err = dummyc.BuildUnshareItemPayload(*dummyUnshareItemMessageFlag)
+			case "list-item-shares":
+				endpoint = c.ListItemShares()
+				data, err = dummyc.BuildListItemSharesPayload(*dummyListItemSharesMessageFlag)
+			case "add-item-tag":
+				endpoint = c.AddItemTag()
+				data, err = dummyc.BuildAddItemTagPayload(*dummyAddItemTagMessageFlag)
+			case "remove-item-tag":
+				endpoint = c.RemoveItemTag()
+				data, err = dummyc.BuildRemoveItemTagPayload(*dummyRemoveItemTagMessageFlag)
+			case "suggest-tags":
+				endpoint = c.SuggestTags()
+				data, err = dummyc.BuildSuggestTagsPayload(*dummySuggestTagsMessageFlag)
+			case "list-attachments":
+				endpoint = c.ListAttachments()
+				data, err = dummyc.BuildListAttachmentsPayload(*dummyListAttachmentsMessageFlag)
+			case "delete-attachment":
+				endpoint = c.DeleteAttachment()
+				data, err = dummyc.BuildDeleteAttachmentPayload(*dummyDeleteAttachmentMessageFlag)
+			case "batch-create-items":
+				endpoint = c.BatchCreateItems()
+				data, err = dummyc.BuildBatchCreateItemsPayload(*dummyBatchCreateItemsMessageFlag)
+			case "batch-update-items":
+				endpoint = c.BatchUpdateItems()
+				data, err = dummyc.BuildBatchUpdateItemsPayload(*dummyBatchUpdateItemsMessageFlag)
+			case "batch-delete-items":
+				endpoint = c.BatchDeleteItems()
+				data, err = dummyc.BuildBatchDeleteItemsPayload(*dummyBatchDeleteItemsMessageFlag)
+			case "import-items":
+				endpoint = c.ImportItems()
+				data, err = dummyc.BuildImportItemsPayload(*dummyImportItemsTokenFlag)
+			case "list-trash":
+				endpoint = c.ListTrash()
+				data, err = dummyc.BuildListTrashPayload(*dummyListTrashMessageFlag)
+			case "restore-item":
+				endpoint = c.RestoreItem()
+				data, err = dummyc.BuildRestoreItemPayload(*dummyRestoreItemMessageFlag)
+			case "purge-item":
+				endpoint = c.PurgeItem()
+				data, err = dummyc.BuildPurgeItemPayload(*dummyPurgeItemMessageFlag)
 			}
 		}
 	}
@@ -158,9 +338,27 @@ func dummyUsage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] dummy COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    create-item: CreateItem implements create_item.`)
-	fmt.Fprintln(os.Stderr, `    list-items: ListItems implements list_items.`)
-	fmt.Fprintln(os.Stderr, `    get-item: GetItem implements get_item.`)
-	fmt.Fprintln(os.Stderr, `    delete-item: DeleteItem implements delete_item.`)
+	fmt.Fprintln(os.Stderr, `    list-items: Pages through the items owned by or shared with the caller, newest first unless order is asc. Pass next_cursor as cursor to fetch the following page with the same filters and order`)
+	fmt.Fprintln(os.Stderr, `    search-items: Full-text search over the name and description of the caller's items, ranked by relevance`)
+	fmt.Fprintln(os.Stderr, `    get-item: Fetches an item owned by or shared with the caller`)
+	fmt.Fprintln(os.Stderr, `    update-item: Replaces the name and description of an item. The update must name the version it is based on and fails with conflict if the item changed since`)
+	fmt.Fprintln(os.Stderr, `    patch-item: Changes the given fields of an item. Like update_item it fails with conflict if the item changed since the given version`)
+	fmt.Fprintln(os.Stderr, `    delete-item: Moves an item to the trash, from which it can be restored until it is purged`)
+	fmt.Fprintln(os.Stderr, `    share-item: Shares an item with another user, or changes the permission it is shared with. Only the owner can share an item`)
+	fmt.Fprintln(os.Stderr, `    unshare-item: Stops sharing an item with a user. The owner can remove any share and users can remove their own`)
+	fmt.Fprintln(os.Stderr, `    list-item-shares: Lists the users an item is shared with. Only the owner can list them`)
+	fmt.Fprintln(os.Stderr, `    add-item-tag: Tags an item, creating the tag in the owner's tags if needed. Requires editor access`)
+	fmt.Fprintln(os.Stderr, `    remove-item-tag: Removes a tag from an item. Requires editor access`)
+	fmt.Fprintln(os.Stderr, `    suggest-tags: Autocompletes the caller's tags, most used first`)
+	fmt.Fprintln(os.Stderr, `    list-attachments: Lists the attachments of an item, oldest first`)
+	fmt.Fprintln(os.Stderr, `    delete-attachment: Deletes an attachment and its content. Requires editor access`)
+	fmt.Fprintln(os.Stderr, `    batch-create-items: Creates up to 100 items, atomically unless atomic is false`)
+	fmt.Fprintln(os.Stderr, `    batch-update-items: Changes the given fields of up to 100 items like patch_item, atomically unless atomic is false`)
+	fmt.Fprintln(os.Stderr, `    batch-delete-items: Moves up to 100 items to the trash, atomically unless atomic is false`)
+	fmt.Fprintln(os.Stderr, `    import-items: Creates items streamed by the client, each on its own, and reports a summary once the stream is closed. gRPC only; the token is sent in the authorization metadata`)
+	fmt.Fprintln(os.Stderr, `    list-trash: Lists the caller's trashed items, most recently deleted first`)
+	fmt.Fprintln(os.Stderr, `    restore-item: Moves an item out of the trash`)
+	fmt.Fprintln(os.Stderr, `    purge-item: Permanently deletes a trashed item`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s dummy COMMAND --help\n", os.Args[0])
@@ -180,7 +378,7 @@ func dummyCreateItemUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy create-item --message '{\n      \"description\": \"Quia molestias rerum.\",\n      \"name\": \"Alias iusto doloribus quia.\",\n      \"token\": \"Alias velit velit est laboriosam est.\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy create-item --message '{\n      \"description\": \"Excepturi possimus cum id impedit ut.\",\n      \"language\": \"french\",\n      \"name\": \"Aspernatur tempore quibusdam.\",\n      \"token\": \"Natus voluptatem at hic et est laborum.\"\n   }'")
 }
 
 func dummyListItemsUsage() {
@@ -191,14 +389,32 @@ func dummyListItemsUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `ListItems implements list_items.`)
+	fmt.Fprintln(os.Stderr, `Pages through the items owned by or shared with the caller, newest first unless order is asc. Pass next_cursor as cursor to fetch the following page with the same filters and order`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -message JSON: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-items --message '{\n      \"token\": \"Dignissimos officia perferendis ab similique.\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-items --message '{\n      \"created_after\": \"2014-02-16T22:41:01Z\",\n      \"created_before\": \"1976-07-11T18:00:44Z\",\n      \"cursor\": \"Aut accusantium veniam sed a.\",\n      \"include_total\": false,\n      \"name_prefix\": \"Vitae non sunt repudiandae nam similique eligendi.\",\n      \"order\": \"asc\",\n      \"page_size\": 37,\n      \"tag_match\": \"any\",\n      \"tags\": [\n         \"Ullam ipsa quidem velit dolorum aut dolorum.\",\n         \"Ut nam quisquam minima officiis.\",\n         \"Iste consequuntur cum voluptatibus est eaque et.\"\n      ],\n      \"token\": \"Consequatur distinctio deleniti dolore omnis dicta praesentium.\"\n   }'")
+}
+
+func dummySearchItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy search-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Full-text search over the name and description of the caller's items, ranked by relevance`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy search-items --message '{\n      \"cursor\": \"Et aliquid ea.\",\n      \"language\": \"danish\",\n      \"page_size\": 83,\n      \"query\": \"quarterly report\",\n      \"token\": \"Ut molestias culpa.\"\n   }'")
 }
 
 func dummyGetItemUsage() {
@@ -209,14 +425,50 @@ func dummyGetItemUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `GetItem implements get_item.`)
+	fmt.Fprintln(os.Stderr, `Fetches an item owned by or shared with the caller`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy get-item --message '{\n      \"id\": \"Minus expedita.\",\n      \"token\": \"Sed ab non.\"\n   }'")
+}
+
+func dummyUpdateItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy update-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replaces the name and description of an item. The update must name the version it is based on and fails with conflict if the item changed since`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -message JSON: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy get-item --message '{\n      \"id\": \"Doloremque in nostrum fugiat cum nobis.\",\n      \"token\": \"Placeat quibusdam dolor.\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy update-item --message '{\n      \"description\": \"Quia temporibus asperiores.\",\n      \"id\": \"Omnis sit ut adipisci fugit eligendi corporis.\",\n      \"if_match\": \"Tempore enim sed ab.\",\n      \"name\": \"A ipsam sed dignissimos adipisci non.\",\n      \"token\": \"Dolor quia harum quis mollitia cum.\",\n      \"version\": 8493172105145364094\n   }'")
+}
+
+func dummyPatchItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy patch-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Changes the given fields of an item. Like update_item it fails with conflict if the item changed since the given version`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy patch-item --message '{\n      \"description\": \"Itaque cum.\",\n      \"id\": \"Voluptas sed dolores consequatur ab mollitia sit.\",\n      \"if_match\": \"Nesciunt perferendis aliquid.\",\n      \"name\": \"96\",\n      \"token\": \"Veniam consequatur culpa cumque et ut.\",\n      \"version\": 2193872752676109372\n   }'")
 }
 
 func dummyDeleteItemUsage() {
@@ -227,12 +479,282 @@ func dummyDeleteItemUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `DeleteItem implements delete_item.`)
+	fmt.Fprintln(os.Stderr, `Moves an item to the trash, from which it can be restored until it is purged`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy delete-item --message '{\n      \"id\": \"Saepe quae provident itaque qui eaque dolorem.\",\n      \"token\": \"Et aut quibusdam voluptate rem.\"\n   }'")
+}
+
+func dummyShareItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy share-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Shares an item with another user, or changes the permission it is shared with. Only the owner can share an item`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy share-item --message '{\n      \"id\": \"Et eius.\",\n      \"permission\": \"editor\",\n      \"token\": \"Non sit est.\",\n      \"user_id\": \"4b1bf93c-9277-46d0-b723-a0ce0c1c8041\"\n   }'")
+}
+
+func dummyUnshareItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy unshare-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stops sharing an item with a user. The owner can remove any share and users can remove their own`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy unshare-item --message '{\n      \"id\": \"Labore non consectetur maiores rerum.\",\n      \"token\": \"Ullam architecto quisquam omnis doloremque.\",\n      \"user_id\": \"Non rerum.\"\n   }'")
+}
+
+func dummyListItemSharesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy list-item-shares", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the users an item is shared with. Only the owner can list them`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-item-shares --message '{\n      \"id\": \"Numquam qui officiis est.\",\n      \"token\": \"Et impedit perspiciatis quasi ea.\"\n   }'")
+}
+
+func dummyAddItemTagUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy add-item-tag", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Tags an item, creating the tag in the owner's tags if needed. Requires editor access`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy add-item-tag --message '{\n      \"id\": \"Molestiae animi corporis dolore quia quam eum.\",\n      \"tag\": \"582\",\n      \"token\": \"Sit cupiditate.\"\n   }'")
+}
+
+func dummyRemoveItemTagUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy remove-item-tag", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Removes a tag from an item. Requires editor access`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy remove-item-tag --message '{\n      \"id\": \"Assumenda doloremque sunt eum est a.\",\n      \"tag\": \"2\",\n      \"token\": \"Et sed pariatur.\"\n   }'")
+}
+
+func dummySuggestTagsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy suggest-tags", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Autocompletes the caller's tags, most used first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy suggest-tags --message '{\n      \"limit\": 40,\n      \"prefix\": \"Incidunt culpa molestiae earum velit dicta nam.\",\n      \"token\": \"Animi maiores.\"\n   }'")
+}
+
+func dummyListAttachmentsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy list-attachments", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the attachments of an item, oldest first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-attachments --message '{\n      \"id\": \"Aliquam laboriosam quidem.\",\n      \"token\": \"Quo perferendis ut tenetur sint.\"\n   }'")
+}
+
+func dummyDeleteAttachmentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy delete-attachment", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Deletes an attachment and its content. Requires editor access`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy delete-attachment --message '{\n      \"attachment_id\": \"Id qui provident quam aliquid.\",\n      \"id\": \"Voluptatibus vero.\",\n      \"token\": \"At ratione et laborum excepturi quia.\"\n   }'")
+}
+
+func dummyBatchCreateItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy batch-create-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Creates up to 100 items, atomically unless atomic is false`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy batch-create-items --message '{\n      \"atomic\": true,\n      \"items\": [\n         {\n            \"description\": \"Perferendis aspernatur voluptatem.\",\n            \"language\": \"tamil\",\n            \"name\": \"fmt\"\n         },\n         {\n            \"description\": \"Perferendis aspernatur voluptatem.\",\n            \"language\": \"tamil\",\n            \"name\": \"fmt\"\n         },\n         {\n            \"description\": \"Perferendis aspernatur voluptatem.\",\n            \"language\": \"tamil\",\n            \"name\": \"fmt\"\n         }\n      ],\n      \"token\": \"Eaque hic et sequi tempora tempora.\"\n   }'")
+}
+
+func dummyBatchUpdateItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy batch-update-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Changes the given fields of up to 100 items like patch_item, atomically unless atomic is false`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy batch-update-items --message '{\n      \"atomic\": false,\n      \"items\": [\n         {\n            \"description\": \"Animi odit.\",\n            \"id\": \"Rerum assumenda ex dolorem.\",\n            \"name\": \"3p\",\n            \"version\": 1332042426222194336\n         },\n         {\n            \"description\": \"Animi odit.\",\n            \"id\": \"Rerum assumenda ex dolorem.\",\n            \"name\": \"3p\",\n            \"version\": 1332042426222194336\n         }\n      ],\n      \"token\": \"Qui provident explicabo animi laborum.\"\n   }'")
+}
+
+func dummyBatchDeleteItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy batch-delete-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Moves up to 100 items to the trash, atomically unless atomic is false`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy batch-delete-items --message '{\n      \"atomic\": false,\n      \"ids\": [\n         \"Ad qui facilis doloremque.\",\n         \"Rerum at.\",\n         \"Perferendis pariatur sapiente sapiente tempore enim.\"\n      ],\n      \"token\": \"Et doloribus.\"\n   }'")
+}
+
+func dummyImportItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy import-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Creates items streamed by the client, each on its own, and reports a summary once the stream is closed. gRPC only; the token is sent in the authorization metadata`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy import-items --token \"Sint et.\"")
+}
+
+func dummyListTrashUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy list-trash", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the caller's trashed items, most recently deleted first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-trash --message '{\n      \"cursor\": \"Dignissimos minus.\",\n      \"page_size\": 108,\n      \"token\": \"Illo sit.\"\n   }'")
+}
+
+func dummyRestoreItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy restore-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Moves an item out of the trash`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy restore-item --message '{\n      \"id\": \"Molestiae animi possimus.\",\n      \"token\": \"Soluta ipsum sequi fugiat temporibus.\"\n   }'")
+}
+
+func dummyPurgeItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy purge-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Permanently deletes a trashed item`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -message JSON: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy delete-item --message '{\n      \"id\": \"Voluptatibus ducimus ut quia molestiae.\",\n      \"token\": \"Autem dolorum.\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy purge-item --message '{\n      \"id\": \"Voluptatem id ipsum sint.\",\n      \"token\": \"Est sunt voluptatibus.\"\n   }'")
 }
@@ -24,13 +24,14 @@ func BuildCreateItemPayload(dummyCreateItemMessage string) (*dummy.CreateItemPay
 		if dummyCreateItemMessage != "" {
 			err = json.Unmarshal([]byte(dummyCreateItemMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Quia molestias rerum.\",\n      \"name\": \"Alias iusto doloribus quia.\",\n      \"token\": \"Alias velit velit est laboriosam est.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Excepturi possimus cum id impedit ut.\",\n      \"language\": \"french\",\n      \"name\": \"Aspernatur tempore quibusdam.\",\n      \"token\": \"Natus voluptatem at hic et est laborum.\"\n   }'")
 			}
 		}
 	}
 	v := &dummy.CreateItemPayload{
 		Name:        message.Name,
 		Description: message.Description,
+		Language:    message.Language,
 		Token:       message.Token,
 	}
 
@@ -46,12 +47,75 @@ func BuildListItemsPayload(dummyListItemsMessage string) (*dummy.ListItemsPayloa
 		if dummyListItemsMessage != "" {
 			err = json.Unmarshal([]byte(dummyListItemsMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Dignissimos officia perferendis ab similique.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"created_after\": \"2014-02-16T22:41:01Z\",\n      \"created_before\": \"1976-07-11T18:00:44Z\",\n      \"cursor\": \"Aut accusantium veniam sed a.\",\n      \"include_total\": false,\n      \"name_prefix\": \"Vitae non sunt repudiandae nam similique eligendi.\",\n      \"order\": \"asc\",\n      \"page_size\": 37,\n      \"tag_match\": \"any\",\n      \"tags\": [\n         \"Ullam ipsa quidem velit dolorum aut dolorum.\",\n         \"Ut nam quisquam minima officiis.\",\n         \"Iste consequuntur cum voluptatibus est eaque et.\"\n      ],\n      \"token\": \"Consequatur distinctio deleniti dolore omnis dicta praesentium.\"\n   }'")
 			}
 		}
 	}
 	v := &dummy.ListItemsPayload{
-		Token: message.Token,
+		Cursor:        message.Cursor,
+		NamePrefix:    message.NamePrefix,
+		CreatedAfter:  message.CreatedAfter,
+		CreatedBefore: message.CreatedBefore,
+		Token:         message.Token,
+	}
+	if message.PageSize != nil {
+		v.PageSize = int(*message.PageSize)
+	}
+	if message.Order != nil {
+		v.Order = *message.Order
+	}
+	if message.IncludeTotal != nil {
+		v.IncludeTotal = *message.IncludeTotal
+	}
+	if message.TagMatch != nil {
+		v.TagMatch = *message.TagMatch
+	}
+	if message.PageSize == nil {
+		v.PageSize = 50
+	}
+	if message.Order == nil {
+		v.Order = "desc"
+	}
+	if message.IncludeTotal == nil {
+		v.IncludeTotal = false
+	}
+	if message.Tags != nil {
+		v.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			v.Tags[i] = val
+		}
+	}
+	if message.TagMatch == nil {
+		v.TagMatch = "any"
+	}
+
+	return v, nil
+}
+
+// BuildSearchItemsPayload builds the payload for the dummy search_items
+// endpoint from CLI flags.
+func BuildSearchItemsPayload(dummySearchItemsMessage string) (*dummy.SearchItemsPayload, error) {
+	var err error
+	var message dummypb.SearchItemsRequest
+	{
+		if dummySearchItemsMessage != "" {
+			err = json.Unmarshal([]byte(dummySearchItemsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cursor\": \"Et aliquid ea.\",\n      \"language\": \"danish\",\n      \"page_size\": 83,\n      \"query\": \"quarterly report\",\n      \"token\": \"Ut molestias culpa.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.SearchItemsPayload{
+		Query:    message.Query,
+		Language: message.Language,
+		Cursor:   message.Cursor,
+		Token:    message.Token,
+	}
+	if message.PageSize != nil {
+		v.PageSize = int(*message.PageSize)
+	}
+	if message.PageSize == nil {
+		v.PageSize = 20
 	}
 
 	return v, nil
@@ -66,7 +130,7 @@ func BuildGetItemPayload(dummyGetItemMessage string) (*dummy.ItemIDPayload, erro
 		if dummyGetItemMessage != "" {
 			err = json.Unmarshal([]byte(dummyGetItemMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Doloremque in nostrum fugiat cum nobis.\",\n      \"token\": \"Placeat quibusdam dolor.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Minus expedita.\",\n      \"token\": \"Sed ab non.\"\n   }'")
 			}
 		}
 	}
@@ -78,6 +142,62 @@ func BuildGetItemPayload(dummyGetItemMessage string) (*dummy.ItemIDPayload, erro
 	return v, nil
 }
 
+// BuildUpdateItemPayload builds the payload for the dummy update_item endpoint
+// from CLI flags.
+func BuildUpdateItemPayload(dummyUpdateItemMessage string) (*dummy.UpdateItemPayload, error) {
+	var err error
+	var message dummypb.UpdateItemRequest
+	{
+		if dummyUpdateItemMessage != "" {
+			err = json.Unmarshal([]byte(dummyUpdateItemMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Quia temporibus asperiores.\",\n      \"id\": \"Omnis sit ut adipisci fugit eligendi corporis.\",\n      \"if_match\": \"Tempore enim sed ab.\",\n      \"name\": \"A ipsam sed dignissimos adipisci non.\",\n      \"token\": \"Dolor quia harum quis mollitia cum.\",\n      \"version\": 8493172105145364094\n   }'")
+			}
+		}
+	}
+	v := &dummy.UpdateItemPayload{
+		ID:          message.Id,
+		Name:        message.Name,
+		Description: message.Description,
+		IfMatch:     message.IfMatch,
+		Token:       message.Token,
+	}
+	if message.Version != nil {
+		version := int(*message.Version)
+		v.Version = &version
+	}
+
+	return v, nil
+}
+
+// BuildPatchItemPayload builds the payload for the dummy patch_item endpoint
+// from CLI flags.
+func BuildPatchItemPayload(dummyPatchItemMessage string) (*dummy.PatchItemPayload, error) {
+	var err error
+	var message dummypb.PatchItemRequest
+	{
+		if dummyPatchItemMessage != "" {
+			err = json.Unmarshal([]byte(dummyPatchItemMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Itaque cum.\",\n      \"id\": \"Voluptas sed dolores consequatur ab mollitia sit.\",\n      \"if_match\": \"Nesciunt perferendis aliquid.\",\n      \"name\": \"96\",\n      \"token\": \"Veniam consequatur culpa cumque et ut.\",\n      \"version\": 2193872752676109372\n   }'")
+			}
+		}
+	}
+	v := &dummy.PatchItemPayload{
+		ID:          message.Id,
+		Name:        message.Name,
+		Description: message.Description,
+		IfMatch:     message.IfMatch,
+		Token:       message.Token,
+	}
+	if message.Version != nil {
+		version := int(*message.Version)
+		v.Version = &version
+	}
+
+	return v, nil
+}
+
 // BuildDeleteItemPayload builds the payload for the dummy delete_item endpoint
 // from CLI flags.
 func BuildDeleteItemPayload(dummyDeleteItemMessage string) (*dummy.ItemIDPayload, error) {
@@ -87,7 +207,379 @@ func BuildDeleteItemPayload(dummyDeleteItemMessage string) (*dummy.ItemIDPayload
 		if dummyDeleteItemMessage != "" {
 			err = json.Unmarshal([]byte(dummyDeleteItemMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Voluptatibus ducimus ut quia molestiae.\",\n      \"token\": \"Autem dolorum.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Saepe quae provident itaque qui eaque dolorem.\",\n      \"token\": \"Et aut quibusdam voluptate rem.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.ItemIDPayload{
+		ID:    message.Id,
+		Token: message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildShareItemPayload builds the payload for the dummy share_item endpoint
+// from CLI flags.
+func BuildShareItemPayload(dummyShareItemMessage string) (*dummy.ShareItemPayload, error) {
+	var err error
+	var message dummypb.ShareItemRequest
+	{
+		if dummyShareItemMessage != "" {
+			err = json.Unmarshal([]byte(dummyShareItemMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Et eius.\",\n      \"permission\": \"editor\",\n      \"token\": \"Non sit est.\",\n      \"user_id\": \"4b1bf93c-9277-46d0-b723-a0ce0c1c8041\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.ShareItemPayload{
+		ID:         message.Id,
+		UserID:     message.UserId,
+		Permission: message.Permission,
+		Token:      message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildUnshareItemPayload builds the payload for the dummy unshare_item
+// endpoint from CLI flags.
+func BuildUnshareItemPayload(dummyUnshareItemMessage string) (*dummy.UnshareItemPayload, error) {
+	var err error
+	var message dummypb.UnshareItemRequest
+	{
+		if dummyUnshareItemMessage != "" {
+			err = json.Unmarshal([]byte(dummyUnshareItemMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Labore non consectetur maiores rerum.\",\n      \"token\": \"Ullam architecto quisquam omnis doloremque.\",\n      \"user_id\": \"Non rerum.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.UnshareItemPayload{
+		ID:     message.Id,
+		UserID: message.UserId,
+		Token:  message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildListItemSharesPayload builds the payload for the dummy list_item_shares
+// endpoint from CLI flags.
+func BuildListItemSharesPayload(dummyListItemSharesMessage string) (*dummy.ItemIDPayload, error) {
+	var err error
+	var message dummypb.ListItemSharesRequest
+	{
+		if dummyListItemSharesMessage != "" {
+			err = json.Unmarshal([]byte(dummyListItemSharesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Numquam qui officiis est.\",\n      \"token\": \"Et impedit perspiciatis quasi ea.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.ItemIDPayload{
+		ID:    message.Id,
+		Token: message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildAddItemTagPayload builds the payload for the dummy add_item_tag
+// endpoint from CLI flags.
+func BuildAddItemTagPayload(dummyAddItemTagMessage string) (*dummy.ItemTagPayload, error) {
+	var err error
+	var message dummypb.AddItemTagRequest
+	{
+		if dummyAddItemTagMessage != "" {
+			err = json.Unmarshal([]byte(dummyAddItemTagMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Molestiae animi corporis dolore quia quam eum.\",\n      \"tag\": \"582\",\n      \"token\": \"Sit cupiditate.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.ItemTagPayload{
+		ID:    message.Id,
+		Tag:   message.Tag,
+		Token: message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildRemoveItemTagPayload builds the payload for the dummy remove_item_tag
+// endpoint from CLI flags.
+func BuildRemoveItemTagPayload(dummyRemoveItemTagMessage string) (*dummy.ItemTagPayload, error) {
+	var err error
+	var message dummypb.RemoveItemTagRequest
+	{
+		if dummyRemoveItemTagMessage != "" {
+			err = json.Unmarshal([]byte(dummyRemoveItemTagMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Assumenda doloremque sunt eum est a.\",\n      \"tag\": \"2\",\n      \"token\": \"Et sed pariatur.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.ItemTagPayload{
+		ID:    message.Id,
+		Tag:   message.Tag,
+		Token: message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildSuggestTagsPayload builds the payload for the dummy suggest_tags
+// endpoint from CLI flags.
+func BuildSuggestTagsPayload(dummySuggestTagsMessage string) (*dummy.SuggestTagsPayload, error) {
+	var err error
+	var message dummypb.SuggestTagsRequest
+	{
+		if dummySuggestTagsMessage != "" {
+			err = json.Unmarshal([]byte(dummySuggestTagsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"limit\": 40,\n      \"prefix\": \"Incidunt culpa molestiae earum velit dicta nam.\",\n      \"token\": \"Animi maiores.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.SuggestTagsPayload{
+		Token: message.Token,
+	}
+	if message.Prefix != nil {
+		v.Prefix = *message.Prefix
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Prefix == nil {
+		v.Prefix = ""
+	}
+	if message.Limit == nil {
+		v.Limit = 10
+	}
+
+	return v, nil
+}
+
+// BuildListAttachmentsPayload builds the payload for the dummy
+// list_attachments endpoint from CLI flags.
+func BuildListAttachmentsPayload(dummyListAttachmentsMessage string) (*dummy.ItemIDPayload, error) {
+	var err error
+	var message dummypb.ListAttachmentsRequest
+	{
+		if dummyListAttachmentsMessage != "" {
+			err = json.Unmarshal([]byte(dummyListAttachmentsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Aliquam laboriosam quidem.\",\n      \"token\": \"Quo perferendis ut tenetur sint.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.ItemIDPayload{
+		ID:    message.Id,
+		Token: message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildDeleteAttachmentPayload builds the payload for the dummy
+// delete_attachment endpoint from CLI flags.
+func BuildDeleteAttachmentPayload(dummyDeleteAttachmentMessage string) (*dummy.AttachmentIDPayload, error) {
+	var err error
+	var message dummypb.DeleteAttachmentRequest
+	{
+		if dummyDeleteAttachmentMessage != "" {
+			err = json.Unmarshal([]byte(dummyDeleteAttachmentMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attachment_id\": \"Id qui provident quam aliquid.\",\n      \"id\": \"Voluptatibus vero.\",\n      \"token\": \"At ratione et laborum excepturi quia.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.AttachmentIDPayload{
+		ID:           message.Id,
+		AttachmentID: message.AttachmentId,
+		Token:        message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildBatchCreateItemsPayload builds the payload for the dummy
+// batch_create_items endpoint from CLI flags.
+func BuildBatchCreateItemsPayload(dummyBatchCreateItemsMessage string) (*dummy.BatchCreateItemsPayload, error) {
+	var err error
+	var message dummypb.BatchCreateItemsRequest
+	{
+		if dummyBatchCreateItemsMessage != "" {
+			err = json.Unmarshal([]byte(dummyBatchCreateItemsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"atomic\": true,\n      \"items\": [\n         {\n            \"description\": \"Perferendis aspernatur voluptatem.\",\n            \"language\": \"tamil\",\n            \"name\": \"fmt\"\n         },\n         {\n            \"description\": \"Perferendis aspernatur voluptatem.\",\n            \"language\": \"tamil\",\n            \"name\": \"fmt\"\n         },\n         {\n            \"description\": \"Perferendis aspernatur voluptatem.\",\n            \"language\": \"tamil\",\n            \"name\": \"fmt\"\n         }\n      ],\n      \"token\": \"Eaque hic et sequi tempora tempora.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.BatchCreateItemsPayload{
+		Token: message.Token,
+	}
+	if message.Atomic != nil {
+		v.Atomic = *message.Atomic
+	}
+	if message.Items != nil {
+		v.Items = make([]*dummy.BatchItemEntry, len(message.Items))
+		for i, val := range message.Items {
+			v.Items[i] = &dummy.BatchItemEntry{
+				Name:        val.Name,
+				Description: val.Description,
+				Language:    val.Language,
+			}
+		}
+	}
+	if message.Atomic == nil {
+		v.Atomic = true
+	}
+
+	return v, nil
+}
+
+// BuildBatchUpdateItemsPayload builds the payload for the dummy
+// batch_update_items endpoint from CLI flags.
+func BuildBatchUpdateItemsPayload(dummyBatchUpdateItemsMessage string) (*dummy.BatchUpdateItemsPayload, error) {
+	var err error
+	var message dummypb.BatchUpdateItemsRequest
+	{
+		if dummyBatchUpdateItemsMessage != "" {
+			err = json.Unmarshal([]byte(dummyBatchUpdateItemsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"atomic\": false,\n      \"items\": [\n         {\n            \"description\": \"Animi odit.\",\n            \"id\": \"Rerum assumenda ex dolorem.\",\n            \"name\": \"3p\",\n            \"version\": 1332042426222194336\n         },\n         {\n            \"description\": \"Animi odit.\",\n            \"id\": \"Rerum assumenda ex dolorem.\",\n            \"name\": \"3p\",\n            \"version\": 1332042426222194336\n         }\n      ],\n      \"token\": \"Qui provident explicabo animi laborum.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.BatchUpdateItemsPayload{
+		Token: message.Token,
+	}
+	if message.Atomic != nil {
+		v.Atomic = *message.Atomic
+	}
+	if message.Items != nil {
+		v.Items = make([]*dummy.BatchUpdateEntry, len(message.Items))
+		for i, val := range message.Items {
+			v.Items[i] = &dummy.BatchUpdateEntry{
+				ID:          val.Id,
+				Version:     int(val.Version),
+				Name:        val.Name,
+				Description: val.Description,
+			}
+		}
+	}
+	if message.Atomic == nil {
+		v.Atomic = true
+	}
+
+	return v, nil
+}
+
+// BuildBatchDeleteItemsPayload builds the payload for the dummy
+// batch_delete_items endpoint from CLI flags.
+func BuildBatchDeleteItemsPayload(dummyBatchDeleteItemsMessage string) (*dummy.BatchDeleteItemsPayload, error) {
+	var err error
+	var message dummypb.BatchDeleteItemsRequest
+	{
+		if dummyBatchDeleteItemsMessage != "" {
+			err = json.Unmarshal([]byte(dummyBatchDeleteItemsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"atomic\": false,\n      \"ids\": [\n         \"Ad qui facilis doloremque.\",\n         \"Rerum at.\",\n         \"Perferendis pariatur sapiente sapiente tempore enim.\"\n      ],\n      \"token\": \"Et doloribus.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.BatchDeleteItemsPayload{
+		Token: message.Token,
+	}
+	if message.Atomic != nil {
+		v.Atomic = *message.Atomic
+	}
+	if message.Ids != nil {
+		v.Ids = make([]string, len(message.Ids))
+		for i, val := range message.Ids {
+			v.Ids[i] = val
+		}
+	}
+	if message.Atomic == nil {
+		v.Atomic = true
+	}
+
+	return v, nil
+}
+
+// BuildImportItemsPayload builds the payload for the dummy import_items
+// endpoint from CLI flags.
+func BuildImportItemsPayload(dummyImportItemsToken string) (*dummy.AuthenticatedPayload, error) {
+	var token string
+	{
+		token = dummyImportItemsToken
+	}
+	v := &dummy.AuthenticatedPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListTrashPayload builds the payload for the dummy list_trash endpoint
+// from CLI flags.
+func BuildListTrashPayload(dummyListTrashMessage string) (*dummy.ListTrashPayload, error) {
+	var err error
+	var message dummypb.ListTrashRequest
+	{
+		if dummyListTrashMessage != "" {
+			err = json.Unmarshal([]byte(dummyListTrashMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cursor\": \"Dignissimos minus.\",\n      \"page_size\": 108,\n      \"token\": \"Illo sit.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.ListTrashPayload{
+		Cursor: message.Cursor,
+		Token:  message.Token,
+	}
+	if message.PageSize != nil {
+		v.PageSize = int(*message.PageSize)
+	}
+	if message.PageSize == nil {
+		v.PageSize = 50
+	}
+
+	return v, nil
+}
+
+// BuildRestoreItemPayload builds the payload for the dummy restore_item
+// endpoint from CLI flags.
+func BuildRestoreItemPayload(dummyRestoreItemMessage string) (*dummy.ItemIDPayload, error) {
+	var err error
+	var message dummypb.RestoreItemRequest
+	{
+		if dummyRestoreItemMessage != "" {
+			err = json.Unmarshal([]byte(dummyRestoreItemMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Molestiae animi possimus.\",\n      \"token\": \"Soluta ipsum sequi fugiat temporibus.\"\n   }'")
+			}
+		}
+	}
+	v := &dummy.ItemIDPayload{
+		ID:    message.Id,
+		Token: message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildPurgeItemPayload builds the payload for the dummy purge_item endpoint
+// from CLI flags.
+func BuildPurgeItemPayload(dummyPurgeItemMessage string) (*dummy.ItemIDPayload, error) {
+	var err error
+	var message dummypb.PurgeItemRequest
+	{
+		if dummyPurgeItemMessage != "" {
+			err = json.Unmarshal([]byte(dummyPurgeItemMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"Voluptatem id ipsum sint.\",\n      \"token\": \"Est sunt voluptatibus.\"\n   }'")
 			}
 		}
 	}
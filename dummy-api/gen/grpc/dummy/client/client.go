@@ -10,6 +10,7 @@ package client
 import (
 	"context"
 
+	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	dummypb "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/grpc/dummy/pb"
 	goagrpc "goa.design/goa/v3/grpc"
 	goapb "goa.design/goa/v3/grpc/pb"
@@ -23,6 +24,12 @@ type Client struct {
 	opts    []grpc.CallOption
 }
 
+// ImportItemsClientStream implements the dummy.ImportItemsClientStream
+// interface.
+type ImportItemsClientStream struct {
+	stream dummypb.Dummy_ImportItemsClient
+}
+
 // NewClient instantiates gRPC client for all the dummy service servers.
 func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
 	return &Client{
@@ -60,12 +67,39 @@ func (c *Client) ListItems() goa.Endpoint {
 			DecodeListItemsResponse)
 		res, err := inv.Invoke(ctx, v)
 		if err != nil {
-			// Try to decode a Goa error response detail before falling back to Fault.
 			resp := goagrpc.DecodeError(err)
-			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
-				return nil, goagrpc.NewServiceError(eresp)
+			switch message := resp.(type) {
+			case *dummypb.ListItemsInvalidCursorError:
+				return nil, NewListItemsInvalidCursorError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// SearchItems calls the "SearchItems" function in dummypb.DummyClient
+// interface.
+func (c *Client) SearchItems() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildSearchItemsFunc(c.grpccli, c.opts...),
+			EncodeSearchItemsRequest,
+			DecodeSearchItemsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.SearchItemsInvalidCursorError:
+				return nil, NewSearchItemsInvalidCursorError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
 			}
-			return nil, goa.Fault("%s", err.Error())
 		}
 		return res, nil
 	}
@@ -80,12 +114,73 @@ func (c *Client) GetItem() goa.Endpoint {
 			DecodeGetItemResponse)
 		res, err := inv.Invoke(ctx, v)
 		if err != nil {
-			// Try to decode a Goa error response detail before falling back to Fault.
 			resp := goagrpc.DecodeError(err)
-			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
-				return nil, goagrpc.NewServiceError(eresp)
+			switch message := resp.(type) {
+			case *dummypb.GetItemNotFoundError:
+				return nil, NewGetItemNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// UpdateItem calls the "UpdateItem" function in dummypb.DummyClient interface.
+func (c *Client) UpdateItem() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildUpdateItemFunc(c.grpccli, c.opts...),
+			EncodeUpdateItemRequest,
+			DecodeUpdateItemResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.UpdateItemConflictError:
+				return nil, NewUpdateItemConflictError(message)
+			case *dummypb.UpdateItemPreconditionRequiredError:
+				return nil, NewUpdateItemPreconditionRequiredError(message)
+			case *dummypb.UpdateItemNotFoundError:
+				return nil, NewUpdateItemNotFoundError(message)
+			case *dummypb.UpdateItemForbiddenError:
+				return nil, NewUpdateItemForbiddenError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PatchItem calls the "PatchItem" function in dummypb.DummyClient interface.
+func (c *Client) PatchItem() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPatchItemFunc(c.grpccli, c.opts...),
+			EncodePatchItemRequest,
+			DecodePatchItemResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.PatchItemConflictError:
+				return nil, NewPatchItemConflictError(message)
+			case *dummypb.PatchItemPreconditionRequiredError:
+				return nil, NewPatchItemPreconditionRequiredError(message)
+			case *dummypb.PatchItemNotFoundError:
+				return nil, NewPatchItemNotFoundError(message)
+			case *dummypb.PatchItemForbiddenError:
+				return nil, NewPatchItemForbiddenError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
 			}
-			return nil, goa.Fault("%s", err.Error())
 		}
 		return res, nil
 	}
@@ -99,6 +194,158 @@ func (c *Client) DeleteItem() goa.Endpoint {
 			EncodeDeleteItemRequest,
 			nil)
 		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.DeleteItemNotFoundError:
+				return nil, NewDeleteItemNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ShareItem calls the "ShareItem" function in dummypb.DummyClient interface.
+func (c *Client) ShareItem() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildShareItemFunc(c.grpccli, c.opts...),
+			EncodeShareItemRequest,
+			DecodeShareItemResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.ShareItemNotFoundError:
+				return nil, NewShareItemNotFoundError(message)
+			case *dummypb.ShareItemForbiddenError:
+				return nil, NewShareItemForbiddenError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// UnshareItem calls the "UnshareItem" function in dummypb.DummyClient
+// interface.
+func (c *Client) UnshareItem() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildUnshareItemFunc(c.grpccli, c.opts...),
+			EncodeUnshareItemRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.UnshareItemNotFoundError:
+				return nil, NewUnshareItemNotFoundError(message)
+			case *dummypb.UnshareItemForbiddenError:
+				return nil, NewUnshareItemForbiddenError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListItemShares calls the "ListItemShares" function in dummypb.DummyClient
+// interface.
+func (c *Client) ListItemShares() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListItemSharesFunc(c.grpccli, c.opts...),
+			EncodeListItemSharesRequest,
+			DecodeListItemSharesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.ListItemSharesNotFoundError:
+				return nil, NewListItemSharesNotFoundError(message)
+			case *dummypb.ListItemSharesForbiddenError:
+				return nil, NewListItemSharesForbiddenError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// AddItemTag calls the "AddItemTag" function in dummypb.DummyClient interface.
+func (c *Client) AddItemTag() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildAddItemTagFunc(c.grpccli, c.opts...),
+			EncodeAddItemTagRequest,
+			DecodeAddItemTagResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.AddItemTagNotFoundError:
+				return nil, NewAddItemTagNotFoundError(message)
+			case *dummypb.AddItemTagForbiddenError:
+				return nil, NewAddItemTagForbiddenError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// RemoveItemTag calls the "RemoveItemTag" function in dummypb.DummyClient
+// interface.
+func (c *Client) RemoveItemTag() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRemoveItemTagFunc(c.grpccli, c.opts...),
+			EncodeRemoveItemTagRequest,
+			DecodeRemoveItemTagResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.RemoveItemTagNotFoundError:
+				return nil, NewRemoveItemTagNotFoundError(message)
+			case *dummypb.RemoveItemTagForbiddenError:
+				return nil, NewRemoveItemTagForbiddenError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// SuggestTags calls the "SuggestTags" function in dummypb.DummyClient
+// interface.
+func (c *Client) SuggestTags() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildSuggestTagsFunc(c.grpccli, c.opts...),
+			EncodeSuggestTagsRequest,
+			DecodeSuggestTagsResponse)
+		res, err := inv.Invoke(ctx, v)
 		if err != nil {
 			// Try to decode a Goa error response detail before falling back to Fault.
 			resp := goagrpc.DecodeError(err)
@@ -110,3 +357,240 @@ func (c *Client) DeleteItem() goa.Endpoint {
 		return res, nil
 	}
 }
+
+// ListAttachments calls the "ListAttachments" function in dummypb.DummyClient
+// interface.
+func (c *Client) ListAttachments() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListAttachmentsFunc(c.grpccli, c.opts...),
+			EncodeListAttachmentsRequest,
+			DecodeListAttachmentsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.ListAttachmentsNotFoundError:
+				return nil, NewListAttachmentsNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// DeleteAttachment calls the "DeleteAttachment" function in
+// dummypb.DummyClient interface.
+func (c *Client) DeleteAttachment() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildDeleteAttachmentFunc(c.grpccli, c.opts...),
+			EncodeDeleteAttachmentRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.DeleteAttachmentNotFoundError:
+				return nil, NewDeleteAttachmentNotFoundError(message)
+			case *dummypb.DeleteAttachmentForbiddenError:
+				return nil, NewDeleteAttachmentForbiddenError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// BatchCreateItems calls the "BatchCreateItems" function in
+// dummypb.DummyClient interface.
+func (c *Client) BatchCreateItems() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildBatchCreateItemsFunc(c.grpccli, c.opts...),
+			EncodeBatchCreateItemsRequest,
+			DecodeBatchCreateItemsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			// Try to decode a Goa error response detail before falling back to Fault.
+			resp := goagrpc.DecodeError(err)
+			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
+				return nil, goagrpc.NewServiceError(eresp)
+			}
+			return nil, goa.Fault("%s", err.Error())
+		}
+		return res, nil
+	}
+}
+
+// BatchUpdateItems calls the "BatchUpdateItems" function in
+// dummypb.DummyClient interface.
+func (c *Client) BatchUpdateItems() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildBatchUpdateItemsFunc(c.grpccli, c.opts...),
+			EncodeBatchUpdateItemsRequest,
+			DecodeBatchUpdateItemsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			// Try to decode a Goa error response detail before falling back to Fault.
+			resp := goagrpc.DecodeError(err)
+			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
+				return nil, goagrpc.NewServiceError(eresp)
+			}
+			return nil, goa.Fault("%s", err.Error())
+		}
+		return res, nil
+	}
+}
+
+// BatchDeleteItems calls the "BatchDeleteItems" function in
+// dummypb.DummyClient interface.
+func (c *Client) BatchDeleteItems() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildBatchDeleteItemsFunc(c.grpccli, c.opts...),
+			EncodeBatchDeleteItemsRequest,
+			DecodeBatchDeleteItemsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			// Try to decode a Goa error response detail before falling back to Fault.
+			resp := goagrpc.DecodeError(err)
+			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
+				return nil, goagrpc.NewServiceError(eresp)
+			}
+			return nil, goa.Fault("%s", err.Error())
+		}
+		return res, nil
+	}
+}
+
+// ImportItems calls the "ImportItems" function in dummypb.DummyClient
+// interface.
+func (c *Client) ImportItems() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildImportItemsFunc(c.grpccli, c.opts...),
+			EncodeImportItemsRequest,
+			DecodeImportItemsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			// Try to decode a Goa error response detail before falling back to Fault.
+			resp := goagrpc.DecodeError(err)
+			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
+				return nil, goagrpc.NewServiceError(eresp)
+			}
+			return nil, goa.Fault("%s", err.Error())
+		}
+		return res, nil
+	}
+}
+
+// ListTrash calls the "ListTrash" function in dummypb.DummyClient interface.
+func (c *Client) ListTrash() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListTrashFunc(c.grpccli, c.opts...),
+			EncodeListTrashRequest,
+			DecodeListTrashResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.ListTrashInvalidCursorError:
+				return nil, NewListTrashInvalidCursorError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// RestoreItem calls the "RestoreItem" function in dummypb.DummyClient
+// interface.
+func (c *Client) RestoreItem() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRestoreItemFunc(c.grpccli, c.opts...),
+			EncodeRestoreItemRequest,
+			DecodeRestoreItemResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.RestoreItemNotFoundError:
+				return nil, NewRestoreItemNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// PurgeItem calls the "PurgeItem" function in dummypb.DummyClient interface.
+func (c *Client) PurgeItem() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildPurgeItemFunc(c.grpccli, c.opts...),
+			EncodePurgeItemRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *dummypb.PurgeItemNotFoundError:
+				return nil, NewPurgeItemNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CloseAndRecv reads instances of "dummypb.ImportItemsResponse" from the
+// "import_items" endpoint gRPC stream.
+func (s *ImportItemsClientStream) CloseAndRecv() (*dummy.ImportSummary, error) {
+	var res *dummy.ImportSummary
+	v, err := s.stream.CloseAndRecv()
+	if err != nil {
+		return res, err
+	}
+	if err = ValidateImportItemsResponse(v); err != nil {
+		return res, err
+	}
+	return NewImportItemsResponseImportSummary(v), nil
+}
+
+// CloseAndRecvWithContext reads instances of "dummypb.ImportItemsResponse"
+// from the "import_items" endpoint gRPC stream with context.
+func (s *ImportItemsClientStream) CloseAndRecvWithContext(ctx context.Context) (*dummy.ImportSummary, error) {
+	return s.CloseAndRecv()
+}
+
+// Send streams instances of "dummypb.ImportItemsStreamingRequest" to the
+// "import_items" endpoint gRPC stream.
+func (s *ImportItemsClientStream) Send(res *dummy.BatchItemEntry) error {
+	v := NewProtoBatchItemEntryImportItemsStreamingRequest(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of "dummypb.ImportItemsStreamingRequest"
+// to the "import_items" endpoint gRPC stream with context.
+func (s *ImportItemsClientStream) SendWithContext(ctx context.Context, res *dummy.BatchItemEntry) error {
+	return s.Send(res)
+}
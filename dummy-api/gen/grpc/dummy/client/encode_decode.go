@@ -98,6 +98,44 @@ func DecodeListItemsResponse(ctx context.Context, v any, hdr, trlr metadata.MD)
 	return res, nil
 }
 
+// BuildSearchItemsFunc builds the remote method to invoke for "dummy" service
+// "search_items" endpoint.
+func BuildSearchItemsFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.SearchItems(ctx, reqpb.(*dummypb.SearchItemsRequest), opts...)
+		}
+		return grpccli.SearchItems(ctx, &dummypb.SearchItemsRequest{}, opts...)
+	}
+}
+
+// EncodeSearchItemsRequest encodes requests sent to dummy search_items
+// endpoint.
+func EncodeSearchItemsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.SearchItemsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "search_items", "*dummy.SearchItemsPayload", v)
+	}
+	return NewProtoSearchItemsRequest(payload), nil
+}
+
+// DecodeSearchItemsResponse decodes responses from the dummy search_items
+// endpoint.
+func DecodeSearchItemsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.SearchItemsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "search_items", "*dummypb.SearchItemsResponse", v)
+	}
+	if err := ValidateSearchItemsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewSearchItemsResult(message)
+	return res, nil
+}
+
 // BuildGetItemFunc builds the remote method to invoke for "dummy" service
 // "get_item" endpoint.
 func BuildGetItemFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
@@ -141,6 +179,93 @@ func DecodeGetItemResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (a
 	return dummy.NewItem(vres), nil
 }
 
+// BuildUpdateItemFunc builds the remote method to invoke for "dummy" service
+// "update_item" endpoint.
+func BuildUpdateItemFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.UpdateItem(ctx, reqpb.(*dummypb.UpdateItemRequest), opts...)
+		}
+		return grpccli.UpdateItem(ctx, &dummypb.UpdateItemRequest{}, opts...)
+	}
+}
+
+// EncodeUpdateItemRequest encodes requests sent to dummy update_item endpoint.
+func EncodeUpdateItemRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.UpdateItemPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "update_item", "*dummy.UpdateItemPayload", v)
+	}
+	return NewProtoUpdateItemRequest(payload), nil
+}
+
+// DecodeUpdateItemResponse decodes responses from the dummy update_item
+// endpoint.
+func DecodeUpdateItemResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*dummypb.UpdateItemResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "update_item", "*dummypb.UpdateItemResponse", v)
+	}
+	res := NewUpdateItemResult(message)
+	vres := &dummyviews.Item{Projected: res, View: view}
+	if err := dummyviews.ValidateItem(vres); err != nil {
+		return nil, err
+	}
+	return dummy.NewItem(vres), nil
+}
+
+// BuildPatchItemFunc builds the remote method to invoke for "dummy" service
+// "patch_item" endpoint.
+func BuildPatchItemFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PatchItem(ctx, reqpb.(*dummypb.PatchItemRequest), opts...)
+		}
+		return grpccli.PatchItem(ctx, &dummypb.PatchItemRequest{}, opts...)
+	}
+}
+
+// EncodePatchItemRequest encodes requests sent to dummy patch_item endpoint.
+func EncodePatchItemRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.PatchItemPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "patch_item", "*dummy.PatchItemPayload", v)
+	}
+	return NewProtoPatchItemRequest(payload), nil
+}
+
+// DecodePatchItemResponse decodes responses from the dummy patch_item endpoint.
+func DecodePatchItemResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*dummypb.PatchItemResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "patch_item", "*dummypb.PatchItemResponse", v)
+	}
+	res := NewPatchItemResult(message)
+	vres := &dummyviews.Item{Projected: res, View: view}
+	if err := dummyviews.ValidateItem(vres); err != nil {
+		return nil, err
+	}
+	return dummy.NewItem(vres), nil
+}
+
 // BuildDeleteItemFunc builds the remote method to invoke for "dummy" service
 // "delete_item" endpoint.
 func BuildDeleteItemFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
@@ -163,3 +288,541 @@ func EncodeDeleteItemRequest(ctx context.Context, v any, md *metadata.MD) (any,
 	}
 	return NewProtoDeleteItemRequest(payload), nil
 }
+
+// BuildShareItemFunc builds the remote method to invoke for "dummy" service
+// "share_item" endpoint.
+func BuildShareItemFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ShareItem(ctx, reqpb.(*dummypb.ShareItemRequest), opts...)
+		}
+		return grpccli.ShareItem(ctx, &dummypb.ShareItemRequest{}, opts...)
+	}
+}
+
+// EncodeShareItemRequest encodes requests sent to dummy share_item endpoint.
+func EncodeShareItemRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.ShareItemPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "share_item", "*dummy.ShareItemPayload", v)
+	}
+	return NewProtoShareItemRequest(payload), nil
+}
+
+// DecodeShareItemResponse decodes responses from the dummy share_item endpoint.
+func DecodeShareItemResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.ShareItemResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "share_item", "*dummypb.ShareItemResponse", v)
+	}
+	if err := ValidateShareItemResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewShareItemResult(message)
+	return res, nil
+}
+
+// BuildUnshareItemFunc builds the remote method to invoke for "dummy" service
+// "unshare_item" endpoint.
+func BuildUnshareItemFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.UnshareItem(ctx, reqpb.(*dummypb.UnshareItemRequest), opts...)
+		}
+		return grpccli.UnshareItem(ctx, &dummypb.UnshareItemRequest{}, opts...)
+	}
+}
+
+// EncodeUnshareItemRequest encodes requests sent to dummy unshare_item
+// endpoint.
+func EncodeUnshareItemRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.UnshareItemPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "unshare_item", "*dummy.UnshareItemPayload", v)
+	}
+	return NewProtoUnshareItemRequest(payload), nil
+}
+
+// BuildListItemSharesFunc builds the remote method to invoke for "dummy"
+// service "list_item_shares" endpoint.
+func BuildListItemSharesFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListItemShares(ctx, reqpb.(*dummypb.ListItemSharesRequest), opts...)
+		}
+		return grpccli.ListItemShares(ctx, &dummypb.ListItemSharesRequest{}, opts...)
+	}
+}
+
+// EncodeListItemSharesRequest encodes requests sent to dummy list_item_shares
+// endpoint.
+func EncodeListItemSharesRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.ItemIDPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_item_shares", "*dummy.ItemIDPayload", v)
+	}
+	return NewProtoListItemSharesRequest(payload), nil
+}
+
+// DecodeListItemSharesResponse decodes responses from the dummy
+// list_item_shares endpoint.
+func DecodeListItemSharesResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.ListItemSharesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_item_shares", "*dummypb.ListItemSharesResponse", v)
+	}
+	if err := ValidateListItemSharesResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListItemSharesResult(message)
+	return res, nil
+}
+
+// BuildAddItemTagFunc builds the remote method to invoke for "dummy" service
+// "add_item_tag" endpoint.
+func BuildAddItemTagFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.AddItemTag(ctx, reqpb.(*dummypb.AddItemTagRequest), opts...)
+		}
+		return grpccli.AddItemTag(ctx, &dummypb.AddItemTagRequest{}, opts...)
+	}
+}
+
+// EncodeAddItemTagRequest encodes requests sent to dummy add_item_tag endpoint.
+func EncodeAddItemTagRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.ItemTagPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "add_item_tag", "*dummy.ItemTagPayload", v)
+	}
+	return NewProtoAddItemTagRequest(payload), nil
+}
+
+// DecodeAddItemTagResponse decodes responses from the dummy add_item_tag
+// endpoint.
+func DecodeAddItemTagResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*dummypb.AddItemTagResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "add_item_tag", "*dummypb.AddItemTagResponse", v)
+	}
+	res := NewAddItemTagResult(message)
+	vres := &dummyviews.Item{Projected: res, View: view}
+	if err := dummyviews.ValidateItem(vres); err != nil {
+		return nil, err
+	}
+	return dummy.NewItem(vres), nil
+}
+
+// BuildRemoveItemTagFunc builds the remote method to invoke for "dummy"
+// service "remove_item_tag" endpoint.
+func BuildRemoveItemTagFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RemoveItemTag(ctx, reqpb.(*dummypb.RemoveItemTagRequest), opts...)
+		}
+		return grpccli.RemoveItemTag(ctx, &dummypb.RemoveItemTagRequest{}, opts...)
+	}
+}
+
+// EncodeRemoveItemTagRequest encodes requests sent to dummy remove_item_tag
+// endpoint.
+func EncodeRemoveItemTagRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.ItemTagPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "remove_item_tag", "*dummy.ItemTagPayload", v)
+	}
+	return NewProtoRemoveItemTagRequest(payload), nil
+}
+
+// DecodeRemoveItemTagResponse decodes responses from the dummy remove_item_tag
+// endpoint.
+func DecodeRemoveItemTagResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*dummypb.RemoveItemTagResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "remove_item_tag", "*dummypb.RemoveItemTagResponse", v)
+	}
+	res := NewRemoveItemTagResult(message)
+	vres := &dummyviews.Item{Projected: res, View: view}
+	if err := dummyviews.ValidateItem(vres); err != nil {
+		return nil, err
+	}
+	return dummy.NewItem(vres), nil
+}
+
+// BuildSuggestTagsFunc builds the remote method to invoke for "dummy" service
+// "suggest_tags" endpoint.
+func BuildSuggestTagsFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.SuggestTags(ctx, reqpb.(*dummypb.SuggestTagsRequest), opts...)
+		}
+		return grpccli.SuggestTags(ctx, &dummypb.SuggestTagsRequest{}, opts...)
+	}
+}
+
+// EncodeSuggestTagsRequest encodes requests sent to dummy suggest_tags
+// endpoint.
+func EncodeSuggestTagsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.SuggestTagsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "suggest_tags", "*dummy.SuggestTagsPayload", v)
+	}
+	return NewProtoSuggestTagsRequest(payload), nil
+}
+
+// DecodeSuggestTagsResponse decodes responses from the dummy suggest_tags
+// endpoint.
+func DecodeSuggestTagsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.SuggestTagsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "suggest_tags", "*dummypb.SuggestTagsResponse", v)
+	}
+	if err := ValidateSuggestTagsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewSuggestTagsResult(message)
+	return res, nil
+}
+
+// BuildListAttachmentsFunc builds the remote method to invoke for "dummy"
+// service "list_attachments" endpoint.
+func BuildListAttachmentsFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListAttachments(ctx, reqpb.(*dummypb.ListAttachmentsRequest), opts...)
+		}
+		return grpccli.ListAttachments(ctx, &dummypb.ListAttachmentsRequest{}, opts...)
+	}
+}
+
+// EncodeListAttachmentsRequest encodes requests sent to dummy list_attachments
+// endpoint.
+func EncodeListAttachmentsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.ItemIDPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_attachments", "*dummy.ItemIDPayload", v)
+	}
+	return NewProtoListAttachmentsRequest(payload), nil
+}
+
+// DecodeListAttachmentsResponse decodes responses from the dummy
+// list_attachments endpoint.
+func DecodeListAttachmentsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.ListAttachmentsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_attachments", "*dummypb.ListAttachmentsResponse", v)
+	}
+	if err := ValidateListAttachmentsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListAttachmentsResult(message)
+	return res, nil
+}
+
+// BuildDeleteAttachmentFunc builds the remote method to invoke for "dummy"
+// service "delete_attachment" endpoint.
+func BuildDeleteAttachmentFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.DeleteAttachment(ctx, reqpb.(*dummypb.DeleteAttachmentRequest), opts...)
+		}
+		return grpccli.DeleteAttachment(ctx, &dummypb.DeleteAttachmentRequest{}, opts...)
+	}
+}
+
+// EncodeDeleteAttachmentRequest encodes requests sent to dummy
+// delete_attachment endpoint.
+func EncodeDeleteAttachmentRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.AttachmentIDPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "delete_attachment", "*dummy.AttachmentIDPayload", v)
+	}
+	return NewProtoDeleteAttachmentRequest(payload), nil
+}
+
+// BuildBatchCreateItemsFunc builds the remote method to invoke for "dummy"
+// service "batch_create_items" endpoint.
+func BuildBatchCreateItemsFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.BatchCreateItems(ctx, reqpb.(*dummypb.BatchCreateItemsRequest), opts...)
+		}
+		return grpccli.BatchCreateItems(ctx, &dummypb.BatchCreateItemsRequest{}, opts...)
+	}
+}
+
+// EncodeBatchCreateItemsRequest encodes requests sent to dummy
+// batch_create_items endpoint.
+func EncodeBatchCreateItemsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.BatchCreateItemsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_create_items", "*dummy.BatchCreateItemsPayload", v)
+	}
+	return NewProtoBatchCreateItemsRequest(payload), nil
+}
+
+// DecodeBatchCreateItemsResponse decodes responses from the dummy
+// batch_create_items endpoint.
+func DecodeBatchCreateItemsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.BatchCreateItemsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_create_items", "*dummypb.BatchCreateItemsResponse", v)
+	}
+	if err := ValidateBatchCreateItemsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewBatchCreateItemsResult(message)
+	return res, nil
+}
+
+// BuildBatchUpdateItemsFunc builds the remote method to invoke for "dummy"
+// service "batch_update_items" endpoint.
+func BuildBatchUpdateItemsFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.BatchUpdateItems(ctx, reqpb.(*dummypb.BatchUpdateItemsRequest), opts...)
+		}
+		return grpccli.BatchUpdateItems(ctx, &dummypb.BatchUpdateItemsRequest{}, opts...)
+	}
+}
+
+// EncodeBatchUpdateItemsRequest encodes requests sent to dummy
+// batch_update_items endpoint.
+func EncodeBatchUpdateItemsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.BatchUpdateItemsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_update_items", "*dummy.BatchUpdateItemsPayload", v)
+	}
+	return NewProtoBatchUpdateItemsRequest(payload), nil
+}
+
+// DecodeBatchUpdateItemsResponse decodes responses from the dummy
+// batch_update_items endpoint.
+func DecodeBatchUpdateItemsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.BatchUpdateItemsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_update_items", "*dummypb.BatchUpdateItemsResponse", v)
+	}
+	if err := ValidateBatchUpdateItemsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewBatchUpdateItemsResult(message)
+	return res, nil
+}
+
+// BuildBatchDeleteItemsFunc builds the remote method to invoke for "dummy"
+// service "batch_delete_items" endpoint.
+func BuildBatchDeleteItemsFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.BatchDeleteItems(ctx, reqpb.(*dummypb.BatchDeleteItemsRequest), opts...)
+		}
+		return grpccli.BatchDeleteItems(ctx, &dummypb.BatchDeleteItemsRequest{}, opts...)
+	}
+}
+
+// EncodeBatchDeleteItemsRequest encodes requests sent to dummy
+// batch_delete_items endpoint.
+func EncodeBatchDeleteItemsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.BatchDeleteItemsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_delete_items", "*dummy.BatchDeleteItemsPayload", v)
+	}
+	return NewProtoBatchDeleteItemsRequest(payload), nil
+}
+
+// DecodeBatchDeleteItemsResponse decodes responses from the dummy
+// batch_delete_items endpoint.
+func DecodeBatchDeleteItemsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.BatchDeleteItemsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_delete_items", "*dummypb.BatchDeleteItemsResponse", v)
+	}
+	if err := ValidateBatchDeleteItemsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewBatchDeleteItemsResult(message)
+	return res, nil
+}
+
+// BuildImportItemsFunc builds the remote method to invoke for "dummy" service
+// "import_items" endpoint.
+func BuildImportItemsFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ImportItems(ctx, opts...)
+		}
+		return grpccli.ImportItems(ctx, opts...)
+	}
+}
+
+// EncodeImportItemsRequest encodes requests sent to dummy import_items
+// endpoint.
+func EncodeImportItemsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.AuthenticatedPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "import_items", "*dummy.AuthenticatedPayload", v)
+	}
+	(*md).Append("authorization", payload.Token)
+	return nil, nil
+}
+
+// DecodeImportItemsResponse decodes responses from the dummy import_items
+// endpoint.
+func DecodeImportItemsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	return &ImportItemsClientStream{
+		stream: v.(dummypb.Dummy_ImportItemsClient),
+	}, nil
+}
+
+// BuildListTrashFunc builds the remote method to invoke for "dummy" service
+// "list_trash" endpoint.
+func BuildListTrashFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListTrash(ctx, reqpb.(*dummypb.ListTrashRequest), opts...)
+		}
+		return grpccli.ListTrash(ctx, &dummypb.ListTrashRequest{}, opts...)
+	}
+}
+
+// EncodeListTrashRequest encodes requests sent to dummy list_trash endpoint.
+func EncodeListTrashRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.ListTrashPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_trash", "*dummy.ListTrashPayload", v)
+	}
+	return NewProtoListTrashRequest(payload), nil
+}
+
+// DecodeListTrashResponse decodes responses from the dummy list_trash endpoint.
+func DecodeListTrashResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*dummypb.ListTrashResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_trash", "*dummypb.ListTrashResponse", v)
+	}
+	if err := ValidateListTrashResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListTrashResult(message)
+	return res, nil
+}
+
+// BuildRestoreItemFunc builds the remote method to invoke for "dummy" service
+// "restore_item" endpoint.
+func BuildRestoreItemFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RestoreItem(ctx, reqpb.(*dummypb.RestoreItemRequest), opts...)
+		}
+		return grpccli.RestoreItem(ctx, &dummypb.RestoreItemRequest{}, opts...)
+	}
+}
+
+// EncodeRestoreItemRequest encodes requests sent to dummy restore_item
+// endpoint.
+func EncodeRestoreItemRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.ItemIDPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "restore_item", "*dummy.ItemIDPayload", v)
+	}
+	return NewProtoRestoreItemRequest(payload), nil
+}
+
+// DecodeRestoreItemResponse decodes responses from the dummy restore_item
+// endpoint.
+func DecodeRestoreItemResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*dummypb.RestoreItemResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "restore_item", "*dummypb.RestoreItemResponse", v)
+	}
+	res := NewRestoreItemResult(message)
+	vres := &dummyviews.Item{Projected: res, View: view}
+	if err := dummyviews.ValidateItem(vres); err != nil {
+		return nil, err
+	}
+	return dummy.NewItem(vres), nil
+}
+
+// BuildPurgeItemFunc builds the remote method to invoke for "dummy" service
+// "purge_item" endpoint.
+func BuildPurgeItemFunc(grpccli dummypb.DummyClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.PurgeItem(ctx, reqpb.(*dummypb.PurgeItemRequest), opts...)
+		}
+		return grpccli.PurgeItem(ctx, &dummypb.PurgeItemRequest{}, opts...)
+	}
+}
+
+// EncodePurgeItemRequest encodes requests sent to dummy purge_item endpoint.
+func EncodePurgeItemRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*dummy.ItemIDPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "purge_item", "*dummy.ItemIDPayload", v)
+	}
+	return NewProtoPurgeItemRequest(payload), nil
+}
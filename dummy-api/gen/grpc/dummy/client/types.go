@@ -20,6 +20,7 @@ func NewProtoCreateItemRequest(payload *dummy.CreateItemPayload) *dummypb.Create
 	message := &dummypb.CreateItemRequest{
 		Name:        payload.Name,
 		Description: payload.Description,
+		Language:    payload.Language,
 		Token:       payload.Token,
 	}
 	return message
@@ -34,23 +35,876 @@ func NewCreateItemResult(message *dummypb.CreateItemResponse) *dummyviews.ItemVi
 		Description: message.Description,
 		OwnerID:     &message.OwnerId,
 		CreatedAt:   &message.CreatedAt,
+		UpdatedAt:   &message.UpdatedAt,
+		Etag:        &message.Etag,
+		Language:    &message.Language,
+		DeletedAt:   message.DeletedAt,
+		Permission:  &message.Permission,
+	}
+	version := int(message.Version)
+	result.Version = &version
+	if message.Tags != nil {
+		result.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			result.Tags[i] = val
+		}
+	}
+	return result
+}
+
+// NewProtoListItemsRequest builds the gRPC request type from the payload of
+// the "list_items" endpoint of the "dummy" service.
+func NewProtoListItemsRequest(payload *dummy.ListItemsPayload) *dummypb.ListItemsRequest {
+	message := &dummypb.ListItemsRequest{
+		Cursor:        payload.Cursor,
+		Order:         &payload.Order,
+		NamePrefix:    payload.NamePrefix,
+		CreatedAfter:  payload.CreatedAfter,
+		CreatedBefore: payload.CreatedBefore,
+		IncludeTotal:  &payload.IncludeTotal,
+		TagMatch:      &payload.TagMatch,
+		Token:         payload.Token,
+	}
+	pageSize := int32(payload.PageSize)
+	message.PageSize = &pageSize
+	if payload.Tags != nil {
+		message.Tags = make([]string, len(payload.Tags))
+		for i, val := range payload.Tags {
+			message.Tags[i] = val
+		}
+	}
+	return message
+}
+
+// NewListItemsResult builds the result type of the "list_items" endpoint of
+// the "dummy" service from the gRPC response type.
+func NewListItemsResult(message *dummypb.ListItemsResponse) *dummy.ItemsCollection {
+	result := &dummy.ItemsCollection{
+		NextCursor: message.NextCursor,
+		Total:      message.Total,
+	}
+	if message.Items != nil {
+		result.Items = make([]*dummy.Item, len(message.Items))
+		for i, val := range message.Items {
+			result.Items[i] = &dummy.Item{
+				ID:          val.Id,
+				Name:        val.Name,
+				Description: val.Description,
+				OwnerID:     val.OwnerId,
+				CreatedAt:   val.CreatedAt,
+				Version:     int(val.Version),
+				UpdatedAt:   val.UpdatedAt,
+				Etag:        val.Etag,
+				Language:    val.Language,
+				DeletedAt:   val.DeletedAt,
+				Permission:  val.Permission,
+			}
+			if val.Tags != nil {
+				result.Items[i].Tags = make([]string, len(val.Tags))
+				for j, val := range val.Tags {
+					result.Items[i].Tags[j] = val
+				}
+			}
+		}
+	}
+	return result
+}
+
+// NewListItemsInvalidCursorError builds the error type of the "list_items"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewListItemsInvalidCursorError(message *dummypb.ListItemsInvalidCursorError) *dummy.DummyBadRequestError {
+	er := &dummy.DummyBadRequestError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoSearchItemsRequest builds the gRPC request type from the payload of
+// the "search_items" endpoint of the "dummy" service.
+func NewProtoSearchItemsRequest(payload *dummy.SearchItemsPayload) *dummypb.SearchItemsRequest {
+	message := &dummypb.SearchItemsRequest{
+		Query:    payload.Query,
+		Language: payload.Language,
+		Cursor:   payload.Cursor,
+		Token:    payload.Token,
+	}
+	pageSize := int32(payload.PageSize)
+	message.PageSize = &pageSize
+	return message
+}
+
+// NewSearchItemsResult builds the result type of the "search_items" endpoint
+// of the "dummy" service from the gRPC response type.
+func NewSearchItemsResult(message *dummypb.SearchItemsResponse) *dummy.SearchResultsCollection {
+	result := &dummy.SearchResultsCollection{
+		NextCursor: message.NextCursor,
+	}
+	if message.Results != nil {
+		result.Results = make([]*dummy.SearchResult, len(message.Results))
+		for i, val := range message.Results {
+			result.Results[i] = &dummy.SearchResult{
+				Rank:    val.Rank,
+				Snippet: val.Snippet,
+			}
+			if val.Item != nil {
+				result.Results[i].Item = protobufDummypbItemToDummyItem(val.Item)
+			}
+		}
+	}
+	return result
+}
+
+// NewSearchItemsInvalidCursorError builds the error type of the "search_items"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewSearchItemsInvalidCursorError(message *dummypb.SearchItemsInvalidCursorError) *dummy.DummyBadRequestError {
+	er := &dummy.DummyBadRequestError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoGetItemRequest builds the gRPC request type from the payload of the
+// "get_item" endpoint of the "dummy" service.
+func NewProtoGetItemRequest(payload *dummy.ItemIDPayload) *dummypb.GetItemRequest {
+	message := &dummypb.GetItemRequest{
+		Id:    payload.ID,
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewGetItemResult builds the result type of the "get_item" endpoint of the
+// "dummy" service from the gRPC response type.
+func NewGetItemResult(message *dummypb.GetItemResponse) *dummyviews.ItemView {
+	result := &dummyviews.ItemView{
+		ID:          &message.Id,
+		Name:        &message.Name,
+		Description: message.Description,
+		OwnerID:     &message.OwnerId,
+		CreatedAt:   &message.CreatedAt,
+		UpdatedAt:   &message.UpdatedAt,
+		Etag:        &message.Etag,
+		Language:    &message.Language,
+		DeletedAt:   message.DeletedAt,
+		Permission:  &message.Permission,
+	}
+	version := int(message.Version)
+	result.Version = &version
+	if message.Tags != nil {
+		result.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			result.Tags[i] = val
+		}
+	}
+	return result
+}
+
+// NewGetItemNotFoundError builds the error type of the "get_item" endpoint of
+// the "dummy" service from the gRPC error response type.
+func NewGetItemNotFoundError(message *dummypb.GetItemNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoUpdateItemRequest builds the gRPC request type from the payload of
+// the "update_item" endpoint of the "dummy" service.
+func NewProtoUpdateItemRequest(payload *dummy.UpdateItemPayload) *dummypb.UpdateItemRequest {
+	message := &dummypb.UpdateItemRequest{
+		Id:          payload.ID,
+		Name:        payload.Name,
+		Description: payload.Description,
+		IfMatch:     payload.IfMatch,
+		Token:       payload.Token,
+	}
+	if payload.Version != nil {
+		version := int32(*payload.Version)
+		message.Version = &version
+	}
+	return message
+}
+
+// NewUpdateItemResult builds the result type of the "update_item" endpoint of
+// the "dummy" service from the gRPC response type.
+func NewUpdateItemResult(message *dummypb.UpdateItemResponse) *dummyviews.ItemView {
+	result := &dummyviews.ItemView{
+		ID:          &message.Id,
+		Name:        &message.Name,
+		Description: message.Description,
+		OwnerID:     &message.OwnerId,
+		CreatedAt:   &message.CreatedAt,
+		UpdatedAt:   &message.UpdatedAt,
+		Etag:        &message.Etag,
+		Language:    &message.Language,
+		DeletedAt:   message.DeletedAt,
+		Permission:  &message.Permission,
+	}
+	version := int(message.Version)
+	result.Version = &version
+	if message.Tags != nil {
+		result.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			result.Tags[i] = val
+		}
+	}
+	return result
+}
+
+// NewUpdateItemConflictError builds the error type of the "update_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewUpdateItemConflictError(message *dummypb.UpdateItemConflictError) *dummy.DummyConflictError {
+	er := &dummy.DummyConflictError{
+		Message: message.Message_,
+	}
+	if message.CurrentVersion != nil {
+		currentVersion := int(*message.CurrentVersion)
+		er.CurrentVersion = &currentVersion
+	}
+	return er
+}
+
+// NewUpdateItemPreconditionRequiredError builds the error type of the
+// "update_item" endpoint of the "dummy" service from the gRPC error response
+// type.
+func NewUpdateItemPreconditionRequiredError(message *dummypb.UpdateItemPreconditionRequiredError) *dummy.DummyPreconditionRequiredError {
+	er := &dummy.DummyPreconditionRequiredError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewUpdateItemNotFoundError builds the error type of the "update_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewUpdateItemNotFoundError(message *dummypb.UpdateItemNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewUpdateItemForbiddenError builds the error type of the "update_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewUpdateItemForbiddenError(message *dummypb.UpdateItemForbiddenError) *dummy.DummyForbiddenError {
+	er := &dummy.DummyForbiddenError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoPatchItemRequest builds the gRPC request type from the payload of
+// the "patch_item" endpoint of the "dummy" service.
+func NewProtoPatchItemRequest(payload *dummy.PatchItemPayload) *dummypb.PatchItemRequest {
+	message := &dummypb.PatchItemRequest{
+		Id:          payload.ID,
+		Name:        payload.Name,
+		Description: payload.Description,
+		IfMatch:     payload.IfMatch,
+		Token:       payload.Token,
+	}
+	if payload.Version != nil {
+		version := int32(*payload.Version)
+		message.Version = &version
+	}
+	return message
+}
+
+// NewPatchItemResult builds the result type of the "patch_item" endpoint of
+// the "dummy" service from the gRPC response type.
+func NewPatchItemResult(message *dummypb.PatchItemResponse) *dummyviews.ItemView {
+	result := &dummyviews.ItemView{
+		ID:          &message.Id,
+		Name:        &message.Name,
+		Description: message.Description,
+		OwnerID:     &message.OwnerId,
+		CreatedAt:   &message.CreatedAt,
+		UpdatedAt:   &message.UpdatedAt,
+		Etag:        &message.Etag,
+		Language:    &message.Language,
+		DeletedAt:   message.DeletedAt,
+		Permission:  &message.Permission,
+	}
+	version := int(message.Version)
+	result.Version = &version
+	if message.Tags != nil {
+		result.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			result.Tags[i] = val
+		}
+	}
+	return result
+}
+
+// NewPatchItemConflictError builds the error type of the "patch_item" endpoint
+// of the "dummy" service from the gRPC error response type.
+func NewPatchItemConflictError(message *dummypb.PatchItemConflictError) *dummy.DummyConflictError {
+	er := &dummy.DummyConflictError{
+		Message: message.Message_,
+	}
+	if message.CurrentVersion != nil {
+		currentVersion := int(*message.CurrentVersion)
+		er.CurrentVersion = &currentVersion
+	}
+	return er
+}
+
+// NewPatchItemPreconditionRequiredError builds the error type of the
+// "patch_item" endpoint of the "dummy" service from the gRPC error response
+// type.
+func NewPatchItemPreconditionRequiredError(message *dummypb.PatchItemPreconditionRequiredError) *dummy.DummyPreconditionRequiredError {
+	er := &dummy.DummyPreconditionRequiredError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewPatchItemNotFoundError builds the error type of the "patch_item" endpoint
+// of the "dummy" service from the gRPC error response type.
+func NewPatchItemNotFoundError(message *dummypb.PatchItemNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewPatchItemForbiddenError builds the error type of the "patch_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewPatchItemForbiddenError(message *dummypb.PatchItemForbiddenError) *dummy.DummyForbiddenError {
+	er := &dummy.DummyForbiddenError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoDeleteItemRequest builds the gRPC request type from the payload of
+// the "delete_item" endpoint of the "dummy" service.
+func NewProtoDeleteItemRequest(payload *dummy.ItemIDPayload) *dummypb.DeleteItemRequest {
+	message := &dummypb.DeleteItemRequest{
+		Id:    payload.ID,
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewDeleteItemNotFoundError builds the error type of the "delete_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewDeleteItemNotFoundError(message *dummypb.DeleteItemNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoShareItemRequest builds the gRPC request type from the payload of
+// the "share_item" endpoint of the "dummy" service.
+func NewProtoShareItemRequest(payload *dummy.ShareItemPayload) *dummypb.ShareItemRequest {
+	message := &dummypb.ShareItemRequest{
+		Id:         payload.ID,
+		UserId:     payload.UserID,
+		Permission: payload.Permission,
+		Token:      payload.Token,
+	}
+	return message
+}
+
+// NewShareItemResult builds the result type of the "share_item" endpoint of
+// the "dummy" service from the gRPC response type.
+func NewShareItemResult(message *dummypb.ShareItemResponse) *dummy.ItemShare {
+	result := &dummy.ItemShare{
+		ItemID:     message.ItemId,
+		UserID:     message.UserId,
+		Permission: message.Permission,
+		CreatedAt:  message.CreatedAt,
+	}
+	return result
+}
+
+// NewShareItemNotFoundError builds the error type of the "share_item" endpoint
+// of the "dummy" service from the gRPC error response type.
+func NewShareItemNotFoundError(message *dummypb.ShareItemNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewShareItemForbiddenError builds the error type of the "share_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewShareItemForbiddenError(message *dummypb.ShareItemForbiddenError) *dummy.DummyForbiddenError {
+	er := &dummy.DummyForbiddenError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoUnshareItemRequest builds the gRPC request type from the payload of
+// the "unshare_item" endpoint of the "dummy" service.
+func NewProtoUnshareItemRequest(payload *dummy.UnshareItemPayload) *dummypb.UnshareItemRequest {
+	message := &dummypb.UnshareItemRequest{
+		Id:     payload.ID,
+		UserId: payload.UserID,
+		Token:  payload.Token,
+	}
+	return message
+}
+
+// NewUnshareItemNotFoundError builds the error type of the "unshare_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewUnshareItemNotFoundError(message *dummypb.UnshareItemNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewUnshareItemForbiddenError builds the error type of the "unshare_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewUnshareItemForbiddenError(message *dummypb.UnshareItemForbiddenError) *dummy.DummyForbiddenError {
+	er := &dummy.DummyForbiddenError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoListItemSharesRequest builds the gRPC request type from the payload
+// of the "list_item_shares" endpoint of the "dummy" service.
+func NewProtoListItemSharesRequest(payload *dummy.ItemIDPayload) *dummypb.ListItemSharesRequest {
+	message := &dummypb.ListItemSharesRequest{
+		Id:    payload.ID,
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewListItemSharesResult builds the result type of the "list_item_shares"
+// endpoint of the "dummy" service from the gRPC response type.
+func NewListItemSharesResult(message *dummypb.ListItemSharesResponse) *dummy.ItemSharesCollection {
+	result := &dummy.ItemSharesCollection{}
+	if message.Shares != nil {
+		result.Shares = make([]*dummy.ItemShare, len(message.Shares))
+		for i, val := range message.Shares {
+			result.Shares[i] = &dummy.ItemShare{
+				ItemID:     val.ItemId,
+				UserID:     val.UserId,
+				Permission: val.Permission,
+				CreatedAt:  val.CreatedAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewListItemSharesNotFoundError builds the error type of the
+// "list_item_shares" endpoint of the "dummy" service from the gRPC error
+// response type.
+func NewListItemSharesNotFoundError(message *dummypb.ListItemSharesNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewListItemSharesForbiddenError builds the error type of the
+// "list_item_shares" endpoint of the "dummy" service from the gRPC error
+// response type.
+func NewListItemSharesForbiddenError(message *dummypb.ListItemSharesForbiddenError) *dummy.DummyForbiddenError {
+	er := &dummy.DummyForbiddenError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoAddItemTagRequest builds the gRPC request type from the payload of
+// the "add_item_tag" endpoint of the "dummy" service.
+func NewProtoAddItemTagRequest(payload *dummy.ItemTagPayload) *dummypb.AddItemTagRequest {
+	message := &dummypb.AddItemTagRequest{
+		Id:    payload.ID,
+		Tag:   payload.Tag,
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewAddItemTagResult builds the result type of the "add_item_tag" endpoint of
+// the "dummy" service from the gRPC response type.
+func NewAddItemTagResult(message *dummypb.AddItemTagResponse) *dummyviews.ItemView {
+	result := &dummyviews.ItemView{
+		ID:          &message.Id,
+		Name:        &message.Name,
+		Description: message.Description,
+		OwnerID:     &message.OwnerId,
+		CreatedAt:   &message.CreatedAt,
+		UpdatedAt:   &message.UpdatedAt,
+		Etag:        &message.Etag,
+		Language:    &message.Language,
+		DeletedAt:   message.DeletedAt,
+		Permission:  &message.Permission,
+	}
+	version := int(message.Version)
+	result.Version = &version
+	if message.Tags != nil {
+		result.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			result.Tags[i] = val
+		}
+	}
+	return result
+}
+
+// NewAddItemTagNotFoundError builds the error type of the "add_item_tag"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewAddItemTagNotFoundError(message *dummypb.AddItemTagNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewAddItemTagForbiddenError builds the error type of the "add_item_tag"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewAddItemTagForbiddenError(message *dummypb.AddItemTagForbiddenError) *dummy.DummyForbiddenError {
+	er := &dummy.DummyForbiddenError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoRemoveItemTagRequest builds the gRPC request type from the payload
+// of the "remove_item_tag" endpoint of the "dummy" service.
+func NewProtoRemoveItemTagRequest(payload *dummy.ItemTagPayload) *dummypb.RemoveItemTagRequest {
+	message := &dummypb.RemoveItemTagRequest{
+		Id:    payload.ID,
+		Tag:   payload.Tag,
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewRemoveItemTagResult builds the result type of the "remove_item_tag"
+// endpoint of the "dummy" service from the gRPC response type.
+func NewRemoveItemTagResult(message *dummypb.RemoveItemTagResponse) *dummyviews.ItemView {
+	result := &dummyviews.ItemView{
+		ID:          &message.Id,
+		Name:        &message.Name,
+		Description: message.Description,
+		OwnerID:     &message.OwnerId,
+		CreatedAt:   &message.CreatedAt,
+		UpdatedAt:   &message.UpdatedAt,
+		Etag:        &message.Etag,
+		Language:    &message.Language,
+		DeletedAt:   message.DeletedAt,
+		Permission:  &message.Permission,
+	}
+	version := int(message.Version)
+	result.Version = &version
+	if message.Tags != nil {
+		result.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			result.Tags[i] = val
+		}
+	}
+	return result
+}
+
+// NewRemoveItemTagNotFoundError builds the error type of the "remove_item_tag"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewRemoveItemTagNotFoundError(message *dummypb.RemoveItemTagNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewRemoveItemTagForbiddenError builds the error type of the
+// "remove_item_tag" endpoint of the "dummy" service from the gRPC error
+// response type.
+func NewRemoveItemTagForbiddenError(message *dummypb.RemoveItemTagForbiddenError) *dummy.DummyForbiddenError {
+	er := &dummy.DummyForbiddenError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoSuggestTagsRequest builds the gRPC request type from the payload of
+// the "suggest_tags" endpoint of the "dummy" service.
+func NewProtoSuggestTagsRequest(payload *dummy.SuggestTagsPayload) *dummypb.SuggestTagsRequest {
+	message := &dummypb.SuggestTagsRequest{
+		Prefix: &payload.Prefix,
+		Token:  payload.Token,
+	}
+	limit := int32(payload.Limit)
+	message.Limit = &limit
+	return message
+}
+
+// NewSuggestTagsResult builds the result type of the "suggest_tags" endpoint
+// of the "dummy" service from the gRPC response type.
+func NewSuggestTagsResult(message *dummypb.SuggestTagsResponse) *dummy.TagSuggestions {
+	result := &dummy.TagSuggestions{}
+	if message.Tags != nil {
+		result.Tags = make([]*dummy.TagSuggestion, len(message.Tags))
+		for i, val := range message.Tags {
+			result.Tags[i] = &dummy.TagSuggestion{
+				Name:      val.Name,
+				ItemCount: val.ItemCount,
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoListAttachmentsRequest builds the gRPC request type from the payload
+// of the "list_attachments" endpoint of the "dummy" service.
+func NewProtoListAttachmentsRequest(payload *dummy.ItemIDPayload) *dummypb.ListAttachmentsRequest {
+	message := &dummypb.ListAttachmentsRequest{
+		Id:    payload.ID,
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewListAttachmentsResult builds the result type of the "list_attachments"
+// endpoint of the "dummy" service from the gRPC response type.
+func NewListAttachmentsResult(message *dummypb.ListAttachmentsResponse) *dummy.AttachmentsCollection {
+	result := &dummy.AttachmentsCollection{}
+	if message.Attachments != nil {
+		result.Attachments = make([]*dummy.Attachment, len(message.Attachments))
+		for i, val := range message.Attachments {
+			result.Attachments[i] = &dummy.Attachment{
+				ID:             val.Id,
+				ItemID:         val.ItemId,
+				Filename:       val.Filename,
+				ContentType:    val.ContentType,
+				Size:           val.Size,
+				ChecksumSha256: val.ChecksumSha256,
+				CreatedAt:      val.CreatedAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewListAttachmentsNotFoundError builds the error type of the
+// "list_attachments" endpoint of the "dummy" service from the gRPC error
+// response type.
+func NewListAttachmentsNotFoundError(message *dummypb.ListAttachmentsNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoDeleteAttachmentRequest builds the gRPC request type from the
+// payload of the "delete_attachment" endpoint of the "dummy" service.
+func NewProtoDeleteAttachmentRequest(payload *dummy.AttachmentIDPayload) *dummypb.DeleteAttachmentRequest {
+	message := &dummypb.DeleteAttachmentRequest{
+		Id:           payload.ID,
+		AttachmentId: payload.AttachmentID,
+		Token:        payload.Token,
+	}
+	return message
+}
+
+// NewDeleteAttachmentNotFoundError builds the error type of the
+// "delete_attachment" endpoint of the "dummy" service from the gRPC error
+// response type.
+func NewDeleteAttachmentNotFoundError(message *dummypb.DeleteAttachmentNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewDeleteAttachmentForbiddenError builds the error type of the
+// "delete_attachment" endpoint of the "dummy" service from the gRPC error
+// response type.
+func NewDeleteAttachmentForbiddenError(message *dummypb.DeleteAttachmentForbiddenError) *dummy.DummyForbiddenError {
+	er := &dummy.DummyForbiddenError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoBatchCreateItemsRequest builds the gRPC request type from the
+// payload of the "batch_create_items" endpoint of the "dummy" service.
+func NewProtoBatchCreateItemsRequest(payload *dummy.BatchCreateItemsPayload) *dummypb.BatchCreateItemsRequest {
+	message := &dummypb.BatchCreateItemsRequest{
+		Atomic: &payload.Atomic,
+		Token:  payload.Token,
+	}
+	if payload.Items != nil {
+		message.Items = make([]*dummypb.BatchItemEntry, len(payload.Items))
+		for i, val := range payload.Items {
+			message.Items[i] = &dummypb.BatchItemEntry{
+				Name:        val.Name,
+				Description: val.Description,
+				Language:    val.Language,
+			}
+		}
+	}
+	return message
+}
+
+// NewBatchCreateItemsResult builds the result type of the "batch_create_items"
+// endpoint of the "dummy" service from the gRPC response type.
+func NewBatchCreateItemsResult(message *dummypb.BatchCreateItemsResponse) *dummy.BatchResult {
+	result := &dummy.BatchResult{
+		Succeeded: int(message.Succeeded),
+		Failed:    int(message.Failed),
+	}
+	if message.Results != nil {
+		result.Results = make([]*dummy.BatchEntryResult, len(message.Results))
+		for i, val := range message.Results {
+			result.Results[i] = &dummy.BatchEntryResult{
+				Index: int(val.Index),
+			}
+			if val.Item != nil {
+				result.Results[i].Item = protobufDummypbItemToDummyItem(val.Item)
+			}
+			if val.Error != nil {
+				result.Results[i].Error = protobufDummypbBatchEntryErrorToDummyBatchEntryError(val.Error)
+			}
+		}
 	}
 	return result
 }
 
-// NewProtoListItemsRequest builds the gRPC request type from the payload of
-// the "list_items" endpoint of the "dummy" service.
-func NewProtoListItemsRequest(payload *dummy.ListItemsPayload) *dummypb.ListItemsRequest {
-	message := &dummypb.ListItemsRequest{
-		Token: payload.Token,
+// NewProtoBatchUpdateItemsRequest builds the gRPC request type from the
+// payload of the "batch_update_items" endpoint of the "dummy" service.
+func NewProtoBatchUpdateItemsRequest(payload *dummy.BatchUpdateItemsPayload) *dummypb.BatchUpdateItemsRequest {
+	message := &dummypb.BatchUpdateItemsRequest{
+		Atomic: &payload.Atomic,
+		Token:  payload.Token,
+	}
+	if payload.Items != nil {
+		message.Items = make([]*dummypb.BatchUpdateEntry, len(payload.Items))
+		for i, val := range payload.Items {
+			message.Items[i] = &dummypb.BatchUpdateEntry{
+				Id:          val.ID,
+				Version:     int32(val.Version),
+				Name:        val.Name,
+				Description: val.Description,
+			}
+		}
 	}
 	return message
 }
 
-// NewListItemsResult builds the result type of the "list_items" endpoint of
+// NewBatchUpdateItemsResult builds the result type of the "batch_update_items"
+// endpoint of the "dummy" service from the gRPC response type.
+func NewBatchUpdateItemsResult(message *dummypb.BatchUpdateItemsResponse) *dummy.BatchResult {
+	result := &dummy.BatchResult{
+		Succeeded: int(message.Succeeded),
+		Failed:    int(message.Failed),
+	}
+	if message.Results != nil {
+		result.Results = make([]*dummy.BatchEntryResult, len(message.Results))
+		for i, val := range message.Results {
+			result.Results[i] = &dummy.BatchEntryResult{
+				Index: int(val.Index),
+			}
+			if val.Item != nil {
+				result.Results[i].Item = protobufDummypbItemToDummyItem(val.Item)
+			}
+			if val.Error != nil {
+				result.Results[i].Error = protobufDummypbBatchEntryErrorToDummyBatchEntryError(val.Error)
+			}
+		}
+	}
+	return result
+}
+
+// NewProtoBatchDeleteItemsRequest builds the gRPC request type from the
+// payload of the "batch_delete_items" endpoint of the "dummy" service.
+func NewProtoBatchDeleteItemsRequest(payload *dummy.BatchDeleteItemsPayload) *dummypb.BatchDeleteItemsRequest {
+	message := &dummypb.BatchDeleteItemsRequest{
+		Atomic: &payload.Atomic,
+		Token:  payload.Token,
+	}
+	if payload.Ids != nil {
+		message.Ids = make([]string, len(payload.Ids))
+		for i, val := range payload.Ids {
+			message.Ids[i] = val
+		}
+	}
+	return message
+}
+
+// NewBatchDeleteItemsResult builds the result type of the "batch_delete_items"
+// endpoint of the "dummy" service from the gRPC response type.
+func NewBatchDeleteItemsResult(message *dummypb.BatchDeleteItemsResponse) *dummy.BatchResult {
+	result := &dummy.BatchResult{
+		Succeeded: int(message.Succeeded),
+		Failed:    int(message.Failed),
+	}
+	if message.Results != nil {
+		result.Results = make([]*dummy.BatchEntryResult, len(message.Results))
+		for i, val := range message.Results {
+			result.Results[i] = &dummy.BatchEntryResult{
+				Index: int(val.Index),
+			}
+			if val.Item != nil {
+				result.Results[i].Item = protobufDummypbItemToDummyItem(val.Item)
+			}
+			if val.Error != nil {
+				result.Results[i].Error = protobufDummypbBatchEntryErrorToDummyBatchEntryError(val.Error)
+			}
+		}
+	}
+	return result
+}
+
+func NewImportItemsResponseImportSummary(v *dummypb.ImportItemsResponse) *dummy.ImportSummary {
+	result := &dummy.ImportSummary{
+		Received: v.Received,
+		Created:  v.Created,
+		Failed:   v.Failed,
+	}
+	if v.Errors != nil {
+		result.Errors = make([]*dummy.BatchEntryResult, len(v.Errors))
+		for i, val := range v.Errors {
+			result.Errors[i] = &dummy.BatchEntryResult{
+				Index: int(val.Index),
+			}
+			if val.Item != nil {
+				result.Errors[i].Item = protobufDummypbItemToDummyItem(val.Item)
+			}
+			if val.Error != nil {
+				result.Errors[i].Error = protobufDummypbBatchEntryErrorToDummyBatchEntryError(val.Error)
+			}
+		}
+	}
+	return result
+}
+
+func NewProtoBatchItemEntryImportItemsStreamingRequest(spayload *dummy.BatchItemEntry) *dummypb.ImportItemsStreamingRequest {
+	v := &dummypb.ImportItemsStreamingRequest{
+		Name:        spayload.Name,
+		Description: spayload.Description,
+		Language:    spayload.Language,
+	}
+	return v
+}
+
+// NewProtoListTrashRequest builds the gRPC request type from the payload of
+// the "list_trash" endpoint of the "dummy" service.
+func NewProtoListTrashRequest(payload *dummy.ListTrashPayload) *dummypb.ListTrashRequest {
+	message := &dummypb.ListTrashRequest{
+		Cursor: payload.Cursor,
+		Token:  payload.Token,
+	}
+	pageSize := int32(payload.PageSize)
+	message.PageSize = &pageSize
+	return message
+}
+
+// NewListTrashResult builds the result type of the "list_trash" endpoint of
 // the "dummy" service from the gRPC response type.
-func NewListItemsResult(message *dummypb.ListItemsResponse) *dummy.ItemsCollection {
-	result := &dummy.ItemsCollection{}
+func NewListTrashResult(message *dummypb.ListTrashResponse) *dummy.ItemsCollection {
+	result := &dummy.ItemsCollection{
+		NextCursor: message.NextCursor,
+		Total:      message.Total,
+	}
 	if message.Items != nil {
 		result.Items = make([]*dummy.Item, len(message.Items))
 		for i, val := range message.Items {
@@ -60,49 +914,111 @@ func NewListItemsResult(message *dummypb.ListItemsResponse) *dummy.ItemsCollecti
 				Description: val.Description,
 				OwnerID:     val.OwnerId,
 				CreatedAt:   val.CreatedAt,
+				Version:     int(val.Version),
+				UpdatedAt:   val.UpdatedAt,
+				Etag:        val.Etag,
+				Language:    val.Language,
+				DeletedAt:   val.DeletedAt,
+				Permission:  val.Permission,
+			}
+			if val.Tags != nil {
+				result.Items[i].Tags = make([]string, len(val.Tags))
+				for j, val := range val.Tags {
+					result.Items[i].Tags[j] = val
+				}
 			}
 		}
 	}
 	return result
 }
 
-// NewProtoGetItemRequest builds the gRPC request type from the payload of the
-// "get_item" endpoint of the "dummy" service.
-func NewProtoGetItemRequest(payload *dummy.ItemIDPayload) *dummypb.GetItemRequest {
-	message := &dummypb.GetItemRequest{
+// NewListTrashInvalidCursorError builds the error type of the "list_trash"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewListTrashInvalidCursorError(message *dummypb.ListTrashInvalidCursorError) *dummy.DummyBadRequestError {
+	er := &dummy.DummyBadRequestError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoRestoreItemRequest builds the gRPC request type from the payload of
+// the "restore_item" endpoint of the "dummy" service.
+func NewProtoRestoreItemRequest(payload *dummy.ItemIDPayload) *dummypb.RestoreItemRequest {
+	message := &dummypb.RestoreItemRequest{
 		Id:    payload.ID,
 		Token: payload.Token,
 	}
 	return message
 }
 
-// NewGetItemResult builds the result type of the "get_item" endpoint of the
-// "dummy" service from the gRPC response type.
-func NewGetItemResult(message *dummypb.GetItemResponse) *dummyviews.ItemView {
+// NewRestoreItemResult builds the result type of the "restore_item" endpoint
+// of the "dummy" service from the gRPC response type.
+func NewRestoreItemResult(message *dummypb.RestoreItemResponse) *dummyviews.ItemView {
 	result := &dummyviews.ItemView{
 		ID:          &message.Id,
 		Name:        &message.Name,
 		Description: message.Description,
 		OwnerID:     &message.OwnerId,
 		CreatedAt:   &message.CreatedAt,
+		UpdatedAt:   &message.UpdatedAt,
+		Etag:        &message.Etag,
+		Language:    &message.Language,
+		DeletedAt:   message.DeletedAt,
+		Permission:  &message.Permission,
+	}
+	version := int(message.Version)
+	result.Version = &version
+	if message.Tags != nil {
+		result.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			result.Tags[i] = val
+		}
 	}
 	return result
 }
 
-// NewProtoDeleteItemRequest builds the gRPC request type from the payload of
-// the "delete_item" endpoint of the "dummy" service.
-func NewProtoDeleteItemRequest(payload *dummy.ItemIDPayload) *dummypb.DeleteItemRequest {
-	message := &dummypb.DeleteItemRequest{
+// NewRestoreItemNotFoundError builds the error type of the "restore_item"
+// endpoint of the "dummy" service from the gRPC error response type.
+func NewRestoreItemNotFoundError(message *dummypb.RestoreItemNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
+// NewProtoPurgeItemRequest builds the gRPC request type from the payload of
+// the "purge_item" endpoint of the "dummy" service.
+func NewProtoPurgeItemRequest(payload *dummy.ItemIDPayload) *dummypb.PurgeItemRequest {
+	message := &dummypb.PurgeItemRequest{
 		Id:    payload.ID,
 		Token: payload.Token,
 	}
 	return message
 }
 
+// NewPurgeItemNotFoundError builds the error type of the "purge_item" endpoint
+// of the "dummy" service from the gRPC error response type.
+func NewPurgeItemNotFoundError(message *dummypb.PurgeItemNotFoundError) *dummy.DummyNotFoundError {
+	er := &dummy.DummyNotFoundError{
+		Message: message.Message_,
+	}
+	return er
+}
+
 // ValidateCreateItemResponse runs the validations defined on
 // CreateItemResponse.
 func ValidateCreateItemResponse(message *dummypb.CreateItemResponse) (err error) {
+	if message.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "message"))
+	}
 	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.updated_at", message.UpdatedAt, goa.FormatDateTime))
+	if message.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.deleted_at", *message.DeletedAt, goa.FormatDateTime))
+	}
+	if !(message.Permission == "owner" || message.Permission == "editor" || message.Permission == "viewer") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"owner", "editor", "viewer"}))
+	}
 	return
 }
 
@@ -123,12 +1039,379 @@ func ValidateListItemsResponse(message *dummypb.ListItemsResponse) (err error) {
 
 // ValidateItem runs the validations defined on Item.
 func ValidateItem(elem *dummypb.Item) (err error) {
+	if elem.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "elem"))
+	}
 	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.updated_at", elem.UpdatedAt, goa.FormatDateTime))
+	if elem.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("elem.deleted_at", *elem.DeletedAt, goa.FormatDateTime))
+	}
+	if !(elem.Permission == "owner" || elem.Permission == "editor" || elem.Permission == "viewer") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.permission", elem.Permission, []any{"owner", "editor", "viewer"}))
+	}
+	return
+}
+
+// ValidateSearchItemsResponse runs the validations defined on
+// SearchItemsResponse.
+func ValidateSearchItemsResponse(message *dummypb.SearchItemsResponse) (err error) {
+	if message.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "message"))
+	}
+	for _, e := range message.Results {
+		if e != nil {
+			if err2 := ValidateSearchResult(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSearchResult runs the validations defined on SearchResult.
+func ValidateSearchResult(elem *dummypb.SearchResult) (err error) {
+	if elem.Item == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("item", "elem"))
+	}
+	if elem.Item != nil {
+		if err2 := ValidateItem(elem.Item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
 // ValidateGetItemResponse runs the validations defined on GetItemResponse.
 func ValidateGetItemResponse(message *dummypb.GetItemResponse) (err error) {
+	if message.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "message"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.updated_at", message.UpdatedAt, goa.FormatDateTime))
+	if message.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.deleted_at", *message.DeletedAt, goa.FormatDateTime))
+	}
+	if !(message.Permission == "owner" || message.Permission == "editor" || message.Permission == "viewer") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"owner", "editor", "viewer"}))
+	}
+	return
+}
+
+// ValidateUpdateItemResponse runs the validations defined on
+// UpdateItemResponse.
+func ValidateUpdateItemResponse(message *dummypb.UpdateItemResponse) (err error) {
+	if message.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "message"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.updated_at", message.UpdatedAt, goa.FormatDateTime))
+	if message.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.deleted_at", *message.DeletedAt, goa.FormatDateTime))
+	}
+	if !(message.Permission == "owner" || message.Permission == "editor" || message.Permission == "viewer") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"owner", "editor", "viewer"}))
+	}
+	return
+}
+
+// ValidatePatchItemResponse runs the validations defined on PatchItemResponse.
+func ValidatePatchItemResponse(message *dummypb.PatchItemResponse) (err error) {
+	if message.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "message"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.updated_at", message.UpdatedAt, goa.FormatDateTime))
+	if message.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.deleted_at", *message.DeletedAt, goa.FormatDateTime))
+	}
+	if !(message.Permission == "owner" || message.Permission == "editor" || message.Permission == "viewer") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"owner", "editor", "viewer"}))
+	}
+	return
+}
+
+// ValidateShareItemResponse runs the validations defined on ShareItemResponse.
+func ValidateShareItemResponse(message *dummypb.ShareItemResponse) (err error) {
+	if !(message.Permission == "viewer" || message.Permission == "editor") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"viewer", "editor"}))
+	}
 	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
 	return
 }
+
+// ValidateListItemSharesResponse runs the validations defined on
+// ListItemSharesResponse.
+func ValidateListItemSharesResponse(message *dummypb.ListItemSharesResponse) (err error) {
+	if message.Shares == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shares", "message"))
+	}
+	for _, e := range message.Shares {
+		if e != nil {
+			if err2 := ValidateItemShare(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateItemShare runs the validations defined on ItemShare.
+func ValidateItemShare(elem *dummypb.ItemShare) (err error) {
+	if !(elem.Permission == "viewer" || elem.Permission == "editor") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.permission", elem.Permission, []any{"viewer", "editor"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateAddItemTagResponse runs the validations defined on
+// AddItemTagResponse.
+func ValidateAddItemTagResponse(message *dummypb.AddItemTagResponse) (err error) {
+	if message.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "message"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.updated_at", message.UpdatedAt, goa.FormatDateTime))
+	if message.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.deleted_at", *message.DeletedAt, goa.FormatDateTime))
+	}
+	if !(message.Permission == "owner" || message.Permission == "editor" || message.Permission == "viewer") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"owner", "editor", "viewer"}))
+	}
+	return
+}
+
+// ValidateRemoveItemTagResponse runs the validations defined on
+// RemoveItemTagResponse.
+func ValidateRemoveItemTagResponse(message *dummypb.RemoveItemTagResponse) (err error) {
+	if message.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "message"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.updated_at", message.UpdatedAt, goa.FormatDateTime))
+	if message.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.deleted_at", *message.DeletedAt, goa.FormatDateTime))
+	}
+	if !(message.Permission == "owner" || message.Permission == "editor" || message.Permission == "viewer") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"owner", "editor", "viewer"}))
+	}
+	return
+}
+
+// ValidateSuggestTagsResponse runs the validations defined on
+// SuggestTagsResponse.
+func ValidateSuggestTagsResponse(message *dummypb.SuggestTagsResponse) (err error) {
+	if message.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "message"))
+	}
+	return
+}
+
+// ValidateListAttachmentsResponse runs the validations defined on
+// ListAttachmentsResponse.
+func ValidateListAttachmentsResponse(message *dummypb.ListAttachmentsResponse) (err error) {
+	if message.Attachments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attachments", "message"))
+	}
+	for _, e := range message.Attachments {
+		if e != nil {
+			if err2 := ValidateAttachment(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAttachment runs the validations defined on Attachment.
+func ValidateAttachment(elem *dummypb.Attachment) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateBatchCreateItemsResponse runs the validations defined on
+// BatchCreateItemsResponse.
+func ValidateBatchCreateItemsResponse(message *dummypb.BatchCreateItemsResponse) (err error) {
+	if message.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "message"))
+	}
+	for _, e := range message.Results {
+		if e != nil {
+			if err2 := ValidateBatchEntryResult(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchEntryResult runs the validations defined on BatchEntryResult.
+func ValidateBatchEntryResult(elem *dummypb.BatchEntryResult) (err error) {
+	if elem.Item != nil {
+		if err2 := ValidateItem(elem.Item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateBatchUpdateItemsResponse runs the validations defined on
+// BatchUpdateItemsResponse.
+func ValidateBatchUpdateItemsResponse(message *dummypb.BatchUpdateItemsResponse) (err error) {
+	if message.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "message"))
+	}
+	for _, e := range message.Results {
+		if e != nil {
+			if err2 := ValidateBatchEntryResult(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchDeleteItemsResponse runs the validations defined on
+// BatchDeleteItemsResponse.
+func ValidateBatchDeleteItemsResponse(message *dummypb.BatchDeleteItemsResponse) (err error) {
+	if message.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "message"))
+	}
+	for _, e := range message.Results {
+		if e != nil {
+			if err2 := ValidateBatchEntryResult(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportItemsResponse runs the validations defined on
+// ImportItemsResponse.
+func ValidateImportItemsResponse(stream *dummypb.ImportItemsResponse) (err error) {
+	if stream.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "stream"))
+	}
+	for _, e := range stream.Errors {
+		if e != nil {
+			if err2 := ValidateBatchEntryResult(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListTrashResponse runs the validations defined on ListTrashResponse.
+func ValidateListTrashResponse(message *dummypb.ListTrashResponse) (err error) {
+	if message.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "message"))
+	}
+	for _, e := range message.Items {
+		if e != nil {
+			if err2 := ValidateItem(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRestoreItemResponse runs the validations defined on
+// RestoreItemResponse.
+func ValidateRestoreItemResponse(message *dummypb.RestoreItemResponse) (err error) {
+	if message.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "message"))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.updated_at", message.UpdatedAt, goa.FormatDateTime))
+	if message.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.deleted_at", *message.DeletedAt, goa.FormatDateTime))
+	}
+	if !(message.Permission == "owner" || message.Permission == "editor" || message.Permission == "viewer") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"owner", "editor", "viewer"}))
+	}
+	return
+}
+
+// svcDummyItemToDummypbItem builds a value of type *dummypb.Item from a value
+// of type *dummy.Item.
+func svcDummyItemToDummypbItem(v *dummy.Item) *dummypb.Item {
+	res := &dummypb.Item{
+		Id:          v.ID,
+		Name:        v.Name,
+		Description: v.Description,
+		OwnerId:     v.OwnerID,
+		CreatedAt:   v.CreatedAt,
+		Version:     int32(v.Version),
+		UpdatedAt:   v.UpdatedAt,
+		Etag:        v.Etag,
+		Language:    v.Language,
+		DeletedAt:   v.DeletedAt,
+		Permission:  v.Permission,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// protobufDummypbItemToDummyItem builds a value of type *dummy.Item from a
+// value of type *dummypb.Item.
+func protobufDummypbItemToDummyItem(v *dummypb.Item) *dummy.Item {
+	res := &dummy.Item{
+		ID:          v.Id,
+		Name:        v.Name,
+		Description: v.Description,
+		OwnerID:     v.OwnerId,
+		CreatedAt:   v.CreatedAt,
+		Version:     int(v.Version),
+		UpdatedAt:   v.UpdatedAt,
+		Etag:        v.Etag,
+		Language:    v.Language,
+		DeletedAt:   v.DeletedAt,
+		Permission:  v.Permission,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// svcDummyBatchEntryErrorToDummypbBatchEntryError builds a value of type
+// *dummypb.BatchEntryError from a value of type *dummy.BatchEntryError.
+func svcDummyBatchEntryErrorToDummypbBatchEntryError(v *dummy.BatchEntryError) *dummypb.BatchEntryError {
+	if v == nil {
+		return nil
+	}
+	res := &dummypb.BatchEntryError{
+		Name:     v.Name,
+		Message_: v.Message,
+	}
+
+	return res
+}
+
+// protobufDummypbBatchEntryErrorToDummyBatchEntryError builds a value of type
+// *dummy.BatchEntryError from a value of type *dummypb.BatchEntryError.
+func protobufDummypbBatchEntryErrorToDummyBatchEntryError(v *dummypb.BatchEntryError) *dummy.BatchEntryError {
+	if v == nil {
+		return nil
+	}
+	res := &dummy.BatchEntryError{
+		Name:    v.Name,
+		Message: v.Message_,
+	}
+
+	return res
+}
@@ -26,10 +26,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Dummy_CreateItem_FullMethodName = "/dummy.Dummy/CreateItem"
-	Dummy_ListItems_FullMethodName  = "/dummy.Dummy/ListItems"
-	Dummy_GetItem_FullMethodName    = "/dummy.Dummy/GetItem"
-	Dummy_DeleteItem_FullMethodName = "/dummy.Dummy/DeleteItem"
+	Dummy_CreateItem_FullMethodName       = "/dummy.Dummy/CreateItem"
+	Dummy_ListItems_FullMethodName        = "/dummy.Dummy/ListItems"
+	Dummy_SearchItems_FullMethodName      = "/dummy.Dummy/SearchItems"
+	Dummy_GetItem_FullMethodName          = "/dummy.Dummy/GetItem"
+	Dummy_UpdateItem_FullMethodName       = "/dummy.Dummy/UpdateItem"
+	Dummy_PatchItem_FullMethodName        = "/dummy.Dummy/PatchItem"
+	Dummy_DeleteItem_FullMethodName       = "/dummy.Dummy/DeleteItem"
+	Dummy_ShareItem_FullMethodName        = "/dummy.Dummy/ShareItem"
+	Dummy_UnshareItem_FullMethodName      = "/dummy.Dummy/UnshareItem"
+	Dummy_ListItemShares_FullMethodName   = "/dummy.Dummy/ListItemShares"
+	Dummy_AddItemTag_FullMethodName       = "/dummy.Dummy/AddItemTag"
+	Dummy_RemoveItemTag_FullMethodName    = "/dummy.Dummy/RemoveItemTag"
+	Dummy_SuggestTags_FullMethodName      = "/dummy.Dummy/SuggestTags"
+	Dummy_ListAttachments_FullMethodName  = "/dummy.Dummy/ListAttachments"
+	Dummy_DeleteAttachment_FullMethodName = "/dummy.Dummy/DeleteAttachment"
+	Dummy_BatchCreateItems_FullMethodName = "/dummy.Dummy/BatchCreateItems"
+	Dummy_BatchUpdateItems_FullMethodName = "/dummy.Dummy/BatchUpdateItems"
+	Dummy_BatchDeleteItems_FullMethodName = "/dummy.Dummy/BatchDeleteItems"
+	Dummy_ImportItems_FullMethodName      = "/dummy.Dummy/ImportItems"
+	Dummy_ListTrash_FullMethodName        = "/dummy.Dummy/ListTrash"
+	Dummy_RestoreItem_FullMethodName      = "/dummy.Dummy/RestoreItem"
+	Dummy_PurgeItem_FullMethodName        = "/dummy.Dummy/PurgeItem"
 )
 
 // DummyClient is the client API for Dummy service.
@@ -40,12 +58,59 @@ const (
 type DummyClient interface {
 	// CreateItem implements create_item.
 	CreateItem(ctx context.Context, in *CreateItemRequest, opts ...grpc.CallOption) (*CreateItemResponse, error)
-	// ListItems implements list_items.
+	// Pages through the items owned by or shared with the caller, newest first
+	// unless order is asc. Pass next_cursor as cursor to fetch the following page
+	// with the same filters and order
 	ListItems(ctx context.Context, in *ListItemsRequest, opts ...grpc.CallOption) (*ListItemsResponse, error)
-	// GetItem implements get_item.
+	// Full-text search over the name and description of the caller's items, ranked
+	// by relevance
+	SearchItems(ctx context.Context, in *SearchItemsRequest, opts ...grpc.CallOption) (*SearchItemsResponse, error)
+	// Fetches an item owned by or shared with the caller
 	GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error)
-	// DeleteItem implements delete_item.
+	// Replaces the name and description of an item. The update must name the
+	// version it is based on and fails with conflict if the item changed since
+	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error)
+	// Changes the given fields of an item. Like update_item it fails with conflict
+	// if the item changed since the given version
+	PatchItem(ctx context.Context, in *PatchItemRequest, opts ...grpc.CallOption) (*PatchItemResponse, error)
+	// Moves an item to the trash, from which it can be restored until it is purged
 	DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error)
+	// Shares an item with another user, or changes the permission it is shared
+	// with. Only the owner can share an item
+	ShareItem(ctx context.Context, in *ShareItemRequest, opts ...grpc.CallOption) (*ShareItemResponse, error)
+	// Stops sharing an item with a user. The owner can remove any share and users
+	// can remove their own
+	UnshareItem(ctx context.Context, in *UnshareItemRequest, opts ...grpc.CallOption) (*UnshareItemResponse, error)
+	// Lists the users an item is shared with. Only the owner can list them
+	ListItemShares(ctx context.Context, in *ListItemSharesRequest, opts ...grpc.CallOption) (*ListItemSharesResponse, error)
+	// Tags an item, creating the tag in the owner's tags if needed. Requires
+	// editor access
+	AddItemTag(ctx context.Context, in *AddItemTagRequest, opts ...grpc.CallOption) (*AddItemTagResponse, error)
+	// Removes a tag from an item. Requires editor access
+	RemoveItemTag(ctx context.Context, in *RemoveItemTagRequest, opts ...grpc.CallOption) (*RemoveItemTagResponse, error)
+	// Autocompletes the caller's tags, most used first
+	SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...grpc.CallOption) (*SuggestTagsResponse, error)
+	// Lists the attachments of an item, oldest first
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	// Deletes an attachment and its content. Requires editor access
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	// Creates up to 100 items, atomically unless atomic is false
+	BatchCreateItems(ctx context.Context, in *BatchCreateItemsRequest, opts ...grpc.CallOption) (*BatchCreateItemsResponse, error)
+	// Changes the given fields of up to 100 items like patch_item, atomically
+	// unless atomic is false
+	BatchUpdateItems(ctx context.Context, in *BatchUpdateItemsRequest, opts ...grpc.CallOption) (*BatchUpdateItemsResponse, error)
+	// Moves up to 100 items to the trash, atomically unless atomic is false
+	BatchDeleteItems(ctx context.Context, in *BatchDeleteItemsRequest, opts ...grpc.CallOption) (*BatchDeleteItemsResponse, error)
+	// Creates items streamed by the client, each on its own, and reports a summary
+	// once the stream is closed. gRPC only; the token is sent in the authorization
+	// metadata
+	ImportItems(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportItemsStreamingRequest, ImportItemsResponse], error)
+	// Lists the caller's trashed items, most recently deleted first
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// Moves an item out of the trash
+	RestoreItem(ctx context.Context, in *RestoreItemRequest, opts ...grpc.CallOption) (*RestoreItemResponse, error)
+	// Permanently deletes a trashed item
+	PurgeItem(ctx context.Context, in *PurgeItemRequest, opts ...grpc.CallOption) (*PurgeItemResponse, error)
 }
 
 type dummyClient struct {
@@ -76,6 +141,16 @@ func (c *dummyClient) ListItems(ctx context.Context, in *ListItemsRequest, opts
 	return out, nil
 }
 
+func (c *dummyClient) SearchItems(ctx context.Context, in *SearchItemsRequest, opts ...grpc.CallOption) (*SearchItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchItemsResponse)
+	err := c.cc.Invoke(ctx, Dummy_SearchItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dummyClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...grpc.CallOption) (*GetItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetItemResponse)
@@ -86,6 +161,26 @@ func (c *dummyClient) GetItem(ctx context.Context, in *GetItemRequest, opts ...g
 	return out, nil
 }
 
+func (c *dummyClient) UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*UpdateItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateItemResponse)
+	err := c.cc.Invoke(ctx, Dummy_UpdateItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) PatchItem(ctx context.Context, in *PatchItemRequest, opts ...grpc.CallOption) (*PatchItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PatchItemResponse)
+	err := c.cc.Invoke(ctx, Dummy_PatchItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dummyClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opts ...grpc.CallOption) (*DeleteItemResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteItemResponse)
@@ -96,6 +191,159 @@ func (c *dummyClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opt
 	return out, nil
 }
 
+func (c *dummyClient) ShareItem(ctx context.Context, in *ShareItemRequest, opts ...grpc.CallOption) (*ShareItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareItemResponse)
+	err := c.cc.Invoke(ctx, Dummy_ShareItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) UnshareItem(ctx context.Context, in *UnshareItemRequest, opts ...grpc.CallOption) (*UnshareItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnshareItemResponse)
+	err := c.cc.Invoke(ctx, Dummy_UnshareItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) ListItemShares(ctx context.Context, in *ListItemSharesRequest, opts ...grpc.CallOption) (*ListItemSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItemSharesResponse)
+	err := c.cc.Invoke(ctx, Dummy_ListItemShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) AddItemTag(ctx context.Context, in *AddItemTagRequest, opts ...grpc.CallOption) (*AddItemTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddItemTagResponse)
+	err := c.cc.Invoke(ctx, Dummy_AddItemTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) RemoveItemTag(ctx context.Context, in *RemoveItemTagRequest, opts ...grpc.CallOption) (*RemoveItemTagResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveItemTagResponse)
+	err := c.cc.Invoke(ctx, Dummy_RemoveItemTag_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) SuggestTags(ctx context.Context, in *SuggestTagsRequest, opts ...grpc.CallOption) (*SuggestTagsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuggestTagsResponse)
+	err := c.cc.Invoke(ctx, Dummy_SuggestTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, Dummy_ListAttachments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, Dummy_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) BatchCreateItems(ctx context.Context, in *BatchCreateItemsRequest, opts ...grpc.CallOption) (*BatchCreateItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCreateItemsResponse)
+	err := c.cc.Invoke(ctx, Dummy_BatchCreateItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) BatchUpdateItems(ctx context.Context, in *BatchUpdateItemsRequest, opts ...grpc.CallOption) (*BatchUpdateItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchUpdateItemsResponse)
+	err := c.cc.Invoke(ctx, Dummy_BatchUpdateItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) BatchDeleteItems(ctx context.Context, in *BatchDeleteItemsRequest, opts ...grpc.CallOption) (*BatchDeleteItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDeleteItemsResponse)
+	err := c.cc.Invoke(ctx, Dummy_BatchDeleteItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) ImportItems(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportItemsStreamingRequest, ImportItemsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dummy_ServiceDesc.Streams[0], Dummy_ImportItems_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportItemsStreamingRequest, ImportItemsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Dummy_ImportItemsClient = grpc.ClientStreamingClient[ImportItemsStreamingRequest, ImportItemsResponse]
+
+func (c *dummyClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, Dummy_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) RestoreItem(ctx context.Context, in *RestoreItemRequest, opts ...grpc.CallOption) (*RestoreItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreItemResponse)
+	err := c.cc.Invoke(ctx, Dummy_RestoreItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dummyClient) PurgeItem(ctx context.Context, in *PurgeItemRequest, opts ...grpc.CallOption) (*PurgeItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeItemResponse)
+	err := c.cc.Invoke(ctx, Dummy_PurgeItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DummyServer is the server API for Dummy service.
 // All implementations must embed UnimplementedDummyServer
 // for forward compatibility.
@@ -104,12 +352,59 @@ func (c *dummyClient) DeleteItem(ctx context.Context, in *DeleteItemRequest, opt
 type DummyServer interface {
 	// CreateItem implements create_item.
 	CreateItem(context.Context, *CreateItemRequest) (*CreateItemResponse, error)
-	// ListItems implements list_items.
+	// Pages through the items owned by or shared with the caller, newest first
+	// unless order is asc. Pass next_cursor as cursor to fetch the following page
+	// with the same filters and order
 	ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error)
-	// GetItem implements get_item.
+	// Full-text search over the name and description of the caller's items, ranked
+	// by relevance
+	SearchItems(context.Context, *SearchItemsRequest) (*SearchItemsResponse, error)
+	// Fetches an item owned by or shared with the caller
 	GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error)
-	// DeleteItem implements delete_item.
+	// Replaces the name and description of an item. The update must name the
+	// version it is based on and fails with conflict if the item changed since
+	UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error)
+	// Changes the given fields of an item. Like update_item it fails with conflict
+	// if the item changed since the given version
+	PatchItem(context.Context, *PatchItemRequest) (*PatchItemResponse, error)
+	// Moves an item to the trash, from which it can be restored until it is purged
 	DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error)
+	// Shares an item with another user, or changes the permission it is shared
+	// with. Only the owner can share an item
+	ShareItem(context.Context, *ShareItemRequest) (*ShareItemResponse, error)
+	// Stops sharing an item with a user. The owner can remove any share and users
+	// can remove their own
+	UnshareItem(context.Context, *UnshareItemRequest) (*UnshareItemResponse, error)
+	// Lists the users an item is shared with. Only the owner can list them
+	ListItemShares(context.Context, *ListItemSharesRequest) (*ListItemSharesResponse, error)
+	// Tags an item, creating the tag in the owner's tags if needed. Requires
+	// editor access
+	AddItemTag(context.Context, *AddItemTagRequest) (*AddItemTagResponse, error)
+	// Removes a tag from an item. Requires editor access
+	RemoveItemTag(context.Context, *RemoveItemTagRequest) (*RemoveItemTagResponse, error)
+	// Autocompletes the caller's tags, most used first
+	SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsResponse, error)
+	// Lists the attachments of an item, oldest first
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	// Deletes an attachment and its content. Requires editor access
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	// Creates up to 100 items, atomically unless atomic is false
+	BatchCreateItems(context.Context, *BatchCreateItemsRequest) (*BatchCreateItemsResponse, error)
+	// Changes the given fields of up to 100 items like patch_item, atomically
+	// unless atomic is false
+	BatchUpdateItems(context.Context, *BatchUpdateItemsRequest) (*BatchUpdateItemsResponse, error)
+	// Moves up to 100 items to the trash, atomically unless atomic is false
+	BatchDeleteItems(context.Context, *BatchDeleteItemsRequest) (*BatchDeleteItemsResponse, error)
+	// Creates items streamed by the client, each on its own, and reports a summary
+	// once the stream is closed. gRPC only; the token is sent in the authorization
+	// metadata
+	ImportItems(grpc.ClientStreamingServer[ImportItemsStreamingRequest, ImportItemsResponse]) error
+	// Lists the caller's trashed items, most recently deleted first
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// Moves an item out of the trash
+	RestoreItem(context.Context, *RestoreItemRequest) (*RestoreItemResponse, error)
+	// Permanently deletes a trashed item
+	PurgeItem(context.Context, *PurgeItemRequest) (*PurgeItemResponse, error)
 	mustEmbedUnimplementedDummyServer()
 }
 
@@ -126,12 +421,66 @@ func (UnimplementedDummyServer) CreateItem(context.Context, *CreateItemRequest)
 func (UnimplementedDummyServer) ListItems(context.Context, *ListItemsRequest) (*ListItemsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
 }
+func (UnimplementedDummyServer) SearchItems(context.Context, *SearchItemsRequest) (*SearchItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchItems not implemented")
+}
 func (UnimplementedDummyServer) GetItem(context.Context, *GetItemRequest) (*GetItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetItem not implemented")
 }
+func (UnimplementedDummyServer) UpdateItem(context.Context, *UpdateItemRequest) (*UpdateItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateItem not implemented")
+}
+func (UnimplementedDummyServer) PatchItem(context.Context, *PatchItemRequest) (*PatchItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchItem not implemented")
+}
 func (UnimplementedDummyServer) DeleteItem(context.Context, *DeleteItemRequest) (*DeleteItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteItem not implemented")
 }
+func (UnimplementedDummyServer) ShareItem(context.Context, *ShareItemRequest) (*ShareItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareItem not implemented")
+}
+func (UnimplementedDummyServer) UnshareItem(context.Context, *UnshareItemRequest) (*UnshareItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareItem not implemented")
+}
+func (UnimplementedDummyServer) ListItemShares(context.Context, *ListItemSharesRequest) (*ListItemSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemShares not implemented")
+}
+func (UnimplementedDummyServer) AddItemTag(context.Context, *AddItemTagRequest) (*AddItemTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddItemTag not implemented")
+}
+func (UnimplementedDummyServer) RemoveItemTag(context.Context, *RemoveItemTagRequest) (*RemoveItemTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveItemTag not implemented")
+}
+func (UnimplementedDummyServer) SuggestTags(context.Context, *SuggestTagsRequest) (*SuggestTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestTags not implemented")
+}
+func (UnimplementedDummyServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedDummyServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedDummyServer) BatchCreateItems(context.Context, *BatchCreateItemsRequest) (*BatchCreateItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateItems not implemented")
+}
+func (UnimplementedDummyServer) BatchUpdateItems(context.Context, *BatchUpdateItemsRequest) (*BatchUpdateItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateItems not implemented")
+}
+func (UnimplementedDummyServer) BatchDeleteItems(context.Context, *BatchDeleteItemsRequest) (*BatchDeleteItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteItems not implemented")
+}
+func (UnimplementedDummyServer) ImportItems(grpc.ClientStreamingServer[ImportItemsStreamingRequest, ImportItemsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportItems not implemented")
+}
+func (UnimplementedDummyServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedDummyServer) RestoreItem(context.Context, *RestoreItemRequest) (*RestoreItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreItem not implemented")
+}
+func (UnimplementedDummyServer) PurgeItem(context.Context, *PurgeItemRequest) (*PurgeItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeItem not implemented")
+}
 func (UnimplementedDummyServer) mustEmbedUnimplementedDummyServer() {}
 func (UnimplementedDummyServer) testEmbeddedByValue()               {}
 
@@ -189,6 +538,24 @@ func _Dummy_ListItems_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dummy_SearchItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).SearchItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_SearchItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).SearchItems(ctx, req.(*SearchItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dummy_GetItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetItemRequest)
 	if err := dec(in); err != nil {
@@ -207,6 +574,42 @@ func _Dummy_GetItem_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dummy_UpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).UpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_UpdateItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).UpdateItem(ctx, req.(*UpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_PatchItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).PatchItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_PatchItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).PatchItem(ctx, req.(*PatchItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dummy_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteItemRequest)
 	if err := dec(in); err != nil {
@@ -225,6 +628,265 @@ func _Dummy_DeleteItem_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dummy_ShareItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).ShareItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_ShareItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).ShareItem(ctx, req.(*ShareItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_UnshareItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).UnshareItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_UnshareItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).UnshareItem(ctx, req.(*UnshareItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_ListItemShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).ListItemShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_ListItemShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).ListItemShares(ctx, req.(*ListItemSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_AddItemTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddItemTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).AddItemTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_AddItemTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).AddItemTag(ctx, req.(*AddItemTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_RemoveItemTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItemTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).RemoveItemTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_RemoveItemTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).RemoveItemTag(ctx, req.(*RemoveItemTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_SuggestTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).SuggestTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_SuggestTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).SuggestTags(ctx, req.(*SuggestTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_BatchCreateItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).BatchCreateItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_BatchCreateItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).BatchCreateItems(ctx, req.(*BatchCreateItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_BatchUpdateItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).BatchUpdateItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_BatchUpdateItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).BatchUpdateItems(ctx, req.(*BatchUpdateItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_BatchDeleteItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).BatchDeleteItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_BatchDeleteItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).BatchDeleteItems(ctx, req.(*BatchDeleteItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_ImportItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DummyServer).ImportItems(&grpc.GenericServerStream[ImportItemsStreamingRequest, ImportItemsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Dummy_ImportItemsServer = grpc.ClientStreamingServer[ImportItemsStreamingRequest, ImportItemsResponse]
+
+func _Dummy_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_RestoreItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).RestoreItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_RestoreItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).RestoreItem(ctx, req.(*RestoreItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dummy_PurgeItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DummyServer).PurgeItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dummy_PurgeItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DummyServer).PurgeItem(ctx, req.(*PurgeItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dummy_ServiceDesc is the grpc.ServiceDesc for Dummy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -240,15 +902,89 @@ var Dummy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListItems",
 			Handler:    _Dummy_ListItems_Handler,
 		},
+		{
+			MethodName: "SearchItems",
+			Handler:    _Dummy_SearchItems_Handler,
+		},
 		{
 			MethodName: "GetItem",
 			Handler:    _Dummy_GetItem_Handler,
 		},
+		{
+			MethodName: "UpdateItem",
+			Handler:    _Dummy_UpdateItem_Handler,
+		},
+		{
+			MethodName: "PatchItem",
+			Handler:    _Dummy_PatchItem_Handler,
+		},
 		{
 			MethodName: "DeleteItem",
 			Handler:    _Dummy_DeleteItem_Handler,
 		},
+		{
+			MethodName: "ShareItem",
+			Handler:    _Dummy_ShareItem_Handler,
+		},
+		{
+			MethodName: "UnshareItem",
+			Handler:    _Dummy_UnshareItem_Handler,
+		},
+		{
+			MethodName: "ListItemShares",
+			Handler:    _Dummy_ListItemShares_Handler,
+		},
+		{
+			MethodName: "AddItemTag",
+			Handler:    _Dummy_AddItemTag_Handler,
+		},
+		{
+			MethodName: "RemoveItemTag",
+			Handler:    _Dummy_RemoveItemTag_Handler,
+		},
+		{
+			MethodName: "SuggestTags",
+			Handler:    _Dummy_SuggestTags_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _Dummy_ListAttachments_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _Dummy_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "BatchCreateItems",
+			Handler:    _Dummy_BatchCreateItems_Handler,
+		},
+		{
+			MethodName: "BatchUpdateItems",
+			Handler:    _Dummy_BatchUpdateItems_Handler,
+		},
+		{
+			MethodName: "BatchDeleteItems",
+			Handler:    _Dummy_BatchDeleteItems_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _Dummy_ListTrash_Handler,
+		},
+		{
+			MethodName: "RestoreItem",
+			Handler:    _Dummy_RestoreItem_Handler,
+		},
+		{
+			MethodName: "PurgeItem",
+			Handler:    _Dummy_PurgeItem_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportItems",
+			Handler:       _Dummy_ImportItems_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "goagen_dummy-api_dummy.proto",
 }
@@ -14,6 +14,7 @@ import (
 	dummyviews "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy/views"
 	dummypb "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/grpc/dummy/pb"
 	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -41,6 +42,9 @@ func DecodeCreateItemRequest(ctx context.Context, v any, md metadata.MD) (any, e
 		if message, ok = v.(*dummypb.CreateItemRequest); !ok {
 			return nil, goagrpc.ErrInvalidType("dummy", "create_item", "*dummypb.CreateItemRequest", v)
 		}
+		if err := ValidateCreateItemRequest(message); err != nil {
+			return nil, err
+		}
 	}
 	var payload *dummy.CreateItemPayload
 	{
@@ -71,6 +75,9 @@ func DecodeListItemsRequest(ctx context.Context, v any, md metadata.MD) (any, er
 		if message, ok = v.(*dummypb.ListItemsRequest); !ok {
 			return nil, goagrpc.ErrInvalidType("dummy", "list_items", "*dummypb.ListItemsRequest", v)
 		}
+		if err := ValidateListItemsRequest(message); err != nil {
+			return nil, err
+		}
 	}
 	var payload *dummy.ListItemsPayload
 	{
@@ -79,6 +86,39 @@ func DecodeListItemsRequest(ctx context.Context, v any, md metadata.MD) (any, er
 	return payload, nil
 }
 
+// EncodeSearchItemsResponse encodes responses from the "dummy" service
+// "search_items" endpoint.
+func EncodeSearchItemsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.SearchResultsCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "search_items", "*dummy.SearchResultsCollection", v)
+	}
+	resp := NewProtoSearchItemsResponse(result)
+	return resp, nil
+}
+
+// DecodeSearchItemsRequest decodes requests sent to "dummy" service
+// "search_items" endpoint.
+func DecodeSearchItemsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.SearchItemsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.SearchItemsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "search_items", "*dummypb.SearchItemsRequest", v)
+		}
+		if err := ValidateSearchItemsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.SearchItemsPayload
+	{
+		payload = NewSearchItemsPayload(message)
+	}
+	return payload, nil
+}
+
 // EncodeGetItemResponse encodes responses from the "dummy" service "get_item"
 // endpoint.
 func EncodeGetItemResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
@@ -111,6 +151,73 @@ func DecodeGetItemRequest(ctx context.Context, v any, md metadata.MD) (any, erro
 	return payload, nil
 }
 
+// EncodeUpdateItemResponse encodes responses from the "dummy" service
+// "update_item" endpoint.
+func EncodeUpdateItemResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*dummyviews.Item)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "update_item", "*dummyviews.Item", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoUpdateItemResponse(result)
+	return resp, nil
+}
+
+// DecodeUpdateItemRequest decodes requests sent to "dummy" service
+// "update_item" endpoint.
+func DecodeUpdateItemRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.UpdateItemRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.UpdateItemRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "update_item", "*dummypb.UpdateItemRequest", v)
+		}
+	}
+	var payload *dummy.UpdateItemPayload
+	{
+		payload = NewUpdateItemPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodePatchItemResponse encodes responses from the "dummy" service
+// "patch_item" endpoint.
+func EncodePatchItemResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*dummyviews.Item)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "patch_item", "*dummyviews.Item", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoPatchItemResponse(result)
+	return resp, nil
+}
+
+// DecodePatchItemRequest decodes requests sent to "dummy" service "patch_item"
+// endpoint.
+func DecodePatchItemRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.PatchItemRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.PatchItemRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "patch_item", "*dummypb.PatchItemRequest", v)
+		}
+		if err := ValidatePatchItemRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.PatchItemPayload
+	{
+		payload = NewPatchItemPayload(message)
+	}
+	return payload, nil
+}
+
 // EncodeDeleteItemResponse encodes responses from the "dummy" service
 // "delete_item" endpoint.
 func EncodeDeleteItemResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
@@ -136,3 +243,476 @@ func DecodeDeleteItemRequest(ctx context.Context, v any, md metadata.MD) (any, e
 	}
 	return payload, nil
 }
+
+// EncodeShareItemResponse encodes responses from the "dummy" service
+// "share_item" endpoint.
+func EncodeShareItemResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.ItemShare)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "share_item", "*dummy.ItemShare", v)
+	}
+	resp := NewProtoShareItemResponse(result)
+	return resp, nil
+}
+
+// DecodeShareItemRequest decodes requests sent to "dummy" service "share_item"
+// endpoint.
+func DecodeShareItemRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.ShareItemRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.ShareItemRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "share_item", "*dummypb.ShareItemRequest", v)
+		}
+		if err := ValidateShareItemRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.ShareItemPayload
+	{
+		payload = NewShareItemPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeUnshareItemResponse encodes responses from the "dummy" service
+// "unshare_item" endpoint.
+func EncodeUnshareItemResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoUnshareItemResponse()
+	return resp, nil
+}
+
+// DecodeUnshareItemRequest decodes requests sent to "dummy" service
+// "unshare_item" endpoint.
+func DecodeUnshareItemRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.UnshareItemRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.UnshareItemRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "unshare_item", "*dummypb.UnshareItemRequest", v)
+		}
+	}
+	var payload *dummy.UnshareItemPayload
+	{
+		payload = NewUnshareItemPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeListItemSharesResponse encodes responses from the "dummy" service
+// "list_item_shares" endpoint.
+func EncodeListItemSharesResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.ItemSharesCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_item_shares", "*dummy.ItemSharesCollection", v)
+	}
+	resp := NewProtoListItemSharesResponse(result)
+	return resp, nil
+}
+
+// DecodeListItemSharesRequest decodes requests sent to "dummy" service
+// "list_item_shares" endpoint.
+func DecodeListItemSharesRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.ListItemSharesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.ListItemSharesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "list_item_shares", "*dummypb.ListItemSharesRequest", v)
+		}
+	}
+	var payload *dummy.ItemIDPayload
+	{
+		payload = NewListItemSharesPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeAddItemTagResponse encodes responses from the "dummy" service
+// "add_item_tag" endpoint.
+func EncodeAddItemTagResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*dummyviews.Item)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "add_item_tag", "*dummyviews.Item", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoAddItemTagResponse(result)
+	return resp, nil
+}
+
+// DecodeAddItemTagRequest decodes requests sent to "dummy" service
+// "add_item_tag" endpoint.
+func DecodeAddItemTagRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.AddItemTagRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.AddItemTagRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "add_item_tag", "*dummypb.AddItemTagRequest", v)
+		}
+		if err := ValidateAddItemTagRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.ItemTagPayload
+	{
+		payload = NewAddItemTagPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeRemoveItemTagResponse encodes responses from the "dummy" service
+// "remove_item_tag" endpoint.
+func EncodeRemoveItemTagResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*dummyviews.Item)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "remove_item_tag", "*dummyviews.Item", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoRemoveItemTagResponse(result)
+	return resp, nil
+}
+
+// DecodeRemoveItemTagRequest decodes requests sent to "dummy" service
+// "remove_item_tag" endpoint.
+func DecodeRemoveItemTagRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.RemoveItemTagRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.RemoveItemTagRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "remove_item_tag", "*dummypb.RemoveItemTagRequest", v)
+		}
+		if err := ValidateRemoveItemTagRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.ItemTagPayload
+	{
+		payload = NewRemoveItemTagPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeSuggestTagsResponse encodes responses from the "dummy" service
+// "suggest_tags" endpoint.
+func EncodeSuggestTagsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.TagSuggestions)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "suggest_tags", "*dummy.TagSuggestions", v)
+	}
+	resp := NewProtoSuggestTagsResponse(result)
+	return resp, nil
+}
+
+// DecodeSuggestTagsRequest decodes requests sent to "dummy" service
+// "suggest_tags" endpoint.
+func DecodeSuggestTagsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.SuggestTagsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.SuggestTagsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "suggest_tags", "*dummypb.SuggestTagsRequest", v)
+		}
+		if err := ValidateSuggestTagsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.SuggestTagsPayload
+	{
+		payload = NewSuggestTagsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeListAttachmentsResponse encodes responses from the "dummy" service
+// "list_attachments" endpoint.
+func EncodeListAttachmentsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.AttachmentsCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_attachments", "*dummy.AttachmentsCollection", v)
+	}
+	resp := NewProtoListAttachmentsResponse(result)
+	return resp, nil
+}
+
+// DecodeListAttachmentsRequest decodes requests sent to "dummy" service
+// "list_attachments" endpoint.
+func DecodeListAttachmentsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.ListAttachmentsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.ListAttachmentsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "list_attachments", "*dummypb.ListAttachmentsRequest", v)
+		}
+	}
+	var payload *dummy.ItemIDPayload
+	{
+		payload = NewListAttachmentsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeDeleteAttachmentResponse encodes responses from the "dummy" service
+// "delete_attachment" endpoint.
+func EncodeDeleteAttachmentResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoDeleteAttachmentResponse()
+	return resp, nil
+}
+
+// DecodeDeleteAttachmentRequest decodes requests sent to "dummy" service
+// "delete_attachment" endpoint.
+func DecodeDeleteAttachmentRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.DeleteAttachmentRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.DeleteAttachmentRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "delete_attachment", "*dummypb.DeleteAttachmentRequest", v)
+		}
+	}
+	var payload *dummy.AttachmentIDPayload
+	{
+		payload = NewDeleteAttachmentPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeBatchCreateItemsResponse encodes responses from the "dummy" service
+// "batch_create_items" endpoint.
+func EncodeBatchCreateItemsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.BatchResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_create_items", "*dummy.BatchResult", v)
+	}
+	resp := NewProtoBatchCreateItemsResponse(result)
+	return resp, nil
+}
+
+// DecodeBatchCreateItemsRequest decodes requests sent to "dummy" service
+// "batch_create_items" endpoint.
+func DecodeBatchCreateItemsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.BatchCreateItemsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.BatchCreateItemsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "batch_create_items", "*dummypb.BatchCreateItemsRequest", v)
+		}
+		if err := ValidateBatchCreateItemsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.BatchCreateItemsPayload
+	{
+		payload = NewBatchCreateItemsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeBatchUpdateItemsResponse encodes responses from the "dummy" service
+// "batch_update_items" endpoint.
+func EncodeBatchUpdateItemsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.BatchResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_update_items", "*dummy.BatchResult", v)
+	}
+	resp := NewProtoBatchUpdateItemsResponse(result)
+	return resp, nil
+}
+
+// DecodeBatchUpdateItemsRequest decodes requests sent to "dummy" service
+// "batch_update_items" endpoint.
+func DecodeBatchUpdateItemsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.BatchUpdateItemsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.BatchUpdateItemsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "batch_update_items", "*dummypb.BatchUpdateItemsRequest", v)
+		}
+		if err := ValidateBatchUpdateItemsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.BatchUpdateItemsPayload
+	{
+		payload = NewBatchUpdateItemsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeBatchDeleteItemsResponse encodes responses from the "dummy" service
+// "batch_delete_items" endpoint.
+func EncodeBatchDeleteItemsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.BatchResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "batch_delete_items", "*dummy.BatchResult", v)
+	}
+	resp := NewProtoBatchDeleteItemsResponse(result)
+	return resp, nil
+}
+
+// DecodeBatchDeleteItemsRequest decodes requests sent to "dummy" service
+// "batch_delete_items" endpoint.
+func DecodeBatchDeleteItemsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.BatchDeleteItemsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.BatchDeleteItemsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "batch_delete_items", "*dummypb.BatchDeleteItemsRequest", v)
+		}
+		if err := ValidateBatchDeleteItemsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.BatchDeleteItemsPayload
+	{
+		payload = NewBatchDeleteItemsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeImportItemsResponse encodes responses from the "dummy" service
+// "import_items" endpoint.
+func EncodeImportItemsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.ImportSummary)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "import_items", "*dummy.ImportSummary", v)
+	}
+	resp := NewProtoImportItemsResponse(result)
+	return resp, nil
+}
+
+// DecodeImportItemsRequest decodes requests sent to "dummy" service
+// "import_items" endpoint.
+func DecodeImportItemsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		token string
+		err   error
+	)
+	{
+		if vals := md.Get("authorization"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("authorization", "metadata"))
+		} else {
+			token = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var payload *dummy.AuthenticatedPayload
+	{
+		payload = NewImportItemsPayload(token)
+	}
+	return payload, nil
+}
+
+// EncodeListTrashResponse encodes responses from the "dummy" service
+// "list_trash" endpoint.
+func EncodeListTrashResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*dummy.ItemsCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "list_trash", "*dummy.ItemsCollection", v)
+	}
+	resp := NewProtoListTrashResponse(result)
+	return resp, nil
+}
+
+// DecodeListTrashRequest decodes requests sent to "dummy" service "list_trash"
+// endpoint.
+func DecodeListTrashRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.ListTrashRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.ListTrashRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "list_trash", "*dummypb.ListTrashRequest", v)
+		}
+		if err := ValidateListTrashRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *dummy.ListTrashPayload
+	{
+		payload = NewListTrashPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeRestoreItemResponse encodes responses from the "dummy" service
+// "restore_item" endpoint.
+func EncodeRestoreItemResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*dummyviews.Item)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("dummy", "restore_item", "*dummyviews.Item", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoRestoreItemResponse(result)
+	return resp, nil
+}
+
+// DecodeRestoreItemRequest decodes requests sent to "dummy" service
+// "restore_item" endpoint.
+func DecodeRestoreItemRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.RestoreItemRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.RestoreItemRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "restore_item", "*dummypb.RestoreItemRequest", v)
+		}
+	}
+	var payload *dummy.ItemIDPayload
+	{
+		payload = NewRestoreItemPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodePurgeItemResponse encodes responses from the "dummy" service
+// "purge_item" endpoint.
+func EncodePurgeItemResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoPurgeItemResponse()
+	return resp, nil
+}
+
+// DecodePurgeItemRequest decodes requests sent to "dummy" service "purge_item"
+// endpoint.
+func DecodePurgeItemRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *dummypb.PurgeItemRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*dummypb.PurgeItemRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("dummy", "purge_item", "*dummypb.PurgeItemRequest", v)
+		}
+	}
+	var payload *dummy.ItemIDPayload
+	{
+		payload = NewPurgeItemPayload(message)
+	}
+	return payload, nil
+}
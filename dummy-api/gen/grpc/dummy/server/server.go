@@ -9,29 +9,73 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	dummypb "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/grpc/dummy/pb"
 	goagrpc "goa.design/goa/v3/grpc"
 	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/codes"
 )
 
 // Server implements the dummypb.DummyServer interface.
 type Server struct {
-	CreateItemH goagrpc.UnaryHandler
-	ListItemsH  goagrpc.UnaryHandler
-	GetItemH    goagrpc.UnaryHandler
-	DeleteItemH goagrpc.UnaryHandler
+	CreateItemH       goagrpc.UnaryHandler
+	ListItemsH        goagrpc.UnaryHandler
+	SearchItemsH      goagrpc.UnaryHandler
+	GetItemH          goagrpc.UnaryHandler
+	UpdateItemH       goagrpc.UnaryHandler
+	PatchItemH        goagrpc.UnaryHandler
+	DeleteItemH       goagrpc.UnaryHandler
+	ShareItemH        goagrpc.UnaryHandler
+	UnshareItemH      goagrpc.UnaryHandler
+	ListItemSharesH   goagrpc.UnaryHandler
+	AddItemTagH       goagrpc.UnaryHandler
+	RemoveItemTagH    goagrpc.UnaryHandler
+	SuggestTagsH      goagrpc.UnaryHandler
+	ListAttachmentsH  goagrpc.UnaryHandler
+	DeleteAttachmentH goagrpc.UnaryHandler
+	BatchCreateItemsH goagrpc.UnaryHandler
+	BatchUpdateItemsH goagrpc.UnaryHandler
+	BatchDeleteItemsH goagrpc.UnaryHandler
+	ImportItemsH      goagrpc.StreamHandler
+	ListTrashH        goagrpc.UnaryHandler
+	RestoreItemH      goagrpc.UnaryHandler
+	PurgeItemH        goagrpc.UnaryHandler
 	dummypb.UnimplementedDummyServer
 }
 
+// ImportItemsServerStream implements the dummy.ImportItemsServerStream
+// interface.
+type ImportItemsServerStream struct {
+	stream dummypb.Dummy_ImportItemsServer
+}
+
 // New instantiates the server struct with the dummy service endpoints.
-func New(e *dummy.Endpoints, uh goagrpc.UnaryHandler) *Server {
+func New(e *dummy.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
 	return &Server{
-		CreateItemH: NewCreateItemHandler(e.CreateItem, uh),
-		ListItemsH:  NewListItemsHandler(e.ListItems, uh),
-		GetItemH:    NewGetItemHandler(e.GetItem, uh),
-		DeleteItemH: NewDeleteItemHandler(e.DeleteItem, uh),
+		CreateItemH:       NewCreateItemHandler(e.CreateItem, uh),
+		ListItemsH:        NewListItemsHandler(e.ListItems, uh),
+		SearchItemsH:      NewSearchItemsHandler(e.SearchItems, uh),
+		GetItemH:          NewGetItemHandler(e.GetItem, uh),
+		UpdateItemH:       NewUpdateItemHandler(e.UpdateItem, uh),
+		PatchItemH:        NewPatchItemHandler(e.PatchItem, uh),
+		DeleteItemH:       NewDeleteItemHandler(e.DeleteItem, uh),
+		ShareItemH:        NewShareItemHandler(e.ShareItem, uh),
+		UnshareItemH:      NewUnshareItemHandler(e.UnshareItem, uh),
+		ListItemSharesH:   NewListItemSharesHandler(e.ListItemShares, uh),
+		AddItemTagH:       NewAddItemTagHandler(e.AddItemTag, uh),
+		RemoveItemTagH:    NewRemoveItemTagHandler(e.RemoveItemTag, uh),
+		SuggestTagsH:      NewSuggestTagsHandler(e.SuggestTags, uh),
+		ListAttachmentsH:  NewListAttachmentsHandler(e.ListAttachments, uh),
+		DeleteAttachmentH: NewDeleteAttachmentHandler(e.DeleteAttachment, uh),
+		BatchCreateItemsH: NewBatchCreateItemsHandler(e.BatchCreateItems, uh),
+		BatchUpdateItemsH: NewBatchUpdateItemsHandler(e.BatchUpdateItems, uh),
+		BatchDeleteItemsH: NewBatchDeleteItemsHandler(e.BatchDeleteItems, uh),
+		ImportItemsH:      NewImportItemsHandler(e.ImportItems, sh),
+		ListTrashH:        NewListTrashHandler(e.ListTrash, uh),
+		RestoreItemH:      NewRestoreItemHandler(e.RestoreItem, uh),
+		PurgeItemH:        NewPurgeItemHandler(e.PurgeItem, uh),
 	}
 }
 
@@ -71,11 +115,50 @@ func (s *Server) ListItems(ctx context.Context, message *dummypb.ListItemsReques
 	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
 	resp, err := s.ListItemsH.Handle(ctx, message)
 	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_cursor":
+				var er *dummy.DummyBadRequestError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewListItemsInvalidCursorError(er))
+			}
+		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*dummypb.ListItemsResponse), nil
 }
 
+// NewSearchItemsHandler creates a gRPC handler which serves the "dummy"
+// service "search_items" endpoint.
+func NewSearchItemsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeSearchItemsRequest, EncodeSearchItemsResponse)
+	}
+	return h
+}
+
+// SearchItems implements the "SearchItems" method in dummypb.DummyServer
+// interface.
+func (s *Server) SearchItems(ctx context.Context, message *dummypb.SearchItemsRequest) (*dummypb.SearchItemsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "search_items")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.SearchItemsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_cursor":
+				var er *dummy.DummyBadRequestError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewSearchItemsInvalidCursorError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.SearchItemsResponse), nil
+}
+
 // NewGetItemHandler creates a gRPC handler which serves the "dummy" service
 // "get_item" endpoint.
 func NewGetItemHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
@@ -91,11 +174,103 @@ func (s *Server) GetItem(ctx context.Context, message *dummypb.GetItemRequest) (
 	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
 	resp, err := s.GetItemH.Handle(ctx, message)
 	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewGetItemNotFoundError(er))
+			}
+		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*dummypb.GetItemResponse), nil
 }
 
+// NewUpdateItemHandler creates a gRPC handler which serves the "dummy" service
+// "update_item" endpoint.
+func NewUpdateItemHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeUpdateItemRequest, EncodeUpdateItemResponse)
+	}
+	return h
+}
+
+// UpdateItem implements the "UpdateItem" method in dummypb.DummyServer
+// interface.
+func (s *Server) UpdateItem(ctx context.Context, message *dummypb.UpdateItemRequest) (*dummypb.UpdateItemResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "update_item")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.UpdateItemH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "conflict":
+				var er *dummy.DummyConflictError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Aborted, err, NewUpdateItemConflictError(er))
+			case "precondition_required":
+				var er *dummy.DummyPreconditionRequiredError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, NewUpdateItemPreconditionRequiredError(er))
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewUpdateItemNotFoundError(er))
+			case "forbidden":
+				var er *dummy.DummyForbiddenError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewUpdateItemForbiddenError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.UpdateItemResponse), nil
+}
+
+// NewPatchItemHandler creates a gRPC handler which serves the "dummy" service
+// "patch_item" endpoint.
+func NewPatchItemHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePatchItemRequest, EncodePatchItemResponse)
+	}
+	return h
+}
+
+// PatchItem implements the "PatchItem" method in dummypb.DummyServer interface.
+func (s *Server) PatchItem(ctx context.Context, message *dummypb.PatchItemRequest) (*dummypb.PatchItemResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "patch_item")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.PatchItemH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "conflict":
+				var er *dummy.DummyConflictError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Aborted, err, NewPatchItemConflictError(er))
+			case "precondition_required":
+				var er *dummy.DummyPreconditionRequiredError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, NewPatchItemPreconditionRequiredError(er))
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewPatchItemNotFoundError(er))
+			case "forbidden":
+				var er *dummy.DummyForbiddenError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewPatchItemForbiddenError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.PatchItemResponse), nil
+}
+
 // NewDeleteItemHandler creates a gRPC handler which serves the "dummy" service
 // "delete_item" endpoint.
 func NewDeleteItemHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
@@ -112,7 +287,484 @@ func (s *Server) DeleteItem(ctx context.Context, message *dummypb.DeleteItemRequ
 	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
 	resp, err := s.DeleteItemH.Handle(ctx, message)
 	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewDeleteItemNotFoundError(er))
+			}
+		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*dummypb.DeleteItemResponse), nil
 }
+
+// NewShareItemHandler creates a gRPC handler which serves the "dummy" service
+// "share_item" endpoint.
+func NewShareItemHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeShareItemRequest, EncodeShareItemResponse)
+	}
+	return h
+}
+
+// ShareItem implements the "ShareItem" method in dummypb.DummyServer interface.
+func (s *Server) ShareItem(ctx context.Context, message *dummypb.ShareItemRequest) (*dummypb.ShareItemResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "share_item")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.ShareItemH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewShareItemNotFoundError(er))
+			case "forbidden":
+				var er *dummy.DummyForbiddenError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewShareItemForbiddenError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.ShareItemResponse), nil
+}
+
+// NewUnshareItemHandler creates a gRPC handler which serves the "dummy"
+// service "unshare_item" endpoint.
+func NewUnshareItemHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeUnshareItemRequest, EncodeUnshareItemResponse)
+	}
+	return h
+}
+
+// UnshareItem implements the "UnshareItem" method in dummypb.DummyServer
+// interface.
+func (s *Server) UnshareItem(ctx context.Context, message *dummypb.UnshareItemRequest) (*dummypb.UnshareItemResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "unshare_item")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.UnshareItemH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewUnshareItemNotFoundError(er))
+			case "forbidden":
+				var er *dummy.DummyForbiddenError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewUnshareItemForbiddenError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.UnshareItemResponse), nil
+}
+
+// NewListItemSharesHandler creates a gRPC handler which serves the "dummy"
+// service "list_item_shares" endpoint.
+func NewListItemSharesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListItemSharesRequest, EncodeListItemSharesResponse)
+	}
+	return h
+}
+
+// ListItemShares implements the "ListItemShares" method in dummypb.DummyServer
+// interface.
+func (s *Server) ListItemShares(ctx context.Context, message *dummypb.ListItemSharesRequest) (*dummypb.ListItemSharesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list_item_shares")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.ListItemSharesH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewListItemSharesNotFoundError(er))
+			case "forbidden":
+				var er *dummy.DummyForbiddenError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewListItemSharesForbiddenError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.ListItemSharesResponse), nil
+}
+
+// NewAddItemTagHandler creates a gRPC handler which serves the "dummy" service
+// "add_item_tag" endpoint.
+func NewAddItemTagHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeAddItemTagRequest, EncodeAddItemTagResponse)
+	}
+	return h
+}
+
+// AddItemTag implements the "AddItemTag" method in dummypb.DummyServer
+// interface.
+func (s *Server) AddItemTag(ctx context.Context, message *dummypb.AddItemTagRequest) (*dummypb.AddItemTagResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "add_item_tag")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.AddItemTagH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewAddItemTagNotFoundError(er))
+			case "forbidden":
+				var er *dummy.DummyForbiddenError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewAddItemTagForbiddenError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.AddItemTagResponse), nil
+}
+
+// NewRemoveItemTagHandler creates a gRPC handler which serves the "dummy"
+// service "remove_item_tag" endpoint.
+func NewRemoveItemTagHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRemoveItemTagRequest, EncodeRemoveItemTagResponse)
+	}
+	return h
+}
+
+// RemoveItemTag implements the "RemoveItemTag" method in dummypb.DummyServer
+// interface.
+func (s *Server) RemoveItemTag(ctx context.Context, message *dummypb.RemoveItemTagRequest) (*dummypb.RemoveItemTagResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "remove_item_tag")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.RemoveItemTagH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewRemoveItemTagNotFoundError(er))
+			case "forbidden":
+				var er *dummy.DummyForbiddenError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewRemoveItemTagForbiddenError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.RemoveItemTagResponse), nil
+}
+
+// NewSuggestTagsHandler creates a gRPC handler which serves the "dummy"
+// service "suggest_tags" endpoint.
+func NewSuggestTagsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeSuggestTagsRequest, EncodeSuggestTagsResponse)
+	}
+	return h
+}
+
+// SuggestTags implements the "SuggestTags" method in dummypb.DummyServer
+// interface.
+func (s *Server) SuggestTags(ctx context.Context, message *dummypb.SuggestTagsRequest) (*dummypb.SuggestTagsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "suggest_tags")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.SuggestTagsH.Handle(ctx, message)
+	if err != nil {
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.SuggestTagsResponse), nil
+}
+
+// NewListAttachmentsHandler creates a gRPC handler which serves the "dummy"
+// service "list_attachments" endpoint.
+func NewListAttachmentsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListAttachmentsRequest, EncodeListAttachmentsResponse)
+	}
+	return h
+}
+
+// ListAttachments implements the "ListAttachments" method in
+// dummypb.DummyServer interface.
+func (s *Server) ListAttachments(ctx context.Context, message *dummypb.ListAttachmentsRequest) (*dummypb.ListAttachmentsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list_attachments")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.ListAttachmentsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewListAttachmentsNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.ListAttachmentsResponse), nil
+}
+
+// NewDeleteAttachmentHandler creates a gRPC handler which serves the "dummy"
+// service "delete_attachment" endpoint.
+func NewDeleteAttachmentHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeDeleteAttachmentRequest, EncodeDeleteAttachmentResponse)
+	}
+	return h
+}
+
+// DeleteAttachment implements the "DeleteAttachment" method in
+// dummypb.DummyServer interface.
+func (s *Server) DeleteAttachment(ctx context.Context, message *dummypb.DeleteAttachmentRequest) (*dummypb.DeleteAttachmentResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "delete_attachment")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.DeleteAttachmentH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewDeleteAttachmentNotFoundError(er))
+			case "forbidden":
+				var er *dummy.DummyForbiddenError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewDeleteAttachmentForbiddenError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.DeleteAttachmentResponse), nil
+}
+
+// NewBatchCreateItemsHandler creates a gRPC handler which serves the "dummy"
+// service "batch_create_items" endpoint.
+func NewBatchCreateItemsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeBatchCreateItemsRequest, EncodeBatchCreateItemsResponse)
+	}
+	return h
+}
+
+// BatchCreateItems implements the "BatchCreateItems" method in
+// dummypb.DummyServer interface.
+func (s *Server) BatchCreateItems(ctx context.Context, message *dummypb.BatchCreateItemsRequest) (*dummypb.BatchCreateItemsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "batch_create_items")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.BatchCreateItemsH.Handle(ctx, message)
+	if err != nil {
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.BatchCreateItemsResponse), nil
+}
+
+// NewBatchUpdateItemsHandler creates a gRPC handler which serves the "dummy"
+// service "batch_update_items" endpoint.
+func NewBatchUpdateItemsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeBatchUpdateItemsRequest, EncodeBatchUpdateItemsResponse)
+	}
+	return h
+}
+
+// BatchUpdateItems implements the "BatchUpdateItems" method in
+// dummypb.DummyServer interface.
+func (s *Server) BatchUpdateItems(ctx context.Context, message *dummypb.BatchUpdateItemsRequest) (*dummypb.BatchUpdateItemsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "batch_update_items")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.BatchUpdateItemsH.Handle(ctx, message)
+	if err != nil {
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.BatchUpdateItemsResponse), nil
+}
+
+// NewBatchDeleteItemsHandler creates a gRPC handler which serves the "dummy"
+// service "batch_delete_items" endpoint.
+func NewBatchDeleteItemsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeBatchDeleteItemsRequest, EncodeBatchDeleteItemsResponse)
+	}
+	return h
+}
+
+// BatchDeleteItems implements the "BatchDeleteItems" method in
+// dummypb.DummyServer interface.
+func (s *Server) BatchDeleteItems(ctx context.Context, message *dummypb.BatchDeleteItemsRequest) (*dummypb.BatchDeleteItemsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "batch_delete_items")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.BatchDeleteItemsH.Handle(ctx, message)
+	if err != nil {
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.BatchDeleteItemsResponse), nil
+}
+
+// NewImportItemsHandler creates a gRPC handler which serves the "dummy"
+// service "import_items" endpoint.
+func NewImportItemsHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, DecodeImportItemsRequest)
+	}
+	return h
+}
+
+// ImportItems implements the "ImportItems" method in dummypb.DummyServer
+// interface.
+func (s *Server) ImportItems(stream dummypb.Dummy_ImportItemsServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "import_items")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	p, err := s.ImportItemsH.Decode(ctx, nil)
+	if err != nil {
+		return goagrpc.EncodeError(err)
+	}
+	ep := &dummy.ImportItemsEndpointInput{
+		Stream:  &ImportItemsServerStream{stream: stream},
+		Payload: p.(*dummy.AuthenticatedPayload),
+	}
+	err = s.ImportItemsH.Handle(ctx, ep)
+	if err != nil {
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// NewListTrashHandler creates a gRPC handler which serves the "dummy" service
+// "list_trash" endpoint.
+func NewListTrashHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListTrashRequest, EncodeListTrashResponse)
+	}
+	return h
+}
+
+// ListTrash implements the "ListTrash" method in dummypb.DummyServer interface.
+func (s *Server) ListTrash(ctx context.Context, message *dummypb.ListTrashRequest) (*dummypb.ListTrashResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list_trash")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.ListTrashH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_cursor":
+				var er *dummy.DummyBadRequestError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewListTrashInvalidCursorError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.ListTrashResponse), nil
+}
+
+// NewRestoreItemHandler creates a gRPC handler which serves the "dummy"
+// service "restore_item" endpoint.
+func NewRestoreItemHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRestoreItemRequest, EncodeRestoreItemResponse)
+	}
+	return h
+}
+
+// RestoreItem implements the "RestoreItem" method in dummypb.DummyServer
+// interface.
+func (s *Server) RestoreItem(ctx context.Context, message *dummypb.RestoreItemRequest) (*dummypb.RestoreItemResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "restore_item")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.RestoreItemH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewRestoreItemNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.RestoreItemResponse), nil
+}
+
+// NewPurgeItemHandler creates a gRPC handler which serves the "dummy" service
+// "purge_item" endpoint.
+func NewPurgeItemHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodePurgeItemRequest, EncodePurgeItemResponse)
+	}
+	return h
+}
+
+// PurgeItem implements the "PurgeItem" method in dummypb.DummyServer interface.
+func (s *Server) PurgeItem(ctx context.Context, message *dummypb.PurgeItemRequest) (*dummypb.PurgeItemResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "purge_item")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+	resp, err := s.PurgeItemH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "not_found":
+				var er *dummy.DummyNotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewPurgeItemNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*dummypb.PurgeItemResponse), nil
+}
+
+// SendAndClose streams instances of "dummypb.ImportItemsResponse" to the
+// "import_items" endpoint gRPC stream.
+func (s *ImportItemsServerStream) SendAndClose(res *dummy.ImportSummary) error {
+	v := NewProtoImportSummaryImportItemsResponse(res)
+	return s.stream.SendAndClose(v)
+}
+
+// SendAndCloseWithContext streams instances of "dummypb.ImportItemsResponse"
+// to the "import_items" endpoint gRPC stream with context.
+func (s *ImportItemsServerStream) SendAndCloseWithContext(ctx context.Context, res *dummy.ImportSummary) error {
+	return s.SendAndClose(res)
+}
+
+// Recv reads instances of "dummypb.ImportItemsStreamingRequest" from the
+// "import_items" endpoint gRPC stream.
+func (s *ImportItemsServerStream) Recv() (*dummy.BatchItemEntry, error) {
+	var res *dummy.BatchItemEntry
+	v, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+	if err = ValidateImportItemsStreamingRequest(v); err != nil {
+		return res, err
+	}
+	return NewImportItemsStreamingRequestBatchItemEntry(v), nil
+}
+
+// RecvWithContext reads instances of "dummypb.ImportItemsStreamingRequest"
+// from the "import_items" endpoint gRPC stream with context.
+func (s *ImportItemsServerStream) RecvWithContext(ctx context.Context) (*dummy.BatchItemEntry, error) {
+	return s.Recv()
+}
@@ -8,9 +8,12 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	dummyviews "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy/views"
 	dummypb "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/grpc/dummy/pb"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // NewCreateItemPayload builds the payload of the "create_item" endpoint of the
@@ -19,6 +22,7 @@ func NewCreateItemPayload(message *dummypb.CreateItemRequest) *dummy.CreateItemP
 	v := &dummy.CreateItemPayload{
 		Name:        message.Name,
 		Description: message.Description,
+		Language:    message.Language,
 		Token:       message.Token,
 	}
 	return v
@@ -33,6 +37,18 @@ func NewProtoCreateItemResponse(result *dummyviews.ItemView) *dummypb.CreateItem
 		Description: result.Description,
 		OwnerId:     *result.OwnerID,
 		CreatedAt:   *result.CreatedAt,
+		Version:     int32(*result.Version),
+		UpdatedAt:   *result.UpdatedAt,
+		Etag:        *result.Etag,
+		Language:    *result.Language,
+		DeletedAt:   result.DeletedAt,
+		Permission:  *result.Permission,
+	}
+	if result.Tags != nil {
+		message.Tags = make([]string, len(result.Tags))
+		for i, val := range result.Tags {
+			message.Tags[i] = val
+		}
 	}
 	return message
 }
@@ -41,7 +57,41 @@ func NewProtoCreateItemResponse(result *dummyviews.ItemView) *dummypb.CreateItem
 // "dummy" service from the gRPC request type.
 func NewListItemsPayload(message *dummypb.ListItemsRequest) *dummy.ListItemsPayload {
 	v := &dummy.ListItemsPayload{
-		Token: message.Token,
+		Cursor:        message.Cursor,
+		NamePrefix:    message.NamePrefix,
+		CreatedAfter:  message.CreatedAfter,
+		CreatedBefore: message.CreatedBefore,
+		Token:         message.Token,
+	}
+	if message.PageSize != nil {
+		v.PageSize = int(*message.PageSize)
+	}
+	if message.Order != nil {
+		v.Order = *message.Order
+	}
+	if message.IncludeTotal != nil {
+		v.IncludeTotal = *message.IncludeTotal
+	}
+	if message.TagMatch != nil {
+		v.TagMatch = *message.TagMatch
+	}
+	if message.PageSize == nil {
+		v.PageSize = 50
+	}
+	if message.Order == nil {
+		v.Order = "desc"
+	}
+	if message.IncludeTotal == nil {
+		v.IncludeTotal = false
+	}
+	if message.Tags != nil {
+		v.Tags = make([]string, len(message.Tags))
+		for i, val := range message.Tags {
+			v.Tags[i] = val
+		}
+	}
+	if message.TagMatch == nil {
+		v.TagMatch = "any"
 	}
 	return v
 }
@@ -49,7 +99,10 @@ func NewListItemsPayload(message *dummypb.ListItemsRequest) *dummy.ListItemsPayl
 // NewProtoListItemsResponse builds the gRPC response type from the result of
 // the "list_items" endpoint of the "dummy" service.
 func NewProtoListItemsResponse(result *dummy.ItemsCollection) *dummypb.ListItemsResponse {
-	message := &dummypb.ListItemsResponse{}
+	message := &dummypb.ListItemsResponse{
+		NextCursor: result.NextCursor,
+		Total:      result.Total,
+	}
 	if result.Items != nil {
 		message.Items = make([]*dummypb.Item, len(result.Items))
 		for i, val := range result.Items {
@@ -59,12 +112,81 @@ func NewProtoListItemsResponse(result *dummy.ItemsCollection) *dummypb.ListItems
 				Description: val.Description,
 				OwnerId:     val.OwnerID,
 				CreatedAt:   val.CreatedAt,
+				Version:     int32(val.Version),
+				UpdatedAt:   val.UpdatedAt,
+				Etag:        val.Etag,
+				Language:    val.Language,
+				DeletedAt:   val.DeletedAt,
+				Permission:  val.Permission,
+			}
+			if val.Tags != nil {
+				message.Items[i].Tags = make([]string, len(val.Tags))
+				for j, val := range val.Tags {
+					message.Items[i].Tags[j] = val
+				}
+			}
+		}
+	}
+	return message
+}
+
+// NewListItemsInvalidCursorError builds the gRPC error response type from the
+// error of the "list_items" endpoint of the "dummy" service.
+func NewListItemsInvalidCursorError(er *dummy.DummyBadRequestError) *dummypb.ListItemsInvalidCursorError {
+	message := &dummypb.ListItemsInvalidCursorError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewSearchItemsPayload builds the payload of the "search_items" endpoint of
+// the "dummy" service from the gRPC request type.
+func NewSearchItemsPayload(message *dummypb.SearchItemsRequest) *dummy.SearchItemsPayload {
+	v := &dummy.SearchItemsPayload{
+		Query:    message.Query,
+		Language: message.Language,
+		Cursor:   message.Cursor,
+		Token:    message.Token,
+	}
+	if message.PageSize != nil {
+		v.PageSize = int(*message.PageSize)
+	}
+	if message.PageSize == nil {
+		v.PageSize = 20
+	}
+	return v
+}
+
+// NewProtoSearchItemsResponse builds the gRPC response type from the result of
+// the "search_items" endpoint of the "dummy" service.
+func NewProtoSearchItemsResponse(result *dummy.SearchResultsCollection) *dummypb.SearchItemsResponse {
+	message := &dummypb.SearchItemsResponse{
+		NextCursor: result.NextCursor,
+	}
+	if result.Results != nil {
+		message.Results = make([]*dummypb.SearchResult, len(result.Results))
+		for i, val := range result.Results {
+			message.Results[i] = &dummypb.SearchResult{
+				Rank:    val.Rank,
+				Snippet: val.Snippet,
+			}
+			if val.Item != nil {
+				message.Results[i].Item = svcDummyItemToDummypbItem(val.Item)
 			}
 		}
 	}
 	return message
 }
 
+// NewSearchItemsInvalidCursorError builds the gRPC error response type from
+// the error of the "search_items" endpoint of the "dummy" service.
+func NewSearchItemsInvalidCursorError(er *dummy.DummyBadRequestError) *dummypb.SearchItemsInvalidCursorError {
+	message := &dummypb.SearchItemsInvalidCursorError{
+		Message_: er.Message,
+	}
+	return message
+}
+
 // NewGetItemPayload builds the payload of the "get_item" endpoint of the
 // "dummy" service from the gRPC request type.
 func NewGetItemPayload(message *dummypb.GetItemRequest) *dummy.ItemIDPayload {
@@ -84,6 +206,191 @@ func NewProtoGetItemResponse(result *dummyviews.ItemView) *dummypb.GetItemRespon
 		Description: result.Description,
 		OwnerId:     *result.OwnerID,
 		CreatedAt:   *result.CreatedAt,
+		Version:     int32(*result.Version),
+		UpdatedAt:   *result.UpdatedAt,
+		Etag:        *result.Etag,
+		Language:    *result.Language,
+		DeletedAt:   result.DeletedAt,
+		Permission:  *result.Permission,
+	}
+	if result.Tags != nil {
+		message.Tags = make([]string, len(result.Tags))
+		for i, val := range result.Tags {
+			message.Tags[i] = val
+		}
+	}
+	return message
+}
+
+// NewGetItemNotFoundError builds the gRPC error response type from the error
+// of the "get_item" endpoint of the "dummy" service.
+func NewGetItemNotFoundError(er *dummy.DummyNotFoundError) *dummypb.GetItemNotFoundError {
+	message := &dummypb.GetItemNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewUpdateItemPayload builds the payload of the "update_item" endpoint of the
+// "dummy" service from the gRPC request type.
+func NewUpdateItemPayload(message *dummypb.UpdateItemRequest) *dummy.UpdateItemPayload {
+	v := &dummy.UpdateItemPayload{
+		ID:          message.Id,
+		Name:        message.Name,
+		Description: message.Description,
+		IfMatch:     message.IfMatch,
+		Token:       message.Token,
+	}
+	if message.Version != nil {
+		version := int(*message.Version)
+		v.Version = &version
+	}
+	return v
+}
+
+// NewProtoUpdateItemResponse builds the gRPC response type from the result of
+// the "update_item" endpoint of the "dummy" service.
+func NewProtoUpdateItemResponse(result *dummyviews.ItemView) *dummypb.UpdateItemResponse {
+	message := &dummypb.UpdateItemResponse{
+		Id:          *result.ID,
+		Name:        *result.Name,
+		Description: result.Description,
+		OwnerId:     *result.OwnerID,
+		CreatedAt:   *result.CreatedAt,
+		Version:     int32(*result.Version),
+		UpdatedAt:   *result.UpdatedAt,
+		Etag:        *result.Etag,
+		Language:    *result.Language,
+		DeletedAt:   result.DeletedAt,
+		Permission:  *result.Permission,
+	}
+	if result.Tags != nil {
+		message.Tags = make([]string, len(result.Tags))
+		for i, val := range result.Tags {
+			message.Tags[i] = val
+		}
+	}
+	return message
+}
+
+// NewUpdateItemConflictError builds the gRPC error response type from the
+// error of the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemConflictError(er *dummy.DummyConflictError) *dummypb.UpdateItemConflictError {
+	message := &dummypb.UpdateItemConflictError{
+		Message_: er.Message,
+	}
+	if er.CurrentVersion != nil {
+		currentVersion := int32(*er.CurrentVersion)
+		message.CurrentVersion = &currentVersion
+	}
+	return message
+}
+
+// NewUpdateItemPreconditionRequiredError builds the gRPC error response type
+// from the error of the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemPreconditionRequiredError(er *dummy.DummyPreconditionRequiredError) *dummypb.UpdateItemPreconditionRequiredError {
+	message := &dummypb.UpdateItemPreconditionRequiredError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewUpdateItemNotFoundError builds the gRPC error response type from the
+// error of the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemNotFoundError(er *dummy.DummyNotFoundError) *dummypb.UpdateItemNotFoundError {
+	message := &dummypb.UpdateItemNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewUpdateItemForbiddenError builds the gRPC error response type from the
+// error of the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemForbiddenError(er *dummy.DummyForbiddenError) *dummypb.UpdateItemForbiddenError {
+	message := &dummypb.UpdateItemForbiddenError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewPatchItemPayload builds the payload of the "patch_item" endpoint of the
+// "dummy" service from the gRPC request type.
+func NewPatchItemPayload(message *dummypb.PatchItemRequest) *dummy.PatchItemPayload {
+	v := &dummy.PatchItemPayload{
+		ID:          message.Id,
+		Name:        message.Name,
+		Description: message.Description,
+		IfMatch:     message.IfMatch,
+		Token:       message.Token,
+	}
+	if message.Version != nil {
+		version := int(*message.Version)
+		v.Version = &version
+	}
+	return v
+}
+
+// NewProtoPatchItemResponse builds the gRPC response type from the result of
+// the "patch_item" endpoint of the "dummy" service.
+func NewProtoPatchItemResponse(result *dummyviews.ItemView) *dummypb.PatchItemResponse {
+	message := &dummypb.PatchItemResponse{
+		Id:          *result.ID,
+		Name:        *result.Name,
+		Description: result.Description,
+		OwnerId:     *result.OwnerID,
+		CreatedAt:   *result.CreatedAt,
+		Version:     int32(*result.Version),
+		UpdatedAt:   *result.UpdatedAt,
+		Etag:        *result.Etag,
+		Language:    *result.Language,
+		DeletedAt:   result.DeletedAt,
+		Permission:  *result.Permission,
+	}
+	if result.Tags != nil {
+		message.Tags = make([]string, len(result.Tags))
+		for i, val := range result.Tags {
+			message.Tags[i] = val
+		}
+	}
+	return message
+}
+
+// NewPatchItemConflictError builds the gRPC error response type from the error
+// of the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemConflictError(er *dummy.DummyConflictError) *dummypb.PatchItemConflictError {
+	message := &dummypb.PatchItemConflictError{
+		Message_: er.Message,
+	}
+	if er.CurrentVersion != nil {
+		currentVersion := int32(*er.CurrentVersion)
+		message.CurrentVersion = &currentVersion
+	}
+	return message
+}
+
+// NewPatchItemPreconditionRequiredError builds the gRPC error response type
+// from the error of the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemPreconditionRequiredError(er *dummy.DummyPreconditionRequiredError) *dummypb.PatchItemPreconditionRequiredError {
+	message := &dummypb.PatchItemPreconditionRequiredError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewPatchItemNotFoundError builds the gRPC error response type from the error
+// of the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemNotFoundError(er *dummy.DummyNotFoundError) *dummypb.PatchItemNotFoundError {
+	message := &dummypb.PatchItemNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewPatchItemForbiddenError builds the gRPC error response type from the
+// error of the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemForbiddenError(er *dummy.DummyForbiddenError) *dummypb.PatchItemForbiddenError {
+	message := &dummypb.PatchItemForbiddenError{
+		Message_: er.Message,
 	}
 	return message
 }
@@ -104,3 +411,1017 @@ func NewProtoDeleteItemResponse() *dummypb.DeleteItemResponse {
 	message := &dummypb.DeleteItemResponse{}
 	return message
 }
+
+// NewDeleteItemNotFoundError builds the gRPC error response type from the
+// error of the "delete_item" endpoint of the "dummy" service.
+func NewDeleteItemNotFoundError(er *dummy.DummyNotFoundError) *dummypb.DeleteItemNotFoundError {
+	message := &dummypb.DeleteItemNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewShareItemPayload builds the payload of the "share_item" endpoint of the
+// "dummy" service from the gRPC request type.
+func NewShareItemPayload(message *dummypb.ShareItemRequest) *dummy.ShareItemPayload {
+	v := &dummy.ShareItemPayload{
+		ID:         message.Id,
+		UserID:     message.UserId,
+		Permission: message.Permission,
+		Token:      message.Token,
+	}
+	return v
+}
+
+// NewProtoShareItemResponse builds the gRPC response type from the result of
+// the "share_item" endpoint of the "dummy" service.
+func NewProtoShareItemResponse(result *dummy.ItemShare) *dummypb.ShareItemResponse {
+	message := &dummypb.ShareItemResponse{
+		ItemId:     result.ItemID,
+		UserId:     result.UserID,
+		Permission: result.Permission,
+		CreatedAt:  result.CreatedAt,
+	}
+	return message
+}
+
+// NewShareItemNotFoundError builds the gRPC error response type from the error
+// of the "share_item" endpoint of the "dummy" service.
+func NewShareItemNotFoundError(er *dummy.DummyNotFoundError) *dummypb.ShareItemNotFoundError {
+	message := &dummypb.ShareItemNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewShareItemForbiddenError builds the gRPC error response type from the
+// error of the "share_item" endpoint of the "dummy" service.
+func NewShareItemForbiddenError(er *dummy.DummyForbiddenError) *dummypb.ShareItemForbiddenError {
+	message := &dummypb.ShareItemForbiddenError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewUnshareItemPayload builds the payload of the "unshare_item" endpoint of
+// the "dummy" service from the gRPC request type.
+func NewUnshareItemPayload(message *dummypb.UnshareItemRequest) *dummy.UnshareItemPayload {
+	v := &dummy.UnshareItemPayload{
+		ID:     message.Id,
+		UserID: message.UserId,
+		Token:  message.Token,
+	}
+	return v
+}
+
+// NewProtoUnshareItemResponse builds the gRPC response type from the result of
+// the "unshare_item" endpoint of the "dummy" service.
+func NewProtoUnshareItemResponse() *dummypb.UnshareItemResponse {
+	message := &dummypb.UnshareItemResponse{}
+	return message
+}
+
+// NewUnshareItemNotFoundError builds the gRPC error response type from the
+// error of the "unshare_item" endpoint of the "dummy" service.
+func NewUnshareItemNotFoundError(er *dummy.DummyNotFoundError) *dummypb.UnshareItemNotFoundError {
+	message := &dummypb.UnshareItemNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewUnshareItemForbiddenError builds the gRPC error response type from the
+// error of the "unshare_item" endpoint of the "dummy" service.
+func NewUnshareItemForbiddenError(er *dummy.DummyForbiddenError) *dummypb.UnshareItemForbiddenError {
+	message := &dummypb.UnshareItemForbiddenError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewListItemSharesPayload builds the payload of the "list_item_shares"
+// endpoint of the "dummy" service from the gRPC request type.
+func NewListItemSharesPayload(message *dummypb.ListItemSharesRequest) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{
+		ID:    message.Id,
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoListItemSharesResponse builds the gRPC response type from the result
+// of the "list_item_shares" endpoint of the "dummy" service.
+func NewProtoListItemSharesResponse(result *dummy.ItemSharesCollection) *dummypb.ListItemSharesResponse {
+	message := &dummypb.ListItemSharesResponse{}
+	if result.Shares != nil {
+		message.Shares = make([]*dummypb.ItemShare, len(result.Shares))
+		for i, val := range result.Shares {
+			message.Shares[i] = &dummypb.ItemShare{
+				ItemId:     val.ItemID,
+				UserId:     val.UserID,
+				Permission: val.Permission,
+				CreatedAt:  val.CreatedAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewListItemSharesNotFoundError builds the gRPC error response type from the
+// error of the "list_item_shares" endpoint of the "dummy" service.
+func NewListItemSharesNotFoundError(er *dummy.DummyNotFoundError) *dummypb.ListItemSharesNotFoundError {
+	message := &dummypb.ListItemSharesNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewListItemSharesForbiddenError builds the gRPC error response type from the
+// error of the "list_item_shares" endpoint of the "dummy" service.
+func NewListItemSharesForbiddenError(er *dummy.DummyForbiddenError) *dummypb.ListItemSharesForbiddenError {
+	message := &dummypb.ListItemSharesForbiddenError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewAddItemTagPayload builds the payload of the "add_item_tag" endpoint of
+// the "dummy" service from the gRPC request type.
+func NewAddItemTagPayload(message *dummypb.AddItemTagRequest) *dummy.ItemTagPayload {
+	v := &dummy.ItemTagPayload{
+		ID:    message.Id,
+		Tag:   message.Tag,
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoAddItemTagResponse builds the gRPC response type from the result of
+// the "add_item_tag" endpoint of the "dummy" service.
+func NewProtoAddItemTagResponse(result *dummyviews.ItemView) *dummypb.AddItemTagResponse {
+	message := &dummypb.AddItemTagResponse{
+		Id:          *result.ID,
+		Name:        *result.Name,
+		Description: result.Description,
+		OwnerId:     *result.OwnerID,
+		CreatedAt:   *result.CreatedAt,
+		Version:     int32(*result.Version),
+		UpdatedAt:   *result.UpdatedAt,
+		Etag:        *result.Etag,
+		Language:    *result.Language,
+		DeletedAt:   result.DeletedAt,
+		Permission:  *result.Permission,
+	}
+	if result.Tags != nil {
+		message.Tags = make([]string, len(result.Tags))
+		for i, val := range result.Tags {
+			message.Tags[i] = val
+		}
+	}
+	return message
+}
+
+// NewAddItemTagNotFoundError builds the gRPC error response type from the
+// error of the "add_item_tag" endpoint of the "dummy" service.
+func NewAddItemTagNotFoundError(er *dummy.DummyNotFoundError) *dummypb.AddItemTagNotFoundError {
+	message := &dummypb.AddItemTagNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewAddItemTagForbiddenError builds the gRPC error response type from the
+// error of the "add_item_tag" endpoint of the "dummy" service.
+func NewAddItemTagForbiddenError(er *dummy.DummyForbiddenError) *dummypb.AddItemTagForbiddenError {
+	message := &dummypb.AddItemTagForbiddenError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewRemoveItemTagPayload builds the payload of the "remove_item_tag" endpoint
+// of the "dummy" service from the gRPC request type.
+func NewRemoveItemTagPayload(message *dummypb.RemoveItemTagRequest) *dummy.ItemTagPayload {
+	v := &dummy.ItemTagPayload{
+		ID:    message.Id,
+		Tag:   message.Tag,
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoRemoveItemTagResponse builds the gRPC response type from the result
+// of the "remove_item_tag" endpoint of the "dummy" service.
+func NewProtoRemoveItemTagResponse(result *dummyviews.ItemView) *dummypb.RemoveItemTagResponse {
+	message := &dummypb.RemoveItemTagResponse{
+		Id:          *result.ID,
+		Name:        *result.Name,
+		Description: result.Description,
+		OwnerId:     *result.OwnerID,
+		CreatedAt:   *result.CreatedAt,
+		Version:     int32(*result.Version),
+		UpdatedAt:   *result.UpdatedAt,
+		Etag:        *result.Etag,
+		Language:    *result.Language,
+		DeletedAt:   result.DeletedAt,
+		Permission:  *result.Permission,
+	}
+	if result.Tags != nil {
+		message.Tags = make([]string, len(result.Tags))
+		for i, val := range result.Tags {
+			message.Tags[i] = val
+		}
+	}
+	return message
+}
+
+// NewRemoveItemTagNotFoundError builds the gRPC error response type from the
+// error of the "remove_item_tag" endpoint of the "dummy" service.
+func NewRemoveItemTagNotFoundError(er *dummy.DummyNotFoundError) *dummypb.RemoveItemTagNotFoundError {
+	message := &dummypb.RemoveItemTagNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewRemoveItemTagForbiddenError builds the gRPC error response type from the
+// error of the "remove_item_tag" endpoint of the "dummy" service.
+func NewRemoveItemTagForbiddenError(er *dummy.DummyForbiddenError) *dummypb.RemoveItemTagForbiddenError {
+	message := &dummypb.RemoveItemTagForbiddenError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewSuggestTagsPayload builds the payload of the "suggest_tags" endpoint of
+// the "dummy" service from the gRPC request type.
+func NewSuggestTagsPayload(message *dummypb.SuggestTagsRequest) *dummy.SuggestTagsPayload {
+	v := &dummy.SuggestTagsPayload{
+		Token: message.Token,
+	}
+	if message.Prefix != nil {
+		v.Prefix = *message.Prefix
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Prefix == nil {
+		v.Prefix = ""
+	}
+	if message.Limit == nil {
+		v.Limit = 10
+	}
+	return v
+}
+
+// NewProtoSuggestTagsResponse builds the gRPC response type from the result of
+// the "suggest_tags" endpoint of the "dummy" service.
+func NewProtoSuggestTagsResponse(result *dummy.TagSuggestions) *dummypb.SuggestTagsResponse {
+	message := &dummypb.SuggestTagsResponse{}
+	if result.Tags != nil {
+		message.Tags = make([]*dummypb.TagSuggestion, len(result.Tags))
+		for i, val := range result.Tags {
+			message.Tags[i] = &dummypb.TagSuggestion{
+				Name:      val.Name,
+				ItemCount: val.ItemCount,
+			}
+		}
+	}
+	return message
+}
+
+// NewListAttachmentsPayload builds the payload of the "list_attachments"
+// endpoint of the "dummy" service from the gRPC request type.
+func NewListAttachmentsPayload(message *dummypb.ListAttachmentsRequest) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{
+		ID:    message.Id,
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoListAttachmentsResponse builds the gRPC response type from the
+// result of the "list_attachments" endpoint of the "dummy" service.
+func NewProtoListAttachmentsResponse(result *dummy.AttachmentsCollection) *dummypb.ListAttachmentsResponse {
+	message := &dummypb.ListAttachmentsResponse{}
+	if result.Attachments != nil {
+		message.Attachments = make([]*dummypb.Attachment, len(result.Attachments))
+		for i, val := range result.Attachments {
+			message.Attachments[i] = &dummypb.Attachment{
+				Id:             val.ID,
+				ItemId:         val.ItemID,
+				Filename:       val.Filename,
+				ContentType:    val.ContentType,
+				Size:           val.Size,
+				ChecksumSha256: val.ChecksumSha256,
+				CreatedAt:      val.CreatedAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewListAttachmentsNotFoundError builds the gRPC error response type from the
+// error of the "list_attachments" endpoint of the "dummy" service.
+func NewListAttachmentsNotFoundError(er *dummy.DummyNotFoundError) *dummypb.ListAttachmentsNotFoundError {
+	message := &dummypb.ListAttachmentsNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewDeleteAttachmentPayload builds the payload of the "delete_attachment"
+// endpoint of the "dummy" service from the gRPC request type.
+func NewDeleteAttachmentPayload(message *dummypb.DeleteAttachmentRequest) *dummy.AttachmentIDPayload {
+	v := &dummy.AttachmentIDPayload{
+		ID:           message.Id,
+		AttachmentID: message.AttachmentId,
+		Token:        message.Token,
+	}
+	return v
+}
+
+// NewProtoDeleteAttachmentResponse builds the gRPC response type from the
+// result of the "delete_attachment" endpoint of the "dummy" service.
+func NewProtoDeleteAttachmentResponse() *dummypb.DeleteAttachmentResponse {
+	message := &dummypb.DeleteAttachmentResponse{}
+	return message
+}
+
+// NewDeleteAttachmentNotFoundError builds the gRPC error response type from
+// the error of the "delete_attachment" endpoint of the "dummy" service.
+func NewDeleteAttachmentNotFoundError(er *dummy.DummyNotFoundError) *dummypb.DeleteAttachmentNotFoundError {
+	message := &dummypb.DeleteAttachmentNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewDeleteAttachmentForbiddenError builds the gRPC error response type from
+// the error of the "delete_attachment" endpoint of the "dummy" service.
+func NewDeleteAttachmentForbiddenError(er *dummy.DummyForbiddenError) *dummypb.DeleteAttachmentForbiddenError {
+	message := &dummypb.DeleteAttachmentForbiddenError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewBatchCreateItemsPayload builds the payload of the "batch_create_items"
+// endpoint of the "dummy" service from the gRPC request type.
+func NewBatchCreateItemsPayload(message *dummypb.BatchCreateItemsRequest) *dummy.BatchCreateItemsPayload {
+	v := &dummy.BatchCreateItemsPayload{
+		Token: message.Token,
+	}
+	if message.Atomic != nil {
+		v.Atomic = *message.Atomic
+	}
+	if message.Items != nil {
+		v.Items = make([]*dummy.BatchItemEntry, len(message.Items))
+		for i, val := range message.Items {
+			v.Items[i] = &dummy.BatchItemEntry{
+				Name:        val.Name,
+				Description: val.Description,
+				Language:    val.Language,
+			}
+		}
+	}
+	if message.Atomic == nil {
+		v.Atomic = true
+	}
+	return v
+}
+
+// NewProtoBatchCreateItemsResponse builds the gRPC response type from the
+// result of the "batch_create_items" endpoint of the "dummy" service.
+func NewProtoBatchCreateItemsResponse(result *dummy.BatchResult) *dummypb.BatchCreateItemsResponse {
+	message := &dummypb.BatchCreateItemsResponse{
+		Succeeded: int32(result.Succeeded),
+		Failed:    int32(result.Failed),
+	}
+	if result.Results != nil {
+		message.Results = make([]*dummypb.BatchEntryResult, len(result.Results))
+		for i, val := range result.Results {
+			message.Results[i] = &dummypb.BatchEntryResult{
+				Index: int32(val.Index),
+			}
+			if val.Item != nil {
+				message.Results[i].Item = svcDummyItemToDummypbItem(val.Item)
+			}
+			if val.Error != nil {
+				message.Results[i].Error = svcDummyBatchEntryErrorToDummypbBatchEntryError(val.Error)
+			}
+		}
+	}
+	return message
+}
+
+// NewBatchUpdateItemsPayload builds the payload of the "batch_update_items"
+// endpoint of the "dummy" service from the gRPC request type.
+func NewBatchUpdateItemsPayload(message *dummypb.BatchUpdateItemsRequest) *dummy.BatchUpdateItemsPayload {
+	v := &dummy.BatchUpdateItemsPayload{
+		Token: message.Token,
+	}
+	if message.Atomic != nil {
+		v.Atomic = *message.Atomic
+	}
+	if message.Items != nil {
+		v.Items = make([]*dummy.BatchUpdateEntry, len(message.Items))
+		for i, val := range message.Items {
+			v.Items[i] = &dummy.BatchUpdateEntry{
+				ID:          val.Id,
+				Version:     int(val.Version),
+				Name:        val.Name,
+				Description: val.Description,
+			}
+		}
+	}
+	if message.Atomic == nil {
+		v.Atomic = true
+	}
+	return v
+}
+
+// NewProtoBatchUpdateItemsResponse builds the gRPC response type from the
+// result of the "batch_update_items" endpoint of the "dummy" service.
+func NewProtoBatchUpdateItemsResponse(result *dummy.BatchResult) *dummypb.BatchUpdateItemsResponse {
+	message := &dummypb.BatchUpdateItemsResponse{
+		Succeeded: int32(result.Succeeded),
+		Failed:    int32(result.Failed),
+	}
+	if result.Results != nil {
+		message.Results = make([]*dummypb.BatchEntryResult, len(result.Results))
+		for i, val := range result.Results {
+			message.Results[i] = &dummypb.BatchEntryResult{
+				Index: int32(val.Index),
+			}
+			if val.Item != nil {
+				message.Results[i].Item = svcDummyItemToDummypbItem(val.Item)
+			}
+			if val.Error != nil {
+				message.Results[i].Error = svcDummyBatchEntryErrorToDummypbBatchEntryError(val.Error)
+			}
+		}
+	}
+	return message
+}
+
+// NewBatchDeleteItemsPayload builds the payload of the "batch_delete_items"
+// endpoint of the "dummy" service from the gRPC request type.
+func NewBatchDeleteItemsPayload(message *dummypb.BatchDeleteItemsRequest) *dummy.BatchDeleteItemsPayload {
+	v := &dummy.BatchDeleteItemsPayload{
+		Token: message.Token,
+	}
+	if message.Atomic != nil {
+		v.Atomic = *message.Atomic
+	}
+	if message.Ids != nil {
+		v.Ids = make([]string, len(message.Ids))
+		for i, val := range message.Ids {
+			v.Ids[i] = val
+		}
+	}
+	if message.Atomic == nil {
+		v.Atomic = true
+	}
+	return v
+}
+
+// NewProtoBatchDeleteItemsResponse builds the gRPC response type from the
+// result of the "batch_delete_items" endpoint of the "dummy" service.
+func NewProtoBatchDeleteItemsResponse(result *dummy.BatchResult) *dummypb.BatchDeleteItemsResponse {
+	message := &dummypb.BatchDeleteItemsResponse{
+		Succeeded: int32(result.Succeeded),
+		Failed:    int32(result.Failed),
+	}
+	if result.Results != nil {
+		message.Results = make([]*dummypb.BatchEntryResult, len(result.Results))
+		for i, val := range result.Results {
+			message.Results[i] = &dummypb.BatchEntryResult{
+				Index: int32(val.Index),
+			}
+			if val.Item != nil {
+				message.Results[i].Item = svcDummyItemToDummypbItem(val.Item)
+			}
+			if val.Error != nil {
+				message.Results[i].Error = svcDummyBatchEntryErrorToDummypbBatchEntryError(val.Error)
+			}
+		}
+	}
+	return message
+}
+
+// NewImportItemsPayload builds the payload of the "import_items" endpoint of
+// the "dummy" service from the gRPC request type.
+func NewImportItemsPayload(token string) *dummy.AuthenticatedPayload {
+	v := &dummy.AuthenticatedPayload{}
+	v.Token = token
+	return v
+}
+
+// NewProtoImportItemsResponse builds the gRPC response type from the result of
+// the "import_items" endpoint of the "dummy" service.
+func NewProtoImportItemsResponse(result *dummy.ImportSummary) *dummypb.ImportItemsResponse {
+	message := &dummypb.ImportItemsResponse{
+		Received: result.Received,
+		Created:  result.Created,
+		Failed:   result.Failed,
+	}
+	if result.Errors != nil {
+		message.Errors = make([]*dummypb.BatchEntryResult, len(result.Errors))
+		for i, val := range result.Errors {
+			message.Errors[i] = &dummypb.BatchEntryResult{
+				Index: int32(val.Index),
+			}
+			if val.Item != nil {
+				message.Errors[i].Item = svcDummyItemToDummypbItem(val.Item)
+			}
+			if val.Error != nil {
+				message.Errors[i].Error = svcDummyBatchEntryErrorToDummypbBatchEntryError(val.Error)
+			}
+		}
+	}
+	return message
+}
+
+func NewProtoImportSummaryImportItemsResponse(result *dummy.ImportSummary) *dummypb.ImportItemsResponse {
+	v := &dummypb.ImportItemsResponse{
+		Received: result.Received,
+		Created:  result.Created,
+		Failed:   result.Failed,
+	}
+	if result.Errors != nil {
+		v.Errors = make([]*dummypb.BatchEntryResult, len(result.Errors))
+		for i, val := range result.Errors {
+			v.Errors[i] = &dummypb.BatchEntryResult{
+				Index: int32(val.Index),
+			}
+			if val.Item != nil {
+				v.Errors[i].Item = svcDummyItemToDummypbItem(val.Item)
+			}
+			if val.Error != nil {
+				v.Errors[i].Error = svcDummyBatchEntryErrorToDummypbBatchEntryError(val.Error)
+			}
+		}
+	}
+	return v
+}
+
+func NewImportItemsStreamingRequestBatchItemEntry(v *dummypb.ImportItemsStreamingRequest) *dummy.BatchItemEntry {
+	spayload := &dummy.BatchItemEntry{
+		Name:        v.Name,
+		Description: v.Description,
+		Language:    v.Language,
+	}
+	return spayload
+}
+
+// NewListTrashPayload builds the payload of the "list_trash" endpoint of the
+// "dummy" service from the gRPC request type.
+func NewListTrashPayload(message *dummypb.ListTrashRequest) *dummy.ListTrashPayload {
+	v := &dummy.ListTrashPayload{
+		Cursor: message.Cursor,
+		Token:  message.Token,
+	}
+	if message.PageSize != nil {
+		v.PageSize = int(*message.PageSize)
+	}
+	if message.PageSize == nil {
+		v.PageSize = 50
+	}
+	return v
+}
+
+// NewProtoListTrashResponse builds the gRPC response type from the result of
+// the "list_trash" endpoint of the "dummy" service.
+func NewProtoListTrashResponse(result *dummy.ItemsCollection) *dummypb.ListTrashResponse {
+	message := &dummypb.ListTrashResponse{
+		NextCursor: result.NextCursor,
+		Total:      result.Total,
+	}
+	if result.Items != nil {
+		message.Items = make([]*dummypb.Item, len(result.Items))
+		for i, val := range result.Items {
+			message.Items[i] = &dummypb.Item{
+				Id:          val.ID,
+				Name:        val.Name,
+				Description: val.Description,
+				OwnerId:     val.OwnerID,
+				CreatedAt:   val.CreatedAt,
+				Version:     int32(val.Version),
+				UpdatedAt:   val.UpdatedAt,
+				Etag:        val.Etag,
+				Language:    val.Language,
+				DeletedAt:   val.DeletedAt,
+				Permission:  val.Permission,
+			}
+			if val.Tags != nil {
+				message.Items[i].Tags = make([]string, len(val.Tags))
+				for j, val := range val.Tags {
+					message.Items[i].Tags[j] = val
+				}
+			}
+		}
+	}
+	return message
+}
+
+// NewListTrashInvalidCursorError builds the gRPC error response type from the
+// error of the "list_trash" endpoint of the "dummy" service.
+func NewListTrashInvalidCursorError(er *dummy.DummyBadRequestError) *dummypb.ListTrashInvalidCursorError {
+	message := &dummypb.ListTrashInvalidCursorError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewRestoreItemPayload builds the payload of the "restore_item" endpoint of
+// the "dummy" service from the gRPC request type.
+func NewRestoreItemPayload(message *dummypb.RestoreItemRequest) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{
+		ID:    message.Id,
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoRestoreItemResponse builds the gRPC response type from the result of
+// the "restore_item" endpoint of the "dummy" service.
+func NewProtoRestoreItemResponse(result *dummyviews.ItemView) *dummypb.RestoreItemResponse {
+	message := &dummypb.RestoreItemResponse{
+		Id:          *result.ID,
+		Name:        *result.Name,
+		Description: result.Description,
+		OwnerId:     *result.OwnerID,
+		CreatedAt:   *result.CreatedAt,
+		Version:     int32(*result.Version),
+		UpdatedAt:   *result.UpdatedAt,
+		Etag:        *result.Etag,
+		Language:    *result.Language,
+		DeletedAt:   result.DeletedAt,
+		Permission:  *result.Permission,
+	}
+	if result.Tags != nil {
+		message.Tags = make([]string, len(result.Tags))
+		for i, val := range result.Tags {
+			message.Tags[i] = val
+		}
+	}
+	return message
+}
+
+// NewRestoreItemNotFoundError builds the gRPC error response type from the
+// error of the "restore_item" endpoint of the "dummy" service.
+func NewRestoreItemNotFoundError(er *dummy.DummyNotFoundError) *dummypb.RestoreItemNotFoundError {
+	message := &dummypb.RestoreItemNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// NewPurgeItemPayload builds the payload of the "purge_item" endpoint of the
+// "dummy" service from the gRPC request type.
+func NewPurgeItemPayload(message *dummypb.PurgeItemRequest) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{
+		ID:    message.Id,
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoPurgeItemResponse builds the gRPC response type from the result of
+// the "purge_item" endpoint of the "dummy" service.
+func NewProtoPurgeItemResponse() *dummypb.PurgeItemResponse {
+	message := &dummypb.PurgeItemResponse{}
+	return message
+}
+
+// NewPurgeItemNotFoundError builds the gRPC error response type from the error
+// of the "purge_item" endpoint of the "dummy" service.
+func NewPurgeItemNotFoundError(er *dummy.DummyNotFoundError) *dummypb.PurgeItemNotFoundError {
+	message := &dummypb.PurgeItemNotFoundError{
+		Message_: er.Message,
+	}
+	return message
+}
+
+// ValidateCreateItemRequest runs the validations defined on CreateItemRequest.
+func ValidateCreateItemRequest(message *dummypb.CreateItemRequest) (err error) {
+	if message.Language != nil {
+		if !(*message.Language == "simple" || *message.Language == "arabic" || *message.Language == "armenian" || *message.Language == "basque" || *message.Language == "catalan" || *message.Language == "danish" || *message.Language == "dutch" || *message.Language == "english" || *message.Language == "finnish" || *message.Language == "french" || *message.Language == "german" || *message.Language == "greek" || *message.Language == "hindi" || *message.Language == "hungarian" || *message.Language == "indonesian" || *message.Language == "irish" || *message.Language == "italian" || *message.Language == "lithuanian" || *message.Language == "nepali" || *message.Language == "norwegian" || *message.Language == "portuguese" || *message.Language == "romanian" || *message.Language == "russian" || *message.Language == "serbian" || *message.Language == "spanish" || *message.Language == "swedish" || *message.Language == "tamil" || *message.Language == "turkish" || *message.Language == "yiddish") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.language", *message.Language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+		}
+	}
+	return
+}
+
+// ValidateListItemsRequest runs the validations defined on ListItemsRequest.
+func ValidateListItemsRequest(message *dummypb.ListItemsRequest) (err error) {
+	if message.PageSize != nil {
+		if *message.PageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.page_size", *message.PageSize, 1, true))
+		}
+	}
+	if message.PageSize != nil {
+		if *message.PageSize > 200 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.page_size", *message.PageSize, 200, false))
+		}
+	}
+	if message.Order != nil {
+		if !(*message.Order == "desc" || *message.Order == "asc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.order", *message.Order, []any{"desc", "asc"}))
+		}
+	}
+	if message.CreatedAfter != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.created_after", *message.CreatedAfter, goa.FormatDateTime))
+	}
+	if message.CreatedBefore != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.created_before", *message.CreatedBefore, goa.FormatDateTime))
+	}
+	if len(message.Tags) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.tags", message.Tags, len(message.Tags), 20, false))
+	}
+	if message.TagMatch != nil {
+		if !(*message.TagMatch == "any" || *message.TagMatch == "all") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.tag_match", *message.TagMatch, []any{"any", "all"}))
+		}
+	}
+	return
+}
+
+// ValidateSearchItemsRequest runs the validations defined on
+// SearchItemsRequest.
+func ValidateSearchItemsRequest(message *dummypb.SearchItemsRequest) (err error) {
+	if utf8.RuneCountInString(message.Query) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.query", message.Query, utf8.RuneCountInString(message.Query), 1, true))
+	}
+	if utf8.RuneCountInString(message.Query) > 500 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.query", message.Query, utf8.RuneCountInString(message.Query), 500, false))
+	}
+	if message.Language != nil {
+		if !(*message.Language == "simple" || *message.Language == "arabic" || *message.Language == "armenian" || *message.Language == "basque" || *message.Language == "catalan" || *message.Language == "danish" || *message.Language == "dutch" || *message.Language == "english" || *message.Language == "finnish" || *message.Language == "french" || *message.Language == "german" || *message.Language == "greek" || *message.Language == "hindi" || *message.Language == "hungarian" || *message.Language == "indonesian" || *message.Language == "irish" || *message.Language == "italian" || *message.Language == "lithuanian" || *message.Language == "nepali" || *message.Language == "norwegian" || *message.Language == "portuguese" || *message.Language == "romanian" || *message.Language == "russian" || *message.Language == "serbian" || *message.Language == "spanish" || *message.Language == "swedish" || *message.Language == "tamil" || *message.Language == "turkish" || *message.Language == "yiddish") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.language", *message.Language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+		}
+	}
+	if message.PageSize != nil {
+		if *message.PageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.page_size", *message.PageSize, 1, true))
+		}
+	}
+	if message.PageSize != nil {
+		if *message.PageSize > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.page_size", *message.PageSize, 100, false))
+		}
+	}
+	return
+}
+
+// ValidatePatchItemRequest runs the validations defined on PatchItemRequest.
+func ValidatePatchItemRequest(message *dummypb.PatchItemRequest) (err error) {
+	if message.Name != nil {
+		if utf8.RuneCountInString(*message.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.name", *message.Name, utf8.RuneCountInString(*message.Name), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateShareItemRequest runs the validations defined on ShareItemRequest.
+func ValidateShareItemRequest(message *dummypb.ShareItemRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.user_id", message.UserId, goa.FormatUUID))
+	if !(message.Permission == "viewer" || message.Permission == "editor") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.permission", message.Permission, []any{"viewer", "editor"}))
+	}
+	return
+}
+
+// ValidateAddItemTagRequest runs the validations defined on AddItemTagRequest.
+func ValidateAddItemTagRequest(message *dummypb.AddItemTagRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.tag", message.Tag, "^\\S(.*\\S)?$"))
+	if utf8.RuneCountInString(message.Tag) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.tag", message.Tag, utf8.RuneCountInString(message.Tag), 1, true))
+	}
+	if utf8.RuneCountInString(message.Tag) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.tag", message.Tag, utf8.RuneCountInString(message.Tag), 50, false))
+	}
+	return
+}
+
+// ValidateRemoveItemTagRequest runs the validations defined on
+// RemoveItemTagRequest.
+func ValidateRemoveItemTagRequest(message *dummypb.RemoveItemTagRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.tag", message.Tag, "^\\S(.*\\S)?$"))
+	if utf8.RuneCountInString(message.Tag) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.tag", message.Tag, utf8.RuneCountInString(message.Tag), 1, true))
+	}
+	if utf8.RuneCountInString(message.Tag) > 50 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.tag", message.Tag, utf8.RuneCountInString(message.Tag), 50, false))
+	}
+	return
+}
+
+// ValidateSuggestTagsRequest runs the validations defined on
+// SuggestTagsRequest.
+func ValidateSuggestTagsRequest(message *dummypb.SuggestTagsRequest) (err error) {
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 50 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 50, false))
+		}
+	}
+	return
+}
+
+// ValidateBatchCreateItemsRequest runs the validations defined on
+// BatchCreateItemsRequest.
+func ValidateBatchCreateItemsRequest(message *dummypb.BatchCreateItemsRequest) (err error) {
+	if message.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "message"))
+	}
+	if len(message.Items) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.items", message.Items, len(message.Items), 1, true))
+	}
+	if len(message.Items) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.items", message.Items, len(message.Items), 100, false))
+	}
+	for _, e := range message.Items {
+		if e != nil {
+			if err2 := ValidateBatchItemEntry(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchItemEntry runs the validations defined on BatchItemEntry.
+func ValidateBatchItemEntry(elem *dummypb.BatchItemEntry) (err error) {
+	if utf8.RuneCountInString(elem.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("elem.name", elem.Name, utf8.RuneCountInString(elem.Name), 1, true))
+	}
+	if elem.Language != nil {
+		if !(*elem.Language == "simple" || *elem.Language == "arabic" || *elem.Language == "armenian" || *elem.Language == "basque" || *elem.Language == "catalan" || *elem.Language == "danish" || *elem.Language == "dutch" || *elem.Language == "english" || *elem.Language == "finnish" || *elem.Language == "french" || *elem.Language == "german" || *elem.Language == "greek" || *elem.Language == "hindi" || *elem.Language == "hungarian" || *elem.Language == "indonesian" || *elem.Language == "irish" || *elem.Language == "italian" || *elem.Language == "lithuanian" || *elem.Language == "nepali" || *elem.Language == "norwegian" || *elem.Language == "portuguese" || *elem.Language == "romanian" || *elem.Language == "russian" || *elem.Language == "serbian" || *elem.Language == "spanish" || *elem.Language == "swedish" || *elem.Language == "tamil" || *elem.Language == "turkish" || *elem.Language == "yiddish") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.language", *elem.Language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+		}
+	}
+	return
+}
+
+// ValidateBatchUpdateItemsRequest runs the validations defined on
+// BatchUpdateItemsRequest.
+func ValidateBatchUpdateItemsRequest(message *dummypb.BatchUpdateItemsRequest) (err error) {
+	if message.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "message"))
+	}
+	if len(message.Items) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.items", message.Items, len(message.Items), 1, true))
+	}
+	if len(message.Items) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.items", message.Items, len(message.Items), 100, false))
+	}
+	for _, e := range message.Items {
+		if e != nil {
+			if err2 := ValidateBatchUpdateEntry(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchUpdateEntry runs the validations defined on BatchUpdateEntry.
+func ValidateBatchUpdateEntry(elem *dummypb.BatchUpdateEntry) (err error) {
+	if elem.Name != nil {
+		if utf8.RuneCountInString(*elem.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("elem.name", *elem.Name, utf8.RuneCountInString(*elem.Name), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateBatchDeleteItemsRequest runs the validations defined on
+// BatchDeleteItemsRequest.
+func ValidateBatchDeleteItemsRequest(message *dummypb.BatchDeleteItemsRequest) (err error) {
+	if message.Ids == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ids", "message"))
+	}
+	if len(message.Ids) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.ids", message.Ids, len(message.Ids), 1, true))
+	}
+	if len(message.Ids) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.ids", message.Ids, len(message.Ids), 100, false))
+	}
+	return
+}
+
+// ValidateImportItemsStreamingRequest runs the validations defined on
+// ImportItemsStreamingRequest.
+func ValidateImportItemsStreamingRequest(stream *dummypb.ImportItemsStreamingRequest) (err error) {
+	if utf8.RuneCountInString(stream.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("stream.name", stream.Name, utf8.RuneCountInString(stream.Name), 1, true))
+	}
+	if stream.Language != nil {
+		if !(*stream.Language == "simple" || *stream.Language == "arabic" || *stream.Language == "armenian" || *stream.Language == "basque" || *stream.Language == "catalan" || *stream.Language == "danish" || *stream.Language == "dutch" || *stream.Language == "english" || *stream.Language == "finnish" || *stream.Language == "french" || *stream.Language == "german" || *stream.Language == "greek" || *stream.Language == "hindi" || *stream.Language == "hungarian" || *stream.Language == "indonesian" || *stream.Language == "irish" || *stream.Language == "italian" || *stream.Language == "lithuanian" || *stream.Language == "nepali" || *stream.Language == "norwegian" || *stream.Language == "portuguese" || *stream.Language == "romanian" || *stream.Language == "russian" || *stream.Language == "serbian" || *stream.Language == "spanish" || *stream.Language == "swedish" || *stream.Language == "tamil" || *stream.Language == "turkish" || *stream.Language == "yiddish") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("stream.language", *stream.Language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+		}
+	}
+	return
+}
+
+// ValidateListTrashRequest runs the validations defined on ListTrashRequest.
+func ValidateListTrashRequest(message *dummypb.ListTrashRequest) (err error) {
+	if message.PageSize != nil {
+		if *message.PageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.page_size", *message.PageSize, 1, true))
+		}
+	}
+	if message.PageSize != nil {
+		if *message.PageSize > 200 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.page_size", *message.PageSize, 200, false))
+		}
+	}
+	return
+}
+
+// svcDummyItemToDummypbItem builds a value of type *dummypb.Item from a value
+// of type *dummy.Item.
+func svcDummyItemToDummypbItem(v *dummy.Item) *dummypb.Item {
+	res := &dummypb.Item{
+		Id:          v.ID,
+		Name:        v.Name,
+		Description: v.Description,
+		OwnerId:     v.OwnerID,
+		CreatedAt:   v.CreatedAt,
+		Version:     int32(v.Version),
+		UpdatedAt:   v.UpdatedAt,
+		Etag:        v.Etag,
+		Language:    v.Language,
+		DeletedAt:   v.DeletedAt,
+		Permission:  v.Permission,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// protobufDummypbItemToDummyItem builds a value of type *dummy.Item from a
+// value of type *dummypb.Item.
+func protobufDummypbItemToDummyItem(v *dummypb.Item) *dummy.Item {
+	res := &dummy.Item{
+		ID:          v.Id,
+		Name:        v.Name,
+		Description: v.Description,
+		OwnerID:     v.OwnerId,
+		CreatedAt:   v.CreatedAt,
+		Version:     int(v.Version),
+		UpdatedAt:   v.UpdatedAt,
+		Etag:        v.Etag,
+		Language:    v.Language,
+		DeletedAt:   v.DeletedAt,
+		Permission:  v.Permission,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// svcDummyBatchEntryErrorToDummypbBatchEntryError builds a value of type
+// *dummypb.BatchEntryError from a value of type *dummy.BatchEntryError.
+func svcDummyBatchEntryErrorToDummypbBatchEntryError(v *dummy.BatchEntryError) *dummypb.BatchEntryError {
+	if v == nil {
+		return nil
+	}
+	res := &dummypb.BatchEntryError{
+		Name:     v.Name,
+		Message_: v.Message,
+	}
+
+	return res
+}
+
+// protobufDummypbBatchEntryErrorToDummyBatchEntryError builds a value of type
+// *dummy.BatchEntryError from a value of type *dummypb.BatchEntryError.
+func protobufDummypbBatchEntryErrorToDummyBatchEntryError(v *dummypb.BatchEntryError) *dummy.BatchEntryError {
+	if v == nil {
+		return nil
+	}
+	res := &dummy.BatchEntryError{
+		Name:    v.Name,
+		Message: v.Message_,
+	}
+
+	return res
+}
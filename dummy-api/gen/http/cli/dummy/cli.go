@@ -23,13 +23,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"dummy (create-item|list-items|get-item|delete-item)",
+		"dummy (create-item|list-items|search-items|get-item|update-item|patch-item|delete-item|share-item|unshare-item|list-item-shares|add-item-tag|remove-item-tag|suggest-tags|upload-attachment|download-attachment|list-attachments|delete-attachment|batch-create-items|batch-update-items|batch-delete-items|export-items|import-items-file|list-trash|restore-item|purge-item)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "dummy create-item --body '{\n      \"description\": \"Sunt iusto.\",\n      \"name\": \"Quisquam odio sit eaque aut perferendis temporibus.\"\n   }' --token \"Neque repellat corrupti temporibus ut omnis molestias.\"" + "\n" +
+	return os.Args[0] + " " + "dummy create-item --body '{\n      \"description\": \"Modi quia et.\",\n      \"language\": \"lithuanian\",\n      \"name\": \"Et aliquid repudiandae id doloribus sit ut.\"\n   }' --token \"A adipisci.\"" + "\n" +
 		""
 }
 
@@ -49,22 +49,157 @@ func ParseEndpoint(
 		dummyCreateItemBodyFlag  = dummyCreateItemFlags.String("body", "REQUIRED", "")
 		dummyCreateItemTokenFlag = dummyCreateItemFlags.String("token", "REQUIRED", "")
 
-		dummyListItemsFlags     = flag.NewFlagSet("list-items", flag.ExitOnError)
-		dummyListItemsTokenFlag = dummyListItemsFlags.String("token", "REQUIRED", "")
+		dummyListItemsFlags             = flag.NewFlagSet("list-items", flag.ExitOnError)
+		dummyListItemsPageSizeFlag      = dummyListItemsFlags.String("page-size", "50", "")
+		dummyListItemsCursorFlag        = dummyListItemsFlags.String("cursor", "", "")
+		dummyListItemsOrderFlag         = dummyListItemsFlags.String("order", "desc", "")
+		dummyListItemsNamePrefixFlag    = dummyListItemsFlags.String("name-prefix", "", "")
+		dummyListItemsCreatedAfterFlag  = dummyListItemsFlags.String("created-after", "", "")
+		dummyListItemsCreatedBeforeFlag = dummyListItemsFlags.String("created-before", "", "")
+		dummyListItemsIncludeTotalFlag  = dummyListItemsFlags.String("include-total", "", "")
+		dummyListItemsTagsFlag          = dummyListItemsFlags.String("tags", "", "")
+		dummyListItemsTagMatchFlag      = dummyListItemsFlags.String("tag-match", "any", "")
+		dummyListItemsTokenFlag         = dummyListItemsFlags.String("token", "REQUIRED", "")
+
+		dummySearchItemsFlags        = flag.NewFlagSet("search-items", flag.ExitOnError)
+		dummySearchItemsQueryFlag    = dummySearchItemsFlags.String("query", "REQUIRED", "")
+		dummySearchItemsLanguageFlag = dummySearchItemsFlags.String("language", "", "")
+		dummySearchItemsPageSizeFlag = dummySearchItemsFlags.String("page-size", "20", "")
+		dummySearchItemsCursorFlag   = dummySearchItemsFlags.String("cursor", "", "")
+		dummySearchItemsTokenFlag    = dummySearchItemsFlags.String("token", "REQUIRED", "")
 
 		dummyGetItemFlags     = flag.NewFlagSet("get-item", flag.ExitOnError)
 		dummyGetItemIDFlag    = dummyGetItemFlags.String("id", "REQUIRED", "")
 		dummyGetItemTokenFlag = dummyGetItemFlags.String("token", "REQUIRED", "")
 
+		dummyUpdateItemFlags       = flag.NewFlagSet("update-item", flag.ExitOnError)
+		dummyUpdateItemBodyFlag    = dummyUpdateItemFlags.String("body", "REQUIRED", "")
+		dummyUpdateItemIDFlag      = dummyUpdateItemFlags.String("id", "REQUIRED", "")
+		dummyUpdateItemTokenFlag   = dummyUpdateItemFlags.String("token", "REQUIRED", "")
+		dummyUpdateItemIfMatchFlag = dummyUpdateItemFlags.String("if-match", "", "")
+
+		dummyPatchItemFlags       = flag.NewFlagSet("patch-item", flag.ExitOnError)
+		dummyPatchItemBodyFlag    = dummyPatchItemFlags.String("body", "REQUIRED", "")
+		dummyPatchItemIDFlag      = dummyPatchItemFlags.String("id", "REQUIRED", "")
+		dummyPatchItemTokenFlag   = dummyPatchItemFlags.String("token", "REQUIRED", "")
+		dummyPatchItemIfMatchFlag = dummyPatchItemFlags.String("if-match", "", "")
+
 		dummyDeleteItemFlags     = flag.NewFlagSet("delete-item", flag.ExitOnError)
 		dummyDeleteItemIDFlag    = dummyDeleteItemFlags.String("id", "REQUIRED", "")
 		dummyDeleteItemTokenFlag = dummyDeleteItemFlags.String("token", "REQUIRED", "")
+
+		dummyShareItemFlags      = flag.NewFlagSet("share-item", flag.ExitOnError)
+		dummyShareItemBodyFlag   = dummyShareItemFlags.String("body", "REQUIRED", "")
+		dummyShareItemIDFlag     = dummyShareItemFlags.String("id", "REQUIRED", "")
+		dummyShareItemUserIDFlag = dummyShareItemFlags.String("user-id", "REQUIRED", "User to share the item with")
+		dummyShareItemTokenFlag  = dummyShareItemFlags.String("token", "REQUIRED", "")
+
+		dummyUnshareItemFlags      = flag.NewFlagSet("unshare-item", flag.ExitOnError)
+		dummyUnshareItemIDFlag     = dummyUnshareItemFlags.String("id", "REQUIRED", "")
+		dummyUnshareItemUserIDFlag = dummyUnshareItemFlags.String("user-id", "REQUIRED", "User to stop sharing the item with")
+		dummyUnshareItemTokenFlag  = dummyUnshareItemFlags.String("token", "REQUIRED", "")
+
+		dummyListItemSharesFlags     = flag.NewFlagSet("list-item-shares", flag.ExitOnError)
+		dummyListItemSharesIDFlag    = dummyListItemSharesFlags.String("id", "REQUIRED", "")
+		dummyListItemSharesTokenFlag = dummyListItemSharesFlags.String("token", "REQUIRED", "")
+
+		dummyAddItemTagFlags     = flag.NewFlagSet("add-item-tag", flag.ExitOnError)
+		dummyAddItemTagIDFlag    = dummyAddItemTagFlags.String("id", "REQUIRED", "")
+		dummyAddItemTagTagFlag   = dummyAddItemTagFlags.String("tag", "REQUIRED", "Tag name; tags are compared case-insensitively")
+		dummyAddItemTagTokenFlag = dummyAddItemTagFlags.String("token", "REQUIRED", "")
+
+		dummyRemoveItemTagFlags     = flag.NewFlagSet("remove-item-tag", flag.ExitOnError)
+		dummyRemoveItemTagIDFlag    = dummyRemoveItemTagFlags.String("id", "REQUIRED", "")
+		dummyRemoveItemTagTagFlag   = dummyRemoveItemTagFlags.String("tag", "REQUIRED", "Tag name; tags are compared case-insensitively")
+		dummyRemoveItemTagTokenFlag = dummyRemoveItemTagFlags.String("token", "REQUIRED", "")
+
+		dummySuggestTagsFlags      = flag.NewFlagSet("suggest-tags", flag.ExitOnError)
+		dummySuggestTagsPrefixFlag = dummySuggestTagsFlags.String("prefix", "", "")
+		dummySuggestTagsLimitFlag  = dummySuggestTagsFlags.String("limit", "10", "")
+		dummySuggestTagsTokenFlag  = dummySuggestTagsFlags.String("token", "REQUIRED", "")
+
+		dummyUploadAttachmentFlags              = flag.NewFlagSet("upload-attachment", flag.ExitOnError)
+		dummyUploadAttachmentIDFlag             = dummyUploadAttachmentFlags.String("id", "REQUIRED", "")
+		dummyUploadAttachmentFilenameFlag       = dummyUploadAttachmentFlags.String("filename", "REQUIRED", "")
+		dummyUploadAttachmentTokenFlag          = dummyUploadAttachmentFlags.String("token", "REQUIRED", "")
+		dummyUploadAttachmentChecksumSha256Flag = dummyUploadAttachmentFlags.String("checksum-sha256", "", "")
+		dummyUploadAttachmentStreamFlag         = dummyUploadAttachmentFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
+		dummyDownloadAttachmentFlags            = flag.NewFlagSet("download-attachment", flag.ExitOnError)
+		dummyDownloadAttachmentIDFlag           = dummyDownloadAttachmentFlags.String("id", "REQUIRED", "")
+		dummyDownloadAttachmentAttachmentIDFlag = dummyDownloadAttachmentFlags.String("attachment-id", "REQUIRED", "")
+		dummyDownloadAttachmentTokenFlag        = dummyDownloadAttachmentFlags.String("token", "REQUIRED", "")
+
+		dummyListAttachmentsFlags     = flag.NewFlagSet("list-attachments", flag.ExitOnError)
+		dummyListAttachmentsIDFlag    = dummyListAttachmentsFlags.String("id", "REQUIRED", "")
+		dummyListAttachmentsTokenFlag = dummyListAttachmentsFlags.String("token", "REQUIRED", "")
+
+		dummyDeleteAttachmentFlags            = flag.NewFlagSet("delete-attachment", flag.ExitOnError)
+		dummyDeleteAttachmentIDFlag           = dummyDeleteAttachmentFlags.String("id", "REQUIRED", "")
+		dummyDeleteAttachmentAttachmentIDFlag = dummyDeleteAttachmentFlags.String("attachment-id", "REQUIRED", "")
+		dummyDeleteAttachmentTokenFlag        = dummyDeleteAttachmentFlags.String("token", "REQUIRED", "")
+
+		dummyBatchCreateItemsFlags     = flag.NewFlagSet("batch-create-items", flag.ExitOnError)
+		dummyBatchCreateItemsBodyFlag  = dummyBatchCreateItemsFlags.String("body", "REQUIRED", "")
+		dummyBatchCreateItemsTokenFlag = dummyBatchCreateItemsFlags.String("token", "REQUIRED", "")
+
+		dummyBatchUpdateItemsFlags     = flag.NewFlagSet("batch-update-items", flag.ExitOnError)
+		dummyBatchUpdateItemsBodyFlag  = dummyBatchUpdateItemsFlags.String("body", "REQUIRED", "")
+		dummyBatchUpdateItemsTokenFlag = dummyBatchUpdateItemsFlags.String("token", "REQUIRED", "")
+
+		dummyBatchDeleteItemsFlags     = flag.NewFlagSet("batch-delete-items", flag.ExitOnError)
+		dummyBatchDeleteItemsBodyFlag  = dummyBatchDeleteItemsFlags.String("body", "REQUIRED", "")
+		dummyBatchDeleteItemsTokenFlag = dummyBatchDeleteItemsFlags.String("token", "REQUIRED", "")
+
+		dummyExportItemsFlags      = flag.NewFlagSet("export-items", flag.ExitOnError)
+		dummyExportItemsFormatFlag = dummyExportItemsFlags.String("format", "ndjson", "")
+		dummyExportItemsTokenFlag  = dummyExportItemsFlags.String("token", "REQUIRED", "")
+
+		dummyImportItemsFileFlags      = flag.NewFlagSet("import-items-file", flag.ExitOnError)
+		dummyImportItemsFileFormatFlag = dummyImportItemsFileFlags.String("format", "ndjson", "")
+		dummyImportItemsFileDryRunFlag = dummyImportItemsFileFlags.String("dry-run", "", "")
+		dummyImportItemsFileTokenFlag  = dummyImportItemsFileFlags.String("token", "REQUIRED", "")
+		dummyImportItemsFileStreamFlag = dummyImportItemsFileFlags.String("stream", "REQUIRED", "path to file containing the streamed request body")
+
+		dummyListTrashFlags        = flag.NewFlagSet("list-trash", flag.ExitOnError)
+		dummyListTrashPageSizeFlag = dummyListTrashFlags.String("page-size", "50", "")
+		dummyListTrashCursorFlag   = dummyListTrashFlags.String("cursor", "", "")
+		dummyListTrashTokenFlag    = dummyListTrashFlags.String("token", "REQUIRED", "")
+
+		dummyRestoreItemFlags     = flag.NewFlagSet("restore-item", flag.ExitOnError)
+		dummyRestoreItemIDFlag    = dummyRestoreItemFlags.String("id", "REQUIRED", "")
+		dummyRestoreItemTokenFlag = dummyRestoreItemFlags.String("token", "REQUIRED", "")
+
+		dummyPurgeItemFlags     = flag.NewFlagSet("purge-item", flag.ExitOnError)
+		dummyPurgeItemIDFlag    = dummyPurgeItemFlags.String("id", "REQUIRED", "")
+		dummyPurgeItemTokenFlag = dummyPurgeItemFlags.String("token", "REQUIRED", "")
 	)
 	dummyFlags.Usage = dummyUsage
 	dummyCreateItemFlags.Usage = dummyCreateItemUsage
 	dummyListItemsFlags.Usage = dummyListItemsUsage
+	dummySearchItemsFlags.Usage = dummySearchItemsUsage
 	dummyGetItemFlags.Usage = dummyGetItemUsage
+	dummyUpdateItemFlags.Usage = dummyUpdateItemUsage
+	dummyPatchItemFlags.Usage = dummyPatchItemUsage
 	dummyDeleteItemFlags.Usage = dummyDeleteItemUsage
+	dummyShareItemFlags.Usage = dummyShareItemUsage
+	dummyUnshareItemFlags.Usage = dummyUnshareItemUsage
+	dummyListItemSharesFlags.Usage = dummyListItemSharesUsage
+	dummyAddItemTagFlags.Usage = dummyAddItemTagUsage
+	dummyRemoveItemTagFlags.Usage = dummyRemoveItemTagUsage
+	dummySuggestTagsFlags.Usage = dummySuggestTagsUsage
+	dummyUploadAttachmentFlags.Usage = dummyUploadAttachmentUsage
+	dummyDownloadAttachmentFlags.Usage = dummyDownloadAttachmentUsage
+	dummyListAttachmentsFlags.Usage = dummyListAttachmentsUsage
+	dummyDeleteAttachmentFlags.Usage = dummyDeleteAttachmentUsage
+	dummyBatchCreateItemsFlags.Usage = dummyBatchCreateItemsUsage
+	dummyBatchUpdateItemsFlags.Usage = dummyBatchUpdateItemsUsage
+	dummyBatchDeleteItemsFlags.Usage = dummyBatchDeleteItemsUsage
+	dummyExportItemsFlags.Usage = dummyExportItemsUsage
+	dummyImportItemsFileFlags.Usage = dummyImportItemsFileUsage
+	dummyListTrashFlags.Usage = dummyListTrashUsage
+	dummyRestoreItemFlags.Usage = dummyRestoreItemUsage
+	dummyPurgeItemFlags.Usage = dummyPurgeItemUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -106,12 +241,75 @@ func ParseEndpoint(
 			case "list-items":
 				epf = dummyListItemsFlags
 
+			case "search-items":
+				epf = dummySearchItemsFlags
+
 			case "get-item":
 				epf = dummyGetItemFlags
 
+			case "update-item":
+				epf = dummyUpdateItemFlags
+
+			case "patch-item":
+				epf = dummyPatchItemFlags
+
 			case "delete-item":
 				epf = dummyDeleteItemFlags
 
+			case "share-item":
+				epf = dummyShareItemFlags
+
+			case "unshare-item":
+				epf = dummyUnshareItemFlags
+
+			case "list-item-shares":
+				epf = dummyListItemSharesFlags
+
+			case "add-item-tag":
+				epf = dummyAddItemTagFlags
+
+			case "remove-item-tag":
+				epf = dummyRemoveItemTagFlags
+
+			case "suggest-tags":
+				epf = dummySuggestTagsFlags
+
+			case "upload-attachment":
+				epf = dummyUploadAttachmentFlags
+
+			case "download-attachment":
+				epf = dummyDownloadAttachmentFlags
+
+			case "list-attachments":
+				epf = dummyListAttachmentsFlags
+
+			case "delete-attachment":
+				epf = dummyDeleteAttachmentFlags
+
+			case "batch-create-items":
+				epf = dummyBatchCreateItemsFlags
+
+			case "batch-update-items":
+				epf = dummyBatchUpdateItemsFlags
+
+			case "batch-delete-items":
+				epf = dummyBatchDeleteItemsFlags
+
+			case "export-items":
+				epf = dummyExportItemsFlags
+
+			case "import-items-file":
+				epf = dummyImportItemsFileFlags
+
+			case "list-trash":
+				epf = dummyListTrashFlags
+
+			case "restore-item":
+				epf = dummyRestoreItemFlags
+
+			case "purge-item":
+				epf = dummyPurgeItemFlags
+
 			}
 
 		}
@@ -142,13 +340,82 @@ func ParseEndpoint(
 				data, err = dummyc.BuildCreateItemPayload(*dummyCreateItemBodyFlag, *dummyCreateItemTokenFlag)
 			case "list-items":
 				endpoint = c.ListItems()
-				data, err = dummyc.BuildListItemsPayload(*dummyListItemsTokenFlag)
+				data, err = dummyc.BuildListItemsPayload(*dummyListItemsPageSizeFlag, *dummyListItemsCursorFlag, *dummyListItemsOrderFlag, *dummyListItemsNamePrefixFlag, *dummyListItemsCreatedAfterFlag, *dummyListItemsCreatedBeforeFlag, *dummyListItemsIncludeTotalFlag, *dummyListItemsTagsFlag, *dummyListItemsTagMatchFlag, *dummyListItemsTokenFlag)
+			case "search-items":
+				endpoint = c.SearchItems()
+				data, err = dummyc.BuildSearchItemsPayload(*dummySearchItemsQueryFlag, *dummySearchItemsLanguageFlag, *dummySearchItemsPageSizeFlag, *dummySearchItemsCursorFlag, *dummySearchItemsTokenFlag)
 			case "get-item":
 				endpoint = c.GetItem()
 				data, err = dummyc.BuildGetItemPayload(*dummyGetItemIDFlag, *dummyGetItemTokenFlag)
+			case "update-item":
+				endpoint = c.UpdateItem()
+				data, err = dummyc.BuildUpdateItemPayload(*dummyUpdateItemBodyFlag, *dummyUpdateItemIDFlag, *dummyUpdateItemTokenFlag, *dummyUpdateItemIfMatchFlag)
+			case "patch-item":
+				endpoint = c.PatchItem()
+				data, err = dummyc.BuildPatchItemPayload(*dummyPatchItemBodyFlag, *dummyPatchItemIDFlag, *dummyPatchItemTokenFlag, *dummyPatchItemIfMatchFlag)
 			case "delete-item":
 				endpoint = c.DeleteItem()
 				data, err = dummyc.BuildDeleteItemPayload(*dummyDeleteItemIDFlag, *dummyDeleteItemTokenFlag)
+			case "share-item":
+				endpoint = c.ShareItem()
+				data, err = dummyc.BuildShareItemPayload(*dummyShareItemBodyFlag, *dummyShareItemIDFlag, *dummyShareItemUserIDFlag, *dummyShareItemTokenFlag)
+			case "unshare-item":
+				endpoint = c.UnshareItem()
+				data, err = dummyc.BuildUnshareItemPayload(*dummyUnshareItemIDFlag, *dummyUnshareItemUserIDFlag, *dummyUnshareItemTokenFlag)
+			case "list-item-shares":
+				endpoint = c.ListItemShares()
+				data, err = dummyc.BuildListItemSharesPayload(*dummyListItemSharesIDFlag, *dummyListItemSharesTokenFlag)
+			case "add-item-tag":
+				endpoint = c.AddItemTag()
+				data, err = dummyc.BuildAddItemTagPayload(*dummyAddItemTagIDFlag, *dummyAddItemTagTagFlag, *dummyAddItemTagTokenFlag)
+			case "remove-item-tag":
+				endpoint = c.RemoveItemTag()
+				data, err = dummyc.BuildRemoveItemTagPayload(*dummyRemoveItemTagIDFlag, *dummyRemoveItemTagTagFlag, *dummyRemoveItemTagTokenFlag)
+			case "suggest-tags":
+				endpoint = c.SuggestTags()
+				data, err = dummyc.BuildSuggestTagsPayload(*dummySuggestTagsPrefixFlag, *dummySuggestTagsLimitFlag, *dummySuggestTagsTokenFlag)
+			case "upload-attachment":
+				endpoint = c.UploadAttachment()
+				data, err = dummyc.BuildUploadAttachmentPayload(*dummyUploadAttachmentIDFlag, *dummyUploadAttachmentFilenameFlag, *dummyUploadAttachmentTokenFlag, *dummyUploadAttachmentChecksumSha256Flag)
+				if err == nil {
+					data, err = dummyc.BuildUploadAttachmentStreamPayload(data, *dummyUploadAttachmentStreamFlag)
+				}
+			case "download-attachment":
+				endpoint = c.DownloadAttachment()
+				data, err = dummyc.BuildDownloadAttachmentPayload(*dummyDownloadAttachmentIDFlag, *dummyDownloadAttachmentAttachmentIDFlag, *dummyDownloadAttachmentTokenFlag)
+			case "list-attachments":
+				endpoint = c.ListAttachments()
+				data, err = dummyc.BuildListAttachmentsPayload(*dummyListAttachmentsIDFlag, *dummyListAttachmentsTokenFlag)
+			case "delete-attachment":
+				endpoint = c.DeleteAttachment()
+				data, err = dummyc.BuildDeleteAttachmentPayload(*dummyDeleteAttachmentIDFlag, *dummyDeleteAttachmentAttachmentIDFlag, *dummyDeleteAttachmentTokenFlag)
+			case "batch-create-items":
+				endpoint = c.BatchCreateItems()
+				data, err = dummyc.BuildBatchCreateItemsPayload(*dummyBatchCreateItemsBodyFlag, *dummyBatchCreateItemsTokenFlag)
+			case "batch-update-items":
+				endpoint = c.BatchUpdateItems()
+				data, err = dummyc.BuildBatchUpdateItemsPayload(*dummyBatchUpdateItemsBodyFlag, *dummyBatchUpdateItemsTokenFlag)
+			case "batch-delete-items":
+				endpoint = c.BatchDeleteItems()
+				data, err = dummyc.BuildBatchDeleteItemsPayload(*dummyBatchDeleteItemsBodyFlag, *dummyBatchDeleteItemsTokenFlag)
+			case "export-items":
+				endpoint = c.ExportItems()
+				data, err = dummyc.BuildExportItemsPayload(*dummyExportItemsFormatFlag, *dummyExportItemsTokenFlag)
+			case "import-items-file":
+				endpoint = c.ImportItemsFile()
+				data, err = dummyc.BuildImportItemsFilePayload(*dummyImportItemsFileFormatFlag, *dummyImportItemsFileDryRunFlag, *dummyImportItemsFileTokenFlag)
+				if err == nil {
+					data, err = dummyc.BuildImportItemsFileStreamPayload(data, *dummyImportItemsFileStreamFlag)
+				}
+			case "list-trash":
+				endpoint = c.ListTrash()
+				data, err = dummyc.BuildListTrashPayload(*dummyListTrashPageSizeFlag, *dummyListTrashCursorFlag, *dummyListTrashTokenFlag)
+			case "restore-item":
+				endpoint = c.RestoreItem()
+				data, err = dummyc.BuildRestoreItemPayload(*dummyRestoreItemIDFlag, *dummyRestoreItemTokenFlag)
+			case "purge-item":
+				endpoint = c.PurgeItem()
+				data, err = dummyc.BuildPurgeItemPayload(*dummyPurgeItemIDFlag, *dummyPurgeItemTokenFlag)
 			}
 		}
 	}
@@ -165,9 +432,30 @@ func dummyUsage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] dummy COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    create-item: CreateItem implements create_item.`)
-	fmt.Fprintln(os.Stderr, `    list-items: ListItems implements list_items.`)
-	fmt.Fprintln(os.Stderr, `    get-item: GetItem implements get_item.`)
-	fmt.Fprintln(os.Stderr, `    delete-item: DeleteItem implements delete_item.`)
+	fmt.Fprintln(os.Stderr, `    list-items: Pages through the items owned by or shared with the caller, newest first unless order is asc. Pass next_cursor as cursor to fetch the following page with the same filters and order`)
+	fmt.Fprintln(os.Stderr, `    search-items: Full-text search over the name and description of the caller's items, ranked by relevance`)
+	fmt.Fprintln(os.Stderr, `    get-item: Fetches an item owned by or shared with the caller`)
+	fmt.Fprintln(os.Stderr, `    update-item: Replaces the name and description of an item. The update must name the version it is based on and fails with conflict if the item changed since`)
+	fmt.Fprintln(os.Stderr, `    patch-item: Changes the given fields of an item. Like update_item it fails with conflict if the item changed since the given version`)
+	fmt.Fprintln(os.Stderr, `    delete-item: Moves an item to the trash, from which it can be restored until it is purged`)
+	fmt.Fprintln(os.Stderr, `    share-item: Shares an item with another user, or changes the permission it is shared with. Only the owner can share an item`)
+	fmt.Fprintln(os.Stderr, `    unshare-item: Stops sharing an item with a user. The owner can remove any share and users can remove their own`)
+	fmt.Fprintln(os.Stderr, `    list-item-shares: Lists the users an item is shared with. Only the owner can list them`)
+	fmt.Fprintln(os.Stderr, `    add-item-tag: Tags an item, creating the tag in the owner's tags if needed. Requires editor access`)
+	fmt.Fprintln(os.Stderr, `    remove-item-tag: Removes a tag from an item. Requires editor access`)
+	fmt.Fprintln(os.Stderr, `    suggest-tags: Autocompletes the caller's tags, most used first`)
+	fmt.Fprintln(os.Stderr, `    upload-attachment: Attaches a file to an item. The request body is the raw content, streamed to blob storage. Requires editor access`)
+	fmt.Fprintln(os.Stderr, `    download-attachment: Streams the content of an attachment as a download. HTML, XML and script content is served as application/octet-stream`)
+	fmt.Fprintln(os.Stderr, `    list-attachments: Lists the attachments of an item, oldest first`)
+	fmt.Fprintln(os.Stderr, `    delete-attachment: Deletes an attachment and its content. Requires editor access`)
+	fmt.Fprintln(os.Stderr, `    batch-create-items: Creates up to 100 items, atomically unless atomic is false`)
+	fmt.Fprintln(os.Stderr, `    batch-update-items: Changes the given fields of up to 100 items like patch_item, atomically unless atomic is false`)
+	fmt.Fprintln(os.Stderr, `    batch-delete-items: Moves up to 100 items to the trash, atomically unless atomic is false`)
+	fmt.Fprintln(os.Stderr, `    export-items: Streams the caller's items, oldest first, as CSV or newline-delimited JSON. Trashed and shared items are left out`)
+	fmt.Fprintln(os.Stderr, `    import-items-file: Creates items from a CSV or newline-delimited JSON file sent as the request body. Every row is validated first and nothing is imported unless all of them are valid; with dry_run nothing is imported either way`)
+	fmt.Fprintln(os.Stderr, `    list-trash: Lists the caller's trashed items, most recently deleted first`)
+	fmt.Fprintln(os.Stderr, `    restore-item: Moves an item out of the trash`)
+	fmt.Fprintln(os.Stderr, `    purge-item: Permanently deletes a trashed item`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s dummy COMMAND --help\n", os.Args[0])
@@ -189,25 +477,69 @@ func dummyCreateItemUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy create-item --body '{\n      \"description\": \"Sunt iusto.\",\n      \"name\": \"Quisquam odio sit eaque aut perferendis temporibus.\"\n   }' --token \"Neque repellat corrupti temporibus ut omnis molestias.\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy create-item --body '{\n      \"description\": \"Modi quia et.\",\n      \"language\": \"lithuanian\",\n      \"name\": \"Et aliquid repudiandae id doloribus sit ut.\"\n   }' --token \"A adipisci.\"")
 }
 
 func dummyListItemsUsage() {
 	// Header with flags
 	fmt.Fprintf(os.Stderr, "%s [flags] dummy list-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -page-size INT")
+	fmt.Fprint(os.Stderr, " -cursor STRING")
+	fmt.Fprint(os.Stderr, " -order STRING")
+	fmt.Fprint(os.Stderr, " -name-prefix STRING")
+	fmt.Fprint(os.Stderr, " -created-after STRING")
+	fmt.Fprint(os.Stderr, " -created-before STRING")
+	fmt.Fprint(os.Stderr, " -include-total BOOL")
+	fmt.Fprint(os.Stderr, " -tags JSON")
+	fmt.Fprint(os.Stderr, " -tag-match STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Pages through the items owned by or shared with the caller, newest first unless order is asc. Pass next_cursor as cursor to fetch the following page with the same filters and order`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -page-size INT: `)
+	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
+	fmt.Fprintln(os.Stderr, `    -order STRING: `)
+	fmt.Fprintln(os.Stderr, `    -name-prefix STRING: `)
+	fmt.Fprintln(os.Stderr, `    -created-after STRING: `)
+	fmt.Fprintln(os.Stderr, `    -created-before STRING: `)
+	fmt.Fprintln(os.Stderr, `    -include-total BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -tags JSON: `)
+	fmt.Fprintln(os.Stderr, `    -tag-match STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-items --page-size 144 --cursor \"Minima expedita est mollitia dolores voluptate.\" --order \"asc\" --name-prefix \"Adipisci qui est fugiat ut.\" --created-after \"1986-10-18T01:11:18Z\" --created-before \"1983-08-03T23:13:07Z\" --include-total false --tags '[\n      \"Dolor ratione occaecati magnam.\",\n      \"Eveniet et eaque perspiciatis.\",\n      \"Quo voluptas autem.\"\n   ]' --tag-match \"all\" --token \"Dignissimos debitis.\"")
+}
+
+func dummySearchItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy search-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -query STRING")
+	fmt.Fprint(os.Stderr, " -language STRING")
+	fmt.Fprint(os.Stderr, " -page-size INT")
+	fmt.Fprint(os.Stderr, " -cursor STRING")
 	fmt.Fprint(os.Stderr, " -token STRING")
 	fmt.Fprintln(os.Stderr)
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `ListItems implements list_items.`)
+	fmt.Fprintln(os.Stderr, `Full-text search over the name and description of the caller's items, ranked by relevance`)
 
 	// Flags list
+	fmt.Fprintln(os.Stderr, `    -query STRING: `)
+	fmt.Fprintln(os.Stderr, `    -language STRING: `)
+	fmt.Fprintln(os.Stderr, `    -page-size INT: `)
+	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
 	fmt.Fprintln(os.Stderr, `    -token STRING: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-items --token \"Quo ipsum reiciendis.\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy search-items --query \"quarterly report\" --language \"serbian\" --page-size 75 --cursor \"Minus esse repellat voluptas.\" --token \"Quia id totam consequuntur aut assumenda maiores.\"")
 }
 
 func dummyGetItemUsage() {
@@ -219,7 +551,7 @@ func dummyGetItemUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `GetItem implements get_item.`)
+	fmt.Fprintln(os.Stderr, `Fetches an item owned by or shared with the caller`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -id STRING: `)
@@ -227,7 +559,55 @@ func dummyGetItemUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy get-item --id \"Consequatur quasi numquam.\" --token \"Sit temporibus cumque at sapiente tempora.\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy get-item --id \"Alias omnis.\" --token \"Dignissimos deserunt ut et voluptatum.\"")
+}
+
+func dummyUpdateItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy update-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Replaces the name and description of an item. The update must name the version it is based on and fails with conflict if the item changed since`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy update-item --body '{\n      \"description\": \"Perferendis quo voluptas.\",\n      \"name\": \"Dolorum cum eum.\"\n   }' --id \"Occaecati ex quo minima.\" --token \"Eos qui nemo qui est.\" --if-match \"Tenetur sit.\"")
+}
+
+func dummyPatchItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy patch-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprint(os.Stderr, " -if-match STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Changes the given fields of an item. Like update_item it fails with conflict if the item changed since the given version`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -if-match STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy patch-item --body '{\n      \"description\": \"Nam incidunt.\",\n      \"name\": \"a\"\n   }' --id \"Et nobis accusamus.\" --token \"Non explicabo dolor sunt.\" --if-match \"Id ab ullam libero voluptatem.\"")
 }
 
 func dummyDeleteItemUsage() {
@@ -239,7 +619,395 @@ func dummyDeleteItemUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `DeleteItem implements delete_item.`)
+	fmt.Fprintln(os.Stderr, `Moves an item to the trash, from which it can be restored until it is purged`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy delete-item --id \"Neque sequi quo.\" --token \"Laborum ratione.\"")
+}
+
+func dummyShareItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy share-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -user-id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Shares an item with another user, or changes the permission it is shared with. Only the owner can share an item`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -user-id STRING: User to share the item with`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy share-item --body '{\n      \"permission\": \"viewer\"\n   }' --id \"Ut dolor tenetur qui nulla est.\" --user-id \"a05df6ce-d556-4574-bdc7-3c819a509644\" --token \"Totam nisi quo.\"")
+}
+
+func dummyUnshareItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy unshare-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -user-id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Stops sharing an item with a user. The owner can remove any share and users can remove their own`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -user-id STRING: User to stop sharing the item with`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy unshare-item --id \"Eveniet tempora dolores.\" --user-id \"Reiciendis tenetur ea temporibus.\" --token \"Commodi consequatur corporis qui et aut beatae.\"")
+}
+
+func dummyListItemSharesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy list-item-shares", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the users an item is shared with. Only the owner can list them`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-item-shares --id \"Molestiae minus ex explicabo ut accusantium.\" --token \"Eum quia unde perferendis.\"")
+}
+
+func dummyAddItemTagUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy add-item-tag", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Tags an item, creating the tag in the owner's tags if needed. Requires editor access`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: Tag name; tags are compared case-insensitively`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy add-item-tag --id \"Sit illum excepturi ut aut.\" --tag \"qhx\" --token \"Ea rerum sequi dolore.\"")
+}
+
+func dummyRemoveItemTagUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy remove-item-tag", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -tag STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Removes a tag from an item. Requires editor access`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -tag STRING: Tag name; tags are compared case-insensitively`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy remove-item-tag --id \"Error possimus libero animi qui quas perferendis.\" --tag \"82\" --token \"Alias officia et consequatur.\"")
+}
+
+func dummySuggestTagsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy suggest-tags", os.Args[0])
+	fmt.Fprint(os.Stderr, " -prefix STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Autocompletes the caller's tags, most used first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -prefix STRING: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy suggest-tags --prefix \"Error et omnis qui.\" --limit 23 --token \"Sunt distinctio perspiciatis occaecati dolores qui.\"")
+}
+
+func dummyUploadAttachmentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy upload-attachment", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -filename STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprint(os.Stderr, " -checksum-sha256 STRING")
+	fmt.Fprint(os.Stderr, " -stream STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Attaches a file to an item. The request body is the raw content, streamed to blob storage. Requires editor access`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -filename STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -checksum-sha256 STRING: `)
+	fmt.Fprintln(os.Stderr, `    -stream STRING: path to file containing the streamed request body`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy upload-attachment --id \"Culpa sapiente.\" --filename \"o\" --token \"Est dolorem minus asperiores est quia.\" --checksum-sha256 \"DF2E6D3aCEEeb951E14e5fcaaeEbE4B2CaFfFFcda35C22ee387FE28c9dFeDBfC\" --stream \"goa.png\"")
+}
+
+func dummyDownloadAttachmentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy download-attachment", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -attachment-id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Streams the content of an attachment as a download. HTML, XML and script content is served as application/octet-stream`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -attachment-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy download-attachment --id \"Quia et nesciunt similique qui qui atque.\" --attachment-id \"Voluptatem et quas rerum eveniet ducimus expedita.\" --token \"Ut esse sunt perspiciatis qui.\"")
+}
+
+func dummyListAttachmentsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy list-attachments", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the attachments of an item, oldest first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-attachments --id \"Voluptatem vitae totam praesentium repellendus cumque.\" --token \"Ullam tenetur itaque.\"")
+}
+
+func dummyDeleteAttachmentUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy delete-attachment", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -attachment-id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Deletes an attachment and its content. Requires editor access`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -attachment-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy delete-attachment --id \"Id non voluptas adipisci deserunt nobis.\" --attachment-id \"Dolores autem assumenda quasi.\" --token \"Sapiente sed fugiat.\"")
+}
+
+func dummyBatchCreateItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy batch-create-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Creates up to 100 items, atomically unless atomic is false`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy batch-create-items --body '{\n      \"atomic\": true,\n      \"items\": [\n         {\n            \"description\": \"Soluta ratione consequuntur ipsum voluptas nemo.\",\n            \"language\": \"yiddish\",\n            \"name\": \"wts\"\n         },\n         {\n            \"description\": \"Soluta ratione consequuntur ipsum voluptas nemo.\",\n            \"language\": \"yiddish\",\n            \"name\": \"wts\"\n         },\n         {\n            \"description\": \"Soluta ratione consequuntur ipsum voluptas nemo.\",\n            \"language\": \"yiddish\",\n            \"name\": \"wts\"\n         }\n      ]\n   }' --token \"Autem ullam et delectus qui.\"")
+}
+
+func dummyBatchUpdateItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy batch-update-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Changes the given fields of up to 100 items like patch_item, atomically unless atomic is false`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy batch-update-items --body '{\n      \"atomic\": false,\n      \"items\": [\n         {\n            \"description\": \"Sed ipsam sit quasi cupiditate unde repellat.\",\n            \"id\": \"Iste et architecto quibusdam.\",\n            \"name\": \"hvm\",\n            \"version\": 8167723690863062301\n         },\n         {\n            \"description\": \"Sed ipsam sit quasi cupiditate unde repellat.\",\n            \"id\": \"Iste et architecto quibusdam.\",\n            \"name\": \"hvm\",\n            \"version\": 8167723690863062301\n         },\n         {\n            \"description\": \"Sed ipsam sit quasi cupiditate unde repellat.\",\n            \"id\": \"Iste et architecto quibusdam.\",\n            \"name\": \"hvm\",\n            \"version\": 8167723690863062301\n         }\n      ]\n   }' --token \"Ducimus aut.\"")
+}
+
+func dummyBatchDeleteItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy batch-delete-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Moves up to 100 items to the trash, atomically unless atomic is false`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy batch-delete-items --body '{\n      \"atomic\": true,\n      \"ids\": [\n         \"Optio eligendi vel.\",\n         \"Quaerat voluptas.\",\n         \"Nostrum provident earum quidem.\"\n      ]\n   }' --token \"Deleniti recusandae cumque tempore dolor esse quia.\"")
+}
+
+func dummyExportItemsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy export-items", os.Args[0])
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Streams the caller's items, oldest first, as CSV or newline-delimited JSON. Trashed and shared items are left out`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy export-items --format \"ndjson\" --token \"Vel tenetur ut in expedita quibusdam.\"")
+}
+
+func dummyImportItemsFileUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy import-items-file", os.Args[0])
+	fmt.Fprint(os.Stderr, " -format STRING")
+	fmt.Fprint(os.Stderr, " -dry-run BOOL")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprint(os.Stderr, " -stream STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Creates items from a CSV or newline-delimited JSON file sent as the request body. Every row is validated first and nothing is imported unless all of them are valid; with dry_run nothing is imported either way`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -format STRING: `)
+	fmt.Fprintln(os.Stderr, `    -dry-run BOOL: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+	fmt.Fprintln(os.Stderr, `    -stream STRING: path to file containing the streamed request body`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy import-items-file --format \"csv\" --dry-run true --token \"Consequatur ex mollitia.\" --stream \"goa.png\"")
+}
+
+func dummyListTrashUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy list-trash", os.Args[0])
+	fmt.Fprint(os.Stderr, " -page-size INT")
+	fmt.Fprint(os.Stderr, " -cursor STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the caller's trashed items, most recently deleted first`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -page-size INT: `)
+	fmt.Fprintln(os.Stderr, `    -cursor STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy list-trash --page-size 64 --cursor \"Tempora placeat maxime.\" --token \"Accusantium ut nam est.\"")
+}
+
+func dummyRestoreItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy restore-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Moves an item out of the trash`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy restore-item --id \"Sed beatae consequatur adipisci dolor laborum.\" --token \"Explicabo voluptatem.\"")
+}
+
+func dummyPurgeItemUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] dummy purge-item", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Permanently deletes a trashed item`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -id STRING: `)
@@ -247,5 +1015,5 @@ func dummyDeleteItemUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy delete-item --id \"Totam dolores harum minima voluptatem.\" --token \"Consectetur enim porro dolores.\"")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "dummy purge-item --id \"Et sit tenetur ipsam est sunt odio.\" --token \"Et voluptatem voluptates quaerat.\"")
 }
@@ -10,8 +10,11 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"unicode/utf8"
 
 	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildCreateItemPayload builds the payload for the dummy create_item endpoint
@@ -22,7 +25,15 @@ func BuildCreateItemPayload(dummyCreateItemBody string, dummyCreateItemToken str
 	{
 		err = json.Unmarshal([]byte(dummyCreateItemBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Sunt iusto.\",\n      \"name\": \"Quisquam odio sit eaque aut perferendis temporibus.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Modi quia et.\",\n      \"language\": \"lithuanian\",\n      \"name\": \"Et aliquid repudiandae id doloribus sit ut.\"\n   }'")
+		}
+		if body.Language != nil {
+			if !(*body.Language == "simple" || *body.Language == "arabic" || *body.Language == "armenian" || *body.Language == "basque" || *body.Language == "catalan" || *body.Language == "danish" || *body.Language == "dutch" || *body.Language == "english" || *body.Language == "finnish" || *body.Language == "french" || *body.Language == "german" || *body.Language == "greek" || *body.Language == "hindi" || *body.Language == "hungarian" || *body.Language == "indonesian" || *body.Language == "irish" || *body.Language == "italian" || *body.Language == "lithuanian" || *body.Language == "nepali" || *body.Language == "norwegian" || *body.Language == "portuguese" || *body.Language == "romanian" || *body.Language == "russian" || *body.Language == "serbian" || *body.Language == "spanish" || *body.Language == "swedish" || *body.Language == "tamil" || *body.Language == "turkish" || *body.Language == "yiddish") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+			}
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	var token string
@@ -32,6 +43,7 @@ func BuildCreateItemPayload(dummyCreateItemBody string, dummyCreateItemToken str
 	v := &dummy.CreateItemPayload{
 		Name:        body.Name,
 		Description: body.Description,
+		Language:    body.Language,
 	}
 	v.Token = token
 
@@ -40,12 +52,191 @@ func BuildCreateItemPayload(dummyCreateItemBody string, dummyCreateItemToken str
 
 // BuildListItemsPayload builds the payload for the dummy list_items endpoint
 // from CLI flags.
-func BuildListItemsPayload(dummyListItemsToken string) (*dummy.ListItemsPayload, error) {
+func BuildListItemsPayload(dummyListItemsPageSize string, dummyListItemsCursor string, dummyListItemsOrder string, dummyListItemsNamePrefix string, dummyListItemsCreatedAfter string, dummyListItemsCreatedBefore string, dummyListItemsIncludeTotal string, dummyListItemsTags string, dummyListItemsTagMatch string, dummyListItemsToken string) (*dummy.ListItemsPayload, error) {
+	var err error
+	var pageSize int
+	{
+		if dummyListItemsPageSize != "" {
+			var v int64
+			v, err = strconv.ParseInt(dummyListItemsPageSize, 10, strconv.IntSize)
+			pageSize = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for pageSize, must be INT")
+			}
+			if pageSize < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+			}
+			if pageSize > 200 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 200, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if dummyListItemsCursor != "" {
+			cursor = &dummyListItemsCursor
+		}
+	}
+	var order string
+	{
+		if dummyListItemsOrder != "" {
+			order = dummyListItemsOrder
+			if !(order == "desc" || order == "asc") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("order", order, []any{"desc", "asc"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var namePrefix *string
+	{
+		if dummyListItemsNamePrefix != "" {
+			namePrefix = &dummyListItemsNamePrefix
+		}
+	}
+	var createdAfter *string
+	{
+		if dummyListItemsCreatedAfter != "" {
+			createdAfter = &dummyListItemsCreatedAfter
+			err = goa.MergeErrors(err, goa.ValidateFormat("created_after", *createdAfter, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var createdBefore *string
+	{
+		if dummyListItemsCreatedBefore != "" {
+			createdBefore = &dummyListItemsCreatedBefore
+			err = goa.MergeErrors(err, goa.ValidateFormat("created_before", *createdBefore, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var includeTotal bool
+	{
+		if dummyListItemsIncludeTotal != "" {
+			includeTotal, err = strconv.ParseBool(dummyListItemsIncludeTotal)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for includeTotal, must be BOOL")
+			}
+		}
+	}
+	var tags []string
+	{
+		if dummyListItemsTags != "" {
+			err = json.Unmarshal([]byte(dummyListItemsTags), &tags)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for tags, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"Dolor ratione occaecati magnam.\",\n      \"Eveniet et eaque perspiciatis.\",\n      \"Quo voluptas autem.\"\n   ]'")
+			}
+			if len(tags) > 20 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("tags", tags, len(tags), 20, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var tagMatch string
+	{
+		if dummyListItemsTagMatch != "" {
+			tagMatch = dummyListItemsTagMatch
+			if !(tagMatch == "any" || tagMatch == "all") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("tag_match", tagMatch, []any{"any", "all"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var token string
 	{
 		token = dummyListItemsToken
 	}
 	v := &dummy.ListItemsPayload{}
+	v.PageSize = pageSize
+	v.Cursor = cursor
+	v.Order = order
+	v.NamePrefix = namePrefix
+	v.CreatedAfter = createdAfter
+	v.CreatedBefore = createdBefore
+	v.IncludeTotal = includeTotal
+	v.Tags = tags
+	v.TagMatch = tagMatch
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildSearchItemsPayload builds the payload for the dummy search_items
+// endpoint from CLI flags.
+func BuildSearchItemsPayload(dummySearchItemsQuery string, dummySearchItemsLanguage string, dummySearchItemsPageSize string, dummySearchItemsCursor string, dummySearchItemsToken string) (*dummy.SearchItemsPayload, error) {
+	var err error
+	var query string
+	{
+		query = dummySearchItemsQuery
+		if utf8.RuneCountInString(query) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		}
+		if utf8.RuneCountInString(query) > 500 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 500, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var language *string
+	{
+		if dummySearchItemsLanguage != "" {
+			language = &dummySearchItemsLanguage
+			if !(*language == "simple" || *language == "arabic" || *language == "armenian" || *language == "basque" || *language == "catalan" || *language == "danish" || *language == "dutch" || *language == "english" || *language == "finnish" || *language == "french" || *language == "german" || *language == "greek" || *language == "hindi" || *language == "hungarian" || *language == "indonesian" || *language == "irish" || *language == "italian" || *language == "lithuanian" || *language == "nepali" || *language == "norwegian" || *language == "portuguese" || *language == "romanian" || *language == "russian" || *language == "serbian" || *language == "spanish" || *language == "swedish" || *language == "tamil" || *language == "turkish" || *language == "yiddish") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("language", *language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var pageSize int
+	{
+		if dummySearchItemsPageSize != "" {
+			var v int64
+			v, err = strconv.ParseInt(dummySearchItemsPageSize, 10, strconv.IntSize)
+			pageSize = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for pageSize, must be INT")
+			}
+			if pageSize < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+			}
+			if pageSize > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if dummySearchItemsCursor != "" {
+			cursor = &dummySearchItemsCursor
+		}
+	}
+	var token string
+	{
+		token = dummySearchItemsToken
+	}
+	v := &dummy.SearchItemsPayload{}
+	v.Query = query
+	v.Language = language
+	v.PageSize = pageSize
+	v.Cursor = cursor
 	v.Token = token
 
 	return v, nil
@@ -69,6 +260,89 @@ func BuildGetItemPayload(dummyGetItemID string, dummyGetItemToken string) (*dumm
 	return v, nil
 }
 
+// BuildUpdateItemPayload builds the payload for the dummy update_item endpoint
+// from CLI flags.
+func BuildUpdateItemPayload(dummyUpdateItemBody string, dummyUpdateItemID string, dummyUpdateItemToken string, dummyUpdateItemIfMatch string) (*dummy.UpdateItemPayload, error) {
+	var err error
+	var body struct {
+		Name *string `form:"name" json:"name" xml:"name"`
+		// New description; omit to clear it
+		Description *string `form:"description" json:"description" xml:"description"`
+	}
+	{
+		err = json.Unmarshal([]byte(dummyUpdateItemBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Perferendis quo voluptas.\",\n      \"name\": \"Dolorum cum eum.\"\n   }'")
+		}
+	}
+	var id string
+	{
+		id = dummyUpdateItemID
+	}
+	var token string
+	{
+		token = dummyUpdateItemToken
+	}
+	var ifMatch *string
+	{
+		if dummyUpdateItemIfMatch != "" {
+			ifMatch = &dummyUpdateItemIfMatch
+		}
+	}
+	v := &dummy.UpdateItemPayload{
+		Description: body.Description,
+	}
+	if body.Name != nil {
+		v.Name = *body.Name
+	}
+	v.ID = id
+	v.Token = token
+	v.IfMatch = ifMatch
+
+	return v, nil
+}
+
+// BuildPatchItemPayload builds the payload for the dummy patch_item endpoint
+// from CLI flags.
+func BuildPatchItemPayload(dummyPatchItemBody string, dummyPatchItemID string, dummyPatchItemToken string, dummyPatchItemIfMatch string) (*dummy.PatchItemPayload, error) {
+	var err error
+	var body struct {
+		// New name; omit to keep it
+		Name *string `form:"name" json:"name" xml:"name"`
+		// New description; omit to keep it, send an empty string to clear it
+		Description *string `form:"description" json:"description" xml:"description"`
+	}
+	{
+		err = json.Unmarshal([]byte(dummyPatchItemBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"description\": \"Nam incidunt.\",\n      \"name\": \"a\"\n   }'")
+		}
+	}
+	var id string
+	{
+		id = dummyPatchItemID
+	}
+	var token string
+	{
+		token = dummyPatchItemToken
+	}
+	var ifMatch *string
+	{
+		if dummyPatchItemIfMatch != "" {
+			ifMatch = &dummyPatchItemIfMatch
+		}
+	}
+	v := &dummy.PatchItemPayload{
+		Name:        body.Name,
+		Description: body.Description,
+	}
+	v.ID = id
+	v.Token = token
+	v.IfMatch = ifMatch
+
+	return v, nil
+}
+
 // BuildDeleteItemPayload builds the payload for the dummy delete_item endpoint
 // from CLI flags.
 func BuildDeleteItemPayload(dummyDeleteItemID string, dummyDeleteItemToken string) (*dummy.ItemIDPayload, error) {
@@ -86,3 +360,614 @@ func BuildDeleteItemPayload(dummyDeleteItemID string, dummyDeleteItemToken strin
 
 	return v, nil
 }
+
+// BuildShareItemPayload builds the payload for the dummy share_item endpoint
+// from CLI flags.
+func BuildShareItemPayload(dummyShareItemBody string, dummyShareItemID string, dummyShareItemUserID string, dummyShareItemToken string) (*dummy.ShareItemPayload, error) {
+	var err error
+	var body struct {
+		// viewer can read the item, editor can also update it
+		Permission *string `form:"permission" json:"permission" xml:"permission"`
+	}
+	{
+		err = json.Unmarshal([]byte(dummyShareItemBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"permission\": \"viewer\"\n   }'")
+		}
+	}
+	var id string
+	{
+		id = dummyShareItemID
+	}
+	var userID string
+	{
+		userID = dummyShareItemUserID
+		err = goa.MergeErrors(err, goa.ValidateFormat("user_id", userID, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = dummyShareItemToken
+	}
+	v := &dummy.ShareItemPayload{}
+	if body.Permission != nil {
+		v.Permission = *body.Permission
+	}
+	v.ID = id
+	v.UserID = userID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUnshareItemPayload builds the payload for the dummy unshare_item
+// endpoint from CLI flags.
+func BuildUnshareItemPayload(dummyUnshareItemID string, dummyUnshareItemUserID string, dummyUnshareItemToken string) (*dummy.UnshareItemPayload, error) {
+	var id string
+	{
+		id = dummyUnshareItemID
+	}
+	var userID string
+	{
+		userID = dummyUnshareItemUserID
+	}
+	var token string
+	{
+		token = dummyUnshareItemToken
+	}
+	v := &dummy.UnshareItemPayload{}
+	v.ID = id
+	v.UserID = userID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListItemSharesPayload builds the payload for the dummy list_item_shares
+// endpoint from CLI flags.
+func BuildListItemSharesPayload(dummyListItemSharesID string, dummyListItemSharesToken string) (*dummy.ItemIDPayload, error) {
+	var id string
+	{
+		id = dummyListItemSharesID
+	}
+	var token string
+	{
+		token = dummyListItemSharesToken
+	}
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAddItemTagPayload builds the payload for the dummy add_item_tag
+// endpoint from CLI flags.
+func BuildAddItemTagPayload(dummyAddItemTagID string, dummyAddItemTagTag string, dummyAddItemTagToken string) (*dummy.ItemTagPayload, error) {
+	var err error
+	var id string
+	{
+		id = dummyAddItemTagID
+	}
+	var tag string
+	{
+		tag = dummyAddItemTagTag
+		err = goa.MergeErrors(err, goa.ValidatePattern("tag", tag, "^\\S(.*\\S)?$"))
+		if utf8.RuneCountInString(tag) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tag", tag, utf8.RuneCountInString(tag), 1, true))
+		}
+		if utf8.RuneCountInString(tag) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tag", tag, utf8.RuneCountInString(tag), 50, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = dummyAddItemTagToken
+	}
+	v := &dummy.ItemTagPayload{}
+	v.ID = id
+	v.Tag = tag
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRemoveItemTagPayload builds the payload for the dummy remove_item_tag
+// endpoint from CLI flags.
+func BuildRemoveItemTagPayload(dummyRemoveItemTagID string, dummyRemoveItemTagTag string, dummyRemoveItemTagToken string) (*dummy.ItemTagPayload, error) {
+	var err error
+	var id string
+	{
+		id = dummyRemoveItemTagID
+	}
+	var tag string
+	{
+		tag = dummyRemoveItemTagTag
+		err = goa.MergeErrors(err, goa.ValidatePattern("tag", tag, "^\\S(.*\\S)?$"))
+		if utf8.RuneCountInString(tag) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tag", tag, utf8.RuneCountInString(tag), 1, true))
+		}
+		if utf8.RuneCountInString(tag) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tag", tag, utf8.RuneCountInString(tag), 50, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = dummyRemoveItemTagToken
+	}
+	v := &dummy.ItemTagPayload{}
+	v.ID = id
+	v.Tag = tag
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildSuggestTagsPayload builds the payload for the dummy suggest_tags
+// endpoint from CLI flags.
+func BuildSuggestTagsPayload(dummySuggestTagsPrefix string, dummySuggestTagsLimit string, dummySuggestTagsToken string) (*dummy.SuggestTagsPayload, error) {
+	var err error
+	var prefix string
+	{
+		if dummySuggestTagsPrefix != "" {
+			prefix = dummySuggestTagsPrefix
+		}
+	}
+	var limit int
+	{
+		if dummySuggestTagsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(dummySuggestTagsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 50 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 50, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = dummySuggestTagsToken
+	}
+	v := &dummy.SuggestTagsPayload{}
+	v.Prefix = prefix
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildUploadAttachmentPayload builds the payload for the dummy
+// upload_attachment endpoint from CLI flags.
+func BuildUploadAttachmentPayload(dummyUploadAttachmentID string, dummyUploadAttachmentFilename string, dummyUploadAttachmentToken string, dummyUploadAttachmentChecksumSha256 string) (*dummy.UploadAttachmentPayload, error) {
+	var err error
+	var id string
+	{
+		id = dummyUploadAttachmentID
+	}
+	var filename string
+	{
+		filename = dummyUploadAttachmentFilename
+		if utf8.RuneCountInString(filename) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("filename", filename, utf8.RuneCountInString(filename), 1, true))
+		}
+		if utf8.RuneCountInString(filename) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("filename", filename, utf8.RuneCountInString(filename), 255, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = dummyUploadAttachmentToken
+	}
+	var checksumSha256 *string
+	{
+		if dummyUploadAttachmentChecksumSha256 != "" {
+			checksumSha256 = &dummyUploadAttachmentChecksumSha256
+			err = goa.MergeErrors(err, goa.ValidatePattern("checksum_sha256", *checksumSha256, "^[0-9a-fA-F]{64}$"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &dummy.UploadAttachmentPayload{}
+	v.ID = id
+	v.Filename = filename
+	v.Token = token
+	v.ChecksumSha256 = checksumSha256
+
+	return v, nil
+}
+
+// BuildDownloadAttachmentPayload builds the payload for the dummy
+// download_attachment endpoint from CLI flags.
+func BuildDownloadAttachmentPayload(dummyDownloadAttachmentID string, dummyDownloadAttachmentAttachmentID string, dummyDownloadAttachmentToken string) (*dummy.AttachmentIDPayload, error) {
+	var id string
+	{
+		id = dummyDownloadAttachmentID
+	}
+	var attachmentID string
+	{
+		attachmentID = dummyDownloadAttachmentAttachmentID
+	}
+	var token string
+	{
+		token = dummyDownloadAttachmentToken
+	}
+	v := &dummy.AttachmentIDPayload{}
+	v.ID = id
+	v.AttachmentID = attachmentID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListAttachmentsPayload builds the payload for the dummy
+// list_attachments endpoint from CLI flags.
+func BuildListAttachmentsPayload(dummyListAttachmentsID string, dummyListAttachmentsToken string) (*dummy.ItemIDPayload, error) {
+	var id string
+	{
+		id = dummyListAttachmentsID
+	}
+	var token string
+	{
+		token = dummyListAttachmentsToken
+	}
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteAttachmentPayload builds the payload for the dummy
+// delete_attachment endpoint from CLI flags.
+func BuildDeleteAttachmentPayload(dummyDeleteAttachmentID string, dummyDeleteAttachmentAttachmentID string, dummyDeleteAttachmentToken string) (*dummy.AttachmentIDPayload, error) {
+	var id string
+	{
+		id = dummyDeleteAttachmentID
+	}
+	var attachmentID string
+	{
+		attachmentID = dummyDeleteAttachmentAttachmentID
+	}
+	var token string
+	{
+		token = dummyDeleteAttachmentToken
+	}
+	v := &dummy.AttachmentIDPayload{}
+	v.ID = id
+	v.AttachmentID = attachmentID
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildBatchCreateItemsPayload builds the payload for the dummy
+// batch_create_items endpoint from CLI flags.
+func BuildBatchCreateItemsPayload(dummyBatchCreateItemsBody string, dummyBatchCreateItemsToken string) (*dummy.BatchCreateItemsPayload, error) {
+	var err error
+	var body BatchCreateItemsRequestBody
+	{
+		err = json.Unmarshal([]byte(dummyBatchCreateItemsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"atomic\": true,\n      \"items\": [\n         {\n            \"description\": \"Soluta ratione consequuntur ipsum voluptas nemo.\",\n            \"language\": \"yiddish\",\n            \"name\": \"wts\"\n         },\n         {\n            \"description\": \"Soluta ratione consequuntur ipsum voluptas nemo.\",\n            \"language\": \"yiddish\",\n            \"name\": \"wts\"\n         },\n         {\n            \"description\": \"Soluta ratione consequuntur ipsum voluptas nemo.\",\n            \"language\": \"yiddish\",\n            \"name\": \"wts\"\n         }\n      ]\n   }'")
+		}
+		if body.Items == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+		}
+		if len(body.Items) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+		}
+		if len(body.Items) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+		}
+		for _, e := range body.Items {
+			if e != nil {
+				if err2 := ValidateBatchItemEntryRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = dummyBatchCreateItemsToken
+	}
+	v := &dummy.BatchCreateItemsPayload{
+		Atomic: body.Atomic,
+	}
+	if body.Items != nil {
+		v.Items = make([]*dummy.BatchItemEntry, len(body.Items))
+		for i, val := range body.Items {
+			if val == nil {
+				v.Items[i] = nil
+				continue
+			}
+			v.Items[i] = marshalBatchItemEntryRequestBodyToDummyBatchItemEntry(val)
+		}
+	} else {
+		v.Items = []*dummy.BatchItemEntry{}
+	}
+	{
+		var zero bool
+		if v.Atomic == zero {
+			v.Atomic = true
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildBatchUpdateItemsPayload builds the payload for the dummy
+// batch_update_items endpoint from CLI flags.
+func BuildBatchUpdateItemsPayload(dummyBatchUpdateItemsBody string, dummyBatchUpdateItemsToken string) (*dummy.BatchUpdateItemsPayload, error) {
+	var err error
+	var body BatchUpdateItemsRequestBody
+	{
+		err = json.Unmarshal([]byte(dummyBatchUpdateItemsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"atomic\": false,\n      \"items\": [\n         {\n            \"description\": \"Sed ipsam sit quasi cupiditate unde repellat.\",\n            \"id\": \"Iste et architecto quibusdam.\",\n            \"name\": \"hvm\",\n            \"version\": 8167723690863062301\n         },\n         {\n            \"description\": \"Sed ipsam sit quasi cupiditate unde repellat.\",\n            \"id\": \"Iste et architecto quibusdam.\",\n            \"name\": \"hvm\",\n            \"version\": 8167723690863062301\n         },\n         {\n            \"description\": \"Sed ipsam sit quasi cupiditate unde repellat.\",\n            \"id\": \"Iste et architecto quibusdam.\",\n            \"name\": \"hvm\",\n            \"version\": 8167723690863062301\n         }\n      ]\n   }'")
+		}
+		if body.Items == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+		}
+		if len(body.Items) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+		}
+		if len(body.Items) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+		}
+		for _, e := range body.Items {
+			if e != nil {
+				if err2 := ValidateBatchUpdateEntryRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = dummyBatchUpdateItemsToken
+	}
+	v := &dummy.BatchUpdateItemsPayload{
+		Atomic: body.Atomic,
+	}
+	if body.Items != nil {
+		v.Items = make([]*dummy.BatchUpdateEntry, len(body.Items))
+		for i, val := range body.Items {
+			if val == nil {
+				v.Items[i] = nil
+				continue
+			}
+			v.Items[i] = marshalBatchUpdateEntryRequestBodyToDummyBatchUpdateEntry(val)
+		}
+	} else {
+		v.Items = []*dummy.BatchUpdateEntry{}
+	}
+	{
+		var zero bool
+		if v.Atomic == zero {
+			v.Atomic = true
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildBatchDeleteItemsPayload builds the payload for the dummy
+// batch_delete_items endpoint from CLI flags.
+func BuildBatchDeleteItemsPayload(dummyBatchDeleteItemsBody string, dummyBatchDeleteItemsToken string) (*dummy.BatchDeleteItemsPayload, error) {
+	var err error
+	var body BatchDeleteItemsRequestBody
+	{
+		err = json.Unmarshal([]byte(dummyBatchDeleteItemsBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"atomic\": true,\n      \"ids\": [\n         \"Optio eligendi vel.\",\n         \"Quaerat voluptas.\",\n         \"Nostrum provident earum quidem.\"\n      ]\n   }'")
+		}
+		if body.Ids == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("ids", "body"))
+		}
+		if len(body.Ids) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.ids", body.Ids, len(body.Ids), 1, true))
+		}
+		if len(body.Ids) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.ids", body.Ids, len(body.Ids), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = dummyBatchDeleteItemsToken
+	}
+	v := &dummy.BatchDeleteItemsPayload{
+		Atomic: body.Atomic,
+	}
+	if body.Ids != nil {
+		v.Ids = make([]string, len(body.Ids))
+		for i, val := range body.Ids {
+			v.Ids[i] = val
+		}
+	} else {
+		v.Ids = []string{}
+	}
+	{
+		var zero bool
+		if v.Atomic == zero {
+			v.Atomic = true
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildExportItemsPayload builds the payload for the dummy export_items
+// endpoint from CLI flags.
+func BuildExportItemsPayload(dummyExportItemsFormat string, dummyExportItemsToken string) (*dummy.ExportItemsPayload, error) {
+	var err error
+	var format string
+	{
+		if dummyExportItemsFormat != "" {
+			format = dummyExportItemsFormat
+			if !(format == "csv" || format == "ndjson") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "ndjson"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = dummyExportItemsToken
+	}
+	v := &dummy.ExportItemsPayload{}
+	v.Format = format
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildImportItemsFilePayload builds the payload for the dummy
+// import_items_file endpoint from CLI flags.
+func BuildImportItemsFilePayload(dummyImportItemsFileFormat string, dummyImportItemsFileDryRun string, dummyImportItemsFileToken string) (*dummy.ImportItemsFilePayload, error) {
+	var err error
+	var format string
+	{
+		if dummyImportItemsFileFormat != "" {
+			format = dummyImportItemsFileFormat
+			if !(format == "csv" || format == "ndjson") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "ndjson"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var dryRun bool
+	{
+		if dummyImportItemsFileDryRun != "" {
+			dryRun, err = strconv.ParseBool(dummyImportItemsFileDryRun)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for dryRun, must be BOOL")
+			}
+		}
+	}
+	var token string
+	{
+		token = dummyImportItemsFileToken
+	}
+	v := &dummy.ImportItemsFilePayload{}
+	v.Format = format
+	v.DryRun = dryRun
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListTrashPayload builds the payload for the dummy list_trash endpoint
+// from CLI flags.
+func BuildListTrashPayload(dummyListTrashPageSize string, dummyListTrashCursor string, dummyListTrashToken string) (*dummy.ListTrashPayload, error) {
+	var err error
+	var pageSize int
+	{
+		if dummyListTrashPageSize != "" {
+			var v int64
+			v, err = strconv.ParseInt(dummyListTrashPageSize, 10, strconv.IntSize)
+			pageSize = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for pageSize, must be INT")
+			}
+			if pageSize < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+			}
+			if pageSize > 200 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 200, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var cursor *string
+	{
+		if dummyListTrashCursor != "" {
+			cursor = &dummyListTrashCursor
+		}
+	}
+	var token string
+	{
+		token = dummyListTrashToken
+	}
+	v := &dummy.ListTrashPayload{}
+	v.PageSize = pageSize
+	v.Cursor = cursor
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRestoreItemPayload builds the payload for the dummy restore_item
+// endpoint from CLI flags.
+func BuildRestoreItemPayload(dummyRestoreItemID string, dummyRestoreItemToken string) (*dummy.ItemIDPayload, error) {
+	var id string
+	{
+		id = dummyRestoreItemID
+	}
+	var token string
+	{
+		token = dummyRestoreItemToken
+	}
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildPurgeItemPayload builds the payload for the dummy purge_item endpoint
+// from CLI flags.
+func BuildPurgeItemPayload(dummyPurgeItemID string, dummyPurgeItemToken string) (*dummy.ItemIDPayload, error) {
+	var id string
+	{
+		id = dummyPurgeItemID
+	}
+	var token string
+	{
+		token = dummyPurgeItemToken
+	}
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
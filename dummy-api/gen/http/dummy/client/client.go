@@ -11,6 +11,7 @@ import (
 	"context"
 	"net/http"
 
+	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -25,14 +26,98 @@ type Client struct {
 	// endpoint.
 	ListItemsDoer goahttp.Doer
 
+	// SearchItems Doer is the HTTP client used to make requests to the
+	// search_items endpoint.
+	SearchItemsDoer goahttp.Doer
+
 	// GetItem Doer is the HTTP client used to make requests to the get_item
 	// endpoint.
 	GetItemDoer goahttp.Doer
 
+	// UpdateItem Doer is the HTTP client used to make requests to the update_item
+	// endpoint.
+	UpdateItemDoer goahttp.Doer
+
+	// PatchItem Doer is the HTTP client used to make requests to the patch_item
+	// endpoint.
+	PatchItemDoer goahttp.Doer
+
 	// DeleteItem Doer is the HTTP client used to make requests to the delete_item
 	// endpoint.
 	DeleteItemDoer goahttp.Doer
 
+	// ShareItem Doer is the HTTP client used to make requests to the share_item
+	// endpoint.
+	ShareItemDoer goahttp.Doer
+
+	// UnshareItem Doer is the HTTP client used to make requests to the
+	// unshare_item endpoint.
+	UnshareItemDoer goahttp.Doer
+
+	// ListItemShares Doer is the HTTP client used to make requests to the
+	// list_item_shares endpoint.
+	ListItemSharesDoer goahttp.Doer
+
+	// AddItemTag Doer is the HTTP client used to make requests to the add_item_tag
+	// endpoint.
+	AddItemTagDoer goahttp.Doer
+
+	// RemoveItemTag Doer is the HTTP client used to make requests to the
+	// remove_item_tag endpoint.
+	RemoveItemTagDoer goahttp.Doer
+
+	// SuggestTags Doer is the HTTP client used to make requests to the
+	// suggest_tags endpoint.
+	SuggestTagsDoer goahttp.Doer
+
+	// UploadAttachment Doer is the HTTP client used to make requests to the
+	// upload_attachment endpoint.
+	UploadAttachmentDoer goahttp.Doer
+
+	// DownloadAttachment Doer is the HTTP client used to make requests to the
+	// download_attachment endpoint.
+	DownloadAttachmentDoer goahttp.Doer
+
+	// ListAttachments Doer is the HTTP client used to make requests to the
+	// list_attachments endpoint.
+	ListAttachmentsDoer goahttp.Doer
+
+	// DeleteAttachment Doer is the HTTP client used to make requests to the
+	// delete_attachment endpoint.
+	DeleteAttachmentDoer goahttp.Doer
+
+	// BatchCreateItems Doer is the HTTP client used to make requests to the
+	// batch_create_items endpoint.
+	BatchCreateItemsDoer goahttp.Doer
+
+	// BatchUpdateItems Doer is the HTTP client used to make requests to the
+	// batch_update_items endpoint.
+	BatchUpdateItemsDoer goahttp.Doer
+
+	// BatchDeleteItems Doer is the HTTP client used to make requests to the
+	// batch_delete_items endpoint.
+	BatchDeleteItemsDoer goahttp.Doer
+
+	// ExportItems Doer is the HTTP client used to make requests to the
+	// export_items endpoint.
+	ExportItemsDoer goahttp.Doer
+
+	// ImportItemsFile Doer is the HTTP client used to make requests to the
+	// import_items_file endpoint.
+	ImportItemsFileDoer goahttp.Doer
+
+	// ListTrash Doer is the HTTP client used to make requests to the list_trash
+	// endpoint.
+	ListTrashDoer goahttp.Doer
+
+	// RestoreItem Doer is the HTTP client used to make requests to the
+	// restore_item endpoint.
+	RestoreItemDoer goahttp.Doer
+
+	// PurgeItem Doer is the HTTP client used to make requests to the purge_item
+	// endpoint.
+	PurgeItemDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -53,15 +138,36 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		CreateItemDoer:      doer,
-		ListItemsDoer:       doer,
-		GetItemDoer:         doer,
-		DeleteItemDoer:      doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		CreateItemDoer:         doer,
+		ListItemsDoer:          doer,
+		SearchItemsDoer:        doer,
+		GetItemDoer:            doer,
+		UpdateItemDoer:         doer,
+		PatchItemDoer:          doer,
+		DeleteItemDoer:         doer,
+		ShareItemDoer:          doer,
+		UnshareItemDoer:        doer,
+		ListItemSharesDoer:     doer,
+		AddItemTagDoer:         doer,
+		RemoveItemTagDoer:      doer,
+		SuggestTagsDoer:        doer,
+		UploadAttachmentDoer:   doer,
+		DownloadAttachmentDoer: doer,
+		ListAttachmentsDoer:    doer,
+		DeleteAttachmentDoer:   doer,
+		BatchCreateItemsDoer:   doer,
+		BatchUpdateItemsDoer:   doer,
+		BatchDeleteItemsDoer:   doer,
+		ExportItemsDoer:        doer,
+		ImportItemsFileDoer:    doer,
+		ListTrashDoer:          doer,
+		RestoreItemDoer:        doer,
+		PurgeItemDoer:          doer,
+		RestoreResponseBody:    restoreBody,
+		scheme:                 scheme,
+		host:                   host,
+		decoder:                dec,
+		encoder:                enc,
 	}
 }
 
@@ -113,6 +219,30 @@ func (c *Client) ListItems() goa.Endpoint {
 	}
 }
 
+// SearchItems returns an endpoint that makes HTTP requests to the dummy
+// service search_items server.
+func (c *Client) SearchItems() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSearchItemsRequest(c.encoder)
+		decodeResponse = DecodeSearchItemsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSearchItemsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SearchItemsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "search_items", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetItem returns an endpoint that makes HTTP requests to the dummy service
 // get_item server.
 func (c *Client) GetItem() goa.Endpoint {
@@ -137,6 +267,54 @@ func (c *Client) GetItem() goa.Endpoint {
 	}
 }
 
+// UpdateItem returns an endpoint that makes HTTP requests to the dummy service
+// update_item server.
+func (c *Client) UpdateItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateItemRequest(c.encoder)
+		decodeResponse = DecodeUpdateItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "update_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PatchItem returns an endpoint that makes HTTP requests to the dummy service
+// patch_item server.
+func (c *Client) PatchItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePatchItemRequest(c.encoder)
+		decodeResponse = DecodePatchItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPatchItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PatchItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "patch_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // DeleteItem returns an endpoint that makes HTTP requests to the dummy service
 // delete_item server.
 func (c *Client) DeleteItem() goa.Endpoint {
@@ -160,3 +338,445 @@ func (c *Client) DeleteItem() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ShareItem returns an endpoint that makes HTTP requests to the dummy service
+// share_item server.
+func (c *Client) ShareItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeShareItemRequest(c.encoder)
+		decodeResponse = DecodeShareItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildShareItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ShareItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "share_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UnshareItem returns an endpoint that makes HTTP requests to the dummy
+// service unshare_item server.
+func (c *Client) UnshareItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUnshareItemRequest(c.encoder)
+		decodeResponse = DecodeUnshareItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUnshareItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UnshareItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "unshare_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListItemShares returns an endpoint that makes HTTP requests to the dummy
+// service list_item_shares server.
+func (c *Client) ListItemShares() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListItemSharesRequest(c.encoder)
+		decodeResponse = DecodeListItemSharesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListItemSharesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListItemSharesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "list_item_shares", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AddItemTag returns an endpoint that makes HTTP requests to the dummy service
+// add_item_tag server.
+func (c *Client) AddItemTag() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddItemTagRequest(c.encoder)
+		decodeResponse = DecodeAddItemTagResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddItemTagRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddItemTagDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "add_item_tag", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RemoveItemTag returns an endpoint that makes HTTP requests to the dummy
+// service remove_item_tag server.
+func (c *Client) RemoveItemTag() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRemoveItemTagRequest(c.encoder)
+		decodeResponse = DecodeRemoveItemTagResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRemoveItemTagRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RemoveItemTagDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "remove_item_tag", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SuggestTags returns an endpoint that makes HTTP requests to the dummy
+// service suggest_tags server.
+func (c *Client) SuggestTags() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSuggestTagsRequest(c.encoder)
+		decodeResponse = DecodeSuggestTagsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSuggestTagsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SuggestTagsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "suggest_tags", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UploadAttachment returns an endpoint that makes HTTP requests to the dummy
+// service upload_attachment server.
+func (c *Client) UploadAttachment() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUploadAttachmentRequest(c.encoder)
+		decodeResponse = DecodeUploadAttachmentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUploadAttachmentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UploadAttachmentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "upload_attachment", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DownloadAttachment returns an endpoint that makes HTTP requests to the dummy
+// service download_attachment server.
+func (c *Client) DownloadAttachment() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDownloadAttachmentRequest(c.encoder)
+		decodeResponse = DecodeDownloadAttachmentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDownloadAttachmentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DownloadAttachmentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "download_attachment", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &dummy.DownloadAttachmentResponseData{Result: res.(*dummy.AttachmentDownload), Body: resp.Body}, nil
+	}
+}
+
+// ListAttachments returns an endpoint that makes HTTP requests to the dummy
+// service list_attachments server.
+func (c *Client) ListAttachments() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAttachmentsRequest(c.encoder)
+		decodeResponse = DecodeListAttachmentsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAttachmentsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAttachmentsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "list_attachments", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteAttachment returns an endpoint that makes HTTP requests to the dummy
+// service delete_attachment server.
+func (c *Client) DeleteAttachment() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteAttachmentRequest(c.encoder)
+		decodeResponse = DecodeDeleteAttachmentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteAttachmentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteAttachmentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "delete_attachment", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BatchCreateItems returns an endpoint that makes HTTP requests to the dummy
+// service batch_create_items server.
+func (c *Client) BatchCreateItems() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBatchCreateItemsRequest(c.encoder)
+		decodeResponse = DecodeBatchCreateItemsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBatchCreateItemsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BatchCreateItemsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "batch_create_items", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BatchUpdateItems returns an endpoint that makes HTTP requests to the dummy
+// service batch_update_items server.
+func (c *Client) BatchUpdateItems() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBatchUpdateItemsRequest(c.encoder)
+		decodeResponse = DecodeBatchUpdateItemsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBatchUpdateItemsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BatchUpdateItemsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "batch_update_items", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// BatchDeleteItems returns an endpoint that makes HTTP requests to the dummy
+// service batch_delete_items server.
+func (c *Client) BatchDeleteItems() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeBatchDeleteItemsRequest(c.encoder)
+		decodeResponse = DecodeBatchDeleteItemsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildBatchDeleteItemsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.BatchDeleteItemsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "batch_delete_items", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ExportItems returns an endpoint that makes HTTP requests to the dummy
+// service export_items server.
+func (c *Client) ExportItems() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportItemsRequest(c.encoder)
+		decodeResponse = DecodeExportItemsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportItemsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportItemsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "export_items", err)
+		}
+		res, err := decodeResponse(resp)
+		if err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+		return &dummy.ExportItemsResponseData{Result: res.(*dummy.ItemsExport), Body: resp.Body}, nil
+	}
+}
+
+// ImportItemsFile returns an endpoint that makes HTTP requests to the dummy
+// service import_items_file server.
+func (c *Client) ImportItemsFile() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportItemsFileRequest(c.encoder)
+		decodeResponse = DecodeImportItemsFileResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportItemsFileRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportItemsFileDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "import_items_file", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListTrash returns an endpoint that makes HTTP requests to the dummy service
+// list_trash server.
+func (c *Client) ListTrash() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListTrashRequest(c.encoder)
+		decodeResponse = DecodeListTrashResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListTrashRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListTrashDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "list_trash", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RestoreItem returns an endpoint that makes HTTP requests to the dummy
+// service restore_item server.
+func (c *Client) RestoreItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRestoreItemRequest(c.encoder)
+		decodeResponse = DecodeRestoreItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRestoreItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RestoreItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "restore_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// PurgeItem returns an endpoint that makes HTTP requests to the dummy service
+// purge_item server.
+func (c *Client) PurgeItem() goa.Endpoint {
+	var (
+		encodeRequest  = EncodePurgeItemRequest(c.encoder)
+		decodeResponse = DecodePurgeItemResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildPurgeItemRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.PurgeItemDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("dummy", "purge_item", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -10,13 +10,17 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"os"
+	"strconv"
 
 	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	dummyviews "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy/views"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildCreateItemRequest instantiates a HTTP request object with method and
@@ -81,7 +85,18 @@ func DecodeCreateItemResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("dummy", "create_item", err)
 			}
-			p := NewCreateItemItemCreated(&body)
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "create_item", err)
+			}
+			p := NewCreateItemItemCreated(&body, etag)
 			view := "default"
 			vres := &dummyviews.Item{Projected: p, View: view}
 			if err = dummyviews.ValidateItem(vres); err != nil {
@@ -123,6 +138,27 @@ func EncodeListItemsRequest(encoder func(*http.Request) goahttp.Encoder) func(*h
 			head := p.Token
 			req.Header.Set("Authorization", head)
 		}
+		values := req.URL.Query()
+		values.Add("page_size", fmt.Sprintf("%v", p.PageSize))
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("order", p.Order)
+		if p.NamePrefix != nil {
+			values.Add("name_prefix", *p.NamePrefix)
+		}
+		if p.CreatedAfter != nil {
+			values.Add("created_after", *p.CreatedAfter)
+		}
+		if p.CreatedBefore != nil {
+			values.Add("created_before", *p.CreatedBefore)
+		}
+		values.Add("include_total", fmt.Sprintf("%v", p.IncludeTotal))
+		for _, value := range p.Tags {
+			values.Add("tags", value)
+		}
+		values.Add("tag_match", p.TagMatch)
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
@@ -130,6 +166,9 @@ func EncodeListItemsRequest(encoder func(*http.Request) goahttp.Encoder) func(*h
 // DecodeListItemsResponse returns a decoder for responses returned by the
 // dummy list_items endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
+// DecodeListItemsResponse may return the following errors:
+//   - "invalid_cursor" (type *dummy.DummyBadRequestError): http.StatusBadRequest
+//   - error: internal error
 func DecodeListItemsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -160,6 +199,20 @@ func DecodeListItemsResponse(decoder func(*http.Response) goahttp.Decoder, resto
 			}
 			res := NewListItemsItemsCollectionOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListItemsInvalidCursorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "list_items", err)
+			}
+			err = ValidateListItemsInvalidCursorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "list_items", err)
+			}
+			return nil, NewListItemsInvalidCursor(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("dummy", "list_items", resp.StatusCode, string(body))
@@ -167,6 +220,104 @@ func DecodeListItemsResponse(decoder func(*http.Response) goahttp.Decoder, resto
 	}
 }
 
+// BuildSearchItemsRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "search_items" endpoint
+func (c *Client) BuildSearchItemsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SearchItemsDummyPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "search_items", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSearchItemsRequest returns an encoder for requests sent to the dummy
+// search_items server.
+func EncodeSearchItemsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.SearchItemsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "search_items", "*dummy.SearchItemsPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		values.Add("q", p.Query)
+		if p.Language != nil {
+			values.Add("language", *p.Language)
+		}
+		values.Add("page_size", fmt.Sprintf("%v", p.PageSize))
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSearchItemsResponse returns a decoder for responses returned by the
+// dummy search_items endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeSearchItemsResponse may return the following errors:
+//   - "invalid_cursor" (type *dummy.DummyBadRequestError): http.StatusBadRequest
+//   - error: internal error
+func DecodeSearchItemsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SearchItemsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "search_items", err)
+			}
+			err = ValidateSearchItemsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "search_items", err)
+			}
+			res := NewSearchItemsSearchResultsCollectionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body SearchItemsInvalidCursorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "search_items", err)
+			}
+			err = ValidateSearchItemsInvalidCursorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "search_items", err)
+			}
+			return nil, NewSearchItemsInvalidCursor(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "search_items", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildGetItemRequest instantiates a HTTP request object with method and path
 // set to call the "dummy" service "get_item" endpoint
 func (c *Client) BuildGetItemRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -211,6 +362,9 @@ func EncodeGetItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*htt
 // DecodeGetItemResponse returns a decoder for responses returned by the dummy
 // get_item endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
+// DecodeGetItemResponse may return the following errors:
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
 func DecodeGetItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -235,7 +389,18 @@ func DecodeGetItemResponse(decoder func(*http.Response) goahttp.Decoder, restore
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("dummy", "get_item", err)
 			}
-			p := NewGetItemItemOK(&body)
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "get_item", err)
+			}
+			p := NewGetItemItemOK(&body, etag)
 			view := "default"
 			vres := &dummyviews.Item{Projected: p, View: view}
 			if err = dummyviews.ValidateItem(vres); err != nil {
@@ -243,6 +408,20 @@ func DecodeGetItemResponse(decoder func(*http.Response) goahttp.Decoder, restore
 			}
 			res := dummy.NewItem(vres)
 			return res, nil
+		case http.StatusNotFound:
+			var (
+				body GetItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "get_item", err)
+			}
+			err = ValidateGetItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "get_item", err)
+			}
+			return nil, NewGetItemNotFound(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("dummy", "get_item", resp.StatusCode, string(body))
@@ -250,23 +429,23 @@ func DecodeGetItemResponse(decoder func(*http.Response) goahttp.Decoder, restore
 	}
 }
 
-// BuildDeleteItemRequest instantiates a HTTP request object with method and
-// path set to call the "dummy" service "delete_item" endpoint
-func (c *Client) BuildDeleteItemRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildUpdateItemRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "update_item" endpoint
+func (c *Client) BuildUpdateItemRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
 		id string
 	)
 	{
-		p, ok := v.(*dummy.ItemIDPayload)
+		p, ok := v.(*dummy.UpdateItemPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("dummy", "delete_item", "*dummy.ItemIDPayload", v)
+			return nil, goahttp.ErrInvalidType("dummy", "update_item", "*dummy.UpdateItemPayload", v)
 		}
 		id = p.ID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteItemDummyPath(id)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateItemDummyPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("dummy", "delete_item", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("dummy", "update_item", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -275,26 +454,40 @@ func (c *Client) BuildDeleteItemRequest(ctx context.Context, v any) (*http.Reque
 	return req, nil
 }
 
-// EncodeDeleteItemRequest returns an encoder for requests sent to the dummy
-// delete_item server.
-func EncodeDeleteItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeUpdateItemRequest returns an encoder for requests sent to the dummy
+// update_item server.
+func EncodeUpdateItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*dummy.ItemIDPayload)
+		p, ok := v.(*dummy.UpdateItemPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("dummy", "delete_item", "*dummy.ItemIDPayload", v)
+			return goahttp.ErrInvalidType("dummy", "update_item", "*dummy.UpdateItemPayload", v)
 		}
 		{
 			head := p.Token
 			req.Header.Set("Authorization", head)
 		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		body := p
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("dummy", "update_item", err)
+		}
 		return nil
 	}
 }
 
-// DecodeDeleteItemResponse returns a decoder for responses returned by the
-// dummy delete_item endpoint. restoreBody controls whether the response body
+// DecodeUpdateItemResponse returns a decoder for responses returned by the
+// dummy update_item endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
-func DecodeDeleteItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+// DecodeUpdateItemResponse may return the following errors:
+//   - "conflict" (type *dummy.DummyConflictError): http.StatusPreconditionFailed
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - "precondition_required" (type *dummy.DummyPreconditionRequiredError): http.StatusPreconditionRequired
+//   - error: internal error
+func DecodeUpdateItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -309,24 +502,2398 @@ func DecodeDeleteItemResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusNoContent:
-			return nil, nil
+		case http.StatusOK:
+			var (
+				body UpdateItemResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "update_item", err)
+			}
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "update_item", err)
+			}
+			p := NewUpdateItemItemOK(&body, etag)
+			view := "default"
+			vres := &dummyviews.Item{Projected: p, View: view}
+			if err = dummyviews.ValidateItem(vres); err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "update_item", err)
+			}
+			res := dummy.NewItem(vres)
+			return res, nil
+		case http.StatusPreconditionFailed:
+			var (
+				body UpdateItemConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "update_item", err)
+			}
+			err = ValidateUpdateItemConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "update_item", err)
+			}
+			return nil, NewUpdateItemConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body UpdateItemForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "update_item", err)
+			}
+			err = ValidateUpdateItemForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "update_item", err)
+			}
+			return nil, NewUpdateItemForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body UpdateItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "update_item", err)
+			}
+			err = ValidateUpdateItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "update_item", err)
+			}
+			return nil, NewUpdateItemNotFound(&body)
+		case http.StatusPreconditionRequired:
+			var (
+				body UpdateItemPreconditionRequiredResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "update_item", err)
+			}
+			err = ValidateUpdateItemPreconditionRequiredResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "update_item", err)
+			}
+			return nil, NewUpdateItemPreconditionRequired(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("dummy", "delete_item", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("dummy", "update_item", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// unmarshalItemResponseBodyToDummyItem builds a value of type *dummy.Item from
-// a value of type *ItemResponseBody.
-func unmarshalItemResponseBodyToDummyItem(v *ItemResponseBody) *dummy.Item {
-	res := &dummy.Item{
-		ID:          *v.ID,
-		Name:        *v.Name,
-		Description: v.Description,
-		OwnerID:     *v.OwnerID,
-		CreatedAt:   *v.CreatedAt,
+// BuildPatchItemRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "patch_item" endpoint
+func (c *Client) BuildPatchItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*dummy.PatchItemPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "patch_item", "*dummy.PatchItemPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PatchItemDummyPath(id)}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "patch_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePatchItemRequest returns an encoder for requests sent to the dummy
+// patch_item server.
+func EncodePatchItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.PatchItemPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "patch_item", "*dummy.PatchItemPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		if p.IfMatch != nil {
+			head := *p.IfMatch
+			req.Header.Set("If-Match", head)
+		}
+		body := p
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("dummy", "patch_item", err)
+		}
+		return nil
+	}
+}
+
+// DecodePatchItemResponse returns a decoder for responses returned by the
+// dummy patch_item endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodePatchItemResponse may return the following errors:
+//   - "conflict" (type *dummy.DummyConflictError): http.StatusPreconditionFailed
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - "precondition_required" (type *dummy.DummyPreconditionRequiredError): http.StatusPreconditionRequired
+//   - error: internal error
+func DecodePatchItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PatchItemResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "patch_item", err)
+			}
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "patch_item", err)
+			}
+			p := NewPatchItemItemOK(&body, etag)
+			view := "default"
+			vres := &dummyviews.Item{Projected: p, View: view}
+			if err = dummyviews.ValidateItem(vres); err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "patch_item", err)
+			}
+			res := dummy.NewItem(vres)
+			return res, nil
+		case http.StatusPreconditionFailed:
+			var (
+				body PatchItemConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "patch_item", err)
+			}
+			err = ValidatePatchItemConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "patch_item", err)
+			}
+			return nil, NewPatchItemConflict(&body)
+		case http.StatusForbidden:
+			var (
+				body PatchItemForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "patch_item", err)
+			}
+			err = ValidatePatchItemForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "patch_item", err)
+			}
+			return nil, NewPatchItemForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body PatchItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "patch_item", err)
+			}
+			err = ValidatePatchItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "patch_item", err)
+			}
+			return nil, NewPatchItemNotFound(&body)
+		case http.StatusPreconditionRequired:
+			var (
+				body PatchItemPreconditionRequiredResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "patch_item", err)
+			}
+			err = ValidatePatchItemPreconditionRequiredResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "patch_item", err)
+			}
+			return nil, NewPatchItemPreconditionRequired(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "patch_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteItemRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "delete_item" endpoint
+func (c *Client) BuildDeleteItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "delete_item", "*dummy.ItemIDPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteItemDummyPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "delete_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteItemRequest returns an encoder for requests sent to the dummy
+// delete_item server.
+func EncodeDeleteItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "delete_item", "*dummy.ItemIDPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteItemResponse returns a decoder for responses returned by the
+// dummy delete_item endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteItemResponse may return the following errors:
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeDeleteItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "delete_item", err)
+			}
+			err = ValidateDeleteItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "delete_item", err)
+			}
+			return nil, NewDeleteItemNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "delete_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildShareItemRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "share_item" endpoint
+func (c *Client) BuildShareItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id     string
+		userID string
+	)
+	{
+		p, ok := v.(*dummy.ShareItemPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "share_item", "*dummy.ShareItemPayload", v)
+		}
+		id = p.ID
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ShareItemDummyPath(id, userID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "share_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeShareItemRequest returns an encoder for requests sent to the dummy
+// share_item server.
+func EncodeShareItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ShareItemPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "share_item", "*dummy.ShareItemPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := p
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("dummy", "share_item", err)
+		}
+		return nil
+	}
+}
+
+// DecodeShareItemResponse returns a decoder for responses returned by the
+// dummy share_item endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeShareItemResponse may return the following errors:
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeShareItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ShareItemResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "share_item", err)
+			}
+			err = ValidateShareItemResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "share_item", err)
+			}
+			res := NewShareItemItemShareOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ShareItemForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "share_item", err)
+			}
+			err = ValidateShareItemForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "share_item", err)
+			}
+			return nil, NewShareItemForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body ShareItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "share_item", err)
+			}
+			err = ValidateShareItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "share_item", err)
+			}
+			return nil, NewShareItemNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "share_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUnshareItemRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "unshare_item" endpoint
+func (c *Client) BuildUnshareItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id     string
+		userID string
+	)
+	{
+		p, ok := v.(*dummy.UnshareItemPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "unshare_item", "*dummy.UnshareItemPayload", v)
+		}
+		id = p.ID
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UnshareItemDummyPath(id, userID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "unshare_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUnshareItemRequest returns an encoder for requests sent to the dummy
+// unshare_item server.
+func EncodeUnshareItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.UnshareItemPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "unshare_item", "*dummy.UnshareItemPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeUnshareItemResponse returns a decoder for responses returned by the
+// dummy unshare_item endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeUnshareItemResponse may return the following errors:
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeUnshareItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body UnshareItemForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "unshare_item", err)
+			}
+			err = ValidateUnshareItemForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "unshare_item", err)
+			}
+			return nil, NewUnshareItemForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body UnshareItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "unshare_item", err)
+			}
+			err = ValidateUnshareItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "unshare_item", err)
+			}
+			return nil, NewUnshareItemNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "unshare_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListItemSharesRequest instantiates a HTTP request object with method
+// and path set to call the "dummy" service "list_item_shares" endpoint
+func (c *Client) BuildListItemSharesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "list_item_shares", "*dummy.ItemIDPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListItemSharesDummyPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "list_item_shares", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListItemSharesRequest returns an encoder for requests sent to the
+// dummy list_item_shares server.
+func EncodeListItemSharesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "list_item_shares", "*dummy.ItemIDPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeListItemSharesResponse returns a decoder for responses returned by the
+// dummy list_item_shares endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListItemSharesResponse may return the following errors:
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeListItemSharesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListItemSharesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "list_item_shares", err)
+			}
+			err = ValidateListItemSharesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "list_item_shares", err)
+			}
+			res := NewListItemSharesItemSharesCollectionOK(&body)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body ListItemSharesForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "list_item_shares", err)
+			}
+			err = ValidateListItemSharesForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "list_item_shares", err)
+			}
+			return nil, NewListItemSharesForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body ListItemSharesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "list_item_shares", err)
+			}
+			err = ValidateListItemSharesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "list_item_shares", err)
+			}
+			return nil, NewListItemSharesNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "list_item_shares", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddItemTagRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "add_item_tag" endpoint
+func (c *Client) BuildAddItemTagRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id  string
+		tag string
+	)
+	{
+		p, ok := v.(*dummy.ItemTagPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "add_item_tag", "*dummy.ItemTagPayload", v)
+		}
+		id = p.ID
+		tag = p.Tag
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddItemTagDummyPath(id, tag)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "add_item_tag", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddItemTagRequest returns an encoder for requests sent to the dummy
+// add_item_tag server.
+func EncodeAddItemTagRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ItemTagPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "add_item_tag", "*dummy.ItemTagPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeAddItemTagResponse returns a decoder for responses returned by the
+// dummy add_item_tag endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeAddItemTagResponse may return the following errors:
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeAddItemTagResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body AddItemTagResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "add_item_tag", err)
+			}
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "add_item_tag", err)
+			}
+			p := NewAddItemTagItemOK(&body, etag)
+			view := "default"
+			vres := &dummyviews.Item{Projected: p, View: view}
+			if err = dummyviews.ValidateItem(vres); err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "add_item_tag", err)
+			}
+			res := dummy.NewItem(vres)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body AddItemTagForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "add_item_tag", err)
+			}
+			err = ValidateAddItemTagForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "add_item_tag", err)
+			}
+			return nil, NewAddItemTagForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body AddItemTagNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "add_item_tag", err)
+			}
+			err = ValidateAddItemTagNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "add_item_tag", err)
+			}
+			return nil, NewAddItemTagNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "add_item_tag", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRemoveItemTagRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "remove_item_tag" endpoint
+func (c *Client) BuildRemoveItemTagRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id  string
+		tag string
+	)
+	{
+		p, ok := v.(*dummy.ItemTagPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "remove_item_tag", "*dummy.ItemTagPayload", v)
+		}
+		id = p.ID
+		tag = p.Tag
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RemoveItemTagDummyPath(id, tag)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "remove_item_tag", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRemoveItemTagRequest returns an encoder for requests sent to the dummy
+// remove_item_tag server.
+func EncodeRemoveItemTagRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ItemTagPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "remove_item_tag", "*dummy.ItemTagPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeRemoveItemTagResponse returns a decoder for responses returned by the
+// dummy remove_item_tag endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRemoveItemTagResponse may return the following errors:
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeRemoveItemTagResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RemoveItemTagResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "remove_item_tag", err)
+			}
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "remove_item_tag", err)
+			}
+			p := NewRemoveItemTagItemOK(&body, etag)
+			view := "default"
+			vres := &dummyviews.Item{Projected: p, View: view}
+			if err = dummyviews.ValidateItem(vres); err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "remove_item_tag", err)
+			}
+			res := dummy.NewItem(vres)
+			return res, nil
+		case http.StatusForbidden:
+			var (
+				body RemoveItemTagForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "remove_item_tag", err)
+			}
+			err = ValidateRemoveItemTagForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "remove_item_tag", err)
+			}
+			return nil, NewRemoveItemTagForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body RemoveItemTagNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "remove_item_tag", err)
+			}
+			err = ValidateRemoveItemTagNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "remove_item_tag", err)
+			}
+			return nil, NewRemoveItemTagNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "remove_item_tag", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSuggestTagsRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "suggest_tags" endpoint
+func (c *Client) BuildSuggestTagsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SuggestTagsDummyPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "suggest_tags", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSuggestTagsRequest returns an encoder for requests sent to the dummy
+// suggest_tags server.
+func EncodeSuggestTagsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.SuggestTagsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "suggest_tags", "*dummy.SuggestTagsPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		values.Add("prefix", p.Prefix)
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeSuggestTagsResponse returns a decoder for responses returned by the
+// dummy suggest_tags endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeSuggestTagsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SuggestTagsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "suggest_tags", err)
+			}
+			err = ValidateSuggestTagsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "suggest_tags", err)
+			}
+			res := NewSuggestTagsTagSuggestionsOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "suggest_tags", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUploadAttachmentRequest instantiates a HTTP request object with method
+// and path set to call the "dummy" service "upload_attachment" endpoint
+func (c *Client) BuildUploadAttachmentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id   string
+		body io.Reader
+	)
+	{
+		rd, ok := v.(*dummy.UploadAttachmentRequestData)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "upload_attachment", "dummy.UploadAttachmentRequestData", v)
+		}
+		p := rd.Payload
+		body = rd.Body
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UploadAttachmentDummyPath(id)}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "upload_attachment", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUploadAttachmentRequest returns an encoder for requests sent to the
+// dummy upload_attachment server.
+func EncodeUploadAttachmentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*dummy.UploadAttachmentRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "upload_attachment", "*dummy.UploadAttachmentRequestData", v)
+		}
+		p := data.Payload
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		if p.ChecksumSha256 != nil {
+			head := *p.ChecksumSha256
+			req.Header.Set("X-Checksum-SHA256", head)
+		}
+		values := req.URL.Query()
+		values.Add("filename", p.Filename)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeUploadAttachmentResponse returns a decoder for responses returned by
+// the dummy upload_attachment endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUploadAttachmentResponse may return the following errors:
+//   - "checksum_mismatch" (type *dummy.DummyChecksumMismatchError): http.StatusUnprocessableEntity
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - "too_large" (type *dummy.DummyTooLargeError): http.StatusRequestEntityTooLarge
+//   - "unsupported_media_type" (type *dummy.DummyUnsupportedMediaTypeError): http.StatusUnsupportedMediaType
+//   - error: internal error
+func DecodeUploadAttachmentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body UploadAttachmentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "upload_attachment", err)
+			}
+			err = ValidateUploadAttachmentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "upload_attachment", err)
+			}
+			res := NewUploadAttachmentAttachmentCreated(&body)
+			return res, nil
+		case http.StatusUnprocessableEntity:
+			var (
+				body UploadAttachmentChecksumMismatchResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "upload_attachment", err)
+			}
+			err = ValidateUploadAttachmentChecksumMismatchResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "upload_attachment", err)
+			}
+			return nil, NewUploadAttachmentChecksumMismatch(&body)
+		case http.StatusForbidden:
+			var (
+				body UploadAttachmentForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "upload_attachment", err)
+			}
+			err = ValidateUploadAttachmentForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "upload_attachment", err)
+			}
+			return nil, NewUploadAttachmentForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body UploadAttachmentNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "upload_attachment", err)
+			}
+			err = ValidateUploadAttachmentNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "upload_attachment", err)
+			}
+			return nil, NewUploadAttachmentNotFound(&body)
+		case http.StatusRequestEntityTooLarge:
+			var (
+				body UploadAttachmentTooLargeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "upload_attachment", err)
+			}
+			err = ValidateUploadAttachmentTooLargeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "upload_attachment", err)
+			}
+			return nil, NewUploadAttachmentTooLarge(&body)
+		case http.StatusUnsupportedMediaType:
+			var (
+				body UploadAttachmentUnsupportedMediaTypeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "upload_attachment", err)
+			}
+			err = ValidateUploadAttachmentUnsupportedMediaTypeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "upload_attachment", err)
+			}
+			return nil, NewUploadAttachmentUnsupportedMediaType(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "upload_attachment", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildUploadAttachmentStreamPayload creates a streaming endpoint request
+// payload from the method payload and the path to the file to be streamed
+func BuildUploadAttachmentStreamPayload(payload any, fpath string) (*dummy.UploadAttachmentRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &dummy.UploadAttachmentRequestData{
+		Payload: payload.(*dummy.UploadAttachmentPayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildDownloadAttachmentRequest instantiates a HTTP request object with
+// method and path set to call the "dummy" service "download_attachment"
+// endpoint
+func (c *Client) BuildDownloadAttachmentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id           string
+		attachmentID string
+	)
+	{
+		p, ok := v.(*dummy.AttachmentIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "download_attachment", "*dummy.AttachmentIDPayload", v)
+		}
+		id = p.ID
+		attachmentID = p.AttachmentID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DownloadAttachmentDummyPath(id, attachmentID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "download_attachment", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDownloadAttachmentRequest returns an encoder for requests sent to the
+// dummy download_attachment server.
+func EncodeDownloadAttachmentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.AttachmentIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "download_attachment", "*dummy.AttachmentIDPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDownloadAttachmentResponse returns a decoder for responses returned by
+// the dummy download_attachment endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDownloadAttachmentResponse may return the following errors:
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeDownloadAttachmentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentLength      int64
+				contentDisposition string
+				checksumSha256     string
+				contentTypeOptions string
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			{
+				contentLengthRaw := resp.Header.Get("Content-Length")
+				if contentLengthRaw == "" {
+					return nil, goahttp.ErrValidationError("dummy", "download_attachment", goa.MissingFieldError("content_length", "header"))
+				}
+				v, err2 := strconv.ParseInt(contentLengthRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("content_length", contentLengthRaw, "integer"))
+				}
+				contentLength = v
+			}
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			checksumSha256Raw := resp.Header.Get("X-Checksum-Sha256")
+			if checksumSha256Raw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("checksum_sha256", "header"))
+			}
+			checksumSha256 = checksumSha256Raw
+			contentTypeOptionsRaw := resp.Header.Get("X-Content-Type-Options")
+			if contentTypeOptionsRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type_options", "header"))
+			}
+			contentTypeOptions = contentTypeOptionsRaw
+			if !(contentTypeOptions == "nosniff") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("content_type_options", contentTypeOptions, []any{"nosniff"}))
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "download_attachment", err)
+			}
+			res := NewDownloadAttachmentAttachmentDownloadOK(contentType, contentLength, contentDisposition, checksumSha256, contentTypeOptions)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body DownloadAttachmentNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "download_attachment", err)
+			}
+			err = ValidateDownloadAttachmentNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "download_attachment", err)
+			}
+			return nil, NewDownloadAttachmentNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "download_attachment", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListAttachmentsRequest instantiates a HTTP request object with method
+// and path set to call the "dummy" service "list_attachments" endpoint
+func (c *Client) BuildListAttachmentsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "list_attachments", "*dummy.ItemIDPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAttachmentsDummyPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "list_attachments", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListAttachmentsRequest returns an encoder for requests sent to the
+// dummy list_attachments server.
+func EncodeListAttachmentsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "list_attachments", "*dummy.ItemIDPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeListAttachmentsResponse returns a decoder for responses returned by
+// the dummy list_attachments endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListAttachmentsResponse may return the following errors:
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeListAttachmentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListAttachmentsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "list_attachments", err)
+			}
+			err = ValidateListAttachmentsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "list_attachments", err)
+			}
+			res := NewListAttachmentsAttachmentsCollectionOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ListAttachmentsNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "list_attachments", err)
+			}
+			err = ValidateListAttachmentsNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "list_attachments", err)
+			}
+			return nil, NewListAttachmentsNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "list_attachments", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteAttachmentRequest instantiates a HTTP request object with method
+// and path set to call the "dummy" service "delete_attachment" endpoint
+func (c *Client) BuildDeleteAttachmentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id           string
+		attachmentID string
+	)
+	{
+		p, ok := v.(*dummy.AttachmentIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "delete_attachment", "*dummy.AttachmentIDPayload", v)
+		}
+		id = p.ID
+		attachmentID = p.AttachmentID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteAttachmentDummyPath(id, attachmentID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "delete_attachment", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteAttachmentRequest returns an encoder for requests sent to the
+// dummy delete_attachment server.
+func EncodeDeleteAttachmentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.AttachmentIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "delete_attachment", "*dummy.AttachmentIDPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteAttachmentResponse returns a decoder for responses returned by
+// the dummy delete_attachment endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteAttachmentResponse may return the following errors:
+//   - "forbidden" (type *dummy.DummyForbiddenError): http.StatusForbidden
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeDeleteAttachmentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body DeleteAttachmentForbiddenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "delete_attachment", err)
+			}
+			err = ValidateDeleteAttachmentForbiddenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "delete_attachment", err)
+			}
+			return nil, NewDeleteAttachmentForbidden(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteAttachmentNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "delete_attachment", err)
+			}
+			err = ValidateDeleteAttachmentNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "delete_attachment", err)
+			}
+			return nil, NewDeleteAttachmentNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "delete_attachment", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBatchCreateItemsRequest instantiates a HTTP request object with method
+// and path set to call the "dummy" service "batch_create_items" endpoint
+func (c *Client) BuildBatchCreateItemsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BatchCreateItemsDummyPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "batch_create_items", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBatchCreateItemsRequest returns an encoder for requests sent to the
+// dummy batch_create_items server.
+func EncodeBatchCreateItemsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.BatchCreateItemsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "batch_create_items", "*dummy.BatchCreateItemsPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewBatchCreateItemsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("dummy", "batch_create_items", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBatchCreateItemsResponse returns a decoder for responses returned by
+// the dummy batch_create_items endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeBatchCreateItemsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BatchCreateItemsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "batch_create_items", err)
+			}
+			err = ValidateBatchCreateItemsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "batch_create_items", err)
+			}
+			res := NewBatchCreateItemsBatchResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "batch_create_items", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBatchUpdateItemsRequest instantiates a HTTP request object with method
+// and path set to call the "dummy" service "batch_update_items" endpoint
+func (c *Client) BuildBatchUpdateItemsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BatchUpdateItemsDummyPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "batch_update_items", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBatchUpdateItemsRequest returns an encoder for requests sent to the
+// dummy batch_update_items server.
+func EncodeBatchUpdateItemsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.BatchUpdateItemsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "batch_update_items", "*dummy.BatchUpdateItemsPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewBatchUpdateItemsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("dummy", "batch_update_items", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBatchUpdateItemsResponse returns a decoder for responses returned by
+// the dummy batch_update_items endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeBatchUpdateItemsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BatchUpdateItemsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "batch_update_items", err)
+			}
+			err = ValidateBatchUpdateItemsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "batch_update_items", err)
+			}
+			res := NewBatchUpdateItemsBatchResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "batch_update_items", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildBatchDeleteItemsRequest instantiates a HTTP request object with method
+// and path set to call the "dummy" service "batch_delete_items" endpoint
+func (c *Client) BuildBatchDeleteItemsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: BatchDeleteItemsDummyPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "batch_delete_items", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeBatchDeleteItemsRequest returns an encoder for requests sent to the
+// dummy batch_delete_items server.
+func EncodeBatchDeleteItemsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.BatchDeleteItemsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "batch_delete_items", "*dummy.BatchDeleteItemsPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewBatchDeleteItemsRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("dummy", "batch_delete_items", err)
+		}
+		return nil
+	}
+}
+
+// DecodeBatchDeleteItemsResponse returns a decoder for responses returned by
+// the dummy batch_delete_items endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeBatchDeleteItemsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body BatchDeleteItemsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "batch_delete_items", err)
+			}
+			err = ValidateBatchDeleteItemsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "batch_delete_items", err)
+			}
+			res := NewBatchDeleteItemsBatchResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "batch_delete_items", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExportItemsRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "export_items" endpoint
+func (c *Client) BuildExportItemsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportItemsDummyPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "export_items", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExportItemsRequest returns an encoder for requests sent to the dummy
+// export_items server.
+func EncodeExportItemsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ExportItemsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "export_items", "*dummy.ExportItemsPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeExportItemsResponse returns a decoder for responses returned by the
+// dummy export_items endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeExportItemsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				contentType        string
+				contentDisposition string
+				err                error
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "header"))
+			}
+			contentType = contentTypeRaw
+			contentDispositionRaw := resp.Header.Get("Content-Disposition")
+			if contentDispositionRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("content_disposition", "header"))
+			}
+			contentDisposition = contentDispositionRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "export_items", err)
+			}
+			res := NewExportItemsItemsExportOK(contentType, contentDisposition)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "export_items", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportItemsFileRequest instantiates a HTTP request object with method
+// and path set to call the "dummy" service "import_items_file" endpoint
+func (c *Client) BuildImportItemsFileRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		body io.Reader
+	)
+	rd, ok := v.(*dummy.ImportItemsFileRequestData)
+	if !ok {
+		return nil, goahttp.ErrInvalidType("dummy", "import_items_file", "dummy.ImportItemsFileRequestData", v)
+	}
+	body = rd.Body
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportItemsFileDummyPath()}
+	req, err := http.NewRequest("POST", u.String(), body)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "import_items_file", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportItemsFileRequest returns an encoder for requests sent to the
+// dummy import_items_file server.
+func EncodeImportItemsFileRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		data, ok := v.(*dummy.ImportItemsFileRequestData)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "import_items_file", "*dummy.ImportItemsFileRequestData", v)
+		}
+		p := data.Payload
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		values.Add("format", p.Format)
+		values.Add("dry_run", fmt.Sprintf("%v", p.DryRun))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeImportItemsFileResponse returns a decoder for responses returned by
+// the dummy import_items_file endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeImportItemsFileResponse may return the following errors:
+//   - "too_large" (type *dummy.DummyTooLargeError): http.StatusRequestEntityTooLarge
+//   - error: internal error
+func DecodeImportItemsFileResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportItemsFileResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "import_items_file", err)
+			}
+			err = ValidateImportItemsFileResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "import_items_file", err)
+			}
+			res := NewImportItemsFileImportReportOK(&body)
+			return res, nil
+		case http.StatusRequestEntityTooLarge:
+			var (
+				body ImportItemsFileTooLargeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "import_items_file", err)
+			}
+			err = ValidateImportItemsFileTooLargeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "import_items_file", err)
+			}
+			return nil, NewImportItemsFileTooLarge(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "import_items_file", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// // BuildImportItemsFileStreamPayload creates a streaming endpoint request
+// payload from the method payload and the path to the file to be streamed
+func BuildImportItemsFileStreamPayload(payload any, fpath string) (*dummy.ImportItemsFileRequestData, error) {
+	f, err := os.Open(fpath)
+	if err != nil {
+		return nil, err
+	}
+	return &dummy.ImportItemsFileRequestData{
+		Payload: payload.(*dummy.ImportItemsFilePayload),
+		Body:    f,
+	}, nil
+}
+
+// BuildListTrashRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "list_trash" endpoint
+func (c *Client) BuildListTrashRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTrashDummyPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "list_trash", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListTrashRequest returns an encoder for requests sent to the dummy
+// list_trash server.
+func EncodeListTrashRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ListTrashPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "list_trash", "*dummy.ListTrashPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		values.Add("page_size", fmt.Sprintf("%v", p.PageSize))
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListTrashResponse returns a decoder for responses returned by the
+// dummy list_trash endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListTrashResponse may return the following errors:
+//   - "invalid_cursor" (type *dummy.DummyBadRequestError): http.StatusBadRequest
+//   - error: internal error
+func DecodeListTrashResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListTrashResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "list_trash", err)
+			}
+			err = ValidateListTrashResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "list_trash", err)
+			}
+			res := NewListTrashItemsCollectionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListTrashInvalidCursorResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "list_trash", err)
+			}
+			err = ValidateListTrashInvalidCursorResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "list_trash", err)
+			}
+			return nil, NewListTrashInvalidCursor(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "list_trash", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRestoreItemRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "restore_item" endpoint
+func (c *Client) BuildRestoreItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "restore_item", "*dummy.ItemIDPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RestoreItemDummyPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "restore_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRestoreItemRequest returns an encoder for requests sent to the dummy
+// restore_item server.
+func EncodeRestoreItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "restore_item", "*dummy.ItemIDPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeRestoreItemResponse returns a decoder for responses returned by the
+// dummy restore_item endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRestoreItemResponse may return the following errors:
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodeRestoreItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RestoreItemResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "restore_item", err)
+			}
+			var (
+				etag string
+			)
+			etagRaw := resp.Header.Get("Etag")
+			if etagRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("etag", "header"))
+			}
+			etag = etagRaw
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "restore_item", err)
+			}
+			p := NewRestoreItemItemOK(&body, etag)
+			view := "default"
+			vres := &dummyviews.Item{Projected: p, View: view}
+			if err = dummyviews.ValidateItem(vres); err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "restore_item", err)
+			}
+			res := dummy.NewItem(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body RestoreItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "restore_item", err)
+			}
+			err = ValidateRestoreItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "restore_item", err)
+			}
+			return nil, NewRestoreItemNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "restore_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPurgeItemRequest instantiates a HTTP request object with method and
+// path set to call the "dummy" service "purge_item" endpoint
+func (c *Client) BuildPurgeItemRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("dummy", "purge_item", "*dummy.ItemIDPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PurgeItemDummyPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("dummy", "purge_item", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePurgeItemRequest returns an encoder for requests sent to the dummy
+// purge_item server.
+func EncodePurgeItemRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*dummy.ItemIDPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("dummy", "purge_item", "*dummy.ItemIDPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodePurgeItemResponse returns a decoder for responses returned by the
+// dummy purge_item endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodePurgeItemResponse may return the following errors:
+//   - "not_found" (type *dummy.DummyNotFoundError): http.StatusNotFound
+//   - error: internal error
+func DecodePurgeItemResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body PurgeItemNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("dummy", "purge_item", err)
+			}
+			err = ValidatePurgeItemNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("dummy", "purge_item", err)
+			}
+			return nil, NewPurgeItemNotFound(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("dummy", "purge_item", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalItemResponseBodyToDummyItem builds a value of type *dummy.Item from
+// a value of type *ItemResponseBody.
+func unmarshalItemResponseBodyToDummyItem(v *ItemResponseBody) *dummy.Item {
+	res := &dummy.Item{
+		ID:          *v.ID,
+		Name:        *v.Name,
+		Description: v.Description,
+		OwnerID:     *v.OwnerID,
+		CreatedAt:   *v.CreatedAt,
+		Version:     *v.Version,
+		UpdatedAt:   *v.UpdatedAt,
+		Etag:        *v.Etag,
+		Language:    *v.Language,
+		DeletedAt:   v.DeletedAt,
+		Permission:  *v.Permission,
+	}
+	res.Tags = make([]string, len(v.Tags))
+	for i, val := range v.Tags {
+		res.Tags[i] = val
+	}
+
+	return res
+}
+
+// unmarshalSearchResultResponseBodyToDummySearchResult builds a value of type
+// *dummy.SearchResult from a value of type *SearchResultResponseBody.
+func unmarshalSearchResultResponseBodyToDummySearchResult(v *SearchResultResponseBody) *dummy.SearchResult {
+	res := &dummy.SearchResult{
+		Rank:    *v.Rank,
+		Snippet: *v.Snippet,
+	}
+	res.Item = unmarshalItemResponseBodyToDummyItem(v.Item)
+
+	return res
+}
+
+// unmarshalItemShareResponseBodyToDummyItemShare builds a value of type
+// *dummy.ItemShare from a value of type *ItemShareResponseBody.
+func unmarshalItemShareResponseBodyToDummyItemShare(v *ItemShareResponseBody) *dummy.ItemShare {
+	res := &dummy.ItemShare{
+		ItemID:     *v.ItemID,
+		UserID:     *v.UserID,
+		Permission: *v.Permission,
+		CreatedAt:  *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalTagSuggestionResponseBodyToDummyTagSuggestion builds a value of
+// type *dummy.TagSuggestion from a value of type *TagSuggestionResponseBody.
+func unmarshalTagSuggestionResponseBodyToDummyTagSuggestion(v *TagSuggestionResponseBody) *dummy.TagSuggestion {
+	res := &dummy.TagSuggestion{
+		Name:      *v.Name,
+		ItemCount: *v.ItemCount,
+	}
+
+	return res
+}
+
+// unmarshalAttachmentResponseBodyToDummyAttachment builds a value of type
+// *dummy.Attachment from a value of type *AttachmentResponseBody.
+func unmarshalAttachmentResponseBodyToDummyAttachment(v *AttachmentResponseBody) *dummy.Attachment {
+	res := &dummy.Attachment{
+		ID:             *v.ID,
+		ItemID:         *v.ItemID,
+		Filename:       *v.Filename,
+		ContentType:    *v.ContentType,
+		Size:           *v.Size,
+		ChecksumSha256: *v.ChecksumSha256,
+		CreatedAt:      *v.CreatedAt,
+	}
+
+	return res
+}
+
+// marshalDummyBatchItemEntryToBatchItemEntryRequestBody builds a value of type
+// *BatchItemEntryRequestBody from a value of type *dummy.BatchItemEntry.
+func marshalDummyBatchItemEntryToBatchItemEntryRequestBody(v *dummy.BatchItemEntry) *BatchItemEntryRequestBody {
+	res := &BatchItemEntryRequestBody{
+		Name:        v.Name,
+		Description: v.Description,
+		Language:    v.Language,
+	}
+
+	return res
+}
+
+// marshalBatchItemEntryRequestBodyToDummyBatchItemEntry builds a value of type
+// *dummy.BatchItemEntry from a value of type *BatchItemEntryRequestBody.
+func marshalBatchItemEntryRequestBodyToDummyBatchItemEntry(v *BatchItemEntryRequestBody) *dummy.BatchItemEntry {
+	res := &dummy.BatchItemEntry{
+		Name:        v.Name,
+		Description: v.Description,
+		Language:    v.Language,
+	}
+
+	return res
+}
+
+// unmarshalBatchEntryResultResponseBodyToDummyBatchEntryResult builds a value
+// of type *dummy.BatchEntryResult from a value of type
+// *BatchEntryResultResponseBody.
+func unmarshalBatchEntryResultResponseBodyToDummyBatchEntryResult(v *BatchEntryResultResponseBody) *dummy.BatchEntryResult {
+	res := &dummy.BatchEntryResult{
+		Index: *v.Index,
+	}
+	if v.Item != nil {
+		res.Item = unmarshalItemResponseBodyToDummyItem(v.Item)
+	}
+	if v.Error != nil {
+		res.Error = unmarshalBatchEntryErrorResponseBodyToDummyBatchEntryError(v.Error)
+	}
+
+	return res
+}
+
+// unmarshalBatchEntryErrorResponseBodyToDummyBatchEntryError builds a value of
+// type *dummy.BatchEntryError from a value of type
+// *BatchEntryErrorResponseBody.
+func unmarshalBatchEntryErrorResponseBodyToDummyBatchEntryError(v *BatchEntryErrorResponseBody) *dummy.BatchEntryError {
+	if v == nil {
+		return nil
+	}
+	res := &dummy.BatchEntryError{
+		Name:    *v.Name,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// marshalDummyBatchUpdateEntryToBatchUpdateEntryRequestBody builds a value of
+// type *BatchUpdateEntryRequestBody from a value of type
+// *dummy.BatchUpdateEntry.
+func marshalDummyBatchUpdateEntryToBatchUpdateEntryRequestBody(v *dummy.BatchUpdateEntry) *BatchUpdateEntryRequestBody {
+	res := &BatchUpdateEntryRequestBody{
+		ID:          v.ID,
+		Version:     v.Version,
+		Name:        v.Name,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// marshalBatchUpdateEntryRequestBodyToDummyBatchUpdateEntry builds a value of
+// type *dummy.BatchUpdateEntry from a value of type
+// *BatchUpdateEntryRequestBody.
+func marshalBatchUpdateEntryRequestBodyToDummyBatchUpdateEntry(v *BatchUpdateEntryRequestBody) *dummy.BatchUpdateEntry {
+	res := &dummy.BatchUpdateEntry{
+		ID:          v.ID,
+		Version:     v.Version,
+		Name:        v.Name,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// unmarshalImportRowErrorResponseBodyToDummyImportRowError builds a value of
+// type *dummy.ImportRowError from a value of type *ImportRowErrorResponseBody.
+func unmarshalImportRowErrorResponseBodyToDummyImportRowError(v *ImportRowErrorResponseBody) *dummy.ImportRowError {
+	res := &dummy.ImportRowError{
+		Line:    *v.Line,
+		Message: *v.Message,
 	}
 
 	return res
@@ -8,6 +8,8 @@
 package client
 
 import (
+	"unicode/utf8"
+
 	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	dummyviews "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy/views"
 	goa "goa.design/goa/v3/pkg"
@@ -18,6 +20,36 @@ import (
 type CreateItemRequestBody struct {
 	Name        string  `form:"name" json:"name" xml:"name"`
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Language used to stem the item for search; defaults to DUMMY_SEARCH_LANGUAGE
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+}
+
+// BatchCreateItemsRequestBody is the type of the "dummy" service
+// "batch_create_items" endpoint HTTP request body.
+type BatchCreateItemsRequestBody struct {
+	Items []*BatchItemEntryRequestBody `form:"items" json:"items" xml:"items"`
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic bool `form:"atomic" json:"atomic" xml:"atomic"`
+}
+
+// BatchUpdateItemsRequestBody is the type of the "dummy" service
+// "batch_update_items" endpoint HTTP request body.
+type BatchUpdateItemsRequestBody struct {
+	Items []*BatchUpdateEntryRequestBody `form:"items" json:"items" xml:"items"`
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic bool `form:"atomic" json:"atomic" xml:"atomic"`
+}
+
+// BatchDeleteItemsRequestBody is the type of the "dummy" service
+// "batch_delete_items" endpoint HTTP request body.
+type BatchDeleteItemsRequestBody struct {
+	// Items to move to the trash
+	Ids []string `form:"ids" json:"ids" xml:"ids"`
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic bool `form:"atomic" json:"atomic" xml:"atomic"`
 }
 
 // CreateItemResponseBody is the type of the "dummy" service "create_item"
@@ -29,12 +61,36 @@ type CreateItemResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	OwnerID     *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
 	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Incremented by every update; send it back to update the item
+	Version   *int    `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Text search configuration used to index the item
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 }
 
 // ListItemsResponseBody is the type of the "dummy" service "list_items"
 // endpoint HTTP response body.
 type ListItemsResponseBody struct {
 	Items []*ItemResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Cursor of the next page; absent on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+	// Number of items matching the filters, when include_total is set
+	Total *int64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// SearchItemsResponseBody is the type of the "dummy" service "search_items"
+// endpoint HTTP response body.
+type SearchItemsResponseBody struct {
+	// Results ordered by relevance
+	Results []*SearchResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+	// Cursor of the next page; absent on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
 }
 
 // GetItemResponseBody is the type of the "dummy" service "get_item" endpoint
@@ -46,6 +102,440 @@ type GetItemResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	OwnerID     *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
 	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Incremented by every update; send it back to update the item
+	Version   *int    `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Text search configuration used to index the item
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// UpdateItemResponseBody is the type of the "dummy" service "update_item"
+// endpoint HTTP response body.
+type UpdateItemResponseBody struct {
+	// Item identifier
+	ID          *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Name        *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
+	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Incremented by every update; send it back to update the item
+	Version   *int    `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Text search configuration used to index the item
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// PatchItemResponseBody is the type of the "dummy" service "patch_item"
+// endpoint HTTP response body.
+type PatchItemResponseBody struct {
+	// Item identifier
+	ID          *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Name        *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
+	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Incremented by every update; send it back to update the item
+	Version   *int    `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Text search configuration used to index the item
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// ShareItemResponseBody is the type of the "dummy" service "share_item"
+// endpoint HTTP response body.
+type ShareItemResponseBody struct {
+	ItemID *string `form:"item_id,omitempty" json:"item_id,omitempty" xml:"item_id,omitempty"`
+	// User the item is shared with
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// viewer can read the item, editor can also update it
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	CreatedAt  *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListItemSharesResponseBody is the type of the "dummy" service
+// "list_item_shares" endpoint HTTP response body.
+type ListItemSharesResponseBody struct {
+	Shares []*ItemShareResponseBody `form:"shares,omitempty" json:"shares,omitempty" xml:"shares,omitempty"`
+}
+
+// AddItemTagResponseBody is the type of the "dummy" service "add_item_tag"
+// endpoint HTTP response body.
+type AddItemTagResponseBody struct {
+	// Item identifier
+	ID          *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Name        *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
+	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Incremented by every update; send it back to update the item
+	Version   *int    `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Text search configuration used to index the item
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// RemoveItemTagResponseBody is the type of the "dummy" service
+// "remove_item_tag" endpoint HTTP response body.
+type RemoveItemTagResponseBody struct {
+	// Item identifier
+	ID          *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Name        *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
+	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Incremented by every update; send it back to update the item
+	Version   *int    `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Text search configuration used to index the item
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// SuggestTagsResponseBody is the type of the "dummy" service "suggest_tags"
+// endpoint HTTP response body.
+type SuggestTagsResponseBody struct {
+	Tags []*TagSuggestionResponseBody `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// UploadAttachmentResponseBody is the type of the "dummy" service
+// "upload_attachment" endpoint HTTP response body.
+type UploadAttachmentResponseBody struct {
+	ID       *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	ItemID   *string `form:"item_id,omitempty" json:"item_id,omitempty" xml:"item_id,omitempty"`
+	Filename *string `form:"filename,omitempty" json:"filename,omitempty" xml:"filename,omitempty"`
+	// Media type sniffed from the content
+	ContentType *string `form:"content_type,omitempty" json:"content_type,omitempty" xml:"content_type,omitempty"`
+	// Size in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Hex-encoded SHA-256 of the content
+	ChecksumSha256 *string `form:"checksum_sha256,omitempty" json:"checksum_sha256,omitempty" xml:"checksum_sha256,omitempty"`
+	CreatedAt      *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// ListAttachmentsResponseBody is the type of the "dummy" service
+// "list_attachments" endpoint HTTP response body.
+type ListAttachmentsResponseBody struct {
+	Attachments []*AttachmentResponseBody `form:"attachments,omitempty" json:"attachments,omitempty" xml:"attachments,omitempty"`
+}
+
+// BatchCreateItemsResponseBody is the type of the "dummy" service
+// "batch_create_items" endpoint HTTP response body.
+type BatchCreateItemsResponseBody struct {
+	// One result per entry, in request order
+	Results   []*BatchEntryResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+	Succeeded *int                            `form:"succeeded,omitempty" json:"succeeded,omitempty" xml:"succeeded,omitempty"`
+	Failed    *int                            `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+}
+
+// BatchUpdateItemsResponseBody is the type of the "dummy" service
+// "batch_update_items" endpoint HTTP response body.
+type BatchUpdateItemsResponseBody struct {
+	// One result per entry, in request order
+	Results   []*BatchEntryResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+	Succeeded *int                            `form:"succeeded,omitempty" json:"succeeded,omitempty" xml:"succeeded,omitempty"`
+	Failed    *int                            `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+}
+
+// BatchDeleteItemsResponseBody is the type of the "dummy" service
+// "batch_delete_items" endpoint HTTP response body.
+type BatchDeleteItemsResponseBody struct {
+	// One result per entry, in request order
+	Results   []*BatchEntryResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+	Succeeded *int                            `form:"succeeded,omitempty" json:"succeeded,omitempty" xml:"succeeded,omitempty"`
+	Failed    *int                            `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+}
+
+// ImportItemsFileResponseBody is the type of the "dummy" service
+// "import_items_file" endpoint HTTP response body.
+type ImportItemsFileResponseBody struct {
+	DryRun *bool `form:"dry_run,omitempty" json:"dry_run,omitempty" xml:"dry_run,omitempty"`
+	// Rows read from the file
+	Rows *int `form:"rows,omitempty" json:"rows,omitempty" xml:"rows,omitempty"`
+	// Rows rejected by validation
+	Failed *int `form:"failed,omitempty" json:"failed,omitempty" xml:"failed,omitempty"`
+	// Items created; zero for dry runs and files with rejected rows
+	Imported *int `form:"imported,omitempty" json:"imported,omitempty" xml:"imported,omitempty"`
+	// The first rejected rows
+	Errors []*ImportRowErrorResponseBody `form:"errors,omitempty" json:"errors,omitempty" xml:"errors,omitempty"`
+}
+
+// ListTrashResponseBody is the type of the "dummy" service "list_trash"
+// endpoint HTTP response body.
+type ListTrashResponseBody struct {
+	Items []*ItemResponseBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Cursor of the next page; absent on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+	// Number of items matching the filters, when include_total is set
+	Total *int64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// RestoreItemResponseBody is the type of the "dummy" service "restore_item"
+// endpoint HTTP response body.
+type RestoreItemResponseBody struct {
+	// Item identifier
+	ID          *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Name        *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
+	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Incremented by every update; send it back to update the item
+	Version   *int    `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Text search configuration used to index the item
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// ListItemsInvalidCursorResponseBody is the type of the "dummy" service
+// "list_items" endpoint HTTP response body for the "invalid_cursor" error.
+type ListItemsInvalidCursorResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SearchItemsInvalidCursorResponseBody is the type of the "dummy" service
+// "search_items" endpoint HTTP response body for the "invalid_cursor" error.
+type SearchItemsInvalidCursorResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetItemNotFoundResponseBody is the type of the "dummy" service "get_item"
+// endpoint HTTP response body for the "not_found" error.
+type GetItemNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateItemConflictResponseBody is the type of the "dummy" service
+// "update_item" endpoint HTTP response body for the "conflict" error.
+type UpdateItemConflictResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Version the item has now
+	CurrentVersion *int `form:"current_version,omitempty" json:"current_version,omitempty" xml:"current_version,omitempty"`
+}
+
+// UpdateItemForbiddenResponseBody is the type of the "dummy" service
+// "update_item" endpoint HTTP response body for the "forbidden" error.
+type UpdateItemForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateItemNotFoundResponseBody is the type of the "dummy" service
+// "update_item" endpoint HTTP response body for the "not_found" error.
+type UpdateItemNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateItemPreconditionRequiredResponseBody is the type of the "dummy"
+// service "update_item" endpoint HTTP response body for the
+// "precondition_required" error.
+type UpdateItemPreconditionRequiredResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchItemConflictResponseBody is the type of the "dummy" service
+// "patch_item" endpoint HTTP response body for the "conflict" error.
+type PatchItemConflictResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Version the item has now
+	CurrentVersion *int `form:"current_version,omitempty" json:"current_version,omitempty" xml:"current_version,omitempty"`
+}
+
+// PatchItemForbiddenResponseBody is the type of the "dummy" service
+// "patch_item" endpoint HTTP response body for the "forbidden" error.
+type PatchItemForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchItemNotFoundResponseBody is the type of the "dummy" service
+// "patch_item" endpoint HTTP response body for the "not_found" error.
+type PatchItemNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PatchItemPreconditionRequiredResponseBody is the type of the "dummy" service
+// "patch_item" endpoint HTTP response body for the "precondition_required"
+// error.
+type PatchItemPreconditionRequiredResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteItemNotFoundResponseBody is the type of the "dummy" service
+// "delete_item" endpoint HTTP response body for the "not_found" error.
+type DeleteItemNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ShareItemForbiddenResponseBody is the type of the "dummy" service
+// "share_item" endpoint HTTP response body for the "forbidden" error.
+type ShareItemForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ShareItemNotFoundResponseBody is the type of the "dummy" service
+// "share_item" endpoint HTTP response body for the "not_found" error.
+type ShareItemNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UnshareItemForbiddenResponseBody is the type of the "dummy" service
+// "unshare_item" endpoint HTTP response body for the "forbidden" error.
+type UnshareItemForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UnshareItemNotFoundResponseBody is the type of the "dummy" service
+// "unshare_item" endpoint HTTP response body for the "not_found" error.
+type UnshareItemNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListItemSharesForbiddenResponseBody is the type of the "dummy" service
+// "list_item_shares" endpoint HTTP response body for the "forbidden" error.
+type ListItemSharesForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListItemSharesNotFoundResponseBody is the type of the "dummy" service
+// "list_item_shares" endpoint HTTP response body for the "not_found" error.
+type ListItemSharesNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddItemTagForbiddenResponseBody is the type of the "dummy" service
+// "add_item_tag" endpoint HTTP response body for the "forbidden" error.
+type AddItemTagForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddItemTagNotFoundResponseBody is the type of the "dummy" service
+// "add_item_tag" endpoint HTTP response body for the "not_found" error.
+type AddItemTagNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RemoveItemTagForbiddenResponseBody is the type of the "dummy" service
+// "remove_item_tag" endpoint HTTP response body for the "forbidden" error.
+type RemoveItemTagForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RemoveItemTagNotFoundResponseBody is the type of the "dummy" service
+// "remove_item_tag" endpoint HTTP response body for the "not_found" error.
+type RemoveItemTagNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UploadAttachmentChecksumMismatchResponseBody is the type of the "dummy"
+// service "upload_attachment" endpoint HTTP response body for the
+// "checksum_mismatch" error.
+type UploadAttachmentChecksumMismatchResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UploadAttachmentForbiddenResponseBody is the type of the "dummy" service
+// "upload_attachment" endpoint HTTP response body for the "forbidden" error.
+type UploadAttachmentForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UploadAttachmentNotFoundResponseBody is the type of the "dummy" service
+// "upload_attachment" endpoint HTTP response body for the "not_found" error.
+type UploadAttachmentNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UploadAttachmentTooLargeResponseBody is the type of the "dummy" service
+// "upload_attachment" endpoint HTTP response body for the "too_large" error.
+type UploadAttachmentTooLargeResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UploadAttachmentUnsupportedMediaTypeResponseBody is the type of the "dummy"
+// service "upload_attachment" endpoint HTTP response body for the
+// "unsupported_media_type" error.
+type UploadAttachmentUnsupportedMediaTypeResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DownloadAttachmentNotFoundResponseBody is the type of the "dummy" service
+// "download_attachment" endpoint HTTP response body for the "not_found" error.
+type DownloadAttachmentNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListAttachmentsNotFoundResponseBody is the type of the "dummy" service
+// "list_attachments" endpoint HTTP response body for the "not_found" error.
+type ListAttachmentsNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteAttachmentForbiddenResponseBody is the type of the "dummy" service
+// "delete_attachment" endpoint HTTP response body for the "forbidden" error.
+type DeleteAttachmentForbiddenResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteAttachmentNotFoundResponseBody is the type of the "dummy" service
+// "delete_attachment" endpoint HTTP response body for the "not_found" error.
+type DeleteAttachmentNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ImportItemsFileTooLargeResponseBody is the type of the "dummy" service
+// "import_items_file" endpoint HTTP response body for the "too_large" error.
+type ImportItemsFileTooLargeResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ListTrashInvalidCursorResponseBody is the type of the "dummy" service
+// "list_trash" endpoint HTTP response body for the "invalid_cursor" error.
+type ListTrashInvalidCursorResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RestoreItemNotFoundResponseBody is the type of the "dummy" service
+// "restore_item" endpoint HTTP response body for the "not_found" error.
+type RestoreItemNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// PurgeItemNotFoundResponseBody is the type of the "dummy" service
+// "purge_item" endpoint HTTP response body for the "not_found" error.
+type PurgeItemNotFoundResponseBody struct {
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
 // ItemResponseBody is used to define fields on response body types.
@@ -56,6 +546,103 @@ type ItemResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	OwnerID     *string `form:"owner_id,omitempty" json:"owner_id,omitempty" xml:"owner_id,omitempty"`
 	CreatedAt   *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Incremented by every update; send it back to update the item
+	Version   *int    `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	UpdatedAt *string `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Entity tag of this version, returned in the ETag header over HTTP
+	Etag *string `form:"etag,omitempty" json:"etag,omitempty" xml:"etag,omitempty"`
+	// Text search configuration used to index the item
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// SearchResultResponseBody is used to define fields on response body types.
+type SearchResultResponseBody struct {
+	Item *ItemResponseBody `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
+	// Relevance of the item; higher is better
+	Rank *float32 `form:"rank,omitempty" json:"rank,omitempty" xml:"rank,omitempty"`
+	// Excerpt of the name and description with matches wrapped in <mark> tags. The
+	// item text is HTML-escaped, so the snippet can be inserted as markup
+	Snippet *string `form:"snippet,omitempty" json:"snippet,omitempty" xml:"snippet,omitempty"`
+}
+
+// ItemShareResponseBody is used to define fields on response body types.
+type ItemShareResponseBody struct {
+	ItemID *string `form:"item_id,omitempty" json:"item_id,omitempty" xml:"item_id,omitempty"`
+	// User the item is shared with
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// viewer can read the item, editor can also update it
+	Permission *string `form:"permission,omitempty" json:"permission,omitempty" xml:"permission,omitempty"`
+	CreatedAt  *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// TagSuggestionResponseBody is used to define fields on response body types.
+type TagSuggestionResponseBody struct {
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Number of items with the tag, not counting trashed ones
+	ItemCount *int64 `form:"item_count,omitempty" json:"item_count,omitempty" xml:"item_count,omitempty"`
+}
+
+// AttachmentResponseBody is used to define fields on response body types.
+type AttachmentResponseBody struct {
+	ID       *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	ItemID   *string `form:"item_id,omitempty" json:"item_id,omitempty" xml:"item_id,omitempty"`
+	Filename *string `form:"filename,omitempty" json:"filename,omitempty" xml:"filename,omitempty"`
+	// Media type sniffed from the content
+	ContentType *string `form:"content_type,omitempty" json:"content_type,omitempty" xml:"content_type,omitempty"`
+	// Size in bytes
+	Size *int64 `form:"size,omitempty" json:"size,omitempty" xml:"size,omitempty"`
+	// Hex-encoded SHA-256 of the content
+	ChecksumSha256 *string `form:"checksum_sha256,omitempty" json:"checksum_sha256,omitempty" xml:"checksum_sha256,omitempty"`
+	CreatedAt      *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// BatchItemEntryRequestBody is used to define fields on request body types.
+type BatchItemEntryRequestBody struct {
+	Name        string  `form:"name" json:"name" xml:"name"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Language used to stem the item for search; defaults to DUMMY_SEARCH_LANGUAGE
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+}
+
+// BatchEntryResultResponseBody is used to define fields on response body types.
+type BatchEntryResultResponseBody struct {
+	// Position of the entry in the request
+	Index *int `form:"index,omitempty" json:"index,omitempty" xml:"index,omitempty"`
+	// The created or updated item
+	Item  *ItemResponseBody            `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
+	Error *BatchEntryErrorResponseBody `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// BatchEntryErrorResponseBody is used to define fields on response body types.
+type BatchEntryErrorResponseBody struct {
+	// Error name, such as not_found or conflict. aborted marks entries rolled back
+	// or skipped because another entry of an atomic batch failed
+	Name    *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// BatchUpdateEntryRequestBody is used to define fields on request body types.
+type BatchUpdateEntryRequestBody struct {
+	ID string `form:"id" json:"id" xml:"id"`
+	// Version the update is based on
+	Version int `form:"version" json:"version" xml:"version"`
+	// New name; omit to keep it
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// New description; omit to keep it, send an empty string to clear it
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ImportRowErrorResponseBody is used to define fields on response body types.
+type ImportRowErrorResponseBody struct {
+	// Line of the file the rejected row starts on, counting from 1
+	Line    *int    `form:"line,omitempty" json:"line,omitempty" xml:"line,omitempty"`
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
 // NewCreateItemRequestBody builds the HTTP request body from the payload of
@@ -64,20 +651,108 @@ func NewCreateItemRequestBody(p *dummy.CreateItemPayload) *CreateItemRequestBody
 	body := &CreateItemRequestBody{
 		Name:        p.Name,
 		Description: p.Description,
+		Language:    p.Language,
+	}
+	return body
+}
+
+// NewBatchCreateItemsRequestBody builds the HTTP request body from the payload
+// of the "batch_create_items" endpoint of the "dummy" service.
+func NewBatchCreateItemsRequestBody(p *dummy.BatchCreateItemsPayload) *BatchCreateItemsRequestBody {
+	body := &BatchCreateItemsRequestBody{
+		Atomic: p.Atomic,
+	}
+	if p.Items != nil {
+		body.Items = make([]*BatchItemEntryRequestBody, len(p.Items))
+		for i, val := range p.Items {
+			if val == nil {
+				body.Items[i] = nil
+				continue
+			}
+			body.Items[i] = marshalDummyBatchItemEntryToBatchItemEntryRequestBody(val)
+		}
+	} else {
+		body.Items = []*BatchItemEntryRequestBody{}
+	}
+	{
+		var zero bool
+		if body.Atomic == zero {
+			body.Atomic = true
+		}
+	}
+	return body
+}
+
+// NewBatchUpdateItemsRequestBody builds the HTTP request body from the payload
+// of the "batch_update_items" endpoint of the "dummy" service.
+func NewBatchUpdateItemsRequestBody(p *dummy.BatchUpdateItemsPayload) *BatchUpdateItemsRequestBody {
+	body := &BatchUpdateItemsRequestBody{
+		Atomic: p.Atomic,
+	}
+	if p.Items != nil {
+		body.Items = make([]*BatchUpdateEntryRequestBody, len(p.Items))
+		for i, val := range p.Items {
+			if val == nil {
+				body.Items[i] = nil
+				continue
+			}
+			body.Items[i] = marshalDummyBatchUpdateEntryToBatchUpdateEntryRequestBody(val)
+		}
+	} else {
+		body.Items = []*BatchUpdateEntryRequestBody{}
+	}
+	{
+		var zero bool
+		if body.Atomic == zero {
+			body.Atomic = true
+		}
+	}
+	return body
+}
+
+// NewBatchDeleteItemsRequestBody builds the HTTP request body from the payload
+// of the "batch_delete_items" endpoint of the "dummy" service.
+func NewBatchDeleteItemsRequestBody(p *dummy.BatchDeleteItemsPayload) *BatchDeleteItemsRequestBody {
+	body := &BatchDeleteItemsRequestBody{
+		Atomic: p.Atomic,
+	}
+	if p.Ids != nil {
+		body.Ids = make([]string, len(p.Ids))
+		for i, val := range p.Ids {
+			body.Ids[i] = val
+		}
+	} else {
+		body.Ids = []string{}
+	}
+	{
+		var zero bool
+		if body.Atomic == zero {
+			body.Atomic = true
+		}
 	}
 	return body
 }
 
 // NewCreateItemItemCreated builds a "dummy" service "create_item" endpoint
 // result from a HTTP "Created" response.
-func NewCreateItemItemCreated(body *CreateItemResponseBody) *dummyviews.ItemView {
+func NewCreateItemItemCreated(body *CreateItemResponseBody, etag string) *dummyviews.ItemView {
 	v := &dummyviews.ItemView{
 		ID:          body.ID,
 		Name:        body.Name,
 		Description: body.Description,
 		OwnerID:     body.OwnerID,
 		CreatedAt:   body.CreatedAt,
+		Version:     body.Version,
+		UpdatedAt:   body.UpdatedAt,
+		Language:    body.Language,
+		DeletedAt:   body.DeletedAt,
+		Permission:  body.Permission,
+	}
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
 	}
+	v.Etag = &etag
 
 	return v
 }
@@ -85,7 +760,10 @@ func NewCreateItemItemCreated(body *CreateItemResponseBody) *dummyviews.ItemView
 // NewListItemsItemsCollectionOK builds a "dummy" service "list_items" endpoint
 // result from a HTTP "OK" response.
 func NewListItemsItemsCollectionOK(body *ListItemsResponseBody) *dummy.ItemsCollection {
-	v := &dummy.ItemsCollection{}
+	v := &dummy.ItemsCollection{
+		NextCursor: body.NextCursor,
+		Total:      body.Total,
+	}
 	v.Items = make([]*dummy.Item, len(body.Items))
 	for i, val := range body.Items {
 		if val == nil {
@@ -98,52 +776,1478 @@ func NewListItemsItemsCollectionOK(body *ListItemsResponseBody) *dummy.ItemsColl
 	return v
 }
 
+// NewListItemsInvalidCursor builds a dummy service list_items endpoint
+// invalid_cursor error.
+func NewListItemsInvalidCursor(body *ListItemsInvalidCursorResponseBody) *dummy.DummyBadRequestError {
+	v := &dummy.DummyBadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSearchItemsSearchResultsCollectionOK builds a "dummy" service
+// "search_items" endpoint result from a HTTP "OK" response.
+func NewSearchItemsSearchResultsCollectionOK(body *SearchItemsResponseBody) *dummy.SearchResultsCollection {
+	v := &dummy.SearchResultsCollection{
+		NextCursor: body.NextCursor,
+	}
+	v.Results = make([]*dummy.SearchResult, len(body.Results))
+	for i, val := range body.Results {
+		if val == nil {
+			v.Results[i] = nil
+			continue
+		}
+		v.Results[i] = unmarshalSearchResultResponseBodyToDummySearchResult(val)
+	}
+
+	return v
+}
+
+// NewSearchItemsInvalidCursor builds a dummy service search_items endpoint
+// invalid_cursor error.
+func NewSearchItemsInvalidCursor(body *SearchItemsInvalidCursorResponseBody) *dummy.DummyBadRequestError {
+	v := &dummy.DummyBadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
 // NewGetItemItemOK builds a "dummy" service "get_item" endpoint result from a
 // HTTP "OK" response.
-func NewGetItemItemOK(body *GetItemResponseBody) *dummyviews.ItemView {
+func NewGetItemItemOK(body *GetItemResponseBody, etag string) *dummyviews.ItemView {
 	v := &dummyviews.ItemView{
 		ID:          body.ID,
 		Name:        body.Name,
 		Description: body.Description,
 		OwnerID:     body.OwnerID,
 		CreatedAt:   body.CreatedAt,
+		Version:     body.Version,
+		UpdatedAt:   body.UpdatedAt,
+		Language:    body.Language,
+		DeletedAt:   body.DeletedAt,
+		Permission:  body.Permission,
 	}
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
+	}
+	v.Etag = &etag
 
 	return v
 }
 
-// ValidateListItemsResponseBody runs the validations defined on
-// list_items_response_body
-func ValidateListItemsResponseBody(body *ListItemsResponseBody) (err error) {
-	if body.Items == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
-	}
-	for _, e := range body.Items {
-		if e != nil {
-			if err2 := ValidateItemResponseBody(e); err2 != nil {
-				err = goa.MergeErrors(err, err2)
-			}
-		}
+// NewGetItemNotFound builds a dummy service get_item endpoint not_found error.
+func NewGetItemNotFound(body *GetItemNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
 	}
-	return
+
+	return v
 }
 
-// ValidateItemResponseBody runs the validations defined on ItemResponseBody
-func ValidateItemResponseBody(body *ItemResponseBody) (err error) {
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+// NewUpdateItemItemOK builds a "dummy" service "update_item" endpoint result
+// from a HTTP "OK" response.
+func NewUpdateItemItemOK(body *UpdateItemResponseBody, etag string) *dummyviews.ItemView {
+	v := &dummyviews.ItemView{
+		ID:          body.ID,
+		Name:        body.Name,
+		Description: body.Description,
+		OwnerID:     body.OwnerID,
+		CreatedAt:   body.CreatedAt,
+		Version:     body.Version,
+		UpdatedAt:   body.UpdatedAt,
+		Language:    body.Language,
+		DeletedAt:   body.DeletedAt,
+		Permission:  body.Permission,
 	}
-	if body.Name == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
 	}
-	if body.OwnerID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("owner_id", "body"))
+	v.Etag = &etag
+
+	return v
+}
+
+// NewUpdateItemConflict builds a dummy service update_item endpoint conflict
+// error.
+func NewUpdateItemConflict(body *UpdateItemConflictResponseBody) *dummy.DummyConflictError {
+	v := &dummy.DummyConflictError{
+		Message:        *body.Message,
+		CurrentVersion: body.CurrentVersion,
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+
+	return v
+}
+
+// NewUpdateItemForbidden builds a dummy service update_item endpoint forbidden
+// error.
+func NewUpdateItemForbidden(body *UpdateItemForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItemNotFound builds a dummy service update_item endpoint not_found
+// error.
+func NewUpdateItemNotFound(body *UpdateItemNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateItemPreconditionRequired builds a dummy service update_item
+// endpoint precondition_required error.
+func NewUpdateItemPreconditionRequired(body *UpdateItemPreconditionRequiredResponseBody) *dummy.DummyPreconditionRequiredError {
+	v := &dummy.DummyPreconditionRequiredError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchItemItemOK builds a "dummy" service "patch_item" endpoint result
+// from a HTTP "OK" response.
+func NewPatchItemItemOK(body *PatchItemResponseBody, etag string) *dummyviews.ItemView {
+	v := &dummyviews.ItemView{
+		ID:          body.ID,
+		Name:        body.Name,
+		Description: body.Description,
+		OwnerID:     body.OwnerID,
+		CreatedAt:   body.CreatedAt,
+		Version:     body.Version,
+		UpdatedAt:   body.UpdatedAt,
+		Language:    body.Language,
+		DeletedAt:   body.DeletedAt,
+		Permission:  body.Permission,
+	}
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
+	}
+	v.Etag = &etag
+
+	return v
+}
+
+// NewPatchItemConflict builds a dummy service patch_item endpoint conflict
+// error.
+func NewPatchItemConflict(body *PatchItemConflictResponseBody) *dummy.DummyConflictError {
+	v := &dummy.DummyConflictError{
+		Message:        *body.Message,
+		CurrentVersion: body.CurrentVersion,
+	}
+
+	return v
+}
+
+// NewPatchItemForbidden builds a dummy service patch_item endpoint forbidden
+// error.
+func NewPatchItemForbidden(body *PatchItemForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchItemNotFound builds a dummy service patch_item endpoint not_found
+// error.
+func NewPatchItemNotFound(body *PatchItemNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPatchItemPreconditionRequired builds a dummy service patch_item endpoint
+// precondition_required error.
+func NewPatchItemPreconditionRequired(body *PatchItemPreconditionRequiredResponseBody) *dummy.DummyPreconditionRequiredError {
+	v := &dummy.DummyPreconditionRequiredError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteItemNotFound builds a dummy service delete_item endpoint not_found
+// error.
+func NewDeleteItemNotFound(body *DeleteItemNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewShareItemItemShareOK builds a "dummy" service "share_item" endpoint
+// result from a HTTP "OK" response.
+func NewShareItemItemShareOK(body *ShareItemResponseBody) *dummy.ItemShare {
+	v := &dummy.ItemShare{
+		ItemID:     *body.ItemID,
+		UserID:     *body.UserID,
+		Permission: *body.Permission,
+		CreatedAt:  *body.CreatedAt,
+	}
+
+	return v
+}
+
+// NewShareItemForbidden builds a dummy service share_item endpoint forbidden
+// error.
+func NewShareItemForbidden(body *ShareItemForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewShareItemNotFound builds a dummy service share_item endpoint not_found
+// error.
+func NewShareItemNotFound(body *ShareItemNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUnshareItemForbidden builds a dummy service unshare_item endpoint
+// forbidden error.
+func NewUnshareItemForbidden(body *UnshareItemForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUnshareItemNotFound builds a dummy service unshare_item endpoint
+// not_found error.
+func NewUnshareItemNotFound(body *UnshareItemNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListItemSharesItemSharesCollectionOK builds a "dummy" service
+// "list_item_shares" endpoint result from a HTTP "OK" response.
+func NewListItemSharesItemSharesCollectionOK(body *ListItemSharesResponseBody) *dummy.ItemSharesCollection {
+	v := &dummy.ItemSharesCollection{}
+	v.Shares = make([]*dummy.ItemShare, len(body.Shares))
+	for i, val := range body.Shares {
+		if val == nil {
+			v.Shares[i] = nil
+			continue
+		}
+		v.Shares[i] = unmarshalItemShareResponseBodyToDummyItemShare(val)
+	}
+
+	return v
+}
+
+// NewListItemSharesForbidden builds a dummy service list_item_shares endpoint
+// forbidden error.
+func NewListItemSharesForbidden(body *ListItemSharesForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListItemSharesNotFound builds a dummy service list_item_shares endpoint
+// not_found error.
+func NewListItemSharesNotFound(body *ListItemSharesNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAddItemTagItemOK builds a "dummy" service "add_item_tag" endpoint result
+// from a HTTP "OK" response.
+func NewAddItemTagItemOK(body *AddItemTagResponseBody, etag string) *dummyviews.ItemView {
+	v := &dummyviews.ItemView{
+		ID:          body.ID,
+		Name:        body.Name,
+		Description: body.Description,
+		OwnerID:     body.OwnerID,
+		CreatedAt:   body.CreatedAt,
+		Version:     body.Version,
+		UpdatedAt:   body.UpdatedAt,
+		Language:    body.Language,
+		DeletedAt:   body.DeletedAt,
+		Permission:  body.Permission,
+	}
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
+	}
+	v.Etag = &etag
+
+	return v
+}
+
+// NewAddItemTagForbidden builds a dummy service add_item_tag endpoint
+// forbidden error.
+func NewAddItemTagForbidden(body *AddItemTagForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAddItemTagNotFound builds a dummy service add_item_tag endpoint not_found
+// error.
+func NewAddItemTagNotFound(body *AddItemTagNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRemoveItemTagItemOK builds a "dummy" service "remove_item_tag" endpoint
+// result from a HTTP "OK" response.
+func NewRemoveItemTagItemOK(body *RemoveItemTagResponseBody, etag string) *dummyviews.ItemView {
+	v := &dummyviews.ItemView{
+		ID:          body.ID,
+		Name:        body.Name,
+		Description: body.Description,
+		OwnerID:     body.OwnerID,
+		CreatedAt:   body.CreatedAt,
+		Version:     body.Version,
+		UpdatedAt:   body.UpdatedAt,
+		Language:    body.Language,
+		DeletedAt:   body.DeletedAt,
+		Permission:  body.Permission,
+	}
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
+	}
+	v.Etag = &etag
+
+	return v
+}
+
+// NewRemoveItemTagForbidden builds a dummy service remove_item_tag endpoint
+// forbidden error.
+func NewRemoveItemTagForbidden(body *RemoveItemTagForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRemoveItemTagNotFound builds a dummy service remove_item_tag endpoint
+// not_found error.
+func NewRemoveItemTagNotFound(body *RemoveItemTagNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewSuggestTagsTagSuggestionsOK builds a "dummy" service "suggest_tags"
+// endpoint result from a HTTP "OK" response.
+func NewSuggestTagsTagSuggestionsOK(body *SuggestTagsResponseBody) *dummy.TagSuggestions {
+	v := &dummy.TagSuggestions{}
+	v.Tags = make([]*dummy.TagSuggestion, len(body.Tags))
+	for i, val := range body.Tags {
+		if val == nil {
+			v.Tags[i] = nil
+			continue
+		}
+		v.Tags[i] = unmarshalTagSuggestionResponseBodyToDummyTagSuggestion(val)
+	}
+
+	return v
+}
+
+// NewUploadAttachmentAttachmentCreated builds a "dummy" service
+// "upload_attachment" endpoint result from a HTTP "Created" response.
+func NewUploadAttachmentAttachmentCreated(body *UploadAttachmentResponseBody) *dummy.Attachment {
+	v := &dummy.Attachment{
+		ID:             *body.ID,
+		ItemID:         *body.ItemID,
+		Filename:       *body.Filename,
+		ContentType:    *body.ContentType,
+		Size:           *body.Size,
+		ChecksumSha256: *body.ChecksumSha256,
+		CreatedAt:      *body.CreatedAt,
+	}
+
+	return v
+}
+
+// NewUploadAttachmentChecksumMismatch builds a dummy service upload_attachment
+// endpoint checksum_mismatch error.
+func NewUploadAttachmentChecksumMismatch(body *UploadAttachmentChecksumMismatchResponseBody) *dummy.DummyChecksumMismatchError {
+	v := &dummy.DummyChecksumMismatchError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUploadAttachmentForbidden builds a dummy service upload_attachment
+// endpoint forbidden error.
+func NewUploadAttachmentForbidden(body *UploadAttachmentForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUploadAttachmentNotFound builds a dummy service upload_attachment
+// endpoint not_found error.
+func NewUploadAttachmentNotFound(body *UploadAttachmentNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUploadAttachmentTooLarge builds a dummy service upload_attachment
+// endpoint too_large error.
+func NewUploadAttachmentTooLarge(body *UploadAttachmentTooLargeResponseBody) *dummy.DummyTooLargeError {
+	v := &dummy.DummyTooLargeError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUploadAttachmentUnsupportedMediaType builds a dummy service
+// upload_attachment endpoint unsupported_media_type error.
+func NewUploadAttachmentUnsupportedMediaType(body *UploadAttachmentUnsupportedMediaTypeResponseBody) *dummy.DummyUnsupportedMediaTypeError {
+	v := &dummy.DummyUnsupportedMediaTypeError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDownloadAttachmentAttachmentDownloadOK builds a "dummy" service
+// "download_attachment" endpoint result from a HTTP "OK" response.
+func NewDownloadAttachmentAttachmentDownloadOK(contentType string, contentLength int64, contentDisposition string, checksumSha256 string, contentTypeOptions string) *dummy.AttachmentDownload {
+	v := &dummy.AttachmentDownload{}
+	v.ContentType = contentType
+	v.ContentLength = contentLength
+	v.ContentDisposition = contentDisposition
+	v.ChecksumSha256 = checksumSha256
+	v.ContentTypeOptions = contentTypeOptions
+
+	return v
+}
+
+// NewDownloadAttachmentNotFound builds a dummy service download_attachment
+// endpoint not_found error.
+func NewDownloadAttachmentNotFound(body *DownloadAttachmentNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListAttachmentsAttachmentsCollectionOK builds a "dummy" service
+// "list_attachments" endpoint result from a HTTP "OK" response.
+func NewListAttachmentsAttachmentsCollectionOK(body *ListAttachmentsResponseBody) *dummy.AttachmentsCollection {
+	v := &dummy.AttachmentsCollection{}
+	v.Attachments = make([]*dummy.Attachment, len(body.Attachments))
+	for i, val := range body.Attachments {
+		if val == nil {
+			v.Attachments[i] = nil
+			continue
+		}
+		v.Attachments[i] = unmarshalAttachmentResponseBodyToDummyAttachment(val)
+	}
+
+	return v
+}
+
+// NewListAttachmentsNotFound builds a dummy service list_attachments endpoint
+// not_found error.
+func NewListAttachmentsNotFound(body *ListAttachmentsNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteAttachmentForbidden builds a dummy service delete_attachment
+// endpoint forbidden error.
+func NewDeleteAttachmentForbidden(body *DeleteAttachmentForbiddenResponseBody) *dummy.DummyForbiddenError {
+	v := &dummy.DummyForbiddenError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteAttachmentNotFound builds a dummy service delete_attachment
+// endpoint not_found error.
+func NewDeleteAttachmentNotFound(body *DeleteAttachmentNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewBatchCreateItemsBatchResultOK builds a "dummy" service
+// "batch_create_items" endpoint result from a HTTP "OK" response.
+func NewBatchCreateItemsBatchResultOK(body *BatchCreateItemsResponseBody) *dummy.BatchResult {
+	v := &dummy.BatchResult{
+		Succeeded: *body.Succeeded,
+		Failed:    *body.Failed,
+	}
+	v.Results = make([]*dummy.BatchEntryResult, len(body.Results))
+	for i, val := range body.Results {
+		if val == nil {
+			v.Results[i] = nil
+			continue
+		}
+		v.Results[i] = unmarshalBatchEntryResultResponseBodyToDummyBatchEntryResult(val)
+	}
+
+	return v
+}
+
+// NewBatchUpdateItemsBatchResultOK builds a "dummy" service
+// "batch_update_items" endpoint result from a HTTP "OK" response.
+func NewBatchUpdateItemsBatchResultOK(body *BatchUpdateItemsResponseBody) *dummy.BatchResult {
+	v := &dummy.BatchResult{
+		Succeeded: *body.Succeeded,
+		Failed:    *body.Failed,
+	}
+	v.Results = make([]*dummy.BatchEntryResult, len(body.Results))
+	for i, val := range body.Results {
+		if val == nil {
+			v.Results[i] = nil
+			continue
+		}
+		v.Results[i] = unmarshalBatchEntryResultResponseBodyToDummyBatchEntryResult(val)
+	}
+
+	return v
+}
+
+// NewBatchDeleteItemsBatchResultOK builds a "dummy" service
+// "batch_delete_items" endpoint result from a HTTP "OK" response.
+func NewBatchDeleteItemsBatchResultOK(body *BatchDeleteItemsResponseBody) *dummy.BatchResult {
+	v := &dummy.BatchResult{
+		Succeeded: *body.Succeeded,
+		Failed:    *body.Failed,
+	}
+	v.Results = make([]*dummy.BatchEntryResult, len(body.Results))
+	for i, val := range body.Results {
+		if val == nil {
+			v.Results[i] = nil
+			continue
+		}
+		v.Results[i] = unmarshalBatchEntryResultResponseBodyToDummyBatchEntryResult(val)
+	}
+
+	return v
+}
+
+// NewExportItemsItemsExportOK builds a "dummy" service "export_items" endpoint
+// result from a HTTP "OK" response.
+func NewExportItemsItemsExportOK(contentType string, contentDisposition string) *dummy.ItemsExport {
+	v := &dummy.ItemsExport{}
+	v.ContentType = contentType
+	v.ContentDisposition = contentDisposition
+
+	return v
+}
+
+// NewImportItemsFileImportReportOK builds a "dummy" service
+// "import_items_file" endpoint result from a HTTP "OK" response.
+func NewImportItemsFileImportReportOK(body *ImportItemsFileResponseBody) *dummy.ImportReport {
+	v := &dummy.ImportReport{
+		DryRun:   *body.DryRun,
+		Rows:     *body.Rows,
+		Failed:   *body.Failed,
+		Imported: *body.Imported,
+	}
+	v.Errors = make([]*dummy.ImportRowError, len(body.Errors))
+	for i, val := range body.Errors {
+		if val == nil {
+			v.Errors[i] = nil
+			continue
+		}
+		v.Errors[i] = unmarshalImportRowErrorResponseBodyToDummyImportRowError(val)
+	}
+
+	return v
+}
+
+// NewImportItemsFileTooLarge builds a dummy service import_items_file endpoint
+// too_large error.
+func NewImportItemsFileTooLarge(body *ImportItemsFileTooLargeResponseBody) *dummy.DummyTooLargeError {
+	v := &dummy.DummyTooLargeError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewListTrashItemsCollectionOK builds a "dummy" service "list_trash" endpoint
+// result from a HTTP "OK" response.
+func NewListTrashItemsCollectionOK(body *ListTrashResponseBody) *dummy.ItemsCollection {
+	v := &dummy.ItemsCollection{
+		NextCursor: body.NextCursor,
+		Total:      body.Total,
+	}
+	v.Items = make([]*dummy.Item, len(body.Items))
+	for i, val := range body.Items {
+		if val == nil {
+			v.Items[i] = nil
+			continue
+		}
+		v.Items[i] = unmarshalItemResponseBodyToDummyItem(val)
+	}
+
+	return v
+}
+
+// NewListTrashInvalidCursor builds a dummy service list_trash endpoint
+// invalid_cursor error.
+func NewListTrashInvalidCursor(body *ListTrashInvalidCursorResponseBody) *dummy.DummyBadRequestError {
+	v := &dummy.DummyBadRequestError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewRestoreItemItemOK builds a "dummy" service "restore_item" endpoint result
+// from a HTTP "OK" response.
+func NewRestoreItemItemOK(body *RestoreItemResponseBody, etag string) *dummyviews.ItemView {
+	v := &dummyviews.ItemView{
+		ID:          body.ID,
+		Name:        body.Name,
+		Description: body.Description,
+		OwnerID:     body.OwnerID,
+		CreatedAt:   body.CreatedAt,
+		Version:     body.Version,
+		UpdatedAt:   body.UpdatedAt,
+		Language:    body.Language,
+		DeletedAt:   body.DeletedAt,
+		Permission:  body.Permission,
+	}
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
+	}
+	v.Etag = &etag
+
+	return v
+}
+
+// NewRestoreItemNotFound builds a dummy service restore_item endpoint
+// not_found error.
+func NewRestoreItemNotFound(body *RestoreItemNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewPurgeItemNotFound builds a dummy service purge_item endpoint not_found
+// error.
+func NewPurgeItemNotFound(body *PurgeItemNotFoundResponseBody) *dummy.DummyNotFoundError {
+	v := &dummy.DummyNotFoundError{
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// ValidateListItemsResponseBody runs the validations defined on
+// list_items_response_body
+func ValidateListItemsResponseBody(body *ListItemsResponseBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateItemResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSearchItemsResponseBody runs the validations defined on
+// search_items_response_body
+func ValidateSearchItemsResponseBody(body *SearchItemsResponseBody) (err error) {
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateSearchResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateShareItemResponseBody runs the validations defined on
+// share_item_response_body
+func ValidateShareItemResponseBody(body *ShareItemResponseBody) (err error) {
+	if body.ItemID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("item_id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Permission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("permission", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Permission != nil {
+		if !(*body.Permission == "viewer" || *body.Permission == "editor") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.permission", *body.Permission, []any{"viewer", "editor"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListItemSharesResponseBody runs the validations defined on
+// list_item_shares_response_body
+func ValidateListItemSharesResponseBody(body *ListItemSharesResponseBody) (err error) {
+	if body.Shares == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shares", "body"))
+	}
+	for _, e := range body.Shares {
+		if e != nil {
+			if err2 := ValidateItemShareResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSuggestTagsResponseBody runs the validations defined on
+// suggest_tags_response_body
+func ValidateSuggestTagsResponseBody(body *SuggestTagsResponseBody) (err error) {
+	if body.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "body"))
+	}
+	for _, e := range body.Tags {
+		if e != nil {
+			if err2 := ValidateTagSuggestionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUploadAttachmentResponseBody runs the validations defined on
+// upload_attachment_response_body
+func ValidateUploadAttachmentResponseBody(body *UploadAttachmentResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ItemID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("item_id", "body"))
+	}
+	if body.Filename == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("filename", "body"))
+	}
+	if body.ContentType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.ChecksumSha256 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checksum_sha256", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListAttachmentsResponseBody runs the validations defined on
+// list_attachments_response_body
+func ValidateListAttachmentsResponseBody(body *ListAttachmentsResponseBody) (err error) {
+	if body.Attachments == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attachments", "body"))
+	}
+	for _, e := range body.Attachments {
+		if e != nil {
+			if err2 := ValidateAttachmentResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchCreateItemsResponseBody runs the validations defined on
+// batch_create_items_response_body
+func ValidateBatchCreateItemsResponseBody(body *BatchCreateItemsResponseBody) (err error) {
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	if body.Succeeded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("succeeded", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateBatchEntryResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchUpdateItemsResponseBody runs the validations defined on
+// batch_update_items_response_body
+func ValidateBatchUpdateItemsResponseBody(body *BatchUpdateItemsResponseBody) (err error) {
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	if body.Succeeded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("succeeded", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateBatchEntryResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchDeleteItemsResponseBody runs the validations defined on
+// batch_delete_items_response_body
+func ValidateBatchDeleteItemsResponseBody(body *BatchDeleteItemsResponseBody) (err error) {
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	if body.Succeeded == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("succeeded", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateBatchEntryResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportItemsFileResponseBody runs the validations defined on
+// import_items_file_response_body
+func ValidateImportItemsFileResponseBody(body *ImportItemsFileResponseBody) (err error) {
+	if body.DryRun == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("dry_run", "body"))
+	}
+	if body.Rows == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rows", "body"))
+	}
+	if body.Failed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failed", "body"))
+	}
+	if body.Imported == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("imported", "body"))
+	}
+	if body.Errors == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errors", "body"))
+	}
+	for _, e := range body.Errors {
+		if e != nil {
+			if err2 := ValidateImportRowErrorResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListTrashResponseBody runs the validations defined on
+// list_trash_response_body
+func ValidateListTrashResponseBody(body *ListTrashResponseBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateItemResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListItemsInvalidCursorResponseBody runs the validations defined on
+// list_items_invalid_cursor_response_body
+func ValidateListItemsInvalidCursorResponseBody(body *ListItemsInvalidCursorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSearchItemsInvalidCursorResponseBody runs the validations defined on
+// search_items_invalid_cursor_response_body
+func ValidateSearchItemsInvalidCursorResponseBody(body *SearchItemsInvalidCursorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetItemNotFoundResponseBody runs the validations defined on
+// get_item_not_found_response_body
+func ValidateGetItemNotFoundResponseBody(body *GetItemNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItemConflictResponseBody runs the validations defined on
+// update_item_conflict_response_body
+func ValidateUpdateItemConflictResponseBody(body *UpdateItemConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItemForbiddenResponseBody runs the validations defined on
+// update_item_forbidden_response_body
+func ValidateUpdateItemForbiddenResponseBody(body *UpdateItemForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItemNotFoundResponseBody runs the validations defined on
+// update_item_not_found_response_body
+func ValidateUpdateItemNotFoundResponseBody(body *UpdateItemNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateItemPreconditionRequiredResponseBody runs the validations
+// defined on update_item_precondition_required_response_body
+func ValidateUpdateItemPreconditionRequiredResponseBody(body *UpdateItemPreconditionRequiredResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchItemConflictResponseBody runs the validations defined on
+// patch_item_conflict_response_body
+func ValidatePatchItemConflictResponseBody(body *PatchItemConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchItemForbiddenResponseBody runs the validations defined on
+// patch_item_forbidden_response_body
+func ValidatePatchItemForbiddenResponseBody(body *PatchItemForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchItemNotFoundResponseBody runs the validations defined on
+// patch_item_not_found_response_body
+func ValidatePatchItemNotFoundResponseBody(body *PatchItemNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePatchItemPreconditionRequiredResponseBody runs the validations
+// defined on patch_item_precondition_required_response_body
+func ValidatePatchItemPreconditionRequiredResponseBody(body *PatchItemPreconditionRequiredResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteItemNotFoundResponseBody runs the validations defined on
+// delete_item_not_found_response_body
+func ValidateDeleteItemNotFoundResponseBody(body *DeleteItemNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateShareItemForbiddenResponseBody runs the validations defined on
+// share_item_forbidden_response_body
+func ValidateShareItemForbiddenResponseBody(body *ShareItemForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateShareItemNotFoundResponseBody runs the validations defined on
+// share_item_not_found_response_body
+func ValidateShareItemNotFoundResponseBody(body *ShareItemNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUnshareItemForbiddenResponseBody runs the validations defined on
+// unshare_item_forbidden_response_body
+func ValidateUnshareItemForbiddenResponseBody(body *UnshareItemForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUnshareItemNotFoundResponseBody runs the validations defined on
+// unshare_item_not_found_response_body
+func ValidateUnshareItemNotFoundResponseBody(body *UnshareItemNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListItemSharesForbiddenResponseBody runs the validations defined on
+// list_item_shares_forbidden_response_body
+func ValidateListItemSharesForbiddenResponseBody(body *ListItemSharesForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListItemSharesNotFoundResponseBody runs the validations defined on
+// list_item_shares_not_found_response_body
+func ValidateListItemSharesNotFoundResponseBody(body *ListItemSharesNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAddItemTagForbiddenResponseBody runs the validations defined on
+// add_item_tag_forbidden_response_body
+func ValidateAddItemTagForbiddenResponseBody(body *AddItemTagForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAddItemTagNotFoundResponseBody runs the validations defined on
+// add_item_tag_not_found_response_body
+func ValidateAddItemTagNotFoundResponseBody(body *AddItemTagNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRemoveItemTagForbiddenResponseBody runs the validations defined on
+// remove_item_tag_forbidden_response_body
+func ValidateRemoveItemTagForbiddenResponseBody(body *RemoveItemTagForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRemoveItemTagNotFoundResponseBody runs the validations defined on
+// remove_item_tag_not_found_response_body
+func ValidateRemoveItemTagNotFoundResponseBody(body *RemoveItemTagNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUploadAttachmentChecksumMismatchResponseBody runs the validations
+// defined on upload_attachment_checksum_mismatch_response_body
+func ValidateUploadAttachmentChecksumMismatchResponseBody(body *UploadAttachmentChecksumMismatchResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUploadAttachmentForbiddenResponseBody runs the validations defined
+// on upload_attachment_forbidden_response_body
+func ValidateUploadAttachmentForbiddenResponseBody(body *UploadAttachmentForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUploadAttachmentNotFoundResponseBody runs the validations defined on
+// upload_attachment_not_found_response_body
+func ValidateUploadAttachmentNotFoundResponseBody(body *UploadAttachmentNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUploadAttachmentTooLargeResponseBody runs the validations defined on
+// upload_attachment_too_large_response_body
+func ValidateUploadAttachmentTooLargeResponseBody(body *UploadAttachmentTooLargeResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUploadAttachmentUnsupportedMediaTypeResponseBody runs the
+// validations defined on upload_attachment_unsupported_media_type_response_body
+func ValidateUploadAttachmentUnsupportedMediaTypeResponseBody(body *UploadAttachmentUnsupportedMediaTypeResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDownloadAttachmentNotFoundResponseBody runs the validations defined
+// on download_attachment_not_found_response_body
+func ValidateDownloadAttachmentNotFoundResponseBody(body *DownloadAttachmentNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListAttachmentsNotFoundResponseBody runs the validations defined on
+// list_attachments_not_found_response_body
+func ValidateListAttachmentsNotFoundResponseBody(body *ListAttachmentsNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteAttachmentForbiddenResponseBody runs the validations defined
+// on delete_attachment_forbidden_response_body
+func ValidateDeleteAttachmentForbiddenResponseBody(body *DeleteAttachmentForbiddenResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteAttachmentNotFoundResponseBody runs the validations defined on
+// delete_attachment_not_found_response_body
+func ValidateDeleteAttachmentNotFoundResponseBody(body *DeleteAttachmentNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateImportItemsFileTooLargeResponseBody runs the validations defined on
+// import_items_file_too_large_response_body
+func ValidateImportItemsFileTooLargeResponseBody(body *ImportItemsFileTooLargeResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListTrashInvalidCursorResponseBody runs the validations defined on
+// list_trash_invalid_cursor_response_body
+func ValidateListTrashInvalidCursorResponseBody(body *ListTrashInvalidCursorResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRestoreItemNotFoundResponseBody runs the validations defined on
+// restore_item_not_found_response_body
+func ValidateRestoreItemNotFoundResponseBody(body *RestoreItemNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePurgeItemNotFoundResponseBody runs the validations defined on
+// purge_item_not_found_response_body
+func ValidatePurgeItemNotFoundResponseBody(body *PurgeItemNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateItemResponseBody runs the validations defined on ItemResponseBody
+func ValidateItemResponseBody(body *ItemResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.OwnerID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("owner_id", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Etag == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("etag", "body"))
+	}
+	if body.Language == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("language", "body"))
+	}
+	if body.Permission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("permission", "body"))
+	}
+	if body.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "body"))
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updated_at", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	if body.DeletedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.deleted_at", *body.DeletedAt, goa.FormatDateTime))
+	}
+	if body.Permission != nil {
+		if !(*body.Permission == "owner" || *body.Permission == "editor" || *body.Permission == "viewer") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.permission", *body.Permission, []any{"owner", "editor", "viewer"}))
+		}
+	}
+	return
+}
+
+// ValidateSearchResultResponseBody runs the validations defined on
+// SearchResultResponseBody
+func ValidateSearchResultResponseBody(body *SearchResultResponseBody) (err error) {
+	if body.Item == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("item", "body"))
+	}
+	if body.Rank == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rank", "body"))
+	}
+	if body.Snippet == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("snippet", "body"))
+	}
+	if body.Item != nil {
+		if err2 := ValidateItemResponseBody(body.Item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateItemShareResponseBody runs the validations defined on
+// ItemShareResponseBody
+func ValidateItemShareResponseBody(body *ItemShareResponseBody) (err error) {
+	if body.ItemID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("item_id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Permission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("permission", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Permission != nil {
+		if !(*body.Permission == "viewer" || *body.Permission == "editor") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.permission", *body.Permission, []any{"viewer", "editor"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateTagSuggestionResponseBody runs the validations defined on
+// TagSuggestionResponseBody
+func ValidateTagSuggestionResponseBody(body *TagSuggestionResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.ItemCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("item_count", "body"))
+	}
+	return
+}
+
+// ValidateAttachmentResponseBody runs the validations defined on
+// AttachmentResponseBody
+func ValidateAttachmentResponseBody(body *AttachmentResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ItemID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("item_id", "body"))
+	}
+	if body.Filename == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("filename", "body"))
+	}
+	if body.ContentType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content_type", "body"))
+	}
+	if body.Size == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("size", "body"))
+	}
+	if body.ChecksumSha256 == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("checksum_sha256", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
 	if body.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
 	}
 	return
 }
+
+// ValidateBatchItemEntryRequestBody runs the validations defined on
+// BatchItemEntryRequestBody
+func ValidateBatchItemEntryRequestBody(body *BatchItemEntryRequestBody) (err error) {
+	if utf8.RuneCountInString(body.Name) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", body.Name, utf8.RuneCountInString(body.Name), 1, true))
+	}
+	if body.Language != nil {
+		if !(*body.Language == "simple" || *body.Language == "arabic" || *body.Language == "armenian" || *body.Language == "basque" || *body.Language == "catalan" || *body.Language == "danish" || *body.Language == "dutch" || *body.Language == "english" || *body.Language == "finnish" || *body.Language == "french" || *body.Language == "german" || *body.Language == "greek" || *body.Language == "hindi" || *body.Language == "hungarian" || *body.Language == "indonesian" || *body.Language == "irish" || *body.Language == "italian" || *body.Language == "lithuanian" || *body.Language == "nepali" || *body.Language == "norwegian" || *body.Language == "portuguese" || *body.Language == "romanian" || *body.Language == "russian" || *body.Language == "serbian" || *body.Language == "spanish" || *body.Language == "swedish" || *body.Language == "tamil" || *body.Language == "turkish" || *body.Language == "yiddish") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+		}
+	}
+	return
+}
+
+// ValidateBatchEntryResultResponseBody runs the validations defined on
+// BatchEntryResultResponseBody
+func ValidateBatchEntryResultResponseBody(body *BatchEntryResultResponseBody) (err error) {
+	if body.Index == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("index", "body"))
+	}
+	if body.Item != nil {
+		if err2 := ValidateItemResponseBody(body.Item); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Error != nil {
+		if err2 := ValidateBatchEntryErrorResponseBody(body.Error); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateBatchEntryErrorResponseBody runs the validations defined on
+// BatchEntryErrorResponseBody
+func ValidateBatchEntryErrorResponseBody(body *BatchEntryErrorResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateBatchUpdateEntryRequestBody runs the validations defined on
+// BatchUpdateEntryRequestBody
+func ValidateBatchUpdateEntryRequestBody(body *BatchUpdateEntryRequestBody) (err error) {
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	return
+}
+
+// ValidateImportRowErrorResponseBody runs the validations defined on
+// ImportRowErrorResponseBody
+func ValidateImportRowErrorResponseBody(body *ImportRowErrorResponseBody) (err error) {
+	if body.Line == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("line", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
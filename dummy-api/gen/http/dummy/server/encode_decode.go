@@ -12,6 +12,8 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
+	"unicode/utf8"
 
 	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	dummyviews "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy/views"
@@ -26,6 +28,9 @@ func EncodeCreateItemResponse(encoder func(context.Context, http.ResponseWriter)
 		res := v.(*dummyviews.Item)
 		enc := encoder(ctx, w)
 		body := NewCreateItemResponseBody(res.Projected)
+		if res.Projected.Etag != nil {
+			w.Header().Set("Etag", *res.Projected.Etag)
+		}
 		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
@@ -88,9 +93,199 @@ func EncodeListItemsResponse(encoder func(context.Context, http.ResponseWriter)
 func DecodeListItemsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ListItemsPayload, error) {
 	return func(r *http.Request) (*dummy.ListItemsPayload, error) {
 		var (
-			token string
-			err   error
+			pageSize      int
+			cursor        *string
+			order         string
+			namePrefix    *string
+			createdAfter  *string
+			createdBefore *string
+			includeTotal  bool
+			tags          []string
+			tagMatch      string
+			token         string
+			err           error
+		)
+		qp := r.URL.Query()
+		{
+			pageSizeRaw := qp.Get("page_size")
+			if pageSizeRaw == "" {
+				pageSize = 50
+			} else {
+				v, err2 := strconv.ParseInt(pageSizeRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("page_size", pageSizeRaw, "integer"))
+				}
+				pageSize = int(v)
+			}
+		}
+		if pageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+		}
+		if pageSize > 200 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 200, false))
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		orderRaw := qp.Get("order")
+		if orderRaw != "" {
+			order = orderRaw
+		} else {
+			order = "desc"
+		}
+		if !(order == "desc" || order == "asc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("order", order, []any{"desc", "asc"}))
+		}
+		namePrefixRaw := qp.Get("name_prefix")
+		if namePrefixRaw != "" {
+			namePrefix = &namePrefixRaw
+		}
+		createdAfterRaw := qp.Get("created_after")
+		if createdAfterRaw != "" {
+			createdAfter = &createdAfterRaw
+		}
+		if createdAfter != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("created_after", *createdAfter, goa.FormatDateTime))
+		}
+		createdBeforeRaw := qp.Get("created_before")
+		if createdBeforeRaw != "" {
+			createdBefore = &createdBeforeRaw
+		}
+		if createdBefore != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("created_before", *createdBefore, goa.FormatDateTime))
+		}
+		{
+			includeTotalRaw := qp.Get("include_total")
+			if includeTotalRaw != "" {
+				v, err2 := strconv.ParseBool(includeTotalRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("include_total", includeTotalRaw, "boolean"))
+				}
+				includeTotal = v
+			}
+		}
+		tags = qp["tags"]
+		if len(tags) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tags", tags, len(tags), 20, false))
+		}
+		tagMatchRaw := qp.Get("tag_match")
+		if tagMatchRaw != "" {
+			tagMatch = tagMatchRaw
+		} else {
+			tagMatch = "any"
+		}
+		if !(tagMatch == "any" || tagMatch == "all") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("tag_match", tagMatch, []any{"any", "all"}))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListItemsPayload(pageSize, cursor, order, namePrefix, createdAfter, createdBefore, includeTotal, tags, tagMatch, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeListItemsError returns an encoder for errors returned by the
+// list_items dummy endpoint.
+func EncodeListItemsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_cursor":
+			var res *dummy.DummyBadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListItemsInvalidCursorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSearchItemsResponse returns an encoder for responses returned by the
+// dummy search_items endpoint.
+func EncodeSearchItemsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.SearchResultsCollection)
+		enc := encoder(ctx, w)
+		body := NewSearchItemsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSearchItemsRequest returns a decoder for requests sent to the dummy
+// search_items endpoint.
+func DecodeSearchItemsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.SearchItemsPayload, error) {
+	return func(r *http.Request) (*dummy.SearchItemsPayload, error) {
+		var (
+			query    string
+			language *string
+			pageSize int
+			cursor   *string
+			token    string
+			err      error
 		)
+		qp := r.URL.Query()
+		query = qp.Get("q")
+		if query == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("query", "query string"))
+		}
+		if utf8.RuneCountInString(query) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 1, true))
+		}
+		if utf8.RuneCountInString(query) > 500 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("query", query, utf8.RuneCountInString(query), 500, false))
+		}
+		languageRaw := qp.Get("language")
+		if languageRaw != "" {
+			language = &languageRaw
+		}
+		if language != nil {
+			if !(*language == "simple" || *language == "arabic" || *language == "armenian" || *language == "basque" || *language == "catalan" || *language == "danish" || *language == "dutch" || *language == "english" || *language == "finnish" || *language == "french" || *language == "german" || *language == "greek" || *language == "hindi" || *language == "hungarian" || *language == "indonesian" || *language == "irish" || *language == "italian" || *language == "lithuanian" || *language == "nepali" || *language == "norwegian" || *language == "portuguese" || *language == "romanian" || *language == "russian" || *language == "serbian" || *language == "spanish" || *language == "swedish" || *language == "tamil" || *language == "turkish" || *language == "yiddish") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("language", *language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+			}
+		}
+		{
+			pageSizeRaw := qp.Get("page_size")
+			if pageSizeRaw == "" {
+				pageSize = 20
+			} else {
+				v, err2 := strconv.ParseInt(pageSizeRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("page_size", pageSizeRaw, "integer"))
+				}
+				pageSize = int(v)
+			}
+		}
+		if pageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+		}
+		if pageSize > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 100, false))
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
 		token = r.Header.Get("Authorization")
 		if token == "" {
 			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
@@ -98,12 +293,41 @@ func DecodeListItemsRequest(mux goahttp.Muxer, decoder func(*http.Request) goaht
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListItemsPayload(token)
+		payload := NewSearchItemsPayload(query, language, pageSize, cursor, token)
 
 		return payload, nil
 	}
 }
 
+// EncodeSearchItemsError returns an encoder for errors returned by the
+// search_items dummy endpoint.
+func EncodeSearchItemsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_cursor":
+			var res *dummy.DummyBadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSearchItemsInvalidCursorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeGetItemResponse returns an encoder for responses returned by the dummy
 // get_item endpoint.
 func EncodeGetItemResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -111,6 +335,9 @@ func EncodeGetItemResponse(encoder func(context.Context, http.ResponseWriter) go
 		res := v.(*dummyviews.Item)
 		enc := encoder(ctx, w)
 		body := NewGetItemResponseBody(res.Projected)
+		if res.Projected.Etag != nil {
+			w.Header().Set("Etag", *res.Projected.Etag)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -141,6 +368,314 @@ func DecodeGetItemRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp
 	}
 }
 
+// EncodeGetItemError returns an encoder for errors returned by the get_item
+// dummy endpoint.
+func EncodeGetItemError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetItemNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateItemResponse returns an encoder for responses returned by the
+// dummy update_item endpoint.
+func EncodeUpdateItemResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*dummyviews.Item)
+		enc := encoder(ctx, w)
+		body := NewUpdateItemResponseBody(res.Projected)
+		if res.Projected.Etag != nil {
+			w.Header().Set("Etag", *res.Projected.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateItemRequest returns a decoder for requests sent to the dummy
+// update_item endpoint.
+func DecodeUpdateItemRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.UpdateItemPayload, error) {
+	return func(r *http.Request) (*dummy.UpdateItemPayload, error) {
+		var (
+			body struct {
+				Name *string `form:"name" json:"name" xml:"name"`
+				// New description; omit to clear it
+				Description *string `form:"description" json:"description" xml:"description"`
+			}
+			err error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		if body.Name == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id      string
+			token   string
+			ifMatch *string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateItemPayload(body, id, token, ifMatch)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateItemError returns an encoder for errors returned by the
+// update_item dummy endpoint.
+func EncodeUpdateItemError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "conflict":
+			var res *dummy.DummyConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItemConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItemForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItemNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "precondition_required":
+			var res *dummy.DummyPreconditionRequiredError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateItemPreconditionRequiredResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusPreconditionRequired)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePatchItemResponse returns an encoder for responses returned by the
+// dummy patch_item endpoint.
+func EncodePatchItemResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*dummyviews.Item)
+		enc := encoder(ctx, w)
+		body := NewPatchItemResponseBody(res.Projected)
+		if res.Projected.Etag != nil {
+			w.Header().Set("Etag", *res.Projected.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePatchItemRequest returns a decoder for requests sent to the dummy
+// patch_item endpoint.
+func DecodePatchItemRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.PatchItemPayload, error) {
+	return func(r *http.Request) (*dummy.PatchItemPayload, error) {
+		var (
+			body struct {
+				// New name; omit to keep it
+				Name *string `form:"name" json:"name" xml:"name"`
+				// New description; omit to keep it, send an empty string to clear it
+				Description *string `form:"description" json:"description" xml:"description"`
+			}
+			err error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		if body.Name != nil {
+			if utf8.RuneCountInString(*body.Name) < 1 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id      string
+			token   string
+			ifMatch *string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		ifMatchRaw := r.Header.Get("If-Match")
+		if ifMatchRaw != "" {
+			ifMatch = &ifMatchRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPatchItemPayload(body, id, token, ifMatch)
+
+		return payload, nil
+	}
+}
+
+// EncodePatchItemError returns an encoder for errors returned by the
+// patch_item dummy endpoint.
+func EncodePatchItemError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "conflict":
+			var res *dummy.DummyConflictError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchItemConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchItemForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchItemNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "precondition_required":
+			var res *dummy.DummyPreconditionRequiredError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchItemPreconditionRequiredResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusPreconditionRequired)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeDeleteItemResponse returns an encoder for responses returned by the
 // dummy delete_item endpoint.
 func EncodeDeleteItemResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -175,15 +710,1547 @@ func DecodeDeleteItemRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 	}
 }
 
-// marshalDummyItemToItemResponseBody builds a value of type *ItemResponseBody
-// from a value of type *dummy.Item.
-func marshalDummyItemToItemResponseBody(v *dummy.Item) *ItemResponseBody {
-	res := &ItemResponseBody{
-		ID:          v.ID,
-		Name:        v.Name,
-		Description: v.Description,
-		OwnerID:     v.OwnerID,
-		CreatedAt:   v.CreatedAt,
+// EncodeDeleteItemError returns an encoder for errors returned by the
+// delete_item dummy endpoint.
+func EncodeDeleteItemError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteItemNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeShareItemResponse returns an encoder for responses returned by the
+// dummy share_item endpoint.
+func EncodeShareItemResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.ItemShare)
+		enc := encoder(ctx, w)
+		body := NewShareItemResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeShareItemRequest returns a decoder for requests sent to the dummy
+// share_item endpoint.
+func DecodeShareItemRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ShareItemPayload, error) {
+	return func(r *http.Request) (*dummy.ShareItemPayload, error) {
+		var (
+			body struct {
+				// viewer can read the item, editor can also update it
+				Permission *string `form:"permission" json:"permission" xml:"permission"`
+			}
+			err error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		if body.Permission == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("permission", "body"))
+		}
+		if body.Permission != nil {
+			if !(*body.Permission == "viewer" || *body.Permission == "editor") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.permission", *body.Permission, []any{"viewer", "editor"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id     string
+			userID string
+			token  string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		userID = params["user_id"]
+		err = goa.MergeErrors(err, goa.ValidateFormat("user_id", userID, goa.FormatUUID))
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewShareItemPayload(body, id, userID, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeShareItemError returns an encoder for errors returned by the
+// share_item dummy endpoint.
+func EncodeShareItemError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewShareItemForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewShareItemNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUnshareItemResponse returns an encoder for responses returned by the
+// dummy unshare_item endpoint.
+func EncodeUnshareItemResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeUnshareItemRequest returns a decoder for requests sent to the dummy
+// unshare_item endpoint.
+func DecodeUnshareItemRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.UnshareItemPayload, error) {
+	return func(r *http.Request) (*dummy.UnshareItemPayload, error) {
+		var (
+			id     string
+			userID string
+			token  string
+			err    error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		userID = params["user_id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUnshareItemPayload(id, userID, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeUnshareItemError returns an encoder for errors returned by the
+// unshare_item dummy endpoint.
+func EncodeUnshareItemError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUnshareItemForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUnshareItemNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListItemSharesResponse returns an encoder for responses returned by
+// the dummy list_item_shares endpoint.
+func EncodeListItemSharesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.ItemSharesCollection)
+		enc := encoder(ctx, w)
+		body := NewListItemSharesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListItemSharesRequest returns a decoder for requests sent to the dummy
+// list_item_shares endpoint.
+func DecodeListItemSharesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ItemIDPayload, error) {
+	return func(r *http.Request) (*dummy.ItemIDPayload, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListItemSharesItemIDPayload(id, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeListItemSharesError returns an encoder for errors returned by the
+// list_item_shares dummy endpoint.
+func EncodeListItemSharesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListItemSharesForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListItemSharesNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAddItemTagResponse returns an encoder for responses returned by the
+// dummy add_item_tag endpoint.
+func EncodeAddItemTagResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*dummyviews.Item)
+		enc := encoder(ctx, w)
+		body := NewAddItemTagResponseBody(res.Projected)
+		if res.Projected.Etag != nil {
+			w.Header().Set("Etag", *res.Projected.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAddItemTagRequest returns a decoder for requests sent to the dummy
+// add_item_tag endpoint.
+func DecodeAddItemTagRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ItemTagPayload, error) {
+	return func(r *http.Request) (*dummy.ItemTagPayload, error) {
+		var (
+			id    string
+			tag   string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		tag = params["tag"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("tag", tag, "^\\S(.*\\S)?$"))
+		if utf8.RuneCountInString(tag) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tag", tag, utf8.RuneCountInString(tag), 1, true))
+		}
+		if utf8.RuneCountInString(tag) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tag", tag, utf8.RuneCountInString(tag), 50, false))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewAddItemTagItemTagPayload(id, tag, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeAddItemTagError returns an encoder for errors returned by the
+// add_item_tag dummy endpoint.
+func EncodeAddItemTagError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddItemTagForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewAddItemTagNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRemoveItemTagResponse returns an encoder for responses returned by the
+// dummy remove_item_tag endpoint.
+func EncodeRemoveItemTagResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*dummyviews.Item)
+		enc := encoder(ctx, w)
+		body := NewRemoveItemTagResponseBody(res.Projected)
+		if res.Projected.Etag != nil {
+			w.Header().Set("Etag", *res.Projected.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRemoveItemTagRequest returns a decoder for requests sent to the dummy
+// remove_item_tag endpoint.
+func DecodeRemoveItemTagRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ItemTagPayload, error) {
+	return func(r *http.Request) (*dummy.ItemTagPayload, error) {
+		var (
+			id    string
+			tag   string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		tag = params["tag"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("tag", tag, "^\\S(.*\\S)?$"))
+		if utf8.RuneCountInString(tag) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tag", tag, utf8.RuneCountInString(tag), 1, true))
+		}
+		if utf8.RuneCountInString(tag) > 50 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("tag", tag, utf8.RuneCountInString(tag), 50, false))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRemoveItemTagItemTagPayload(id, tag, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeRemoveItemTagError returns an encoder for errors returned by the
+// remove_item_tag dummy endpoint.
+func EncodeRemoveItemTagError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveItemTagForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRemoveItemTagNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSuggestTagsResponse returns an encoder for responses returned by the
+// dummy suggest_tags endpoint.
+func EncodeSuggestTagsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.TagSuggestions)
+		enc := encoder(ctx, w)
+		body := NewSuggestTagsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSuggestTagsRequest returns a decoder for requests sent to the dummy
+// suggest_tags endpoint.
+func DecodeSuggestTagsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.SuggestTagsPayload, error) {
+	return func(r *http.Request) (*dummy.SuggestTagsPayload, error) {
+		var (
+			prefix string
+			limit  int
+			token  string
+			err    error
+		)
+		qp := r.URL.Query()
+		prefixRaw := qp.Get("prefix")
+		if prefixRaw != "" {
+			prefix = prefixRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 10
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 50 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 50, false))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSuggestTagsPayload(prefix, limit, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeUploadAttachmentResponse returns an encoder for responses returned by
+// the dummy upload_attachment endpoint.
+func EncodeUploadAttachmentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.Attachment)
+		enc := encoder(ctx, w)
+		body := NewUploadAttachmentResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUploadAttachmentRequest returns a decoder for requests sent to the
+// dummy upload_attachment endpoint.
+func DecodeUploadAttachmentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.UploadAttachmentPayload, error) {
+	return func(r *http.Request) (*dummy.UploadAttachmentPayload, error) {
+		var (
+			id             string
+			filename       string
+			token          string
+			checksumSha256 *string
+			err            error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		filename = r.URL.Query().Get("filename")
+		if filename == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("filename", "query string"))
+		}
+		if utf8.RuneCountInString(filename) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("filename", filename, utf8.RuneCountInString(filename), 1, true))
+		}
+		if utf8.RuneCountInString(filename) > 255 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("filename", filename, utf8.RuneCountInString(filename), 255, false))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		checksumSha256Raw := r.Header.Get("X-Checksum-SHA256")
+		if checksumSha256Raw != "" {
+			checksumSha256 = &checksumSha256Raw
+		}
+		if checksumSha256 != nil {
+			err = goa.MergeErrors(err, goa.ValidatePattern("checksum_sha256", *checksumSha256, "^[0-9a-fA-F]{64}$"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUploadAttachmentPayload(id, filename, token, checksumSha256)
+
+		return payload, nil
+	}
+}
+
+// EncodeUploadAttachmentError returns an encoder for errors returned by the
+// upload_attachment dummy endpoint.
+func EncodeUploadAttachmentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "checksum_mismatch":
+			var res *dummy.DummyChecksumMismatchError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadAttachmentChecksumMismatchResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadAttachmentForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadAttachmentNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "too_large":
+			var res *dummy.DummyTooLargeError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadAttachmentTooLargeResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return enc.Encode(body)
+		case "unsupported_media_type":
+			var res *dummy.DummyUnsupportedMediaTypeError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUploadAttachmentUnsupportedMediaTypeResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnsupportedMediaType)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDownloadAttachmentResponse returns an encoder for responses returned
+// by the dummy download_attachment endpoint.
+func EncodeDownloadAttachmentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.AttachmentDownload)
+		w.Header().Set("Content-Type", res.ContentType)
+		{
+			val := res.ContentLength
+			contentLengths := strconv.FormatInt(val, 10)
+			w.Header().Set("Content-Length", contentLengths)
+		}
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.Header().Set("X-Checksum-Sha256", res.ChecksumSha256)
+		w.Header().Set("X-Content-Type-Options", res.ContentTypeOptions)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeDownloadAttachmentRequest returns a decoder for requests sent to the
+// dummy download_attachment endpoint.
+func DecodeDownloadAttachmentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.AttachmentIDPayload, error) {
+	return func(r *http.Request) (*dummy.AttachmentIDPayload, error) {
+		var (
+			id           string
+			attachmentID string
+			token        string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		attachmentID = params["attachment_id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDownloadAttachmentAttachmentIDPayload(id, attachmentID, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeDownloadAttachmentError returns an encoder for errors returned by the
+// download_attachment dummy endpoint.
+func EncodeDownloadAttachmentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDownloadAttachmentNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListAttachmentsResponse returns an encoder for responses returned by
+// the dummy list_attachments endpoint.
+func EncodeListAttachmentsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.AttachmentsCollection)
+		enc := encoder(ctx, w)
+		body := NewListAttachmentsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListAttachmentsRequest returns a decoder for requests sent to the
+// dummy list_attachments endpoint.
+func DecodeListAttachmentsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ItemIDPayload, error) {
+	return func(r *http.Request) (*dummy.ItemIDPayload, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListAttachmentsItemIDPayload(id, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeListAttachmentsError returns an encoder for errors returned by the
+// list_attachments dummy endpoint.
+func EncodeListAttachmentsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListAttachmentsNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteAttachmentResponse returns an encoder for responses returned by
+// the dummy delete_attachment endpoint.
+func EncodeDeleteAttachmentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteAttachmentRequest returns a decoder for requests sent to the
+// dummy delete_attachment endpoint.
+func DecodeDeleteAttachmentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.AttachmentIDPayload, error) {
+	return func(r *http.Request) (*dummy.AttachmentIDPayload, error) {
+		var (
+			id           string
+			attachmentID string
+			token        string
+			err          error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		attachmentID = params["attachment_id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteAttachmentAttachmentIDPayload(id, attachmentID, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteAttachmentError returns an encoder for errors returned by the
+// delete_attachment dummy endpoint.
+func EncodeDeleteAttachmentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "forbidden":
+			var res *dummy.DummyForbiddenError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteAttachmentForbiddenResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteAttachmentNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeBatchCreateItemsResponse returns an encoder for responses returned by
+// the dummy batch_create_items endpoint.
+func EncodeBatchCreateItemsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.BatchResult)
+		enc := encoder(ctx, w)
+		body := NewBatchCreateItemsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBatchCreateItemsRequest returns a decoder for requests sent to the
+// dummy batch_create_items endpoint.
+func DecodeBatchCreateItemsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.BatchCreateItemsPayload, error) {
+	return func(r *http.Request) (*dummy.BatchCreateItemsPayload, error) {
+		var (
+			body BatchCreateItemsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateBatchCreateItemsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			token string
+		)
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewBatchCreateItemsPayload(&body, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeBatchUpdateItemsResponse returns an encoder for responses returned by
+// the dummy batch_update_items endpoint.
+func EncodeBatchUpdateItemsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.BatchResult)
+		enc := encoder(ctx, w)
+		body := NewBatchUpdateItemsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBatchUpdateItemsRequest returns a decoder for requests sent to the
+// dummy batch_update_items endpoint.
+func DecodeBatchUpdateItemsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.BatchUpdateItemsPayload, error) {
+	return func(r *http.Request) (*dummy.BatchUpdateItemsPayload, error) {
+		var (
+			body BatchUpdateItemsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateBatchUpdateItemsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			token string
+		)
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewBatchUpdateItemsPayload(&body, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeBatchDeleteItemsResponse returns an encoder for responses returned by
+// the dummy batch_delete_items endpoint.
+func EncodeBatchDeleteItemsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.BatchResult)
+		enc := encoder(ctx, w)
+		body := NewBatchDeleteItemsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeBatchDeleteItemsRequest returns a decoder for requests sent to the
+// dummy batch_delete_items endpoint.
+func DecodeBatchDeleteItemsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.BatchDeleteItemsPayload, error) {
+	return func(r *http.Request) (*dummy.BatchDeleteItemsPayload, error) {
+		var (
+			body BatchDeleteItemsRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateBatchDeleteItemsRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			token string
+		)
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewBatchDeleteItemsPayload(&body, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeExportItemsResponse returns an encoder for responses returned by the
+// dummy export_items endpoint.
+func EncodeExportItemsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.ItemsExport)
+		w.Header().Set("Content-Type", res.ContentType)
+		w.Header().Set("Content-Disposition", res.ContentDisposition)
+		w.WriteHeader(http.StatusOK)
+		return nil
+	}
+}
+
+// DecodeExportItemsRequest returns a decoder for requests sent to the dummy
+// export_items endpoint.
+func DecodeExportItemsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ExportItemsPayload, error) {
+	return func(r *http.Request) (*dummy.ExportItemsPayload, error) {
+		var (
+			format string
+			token  string
+			err    error
+		)
+		formatRaw := r.URL.Query().Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "ndjson"
+		}
+		if !(format == "csv" || format == "ndjson") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "ndjson"}))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewExportItemsPayload(format, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeImportItemsFileResponse returns an encoder for responses returned by
+// the dummy import_items_file endpoint.
+func EncodeImportItemsFileResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.ImportReport)
+		enc := encoder(ctx, w)
+		body := NewImportItemsFileResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeImportItemsFileRequest returns a decoder for requests sent to the
+// dummy import_items_file endpoint.
+func DecodeImportItemsFileRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ImportItemsFilePayload, error) {
+	return func(r *http.Request) (*dummy.ImportItemsFilePayload, error) {
+		var (
+			format string
+			dryRun bool
+			token  string
+			err    error
+		)
+		qp := r.URL.Query()
+		formatRaw := qp.Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "ndjson"
+		}
+		if !(format == "csv" || format == "ndjson") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"csv", "ndjson"}))
+		}
+		{
+			dryRunRaw := qp.Get("dry_run")
+			if dryRunRaw != "" {
+				v, err2 := strconv.ParseBool(dryRunRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("dry_run", dryRunRaw, "boolean"))
+				}
+				dryRun = v
+			}
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewImportItemsFilePayload(format, dryRun, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeImportItemsFileError returns an encoder for errors returned by the
+// import_items_file dummy endpoint.
+func EncodeImportItemsFileError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "too_large":
+			var res *dummy.DummyTooLargeError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewImportItemsFileTooLargeResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListTrashResponse returns an encoder for responses returned by the
+// dummy list_trash endpoint.
+func EncodeListTrashResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*dummy.ItemsCollection)
+		enc := encoder(ctx, w)
+		body := NewListTrashResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListTrashRequest returns a decoder for requests sent to the dummy
+// list_trash endpoint.
+func DecodeListTrashRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ListTrashPayload, error) {
+	return func(r *http.Request) (*dummy.ListTrashPayload, error) {
+		var (
+			pageSize int
+			cursor   *string
+			token    string
+			err      error
+		)
+		qp := r.URL.Query()
+		{
+			pageSizeRaw := qp.Get("page_size")
+			if pageSizeRaw == "" {
+				pageSize = 50
+			} else {
+				v, err2 := strconv.ParseInt(pageSizeRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("page_size", pageSizeRaw, "integer"))
+				}
+				pageSize = int(v)
+			}
+		}
+		if pageSize < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 1, true))
+		}
+		if pageSize > 200 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("page_size", pageSize, 200, false))
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListTrashPayload(pageSize, cursor, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeListTrashError returns an encoder for errors returned by the
+// list_trash dummy endpoint.
+func EncodeListTrashError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_cursor":
+			var res *dummy.DummyBadRequestError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListTrashInvalidCursorResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRestoreItemResponse returns an encoder for responses returned by the
+// dummy restore_item endpoint.
+func EncodeRestoreItemResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res := v.(*dummyviews.Item)
+		enc := encoder(ctx, w)
+		body := NewRestoreItemResponseBody(res.Projected)
+		if res.Projected.Etag != nil {
+			w.Header().Set("Etag", *res.Projected.Etag)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRestoreItemRequest returns a decoder for requests sent to the dummy
+// restore_item endpoint.
+func DecodeRestoreItemRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ItemIDPayload, error) {
+	return func(r *http.Request) (*dummy.ItemIDPayload, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRestoreItemItemIDPayload(id, token)
+
+		return payload, nil
+	}
+}
+
+// EncodeRestoreItemError returns an encoder for errors returned by the
+// restore_item dummy endpoint.
+func EncodeRestoreItemError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRestoreItemNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePurgeItemResponse returns an encoder for responses returned by the
+// dummy purge_item endpoint.
+func EncodePurgeItemResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodePurgeItemRequest returns a decoder for requests sent to the dummy
+// purge_item endpoint.
+func DecodePurgeItemRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*dummy.ItemIDPayload, error) {
+	return func(r *http.Request) (*dummy.ItemIDPayload, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPurgeItemItemIDPayload(id, token)
+
+		return payload, nil
+	}
+}
+
+// EncodePurgeItemError returns an encoder for errors returned by the
+// purge_item dummy endpoint.
+func EncodePurgeItemError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "not_found":
+			var res *dummy.DummyNotFoundError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPurgeItemNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalDummyItemToItemResponseBody builds a value of type *ItemResponseBody
+// from a value of type *dummy.Item.
+func marshalDummyItemToItemResponseBody(v *dummy.Item) *ItemResponseBody {
+	res := &ItemResponseBody{
+		ID:          v.ID,
+		Name:        v.Name,
+		Description: v.Description,
+		OwnerID:     v.OwnerID,
+		CreatedAt:   v.CreatedAt,
+		Version:     v.Version,
+		UpdatedAt:   v.UpdatedAt,
+		Etag:        v.Etag,
+		Language:    v.Language,
+		DeletedAt:   v.DeletedAt,
+		Permission:  v.Permission,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	} else {
+		res.Tags = []string{}
+	}
+
+	return res
+}
+
+// marshalDummySearchResultToSearchResultResponseBody builds a value of type
+// *SearchResultResponseBody from a value of type *dummy.SearchResult.
+func marshalDummySearchResultToSearchResultResponseBody(v *dummy.SearchResult) *SearchResultResponseBody {
+	res := &SearchResultResponseBody{
+		Rank:    v.Rank,
+		Snippet: v.Snippet,
+	}
+	if v.Item != nil {
+		res.Item = marshalDummyItemToItemResponseBody(v.Item)
+	}
+
+	return res
+}
+
+// marshalDummyItemShareToItemShareResponseBody builds a value of type
+// *ItemShareResponseBody from a value of type *dummy.ItemShare.
+func marshalDummyItemShareToItemShareResponseBody(v *dummy.ItemShare) *ItemShareResponseBody {
+	res := &ItemShareResponseBody{
+		ItemID:     v.ItemID,
+		UserID:     v.UserID,
+		Permission: v.Permission,
+		CreatedAt:  v.CreatedAt,
+	}
+
+	return res
+}
+
+// marshalDummyTagSuggestionToTagSuggestionResponseBody builds a value of type
+// *TagSuggestionResponseBody from a value of type *dummy.TagSuggestion.
+func marshalDummyTagSuggestionToTagSuggestionResponseBody(v *dummy.TagSuggestion) *TagSuggestionResponseBody {
+	res := &TagSuggestionResponseBody{
+		Name:      v.Name,
+		ItemCount: v.ItemCount,
+	}
+
+	return res
+}
+
+// marshalDummyAttachmentToAttachmentResponseBody builds a value of type
+// *AttachmentResponseBody from a value of type *dummy.Attachment.
+func marshalDummyAttachmentToAttachmentResponseBody(v *dummy.Attachment) *AttachmentResponseBody {
+	res := &AttachmentResponseBody{
+		ID:             v.ID,
+		ItemID:         v.ItemID,
+		Filename:       v.Filename,
+		ContentType:    v.ContentType,
+		Size:           v.Size,
+		ChecksumSha256: v.ChecksumSha256,
+		CreatedAt:      v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalBatchItemEntryRequestBodyToDummyBatchItemEntry builds a value of
+// type *dummy.BatchItemEntry from a value of type *BatchItemEntryRequestBody.
+func unmarshalBatchItemEntryRequestBodyToDummyBatchItemEntry(v *BatchItemEntryRequestBody) *dummy.BatchItemEntry {
+	res := &dummy.BatchItemEntry{
+		Name:        *v.Name,
+		Description: v.Description,
+		Language:    v.Language,
+	}
+
+	return res
+}
+
+// marshalDummyBatchEntryResultToBatchEntryResultResponseBody builds a value of
+// type *BatchEntryResultResponseBody from a value of type
+// *dummy.BatchEntryResult.
+func marshalDummyBatchEntryResultToBatchEntryResultResponseBody(v *dummy.BatchEntryResult) *BatchEntryResultResponseBody {
+	res := &BatchEntryResultResponseBody{
+		Index: v.Index,
+	}
+	if v.Item != nil {
+		res.Item = marshalDummyItemToItemResponseBody(v.Item)
+	}
+	if v.Error != nil {
+		res.Error = marshalDummyBatchEntryErrorToBatchEntryErrorResponseBody(v.Error)
+	}
+
+	return res
+}
+
+// marshalDummyBatchEntryErrorToBatchEntryErrorResponseBody builds a value of
+// type *BatchEntryErrorResponseBody from a value of type
+// *dummy.BatchEntryError.
+func marshalDummyBatchEntryErrorToBatchEntryErrorResponseBody(v *dummy.BatchEntryError) *BatchEntryErrorResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &BatchEntryErrorResponseBody{
+		Name:    v.Name,
+		Message: v.Message,
+	}
+
+	return res
+}
+
+// unmarshalBatchUpdateEntryRequestBodyToDummyBatchUpdateEntry builds a value
+// of type *dummy.BatchUpdateEntry from a value of type
+// *BatchUpdateEntryRequestBody.
+func unmarshalBatchUpdateEntryRequestBodyToDummyBatchUpdateEntry(v *BatchUpdateEntryRequestBody) *dummy.BatchUpdateEntry {
+	res := &dummy.BatchUpdateEntry{
+		ID:          *v.ID,
+		Version:     *v.Version,
+		Name:        v.Name,
+		Description: v.Description,
+	}
+
+	return res
+}
+
+// marshalDummyImportRowErrorToImportRowErrorResponseBody builds a value of
+// type *ImportRowErrorResponseBody from a value of type *dummy.ImportRowError.
+func marshalDummyImportRowErrorToImportRowErrorResponseBody(v *dummy.ImportRowError) *ImportRowErrorResponseBody {
+	res := &ImportRowErrorResponseBody{
+		Line:    v.Line,
+		Message: v.Message,
 	}
 
 	return res
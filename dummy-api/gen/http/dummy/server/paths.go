@@ -21,12 +21,117 @@ func ListItemsDummyPath() string {
 	return "/v1/dummy/items"
 }
 
+// SearchItemsDummyPath returns the URL path to the dummy service search_items HTTP endpoint.
+func SearchItemsDummyPath() string {
+	return "/v1/dummy/items/search"
+}
+
 // GetItemDummyPath returns the URL path to the dummy service get_item HTTP endpoint.
 func GetItemDummyPath(id string) string {
 	return fmt.Sprintf("/v1/dummy/items/%v", id)
 }
 
+// UpdateItemDummyPath returns the URL path to the dummy service update_item HTTP endpoint.
+func UpdateItemDummyPath(id string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v", id)
+}
+
+// PatchItemDummyPath returns the URL path to the dummy service patch_item HTTP endpoint.
+func PatchItemDummyPath(id string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v", id)
+}
+
 // DeleteItemDummyPath returns the URL path to the dummy service delete_item HTTP endpoint.
 func DeleteItemDummyPath(id string) string {
 	return fmt.Sprintf("/v1/dummy/items/%v", id)
 }
+
+// ShareItemDummyPath returns the URL path to the dummy service share_item HTTP endpoint.
+func ShareItemDummyPath(id string, userID string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/shares/%v", id, userID)
+}
+
+// UnshareItemDummyPath returns the URL path to the dummy service unshare_item HTTP endpoint.
+func UnshareItemDummyPath(id string, userID string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/shares/%v", id, userID)
+}
+
+// ListItemSharesDummyPath returns the URL path to the dummy service list_item_shares HTTP endpoint.
+func ListItemSharesDummyPath(id string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/shares", id)
+}
+
+// AddItemTagDummyPath returns the URL path to the dummy service add_item_tag HTTP endpoint.
+func AddItemTagDummyPath(id string, tag string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/tags/%v", id, tag)
+}
+
+// RemoveItemTagDummyPath returns the URL path to the dummy service remove_item_tag HTTP endpoint.
+func RemoveItemTagDummyPath(id string, tag string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/tags/%v", id, tag)
+}
+
+// SuggestTagsDummyPath returns the URL path to the dummy service suggest_tags HTTP endpoint.
+func SuggestTagsDummyPath() string {
+	return "/v1/dummy/tags"
+}
+
+// UploadAttachmentDummyPath returns the URL path to the dummy service upload_attachment HTTP endpoint.
+func UploadAttachmentDummyPath(id string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/attachments", id)
+}
+
+// DownloadAttachmentDummyPath returns the URL path to the dummy service download_attachment HTTP endpoint.
+func DownloadAttachmentDummyPath(id string, attachmentID string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/attachments/%v", id, attachmentID)
+}
+
+// ListAttachmentsDummyPath returns the URL path to the dummy service list_attachments HTTP endpoint.
+func ListAttachmentsDummyPath(id string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/attachments", id)
+}
+
+// DeleteAttachmentDummyPath returns the URL path to the dummy service delete_attachment HTTP endpoint.
+func DeleteAttachmentDummyPath(id string, attachmentID string) string {
+	return fmt.Sprintf("/v1/dummy/items/%v/attachments/%v", id, attachmentID)
+}
+
+// BatchCreateItemsDummyPath returns the URL path to the dummy service batch_create_items HTTP endpoint.
+func BatchCreateItemsDummyPath() string {
+	return "/v1/dummy/items/batch/create"
+}
+
+// BatchUpdateItemsDummyPath returns the URL path to the dummy service batch_update_items HTTP endpoint.
+func BatchUpdateItemsDummyPath() string {
+	return "/v1/dummy/items/batch/update"
+}
+
+// BatchDeleteItemsDummyPath returns the URL path to the dummy service batch_delete_items HTTP endpoint.
+func BatchDeleteItemsDummyPath() string {
+	return "/v1/dummy/items/batch/delete"
+}
+
+// ExportItemsDummyPath returns the URL path to the dummy service export_items HTTP endpoint.
+func ExportItemsDummyPath() string {
+	return "/v1/dummy/items/export"
+}
+
+// ImportItemsFileDummyPath returns the URL path to the dummy service import_items_file HTTP endpoint.
+func ImportItemsFileDummyPath() string {
+	return "/v1/dummy/items/import"
+}
+
+// ListTrashDummyPath returns the URL path to the dummy service list_trash HTTP endpoint.
+func ListTrashDummyPath() string {
+	return "/v1/dummy/trash"
+}
+
+// RestoreItemDummyPath returns the URL path to the dummy service restore_item HTTP endpoint.
+func RestoreItemDummyPath(id string) string {
+	return fmt.Sprintf("/v1/dummy/trash/%v/restore", id)
+}
+
+// PurgeItemDummyPath returns the URL path to the dummy service purge_item HTTP endpoint.
+func PurgeItemDummyPath(id string) string {
+	return fmt.Sprintf("/v1/dummy/trash/%v", id)
+}
@@ -8,7 +8,9 @@
 package server
 
 import (
+	"bufio"
 	"context"
+	"io"
 	"net/http"
 	"path"
 
@@ -22,8 +24,29 @@ type Server struct {
 	Mounts             []*MountPoint
 	CreateItem         http.Handler
 	ListItems          http.Handler
+	SearchItems        http.Handler
 	GetItem            http.Handler
+	UpdateItem         http.Handler
+	PatchItem          http.Handler
 	DeleteItem         http.Handler
+	ShareItem          http.Handler
+	UnshareItem        http.Handler
+	ListItemShares     http.Handler
+	AddItemTag         http.Handler
+	RemoveItemTag      http.Handler
+	SuggestTags        http.Handler
+	UploadAttachment   http.Handler
+	DownloadAttachment http.Handler
+	ListAttachments    http.Handler
+	DeleteAttachment   http.Handler
+	BatchCreateItems   http.Handler
+	BatchUpdateItems   http.Handler
+	BatchDeleteItems   http.Handler
+	ExportItems        http.Handler
+	ImportItemsFile    http.Handler
+	ListTrash          http.Handler
+	RestoreItem        http.Handler
+	PurgeItem          http.Handler
 	GenHTTPOpenapiJSON http.Handler
 }
 
@@ -61,14 +84,56 @@ func New(
 		Mounts: []*MountPoint{
 			{"CreateItem", "POST", "/v1/dummy/items"},
 			{"ListItems", "GET", "/v1/dummy/items"},
+			{"SearchItems", "GET", "/v1/dummy/items/search"},
 			{"GetItem", "GET", "/v1/dummy/items/{id}"},
+			{"UpdateItem", "PUT", "/v1/dummy/items/{id}"},
+			{"PatchItem", "PATCH", "/v1/dummy/items/{id}"},
 			{"DeleteItem", "DELETE", "/v1/dummy/items/{id}"},
+			{"ShareItem", "PUT", "/v1/dummy/items/{id}/shares/{user_id}"},
+			{"UnshareItem", "DELETE", "/v1/dummy/items/{id}/shares/{user_id}"},
+			{"ListItemShares", "GET", "/v1/dummy/items/{id}/shares"},
+			{"AddItemTag", "PUT", "/v1/dummy/items/{id}/tags/{tag}"},
+			{"RemoveItemTag", "DELETE", "/v1/dummy/items/{id}/tags/{tag}"},
+			{"SuggestTags", "GET", "/v1/dummy/tags"},
+			{"UploadAttachment", "POST", "/v1/dummy/items/{id}/attachments"},
+			{"DownloadAttachment", "GET", "/v1/dummy/items/{id}/attachments/{attachment_id}"},
+			{"ListAttachments", "GET", "/v1/dummy/items/{id}/attachments"},
+			{"DeleteAttachment", "DELETE", "/v1/dummy/items/{id}/attachments/{attachment_id}"},
+			{"BatchCreateItems", "POST", "/v1/dummy/items/batch/create"},
+			{"BatchUpdateItems", "POST", "/v1/dummy/items/batch/update"},
+			{"BatchDeleteItems", "POST", "/v1/dummy/items/batch/delete"},
+			{"ExportItems", "GET", "/v1/dummy/items/export"},
+			{"ImportItemsFile", "POST", "/v1/dummy/items/import"},
+			{"ListTrash", "GET", "/v1/dummy/trash"},
+			{"RestoreItem", "POST", "/v1/dummy/trash/{id}/restore"},
+			{"PurgeItem", "DELETE", "/v1/dummy/trash/{id}"},
 			{"Serve gen/http/openapi.json", "GET", "/openapi.json"},
 		},
 		CreateItem:         NewCreateItemHandler(e.CreateItem, mux, decoder, encoder, errhandler, formatter),
 		ListItems:          NewListItemsHandler(e.ListItems, mux, decoder, encoder, errhandler, formatter),
+		SearchItems:        NewSearchItemsHandler(e.SearchItems, mux, decoder, encoder, errhandler, formatter),
 		GetItem:            NewGetItemHandler(e.GetItem, mux, decoder, encoder, errhandler, formatter),
+		UpdateItem:         NewUpdateItemHandler(e.UpdateItem, mux, decoder, encoder, errhandler, formatter),
+		PatchItem:          NewPatchItemHandler(e.PatchItem, mux, decoder, encoder, errhandler, formatter),
 		DeleteItem:         NewDeleteItemHandler(e.DeleteItem, mux, decoder, encoder, errhandler, formatter),
+		ShareItem:          NewShareItemHandler(e.ShareItem, mux, decoder, encoder, errhandler, formatter),
+		UnshareItem:        NewUnshareItemHandler(e.UnshareItem, mux, decoder, encoder, errhandler, formatter),
+		ListItemShares:     NewListItemSharesHandler(e.ListItemShares, mux, decoder, encoder, errhandler, formatter),
+		AddItemTag:         NewAddItemTagHandler(e.AddItemTag, mux, decoder, encoder, errhandler, formatter),
+		RemoveItemTag:      NewRemoveItemTagHandler(e.RemoveItemTag, mux, decoder, encoder, errhandler, formatter),
+		SuggestTags:        NewSuggestTagsHandler(e.SuggestTags, mux, decoder, encoder, errhandler, formatter),
+		UploadAttachment:   NewUploadAttachmentHandler(e.UploadAttachment, mux, decoder, encoder, errhandler, formatter),
+		DownloadAttachment: NewDownloadAttachmentHandler(e.DownloadAttachment, mux, decoder, encoder, errhandler, formatter),
+		ListAttachments:    NewListAttachmentsHandler(e.ListAttachments, mux, decoder, encoder, errhandler, formatter),
+		DeleteAttachment:   NewDeleteAttachmentHandler(e.DeleteAttachment, mux, decoder, encoder, errhandler, formatter),
+		BatchCreateItems:   NewBatchCreateItemsHandler(e.BatchCreateItems, mux, decoder, encoder, errhandler, formatter),
+		BatchUpdateItems:   NewBatchUpdateItemsHandler(e.BatchUpdateItems, mux, decoder, encoder, errhandler, formatter),
+		BatchDeleteItems:   NewBatchDeleteItemsHandler(e.BatchDeleteItems, mux, decoder, encoder, errhandler, formatter),
+		ExportItems:        NewExportItemsHandler(e.ExportItems, mux, decoder, encoder, errhandler, formatter),
+		ImportItemsFile:    NewImportItemsFileHandler(e.ImportItemsFile, mux, decoder, encoder, errhandler, formatter),
+		ListTrash:          NewListTrashHandler(e.ListTrash, mux, decoder, encoder, errhandler, formatter),
+		RestoreItem:        NewRestoreItemHandler(e.RestoreItem, mux, decoder, encoder, errhandler, formatter),
+		PurgeItem:          NewPurgeItemHandler(e.PurgeItem, mux, decoder, encoder, errhandler, formatter),
 		GenHTTPOpenapiJSON: http.FileServer(fileSystemGenHTTPOpenapiJSON),
 	}
 }
@@ -80,8 +145,29 @@ func (s *Server) Service() string { return "dummy" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.CreateItem = m(s.CreateItem)
 	s.ListItems = m(s.ListItems)
+	s.SearchItems = m(s.SearchItems)
 	s.GetItem = m(s.GetItem)
+	s.UpdateItem = m(s.UpdateItem)
+	s.PatchItem = m(s.PatchItem)
 	s.DeleteItem = m(s.DeleteItem)
+	s.ShareItem = m(s.ShareItem)
+	s.UnshareItem = m(s.UnshareItem)
+	s.ListItemShares = m(s.ListItemShares)
+	s.AddItemTag = m(s.AddItemTag)
+	s.RemoveItemTag = m(s.RemoveItemTag)
+	s.SuggestTags = m(s.SuggestTags)
+	s.UploadAttachment = m(s.UploadAttachment)
+	s.DownloadAttachment = m(s.DownloadAttachment)
+	s.ListAttachments = m(s.ListAttachments)
+	s.DeleteAttachment = m(s.DeleteAttachment)
+	s.BatchCreateItems = m(s.BatchCreateItems)
+	s.BatchUpdateItems = m(s.BatchUpdateItems)
+	s.BatchDeleteItems = m(s.BatchDeleteItems)
+	s.ExportItems = m(s.ExportItems)
+	s.ImportItemsFile = m(s.ImportItemsFile)
+	s.ListTrash = m(s.ListTrash)
+	s.RestoreItem = m(s.RestoreItem)
+	s.PurgeItem = m(s.PurgeItem)
 }
 
 // MethodNames returns the methods served.
@@ -91,8 +177,29 @@ func (s *Server) MethodNames() []string { return dummy.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountCreateItemHandler(mux, h.CreateItem)
 	MountListItemsHandler(mux, h.ListItems)
+	MountSearchItemsHandler(mux, h.SearchItems)
 	MountGetItemHandler(mux, h.GetItem)
+	MountUpdateItemHandler(mux, h.UpdateItem)
+	MountPatchItemHandler(mux, h.PatchItem)
 	MountDeleteItemHandler(mux, h.DeleteItem)
+	MountShareItemHandler(mux, h.ShareItem)
+	MountUnshareItemHandler(mux, h.UnshareItem)
+	MountListItemSharesHandler(mux, h.ListItemShares)
+	MountAddItemTagHandler(mux, h.AddItemTag)
+	MountRemoveItemTagHandler(mux, h.RemoveItemTag)
+	MountSuggestTagsHandler(mux, h.SuggestTags)
+	MountUploadAttachmentHandler(mux, h.UploadAttachment)
+	MountDownloadAttachmentHandler(mux, h.DownloadAttachment)
+	MountListAttachmentsHandler(mux, h.ListAttachments)
+	MountDeleteAttachmentHandler(mux, h.DeleteAttachment)
+	MountBatchCreateItemsHandler(mux, h.BatchCreateItems)
+	MountBatchUpdateItemsHandler(mux, h.BatchUpdateItems)
+	MountBatchDeleteItemsHandler(mux, h.BatchDeleteItems)
+	MountExportItemsHandler(mux, h.ExportItems)
+	MountImportItemsFileHandler(mux, h.ImportItemsFile)
+	MountListTrashHandler(mux, h.ListTrash)
+	MountRestoreItemHandler(mux, h.RestoreItem)
+	MountPurgeItemHandler(mux, h.PurgeItem)
 	MountGenHTTPOpenapiJSON(mux, h.GenHTTPOpenapiJSON)
 }
 
@@ -179,7 +286,7 @@ func NewListItemsHandler(
 	var (
 		decodeRequest  = DecodeListItemsRequest(mux, decoder)
 		encodeResponse = EncodeListItemsResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeListItemsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -207,6 +314,59 @@ func NewListItemsHandler(
 	})
 }
 
+// MountSearchItemsHandler configures the mux to serve the "dummy" service
+// "search_items" endpoint.
+func MountSearchItemsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/dummy/items/search", f)
+}
+
+// NewSearchItemsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "dummy" service "search_items" endpoint.
+func NewSearchItemsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSearchItemsRequest(mux, decoder)
+		encodeResponse = EncodeSearchItemsResponse(encoder)
+		encodeError    = EncodeSearchItemsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "search_items")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountGetItemHandler configures the mux to serve the "dummy" service
 // "get_item" endpoint.
 func MountGetItemHandler(mux goahttp.Muxer, h http.Handler) {
@@ -232,7 +392,7 @@ func NewGetItemHandler(
 	var (
 		decodeRequest  = DecodeGetItemRequest(mux, decoder)
 		encodeResponse = EncodeGetItemResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeGetItemError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -260,6 +420,112 @@ func NewGetItemHandler(
 	})
 }
 
+// MountUpdateItemHandler configures the mux to serve the "dummy" service
+// "update_item" endpoint.
+func MountUpdateItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/dummy/items/{id}", f)
+}
+
+// NewUpdateItemHandler creates a HTTP handler which loads the HTTP request and
+// calls the "dummy" service "update_item" endpoint.
+func NewUpdateItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateItemRequest(mux, decoder)
+		encodeResponse = EncodeUpdateItemResponse(encoder)
+		encodeError    = EncodeUpdateItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "update_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPatchItemHandler configures the mux to serve the "dummy" service
+// "patch_item" endpoint.
+func MountPatchItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PATCH", "/v1/dummy/items/{id}", f)
+}
+
+// NewPatchItemHandler creates a HTTP handler which loads the HTTP request and
+// calls the "dummy" service "patch_item" endpoint.
+func NewPatchItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePatchItemRequest(mux, decoder)
+		encodeResponse = EncodePatchItemResponse(encoder)
+		encodeError    = EncodePatchItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "patch_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountDeleteItemHandler configures the mux to serve the "dummy" service
 // "delete_item" endpoint.
 func MountDeleteItemHandler(mux goahttp.Muxer, h http.Handler) {
@@ -285,7 +551,7 @@ func NewDeleteItemHandler(
 	var (
 		decodeRequest  = DecodeDeleteItemRequest(mux, decoder)
 		encodeResponse = EncodeDeleteItemResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeDeleteItemError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -313,6 +579,1032 @@ func NewDeleteItemHandler(
 	})
 }
 
+// MountShareItemHandler configures the mux to serve the "dummy" service
+// "share_item" endpoint.
+func MountShareItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/dummy/items/{id}/shares/{user_id}", f)
+}
+
+// NewShareItemHandler creates a HTTP handler which loads the HTTP request and
+// calls the "dummy" service "share_item" endpoint.
+func NewShareItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeShareItemRequest(mux, decoder)
+		encodeResponse = EncodeShareItemResponse(encoder)
+		encodeError    = EncodeShareItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "share_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUnshareItemHandler configures the mux to serve the "dummy" service
+// "unshare_item" endpoint.
+func MountUnshareItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/dummy/items/{id}/shares/{user_id}", f)
+}
+
+// NewUnshareItemHandler creates a HTTP handler which loads the HTTP request
+// and calls the "dummy" service "unshare_item" endpoint.
+func NewUnshareItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUnshareItemRequest(mux, decoder)
+		encodeResponse = EncodeUnshareItemResponse(encoder)
+		encodeError    = EncodeUnshareItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "unshare_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListItemSharesHandler configures the mux to serve the "dummy" service
+// "list_item_shares" endpoint.
+func MountListItemSharesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/dummy/items/{id}/shares", f)
+}
+
+// NewListItemSharesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "dummy" service "list_item_shares" endpoint.
+func NewListItemSharesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListItemSharesRequest(mux, decoder)
+		encodeResponse = EncodeListItemSharesResponse(encoder)
+		encodeError    = EncodeListItemSharesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_item_shares")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAddItemTagHandler configures the mux to serve the "dummy" service
+// "add_item_tag" endpoint.
+func MountAddItemTagHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/dummy/items/{id}/tags/{tag}", f)
+}
+
+// NewAddItemTagHandler creates a HTTP handler which loads the HTTP request and
+// calls the "dummy" service "add_item_tag" endpoint.
+func NewAddItemTagHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddItemTagRequest(mux, decoder)
+		encodeResponse = EncodeAddItemTagResponse(encoder)
+		encodeError    = EncodeAddItemTagError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "add_item_tag")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRemoveItemTagHandler configures the mux to serve the "dummy" service
+// "remove_item_tag" endpoint.
+func MountRemoveItemTagHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/dummy/items/{id}/tags/{tag}", f)
+}
+
+// NewRemoveItemTagHandler creates a HTTP handler which loads the HTTP request
+// and calls the "dummy" service "remove_item_tag" endpoint.
+func NewRemoveItemTagHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRemoveItemTagRequest(mux, decoder)
+		encodeResponse = EncodeRemoveItemTagResponse(encoder)
+		encodeError    = EncodeRemoveItemTagError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "remove_item_tag")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSuggestTagsHandler configures the mux to serve the "dummy" service
+// "suggest_tags" endpoint.
+func MountSuggestTagsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/dummy/tags", f)
+}
+
+// NewSuggestTagsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "dummy" service "suggest_tags" endpoint.
+func NewSuggestTagsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSuggestTagsRequest(mux, decoder)
+		encodeResponse = EncodeSuggestTagsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "suggest_tags")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountUploadAttachmentHandler configures the mux to serve the "dummy" service
+// "upload_attachment" endpoint.
+func MountUploadAttachmentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/dummy/items/{id}/attachments", f)
+}
+
+// NewUploadAttachmentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "dummy" service "upload_attachment" endpoint.
+func NewUploadAttachmentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUploadAttachmentRequest(mux, decoder)
+		encodeResponse = EncodeUploadAttachmentResponse(encoder)
+		encodeError    = EncodeUploadAttachmentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "upload_attachment")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &dummy.UploadAttachmentRequestData{Payload: payload, Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDownloadAttachmentHandler configures the mux to serve the "dummy"
+// service "download_attachment" endpoint.
+func MountDownloadAttachmentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/dummy/items/{id}/attachments/{attachment_id}", f)
+}
+
+// NewDownloadAttachmentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "dummy" service "download_attachment" endpoint.
+func NewDownloadAttachmentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDownloadAttachmentRequest(mux, decoder)
+		encodeResponse = EncodeDownloadAttachmentResponse(encoder)
+		encodeError    = EncodeDownloadAttachmentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "download_attachment")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*dummy.DownloadAttachmentResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountListAttachmentsHandler configures the mux to serve the "dummy" service
+// "list_attachments" endpoint.
+func MountListAttachmentsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/dummy/items/{id}/attachments", f)
+}
+
+// NewListAttachmentsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "dummy" service "list_attachments" endpoint.
+func NewListAttachmentsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAttachmentsRequest(mux, decoder)
+		encodeResponse = EncodeListAttachmentsResponse(encoder)
+		encodeError    = EncodeListAttachmentsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_attachments")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteAttachmentHandler configures the mux to serve the "dummy" service
+// "delete_attachment" endpoint.
+func MountDeleteAttachmentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/dummy/items/{id}/attachments/{attachment_id}", f)
+}
+
+// NewDeleteAttachmentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "dummy" service "delete_attachment" endpoint.
+func NewDeleteAttachmentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteAttachmentRequest(mux, decoder)
+		encodeResponse = EncodeDeleteAttachmentResponse(encoder)
+		encodeError    = EncodeDeleteAttachmentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_attachment")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBatchCreateItemsHandler configures the mux to serve the "dummy" service
+// "batch_create_items" endpoint.
+func MountBatchCreateItemsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/dummy/items/batch/create", f)
+}
+
+// NewBatchCreateItemsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "dummy" service "batch_create_items" endpoint.
+func NewBatchCreateItemsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBatchCreateItemsRequest(mux, decoder)
+		encodeResponse = EncodeBatchCreateItemsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "batch_create_items")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBatchUpdateItemsHandler configures the mux to serve the "dummy" service
+// "batch_update_items" endpoint.
+func MountBatchUpdateItemsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/dummy/items/batch/update", f)
+}
+
+// NewBatchUpdateItemsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "dummy" service "batch_update_items" endpoint.
+func NewBatchUpdateItemsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBatchUpdateItemsRequest(mux, decoder)
+		encodeResponse = EncodeBatchUpdateItemsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "batch_update_items")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountBatchDeleteItemsHandler configures the mux to serve the "dummy" service
+// "batch_delete_items" endpoint.
+func MountBatchDeleteItemsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/dummy/items/batch/delete", f)
+}
+
+// NewBatchDeleteItemsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "dummy" service "batch_delete_items" endpoint.
+func NewBatchDeleteItemsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeBatchDeleteItemsRequest(mux, decoder)
+		encodeResponse = EncodeBatchDeleteItemsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "batch_delete_items")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountExportItemsHandler configures the mux to serve the "dummy" service
+// "export_items" endpoint.
+func MountExportItemsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/dummy/items/export", f)
+}
+
+// NewExportItemsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "dummy" service "export_items" endpoint.
+func NewExportItemsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExportItemsRequest(mux, decoder)
+		encodeResponse = EncodeExportItemsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "export_items")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		o := res.(*dummy.ExportItemsResponseData)
+		defer o.Body.Close()
+		if wt, ok := o.Body.(io.WriterTo); ok {
+			if err := encodeResponse(ctx, w, o.Result); err != nil {
+				if errhandler != nil {
+					errhandler(ctx, w, err)
+				}
+				return
+			}
+			n, err := wt.WriteTo(w)
+			if err != nil {
+				if n == 0 {
+					if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+						errhandler(ctx, w, err)
+					}
+				} else {
+					http.NewResponseController(w).Flush()
+					panic(http.ErrAbortHandler) // too late to write an error
+				}
+			}
+			return
+		}
+		// handle immediate read error like a returned error
+		buf := bufio.NewReader(o.Body)
+		if _, err := buf.Peek(1); err != nil && err != io.EOF {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, o.Result); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if _, err := io.Copy(w, buf); err != nil {
+			http.NewResponseController(w).Flush()
+			panic(http.ErrAbortHandler) // too late to write an error
+		}
+	})
+}
+
+// MountImportItemsFileHandler configures the mux to serve the "dummy" service
+// "import_items_file" endpoint.
+func MountImportItemsFileHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/dummy/items/import", f)
+}
+
+// NewImportItemsFileHandler creates a HTTP handler which loads the HTTP
+// request and calls the "dummy" service "import_items_file" endpoint.
+func NewImportItemsFileHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportItemsFileRequest(mux, decoder)
+		encodeResponse = EncodeImportItemsFileResponse(encoder)
+		encodeError    = EncodeImportItemsFileError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "import_items_file")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		data := &dummy.ImportItemsFileRequestData{Payload: payload, Body: r.Body}
+		res, err := endpoint(ctx, data)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListTrashHandler configures the mux to serve the "dummy" service
+// "list_trash" endpoint.
+func MountListTrashHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/dummy/trash", f)
+}
+
+// NewListTrashHandler creates a HTTP handler which loads the HTTP request and
+// calls the "dummy" service "list_trash" endpoint.
+func NewListTrashHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListTrashRequest(mux, decoder)
+		encodeResponse = EncodeListTrashResponse(encoder)
+		encodeError    = EncodeListTrashError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_trash")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRestoreItemHandler configures the mux to serve the "dummy" service
+// "restore_item" endpoint.
+func MountRestoreItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/dummy/trash/{id}/restore", f)
+}
+
+// NewRestoreItemHandler creates a HTTP handler which loads the HTTP request
+// and calls the "dummy" service "restore_item" endpoint.
+func NewRestoreItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRestoreItemRequest(mux, decoder)
+		encodeResponse = EncodeRestoreItemResponse(encoder)
+		encodeError    = EncodeRestoreItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "restore_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountPurgeItemHandler configures the mux to serve the "dummy" service
+// "purge_item" endpoint.
+func MountPurgeItemHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/dummy/trash/{id}", f)
+}
+
+// NewPurgeItemHandler creates a HTTP handler which loads the HTTP request and
+// calls the "dummy" service "purge_item" endpoint.
+func NewPurgeItemHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodePurgeItemRequest(mux, decoder)
+		encodeResponse = EncodePurgeItemResponse(encoder)
+		encodeError    = EncodePurgeItemError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "purge_item")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "dummy")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // appendFS is a custom implementation of fs.FS that appends a specified prefix
 // to the file paths before delegating the Open call to the underlying fs.FS.
 type appendFS struct {
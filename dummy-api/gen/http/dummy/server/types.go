@@ -8,6 +8,8 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	dummy "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	dummyviews "github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy/views"
 	goa "goa.design/goa/v3/pkg"
@@ -18,6 +20,36 @@ import (
 type CreateItemRequestBody struct {
 	Name        *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Language used to stem the item for search; defaults to DUMMY_SEARCH_LANGUAGE
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+}
+
+// BatchCreateItemsRequestBody is the type of the "dummy" service
+// "batch_create_items" endpoint HTTP request body.
+type BatchCreateItemsRequestBody struct {
+	Items []*BatchItemEntryRequestBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty" xml:"atomic,omitempty"`
+}
+
+// BatchUpdateItemsRequestBody is the type of the "dummy" service
+// "batch_update_items" endpoint HTTP request body.
+type BatchUpdateItemsRequestBody struct {
+	Items []*BatchUpdateEntryRequestBody `form:"items,omitempty" json:"items,omitempty" xml:"items,omitempty"`
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty" xml:"atomic,omitempty"`
+}
+
+// BatchDeleteItemsRequestBody is the type of the "dummy" service
+// "batch_delete_items" endpoint HTTP request body.
+type BatchDeleteItemsRequestBody struct {
+	// Items to move to the trash
+	Ids []string `form:"ids,omitempty" json:"ids,omitempty" xml:"ids,omitempty"`
+	// Apply all entries in one transaction, or none if any fails; when false each
+	// entry succeeds or fails on its own
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty" xml:"atomic,omitempty"`
 }
 
 // CreateItemResponseBody is the type of the "dummy" service "create_item"
@@ -29,12 +61,36 @@ type CreateItemResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	OwnerID     string  `form:"owner_id" json:"owner_id" xml:"owner_id"`
 	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	// Incremented by every update; send it back to update the item
+	Version   int    `form:"version" json:"version" xml:"version"`
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Text search configuration used to index the item
+	Language string `form:"language" json:"language" xml:"language"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
 }
 
 // ListItemsResponseBody is the type of the "dummy" service "list_items"
 // endpoint HTTP response body.
 type ListItemsResponseBody struct {
 	Items []*ItemResponseBody `form:"items" json:"items" xml:"items"`
+	// Cursor of the next page; absent on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+	// Number of items matching the filters, when include_total is set
+	Total *int64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// SearchItemsResponseBody is the type of the "dummy" service "search_items"
+// endpoint HTTP response body.
+type SearchItemsResponseBody struct {
+	// Results ordered by relevance
+	Results []*SearchResultResponseBody `form:"results" json:"results" xml:"results"`
+	// Cursor of the next page; absent on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
 }
 
 // GetItemResponseBody is the type of the "dummy" service "get_item" endpoint
@@ -46,6 +102,440 @@ type GetItemResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	OwnerID     string  `form:"owner_id" json:"owner_id" xml:"owner_id"`
 	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	// Incremented by every update; send it back to update the item
+	Version   int    `form:"version" json:"version" xml:"version"`
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Text search configuration used to index the item
+	Language string `form:"language" json:"language" xml:"language"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+}
+
+// UpdateItemResponseBody is the type of the "dummy" service "update_item"
+// endpoint HTTP response body.
+type UpdateItemResponseBody struct {
+	// Item identifier
+	ID          string  `form:"id" json:"id" xml:"id"`
+	Name        string  `form:"name" json:"name" xml:"name"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     string  `form:"owner_id" json:"owner_id" xml:"owner_id"`
+	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	// Incremented by every update; send it back to update the item
+	Version   int    `form:"version" json:"version" xml:"version"`
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Text search configuration used to index the item
+	Language string `form:"language" json:"language" xml:"language"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+}
+
+// PatchItemResponseBody is the type of the "dummy" service "patch_item"
+// endpoint HTTP response body.
+type PatchItemResponseBody struct {
+	// Item identifier
+	ID          string  `form:"id" json:"id" xml:"id"`
+	Name        string  `form:"name" json:"name" xml:"name"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     string  `form:"owner_id" json:"owner_id" xml:"owner_id"`
+	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	// Incremented by every update; send it back to update the item
+	Version   int    `form:"version" json:"version" xml:"version"`
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Text search configuration used to index the item
+	Language string `form:"language" json:"language" xml:"language"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+}
+
+// ShareItemResponseBody is the type of the "dummy" service "share_item"
+// endpoint HTTP response body.
+type ShareItemResponseBody struct {
+	ItemID string `form:"item_id" json:"item_id" xml:"item_id"`
+	// User the item is shared with
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// viewer can read the item, editor can also update it
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	CreatedAt  string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListItemSharesResponseBody is the type of the "dummy" service
+// "list_item_shares" endpoint HTTP response body.
+type ListItemSharesResponseBody struct {
+	Shares []*ItemShareResponseBody `form:"shares" json:"shares" xml:"shares"`
+}
+
+// AddItemTagResponseBody is the type of the "dummy" service "add_item_tag"
+// endpoint HTTP response body.
+type AddItemTagResponseBody struct {
+	// Item identifier
+	ID          string  `form:"id" json:"id" xml:"id"`
+	Name        string  `form:"name" json:"name" xml:"name"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     string  `form:"owner_id" json:"owner_id" xml:"owner_id"`
+	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	// Incremented by every update; send it back to update the item
+	Version   int    `form:"version" json:"version" xml:"version"`
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Text search configuration used to index the item
+	Language string `form:"language" json:"language" xml:"language"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+}
+
+// RemoveItemTagResponseBody is the type of the "dummy" service
+// "remove_item_tag" endpoint HTTP response body.
+type RemoveItemTagResponseBody struct {
+	// Item identifier
+	ID          string  `form:"id" json:"id" xml:"id"`
+	Name        string  `form:"name" json:"name" xml:"name"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     string  `form:"owner_id" json:"owner_id" xml:"owner_id"`
+	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	// Incremented by every update; send it back to update the item
+	Version   int    `form:"version" json:"version" xml:"version"`
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Text search configuration used to index the item
+	Language string `form:"language" json:"language" xml:"language"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+}
+
+// SuggestTagsResponseBody is the type of the "dummy" service "suggest_tags"
+// endpoint HTTP response body.
+type SuggestTagsResponseBody struct {
+	Tags []*TagSuggestionResponseBody `form:"tags" json:"tags" xml:"tags"`
+}
+
+// UploadAttachmentResponseBody is the type of the "dummy" service
+// "upload_attachment" endpoint HTTP response body.
+type UploadAttachmentResponseBody struct {
+	ID       string `form:"id" json:"id" xml:"id"`
+	ItemID   string `form:"item_id" json:"item_id" xml:"item_id"`
+	Filename string `form:"filename" json:"filename" xml:"filename"`
+	// Media type sniffed from the content
+	ContentType string `form:"content_type" json:"content_type" xml:"content_type"`
+	// Size in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// Hex-encoded SHA-256 of the content
+	ChecksumSha256 string `form:"checksum_sha256" json:"checksum_sha256" xml:"checksum_sha256"`
+	CreatedAt      string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListAttachmentsResponseBody is the type of the "dummy" service
+// "list_attachments" endpoint HTTP response body.
+type ListAttachmentsResponseBody struct {
+	Attachments []*AttachmentResponseBody `form:"attachments" json:"attachments" xml:"attachments"`
+}
+
+// BatchCreateItemsResponseBody is the type of the "dummy" service
+// "batch_create_items" endpoint HTTP response body.
+type BatchCreateItemsResponseBody struct {
+	// One result per entry, in request order
+	Results   []*BatchEntryResultResponseBody `form:"results" json:"results" xml:"results"`
+	Succeeded int                             `form:"succeeded" json:"succeeded" xml:"succeeded"`
+	Failed    int                             `form:"failed" json:"failed" xml:"failed"`
+}
+
+// BatchUpdateItemsResponseBody is the type of the "dummy" service
+// "batch_update_items" endpoint HTTP response body.
+type BatchUpdateItemsResponseBody struct {
+	// One result per entry, in request order
+	Results   []*BatchEntryResultResponseBody `form:"results" json:"results" xml:"results"`
+	Succeeded int                             `form:"succeeded" json:"succeeded" xml:"succeeded"`
+	Failed    int                             `form:"failed" json:"failed" xml:"failed"`
+}
+
+// BatchDeleteItemsResponseBody is the type of the "dummy" service
+// "batch_delete_items" endpoint HTTP response body.
+type BatchDeleteItemsResponseBody struct {
+	// One result per entry, in request order
+	Results   []*BatchEntryResultResponseBody `form:"results" json:"results" xml:"results"`
+	Succeeded int                             `form:"succeeded" json:"succeeded" xml:"succeeded"`
+	Failed    int                             `form:"failed" json:"failed" xml:"failed"`
+}
+
+// ImportItemsFileResponseBody is the type of the "dummy" service
+// "import_items_file" endpoint HTTP response body.
+type ImportItemsFileResponseBody struct {
+	DryRun bool `form:"dry_run" json:"dry_run" xml:"dry_run"`
+	// Rows read from the file
+	Rows int `form:"rows" json:"rows" xml:"rows"`
+	// Rows rejected by validation
+	Failed int `form:"failed" json:"failed" xml:"failed"`
+	// Items created; zero for dry runs and files with rejected rows
+	Imported int `form:"imported" json:"imported" xml:"imported"`
+	// The first rejected rows
+	Errors []*ImportRowErrorResponseBody `form:"errors" json:"errors" xml:"errors"`
+}
+
+// ListTrashResponseBody is the type of the "dummy" service "list_trash"
+// endpoint HTTP response body.
+type ListTrashResponseBody struct {
+	Items []*ItemResponseBody `form:"items" json:"items" xml:"items"`
+	// Cursor of the next page; absent on the last page
+	NextCursor *string `form:"next_cursor,omitempty" json:"next_cursor,omitempty" xml:"next_cursor,omitempty"`
+	// Number of items matching the filters, when include_total is set
+	Total *int64 `form:"total,omitempty" json:"total,omitempty" xml:"total,omitempty"`
+}
+
+// RestoreItemResponseBody is the type of the "dummy" service "restore_item"
+// endpoint HTTP response body.
+type RestoreItemResponseBody struct {
+	// Item identifier
+	ID          string  `form:"id" json:"id" xml:"id"`
+	Name        string  `form:"name" json:"name" xml:"name"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	OwnerID     string  `form:"owner_id" json:"owner_id" xml:"owner_id"`
+	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	// Incremented by every update; send it back to update the item
+	Version   int    `form:"version" json:"version" xml:"version"`
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Text search configuration used to index the item
+	Language string `form:"language" json:"language" xml:"language"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+}
+
+// ListItemsInvalidCursorResponseBody is the type of the "dummy" service
+// "list_items" endpoint HTTP response body for the "invalid_cursor" error.
+type ListItemsInvalidCursorResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SearchItemsInvalidCursorResponseBody is the type of the "dummy" service
+// "search_items" endpoint HTTP response body for the "invalid_cursor" error.
+type SearchItemsInvalidCursorResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetItemNotFoundResponseBody is the type of the "dummy" service "get_item"
+// endpoint HTTP response body for the "not_found" error.
+type GetItemNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateItemConflictResponseBody is the type of the "dummy" service
+// "update_item" endpoint HTTP response body for the "conflict" error.
+type UpdateItemConflictResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+	// Version the item has now
+	CurrentVersion *int `form:"current_version,omitempty" json:"current_version,omitempty" xml:"current_version,omitempty"`
+}
+
+// UpdateItemForbiddenResponseBody is the type of the "dummy" service
+// "update_item" endpoint HTTP response body for the "forbidden" error.
+type UpdateItemForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateItemNotFoundResponseBody is the type of the "dummy" service
+// "update_item" endpoint HTTP response body for the "not_found" error.
+type UpdateItemNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateItemPreconditionRequiredResponseBody is the type of the "dummy"
+// service "update_item" endpoint HTTP response body for the
+// "precondition_required" error.
+type UpdateItemPreconditionRequiredResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchItemConflictResponseBody is the type of the "dummy" service
+// "patch_item" endpoint HTTP response body for the "conflict" error.
+type PatchItemConflictResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+	// Version the item has now
+	CurrentVersion *int `form:"current_version,omitempty" json:"current_version,omitempty" xml:"current_version,omitempty"`
+}
+
+// PatchItemForbiddenResponseBody is the type of the "dummy" service
+// "patch_item" endpoint HTTP response body for the "forbidden" error.
+type PatchItemForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchItemNotFoundResponseBody is the type of the "dummy" service
+// "patch_item" endpoint HTTP response body for the "not_found" error.
+type PatchItemNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PatchItemPreconditionRequiredResponseBody is the type of the "dummy" service
+// "patch_item" endpoint HTTP response body for the "precondition_required"
+// error.
+type PatchItemPreconditionRequiredResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteItemNotFoundResponseBody is the type of the "dummy" service
+// "delete_item" endpoint HTTP response body for the "not_found" error.
+type DeleteItemNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ShareItemForbiddenResponseBody is the type of the "dummy" service
+// "share_item" endpoint HTTP response body for the "forbidden" error.
+type ShareItemForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ShareItemNotFoundResponseBody is the type of the "dummy" service
+// "share_item" endpoint HTTP response body for the "not_found" error.
+type ShareItemNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UnshareItemForbiddenResponseBody is the type of the "dummy" service
+// "unshare_item" endpoint HTTP response body for the "forbidden" error.
+type UnshareItemForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UnshareItemNotFoundResponseBody is the type of the "dummy" service
+// "unshare_item" endpoint HTTP response body for the "not_found" error.
+type UnshareItemNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListItemSharesForbiddenResponseBody is the type of the "dummy" service
+// "list_item_shares" endpoint HTTP response body for the "forbidden" error.
+type ListItemSharesForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListItemSharesNotFoundResponseBody is the type of the "dummy" service
+// "list_item_shares" endpoint HTTP response body for the "not_found" error.
+type ListItemSharesNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddItemTagForbiddenResponseBody is the type of the "dummy" service
+// "add_item_tag" endpoint HTTP response body for the "forbidden" error.
+type AddItemTagForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddItemTagNotFoundResponseBody is the type of the "dummy" service
+// "add_item_tag" endpoint HTTP response body for the "not_found" error.
+type AddItemTagNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RemoveItemTagForbiddenResponseBody is the type of the "dummy" service
+// "remove_item_tag" endpoint HTTP response body for the "forbidden" error.
+type RemoveItemTagForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RemoveItemTagNotFoundResponseBody is the type of the "dummy" service
+// "remove_item_tag" endpoint HTTP response body for the "not_found" error.
+type RemoveItemTagNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UploadAttachmentChecksumMismatchResponseBody is the type of the "dummy"
+// service "upload_attachment" endpoint HTTP response body for the
+// "checksum_mismatch" error.
+type UploadAttachmentChecksumMismatchResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UploadAttachmentForbiddenResponseBody is the type of the "dummy" service
+// "upload_attachment" endpoint HTTP response body for the "forbidden" error.
+type UploadAttachmentForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UploadAttachmentNotFoundResponseBody is the type of the "dummy" service
+// "upload_attachment" endpoint HTTP response body for the "not_found" error.
+type UploadAttachmentNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UploadAttachmentTooLargeResponseBody is the type of the "dummy" service
+// "upload_attachment" endpoint HTTP response body for the "too_large" error.
+type UploadAttachmentTooLargeResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UploadAttachmentUnsupportedMediaTypeResponseBody is the type of the "dummy"
+// service "upload_attachment" endpoint HTTP response body for the
+// "unsupported_media_type" error.
+type UploadAttachmentUnsupportedMediaTypeResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DownloadAttachmentNotFoundResponseBody is the type of the "dummy" service
+// "download_attachment" endpoint HTTP response body for the "not_found" error.
+type DownloadAttachmentNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListAttachmentsNotFoundResponseBody is the type of the "dummy" service
+// "list_attachments" endpoint HTTP response body for the "not_found" error.
+type ListAttachmentsNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteAttachmentForbiddenResponseBody is the type of the "dummy" service
+// "delete_attachment" endpoint HTTP response body for the "forbidden" error.
+type DeleteAttachmentForbiddenResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteAttachmentNotFoundResponseBody is the type of the "dummy" service
+// "delete_attachment" endpoint HTTP response body for the "not_found" error.
+type DeleteAttachmentNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportItemsFileTooLargeResponseBody is the type of the "dummy" service
+// "import_items_file" endpoint HTTP response body for the "too_large" error.
+type ImportItemsFileTooLargeResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ListTrashInvalidCursorResponseBody is the type of the "dummy" service
+// "list_trash" endpoint HTTP response body for the "invalid_cursor" error.
+type ListTrashInvalidCursorResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RestoreItemNotFoundResponseBody is the type of the "dummy" service
+// "restore_item" endpoint HTTP response body for the "not_found" error.
+type RestoreItemNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// PurgeItemNotFoundResponseBody is the type of the "dummy" service
+// "purge_item" endpoint HTTP response body for the "not_found" error.
+type PurgeItemNotFoundResponseBody struct {
+	Message string `form:"message" json:"message" xml:"message"`
 }
 
 // ItemResponseBody is used to define fields on response body types.
@@ -56,6 +546,103 @@ type ItemResponseBody struct {
 	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 	OwnerID     string  `form:"owner_id" json:"owner_id" xml:"owner_id"`
 	CreatedAt   string  `form:"created_at" json:"created_at" xml:"created_at"`
+	// Incremented by every update; send it back to update the item
+	Version   int    `form:"version" json:"version" xml:"version"`
+	UpdatedAt string `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Entity tag of this version, returned in the ETag header over HTTP
+	Etag string `form:"etag" json:"etag" xml:"etag"`
+	// Text search configuration used to index the item
+	Language string `form:"language" json:"language" xml:"language"`
+	// When the item was moved to the trash
+	DeletedAt *string `form:"deleted_at,omitempty" json:"deleted_at,omitempty" xml:"deleted_at,omitempty"`
+	// Caller's access to the item: owner, or the permission it was shared with
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	// Tags of the item, in alphabetical order
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+}
+
+// SearchResultResponseBody is used to define fields on response body types.
+type SearchResultResponseBody struct {
+	Item *ItemResponseBody `form:"item" json:"item" xml:"item"`
+	// Relevance of the item; higher is better
+	Rank float32 `form:"rank" json:"rank" xml:"rank"`
+	// Excerpt of the name and description with matches wrapped in <mark> tags. The
+	// item text is HTML-escaped, so the snippet can be inserted as markup
+	Snippet string `form:"snippet" json:"snippet" xml:"snippet"`
+}
+
+// ItemShareResponseBody is used to define fields on response body types.
+type ItemShareResponseBody struct {
+	ItemID string `form:"item_id" json:"item_id" xml:"item_id"`
+	// User the item is shared with
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// viewer can read the item, editor can also update it
+	Permission string `form:"permission" json:"permission" xml:"permission"`
+	CreatedAt  string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// TagSuggestionResponseBody is used to define fields on response body types.
+type TagSuggestionResponseBody struct {
+	Name string `form:"name" json:"name" xml:"name"`
+	// Number of items with the tag, not counting trashed ones
+	ItemCount int64 `form:"item_count" json:"item_count" xml:"item_count"`
+}
+
+// AttachmentResponseBody is used to define fields on response body types.
+type AttachmentResponseBody struct {
+	ID       string `form:"id" json:"id" xml:"id"`
+	ItemID   string `form:"item_id" json:"item_id" xml:"item_id"`
+	Filename string `form:"filename" json:"filename" xml:"filename"`
+	// Media type sniffed from the content
+	ContentType string `form:"content_type" json:"content_type" xml:"content_type"`
+	// Size in bytes
+	Size int64 `form:"size" json:"size" xml:"size"`
+	// Hex-encoded SHA-256 of the content
+	ChecksumSha256 string `form:"checksum_sha256" json:"checksum_sha256" xml:"checksum_sha256"`
+	CreatedAt      string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// BatchEntryResultResponseBody is used to define fields on response body types.
+type BatchEntryResultResponseBody struct {
+	// Position of the entry in the request
+	Index int `form:"index" json:"index" xml:"index"`
+	// The created or updated item
+	Item  *ItemResponseBody            `form:"item,omitempty" json:"item,omitempty" xml:"item,omitempty"`
+	Error *BatchEntryErrorResponseBody `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// BatchEntryErrorResponseBody is used to define fields on response body types.
+type BatchEntryErrorResponseBody struct {
+	// Error name, such as not_found or conflict. aborted marks entries rolled back
+	// or skipped because another entry of an atomic batch failed
+	Name    string `form:"name" json:"name" xml:"name"`
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ImportRowErrorResponseBody is used to define fields on response body types.
+type ImportRowErrorResponseBody struct {
+	// Line of the file the rejected row starts on, counting from 1
+	Line    int    `form:"line" json:"line" xml:"line"`
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// BatchItemEntryRequestBody is used to define fields on request body types.
+type BatchItemEntryRequestBody struct {
+	Name        *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Language used to stem the item for search; defaults to DUMMY_SEARCH_LANGUAGE
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+}
+
+// BatchUpdateEntryRequestBody is used to define fields on request body types.
+type BatchUpdateEntryRequestBody struct {
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Version the update is based on
+	Version *int `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// New name; omit to keep it
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// New description; omit to keep it, send an empty string to clear it
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
 }
 
 // NewCreateItemResponseBody builds the HTTP response body from the result of
@@ -67,6 +654,19 @@ func NewCreateItemResponseBody(res *dummyviews.ItemView) *CreateItemResponseBody
 		Description: res.Description,
 		OwnerID:     *res.OwnerID,
 		CreatedAt:   *res.CreatedAt,
+		Version:     *res.Version,
+		UpdatedAt:   *res.UpdatedAt,
+		Language:    *res.Language,
+		DeletedAt:   res.DeletedAt,
+		Permission:  *res.Permission,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
 	}
 	return body
 }
@@ -74,7 +674,10 @@ func NewCreateItemResponseBody(res *dummyviews.ItemView) *CreateItemResponseBody
 // NewListItemsResponseBody builds the HTTP response body from the result of
 // the "list_items" endpoint of the "dummy" service.
 func NewListItemsResponseBody(res *dummy.ItemsCollection) *ListItemsResponseBody {
-	body := &ListItemsResponseBody{}
+	body := &ListItemsResponseBody{
+		NextCursor: res.NextCursor,
+		Total:      res.Total,
+	}
 	if res.Items != nil {
 		body.Items = make([]*ItemResponseBody, len(res.Items))
 		for i, val := range res.Items {
@@ -90,6 +693,27 @@ func NewListItemsResponseBody(res *dummy.ItemsCollection) *ListItemsResponseBody
 	return body
 }
 
+// NewSearchItemsResponseBody builds the HTTP response body from the result of
+// the "search_items" endpoint of the "dummy" service.
+func NewSearchItemsResponseBody(res *dummy.SearchResultsCollection) *SearchItemsResponseBody {
+	body := &SearchItemsResponseBody{
+		NextCursor: res.NextCursor,
+	}
+	if res.Results != nil {
+		body.Results = make([]*SearchResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			if val == nil {
+				body.Results[i] = nil
+				continue
+			}
+			body.Results[i] = marshalDummySearchResultToSearchResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*SearchResultResponseBody{}
+	}
+	return body
+}
+
 // NewGetItemResponseBody builds the HTTP response body from the result of the
 // "get_item" endpoint of the "dummy" service.
 func NewGetItemResponseBody(res *dummyviews.ItemView) *GetItemResponseBody {
@@ -99,53 +723,1096 @@ func NewGetItemResponseBody(res *dummyviews.ItemView) *GetItemResponseBody {
 		Description: res.Description,
 		OwnerID:     *res.OwnerID,
 		CreatedAt:   *res.CreatedAt,
+		Version:     *res.Version,
+		UpdatedAt:   *res.UpdatedAt,
+		Language:    *res.Language,
+		DeletedAt:   res.DeletedAt,
+		Permission:  *res.Permission,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
 	}
 	return body
 }
 
-// NewCreateItemPayload builds a dummy service create_item endpoint payload.
-func NewCreateItemPayload(body *CreateItemRequestBody, token string) *dummy.CreateItemPayload {
-	v := &dummy.CreateItemPayload{
-		Name:        *body.Name,
-		Description: body.Description,
+// NewUpdateItemResponseBody builds the HTTP response body from the result of
+// the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemResponseBody(res *dummyviews.ItemView) *UpdateItemResponseBody {
+	body := &UpdateItemResponseBody{
+		ID:          *res.ID,
+		Name:        *res.Name,
+		Description: res.Description,
+		OwnerID:     *res.OwnerID,
+		CreatedAt:   *res.CreatedAt,
+		Version:     *res.Version,
+		UpdatedAt:   *res.UpdatedAt,
+		Language:    *res.Language,
+		DeletedAt:   res.DeletedAt,
+		Permission:  *res.Permission,
 	}
-	v.Token = token
-
-	return v
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
+	}
+	return body
 }
 
-// NewListItemsPayload builds a dummy service list_items endpoint payload.
-func NewListItemsPayload(token string) *dummy.ListItemsPayload {
-	v := &dummy.ListItemsPayload{}
-	v.Token = token
-
-	return v
+// NewPatchItemResponseBody builds the HTTP response body from the result of
+// the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemResponseBody(res *dummyviews.ItemView) *PatchItemResponseBody {
+	body := &PatchItemResponseBody{
+		ID:          *res.ID,
+		Name:        *res.Name,
+		Description: res.Description,
+		OwnerID:     *res.OwnerID,
+		CreatedAt:   *res.CreatedAt,
+		Version:     *res.Version,
+		UpdatedAt:   *res.UpdatedAt,
+		Language:    *res.Language,
+		DeletedAt:   res.DeletedAt,
+		Permission:  *res.Permission,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
+	}
+	return body
 }
 
-// NewGetItemItemIDPayload builds a dummy service get_item endpoint payload.
-func NewGetItemItemIDPayload(id string, token string) *dummy.ItemIDPayload {
-	v := &dummy.ItemIDPayload{}
-	v.ID = id
-	v.Token = token
-
-	return v
+// NewShareItemResponseBody builds the HTTP response body from the result of
+// the "share_item" endpoint of the "dummy" service.
+func NewShareItemResponseBody(res *dummy.ItemShare) *ShareItemResponseBody {
+	body := &ShareItemResponseBody{
+		ItemID:     res.ItemID,
+		UserID:     res.UserID,
+		Permission: res.Permission,
+		CreatedAt:  res.CreatedAt,
+	}
+	return body
 }
 
-// NewDeleteItemItemIDPayload builds a dummy service delete_item endpoint
-// payload.
-func NewDeleteItemItemIDPayload(id string, token string) *dummy.ItemIDPayload {
-	v := &dummy.ItemIDPayload{}
-	v.ID = id
-	v.Token = token
+// NewListItemSharesResponseBody builds the HTTP response body from the result
+// of the "list_item_shares" endpoint of the "dummy" service.
+func NewListItemSharesResponseBody(res *dummy.ItemSharesCollection) *ListItemSharesResponseBody {
+	body := &ListItemSharesResponseBody{}
+	if res.Shares != nil {
+		body.Shares = make([]*ItemShareResponseBody, len(res.Shares))
+		for i, val := range res.Shares {
+			if val == nil {
+				body.Shares[i] = nil
+				continue
+			}
+			body.Shares[i] = marshalDummyItemShareToItemShareResponseBody(val)
+		}
+	} else {
+		body.Shares = []*ItemShareResponseBody{}
+	}
+	return body
+}
 
-	return v
+// NewAddItemTagResponseBody builds the HTTP response body from the result of
+// the "add_item_tag" endpoint of the "dummy" service.
+func NewAddItemTagResponseBody(res *dummyviews.ItemView) *AddItemTagResponseBody {
+	body := &AddItemTagResponseBody{
+		ID:          *res.ID,
+		Name:        *res.Name,
+		Description: res.Description,
+		OwnerID:     *res.OwnerID,
+		CreatedAt:   *res.CreatedAt,
+		Version:     *res.Version,
+		UpdatedAt:   *res.UpdatedAt,
+		Language:    *res.Language,
+		DeletedAt:   res.DeletedAt,
+		Permission:  *res.Permission,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
+	}
+	return body
 }
 
-// ValidateCreateItemRequestBody runs the validations defined on
-// create_item_request_body
-func ValidateCreateItemRequestBody(body *CreateItemRequestBody) (err error) {
-	if body.Name == nil {
+// NewRemoveItemTagResponseBody builds the HTTP response body from the result
+// of the "remove_item_tag" endpoint of the "dummy" service.
+func NewRemoveItemTagResponseBody(res *dummyviews.ItemView) *RemoveItemTagResponseBody {
+	body := &RemoveItemTagResponseBody{
+		ID:          *res.ID,
+		Name:        *res.Name,
+		Description: res.Description,
+		OwnerID:     *res.OwnerID,
+		CreatedAt:   *res.CreatedAt,
+		Version:     *res.Version,
+		UpdatedAt:   *res.UpdatedAt,
+		Language:    *res.Language,
+		DeletedAt:   res.DeletedAt,
+		Permission:  *res.Permission,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
+	}
+	return body
+}
+
+// NewSuggestTagsResponseBody builds the HTTP response body from the result of
+// the "suggest_tags" endpoint of the "dummy" service.
+func NewSuggestTagsResponseBody(res *dummy.TagSuggestions) *SuggestTagsResponseBody {
+	body := &SuggestTagsResponseBody{}
+	if res.Tags != nil {
+		body.Tags = make([]*TagSuggestionResponseBody, len(res.Tags))
+		for i, val := range res.Tags {
+			if val == nil {
+				body.Tags[i] = nil
+				continue
+			}
+			body.Tags[i] = marshalDummyTagSuggestionToTagSuggestionResponseBody(val)
+		}
+	} else {
+		body.Tags = []*TagSuggestionResponseBody{}
+	}
+	return body
+}
+
+// NewUploadAttachmentResponseBody builds the HTTP response body from the
+// result of the "upload_attachment" endpoint of the "dummy" service.
+func NewUploadAttachmentResponseBody(res *dummy.Attachment) *UploadAttachmentResponseBody {
+	body := &UploadAttachmentResponseBody{
+		ID:             res.ID,
+		ItemID:         res.ItemID,
+		Filename:       res.Filename,
+		ContentType:    res.ContentType,
+		Size:           res.Size,
+		ChecksumSha256: res.ChecksumSha256,
+		CreatedAt:      res.CreatedAt,
+	}
+	return body
+}
+
+// NewListAttachmentsResponseBody builds the HTTP response body from the result
+// of the "list_attachments" endpoint of the "dummy" service.
+func NewListAttachmentsResponseBody(res *dummy.AttachmentsCollection) *ListAttachmentsResponseBody {
+	body := &ListAttachmentsResponseBody{}
+	if res.Attachments != nil {
+		body.Attachments = make([]*AttachmentResponseBody, len(res.Attachments))
+		for i, val := range res.Attachments {
+			if val == nil {
+				body.Attachments[i] = nil
+				continue
+			}
+			body.Attachments[i] = marshalDummyAttachmentToAttachmentResponseBody(val)
+		}
+	} else {
+		body.Attachments = []*AttachmentResponseBody{}
+	}
+	return body
+}
+
+// NewBatchCreateItemsResponseBody builds the HTTP response body from the
+// result of the "batch_create_items" endpoint of the "dummy" service.
+func NewBatchCreateItemsResponseBody(res *dummy.BatchResult) *BatchCreateItemsResponseBody {
+	body := &BatchCreateItemsResponseBody{
+		Succeeded: res.Succeeded,
+		Failed:    res.Failed,
+	}
+	if res.Results != nil {
+		body.Results = make([]*BatchEntryResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			if val == nil {
+				body.Results[i] = nil
+				continue
+			}
+			body.Results[i] = marshalDummyBatchEntryResultToBatchEntryResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*BatchEntryResultResponseBody{}
+	}
+	return body
+}
+
+// NewBatchUpdateItemsResponseBody builds the HTTP response body from the
+// result of the "batch_update_items" endpoint of the "dummy" service.
+func NewBatchUpdateItemsResponseBody(res *dummy.BatchResult) *BatchUpdateItemsResponseBody {
+	body := &BatchUpdateItemsResponseBody{
+		Succeeded: res.Succeeded,
+		Failed:    res.Failed,
+	}
+	if res.Results != nil {
+		body.Results = make([]*BatchEntryResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			if val == nil {
+				body.Results[i] = nil
+				continue
+			}
+			body.Results[i] = marshalDummyBatchEntryResultToBatchEntryResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*BatchEntryResultResponseBody{}
+	}
+	return body
+}
+
+// NewBatchDeleteItemsResponseBody builds the HTTP response body from the
+// result of the "batch_delete_items" endpoint of the "dummy" service.
+func NewBatchDeleteItemsResponseBody(res *dummy.BatchResult) *BatchDeleteItemsResponseBody {
+	body := &BatchDeleteItemsResponseBody{
+		Succeeded: res.Succeeded,
+		Failed:    res.Failed,
+	}
+	if res.Results != nil {
+		body.Results = make([]*BatchEntryResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			if val == nil {
+				body.Results[i] = nil
+				continue
+			}
+			body.Results[i] = marshalDummyBatchEntryResultToBatchEntryResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*BatchEntryResultResponseBody{}
+	}
+	return body
+}
+
+// NewImportItemsFileResponseBody builds the HTTP response body from the result
+// of the "import_items_file" endpoint of the "dummy" service.
+func NewImportItemsFileResponseBody(res *dummy.ImportReport) *ImportItemsFileResponseBody {
+	body := &ImportItemsFileResponseBody{
+		DryRun:   res.DryRun,
+		Rows:     res.Rows,
+		Failed:   res.Failed,
+		Imported: res.Imported,
+	}
+	if res.Errors != nil {
+		body.Errors = make([]*ImportRowErrorResponseBody, len(res.Errors))
+		for i, val := range res.Errors {
+			if val == nil {
+				body.Errors[i] = nil
+				continue
+			}
+			body.Errors[i] = marshalDummyImportRowErrorToImportRowErrorResponseBody(val)
+		}
+	} else {
+		body.Errors = []*ImportRowErrorResponseBody{}
+	}
+	return body
+}
+
+// NewListTrashResponseBody builds the HTTP response body from the result of
+// the "list_trash" endpoint of the "dummy" service.
+func NewListTrashResponseBody(res *dummy.ItemsCollection) *ListTrashResponseBody {
+	body := &ListTrashResponseBody{
+		NextCursor: res.NextCursor,
+		Total:      res.Total,
+	}
+	if res.Items != nil {
+		body.Items = make([]*ItemResponseBody, len(res.Items))
+		for i, val := range res.Items {
+			if val == nil {
+				body.Items[i] = nil
+				continue
+			}
+			body.Items[i] = marshalDummyItemToItemResponseBody(val)
+		}
+	} else {
+		body.Items = []*ItemResponseBody{}
+	}
+	return body
+}
+
+// NewRestoreItemResponseBody builds the HTTP response body from the result of
+// the "restore_item" endpoint of the "dummy" service.
+func NewRestoreItemResponseBody(res *dummyviews.ItemView) *RestoreItemResponseBody {
+	body := &RestoreItemResponseBody{
+		ID:          *res.ID,
+		Name:        *res.Name,
+		Description: res.Description,
+		OwnerID:     *res.OwnerID,
+		CreatedAt:   *res.CreatedAt,
+		Version:     *res.Version,
+		UpdatedAt:   *res.UpdatedAt,
+		Language:    *res.Language,
+		DeletedAt:   res.DeletedAt,
+		Permission:  *res.Permission,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
+	}
+	return body
+}
+
+// NewListItemsInvalidCursorResponseBody builds the HTTP response body from the
+// result of the "list_items" endpoint of the "dummy" service.
+func NewListItemsInvalidCursorResponseBody(res *dummy.DummyBadRequestError) *ListItemsInvalidCursorResponseBody {
+	body := &ListItemsInvalidCursorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSearchItemsInvalidCursorResponseBody builds the HTTP response body from
+// the result of the "search_items" endpoint of the "dummy" service.
+func NewSearchItemsInvalidCursorResponseBody(res *dummy.DummyBadRequestError) *SearchItemsInvalidCursorResponseBody {
+	body := &SearchItemsInvalidCursorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetItemNotFoundResponseBody builds the HTTP response body from the result
+// of the "get_item" endpoint of the "dummy" service.
+func NewGetItemNotFoundResponseBody(res *dummy.DummyNotFoundError) *GetItemNotFoundResponseBody {
+	body := &GetItemNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItemConflictResponseBody builds the HTTP response body from the
+// result of the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemConflictResponseBody(res *dummy.DummyConflictError) *UpdateItemConflictResponseBody {
+	body := &UpdateItemConflictResponseBody{
+		Message:        res.Message,
+		CurrentVersion: res.CurrentVersion,
+	}
+	return body
+}
+
+// NewUpdateItemForbiddenResponseBody builds the HTTP response body from the
+// result of the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemForbiddenResponseBody(res *dummy.DummyForbiddenError) *UpdateItemForbiddenResponseBody {
+	body := &UpdateItemForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemNotFoundResponseBody(res *dummy.DummyNotFoundError) *UpdateItemNotFoundResponseBody {
+	body := &UpdateItemNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateItemPreconditionRequiredResponseBody builds the HTTP response body
+// from the result of the "update_item" endpoint of the "dummy" service.
+func NewUpdateItemPreconditionRequiredResponseBody(res *dummy.DummyPreconditionRequiredError) *UpdateItemPreconditionRequiredResponseBody {
+	body := &UpdateItemPreconditionRequiredResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchItemConflictResponseBody builds the HTTP response body from the
+// result of the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemConflictResponseBody(res *dummy.DummyConflictError) *PatchItemConflictResponseBody {
+	body := &PatchItemConflictResponseBody{
+		Message:        res.Message,
+		CurrentVersion: res.CurrentVersion,
+	}
+	return body
+}
+
+// NewPatchItemForbiddenResponseBody builds the HTTP response body from the
+// result of the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemForbiddenResponseBody(res *dummy.DummyForbiddenError) *PatchItemForbiddenResponseBody {
+	body := &PatchItemForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemNotFoundResponseBody(res *dummy.DummyNotFoundError) *PatchItemNotFoundResponseBody {
+	body := &PatchItemNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPatchItemPreconditionRequiredResponseBody builds the HTTP response body
+// from the result of the "patch_item" endpoint of the "dummy" service.
+func NewPatchItemPreconditionRequiredResponseBody(res *dummy.DummyPreconditionRequiredError) *PatchItemPreconditionRequiredResponseBody {
+	body := &PatchItemPreconditionRequiredResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "delete_item" endpoint of the "dummy" service.
+func NewDeleteItemNotFoundResponseBody(res *dummy.DummyNotFoundError) *DeleteItemNotFoundResponseBody {
+	body := &DeleteItemNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewShareItemForbiddenResponseBody builds the HTTP response body from the
+// result of the "share_item" endpoint of the "dummy" service.
+func NewShareItemForbiddenResponseBody(res *dummy.DummyForbiddenError) *ShareItemForbiddenResponseBody {
+	body := &ShareItemForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewShareItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "share_item" endpoint of the "dummy" service.
+func NewShareItemNotFoundResponseBody(res *dummy.DummyNotFoundError) *ShareItemNotFoundResponseBody {
+	body := &ShareItemNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUnshareItemForbiddenResponseBody builds the HTTP response body from the
+// result of the "unshare_item" endpoint of the "dummy" service.
+func NewUnshareItemForbiddenResponseBody(res *dummy.DummyForbiddenError) *UnshareItemForbiddenResponseBody {
+	body := &UnshareItemForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUnshareItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "unshare_item" endpoint of the "dummy" service.
+func NewUnshareItemNotFoundResponseBody(res *dummy.DummyNotFoundError) *UnshareItemNotFoundResponseBody {
+	body := &UnshareItemNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListItemSharesForbiddenResponseBody builds the HTTP response body from
+// the result of the "list_item_shares" endpoint of the "dummy" service.
+func NewListItemSharesForbiddenResponseBody(res *dummy.DummyForbiddenError) *ListItemSharesForbiddenResponseBody {
+	body := &ListItemSharesForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListItemSharesNotFoundResponseBody builds the HTTP response body from the
+// result of the "list_item_shares" endpoint of the "dummy" service.
+func NewListItemSharesNotFoundResponseBody(res *dummy.DummyNotFoundError) *ListItemSharesNotFoundResponseBody {
+	body := &ListItemSharesNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddItemTagForbiddenResponseBody builds the HTTP response body from the
+// result of the "add_item_tag" endpoint of the "dummy" service.
+func NewAddItemTagForbiddenResponseBody(res *dummy.DummyForbiddenError) *AddItemTagForbiddenResponseBody {
+	body := &AddItemTagForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddItemTagNotFoundResponseBody builds the HTTP response body from the
+// result of the "add_item_tag" endpoint of the "dummy" service.
+func NewAddItemTagNotFoundResponseBody(res *dummy.DummyNotFoundError) *AddItemTagNotFoundResponseBody {
+	body := &AddItemTagNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRemoveItemTagForbiddenResponseBody builds the HTTP response body from the
+// result of the "remove_item_tag" endpoint of the "dummy" service.
+func NewRemoveItemTagForbiddenResponseBody(res *dummy.DummyForbiddenError) *RemoveItemTagForbiddenResponseBody {
+	body := &RemoveItemTagForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRemoveItemTagNotFoundResponseBody builds the HTTP response body from the
+// result of the "remove_item_tag" endpoint of the "dummy" service.
+func NewRemoveItemTagNotFoundResponseBody(res *dummy.DummyNotFoundError) *RemoveItemTagNotFoundResponseBody {
+	body := &RemoveItemTagNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUploadAttachmentChecksumMismatchResponseBody builds the HTTP response
+// body from the result of the "upload_attachment" endpoint of the "dummy"
+// service.
+func NewUploadAttachmentChecksumMismatchResponseBody(res *dummy.DummyChecksumMismatchError) *UploadAttachmentChecksumMismatchResponseBody {
+	body := &UploadAttachmentChecksumMismatchResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUploadAttachmentForbiddenResponseBody builds the HTTP response body from
+// the result of the "upload_attachment" endpoint of the "dummy" service.
+func NewUploadAttachmentForbiddenResponseBody(res *dummy.DummyForbiddenError) *UploadAttachmentForbiddenResponseBody {
+	body := &UploadAttachmentForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUploadAttachmentNotFoundResponseBody builds the HTTP response body from
+// the result of the "upload_attachment" endpoint of the "dummy" service.
+func NewUploadAttachmentNotFoundResponseBody(res *dummy.DummyNotFoundError) *UploadAttachmentNotFoundResponseBody {
+	body := &UploadAttachmentNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUploadAttachmentTooLargeResponseBody builds the HTTP response body from
+// the result of the "upload_attachment" endpoint of the "dummy" service.
+func NewUploadAttachmentTooLargeResponseBody(res *dummy.DummyTooLargeError) *UploadAttachmentTooLargeResponseBody {
+	body := &UploadAttachmentTooLargeResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUploadAttachmentUnsupportedMediaTypeResponseBody builds the HTTP response
+// body from the result of the "upload_attachment" endpoint of the "dummy"
+// service.
+func NewUploadAttachmentUnsupportedMediaTypeResponseBody(res *dummy.DummyUnsupportedMediaTypeError) *UploadAttachmentUnsupportedMediaTypeResponseBody {
+	body := &UploadAttachmentUnsupportedMediaTypeResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDownloadAttachmentNotFoundResponseBody builds the HTTP response body from
+// the result of the "download_attachment" endpoint of the "dummy" service.
+func NewDownloadAttachmentNotFoundResponseBody(res *dummy.DummyNotFoundError) *DownloadAttachmentNotFoundResponseBody {
+	body := &DownloadAttachmentNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListAttachmentsNotFoundResponseBody builds the HTTP response body from
+// the result of the "list_attachments" endpoint of the "dummy" service.
+func NewListAttachmentsNotFoundResponseBody(res *dummy.DummyNotFoundError) *ListAttachmentsNotFoundResponseBody {
+	body := &ListAttachmentsNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteAttachmentForbiddenResponseBody builds the HTTP response body from
+// the result of the "delete_attachment" endpoint of the "dummy" service.
+func NewDeleteAttachmentForbiddenResponseBody(res *dummy.DummyForbiddenError) *DeleteAttachmentForbiddenResponseBody {
+	body := &DeleteAttachmentForbiddenResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteAttachmentNotFoundResponseBody builds the HTTP response body from
+// the result of the "delete_attachment" endpoint of the "dummy" service.
+func NewDeleteAttachmentNotFoundResponseBody(res *dummy.DummyNotFoundError) *DeleteAttachmentNotFoundResponseBody {
+	body := &DeleteAttachmentNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewImportItemsFileTooLargeResponseBody builds the HTTP response body from
+// the result of the "import_items_file" endpoint of the "dummy" service.
+func NewImportItemsFileTooLargeResponseBody(res *dummy.DummyTooLargeError) *ImportItemsFileTooLargeResponseBody {
+	body := &ImportItemsFileTooLargeResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewListTrashInvalidCursorResponseBody builds the HTTP response body from the
+// result of the "list_trash" endpoint of the "dummy" service.
+func NewListTrashInvalidCursorResponseBody(res *dummy.DummyBadRequestError) *ListTrashInvalidCursorResponseBody {
+	body := &ListTrashInvalidCursorResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRestoreItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "restore_item" endpoint of the "dummy" service.
+func NewRestoreItemNotFoundResponseBody(res *dummy.DummyNotFoundError) *RestoreItemNotFoundResponseBody {
+	body := &RestoreItemNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewPurgeItemNotFoundResponseBody builds the HTTP response body from the
+// result of the "purge_item" endpoint of the "dummy" service.
+func NewPurgeItemNotFoundResponseBody(res *dummy.DummyNotFoundError) *PurgeItemNotFoundResponseBody {
+	body := &PurgeItemNotFoundResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateItemPayload builds a dummy service create_item endpoint payload.
+func NewCreateItemPayload(body *CreateItemRequestBody, token string) *dummy.CreateItemPayload {
+	v := &dummy.CreateItemPayload{
+		Name:        *body.Name,
+		Description: body.Description,
+		Language:    body.Language,
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewListItemsPayload builds a dummy service list_items endpoint payload.
+func NewListItemsPayload(pageSize int, cursor *string, order string, namePrefix *string, createdAfter *string, createdBefore *string, includeTotal bool, tags []string, tagMatch string, token string) *dummy.ListItemsPayload {
+	v := &dummy.ListItemsPayload{}
+	v.PageSize = pageSize
+	v.Cursor = cursor
+	v.Order = order
+	v.NamePrefix = namePrefix
+	v.CreatedAfter = createdAfter
+	v.CreatedBefore = createdBefore
+	v.IncludeTotal = includeTotal
+	v.Tags = tags
+	v.TagMatch = tagMatch
+	v.Token = token
+
+	return v
+}
+
+// NewSearchItemsPayload builds a dummy service search_items endpoint payload.
+func NewSearchItemsPayload(query string, language *string, pageSize int, cursor *string, token string) *dummy.SearchItemsPayload {
+	v := &dummy.SearchItemsPayload{}
+	v.Query = query
+	v.Language = language
+	v.PageSize = pageSize
+	v.Cursor = cursor
+	v.Token = token
+
+	return v
+}
+
+// NewGetItemItemIDPayload builds a dummy service get_item endpoint payload.
+func NewGetItemItemIDPayload(id string, token string) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewUpdateItemPayload builds a dummy service update_item endpoint payload.
+func NewUpdateItemPayload(body struct {
+	Name *string `form:"name" json:"name" xml:"name"`
+	// New description; omit to clear it
+	Description *string `form:"description" json:"description" xml:"description"`
+}, id string, token string, ifMatch *string) *dummy.UpdateItemPayload {
+	v := &dummy.UpdateItemPayload{
+		Description: body.Description,
+	}
+	if body.Name != nil {
+		v.Name = *body.Name
+	}
+	v.ID = id
+	v.Token = token
+	v.IfMatch = ifMatch
+
+	return v
+}
+
+// NewPatchItemPayload builds a dummy service patch_item endpoint payload.
+func NewPatchItemPayload(body struct {
+	// New name; omit to keep it
+	Name *string `form:"name" json:"name" xml:"name"`
+	// New description; omit to keep it, send an empty string to clear it
+	Description *string `form:"description" json:"description" xml:"description"`
+}, id string, token string, ifMatch *string) *dummy.PatchItemPayload {
+	v := &dummy.PatchItemPayload{
+		Name:        body.Name,
+		Description: body.Description,
+	}
+	v.ID = id
+	v.Token = token
+	v.IfMatch = ifMatch
+
+	return v
+}
+
+// NewDeleteItemItemIDPayload builds a dummy service delete_item endpoint
+// payload.
+func NewDeleteItemItemIDPayload(id string, token string) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewShareItemPayload builds a dummy service share_item endpoint payload.
+func NewShareItemPayload(body struct {
+	// viewer can read the item, editor can also update it
+	Permission *string `form:"permission" json:"permission" xml:"permission"`
+}, id string, userID string, token string) *dummy.ShareItemPayload {
+	v := &dummy.ShareItemPayload{}
+	if body.Permission != nil {
+		v.Permission = *body.Permission
+	}
+	v.ID = id
+	v.UserID = userID
+	v.Token = token
+
+	return v
+}
+
+// NewUnshareItemPayload builds a dummy service unshare_item endpoint payload.
+func NewUnshareItemPayload(id string, userID string, token string) *dummy.UnshareItemPayload {
+	v := &dummy.UnshareItemPayload{}
+	v.ID = id
+	v.UserID = userID
+	v.Token = token
+
+	return v
+}
+
+// NewListItemSharesItemIDPayload builds a dummy service list_item_shares
+// endpoint payload.
+func NewListItemSharesItemIDPayload(id string, token string) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewAddItemTagItemTagPayload builds a dummy service add_item_tag endpoint
+// payload.
+func NewAddItemTagItemTagPayload(id string, tag string, token string) *dummy.ItemTagPayload {
+	v := &dummy.ItemTagPayload{}
+	v.ID = id
+	v.Tag = tag
+	v.Token = token
+
+	return v
+}
+
+// NewRemoveItemTagItemTagPayload builds a dummy service remove_item_tag
+// endpoint payload.
+func NewRemoveItemTagItemTagPayload(id string, tag string, token string) *dummy.ItemTagPayload {
+	v := &dummy.ItemTagPayload{}
+	v.ID = id
+	v.Tag = tag
+	v.Token = token
+
+	return v
+}
+
+// NewSuggestTagsPayload builds a dummy service suggest_tags endpoint payload.
+func NewSuggestTagsPayload(prefix string, limit int, token string) *dummy.SuggestTagsPayload {
+	v := &dummy.SuggestTagsPayload{}
+	v.Prefix = prefix
+	v.Limit = limit
+	v.Token = token
+
+	return v
+}
+
+// NewUploadAttachmentPayload builds a dummy service upload_attachment endpoint
+// payload.
+func NewUploadAttachmentPayload(id string, filename string, token string, checksumSha256 *string) *dummy.UploadAttachmentPayload {
+	v := &dummy.UploadAttachmentPayload{}
+	v.ID = id
+	v.Filename = filename
+	v.Token = token
+	v.ChecksumSha256 = checksumSha256
+
+	return v
+}
+
+// NewDownloadAttachmentAttachmentIDPayload builds a dummy service
+// download_attachment endpoint payload.
+func NewDownloadAttachmentAttachmentIDPayload(id string, attachmentID string, token string) *dummy.AttachmentIDPayload {
+	v := &dummy.AttachmentIDPayload{}
+	v.ID = id
+	v.AttachmentID = attachmentID
+	v.Token = token
+
+	return v
+}
+
+// NewListAttachmentsItemIDPayload builds a dummy service list_attachments
+// endpoint payload.
+func NewListAttachmentsItemIDPayload(id string, token string) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteAttachmentAttachmentIDPayload builds a dummy service
+// delete_attachment endpoint payload.
+func NewDeleteAttachmentAttachmentIDPayload(id string, attachmentID string, token string) *dummy.AttachmentIDPayload {
+	v := &dummy.AttachmentIDPayload{}
+	v.ID = id
+	v.AttachmentID = attachmentID
+	v.Token = token
+
+	return v
+}
+
+// NewBatchCreateItemsPayload builds a dummy service batch_create_items
+// endpoint payload.
+func NewBatchCreateItemsPayload(body *BatchCreateItemsRequestBody, token string) *dummy.BatchCreateItemsPayload {
+	v := &dummy.BatchCreateItemsPayload{}
+	if body.Atomic != nil {
+		v.Atomic = *body.Atomic
+	}
+	v.Items = make([]*dummy.BatchItemEntry, len(body.Items))
+	for i, val := range body.Items {
+		if val == nil {
+			v.Items[i] = nil
+			continue
+		}
+		v.Items[i] = unmarshalBatchItemEntryRequestBodyToDummyBatchItemEntry(val)
+	}
+	if body.Atomic == nil {
+		v.Atomic = true
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewBatchUpdateItemsPayload builds a dummy service batch_update_items
+// endpoint payload.
+func NewBatchUpdateItemsPayload(body *BatchUpdateItemsRequestBody, token string) *dummy.BatchUpdateItemsPayload {
+	v := &dummy.BatchUpdateItemsPayload{}
+	if body.Atomic != nil {
+		v.Atomic = *body.Atomic
+	}
+	v.Items = make([]*dummy.BatchUpdateEntry, len(body.Items))
+	for i, val := range body.Items {
+		if val == nil {
+			v.Items[i] = nil
+			continue
+		}
+		v.Items[i] = unmarshalBatchUpdateEntryRequestBodyToDummyBatchUpdateEntry(val)
+	}
+	if body.Atomic == nil {
+		v.Atomic = true
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewBatchDeleteItemsPayload builds a dummy service batch_delete_items
+// endpoint payload.
+func NewBatchDeleteItemsPayload(body *BatchDeleteItemsRequestBody, token string) *dummy.BatchDeleteItemsPayload {
+	v := &dummy.BatchDeleteItemsPayload{}
+	if body.Atomic != nil {
+		v.Atomic = *body.Atomic
+	}
+	v.Ids = make([]string, len(body.Ids))
+	for i, val := range body.Ids {
+		v.Ids[i] = val
+	}
+	if body.Atomic == nil {
+		v.Atomic = true
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewExportItemsPayload builds a dummy service export_items endpoint payload.
+func NewExportItemsPayload(format string, token string) *dummy.ExportItemsPayload {
+	v := &dummy.ExportItemsPayload{}
+	v.Format = format
+	v.Token = token
+
+	return v
+}
+
+// NewImportItemsFilePayload builds a dummy service import_items_file endpoint
+// payload.
+func NewImportItemsFilePayload(format string, dryRun bool, token string) *dummy.ImportItemsFilePayload {
+	v := &dummy.ImportItemsFilePayload{}
+	v.Format = format
+	v.DryRun = dryRun
+	v.Token = token
+
+	return v
+}
+
+// NewListTrashPayload builds a dummy service list_trash endpoint payload.
+func NewListTrashPayload(pageSize int, cursor *string, token string) *dummy.ListTrashPayload {
+	v := &dummy.ListTrashPayload{}
+	v.PageSize = pageSize
+	v.Cursor = cursor
+	v.Token = token
+
+	return v
+}
+
+// NewRestoreItemItemIDPayload builds a dummy service restore_item endpoint
+// payload.
+func NewRestoreItemItemIDPayload(id string, token string) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewPurgeItemItemIDPayload builds a dummy service purge_item endpoint payload.
+func NewPurgeItemItemIDPayload(id string, token string) *dummy.ItemIDPayload {
+	v := &dummy.ItemIDPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// ValidateCreateItemRequestBody runs the validations defined on
+// create_item_request_body
+func ValidateCreateItemRequestBody(body *CreateItemRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Language != nil {
+		if !(*body.Language == "simple" || *body.Language == "arabic" || *body.Language == "armenian" || *body.Language == "basque" || *body.Language == "catalan" || *body.Language == "danish" || *body.Language == "dutch" || *body.Language == "english" || *body.Language == "finnish" || *body.Language == "french" || *body.Language == "german" || *body.Language == "greek" || *body.Language == "hindi" || *body.Language == "hungarian" || *body.Language == "indonesian" || *body.Language == "irish" || *body.Language == "italian" || *body.Language == "lithuanian" || *body.Language == "nepali" || *body.Language == "norwegian" || *body.Language == "portuguese" || *body.Language == "romanian" || *body.Language == "russian" || *body.Language == "serbian" || *body.Language == "spanish" || *body.Language == "swedish" || *body.Language == "tamil" || *body.Language == "turkish" || *body.Language == "yiddish") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+		}
+	}
+	return
+}
+
+// ValidateBatchCreateItemsRequestBody runs the validations defined on
+// batch_create_items_request_body
+func ValidateBatchCreateItemsRequestBody(body *BatchCreateItemsRequestBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	if len(body.Items) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+	}
+	if len(body.Items) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateBatchItemEntryRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchUpdateItemsRequestBody runs the validations defined on
+// batch_update_items_request_body
+func ValidateBatchUpdateItemsRequestBody(body *BatchUpdateItemsRequestBody) (err error) {
+	if body.Items == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("items", "body"))
+	}
+	if len(body.Items) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 1, true))
+	}
+	if len(body.Items) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.items", body.Items, len(body.Items), 100, false))
+	}
+	for _, e := range body.Items {
+		if e != nil {
+			if err2 := ValidateBatchUpdateEntryRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateBatchDeleteItemsRequestBody runs the validations defined on
+// batch_delete_items_request_body
+func ValidateBatchDeleteItemsRequestBody(body *BatchDeleteItemsRequestBody) (err error) {
+	if body.Ids == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("ids", "body"))
+	}
+	if len(body.Ids) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.ids", body.Ids, len(body.Ids), 1, true))
+	}
+	if len(body.Ids) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.ids", body.Ids, len(body.Ids), 100, false))
+	}
+	return
+}
+
+// ValidateBatchItemEntryRequestBody runs the validations defined on
+// BatchItemEntryRequestBody
+func ValidateBatchItemEntryRequestBody(body *BatchItemEntryRequestBody) (err error) {
+	if body.Name == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
 	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
+	if body.Language != nil {
+		if !(*body.Language == "simple" || *body.Language == "arabic" || *body.Language == "armenian" || *body.Language == "basque" || *body.Language == "catalan" || *body.Language == "danish" || *body.Language == "dutch" || *body.Language == "english" || *body.Language == "finnish" || *body.Language == "french" || *body.Language == "german" || *body.Language == "greek" || *body.Language == "hindi" || *body.Language == "hungarian" || *body.Language == "indonesian" || *body.Language == "irish" || *body.Language == "italian" || *body.Language == "lithuanian" || *body.Language == "nepali" || *body.Language == "norwegian" || *body.Language == "portuguese" || *body.Language == "romanian" || *body.Language == "russian" || *body.Language == "serbian" || *body.Language == "spanish" || *body.Language == "swedish" || *body.Language == "tamil" || *body.Language == "turkish" || *body.Language == "yiddish") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"simple", "arabic", "armenian", "basque", "catalan", "danish", "dutch", "english", "finnish", "french", "german", "greek", "hindi", "hungarian", "indonesian", "irish", "italian", "lithuanian", "nepali", "norwegian", "portuguese", "romanian", "russian", "serbian", "spanish", "swedish", "tamil", "turkish", "yiddish"}))
+		}
+	}
+	return
+}
+
+// ValidateBatchUpdateEntryRequestBody runs the validations defined on
+// BatchUpdateEntryRequestBody
+func ValidateBatchUpdateEntryRequestBody(body *BatchUpdateEntryRequestBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Name != nil {
+		if utf8.RuneCountInString(*body.Name) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.name", *body.Name, utf8.RuneCountInString(*body.Name), 1, true))
+		}
+	}
 	return
 }
@@ -12,29 +12,66 @@ import (
 type Claims struct {
 	UserID string
 	Email  string
+	// Actor is set when the token was issued to an administrator impersonating
+	// the user.
+	Actor *Actor
+	// Custom holds the claims added by identity-api's claims enrichers.
+	Custom map[string]string
+}
+
+// Actor identifies the party acting on behalf of the token subject.
+type Actor struct {
+	UserID string
+	Email  string
 }
 
 // Client validates tokens by delegating to identity-api over gRPC.
 type Client struct {
 	identity identitypb.IdentityClient
+	audience string
 }
 
-// NewClient constructs a Client using an existing gRPC connection.
-func NewClient(conn *grpc.ClientConn) *Client {
-	return &Client{identity: identitypb.NewIdentityClient(conn)}
+// NewClient constructs a Client using an existing gRPC connection. Tokens are
+// only accepted when they are intended for audience; an empty audience
+// accepts any token issued by identity-api.
+func NewClient(conn *grpc.ClientConn, audience string) *Client {
+	return &Client{identity: identitypb.NewIdentityClient(conn), audience: audience}
 }
 
 // Validate asks identity-api to validate the provided token string.
 func (c *Client) Validate(ctx context.Context, token string) (*Claims, error) {
-	resp, err := c.identity.ValidateToken(ctx, &identitypb.ValidateTokenRequest{Token: token})
+	req := &identitypb.ValidateTokenRequest{Token: token}
+	if c.audience != "" {
+		req.Audience = &c.audience
+	}
+	resp, err := c.identity.ValidateToken(ctx, req)
 	if err != nil {
 		return nil, err
 	}
+	return claimsFromResult(resp)
+}
+
+// validationResult is implemented by the unary and streaming validation
+// responses of identity-api.
+type validationResult interface {
+	GetValid() bool
+	GetReason() string
+	GetUserId() string
+	GetEmail() string
+	GetActor() *identitypb.Actor
+	GetClaims() map[string]string
+}
+
+func claimsFromResult(resp validationResult) (*Claims, error) {
 	if !resp.GetValid() {
-		if resp.Reason != nil {
-			return nil, fmt.Errorf("%s", *resp.Reason)
+		if reason := resp.GetReason(); reason != "" {
+			return nil, fmt.Errorf("%s", reason)
 		}
 		return nil, fmt.Errorf("token validation failed")
 	}
-	return &Claims{UserID: resp.GetUserId(), Email: resp.GetEmail()}, nil
+	claims := &Claims{UserID: resp.GetUserId(), Email: resp.GetEmail(), Custom: resp.GetClaims()}
+	if act := resp.GetActor(); act != nil {
+		claims.Actor = &Actor{UserID: act.GetUserId(), Email: act.GetEmail()}
+	}
+	return claims, nil
 }
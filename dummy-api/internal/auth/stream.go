@@ -0,0 +1,272 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	identitypb "github.com/vidwadeseram/go-boilerplate/identity-api/gen/grpc/identity/pb"
+	"google.golang.org/grpc"
+)
+
+// errValidatorClosed is returned by StreamValidator.Validate after Close.
+var errValidatorClosed = errors.New("stream validator closed")
+
+// StreamValidator validates tokens over a fixed pool of long-lived
+// ValidateTokenStream streams instead of issuing one unary RPC per token.
+// Requests are spread over the pool round-robin, and a stream that breaks is
+// reopened on its next use.
+type StreamValidator struct {
+	audience string
+	streams  []*validationStream
+	next     atomic.Uint64
+}
+
+// NewStreamValidator constructs a StreamValidator with size streams on an
+// existing gRPC connection. Streams are opened lazily. Tokens are only
+// accepted when they are intended for audience; an empty audience accepts
+// any token issued by identity-api.
+func NewStreamValidator(conn *grpc.ClientConn, audience string, size int) *StreamValidator {
+	if size <= 0 {
+		size = 1
+	}
+	client := identitypb.NewIdentityClient(conn)
+	v := &StreamValidator{audience: audience, streams: make([]*validationStream, size)}
+	for i := range v.streams {
+		v.streams[i] = &validationStream{client: client}
+	}
+	return v
+}
+
+// Validate asks identity-api to validate the provided token string.
+func (v *StreamValidator) Validate(ctx context.Context, token string) (*Claims, error) {
+	req := &identitypb.ValidateTokenStreamStreamingRequest{Token: token}
+	if v.audience != "" {
+		req.Audience = &v.audience
+	}
+	stream := v.streams[v.next.Add(1)%uint64(len(v.streams))]
+	result, err := stream.validate(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return claimsFromResult(result)
+}
+
+// Close ends every stream in the pool. Pending validations fail.
+func (v *StreamValidator) Close() error {
+	for _, stream := range v.streams {
+		stream.close()
+	}
+	return nil
+}
+
+// validationStream multiplexes concurrent validations over one stream,
+// matching responses to requests by id.
+type validationStream struct {
+	client identitypb.IdentityClient
+
+	// opening serialises opening streams so that concurrent callers share
+	// the one that gets opened.
+	opening sync.Mutex
+
+	// mu guards the fields below and the pending map of the current stream.
+	// It is never held while talking to identity-api.
+	mu      sync.Mutex
+	current *streamConn
+	seq     uint64
+	closed  bool
+}
+
+// streamConn is one opened stream. gRPC streams do not support concurrent
+// sends, so requests are queued to a single sender. ctx is done once the
+// stream has failed or been closed, and pending is set to nil once the
+// receiver has failed the requests still waiting on it.
+type streamConn struct {
+	stream   identitypb.Identity_ValidateTokenStreamClient
+	ctx      context.Context
+	cancel   context.CancelFunc
+	requests chan *identitypb.ValidateTokenStreamStreamingRequest
+	pending  map[string]chan streamReply
+}
+
+type streamReply struct {
+	result *identitypb.ValidationResult
+	err    error
+}
+
+func (s *validationStream) validate(ctx context.Context, req *identitypb.ValidateTokenStreamStreamingRequest) (*identitypb.ValidationResult, error) {
+	conn, err := s.conn()
+	if err != nil {
+		return nil, err
+	}
+
+	reply := make(chan streamReply, 1)
+	s.mu.Lock()
+	if conn.pending == nil {
+		s.mu.Unlock()
+		return nil, errors.New("validation stream failed")
+	}
+	s.seq++
+	id := strconv.FormatUint(s.seq, 10)
+	req.Id = &id
+	conn.pending[id] = reply
+	s.mu.Unlock()
+
+	select {
+	case conn.requests <- req:
+	case <-conn.ctx.Done():
+		// The stream failed before the request was sent; the receiver
+		// fails it together with the other pending requests.
+	case <-ctx.Done():
+		s.forget(conn, id)
+		return nil, ctx.Err()
+	}
+
+	select {
+	case r := <-reply:
+		return r.result, r.err
+	case <-ctx.Done():
+		s.forget(conn, id)
+		return nil, ctx.Err()
+	}
+}
+
+// conn returns the current stream, opening one when there is none.
+func (s *validationStream) conn() (*streamConn, error) {
+	if conn, err := s.currentConn(); conn != nil || err != nil {
+		return conn, err
+	}
+
+	s.opening.Lock()
+	defer s.opening.Unlock()
+	if conn, err := s.currentConn(); conn != nil || err != nil {
+		return conn, err
+	}
+
+	conn, err := s.open()
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		conn.cancel()
+		return nil, errValidatorClosed
+	}
+	s.current = conn
+	return conn, nil
+}
+
+func (s *validationStream) currentConn() (*streamConn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil, errValidatorClosed
+	}
+	return s.current, nil
+}
+
+// forget drops a request that is no longer waited for.
+func (s *validationStream) forget(conn *streamConn, id string) {
+	s.mu.Lock()
+	if conn.pending != nil {
+		delete(conn.pending, id)
+	}
+	s.mu.Unlock()
+}
+
+// open starts a new stream with its sender and receiver.
+func (s *validationStream) open() (*streamConn, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := s.client.ValidateTokenStream(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("open validation stream: %w", err)
+	}
+	conn := &streamConn{
+		stream:   stream,
+		ctx:      ctx,
+		cancel:   cancel,
+		requests: make(chan *identitypb.ValidateTokenStreamStreamingRequest),
+		pending:  make(map[string]chan streamReply),
+	}
+	go s.send(conn)
+	go s.receive(conn)
+	return conn, nil
+}
+
+// send writes queued requests to the stream until it fails or is closed.
+func (s *validationStream) send(conn *streamConn) {
+	for {
+		select {
+		case req := <-conn.requests:
+			if err := conn.stream.Send(req); err != nil {
+				// The receiver observes the cancellation and fails the
+				// pending requests of this stream.
+				conn.cancel()
+				return
+			}
+		case <-conn.ctx.Done():
+			return
+		}
+	}
+}
+
+// receive delivers responses to the waiting requests until the stream fails,
+// then fails every request still pending on it.
+func (s *validationStream) receive(conn *streamConn) {
+	for {
+		resp, err := conn.stream.Recv()
+		if err != nil {
+			s.mu.Lock()
+			if s.current == conn {
+				s.current = nil
+			}
+			pending := conn.pending
+			conn.pending = nil
+			s.mu.Unlock()
+
+			conn.cancel()
+			for _, reply := range pending {
+				reply <- streamReply{err: fmt.Errorf("validation stream: %w", err)}
+			}
+			return
+		}
+
+		s.mu.Lock()
+		reply, ok := conn.pending[resp.GetId()]
+		delete(conn.pending, resp.GetId())
+		s.mu.Unlock()
+
+		if !ok {
+			continue
+		}
+		if resp.GetResult() == nil {
+			reason := resp.GetError()
+			if reason == "" {
+				reason = "no result"
+			}
+			reply <- streamReply{err: fmt.Errorf("validation stream: %s", reason)}
+			continue
+		}
+		reply <- streamReply{result: resp.GetResult()}
+	}
+}
+
+// close ends the current stream. Its sender may be in the middle of a Send,
+// which must not run concurrently with CloseSend, so the stream is cancelled
+// instead of half-closed.
+func (s *validationStream) close() {
+	s.mu.Lock()
+	s.closed = true
+	conn := s.current
+	s.current = nil
+	s.mu.Unlock()
+
+	if conn != nil {
+		conn.cancel()
+	}
+}
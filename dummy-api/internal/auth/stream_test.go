@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	identitypb "github.com/vidwadeseram/go-boilerplate/identity-api/gen/grpc/identity/pb"
+	"google.golang.org/grpc"
+)
+
+// fakeIdentityClient opens fakeStreams; the other IdentityClient methods are
+// not used by StreamValidator.
+type fakeIdentityClient struct {
+	identitypb.IdentityClient
+	stream *fakeStream
+}
+
+func (c *fakeIdentityClient) ValidateTokenStream(ctx context.Context, _ ...grpc.CallOption) (grpc.BidiStreamingClient[identitypb.ValidateTokenStreamStreamingRequest, identitypb.ValidateTokenStreamResponse], error) {
+	c.stream.ctx = ctx
+	return c.stream, nil
+}
+
+// fakeStream hands sent requests to the test and returns the responses the
+// test queues. When block is set, every Send after the first free ones
+// blocks until block is closed.
+type fakeStream struct {
+	grpc.ClientStream
+	ctx       context.Context
+	sent      chan *identitypb.ValidateTokenStreamStreamingRequest
+	responses chan *identitypb.ValidateTokenStreamResponse
+	block     chan struct{}
+	free      int
+}
+
+func newFakeStream() *fakeStream {
+	return &fakeStream{
+		sent:      make(chan *identitypb.ValidateTokenStreamStreamingRequest, 10),
+		responses: make(chan *identitypb.ValidateTokenStreamResponse, 10),
+	}
+}
+
+func (s *fakeStream) Send(req *identitypb.ValidateTokenStreamStreamingRequest) error {
+	s.sent <- req
+	if s.free > 0 {
+		s.free--
+		return nil
+	}
+	if s.block != nil {
+		select {
+		case <-s.block:
+		case <-s.ctx.Done():
+			return s.ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (s *fakeStream) Recv() (*identitypb.ValidateTokenStreamResponse, error) {
+	select {
+	case resp := <-s.responses:
+		return resp, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func validResponse(id, userID string) *identitypb.ValidateTokenStreamResponse {
+	return &identitypb.ValidateTokenStreamResponse{
+		Id:     &id,
+		Result: &identitypb.ValidationResult{Valid: true, UserId: &userID},
+	}
+}
+
+func newFakeValidator(stream *fakeStream) *StreamValidator {
+	return &StreamValidator{streams: []*validationStream{{client: &fakeIdentityClient{stream: stream}}}}
+}
+
+func TestStreamValidatorDeliversWhileSendBlocks(t *testing.T) {
+	stream := newFakeStream()
+	stream.block = make(chan struct{})
+	stream.free = 1
+	defer close(stream.block)
+	v := newFakeValidator(stream)
+	defer v.Close()
+
+	type result struct {
+		claims *Claims
+		err    error
+	}
+	first := make(chan result, 1)
+	go func() {
+		claims, err := v.Validate(context.Background(), "first")
+		first <- result{claims, err}
+	}()
+	firstReq := <-stream.sent
+
+	// The next Send stalls, as it does when the stream is flow controlled.
+	// The response to the first request must still be delivered.
+	go func() { _, _ = v.Validate(context.Background(), "second") }()
+	<-stream.sent
+
+	stream.responses <- validResponse(firstReq.GetId(), "user-1")
+	select {
+	case r := <-first:
+		if r.err != nil || r.claims.UserID != "user-1" {
+			t.Fatalf("Validate() = %+v, %v, want user-1", r.claims, r.err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("response not delivered while another request was being sent")
+	}
+}
+
+func TestStreamValidatorHonoursContextWhileSendBlocks(t *testing.T) {
+	stream := newFakeStream()
+	stream.block = make(chan struct{})
+	defer close(stream.block)
+	v := newFakeValidator(stream)
+	defer v.Close()
+
+	go func() { _, _ = v.Validate(context.Background(), "stalled") }()
+	<-stream.sent
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() {
+		_, err := v.Validate(ctx, "queued")
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("Validate() error = %v, want %v", err, context.DeadlineExceeded)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Validate ignored its context while the stream was blocked")
+	}
+}
+
+func TestStreamValidatorFailsPendingOnClose(t *testing.T) {
+	stream := newFakeStream()
+	v := newFakeValidator(stream)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := v.Validate(context.Background(), "token")
+		done <- err
+	}()
+	<-stream.sent
+	v.Close()
+
+	select {
+	case err := <-done:
+		if err == nil {
+			t.Fatal("Validate() succeeded after Close")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("pending validation not failed by Close")
+	}
+	if _, err := v.Validate(context.Background(), "token"); !errors.Is(err, errValidatorClosed) {
+		t.Errorf("Validate() after Close error = %v, want %v", err, errValidatorClosed)
+	}
+}
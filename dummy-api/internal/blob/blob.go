@@ -0,0 +1,34 @@
+// Package blob stores attachment content outside the database.
+package blob
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrNotFound is returned by Get for keys that hold no object.
+var ErrNotFound = errors.New("blob not found")
+
+// Info describes an object being stored.
+type Info struct {
+	// Size is the exact number of bytes the body yields.
+	Size int64
+	// ContentType is stored alongside the object where the backend supports it.
+	ContentType string
+	// ChecksumSHA256 is the hex-encoded SHA-256 of the body. Backends that
+	// can verify it reject a body that does not match.
+	ChecksumSHA256 string
+}
+
+// Store keeps objects under slash-separated keys. Implementations must be
+// safe for concurrent use.
+type Store interface {
+	// Put stores the body under key, replacing any existing object.
+	Put(ctx context.Context, key string, body io.Reader, info Info) error
+	// Get opens the object stored under key. The caller closes the reader.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the object stored under key. Deleting a missing object
+	// is not an error.
+	Delete(ctx context.Context, key string) error
+}
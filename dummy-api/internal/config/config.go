@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -12,6 +13,35 @@ type Config struct {
 	GRPCAddr           string `envconfig:"DUMMY_GRPC_ADDR" default:":9082"`
 	DatabaseURL        string `envconfig:"DUMMY_DATABASE_URL" required:"true"`
 	IdentityGRPCTarget string `envconfig:"DUMMY_IDENTITY_GRPC_TARGET" default:"localhost:9081"`
+	// TokenAudience is the audience access tokens must be issued for.
+	TokenAudience string `envconfig:"DUMMY_TOKEN_AUDIENCE" default:"dummy-api"`
+	// TokenValidationStreams selects stream-backed token validation with a
+	// pool of this many ValidateTokenStream streams; 0 uses unary calls.
+	TokenValidationStreams int `envconfig:"DUMMY_TOKEN_VALIDATION_STREAMS" default:"0"`
+	// SearchLanguage is the PostgreSQL text search configuration used to
+	// stem items and queries that do not name a language.
+	SearchLanguage string `envconfig:"DUMMY_SEARCH_LANGUAGE" default:"english"`
+	// Deleted items stay in the trash for TrashRetention before the purger,
+	// which runs every PurgeInterval, removes them; 0 keeps them forever.
+	TrashRetention time.Duration `envconfig:"DUMMY_TRASH_RETENTION" default:"720h"`
+	PurgeInterval  time.Duration `envconfig:"DUMMY_PURGE_INTERVAL" default:"1h"`
+	// BlobBackend selects where attachment content is stored: "local" keeps
+	// it below BlobDir and "s3" in an S3-compatible bucket.
+	BlobBackend string `envconfig:"DUMMY_BLOB_BACKEND" default:"local"`
+	BlobDir     string `envconfig:"DUMMY_BLOB_DIR" default:"./data/attachments"`
+	// The S3 settings configure the "s3" backend. S3PathStyle addresses the
+	// bucket in the URL path, as MinIO expects.
+	S3Endpoint        string `envconfig:"DUMMY_S3_ENDPOINT"`
+	S3Region          string `envconfig:"DUMMY_S3_REGION" default:"us-east-1"`
+	S3Bucket          string `envconfig:"DUMMY_S3_BUCKET"`
+	S3AccessKeyID     string `envconfig:"DUMMY_S3_ACCESS_KEY_ID"`
+	S3SecretAccessKey string `envconfig:"DUMMY_S3_SECRET_ACCESS_KEY"`
+	S3PathStyle       bool   `envconfig:"DUMMY_S3_PATH_STYLE" default:"true"`
+	// AttachmentMaxSize bounds uploads in bytes. AttachmentContentTypes
+	// lists the sniffed media types accepted, such as "image/*"; empty
+	// accepts any.
+	AttachmentMaxSize      int64    `envconfig:"DUMMY_ATTACHMENT_MAX_SIZE" default:"10485760"`
+	AttachmentContentTypes []string `envconfig:"DUMMY_ATTACHMENT_CONTENT_TYPES"`
 }
 
 // Load retrieves configuration from environment variables.
@@ -0,0 +1,25 @@
+-- name: CreateAttachment :one
+INSERT INTO attachments (
+    id,
+    item_id,
+    filename,
+    content_type,
+    size,
+    checksum_sha256,
+    storage_key,
+    created_by
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+) RETURNING *;
+
+-- name: GetAttachment :one
+SELECT * FROM attachments WHERE id = $1 AND item_id = $2;
+
+-- name: ListAttachments :many
+SELECT * FROM attachments
+WHERE item_id = $1
+ORDER BY created_at, id;
+
+-- name: DeleteAttachment :one
+DELETE FROM attachments WHERE id = $1 AND item_id = $2
+RETURNING storage_key;
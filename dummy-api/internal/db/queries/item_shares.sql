@@ -0,0 +1,18 @@
+-- name: UpsertItemShare :one
+INSERT INTO item_shares (
+    item_id,
+    user_id,
+    permission
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (item_id, user_id) DO UPDATE SET permission = EXCLUDED.permission
+RETURNING *;
+
+-- name: DeleteItemShare :execrows
+DELETE FROM item_shares WHERE item_id = $1 AND user_id = $2;
+
+-- name: ListItemShares :many
+SELECT * FROM item_shares
+WHERE item_id = $1
+ORDER BY created_at, user_id;
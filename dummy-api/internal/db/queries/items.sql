@@ -2,19 +2,195 @@
 INSERT INTO items (
     owner_id,
     name,
-    description
+    description,
+    search_language
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4
 ) RETURNING *;
 
+-- The list queries share visible_items: the caller's own items, read in
+-- keyset order through idx_items_owner, and the items shared with them, each
+-- tagged with the caller's permission. The filters and the keyset cursor are
+-- applied once over that set, so keep them the same in all three queries.
 -- name: ListItems :many
-SELECT * FROM items
-WHERE owner_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3;
+WITH visible_items AS (
+    SELECT items.id, items.created_at, 'owner'::text AS permission
+    FROM items
+    WHERE items.owner_id = sqlc.arg(user_id)
+    UNION ALL
+    SELECT items.id, items.created_at, item_shares.permission::text
+    FROM item_shares
+    JOIN items ON items.id = item_shares.item_id
+    WHERE item_shares.user_id = sqlc.arg(user_id)
+      AND items.owner_id <> sqlc.arg(user_id)
+)
+SELECT sqlc.embed(items), visible_items.permission
+FROM visible_items
+JOIN items ON items.id = visible_items.id
+WHERE items.deleted_at IS NULL
+  AND (sqlc.narg(name_prefix)::text IS NULL OR starts_with(lower(items.name), lower(sqlc.narg(name_prefix))))
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR items.created_at >= sqlc.narg(created_after))
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR items.created_at < sqlc.narg(created_before))
+  AND (sqlc.narg(tags)::text[] IS NULL OR (
+        SELECT COUNT(*) FROM item_tags
+        JOIN tags ON tags.id = item_tags.tag_id
+        WHERE item_tags.item_id = items.id AND lower(tags.name) = ANY(sqlc.narg(tags)::text[])
+      ) >= CASE WHEN sqlc.arg(match_all)::boolean THEN cardinality(sqlc.narg(tags)::text[]) ELSE 1 END)
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (visible_items.created_at, visible_items.id) < (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::uuid))
+ORDER BY visible_items.created_at DESC, visible_items.id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListItemsAscending :many
+WITH visible_items AS (
+    SELECT items.id, items.created_at, 'owner'::text AS permission
+    FROM items
+    WHERE items.owner_id = sqlc.arg(user_id)
+    UNION ALL
+    SELECT items.id, items.created_at, item_shares.permission::text
+    FROM item_shares
+    JOIN items ON items.id = item_shares.item_id
+    WHERE item_shares.user_id = sqlc.arg(user_id)
+      AND items.owner_id <> sqlc.arg(user_id)
+)
+SELECT sqlc.embed(items), visible_items.permission
+FROM visible_items
+JOIN items ON items.id = visible_items.id
+WHERE items.deleted_at IS NULL
+  AND (sqlc.narg(name_prefix)::text IS NULL OR starts_with(lower(items.name), lower(sqlc.narg(name_prefix))))
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR items.created_at >= sqlc.narg(created_after))
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR items.created_at < sqlc.narg(created_before))
+  AND (sqlc.narg(tags)::text[] IS NULL OR (
+        SELECT COUNT(*) FROM item_tags
+        JOIN tags ON tags.id = item_tags.tag_id
+        WHERE item_tags.item_id = items.id AND lower(tags.name) = ANY(sqlc.narg(tags)::text[])
+      ) >= CASE WHEN sqlc.arg(match_all)::boolean THEN cardinality(sqlc.narg(tags)::text[]) ELSE 1 END)
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (visible_items.created_at, visible_items.id) > (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::uuid))
+ORDER BY visible_items.created_at ASC, visible_items.id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountItems :one
+WITH visible_items AS (
+    SELECT items.id, items.created_at, 'owner'::text AS permission
+    FROM items
+    WHERE items.owner_id = sqlc.arg(user_id)
+    UNION ALL
+    SELECT items.id, items.created_at, item_shares.permission::text
+    FROM item_shares
+    JOIN items ON items.id = item_shares.item_id
+    WHERE item_shares.user_id = sqlc.arg(user_id)
+      AND items.owner_id <> sqlc.arg(user_id)
+)
+SELECT COUNT(*)
+FROM visible_items
+JOIN items ON items.id = visible_items.id
+WHERE items.deleted_at IS NULL
+  AND (sqlc.narg(name_prefix)::text IS NULL OR starts_with(lower(items.name), lower(sqlc.narg(name_prefix))))
+  AND (sqlc.narg(created_after)::timestamptz IS NULL OR items.created_at >= sqlc.narg(created_after))
+  AND (sqlc.narg(created_before)::timestamptz IS NULL OR items.created_at < sqlc.narg(created_before))
+  AND (sqlc.narg(tags)::text[] IS NULL OR (
+        SELECT COUNT(*) FROM item_tags
+        JOIN tags ON tags.id = item_tags.tag_id
+        WHERE item_tags.item_id = items.id AND lower(tags.name) = ANY(sqlc.narg(tags)::text[])
+      ) >= CASE WHEN sqlc.arg(match_all)::boolean THEN cardinality(sqlc.narg(tags)::text[]) ELSE 1 END);
 
 -- name: GetItem :one
-SELECT * FROM items WHERE id = $1 AND owner_id = $2;
+SELECT sqlc.embed(items),
+       CASE WHEN items.owner_id = sqlc.arg(user_id) THEN 'owner' ELSE item_shares.permission END::text AS permission
+FROM items
+LEFT JOIN item_shares ON item_shares.item_id = items.id AND item_shares.user_id = sqlc.arg(user_id)
+WHERE items.id = sqlc.arg(id)
+  AND (items.owner_id = sqlc.arg(user_id) OR item_shares.user_id IS NOT NULL)
+  AND items.deleted_at IS NULL;
+
+-- name: DeleteItem :execrows
+UPDATE items SET deleted_at = NOW() WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL;
+
+-- name: ExportItems :many
+SELECT * FROM items
+WHERE owner_id = sqlc.arg(owner_id)
+  AND deleted_at IS NULL
+  AND (sqlc.narg(cursor_created_at)::timestamptz IS NULL
+       OR (created_at, id) > (sqlc.narg(cursor_created_at), sqlc.narg(cursor_id)::uuid))
+ORDER BY created_at ASC, id ASC
+LIMIT sqlc.arg(page_size);
+
+-- name: UpdateItem :one
+UPDATE items
+SET name = $3,
+    description = $4,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND owner_id = $2 AND version = $5 AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SearchItems :many
+SELECT
+    id,
+    owner_id,
+    name,
+    description,
+    created_at,
+    version,
+    updated_at,
+    search_language,
+    ts_rank_cd(search_vector, websearch_to_tsquery(sqlc.arg(language)::regconfig, sqlc.arg(query)))::real AS rank,
+    ts_headline(
+        sqlc.arg(language)::regconfig,
+        replace(replace(replace(concat_ws(' - ', name, description), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+        websearch_to_tsquery(sqlc.arg(language)::regconfig, sqlc.arg(query)),
+        'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MinWords=5, MaxWords=20'
+    ) AS snippet
+FROM items
+WHERE owner_id = sqlc.arg(owner_id)
+  AND deleted_at IS NULL
+  AND search_vector @@ websearch_to_tsquery(sqlc.arg(language)::regconfig, sqlc.arg(query))
+  AND (sqlc.narg(cursor_rank)::real IS NULL
+       OR (ts_rank_cd(search_vector, websearch_to_tsquery(sqlc.arg(language)::regconfig, sqlc.arg(query)))::real, id) < (sqlc.narg(cursor_rank), sqlc.narg(cursor_id)::uuid))
+ORDER BY rank DESC, id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: ListTrash :many
+SELECT * FROM items
+WHERE owner_id = sqlc.arg(owner_id)
+  AND deleted_at IS NOT NULL
+  AND (sqlc.narg(cursor_deleted_at)::timestamptz IS NULL
+       OR (deleted_at, id) < (sqlc.narg(cursor_deleted_at), sqlc.narg(cursor_id)::uuid))
+ORDER BY deleted_at DESC, id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: RestoreItem :one
+UPDATE items
+SET deleted_at = NULL
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- The purge queries return the storage keys of the attachments that went
+-- with the purged items, one row per attachment, so that their content can be
+-- removed from blob storage. Items without attachments yield a NULL key.
+
+-- name: PurgeItem :many
+WITH purged AS (
+    DELETE FROM items
+    WHERE items.id = $1 AND items.owner_id = $2 AND items.deleted_at IS NOT NULL
+    RETURNING items.id
+)
+SELECT purged.id, attachments.storage_key
+FROM purged
+LEFT JOIN attachments ON attachments.item_id = purged.id;
 
--- name: DeleteItem :exec
-DELETE FROM items WHERE id = $1 AND owner_id = $2;
+-- name: PurgeDeletedItems :many
+WITH purged AS (
+    DELETE FROM items
+    WHERE items.id IN (
+        SELECT expired.id FROM items AS expired
+        WHERE expired.deleted_at < sqlc.arg(deleted_before)
+        ORDER BY expired.deleted_at
+        LIMIT sqlc.arg(batch_size)
+    )
+    RETURNING items.id
+)
+SELECT purged.id, attachments.storage_key
+FROM purged
+LEFT JOIN attachments ON attachments.item_id = purged.id;
@@ -0,0 +1,38 @@
+-- name: UpsertTag :one
+INSERT INTO tags (
+    owner_id,
+    name
+) VALUES (
+    $1, $2
+)
+ON CONFLICT (owner_id, lower(name)) DO UPDATE SET name = tags.name
+RETURNING *;
+
+-- name: AddItemTag :exec
+INSERT INTO item_tags (item_id, tag_id) VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveItemTag :execrows
+DELETE FROM item_tags
+USING tags
+WHERE item_tags.tag_id = tags.id
+  AND item_tags.item_id = sqlc.arg(item_id)
+  AND lower(tags.name) = lower(sqlc.arg(name));
+
+-- name: ListItemTags :many
+SELECT item_tags.item_id, tags.name
+FROM item_tags
+JOIN tags ON tags.id = item_tags.tag_id
+WHERE item_tags.item_id = ANY(sqlc.arg(item_ids)::uuid[])
+ORDER BY item_tags.item_id, lower(tags.name);
+
+-- name: SuggestTags :many
+SELECT tags.name, COUNT(items.id) AS item_count
+FROM tags
+JOIN item_tags ON item_tags.tag_id = tags.id
+JOIN items ON items.id = item_tags.item_id AND items.deleted_at IS NULL
+WHERE tags.owner_id = sqlc.arg(owner_id)
+  AND starts_with(lower(tags.name), lower(sqlc.arg(prefix)))
+GROUP BY tags.id
+ORDER BY item_count DESC, lower(tags.name)
+LIMIT sqlc.arg(max_results);
@@ -11,24 +11,80 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countItems = `-- name: CountItems :one
+WITH visible_items AS (
+    SELECT items.id, items.created_at, 'owner'::text AS permission
+    FROM items
+    WHERE items.owner_id = $1
+    UNION ALL
+    SELECT items.id, items.created_at, item_shares.permission::text
+    FROM item_shares
+    JOIN items ON items.id = item_shares.item_id
+    WHERE item_shares.user_id = $1
+      AND items.owner_id <> $1
+)
+SELECT COUNT(*)
+FROM visible_items
+JOIN items ON items.id = visible_items.id
+WHERE items.deleted_at IS NULL
+  AND ($2::text IS NULL OR starts_with(lower(items.name), lower($2)))
+  AND ($3::timestamptz IS NULL OR items.created_at >= $3)
+  AND ($4::timestamptz IS NULL OR items.created_at < $4)
+  AND ($5::text[] IS NULL OR (
+        SELECT COUNT(*) FROM item_tags
+        JOIN tags ON tags.id = item_tags.tag_id
+        WHERE item_tags.item_id = items.id AND lower(tags.name) = ANY($5::text[])
+      ) >= CASE WHEN $6::boolean THEN cardinality($5::text[]) ELSE 1 END)
+`
+
+type CountItemsParams struct {
+	UserID        pgtype.UUID        `json:"user_id"`
+	NamePrefix    *string            `json:"name_prefix"`
+	CreatedAfter  pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore pgtype.Timestamptz `json:"created_before"`
+	Tags          []string           `json:"tags"`
+	MatchAll      bool               `json:"match_all"`
+}
+
+func (q *Queries) CountItems(ctx context.Context, arg CountItemsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countItems,
+		arg.UserID,
+		arg.NamePrefix,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Tags,
+		arg.MatchAll,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createItem = `-- name: CreateItem :one
 INSERT INTO items (
     owner_id,
     name,
-    description
+    description,
+    search_language
 ) VALUES (
-    $1, $2, $3
-) RETURNING id, owner_id, name, description, created_at
+    $1, $2, $3, $4
+) RETURNING id, owner_id, name, description, created_at, version, updated_at, search_language, search_vector, deleted_at
 `
 
 type CreateItemParams struct {
-	OwnerID     pgtype.UUID `json:"owner_id"`
-	Name        string      `json:"name"`
-	Description *string     `json:"description"`
+	OwnerID        pgtype.UUID `json:"owner_id"`
+	Name           string      `json:"name"`
+	Description    *string     `json:"description"`
+	SearchLanguage string      `json:"search_language"`
 }
 
 func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) (Item, error) {
-	row := q.db.QueryRow(ctx, createItem, arg.OwnerID, arg.Name, arg.Description)
+	row := q.db.QueryRow(ctx, createItem,
+		arg.OwnerID,
+		arg.Name,
+		arg.Description,
+		arg.SearchLanguage,
+	)
 	var i Item
 	err := row.Scan(
 		&i.ID,
@@ -36,12 +92,17 @@ func (q *Queries) CreateItem(ctx context.Context, arg CreateItemParams) (Item, e
 		&i.Name,
 		&i.Description,
 		&i.CreatedAt,
+		&i.Version,
+		&i.UpdatedAt,
+		&i.SearchLanguage,
+		&i.SearchVector,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteItem = `-- name: DeleteItem :exec
-DELETE FROM items WHERE id = $1 AND owner_id = $2
+const deleteItem = `-- name: DeleteItem :execrows
+UPDATE items SET deleted_at = NOW() WHERE id = $1 AND owner_id = $2 AND deleted_at IS NULL
 `
 
 type DeleteItemParams struct {
@@ -49,22 +110,438 @@ type DeleteItemParams struct {
 	OwnerID pgtype.UUID `json:"owner_id"`
 }
 
-func (q *Queries) DeleteItem(ctx context.Context, arg DeleteItemParams) error {
-	_, err := q.db.Exec(ctx, deleteItem, arg.ID, arg.OwnerID)
-	return err
+func (q *Queries) DeleteItem(ctx context.Context, arg DeleteItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteItem, arg.ID, arg.OwnerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const exportItems = `-- name: ExportItems :many
+SELECT id, owner_id, name, description, created_at, version, updated_at, search_language, search_vector, deleted_at FROM items
+WHERE owner_id = $1
+  AND deleted_at IS NULL
+  AND ($2::timestamptz IS NULL
+       OR (created_at, id) > ($2, $3::uuid))
+ORDER BY created_at ASC, id ASC
+LIMIT $4
+`
+
+type ExportItemsParams struct {
+	OwnerID         pgtype.UUID        `json:"owner_id"`
+	CursorCreatedAt pgtype.Timestamptz `json:"cursor_created_at"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	PageSize        int32              `json:"page_size"`
+}
+
+func (q *Queries) ExportItems(ctx context.Context, arg ExportItemsParams) ([]Item, error) {
+	rows, err := q.db.Query(ctx, exportItems,
+		arg.OwnerID,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Item
+	for rows.Next() {
+		var i Item
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.Version,
+			&i.UpdatedAt,
+			&i.SearchLanguage,
+			&i.SearchVector,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const getItem = `-- name: GetItem :one
-SELECT id, owner_id, name, description, created_at FROM items WHERE id = $1 AND owner_id = $2
+SELECT items.id, items.owner_id, items.name, items.description, items.created_at, items.version, items.updated_at, items.search_language, items.search_vector, items.deleted_at,
+       CASE WHEN items.owner_id = $1 THEN 'owner' ELSE item_shares.permission END::text AS permission
+FROM items
+LEFT JOIN item_shares ON item_shares.item_id = items.id AND item_shares.user_id = $1
+WHERE items.id = $2
+  AND (items.owner_id = $1 OR item_shares.user_id IS NOT NULL)
+  AND items.deleted_at IS NULL
 `
 
 type GetItemParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	ID     pgtype.UUID `json:"id"`
+}
+
+type GetItemRow struct {
+	Item       Item   `json:"item"`
+	Permission string `json:"permission"`
+}
+
+func (q *Queries) GetItem(ctx context.Context, arg GetItemParams) (GetItemRow, error) {
+	row := q.db.QueryRow(ctx, getItem, arg.UserID, arg.ID)
+	var i GetItemRow
+	err := row.Scan(
+		&i.Item.ID,
+		&i.Item.OwnerID,
+		&i.Item.Name,
+		&i.Item.Description,
+		&i.Item.CreatedAt,
+		&i.Item.Version,
+		&i.Item.UpdatedAt,
+		&i.Item.SearchLanguage,
+		&i.Item.SearchVector,
+		&i.Item.DeletedAt,
+		&i.Permission,
+	)
+	return i, err
+}
+
+const listItems = `-- name: ListItems :many
+WITH visible_items AS (
+    SELECT items.id, items.created_at, 'owner'::text AS permission
+    FROM items
+    WHERE items.owner_id = $1
+    UNION ALL
+    SELECT items.id, items.created_at, item_shares.permission::text
+    FROM item_shares
+    JOIN items ON items.id = item_shares.item_id
+    WHERE item_shares.user_id = $1
+      AND items.owner_id <> $1
+)
+SELECT items.id, items.owner_id, items.name, items.description, items.created_at, items.version, items.updated_at, items.search_language, items.search_vector, items.deleted_at, visible_items.permission
+FROM visible_items
+JOIN items ON items.id = visible_items.id
+WHERE items.deleted_at IS NULL
+  AND ($2::text IS NULL OR starts_with(lower(items.name), lower($2)))
+  AND ($3::timestamptz IS NULL OR items.created_at >= $3)
+  AND ($4::timestamptz IS NULL OR items.created_at < $4)
+  AND ($5::text[] IS NULL OR (
+        SELECT COUNT(*) FROM item_tags
+        JOIN tags ON tags.id = item_tags.tag_id
+        WHERE item_tags.item_id = items.id AND lower(tags.name) = ANY($5::text[])
+      ) >= CASE WHEN $6::boolean THEN cardinality($5::text[]) ELSE 1 END)
+  AND ($7::timestamptz IS NULL
+       OR (visible_items.created_at, visible_items.id) < ($7, $8::uuid))
+ORDER BY visible_items.created_at DESC, visible_items.id DESC
+LIMIT $9
+`
+
+type ListItemsParams struct {
+	UserID          pgtype.UUID        `json:"user_id"`
+	NamePrefix      *string            `json:"name_prefix"`
+	CreatedAfter    pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore   pgtype.Timestamptz `json:"created_before"`
+	Tags            []string           `json:"tags"`
+	MatchAll        bool               `json:"match_all"`
+	CursorCreatedAt pgtype.Timestamptz `json:"cursor_created_at"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	PageSize        int32              `json:"page_size"`
+}
+
+type ListItemsRow struct {
+	Item       Item   `json:"item"`
+	Permission string `json:"permission"`
+}
+
+func (q *Queries) ListItems(ctx context.Context, arg ListItemsParams) ([]ListItemsRow, error) {
+	rows, err := q.db.Query(ctx, listItems,
+		arg.UserID,
+		arg.NamePrefix,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Tags,
+		arg.MatchAll,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListItemsRow
+	for rows.Next() {
+		var i ListItemsRow
+		if err := rows.Scan(
+			&i.Item.ID,
+			&i.Item.OwnerID,
+			&i.Item.Name,
+			&i.Item.Description,
+			&i.Item.CreatedAt,
+			&i.Item.Version,
+			&i.Item.UpdatedAt,
+			&i.Item.SearchLanguage,
+			&i.Item.SearchVector,
+			&i.Item.DeletedAt,
+			&i.Permission,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listItemsAscending = `-- name: ListItemsAscending :many
+WITH visible_items AS (
+    SELECT items.id, items.created_at, 'owner'::text AS permission
+    FROM items
+    WHERE items.owner_id = $1
+    UNION ALL
+    SELECT items.id, items.created_at, item_shares.permission::text
+    FROM item_shares
+    JOIN items ON items.id = item_shares.item_id
+    WHERE item_shares.user_id = $1
+      AND items.owner_id <> $1
+)
+SELECT items.id, items.owner_id, items.name, items.description, items.created_at, items.version, items.updated_at, items.search_language, items.search_vector, items.deleted_at, visible_items.permission
+FROM visible_items
+JOIN items ON items.id = visible_items.id
+WHERE items.deleted_at IS NULL
+  AND ($2::text IS NULL OR starts_with(lower(items.name), lower($2)))
+  AND ($3::timestamptz IS NULL OR items.created_at >= $3)
+  AND ($4::timestamptz IS NULL OR items.created_at < $4)
+  AND ($5::text[] IS NULL OR (
+        SELECT COUNT(*) FROM item_tags
+        JOIN tags ON tags.id = item_tags.tag_id
+        WHERE item_tags.item_id = items.id AND lower(tags.name) = ANY($5::text[])
+      ) >= CASE WHEN $6::boolean THEN cardinality($5::text[]) ELSE 1 END)
+  AND ($7::timestamptz IS NULL
+       OR (visible_items.created_at, visible_items.id) > ($7, $8::uuid))
+ORDER BY visible_items.created_at ASC, visible_items.id ASC
+LIMIT $9
+`
+
+type ListItemsAscendingParams struct {
+	UserID          pgtype.UUID        `json:"user_id"`
+	NamePrefix      *string            `json:"name_prefix"`
+	CreatedAfter    pgtype.Timestamptz `json:"created_after"`
+	CreatedBefore   pgtype.Timestamptz `json:"created_before"`
+	Tags            []string           `json:"tags"`
+	MatchAll        bool               `json:"match_all"`
+	CursorCreatedAt pgtype.Timestamptz `json:"cursor_created_at"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	PageSize        int32              `json:"page_size"`
+}
+
+type ListItemsAscendingRow struct {
+	Item       Item   `json:"item"`
+	Permission string `json:"permission"`
+}
+
+func (q *Queries) ListItemsAscending(ctx context.Context, arg ListItemsAscendingParams) ([]ListItemsAscendingRow, error) {
+	rows, err := q.db.Query(ctx, listItemsAscending,
+		arg.UserID,
+		arg.NamePrefix,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Tags,
+		arg.MatchAll,
+		arg.CursorCreatedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListItemsAscendingRow
+	for rows.Next() {
+		var i ListItemsAscendingRow
+		if err := rows.Scan(
+			&i.Item.ID,
+			&i.Item.OwnerID,
+			&i.Item.Name,
+			&i.Item.Description,
+			&i.Item.CreatedAt,
+			&i.Item.Version,
+			&i.Item.UpdatedAt,
+			&i.Item.SearchLanguage,
+			&i.Item.SearchVector,
+			&i.Item.DeletedAt,
+			&i.Permission,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTrash = `-- name: ListTrash :many
+SELECT id, owner_id, name, description, created_at, version, updated_at, search_language, search_vector, deleted_at FROM items
+WHERE owner_id = $1
+  AND deleted_at IS NOT NULL
+  AND ($2::timestamptz IS NULL
+       OR (deleted_at, id) < ($2, $3::uuid))
+ORDER BY deleted_at DESC, id DESC
+LIMIT $4
+`
+
+type ListTrashParams struct {
+	OwnerID         pgtype.UUID        `json:"owner_id"`
+	CursorDeletedAt pgtype.Timestamptz `json:"cursor_deleted_at"`
+	CursorID        pgtype.UUID        `json:"cursor_id"`
+	PageSize        int32              `json:"page_size"`
+}
+
+func (q *Queries) ListTrash(ctx context.Context, arg ListTrashParams) ([]Item, error) {
+	rows, err := q.db.Query(ctx, listTrash,
+		arg.OwnerID,
+		arg.CursorDeletedAt,
+		arg.CursorID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Item
+	for rows.Next() {
+		var i Item
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Name,
+			&i.Description,
+			&i.CreatedAt,
+			&i.Version,
+			&i.UpdatedAt,
+			&i.SearchLanguage,
+			&i.SearchVector,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeDeletedItems = `-- name: PurgeDeletedItems :many
+WITH purged AS (
+    DELETE FROM items
+    WHERE items.id IN (
+        SELECT expired.id FROM items AS expired
+        WHERE expired.deleted_at < $1
+        ORDER BY expired.deleted_at
+        LIMIT $2
+    )
+    RETURNING items.id
+)
+SELECT purged.id, attachments.storage_key
+FROM purged
+LEFT JOIN attachments ON attachments.item_id = purged.id
+`
+
+type PurgeDeletedItemsParams struct {
+	DeletedBefore pgtype.Timestamptz `json:"deleted_before"`
+	BatchSize     int32              `json:"batch_size"`
+}
+
+type PurgeDeletedItemsRow struct {
+	ID         pgtype.UUID `json:"id"`
+	StorageKey *string     `json:"storage_key"`
+}
+
+func (q *Queries) PurgeDeletedItems(ctx context.Context, arg PurgeDeletedItemsParams) ([]PurgeDeletedItemsRow, error) {
+	rows, err := q.db.Query(ctx, purgeDeletedItems, arg.DeletedBefore, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PurgeDeletedItemsRow
+	for rows.Next() {
+		var i PurgeDeletedItemsRow
+		if err := rows.Scan(&i.ID, &i.StorageKey); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const purgeItem = `-- name: PurgeItem :many
+WITH purged AS (
+    DELETE FROM items
+    WHERE items.id = $1 AND items.owner_id = $2 AND items.deleted_at IS NOT NULL
+    RETURNING items.id
+)
+SELECT purged.id, attachments.storage_key
+FROM purged
+LEFT JOIN attachments ON attachments.item_id = purged.id
+`
+
+type PurgeItemParams struct {
 	ID      pgtype.UUID `json:"id"`
 	OwnerID pgtype.UUID `json:"owner_id"`
 }
 
-func (q *Queries) GetItem(ctx context.Context, arg GetItemParams) (Item, error) {
-	row := q.db.QueryRow(ctx, getItem, arg.ID, arg.OwnerID)
+type PurgeItemRow struct {
+	ID         pgtype.UUID `json:"id"`
+	StorageKey *string     `json:"storage_key"`
+}
+
+func (q *Queries) PurgeItem(ctx context.Context, arg PurgeItemParams) ([]PurgeItemRow, error) {
+	rows, err := q.db.Query(ctx, purgeItem, arg.ID, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PurgeItemRow
+	for rows.Next() {
+		var i PurgeItemRow
+		if err := rows.Scan(&i.ID, &i.StorageKey); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const restoreItem = `-- name: RestoreItem :one
+UPDATE items
+SET deleted_at = NULL
+WHERE id = $1 AND owner_id = $2 AND deleted_at IS NOT NULL
+RETURNING id, owner_id, name, description, created_at, version, updated_at, search_language, search_vector, deleted_at
+`
+
+type RestoreItemParams struct {
+	ID      pgtype.UUID `json:"id"`
+	OwnerID pgtype.UUID `json:"owner_id"`
+}
+
+func (q *Queries) RestoreItem(ctx context.Context, arg RestoreItemParams) (Item, error) {
+	row := q.db.QueryRow(ctx, restoreItem, arg.ID, arg.OwnerID)
 	var i Item
 	err := row.Scan(
 		&i.ID,
@@ -72,38 +549,91 @@ func (q *Queries) GetItem(ctx context.Context, arg GetItemParams) (Item, error)
 		&i.Name,
 		&i.Description,
 		&i.CreatedAt,
+		&i.Version,
+		&i.UpdatedAt,
+		&i.SearchLanguage,
+		&i.SearchVector,
+		&i.DeletedAt,
 	)
 	return i, err
 }
 
-const listItems = `-- name: ListItems :many
-SELECT id, owner_id, name, description, created_at FROM items
-WHERE owner_id = $1
-ORDER BY created_at DESC
-LIMIT $2 OFFSET $3
+const searchItems = `-- name: SearchItems :many
+SELECT
+    id,
+    owner_id,
+    name,
+    description,
+    created_at,
+    version,
+    updated_at,
+    search_language,
+    ts_rank_cd(search_vector, websearch_to_tsquery($1::regconfig, $2))::real AS rank,
+    ts_headline(
+        $1::regconfig,
+        replace(replace(replace(concat_ws(' - ', name, description), '&', '&amp;'), '<', '&lt;'), '>', '&gt;'),
+        websearch_to_tsquery($1::regconfig, $2),
+        'StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MinWords=5, MaxWords=20'
+    ) AS snippet
+FROM items
+WHERE owner_id = $3
+  AND deleted_at IS NULL
+  AND search_vector @@ websearch_to_tsquery($1::regconfig, $2)
+  AND ($4::real IS NULL
+       OR (ts_rank_cd(search_vector, websearch_to_tsquery($1::regconfig, $2))::real, id) < ($4, $5::uuid))
+ORDER BY rank DESC, id DESC
+LIMIT $6
 `
 
-type ListItemsParams struct {
-	OwnerID pgtype.UUID `json:"owner_id"`
-	Limit   int32       `json:"limit"`
-	Offset  int32       `json:"offset"`
+type SearchItemsParams struct {
+	Language   string      `json:"language"`
+	Query      string      `json:"query"`
+	OwnerID    pgtype.UUID `json:"owner_id"`
+	CursorRank *float32    `json:"cursor_rank"`
+	CursorID   pgtype.UUID `json:"cursor_id"`
+	PageSize   int32       `json:"page_size"`
+}
+
+type SearchItemsRow struct {
+	ID             pgtype.UUID        `json:"id"`
+	OwnerID        pgtype.UUID        `json:"owner_id"`
+	Name           string             `json:"name"`
+	Description    *string            `json:"description"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	Version        int32              `json:"version"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	SearchLanguage string             `json:"search_language"`
+	Rank           float32            `json:"rank"`
+	Snippet        string             `json:"snippet"`
 }
 
-func (q *Queries) ListItems(ctx context.Context, arg ListItemsParams) ([]Item, error) {
-	rows, err := q.db.Query(ctx, listItems, arg.OwnerID, arg.Limit, arg.Offset)
+func (q *Queries) SearchItems(ctx context.Context, arg SearchItemsParams) ([]SearchItemsRow, error) {
+	rows, err := q.db.Query(ctx, searchItems,
+		arg.Language,
+		arg.Query,
+		arg.OwnerID,
+		arg.CursorRank,
+		arg.CursorID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Item
+	var items []SearchItemsRow
 	for rows.Next() {
-		var i Item
+		var i SearchItemsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.OwnerID,
 			&i.Name,
 			&i.Description,
 			&i.CreatedAt,
+			&i.Version,
+			&i.UpdatedAt,
+			&i.SearchLanguage,
+			&i.Rank,
+			&i.Snippet,
 		); err != nil {
 			return nil, err
 		}
@@ -114,3 +644,45 @@ func (q *Queries) ListItems(ctx context.Context, arg ListItemsParams) ([]Item, e
 	}
 	return items, nil
 }
+
+const updateItem = `-- name: UpdateItem :one
+UPDATE items
+SET name = $3,
+    description = $4,
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1 AND owner_id = $2 AND version = $5 AND deleted_at IS NULL
+RETURNING id, owner_id, name, description, created_at, version, updated_at, search_language, search_vector, deleted_at
+`
+
+type UpdateItemParams struct {
+	ID          pgtype.UUID `json:"id"`
+	OwnerID     pgtype.UUID `json:"owner_id"`
+	Name        string      `json:"name"`
+	Description *string     `json:"description"`
+	Version     int32       `json:"version"`
+}
+
+func (q *Queries) UpdateItem(ctx context.Context, arg UpdateItemParams) (Item, error) {
+	row := q.db.QueryRow(ctx, updateItem,
+		arg.ID,
+		arg.OwnerID,
+		arg.Name,
+		arg.Description,
+		arg.Version,
+	)
+	var i Item
+	err := row.Scan(
+		&i.ID,
+		&i.OwnerID,
+		&i.Name,
+		&i.Description,
+		&i.CreatedAt,
+		&i.Version,
+		&i.UpdatedAt,
+		&i.SearchLanguage,
+		&i.SearchVector,
+		&i.DeletedAt,
+	)
+	return i, err
+}
@@ -8,10 +8,46 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Attachment struct {
+	ID             pgtype.UUID        `json:"id"`
+	ItemID         pgtype.UUID        `json:"item_id"`
+	Filename       string             `json:"filename"`
+	ContentType    string             `json:"content_type"`
+	Size           int64              `json:"size"`
+	ChecksumSha256 string             `json:"checksum_sha256"`
+	StorageKey     string             `json:"storage_key"`
+	CreatedBy      pgtype.UUID        `json:"created_by"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
 type Item struct {
-	ID          pgtype.UUID        `json:"id"`
-	OwnerID     pgtype.UUID        `json:"owner_id"`
-	Name        string             `json:"name"`
-	Description *string            `json:"description"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	ID             pgtype.UUID        `json:"id"`
+	OwnerID        pgtype.UUID        `json:"owner_id"`
+	Name           string             `json:"name"`
+	Description    *string            `json:"description"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	Version        int32              `json:"version"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+	SearchLanguage string             `json:"search_language"`
+	SearchVector   string             `json:"search_vector"`
+	DeletedAt      pgtype.Timestamptz `json:"deleted_at"`
+}
+
+type ItemShare struct {
+	ItemID     pgtype.UUID        `json:"item_id"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	Permission string             `json:"permission"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+}
+
+type ItemTag struct {
+	ItemID pgtype.UUID `json:"item_id"`
+	TagID  pgtype.UUID `json:"tag_id"`
+}
+
+type Tag struct {
+	ID        pgtype.UUID        `json:"id"`
+	OwnerID   pgtype.UUID        `json:"owner_id"`
+	Name      string             `json:"name"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
 }
@@ -6,13 +6,36 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddItemTag(ctx context.Context, arg AddItemTagParams) error
+	CountItems(ctx context.Context, arg CountItemsParams) (int64, error)
+	CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (Attachment, error)
 	CreateItem(ctx context.Context, arg CreateItemParams) (Item, error)
-	DeleteItem(ctx context.Context, arg DeleteItemParams) error
-	GetItem(ctx context.Context, arg GetItemParams) (Item, error)
-	ListItems(ctx context.Context, arg ListItemsParams) ([]Item, error)
+	DeleteAttachment(ctx context.Context, arg DeleteAttachmentParams) (string, error)
+	DeleteItem(ctx context.Context, arg DeleteItemParams) (int64, error)
+	DeleteItemShare(ctx context.Context, arg DeleteItemShareParams) (int64, error)
+	ExportItems(ctx context.Context, arg ExportItemsParams) ([]Item, error)
+	GetAttachment(ctx context.Context, arg GetAttachmentParams) (Attachment, error)
+	GetItem(ctx context.Context, arg GetItemParams) (GetItemRow, error)
+	ListAttachments(ctx context.Context, itemID pgtype.UUID) ([]Attachment, error)
+	ListItemShares(ctx context.Context, itemID pgtype.UUID) ([]ItemShare, error)
+	ListItemTags(ctx context.Context, itemIds []pgtype.UUID) ([]ListItemTagsRow, error)
+	ListItems(ctx context.Context, arg ListItemsParams) ([]ListItemsRow, error)
+	ListItemsAscending(ctx context.Context, arg ListItemsAscendingParams) ([]ListItemsAscendingRow, error)
+	ListTrash(ctx context.Context, arg ListTrashParams) ([]Item, error)
+	PurgeDeletedItems(ctx context.Context, arg PurgeDeletedItemsParams) ([]PurgeDeletedItemsRow, error)
+	PurgeItem(ctx context.Context, arg PurgeItemParams) ([]PurgeItemRow, error)
+	RemoveItemTag(ctx context.Context, arg RemoveItemTagParams) (int64, error)
+	RestoreItem(ctx context.Context, arg RestoreItemParams) (Item, error)
+	SearchItems(ctx context.Context, arg SearchItemsParams) ([]SearchItemsRow, error)
+	SuggestTags(ctx context.Context, arg SuggestTagsParams) ([]SuggestTagsRow, error)
+	UpdateItem(ctx context.Context, arg UpdateItemParams) (Item, error)
+	UpsertItemShare(ctx context.Context, arg UpsertItemShareParams) (ItemShare, error)
+	UpsertTag(ctx context.Context, arg UpsertTagParams) (Tag, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,164 @@
+package itemio
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+const exportPageSize = 500
+
+// Record is an exported item.
+type Record struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description *string   `json:"description"`
+	Language    string    `json:"language"`
+	Tags        []string  `json:"tags"`
+	Version     int32     `json:"version"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// Export writes the items of an owner that are not in the trash to w, oldest
+// first, and returns how many were written. Items are read a page at a time,
+// so large exports do not have to fit in memory.
+func Export(ctx context.Context, queries *db.Queries, ownerID pgtype.UUID, w io.Writer, format string) (int, error) {
+	enc, err := newEncoder(w, format)
+	if err != nil {
+		return 0, err
+	}
+
+	params := db.ExportItemsParams{OwnerID: ownerID, PageSize: exportPageSize}
+	written := 0
+	for {
+		items, err := queries.ExportItems(ctx, params)
+		if err != nil {
+			return written, fmt.Errorf("export items: %w", err)
+		}
+		tags, err := itemTags(ctx, queries, items)
+		if err != nil {
+			return written, err
+		}
+		for _, item := range items {
+			if err := enc.encode(newRecord(item, tags[item.ID])); err != nil {
+				return written, fmt.Errorf("encode item: %w", err)
+			}
+			written++
+		}
+		if len(items) < exportPageSize {
+			break
+		}
+		last := items[len(items)-1]
+		params.CursorCreatedAt, params.CursorID = last.CreatedAt, last.ID
+	}
+
+	if err := enc.flush(); err != nil {
+		return written, fmt.Errorf("flush export: %w", err)
+	}
+	return written, nil
+}
+
+func itemTags(ctx context.Context, queries *db.Queries, items []db.Item) (map[pgtype.UUID][]string, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	ids := make([]pgtype.UUID, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+	rows, err := queries.ListItemTags(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list item tags: %w", err)
+	}
+	tags := make(map[pgtype.UUID][]string, len(items))
+	for _, row := range rows {
+		tags[row.ItemID] = append(tags[row.ItemID], row.Name)
+	}
+	return tags, nil
+}
+
+func newRecord(item db.Item, tags []string) Record {
+	if tags == nil {
+		tags = []string{}
+	}
+	return Record{
+		ID:          item.ID.String(),
+		Name:        item.Name,
+		Description: item.Description,
+		Language:    item.SearchLanguage,
+		Tags:        tags,
+		Version:     item.Version,
+		CreatedAt:   item.CreatedAt.Time.UTC(),
+		UpdatedAt:   item.UpdatedAt.Time.UTC(),
+	}
+}
+
+type encoder interface {
+	encode(record Record) error
+	flush() error
+}
+
+func newEncoder(w io.Writer, format string) (encoder, error) {
+	if err := checkFormat(format); err != nil {
+		return nil, err
+	}
+	if format == FormatCSV {
+		enc := &csvEncoder{w: csv.NewWriter(w)}
+		if err := enc.w.Write(csvColumns); err != nil {
+			return nil, fmt.Errorf("write header: %w", err)
+		}
+		return enc, nil
+	}
+	buf := bufio.NewWriter(w)
+	return &ndjsonEncoder{buf: buf, enc: json.NewEncoder(buf)}, nil
+}
+
+type csvEncoder struct {
+	w *csv.Writer
+}
+
+func (e *csvEncoder) encode(record Record) error {
+	description := ""
+	if record.Description != nil {
+		description = *record.Description
+	}
+	return e.w.Write([]string{
+		record.ID,
+		record.Name,
+		description,
+		record.Language,
+		strings.Join(record.Tags, tagSeparator),
+		strconv.Itoa(int(record.Version)),
+		record.CreatedAt.Format(time.RFC3339),
+		record.UpdatedAt.Format(time.RFC3339),
+	})
+}
+
+func (e *csvEncoder) flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonEncoder struct {
+	buf *bufio.Writer
+	enc *json.Encoder
+}
+
+func (e *ndjsonEncoder) encode(record Record) error {
+	return e.enc.Encode(record)
+}
+
+func (e *ndjsonEncoder) flush() error {
+	return e.buf.Flush()
+}
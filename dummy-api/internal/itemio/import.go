@@ -0,0 +1,261 @@
+package itemio
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+// maxLineLength bounds a line of an NDJSON file.
+const maxLineLength = 1 << 20
+
+// ErrTooManyRows is returned by Parse when a file holds more rows than
+// allowed.
+var ErrTooManyRows = errors.New("too many rows")
+
+// Row is a valid item read from an import file.
+type Row struct {
+	// Line is the line the row starts on, counting from 1.
+	Line        int
+	Name        string
+	Description *string
+	// Language is nil when the row leaves it to the default.
+	Language *string
+	Tags     []string
+}
+
+// RowError explains why the row starting on Line was rejected.
+type RowError struct {
+	Line    int
+	Message string
+}
+
+// Parse reads and validates an import file. Rows are checked one by one so
+// that every problem is reported, except that a malformed file stops parsing
+// at the line where it breaks. The returned error is reserved for failures
+// to read r and for files with more than maxRows rows; zero means no limit.
+func Parse(r io.Reader, format string, maxRows int) ([]Row, []RowError, error) {
+	if err := checkFormat(format); err != nil {
+		return nil, nil, err
+	}
+	p := &parser{maxRows: maxRows}
+	var err error
+	if format == FormatCSV {
+		err = p.parseCSV(r)
+	} else {
+		err = p.parseNDJSON(r)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.rows, p.errors, nil
+}
+
+type parser struct {
+	maxRows int
+	seen    int
+	rows    []Row
+	errors  []RowError
+}
+
+func (p *parser) parseCSV(r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return p.csvError(err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))
+		if _, ok := columns[name]; !ok {
+			columns[name] = i
+		}
+	}
+	if _, ok := columns["name"]; !ok {
+		p.reject(1, "header has no name column")
+		return nil
+	}
+	field := func(record []string, column string) *string {
+		i, ok := columns[column]
+		if !ok || record[i] == "" {
+			return nil
+		}
+		return &record[i]
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return p.csvError(err)
+		}
+		line, _ := reader.FieldPos(0)
+		if err := p.count(); err != nil {
+			return err
+		}
+		if len(record) != len(header) {
+			p.reject(line, fmt.Sprintf("has %d fields, want %d", len(record), len(header)))
+			continue
+		}
+
+		var tags []string
+		if raw := field(record, "tags"); raw != nil {
+			tags = strings.Split(*raw, tagSeparator)
+		}
+		name := ""
+		if raw := field(record, "name"); raw != nil {
+			name = *raw
+		}
+		p.validate(line, name, field(record, "description"), field(record, "language"), tags)
+	}
+}
+
+// csvError reports a malformed CSV file at the line where it breaks.
+func (p *parser) csvError(err error) error {
+	var parseErr *csv.ParseError
+	if !errors.As(err, &parseErr) {
+		return fmt.Errorf("read csv: %w", err)
+	}
+	p.reject(parseErr.Line, parseErr.Err.Error()+"; stopped reading the file")
+	return nil
+}
+
+type ndjsonRow struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description"`
+	Language    *string  `json:"language"`
+	Tags        []string `json:"tags"`
+}
+
+func (p *parser) parseNDJSON(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxLineLength)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		raw := strings.TrimSpace(scanner.Text())
+		if raw == "" {
+			continue
+		}
+		if err := p.count(); err != nil {
+			return err
+		}
+		var row ndjsonRow
+		if err := json.Unmarshal([]byte(raw), &row); err != nil {
+			p.reject(line, "invalid JSON: "+err.Error())
+			continue
+		}
+		if row.Description != nil && *row.Description == "" {
+			row.Description = nil
+		}
+		if row.Language != nil && *row.Language == "" {
+			row.Language = nil
+		}
+		p.validate(line, row.Name, row.Description, row.Language, row.Tags)
+	}
+	if err := scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			p.reject(line+1, "line is longer than "+strconv.Itoa(maxLineLength)+" bytes; stopped reading the file")
+			return nil
+		}
+		return fmt.Errorf("read ndjson: %w", err)
+	}
+	return nil
+}
+
+func (p *parser) count() error {
+	p.seen++
+	if p.maxRows > 0 && p.seen > p.maxRows {
+		return fmt.Errorf("%w: files are limited to %d rows", ErrTooManyRows, p.maxRows)
+	}
+	return nil
+}
+
+// validate applies the rules the API enforces on new items and records the
+// row, or every problem with it.
+func (p *parser) validate(line int, name string, description, language *string, tags []string) {
+	var problems []string
+	if strings.TrimSpace(name) == "" {
+		problems = append(problems, "name is required")
+	}
+	if language != nil && !languages[*language] {
+		problems = append(problems, fmt.Sprintf("unknown language %q", *language))
+	}
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		switch {
+		case tag == "":
+		case len([]rune(tag)) > maxTagLength:
+			problems = append(problems, fmt.Sprintf("tag %q is longer than %d characters", tag, maxTagLength))
+		case !slices.ContainsFunc(normalized, func(t string) bool { return strings.EqualFold(t, tag) }):
+			normalized = append(normalized, tag)
+		}
+	}
+
+	if len(problems) > 0 {
+		p.reject(line, strings.Join(problems, "; "))
+		return
+	}
+	p.rows = append(p.rows, Row{
+		Line:        line,
+		Name:        name,
+		Description: description,
+		Language:    language,
+		Tags:        normalized,
+	})
+}
+
+func (p *parser) reject(line int, message string) {
+	p.errors = append(p.errors, RowError{Line: line, Message: message})
+}
+
+// Import creates an item owned by ownerID for every row and tags it. Rows
+// without a language use defaultLanguage. Callers pass queries bound to a
+// transaction so that either every row is imported or none is.
+func Import(ctx context.Context, queries *db.Queries, ownerID pgtype.UUID, rows []Row, defaultLanguage string) error {
+	for _, row := range rows {
+		language := defaultLanguage
+		if row.Language != nil {
+			language = *row.Language
+		}
+		item, err := queries.CreateItem(ctx, db.CreateItemParams{
+			OwnerID:        ownerID,
+			Name:           row.Name,
+			Description:    row.Description,
+			SearchLanguage: language,
+		})
+		if err != nil {
+			return fmt.Errorf("create item from line %d: %w", row.Line, err)
+		}
+		for _, name := range row.Tags {
+			tag, err := queries.UpsertTag(ctx, db.UpsertTagParams{OwnerID: ownerID, Name: name})
+			if err != nil {
+				return fmt.Errorf("upsert tag from line %d: %w", row.Line, err)
+			}
+			if err := queries.AddItemTag(ctx, db.AddItemTagParams{ItemID: item.ID, TagID: tag.ID}); err != nil {
+				return fmt.Errorf("add item tag from line %d: %w", row.Line, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package itemio
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	longTag := strings.Repeat("x", maxTagLength+1)
+
+	tests := []struct {
+		name    string
+		format  string
+		input   string
+		maxRows int
+		// wantRows are the lines of the accepted rows.
+		wantRows []int
+		// wantErrors are the rejected rows; messages only need to contain
+		// the given text.
+		wantErrors []RowError
+		wantErr    error
+	}{
+		{
+			name:     "csv",
+			format:   FormatCSV,
+			input:    "name,description\nfirst,one\nsecond,\n",
+			wantRows: []int{2, 3},
+		},
+		{
+			name:     "csv with byte order mark",
+			format:   FormatCSV,
+			input:    "\ufeffName,tags\nfirst,a;b\n",
+			wantRows: []int{2},
+		},
+		{
+			name:       "csv without name column",
+			format:     FormatCSV,
+			input:      "title,description\nfirst,one\n",
+			wantErrors: []RowError{{Line: 1, Message: "no name column"}},
+		},
+		{
+			name:     "csv row spanning lines",
+			format:   FormatCSV,
+			input:    "name,description\nfirst,\"one\ntwo\"\nsecond,three\n",
+			wantRows: []int{2, 4},
+		},
+		{
+			name:     "csv bad rows",
+			format:   FormatCSV,
+			input:    "name,language,tags\n,english,\nfirst,klingon,\nsecond,," + longTag + "\nthird,english\nfourth,english,a\n",
+			wantRows: []int{6},
+			wantErrors: []RowError{
+				{Line: 2, Message: "name is required"},
+				{Line: 3, Message: `unknown language "klingon"`},
+				{Line: 4, Message: "longer than 50 characters"},
+				{Line: 5, Message: "has 2 fields, want 3"},
+			},
+		},
+		{
+			name:       "malformed csv",
+			format:     FormatCSV,
+			input:      "name\nfirst\nsec\"ond\nthird\n",
+			wantRows:   []int{2},
+			wantErrors: []RowError{{Line: 3, Message: "stopped reading the file"}},
+		},
+		{
+			name:     "ndjson skips blank lines",
+			format:   FormatNDJSON,
+			input:    "{\"name\":\"first\"}\n\n{\"name\":\"second\",\"tags\":[\"a\"]}\n",
+			wantRows: []int{1, 3},
+		},
+		{
+			name:     "ndjson bad rows",
+			format:   FormatNDJSON,
+			input:    "{\"name\":\"first\"}\nnot json\n{\"name\":\" \"}\n{\"name\":\"second\",\"language\":\"klingon\"}\n",
+			wantRows: []int{1},
+			wantErrors: []RowError{
+				{Line: 2, Message: "invalid JSON"},
+				{Line: 3, Message: "name is required"},
+				{Line: 4, Message: `unknown language "klingon"`},
+			},
+		},
+		{
+			name:     "at the row limit",
+			format:   FormatCSV,
+			input:    "name\nfirst\nsecond\n",
+			maxRows:  2,
+			wantRows: []int{2, 3},
+		},
+		{
+			name:    "over the row limit",
+			format:  FormatCSV,
+			input:   "name\nfirst\nsecond\nthird\n",
+			maxRows: 2,
+			wantErr: ErrTooManyRows,
+		},
+		{
+			name:    "rejected rows count towards the limit",
+			format:  FormatNDJSON,
+			input:   "not json\n{\"name\":\"\"}\n{\"name\":\"first\"}\n",
+			maxRows: 2,
+			wantErr: ErrTooManyRows,
+		},
+		{
+			name:   "empty file",
+			format: FormatCSV,
+			input:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, rowErrors, err := Parse(strings.NewReader(tt.input), tt.format, tt.maxRows)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			var lines []int
+			for _, row := range rows {
+				lines = append(lines, row.Line)
+			}
+			if !slices.Equal(lines, tt.wantRows) {
+				t.Errorf("accepted rows on lines %v, want %v", lines, tt.wantRows)
+			}
+			if len(rowErrors) != len(tt.wantErrors) {
+				t.Fatalf("rejected rows = %+v, want %+v", rowErrors, tt.wantErrors)
+			}
+			for i, want := range tt.wantErrors {
+				got := rowErrors[i]
+				if got.Line != want.Line || !strings.Contains(got.Message, want.Message) {
+					t.Errorf("rejected row %d = %+v, want line %d with %q", i, got, want.Line, want.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestParseNormalizesRows(t *testing.T) {
+	input := "\ufeffname,description,language,tags\nfirst,,german, Go ;go;;db \n"
+	rows, rowErrors, err := Parse(strings.NewReader(input), FormatCSV, 0)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(rowErrors) != 0 || len(rows) != 1 {
+		t.Fatalf("Parse() = %+v, %+v, want one accepted row", rows, rowErrors)
+	}
+
+	row := rows[0]
+	if row.Name != "first" || row.Description != nil {
+		t.Errorf("name, description = %q, %v, want %q, nil", row.Name, row.Description, "first")
+	}
+	if row.Language == nil || *row.Language != "german" {
+		t.Errorf("language = %v, want german", row.Language)
+	}
+	if want := []string{"Go", "db"}; !slices.Equal(row.Tags, want) {
+		t.Errorf("tags = %q, want %q", row.Tags, want)
+	}
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	if _, _, err := Parse(strings.NewReader("name\nfirst\n"), "xml", 0); err == nil {
+		t.Fatal("Parse() error = nil, want an error for an unknown format")
+	}
+}
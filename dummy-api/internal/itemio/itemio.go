@@ -0,0 +1,50 @@
+// Package itemio converts items to and from the CSV and NDJSON files used to
+// export and import them. It is shared by the HTTP endpoints and the items
+// commands of the CLI.
+package itemio
+
+import "fmt"
+
+// Supported file formats.
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// maxTagLength mirrors the limit enforced on tags added through the API.
+const maxTagLength = 50
+
+// tagSeparator separates the tags in the tags column of a CSV file.
+const tagSeparator = ";"
+
+// csvColumns is the header of exported CSV files. Imports only read name,
+// description, language and tags, so exported files can be imported as is.
+var csvColumns = []string{"id", "name", "description", "language", "tags", "version", "created_at", "updated_at"}
+
+// languages are the text search configurations shipped with PostgreSQL,
+// matching those the API accepts.
+var languages = map[string]bool{
+	"simple": true, "arabic": true, "armenian": true, "basque": true, "catalan": true,
+	"danish": true, "dutch": true, "english": true, "finnish": true, "french": true,
+	"german": true, "greek": true, "hindi": true, "hungarian": true, "indonesian": true,
+	"irish": true, "italian": true, "lithuanian": true, "nepali": true, "norwegian": true,
+	"portuguese": true, "romanian": true, "russian": true, "serbian": true, "spanish": true,
+	"swedish": true, "tamil": true, "turkish": true, "yiddish": true,
+}
+
+// ContentType returns the media type of files in the given format.
+func ContentType(format string) string {
+	if format == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/x-ndjson"
+}
+
+func checkFormat(format string) error {
+	switch format {
+	case FormatCSV, FormatNDJSON:
+		return nil
+	default:
+		return fmt.Errorf("unknown format %q, want %s or %s", format, FormatCSV, FormatNDJSON)
+	}
+}
@@ -0,0 +1,104 @@
+package purge
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/blob"
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+const (
+	defaultInterval  = time.Hour
+	defaultBatchSize = 500
+)
+
+// Options tunes the purger. Zero values select the defaults, except for
+// Retention, which must be set.
+type Options struct {
+	// Retention is how long items stay in the trash before they are
+	// permanently deleted.
+	Retention time.Duration
+	// Interval is how often the trash is checked for expired items.
+	Interval time.Duration
+	// BatchSize is the number of items deleted per statement, which keeps
+	// transactions short when a large backlog has expired.
+	BatchSize int
+}
+
+// Purger permanently deletes items that have been in the trash for longer
+// than the retention period, together with the content of their attachments.
+// Several purgers may run against the same database.
+type Purger struct {
+	log     *slog.Logger
+	queries *db.Queries
+	blobs   blob.Store
+	opts    Options
+}
+
+// NewPurger creates a new Purger instance.
+func NewPurger(log *slog.Logger, queries *db.Queries, blobs blob.Store, opts Options) *Purger {
+	if opts.Interval <= 0 {
+		opts.Interval = defaultInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	return &Purger{log: log, queries: queries, blobs: blobs, opts: opts}
+}
+
+// Run purges expired items until ctx is cancelled.
+func (p *Purger) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge deletes expired items batch by batch until none are left.
+func (p *Purger) purge(ctx context.Context) {
+	cutoff := pgtype.Timestamptz{Time: time.Now().UTC().Add(-p.opts.Retention), Valid: true}
+	var total int64
+	for ctx.Err() == nil {
+		rows, err := p.queries.PurgeDeletedItems(ctx, db.PurgeDeletedItemsParams{
+			DeletedBefore: cutoff,
+			BatchSize:     int32(p.opts.BatchSize),
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				p.log.ErrorContext(ctx, "purge deleted items", "error", err)
+			}
+			break
+		}
+
+		// Rows list every attachment of the purged items, so an item
+		// can appear more than once.
+		purged := make(map[[16]byte]struct{}, len(rows))
+		for _, row := range rows {
+			purged[row.ID.Bytes] = struct{}{}
+			if row.StorageKey == nil {
+				continue
+			}
+			if err := p.blobs.Delete(ctx, *row.StorageKey); err != nil {
+				p.log.WarnContext(ctx, "failed to delete attachment content", "key", *row.StorageKey, "error", err)
+			}
+		}
+		total += int64(len(purged))
+		if len(purged) < p.opts.BatchSize {
+			break
+		}
+	}
+	if total > 0 {
+		p.log.InfoContext(ctx, "purged trashed items", "count", total, "deletedBefore", cutoff.Time)
+	}
+}
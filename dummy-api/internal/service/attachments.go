@@ -0,0 +1,325 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/blob"
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+// defaultAttachmentMaxSize bounds uploads when no limit is configured.
+const defaultAttachmentMaxSize = 10 << 20
+
+// sniffLength is the number of leading bytes http.DetectContentType considers.
+const sniffLength = 512
+
+// UploadAttachment streams the request body to a temporary file while
+// hashing it and sniffing its content type, then hands it to blob storage.
+// Nothing is stored unless the content passes the size, type and checksum
+// checks.
+func (s *Service) UploadAttachment(ctx context.Context, payload *dummy.UploadAttachmentPayload, body io.ReadCloser) (*dummy.Attachment, error) {
+	defer body.Close()
+
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.editableItem(ctx, claims, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	createdBy, err := toUUID(claims.UserID)
+	if err != nil {
+		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	upload, err := s.spoolUpload(body)
+	if err != nil {
+		return nil, err
+	}
+	defer upload.close()
+	if payload.ChecksumSha256 != nil && !strings.EqualFold(*payload.ChecksumSha256, upload.checksum) {
+		return nil, &dummy.DummyChecksumMismatchError{Message: "content does not match checksum_sha256"}
+	}
+
+	id := uuid.New()
+	key := "items/" + row.Item.ID.String() + "/" + id.String()
+	err = s.blobs.Put(ctx, key, upload.file, blob.Info{
+		Size:           upload.size,
+		ContentType:    upload.contentType,
+		ChecksumSHA256: upload.checksum,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("store attachment: %w", err)
+	}
+
+	attachment, err := s.queries.CreateAttachment(ctx, db.CreateAttachmentParams{
+		ID:             pgtype.UUID{Bytes: id, Valid: true},
+		ItemID:         row.Item.ID,
+		Filename:       payload.Filename,
+		ContentType:    upload.contentType,
+		Size:           upload.size,
+		ChecksumSha256: upload.checksum,
+		StorageKey:     key,
+		CreatedBy:      createdBy,
+	})
+	if err != nil {
+		s.deleteBlobs(ctx, key)
+		return nil, fmt.Errorf("create attachment: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "uploaded attachment", "itemID", payload.ID, "attachmentID", id.String(), "size", upload.size, "contentType", upload.contentType, "userID", claims.UserID)
+	return mapAttachment(attachment), nil
+}
+
+// DownloadAttachment streams an attachment of an item the caller can see.
+// The content is checked against the stored checksum as it is read, and the
+// stream fails at the end if blob storage returned something else.
+func (s *Service) DownloadAttachment(ctx context.Context, payload *dummy.AttachmentIDPayload) (*dummy.AttachmentDownload, io.ReadCloser, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	row, err := s.accessibleItem(ctx, claims, payload.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	attachment, err := s.attachment(ctx, row.Item.ID, payload.AttachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := s.blobs.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open attachment %s: %w", payload.AttachmentID, err)
+	}
+
+	contentType := attachment.ContentType
+	if activeContentType(contentType) {
+		contentType = "application/octet-stream"
+	}
+	result := &dummy.AttachmentDownload{
+		ContentType:        contentType,
+		ContentLength:      attachment.Size,
+		ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}),
+		ChecksumSha256:     attachment.ChecksumSha256,
+		ContentTypeOptions: "nosniff",
+	}
+	return result, &verifyingReader{ReadCloser: content, hash: sha256.New(), want: attachment.ChecksumSha256}, nil
+}
+
+// ListAttachments lists the attachments of an item the caller can see.
+func (s *Service) ListAttachments(ctx context.Context, payload *dummy.ItemIDPayload) (*dummy.AttachmentsCollection, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.accessibleItem(ctx, claims, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.queries.ListAttachments(ctx, row.Item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list attachments: %w", err)
+	}
+
+	result := &dummy.AttachmentsCollection{Attachments: make([]*dummy.Attachment, 0, len(attachments))}
+	for _, attachment := range attachments {
+		result.Attachments = append(result.Attachments, mapAttachment(attachment))
+	}
+	return result, nil
+}
+
+// DeleteAttachment removes an attachment of an item the caller can edit.
+func (s *Service) DeleteAttachment(ctx context.Context, payload *dummy.AttachmentIDPayload) error {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return err
+	}
+
+	row, err := s.editableItem(ctx, claims, payload.ID)
+	if err != nil {
+		return err
+	}
+	attachmentID, err := toUUID(payload.AttachmentID)
+	if err != nil {
+		return &dummy.DummyNotFoundError{Message: "invalid attachment id"}
+	}
+
+	key, err := s.queries.DeleteAttachment(ctx, db.DeleteAttachmentParams{ID: attachmentID, ItemID: row.Item.ID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &dummy.DummyNotFoundError{Message: "attachment not found"}
+		}
+		return fmt.Errorf("delete attachment: %w", err)
+	}
+	s.deleteBlobs(ctx, key)
+
+	s.log.InfoContext(ctx, "deleted attachment", "itemID", payload.ID, "attachmentID", payload.AttachmentID, "userID", claims.UserID)
+	return nil
+}
+
+func (s *Service) attachment(ctx context.Context, itemID pgtype.UUID, id string) (db.Attachment, error) {
+	attachmentID, err := toUUID(id)
+	if err != nil {
+		return db.Attachment{}, &dummy.DummyNotFoundError{Message: "invalid attachment id"}
+	}
+	attachment, err := s.queries.GetAttachment(ctx, db.GetAttachmentParams{ID: attachmentID, ItemID: itemID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.Attachment{}, &dummy.DummyNotFoundError{Message: "attachment not found"}
+		}
+		return db.Attachment{}, fmt.Errorf("get attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+// deleteBlobs removes attachment content whose rows are already gone. A
+// failure leaves an unreferenced object behind, which is logged rather than
+// reported to the caller.
+func (s *Service) deleteBlobs(ctx context.Context, keys ...string) {
+	for _, key := range keys {
+		if err := s.blobs.Delete(ctx, key); err != nil {
+			s.log.WarnContext(ctx, "failed to delete attachment content", "key", key, "error", err)
+		}
+	}
+}
+
+// spooledUpload is an upload that passed the size and content type checks,
+// held in a temporary file positioned at its start.
+type spooledUpload struct {
+	file        *os.File
+	size        int64
+	contentType string
+	checksum    string
+}
+
+func (u *spooledUpload) close() {
+	u.file.Close()
+	os.Remove(u.file.Name())
+}
+
+func (s *Service) spoolUpload(body io.Reader) (*spooledUpload, error) {
+	limited := io.LimitReader(body, s.attachmentMaxSize+1)
+	head := make([]byte, sniffLength)
+	n, err := io.ReadFull(limited, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, fmt.Errorf("read upload: %w", err)
+	}
+	head = head[:n]
+
+	contentType := http.DetectContentType(head)
+	if !s.contentTypeAllowed(contentType) {
+		return nil, &dummy.DummyUnsupportedMediaTypeError{Message: "content type " + contentType + " is not allowed"}
+	}
+
+	file, err := os.CreateTemp("", "dummy-upload-*")
+	if err != nil {
+		return nil, fmt.Errorf("spool upload: %w", err)
+	}
+	upload := &spooledUpload{file: file, contentType: contentType}
+
+	sum := sha256.New()
+	upload.size, err = io.Copy(io.MultiWriter(file, sum), io.MultiReader(bytes.NewReader(head), limited))
+	if err != nil {
+		upload.close()
+		return nil, fmt.Errorf("spool upload: %w", err)
+	}
+	if upload.size > s.attachmentMaxSize {
+		upload.close()
+		return nil, &dummy.DummyTooLargeError{Message: fmt.Sprintf("attachments are limited to %d bytes", s.attachmentMaxSize)}
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		upload.close()
+		return nil, fmt.Errorf("spool upload: %w", err)
+	}
+	upload.checksum = hex.EncodeToString(sum.Sum(nil))
+	return upload, nil
+}
+
+// contentTypeAllowed matches a sniffed content type against the configured
+// list, which holds media types such as "application/pdf" or wildcards such
+// as "image/*". An empty list allows everything.
+func (s *Service) contentTypeAllowed(contentType string) bool {
+	if len(s.attachmentContentTypes) == 0 {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range s.attachmentContentTypes {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+		if allowed == mediaType || strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// activeContentType reports whether browsers may run scripts in content of
+// this type, as they do in HTML, XML and SVG documents. Such attachments are
+// downloaded as opaque bytes so that they cannot run in the API's origin.
+func activeContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return true
+	}
+	switch {
+	case mediaType == "text/html", mediaType == "text/xml", mediaType == "application/xml":
+		return true
+	case strings.HasSuffix(mediaType, "+xml"), strings.Contains(mediaType, "javascript"), strings.Contains(mediaType, "ecmascript"):
+		return true
+	}
+	return false
+}
+
+// verifyingReader hashes what it reads and fails at the end of the stream if
+// the content does not have the expected checksum.
+type verifyingReader struct {
+	io.ReadCloser
+	hash hash.Hash
+	want string
+}
+
+func (r *verifyingReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) && hex.EncodeToString(r.hash.Sum(nil)) != r.want {
+		return n, errors.New("attachment content does not match its checksum")
+	}
+	return n, err
+}
+
+func mapAttachment(attachment db.Attachment) *dummy.Attachment {
+	return &dummy.Attachment{
+		ID:             attachment.ID.String(),
+		ItemID:         attachment.ItemID.String(),
+		Filename:       attachment.Filename,
+		ContentType:    attachment.ContentType,
+		Size:           attachment.Size,
+		ChecksumSha256: attachment.ChecksumSha256,
+		CreatedAt:      attachment.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}
+}
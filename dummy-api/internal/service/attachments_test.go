@@ -0,0 +1,29 @@
+package service
+
+import "testing"
+
+func TestActiveContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		active      bool
+	}{
+		{"text/html; charset=utf-8", true},
+		{"TEXT/HTML", true},
+		{"text/xml; charset=utf-8", true},
+		{"application/xml", true},
+		{"application/xhtml+xml", true},
+		{"image/svg+xml", true},
+		{"text/javascript", true},
+		{"application/javascript", true},
+		{"not a media type", true},
+		{"text/plain; charset=utf-8", false},
+		{"image/png", false},
+		{"application/pdf", false},
+		{"application/octet-stream", false},
+	}
+	for _, tt := range tests {
+		if got := activeContentType(tt.contentType); got != tt.active {
+			t.Errorf("activeContentType(%q) = %v, want %v", tt.contentType, got, tt.active)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+)
+
+// maxImportErrors bounds the failures reported by ImportItems and the
+// rejected rows reported by ImportItemsFile.
+const maxImportErrors = 100
+
+// batchEntry applies one entry of a batch using the given service, which is
+// bound to the batch transaction in atomic mode. Deletes return a nil item.
+type batchEntry func(ctx context.Context, s *Service) (*dummy.Item, error)
+
+// BatchCreateItems creates several items for the authenticated user.
+func (s *Service) BatchCreateItems(ctx context.Context, payload *dummy.BatchCreateItemsPayload) (*dummy.BatchResult, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]batchEntry, 0, len(payload.Items))
+	for _, entry := range payload.Items {
+		entries = append(entries, func(ctx context.Context, s *Service) (*dummy.Item, error) {
+			return s.createItem(ctx, claims, entry.Name, entry.Description, entry.Language)
+		})
+	}
+	return s.runBatch(ctx, payload.Atomic, entries)
+}
+
+// BatchUpdateItems changes several items like PatchItem. Every entry names
+// the version it is based on.
+func (s *Service) BatchUpdateItems(ctx context.Context, payload *dummy.BatchUpdateItemsPayload) (*dummy.BatchResult, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]batchEntry, 0, len(payload.Items))
+	for _, entry := range payload.Items {
+		entries = append(entries, func(ctx context.Context, s *Service) (*dummy.Item, error) {
+			return s.patchItem(ctx, claims, entry.ID, nil, &entry.Version, entry.Name, entry.Description)
+		})
+	}
+	return s.runBatch(ctx, payload.Atomic, entries)
+}
+
+// BatchDeleteItems moves several items owned by the caller to the trash.
+func (s *Service) BatchDeleteItems(ctx context.Context, payload *dummy.BatchDeleteItemsPayload) (*dummy.BatchResult, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]batchEntry, 0, len(payload.Ids))
+	for _, id := range payload.Ids {
+		entries = append(entries, func(ctx context.Context, s *Service) (*dummy.Item, error) {
+			return nil, s.deleteItem(ctx, claims, id)
+		})
+	}
+	return s.runBatch(ctx, payload.Atomic, entries)
+}
+
+// ImportItems creates the items streamed by the client, each on its own, so
+// that a bad entry does not cost the rest of a large import.
+func (s *Service) ImportItems(ctx context.Context, payload *dummy.AuthenticatedPayload, stream dummy.ImportItemsServerStream) error {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return err
+	}
+
+	summary := &dummy.ImportSummary{Errors: []*dummy.BatchEntryResult{}}
+	for {
+		entry, err := stream.RecvWithContext(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+
+		index := int(summary.Received)
+		summary.Received++
+		if _, err := s.createItem(ctx, claims, entry.Name, entry.Description, entry.Language); err != nil {
+			summary.Failed++
+			if len(summary.Errors) < maxImportErrors {
+				summary.Errors = append(summary.Errors, &dummy.BatchEntryResult{Index: index, Error: s.batchEntryError(ctx, err)})
+			}
+			continue
+		}
+		summary.Created++
+	}
+
+	s.log.InfoContext(ctx, "imported items", "ownerID", claims.UserID, "created", summary.Created, "failed", summary.Failed)
+	return stream.SendAndCloseWithContext(ctx, summary)
+}
+
+// runBatch applies the entries in order. In atomic mode they share one
+// transaction that is committed only if every entry succeeds; the first
+// failure rolls back the others and skips the rest.
+func (s *Service) runBatch(ctx context.Context, atomic bool, entries []batchEntry) (*dummy.BatchResult, error) {
+	result := &dummy.BatchResult{Results: make([]*dummy.BatchEntryResult, len(entries))}
+	for i := range entries {
+		result.Results[i] = &dummy.BatchEntryResult{Index: i}
+	}
+
+	if !atomic {
+		for i, apply := range entries {
+			item, err := apply(ctx, s)
+			if err != nil {
+				result.Results[i].Error = s.batchEntryError(ctx, err)
+				result.Failed++
+				continue
+			}
+			result.Results[i].Item = item
+			result.Succeeded++
+		}
+		return result, nil
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	txService := *s
+	txService.queries = s.queries.WithTx(tx)
+
+	for i, apply := range entries {
+		item, err := apply(ctx, &txService)
+		if err != nil {
+			abort(result, i, s.batchEntryError(ctx, err))
+			return result, nil
+		}
+		result.Results[i].Item = item
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit batch: %w", err)
+	}
+	result.Succeeded = len(entries)
+	return result, nil
+}
+
+// abort records the failure of entry failed in an atomic batch and marks
+// every other entry as aborted.
+func abort(result *dummy.BatchResult, failed int, cause *dummy.BatchEntryError) {
+	for i, entry := range result.Results {
+		entry.Item = nil
+		switch {
+		case i == failed:
+			entry.Error = cause
+		case i < failed:
+			entry.Error = &dummy.BatchEntryError{Name: "aborted", Message: "rolled back because entry " + strconv.Itoa(failed) + " failed"}
+		default:
+			entry.Error = &dummy.BatchEntryError{Name: "aborted", Message: "not applied because entry " + strconv.Itoa(failed) + " failed"}
+		}
+	}
+	result.Failed = len(result.Results)
+}
+
+// batchEntryError describes why an entry failed. Errors other than the
+// service's own are logged and reported without details.
+func (s *Service) batchEntryError(ctx context.Context, err error) *dummy.BatchEntryError {
+	var (
+		notFound     *dummy.DummyNotFoundError
+		forbidden    *dummy.DummyForbiddenError
+		conflict     *dummy.DummyConflictError
+		unauthorized *dummy.DummyUnauthorizedError
+	)
+	switch {
+	case errors.As(err, &notFound):
+		return &dummy.BatchEntryError{Name: notFound.GoaErrorName(), Message: notFound.Message}
+	case errors.As(err, &forbidden):
+		return &dummy.BatchEntryError{Name: forbidden.GoaErrorName(), Message: forbidden.Message}
+	case errors.As(err, &conflict):
+		return &dummy.BatchEntryError{Name: conflict.GoaErrorName(), Message: conflict.Message}
+	case errors.As(err, &unauthorized):
+		return &dummy.BatchEntryError{Name: unauthorized.GoaErrorName(), Message: unauthorized.Message}
+	}
+	s.log.ErrorContext(ctx, "batch entry failed", "error", err)
+	return &dummy.BatchEntryError{Name: "internal", Message: "internal error"}
+}
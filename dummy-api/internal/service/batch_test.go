@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+)
+
+func TestAbort(t *testing.T) {
+	result := &dummy.BatchResult{Succeeded: 1}
+	for i := range 3 {
+		result.Results = append(result.Results, &dummy.BatchEntryResult{Index: i, Item: &dummy.Item{}})
+	}
+	cause := &dummy.BatchEntryError{Name: "conflict", Message: "item was modified by another request"}
+
+	abort(result, 1, cause)
+
+	if result.Failed != 3 || result.Succeeded != 1 {
+		t.Errorf("failed, succeeded = %d, %d, want 3, 1", result.Failed, result.Succeeded)
+	}
+	want := []struct{ name, message string }{
+		{"aborted", "rolled back because entry 1 failed"},
+		{"conflict", "item was modified by another request"},
+		{"aborted", "not applied because entry 1 failed"},
+	}
+	for i, entry := range result.Results {
+		if entry.Item != nil {
+			t.Errorf("entry %d item = %+v, want nil", i, entry.Item)
+		}
+		if entry.Error == nil || entry.Error.Name != want[i].name || entry.Error.Message != want[i].message {
+			t.Errorf("entry %d error = %+v, want %s: %s", i, entry.Error, want[i].name, want[i].message)
+		}
+	}
+}
+
+func TestAtomicBatchRollsBack(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner := newTestUser()
+	first := createTestItem(t, svc, owner, "first", nil)
+	second := createTestItem(t, svc, owner, "second", nil)
+	third := createTestItem(t, svc, owner, "third", nil)
+
+	stale := int(second.Version) - 1
+	result, err := svc.BatchUpdateItems(ctx, &dummy.BatchUpdateItemsPayload{
+		Items: []*dummy.BatchUpdateEntry{
+			{ID: first.ID, Version: int(first.Version), Name: ptr("first changed")},
+			{ID: second.ID, Version: stale, Name: ptr("second changed")},
+			{ID: third.ID, Version: int(third.Version), Name: ptr("third changed")},
+		},
+		Atomic: true,
+		Token:  owner,
+	})
+	if err != nil {
+		t.Fatalf("BatchUpdateItems error = %v", err)
+	}
+	if result.Succeeded != 0 || result.Failed != 3 {
+		t.Errorf("succeeded, failed = %d, %d, want 0, 3", result.Succeeded, result.Failed)
+	}
+	wantErrors := []string{"aborted", "conflict", "aborted"}
+	for i, entry := range result.Results {
+		if entry.Item != nil || entry.Error == nil || entry.Error.Name != wantErrors[i] {
+			t.Errorf("entry %d = %+v, want only a %s error", i, entry, wantErrors[i])
+		}
+	}
+
+	// The update of the first item ran in the batch transaction and must
+	// have been rolled back.
+	for _, item := range []*dummy.Item{first, second, third} {
+		got, err := svc.GetItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner})
+		if err != nil {
+			t.Fatalf("GetItem(%s) error = %v", item.Name, err)
+		}
+		if got.Name != item.Name || got.Version != item.Version {
+			t.Errorf("item %s = %q version %d, want it unchanged", item.Name, got.Name, got.Version)
+		}
+	}
+
+	result, err = svc.BatchDeleteItems(ctx, &dummy.BatchDeleteItemsPayload{
+		Ids:    []string{first.ID, "00000000-0000-0000-0000-000000000000"},
+		Atomic: true,
+		Token:  owner,
+	})
+	if err != nil {
+		t.Fatalf("BatchDeleteItems error = %v", err)
+	}
+	if result.Results[0].Error == nil || !strings.HasPrefix(result.Results[0].Error.Message, "rolled back") || result.Results[1].Error == nil || result.Results[1].Error.Name != "not_found" {
+		t.Errorf("BatchDeleteItems results = %+v, %+v, want rolled back and not_found", result.Results[0].Error, result.Results[1].Error)
+	}
+	if _, err := svc.GetItem(ctx, &dummy.ItemIDPayload{ID: first.ID, Token: owner}); err != nil {
+		t.Errorf("item deleted by a rolled back batch: %v", err)
+	}
+}
+
+func TestBatchAppliesEntriesIndependently(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner := newTestUser()
+	first := createTestItem(t, svc, owner, "first", nil)
+	second := createTestItem(t, svc, owner, "second", nil)
+
+	result, err := svc.BatchUpdateItems(ctx, &dummy.BatchUpdateItemsPayload{
+		Items: []*dummy.BatchUpdateEntry{
+			{ID: first.ID, Version: int(first.Version), Name: ptr("first changed")},
+			{ID: second.ID, Version: int(second.Version) - 1, Name: ptr("second changed")},
+		},
+		Token: owner,
+	})
+	if err != nil {
+		t.Fatalf("BatchUpdateItems error = %v", err)
+	}
+	if result.Succeeded != 1 || result.Failed != 1 {
+		t.Errorf("succeeded, failed = %d, %d, want 1, 1", result.Succeeded, result.Failed)
+	}
+	if item := result.Results[0].Item; item == nil || item.Name != "first changed" || result.Results[0].Error != nil {
+		t.Errorf("entry 0 = %+v, want the updated item", result.Results[0])
+	}
+	if entry := result.Results[1]; entry.Item != nil || entry.Error == nil || entry.Error.Name != "conflict" {
+		t.Errorf("entry 1 = %+v, want a conflict", entry)
+	}
+
+	got, err := svc.GetItem(ctx, &dummy.ItemIDPayload{ID: first.ID, Token: owner})
+	if err != nil {
+		t.Fatalf("GetItem error = %v", err)
+	}
+	if got.Name != "first changed" {
+		t.Errorf("name = %q, want first changed", got.Name)
+	}
+}
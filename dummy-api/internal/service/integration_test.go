@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/auth"
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+// testDatabaseEnv names the PostgreSQL database the integration tests run
+// against. The migrations are applied to it; every test works with owners of
+// its own, so the database can be shared between runs.
+const testDatabaseEnv = "DUMMY_TEST_DATABASE_URL"
+
+var (
+	migrateOnce sync.Once
+	migrateErr  error
+)
+
+// newTestService returns a Service backed by the test database, skipping the
+// test when none is configured.
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+	url := os.Getenv(testDatabaseEnv)
+	if url == "" {
+		t.Skip(testDatabaseEnv + " is not set")
+	}
+
+	migrateOnce.Do(func() {
+		m, err := migrate.New("file://../../migrations", url)
+		if err != nil {
+			migrateErr = err
+			return
+		}
+		defer m.Close()
+		if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+			migrateErr = err
+		}
+	})
+	if migrateErr != nil {
+		t.Fatalf("migrate test database: %v", migrateErr)
+	}
+
+	pool, err := pgxpool.New(context.Background(), url)
+	if err != nil {
+		t.Fatalf("connect to test database: %v", err)
+	}
+	t.Cleanup(pool.Close)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return New(log, db.New(pool), testValidator{}, Options{DB: pool})
+}
+
+// testValidator accepts any token and treats it as the caller's user id.
+type testValidator struct{}
+
+func (testValidator) Validate(_ context.Context, token string) (*auth.Claims, error) {
+	return &auth.Claims{UserID: token}, nil
+}
+
+// newTestUser returns the bearer token of a new, unrelated user.
+func newTestUser() string {
+	return "Bearer " + uuid.NewString()
+}
+
+func createTestItem(t *testing.T, svc *Service, token, name string, description *string) *dummy.Item {
+	t.Helper()
+	item, err := svc.CreateItem(context.Background(), &dummy.CreateItemPayload{Name: name, Description: description, Token: token})
+	if err != nil {
+		t.Fatalf("CreateItem(%q) error = %v", name, err)
+	}
+	return item
+}
@@ -0,0 +1,94 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+// List orders accepted by ListItems. orderTrash marks cursors issued by
+// ListTrash, whose position is the deletion time rather than the creation time.
+const (
+	orderDesc  = "desc"
+	orderAsc   = "asc"
+	orderTrash = "trash"
+)
+
+const defaultPageSize = 50
+
+// itemCursor is the keyset position after which the next page starts. It is
+// handed to clients as base64url-encoded JSON and carries the order it was
+// issued for, since a position is meaningless in the opposite direction.
+type itemCursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        string    `json:"id"`
+	Order     string    `json:"o"`
+}
+
+func encodeCursor(at pgtype.Timestamptz, id pgtype.UUID, order string) string {
+	raw, _ := json.Marshal(itemCursor{CreatedAt: at.Time, ID: id.String(), Order: order})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(value, order string) (pgtype.Timestamptz, pgtype.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return pgtype.Timestamptz{}, pgtype.UUID{}, errors.New("cursor is malformed")
+	}
+	var cursor itemCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.CreatedAt.IsZero() {
+		return pgtype.Timestamptz{}, pgtype.UUID{}, errors.New("cursor is malformed")
+	}
+	if cursor.Order != order {
+		return pgtype.Timestamptz{}, pgtype.UUID{}, errors.New("cursor was issued for order " + cursor.Order)
+	}
+	id, err := toUUID(cursor.ID)
+	if err != nil {
+		return pgtype.Timestamptz{}, pgtype.UUID{}, errors.New("cursor is malformed")
+	}
+	return pgtype.Timestamptz{Time: cursor.CreatedAt, Valid: true}, id, nil
+}
+
+// searchCursor is the keyset position of the last search result of a page.
+type searchCursor struct {
+	Rank float32 `json:"r"`
+	ID   string  `json:"id"`
+}
+
+func encodeSearchCursor(row db.SearchItemsRow) string {
+	raw, _ := json.Marshal(searchCursor{Rank: row.Rank, ID: row.ID.String()})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeSearchCursor(value string) (*float32, pgtype.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, pgtype.UUID{}, errors.New("cursor is malformed")
+	}
+	var cursor searchCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return nil, pgtype.UUID{}, errors.New("cursor is malformed")
+	}
+	id, err := toUUID(cursor.ID)
+	if err != nil {
+		return nil, pgtype.UUID{}, errors.New("cursor is malformed")
+	}
+	return &cursor.Rank, id, nil
+}
+
+// parseTimeFilter converts an optional RFC 3339 filter value.
+func parseTimeFilter(value *string) (pgtype.Timestamptz, error) {
+	if value == nil {
+		return pgtype.Timestamptz{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return pgtype.Timestamptz{}, err
+	}
+	return pgtype.Timestamptz{Time: parsed, Valid: true}, nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+)
+
+// listAll pages through ListItems and returns "name:permission" for every
+// item, in order.
+func listAll(t *testing.T, svc *Service, payload dummy.ListItemsPayload) []string {
+	t.Helper()
+	var listed []string
+	for page := 0; ; page++ {
+		if page > 10 {
+			t.Fatal("pagination does not end")
+		}
+		result, err := svc.ListItems(context.Background(), &payload)
+		if err != nil {
+			t.Fatalf("ListItems() error = %v", err)
+		}
+		if len(result.Items) > payload.PageSize {
+			t.Fatalf("page has %d items, want at most %d", len(result.Items), payload.PageSize)
+		}
+		for _, item := range result.Items {
+			listed = append(listed, item.Name+":"+item.Permission)
+		}
+		if result.NextCursor == nil {
+			return listed
+		}
+		payload.Cursor = result.NextCursor
+	}
+}
+
+func TestListItemsMergesOwnedAndSharedItems(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner, sharer := newTestUser(), newTestUser()
+	ownerID := strings.TrimPrefix(owner, "Bearer ")
+
+	// Created one after the other so that owned and shared items interleave.
+	createTestItem(t, svc, owner, "own-1", nil)
+	shared1 := createTestItem(t, svc, sharer, "shared-1", nil)
+	createTestItem(t, svc, owner, "own-2", nil)
+	shared2 := createTestItem(t, svc, sharer, "shared-2", nil)
+	createTestItem(t, svc, owner, "own-3", nil)
+	createTestItem(t, svc, sharer, "not-shared", nil)
+	for item, permission := range map[*dummy.Item]string{shared1: permissionViewer, shared2: permissionEditor} {
+		if _, err := svc.ShareItem(ctx, &dummy.ShareItemPayload{ID: item.ID, UserID: ownerID, Permission: permission, Token: sharer}); err != nil {
+			t.Fatalf("ShareItem() error = %v", err)
+		}
+	}
+
+	desc := []string{"own-3:owner", "shared-2:editor", "own-2:owner", "shared-1:viewer", "own-1:owner"}
+	asc := slices.Clone(desc)
+	slices.Reverse(asc)
+	tests := []struct {
+		name    string
+		payload dummy.ListItemsPayload
+		want    []string
+	}{
+		{"descending", dummy.ListItemsPayload{PageSize: 2, Order: orderDesc}, desc},
+		{"ascending", dummy.ListItemsPayload{PageSize: 2, Order: orderAsc}, asc},
+		{"single page", dummy.ListItemsPayload{PageSize: 10, Order: orderDesc}, desc},
+		{"filtered", dummy.ListItemsPayload{PageSize: 1, Order: orderDesc, NamePrefix: ptr("SHARED")}, []string{"shared-2:editor", "shared-1:viewer"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.payload.Token = owner
+			if got := listAll(t, svc, tt.payload); !slices.Equal(got, tt.want) {
+				t.Errorf("listed %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	result, err := svc.ListItems(ctx, &dummy.ListItemsPayload{PageSize: 1, Order: orderDesc, IncludeTotal: true, Token: owner})
+	if err != nil {
+		t.Fatalf("ListItems() error = %v", err)
+	}
+	if result.Total == nil || *result.Total != 5 {
+		t.Errorf("total = %v, want 5", result.Total)
+	}
+}
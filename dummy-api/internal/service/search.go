@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+const (
+	defaultSearchLanguage = "english"
+	defaultSearchPageSize = 20
+)
+
+// SearchItems runs a full-text query over the caller's items. Results are
+// ordered by relevance and paged with a keyset cursor over (rank, id).
+func (s *Service) SearchItems(ctx context.Context, payload *dummy.SearchItemsPayload) (*dummy.SearchResultsCollection, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := toUUID(claims.UserID)
+	if err != nil {
+		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	pageSize := payload.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	language := s.searchLanguage
+	if payload.Language != nil {
+		language = *payload.Language
+	}
+
+	params := db.SearchItemsParams{
+		Language: language,
+		Query:    payload.Query,
+		OwnerID:  ownerID,
+		// One extra row tells whether another page follows.
+		PageSize: int32(pageSize) + 1,
+	}
+	if payload.Cursor != nil && *payload.Cursor != "" {
+		params.CursorRank, params.CursorID, err = decodeSearchCursor(*payload.Cursor)
+		if err != nil {
+			return nil, &dummy.DummyBadRequestError{Message: err.Error()}
+		}
+	}
+
+	rows, err := s.queries.SearchItems(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("search items: %w", err)
+	}
+
+	result := &dummy.SearchResultsCollection{}
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		result.NextCursor = ptr(encodeSearchCursor(rows[len(rows)-1]))
+	}
+	result.Results = make([]*dummy.SearchResult, 0, len(rows))
+	items := make([]*dummy.Item, 0, len(rows))
+	for _, row := range rows {
+		item := mapItem(db.Item{
+			ID:             row.ID,
+			OwnerID:        row.OwnerID,
+			Name:           row.Name,
+			Description:    row.Description,
+			CreatedAt:      row.CreatedAt,
+			Version:        row.Version,
+			UpdatedAt:      row.UpdatedAt,
+			SearchLanguage: row.SearchLanguage,
+		}, permissionOwner)
+		items = append(items, item)
+		result.Results = append(result.Results, &dummy.SearchResult{
+			Item:    item,
+			Rank:    row.Rank,
+			Snippet: row.Snippet,
+		})
+	}
+	if err := s.attachTags(ctx, items...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+)
+
+func TestSearchItemsEscapesSnippet(t *testing.T) {
+	svc := newTestService(t)
+	token := newTestUser()
+	createTestItem(t, svc, token, `<script>alert(1)</script> widget`, ptr(`fits <b>a & b</b> sockets`))
+
+	results, err := svc.SearchItems(context.Background(), &dummy.SearchItemsPayload{Query: "widget sockets", Token: token})
+	if err != nil {
+		t.Fatalf("SearchItems() error = %v", err)
+	}
+	if len(results.Results) != 1 {
+		t.Fatalf("got %d results, want 1", len(results.Results))
+	}
+
+	snippet := results.Results[0].Snippet
+	for _, unsafe := range []string{"<script", "<b>", " & "} {
+		if strings.Contains(snippet, unsafe) {
+			t.Errorf("snippet %q contains unescaped %q", snippet, unsafe)
+		}
+	}
+	for _, want := range []string{"&lt;script&gt;", "<mark>widget</mark>", "<mark>sockets</mark>"} {
+		if !strings.Contains(snippet, want) {
+			t.Errorf("snippet %q does not contain %q", snippet, want)
+		}
+	}
+	if item := results.Results[0].Item; item.Name != `<script>alert(1)</script> widget` {
+		t.Errorf("item name = %q, want it unchanged", item.Name)
+	}
+}
@@ -5,15 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 
 	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
 	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/auth"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/blob"
 	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
 )
 
@@ -24,14 +27,52 @@ type IdentityValidator interface {
 
 // Service wires transports to business logic and data stores.
 type Service struct {
-	log       *slog.Logger
-	queries   *db.Queries
-	validator IdentityValidator
+	log                    *slog.Logger
+	queries                *db.Queries
+	pool                   *pgxpool.Pool
+	validator              IdentityValidator
+	searchLanguage         string
+	blobs                  blob.Store
+	attachmentMaxSize      int64
+	attachmentContentTypes []string
+}
+
+// Options holds the settings the Service depends on besides its data store
+// and token validator.
+type Options struct {
+	// SearchLanguage is the text search configuration used for items and
+	// queries that do not name a language.
+	SearchLanguage string
+	// Blobs stores the content of attachments.
+	Blobs blob.Store
+	// AttachmentMaxSize bounds the size of an attachment in bytes.
+	AttachmentMaxSize int64
+	// AttachmentContentTypes lists the sniffed media types attachments may
+	// have, such as "application/pdf" or "image/*"; empty allows any.
+	AttachmentContentTypes []string
+	// DB runs batches in a transaction; it must be the pool backing the
+	// queries.
+	DB *pgxpool.Pool
 }
 
 // New creates a new Service instance.
-func New(log *slog.Logger, queries *db.Queries, validator IdentityValidator) *Service {
-	return &Service{log: log, queries: queries, validator: validator}
+func New(log *slog.Logger, queries *db.Queries, validator IdentityValidator, opts Options) *Service {
+	if opts.SearchLanguage == "" {
+		opts.SearchLanguage = defaultSearchLanguage
+	}
+	if opts.AttachmentMaxSize <= 0 {
+		opts.AttachmentMaxSize = defaultAttachmentMaxSize
+	}
+	return &Service{
+		log:                    log,
+		queries:                queries,
+		pool:                   opts.DB,
+		validator:              validator,
+		searchLanguage:         opts.SearchLanguage,
+		blobs:                  opts.Blobs,
+		attachmentMaxSize:      opts.AttachmentMaxSize,
+		attachmentContentTypes: opts.AttachmentContentTypes,
+	}
 }
 
 // CreateItem inserts a new record for the authenticated user.
@@ -41,100 +82,282 @@ func (s *Service) CreateItem(ctx context.Context, payload *dummy.CreateItemPaylo
 		return nil, err
 	}
 
+	return s.createItem(ctx, claims, payload.Name, payload.Description, payload.Language)
+}
+
+func (s *Service) createItem(ctx context.Context, claims *auth.Claims, name string, description, language *string) (*dummy.Item, error) {
 	ownerID, err := toUUID(claims.UserID)
 	if err != nil {
 		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
 	}
 
+	searchLanguage := s.searchLanguage
+	if language != nil {
+		searchLanguage = *language
+	}
+
 	item, err := s.queries.CreateItem(ctx, db.CreateItemParams{
-		OwnerID:     ownerID,
-		Name:        payload.Name,
-		Description: payload.Description,
+		OwnerID:        ownerID,
+		Name:           name,
+		Description:    description,
+		SearchLanguage: searchLanguage,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("create item: %w", err)
 	}
 
-	result := mapItem(item)
+	result := mapItem(item, permissionOwner)
 	s.log.InfoContext(ctx, "created item", "itemID", result.ID, "ownerID", result.OwnerID)
 	return result, nil
 }
 
-// ListItems returns all entries for the authenticated user.
+// ListItems pages through the entries owned by or shared with the
+// authenticated user using a
+// keyset cursor over (created_at, id), so pages stay stable while items are
+// added and deep pages cost the same as the first.
 func (s *Service) ListItems(ctx context.Context, payload *dummy.ListItemsPayload) (*dummy.ItemsCollection, error) {
 	claims, err := s.authorize(ctx, payload.Token)
 	if err != nil {
 		return nil, err
 	}
 
-	ownerID, err := toUUID(claims.UserID)
+	userID, err := toUUID(claims.UserID)
 	if err != nil {
 		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
 	}
 
-	rows, err := s.queries.ListItems(ctx, db.ListItemsParams{OwnerID: ownerID, Limit: 50, Offset: 0})
+	pageSize := payload.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	order := payload.Order
+	if order == "" {
+		order = orderDesc
+	}
+
+	filters := db.CountItemsParams{
+		UserID:     userID,
+		NamePrefix: payload.NamePrefix,
+		Tags:       normalizeTags(payload.Tags),
+		MatchAll:   payload.TagMatch == tagMatchAll,
+	}
+	if filters.CreatedAfter, err = parseTimeFilter(payload.CreatedAfter); err != nil {
+		return nil, &dummy.DummyBadRequestError{Message: "invalid created_after"}
+	}
+	if filters.CreatedBefore, err = parseTimeFilter(payload.CreatedBefore); err != nil {
+		return nil, &dummy.DummyBadRequestError{Message: "invalid created_before"}
+	}
+
+	params := db.ListItemsParams{
+		UserID:        filters.UserID,
+		NamePrefix:    filters.NamePrefix,
+		CreatedAfter:  filters.CreatedAfter,
+		CreatedBefore: filters.CreatedBefore,
+		Tags:          filters.Tags,
+		MatchAll:      filters.MatchAll,
+		// One extra row tells whether another page follows.
+		PageSize: int32(pageSize) + 1,
+	}
+	if payload.Cursor != nil && *payload.Cursor != "" {
+		params.CursorCreatedAt, params.CursorID, err = decodeCursor(*payload.Cursor, order)
+		if err != nil {
+			return nil, &dummy.DummyBadRequestError{Message: err.Error()}
+		}
+	}
+
+	var rows []db.ListItemsRow
+	if order == orderAsc {
+		var ascending []db.ListItemsAscendingRow
+		ascending, err = s.queries.ListItemsAscending(ctx, db.ListItemsAscendingParams(params))
+		for _, row := range ascending {
+			rows = append(rows, db.ListItemsRow(row))
+		}
+	} else {
+		rows, err = s.queries.ListItems(ctx, params)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("list items: %w", err)
 	}
 
-	items := make([]*dummy.Item, 0, len(rows))
+	result := &dummy.ItemsCollection{}
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		last := rows[len(rows)-1].Item
+		result.NextCursor = ptr(encodeCursor(last.CreatedAt, last.ID, order))
+	}
+	result.Items = make([]*dummy.Item, 0, len(rows))
 	for _, row := range rows {
-		items = append(items, mapItem(row))
+		result.Items = append(result.Items, mapItem(row.Item, row.Permission))
+	}
+	if err := s.attachTags(ctx, result.Items...); err != nil {
+		return nil, err
+	}
+
+	if payload.IncludeTotal {
+		total, err := s.queries.CountItems(ctx, filters)
+		if err != nil {
+			return nil, fmt.Errorf("count items: %w", err)
+		}
+		result.Total = &total
 	}
 
-	return &dummy.ItemsCollection{Items: items}, nil
+	return result, nil
 }
 
-// GetItem fetches a single entry if owned by the caller.
+// GetItem fetches a single entry if owned by or shared with the caller.
 func (s *Service) GetItem(ctx context.Context, payload *dummy.ItemIDPayload) (*dummy.Item, error) {
 	claims, err := s.authorize(ctx, payload.Token)
 	if err != nil {
 		return nil, err
 	}
 
-	ownerID, err := toUUID(claims.UserID)
+	row, err := s.accessibleItem(ctx, claims, payload.ID)
 	if err != nil {
-		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+		return nil, err
+	}
+
+	result := mapItem(row.Item, row.Permission)
+	if err := s.attachTags(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateItem replaces the name and description of an entry the caller owns
+// or can edit, provided it has not changed since the version the caller read.
+func (s *Service) UpdateItem(ctx context.Context, payload *dummy.UpdateItemPayload) (*dummy.Item, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.itemForUpdate(ctx, claims, payload.ID, payload.IfMatch, payload.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.updateItem(ctx, current, payload.Name, payload.Description)
+}
+
+// PatchItem changes the given fields of an entry the caller owns or can
+// edit, provided it has not changed since the version the caller read. An empty
+// description clears it.
+func (s *Service) PatchItem(ctx context.Context, payload *dummy.PatchItemPayload) (*dummy.Item, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
 	}
 
-	itemID, err := toUUID(payload.ID)
+	return s.patchItem(ctx, claims, payload.ID, payload.IfMatch, payload.Version, payload.Name, payload.Description)
+}
+
+func (s *Service) patchItem(ctx context.Context, claims *auth.Claims, id string, ifMatch *string, version *int, newName, newDescription *string) (*dummy.Item, error) {
+	current, err := s.itemForUpdate(ctx, claims, id, ifMatch, version)
 	if err != nil {
-		return nil, &dummy.DummyNotFoundError{Message: "invalid item id"}
+		return nil, err
+	}
+
+	name, description := current.Item.Name, current.Item.Description
+	if newName != nil {
+		name = *newName
+	}
+	if newDescription != nil {
+		description = newDescription
+		if *description == "" {
+			description = nil
+		}
+	}
+	return s.updateItem(ctx, current, name, description)
+}
+
+// itemForUpdate loads the item to update, checks the caller may edit it and
+// that it still has the version named by the If-Match header or, when absent,
+// the version field. If-Match "*" matches any version.
+func (s *Service) itemForUpdate(ctx context.Context, claims *auth.Claims, id string, ifMatch *string, version *int) (db.GetItemRow, error) {
+	row, err := s.editableItem(ctx, claims, id)
+	if err != nil {
+		return db.GetItemRow{}, err
+	}
+
+	switch {
+	case ifMatch != nil:
+		if !etagMatches(*ifMatch, row.Item.Version) {
+			return db.GetItemRow{}, conflictError(row.Item.Version)
+		}
+	case version != nil:
+		if int32(*version) != row.Item.Version {
+			return db.GetItemRow{}, conflictError(row.Item.Version)
+		}
+	default:
+		return db.GetItemRow{}, &dummy.DummyPreconditionRequiredError{Message: "If-Match header or version is required"}
 	}
+	return row, nil
+}
 
-	item, err := s.queries.GetItem(ctx, db.GetItemParams{ID: itemID, OwnerID: ownerID})
+// updateItem writes the new values if the item still has the version that
+// was checked; a concurrent update in between is reported as a conflict.
+func (s *Service) updateItem(ctx context.Context, current db.GetItemRow, name string, description *string) (*dummy.Item, error) {
+	item, err := s.queries.UpdateItem(ctx, db.UpdateItemParams{
+		ID:          current.Item.ID,
+		OwnerID:     current.Item.OwnerID,
+		Name:        name,
+		Description: description,
+		Version:     current.Item.Version,
+	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, &dummy.DummyNotFoundError{Message: "item not found"}
+			latest, getErr := s.queries.GetItem(ctx, db.GetItemParams{UserID: current.Item.OwnerID, ID: current.Item.ID})
+			if errors.Is(getErr, pgx.ErrNoRows) {
+				return nil, &dummy.DummyNotFoundError{Message: "item not found"}
+			}
+			if getErr != nil {
+				return nil, fmt.Errorf("get item: %w", getErr)
+			}
+			return nil, conflictError(latest.Item.Version)
 		}
-		return nil, fmt.Errorf("get item: %w", err)
+		return nil, fmt.Errorf("update item: %w", err)
 	}
 
-	return mapItem(item), nil
+	result := mapItem(item, current.Permission)
+	if err := s.attachTags(ctx, result); err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "updated item", "itemID", result.ID, "ownerID", result.OwnerID, "version", result.Version)
+	return result, nil
 }
 
-// DeleteItem removes an entry owned by the authenticated user.
+// DeleteItem moves an entry owned by the authenticated user to the trash.
 func (s *Service) DeleteItem(ctx context.Context, payload *dummy.ItemIDPayload) error {
 	claims, err := s.authorize(ctx, payload.Token)
 	if err != nil {
 		return err
 	}
 
+	return s.deleteItem(ctx, claims, payload.ID)
+}
+
+func (s *Service) deleteItem(ctx context.Context, claims *auth.Claims, id string) error {
 	ownerID, err := toUUID(claims.UserID)
 	if err != nil {
 		return &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
 	}
 
-	itemID, err := toUUID(payload.ID)
+	itemID, err := toUUID(id)
 	if err != nil {
 		return &dummy.DummyNotFoundError{Message: "invalid item id"}
 	}
 
-	if err := s.queries.DeleteItem(ctx, db.DeleteItemParams{ID: itemID, OwnerID: ownerID}); err != nil {
+	// Items of other owners are reported as missing so that their ids are
+	// not disclosed.
+	deleted, err := s.queries.DeleteItem(ctx, db.DeleteItemParams{ID: itemID, OwnerID: ownerID})
+	if err != nil {
 		return fmt.Errorf("delete item: %w", err)
 	}
+	if deleted == 0 {
+		return &dummy.DummyNotFoundError{Message: "item not found"}
+	}
 
-	s.log.InfoContext(ctx, "deleted item", "itemID", payload.ID, "ownerID", claims.UserID)
+	s.log.InfoContext(ctx, "moved item to trash", "itemID", id, "ownerID", claims.UserID)
 	return nil
 }
 
@@ -148,10 +371,17 @@ func (s *Service) authorize(ctx context.Context, raw string) (*auth.Claims, erro
 	if err != nil {
 		return nil, &dummy.DummyUnauthorizedError{Message: err.Error()}
 	}
+	if claims.Actor != nil {
+		s.log.InfoContext(ctx, "request made with impersonation token", "userID", claims.UserID, "actorID", claims.Actor.UserID, "actorEmail", claims.Actor.Email)
+	}
 
 	return claims, nil
 }
 
+func ptr[T any](v T) *T {
+	return &v
+}
+
 func extractToken(value string) string {
 	value = strings.TrimSpace(value)
 	if value == "" {
@@ -175,7 +405,31 @@ func toUUID(id string) (pgtype.UUID, error) {
 	return result, nil
 }
 
-func mapItem(item db.Item) *dummy.Item {
+// etag formats the entity tag of an item version.
+func etag(version int32) string {
+	return strconv.Quote(strconv.Itoa(int(version)))
+}
+
+// etagMatches reports whether an If-Match header value, a comma-separated
+// list of entity tags, names version. Weak tags are compared by value.
+func etagMatches(header string, version int32) bool {
+	want := etag(version)
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag == "*" || tag == want {
+			return true
+		}
+	}
+	return false
+}
+
+func conflictError(version int32) *dummy.DummyConflictError {
+	current := int(version)
+	return &dummy.DummyConflictError{Message: "item was modified by another request", CurrentVersion: &current}
+}
+
+// mapItem converts an item, as seen by a caller with the given permission.
+func mapItem(item db.Item, permission string) *dummy.Item {
 	createdAt := time.Now().UTC()
 	if item.CreatedAt.Valid {
 		createdAt = item.CreatedAt.Time
@@ -191,11 +445,26 @@ func mapItem(item db.Item) *dummy.Item {
 		id = item.ID.String()
 	}
 
-	return &dummy.Item{
+	updatedAt := createdAt
+	if item.UpdatedAt.Valid {
+		updatedAt = item.UpdatedAt.Time
+	}
+
+	result := &dummy.Item{
 		ID:          id,
 		Name:        item.Name,
 		Description: item.Description,
 		OwnerID:     owner,
 		CreatedAt:   createdAt.UTC().Format(time.RFC3339),
+		Version:     int(item.Version),
+		UpdatedAt:   updatedAt.UTC().Format(time.RFC3339),
+		Etag:        etag(item.Version),
+		Language:    item.SearchLanguage,
+		Permission:  permission,
+		Tags:        []string{},
+	}
+	if item.DeletedAt.Valid {
+		result.DeletedAt = ptr(item.DeletedAt.Time.UTC().Format(time.RFC3339))
 	}
+	return result
 }
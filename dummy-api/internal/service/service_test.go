@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/auth"
+)
+
+func assertNotFound(t *testing.T, op string, err error) {
+	t.Helper()
+	var notFound *dummy.DummyNotFoundError
+	if !errors.As(err, &notFound) {
+		t.Errorf("%s error = %v, want not_found", op, err)
+	}
+}
+
+// Every method that reads or changes an item must treat another user's item
+// as missing, both while it is live and once it is in the trash.
+func assertConflict(t *testing.T, op string, err error, currentVersion int) {
+	t.Helper()
+	var conflict *dummy.DummyConflictError
+	if !errors.As(err, &conflict) {
+		t.Errorf("%s error = %v, want conflict", op, err)
+		return
+	}
+	if conflict.CurrentVersion == nil || *conflict.CurrentVersion != currentVersion {
+		t.Errorf("%s current_version = %v, want %d", op, conflict.CurrentVersion, currentVersion)
+	}
+}
+
+func TestOtherUsersItemsAreNotFound(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner, other := newTestUser(), newTestUser()
+	item := createTestItem(t, svc, owner, "private", ptr("owner only"))
+	version := int(item.Version)
+
+	_, err := svc.GetItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: other})
+	assertNotFound(t, "GetItem", err)
+	_, err = svc.UpdateItem(ctx, &dummy.UpdateItemPayload{ID: item.ID, Name: "stolen", Version: &version, Token: other})
+	assertNotFound(t, "UpdateItem", err)
+	_, err = svc.PatchItem(ctx, &dummy.PatchItemPayload{ID: item.ID, Name: ptr("stolen"), Version: &version, Token: other})
+	assertNotFound(t, "PatchItem", err)
+	// DeleteItem relies on the number of rows its UPDATE affected.
+	assertNotFound(t, "DeleteItem", svc.DeleteItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: other}))
+	assertNotFound(t, "PurgeItem", svc.PurgeItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: other}))
+
+	got, err := svc.GetItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner})
+	if err != nil {
+		t.Fatalf("owner GetItem error = %v", err)
+	}
+	if got.Name != "private" || got.Version != item.Version {
+		t.Fatalf("item changed by another user: %+v", got)
+	}
+
+	if err := svc.DeleteItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner}); err != nil {
+		t.Fatalf("owner DeleteItem error = %v", err)
+	}
+	_, err = svc.RestoreItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: other})
+	assertNotFound(t, "RestoreItem", err)
+	assertNotFound(t, "PurgeItem of trashed item", svc.PurgeItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: other}))
+
+	if _, err := svc.RestoreItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner}); err != nil {
+		t.Fatalf("owner RestoreItem error = %v", err)
+	}
+}
+
+func TestDeleteItemReportsAffectedRows(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner := newTestUser()
+	item := createTestItem(t, svc, owner, "once", nil)
+
+	if err := svc.DeleteItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner}); err != nil {
+		t.Fatalf("DeleteItem error = %v", err)
+	}
+	// The item is already in the trash, so the UPDATE matches no row.
+	assertNotFound(t, "second DeleteItem", svc.DeleteItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner}))
+	assertNotFound(t, "DeleteItem of unknown item", svc.DeleteItem(ctx, &dummy.ItemIDPayload{ID: "00000000-0000-0000-0000-000000000000", Token: owner}))
+}
+
+func TestBatchDeleteOtherUsersItems(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner, other := newTestUser(), newTestUser()
+	item := createTestItem(t, svc, owner, "private", nil)
+
+	result, err := svc.BatchDeleteItems(ctx, &dummy.BatchDeleteItemsPayload{Ids: []string{item.ID}, Token: other})
+	if err != nil {
+		t.Fatalf("BatchDeleteItems error = %v", err)
+	}
+	if len(result.Results) != 1 || result.Results[0].Error == nil || result.Results[0].Error.Name != "not_found" {
+		t.Fatalf("BatchDeleteItems results = %+v, want one not_found entry", result.Results)
+	}
+	if _, err := svc.GetItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner}); err != nil {
+		t.Errorf("item deleted by another user: %v", err)
+	}
+}
+
+func TestUpdateItemVersionConflict(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner := newTestUser()
+	item := createTestItem(t, svc, owner, "draft", ptr("first"))
+	read := int(item.Version)
+
+	updated, err := svc.UpdateItem(ctx, &dummy.UpdateItemPayload{ID: item.ID, Name: "final", Version: &read, Token: owner})
+	if err != nil {
+		t.Fatalf("UpdateItem error = %v", err)
+	}
+	if updated.Version != item.Version+1 || updated.Description != nil {
+		t.Errorf("updated item = %+v, want version %d without description", updated, item.Version+1)
+	}
+
+	// Writes based on the first version are refused and name the current one.
+	current := int(updated.Version)
+	_, err = svc.UpdateItem(ctx, &dummy.UpdateItemPayload{ID: item.ID, Name: "lost", Version: &read, Token: owner})
+	assertConflict(t, "UpdateItem with a stale version", err, current)
+	_, err = svc.PatchItem(ctx, &dummy.PatchItemPayload{ID: item.ID, Name: ptr("lost"), Version: &read, Token: owner})
+	assertConflict(t, "PatchItem with a stale version", err, current)
+	_, err = svc.PatchItem(ctx, &dummy.PatchItemPayload{ID: item.ID, Name: ptr("lost"), IfMatch: ptr(item.Etag), Token: owner})
+	assertConflict(t, "PatchItem with a stale If-Match", err, current)
+
+	// If-Match wins over the version field.
+	patched, err := svc.PatchItem(ctx, &dummy.PatchItemPayload{ID: item.ID, Description: ptr("second"), IfMatch: ptr(updated.Etag), Version: &read, Token: owner})
+	if err != nil {
+		t.Fatalf("PatchItem error = %v", err)
+	}
+	if patched.Name != "final" || patched.Description == nil || *patched.Description != "second" {
+		t.Errorf("patched item = %+v, want name final and description second", patched)
+	}
+
+	_, err = svc.PatchItem(ctx, &dummy.PatchItemPayload{ID: item.ID, Name: ptr("blind"), Token: owner})
+	var required *dummy.DummyPreconditionRequiredError
+	if !errors.As(err, &required) {
+		t.Errorf("PatchItem without a version error = %v, want precondition_required", err)
+	}
+}
+
+// A write that passed the version check can still lose the race against a
+// concurrent one; the UPDATE itself must then refuse it.
+func TestUpdateItemConcurrentWrite(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner := newTestUser()
+	item := createTestItem(t, svc, owner, "draft", nil)
+
+	claims := &auth.Claims{UserID: strings.TrimPrefix(owner, "Bearer ")}
+	checked, err := svc.itemForUpdate(ctx, claims, item.ID, nil, ptr(int(item.Version)))
+	if err != nil {
+		t.Fatalf("itemForUpdate error = %v", err)
+	}
+
+	read := int(item.Version)
+	winner, err := svc.UpdateItem(ctx, &dummy.UpdateItemPayload{ID: item.ID, Name: "winner", Version: &read, Token: owner})
+	if err != nil {
+		t.Fatalf("UpdateItem error = %v", err)
+	}
+
+	_, err = svc.updateItem(ctx, checked, "loser", nil)
+	assertConflict(t, "updateItem after a concurrent write", err, int(winner.Version))
+
+	got, err := svc.GetItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner})
+	if err != nil {
+		t.Fatalf("GetItem error = %v", err)
+	}
+	if got.Name != "winner" {
+		t.Errorf("name = %q, want winner", got.Name)
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/auth"
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+// Permissions a caller can have on an item. Owners can do anything, editors
+// can read and update the item and viewers can only read it.
+const (
+	permissionOwner  = "owner"
+	permissionEditor = "editor"
+	permissionViewer = "viewer"
+)
+
+// ShareItem grants another user viewer or editor access to an item owned by
+// the caller, replacing the permission the item was shared with before.
+func (s *Service) ShareItem(ctx context.Context, payload *dummy.ShareItemPayload) (*dummy.ItemShare, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.ownedItem(ctx, claims, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := toUUID(payload.UserID)
+	if err != nil {
+		return nil, &dummy.DummyNotFoundError{Message: "invalid user id"}
+	}
+	if userID == row.Item.OwnerID {
+		return nil, &dummy.DummyForbiddenError{Message: "an item cannot be shared with its owner"}
+	}
+
+	share, err := s.queries.UpsertItemShare(ctx, db.UpsertItemShareParams{
+		ItemID:     row.Item.ID,
+		UserID:     userID,
+		Permission: payload.Permission,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("share item: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "shared item", "itemID", payload.ID, "ownerID", claims.UserID, "userID", payload.UserID, "permission", share.Permission)
+	return mapItemShare(share), nil
+}
+
+// UnshareItem revokes a user's access to an item. The owner can revoke any
+// share; other users can only give up their own.
+func (s *Service) UnshareItem(ctx context.Context, payload *dummy.UnshareItemPayload) error {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return err
+	}
+
+	row, err := s.accessibleItem(ctx, claims, payload.ID)
+	if err != nil {
+		return err
+	}
+
+	userID, err := toUUID(payload.UserID)
+	if err != nil {
+		return &dummy.DummyNotFoundError{Message: "invalid user id"}
+	}
+	if row.Permission != permissionOwner && userID.String() != claims.UserID {
+		return &dummy.DummyForbiddenError{Message: "only the owner can unshare an item"}
+	}
+
+	deleted, err := s.queries.DeleteItemShare(ctx, db.DeleteItemShareParams{ItemID: row.Item.ID, UserID: userID})
+	if err != nil {
+		return fmt.Errorf("unshare item: %w", err)
+	}
+	if deleted == 0 {
+		return &dummy.DummyNotFoundError{Message: "item is not shared with this user"}
+	}
+
+	s.log.InfoContext(ctx, "unshared item", "itemID", payload.ID, "userID", payload.UserID, "by", claims.UserID)
+	return nil
+}
+
+// ListItemShares lists the users an item owned by the caller is shared with.
+func (s *Service) ListItemShares(ctx context.Context, payload *dummy.ItemIDPayload) (*dummy.ItemSharesCollection, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.ownedItem(ctx, claims, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	shares, err := s.queries.ListItemShares(ctx, row.Item.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list item shares: %w", err)
+	}
+
+	result := &dummy.ItemSharesCollection{Shares: make([]*dummy.ItemShare, 0, len(shares))}
+	for _, share := range shares {
+		result.Shares = append(result.Shares, mapItemShare(share))
+	}
+	return result, nil
+}
+
+// accessibleItem loads an item owned by or shared with the caller together
+// with the caller's permission. Items the caller cannot see are reported as
+// missing so that their ids are not disclosed.
+func (s *Service) accessibleItem(ctx context.Context, claims *auth.Claims, id string) (db.GetItemRow, error) {
+	userID, err := toUUID(claims.UserID)
+	if err != nil {
+		return db.GetItemRow{}, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	itemID, err := toUUID(id)
+	if err != nil {
+		return db.GetItemRow{}, &dummy.DummyNotFoundError{Message: "invalid item id"}
+	}
+
+	row, err := s.queries.GetItem(ctx, db.GetItemParams{UserID: userID, ID: itemID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.GetItemRow{}, &dummy.DummyNotFoundError{Message: "item not found"}
+		}
+		return db.GetItemRow{}, fmt.Errorf("get item: %w", err)
+	}
+	return row, nil
+}
+
+// ownedItem is accessibleItem for operations reserved to the owner.
+func (s *Service) ownedItem(ctx context.Context, claims *auth.Claims, id string) (db.GetItemRow, error) {
+	row, err := s.accessibleItem(ctx, claims, id)
+	if err != nil {
+		return db.GetItemRow{}, err
+	}
+	if row.Permission != permissionOwner {
+		return db.GetItemRow{}, &dummy.DummyForbiddenError{Message: "only the owner can manage the sharing of an item"}
+	}
+	return row, nil
+}
+
+// editableItem is accessibleItem for operations that need editor access.
+func (s *Service) editableItem(ctx context.Context, claims *auth.Claims, id string) (db.GetItemRow, error) {
+	row, err := s.accessibleItem(ctx, claims, id)
+	if err != nil {
+		return db.GetItemRow{}, err
+	}
+	if row.Permission == permissionViewer {
+		return db.GetItemRow{}, &dummy.DummyForbiddenError{Message: "item is shared with you read-only"}
+	}
+	return row, nil
+}
+
+func mapItemShare(share db.ItemShare) *dummy.ItemShare {
+	return &dummy.ItemShare{
+		ItemID:     share.ItemID.String(),
+		UserID:     share.UserID.String(),
+		Permission: share.Permission,
+		CreatedAt:  share.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+// Tag matching modes accepted by ListItems.
+const (
+	tagMatchAny = "any"
+	tagMatchAll = "all"
+)
+
+// AddItemTag tags an item the caller can edit. Tags belong to the item's
+// owner, so an editor tagging a shared item adds to the owner's tags.
+func (s *Service) AddItemTag(ctx context.Context, payload *dummy.ItemTagPayload) (*dummy.Item, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.editableItem(ctx, claims, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := s.queries.UpsertTag(ctx, db.UpsertTagParams{OwnerID: row.Item.OwnerID, Name: payload.Tag})
+	if err != nil {
+		return nil, fmt.Errorf("upsert tag: %w", err)
+	}
+	if err := s.queries.AddItemTag(ctx, db.AddItemTagParams{ItemID: row.Item.ID, TagID: tag.ID}); err != nil {
+		return nil, fmt.Errorf("add item tag: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "tagged item", "itemID", payload.ID, "tag", tag.Name, "userID", claims.UserID)
+	result := mapItem(row.Item, row.Permission)
+	if err := s.attachTags(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveItemTag removes a tag from an item the caller can edit.
+func (s *Service) RemoveItemTag(ctx context.Context, payload *dummy.ItemTagPayload) (*dummy.Item, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.editableItem(ctx, claims, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := s.queries.RemoveItemTag(ctx, db.RemoveItemTagParams{ItemID: row.Item.ID, Name: payload.Tag})
+	if err != nil {
+		return nil, fmt.Errorf("remove item tag: %w", err)
+	}
+	if removed == 0 {
+		return nil, &dummy.DummyNotFoundError{Message: "item does not have this tag"}
+	}
+
+	s.log.InfoContext(ctx, "untagged item", "itemID", payload.ID, "tag", payload.Tag, "userID", claims.UserID)
+	result := mapItem(row.Item, row.Permission)
+	if err := s.attachTags(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SuggestTags returns the caller's tags starting with a prefix, most used
+// first. Tags no longer on any item are left out.
+func (s *Service) SuggestTags(ctx context.Context, payload *dummy.SuggestTagsPayload) (*dummy.TagSuggestions, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := toUUID(claims.UserID)
+	if err != nil {
+		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	rows, err := s.queries.SuggestTags(ctx, db.SuggestTagsParams{
+		OwnerID:    ownerID,
+		Prefix:     strings.TrimSpace(payload.Prefix),
+		MaxResults: int32(payload.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("suggest tags: %w", err)
+	}
+
+	result := &dummy.TagSuggestions{Tags: make([]*dummy.TagSuggestion, 0, len(rows))}
+	for _, row := range rows {
+		result.Tags = append(result.Tags, &dummy.TagSuggestion{Name: row.Name, ItemCount: row.ItemCount})
+	}
+	return result, nil
+}
+
+// attachTags fills in the tags of items with a single query.
+func (s *Service) attachTags(ctx context.Context, items ...*dummy.Item) error {
+	if len(items) == 0 {
+		return nil
+	}
+	ids := make([]pgtype.UUID, 0, len(items))
+	byID := make(map[string]*dummy.Item, len(items))
+	for _, item := range items {
+		id, err := toUUID(item.ID)
+		if err != nil {
+			return fmt.Errorf("attach tags: %w", err)
+		}
+		ids = append(ids, id)
+		byID[item.ID] = item
+	}
+
+	rows, err := s.queries.ListItemTags(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("list item tags: %w", err)
+	}
+	for _, row := range rows {
+		if item, ok := byID[row.ItemID.String()]; ok {
+			item.Tags = append(item.Tags, row.Name)
+		}
+	}
+	return nil
+}
+
+// normalizeTags lowercases and deduplicates a tag filter. An empty filter
+// yields nil, which disables filtering.
+func normalizeTags(tags []string) []string {
+	var normalized []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag != "" && !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	return normalized
+}
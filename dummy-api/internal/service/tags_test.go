@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		tags []string
+		want []string
+	}{
+		{tags: nil, want: nil},
+		{tags: []string{" ", ""}, want: nil},
+		{tags: []string{"Go", " go ", "GO"}, want: []string{"go"}},
+		{tags: []string{"db", "Go", "DB"}, want: []string{"db", "go"}},
+	}
+	for _, tt := range tests {
+		if got := normalizeTags(tt.tags); !slices.Equal(got, tt.want) {
+			t.Errorf("normalizeTags(%q) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+}
+
+func tagTestItem(t *testing.T, svc *Service, token string, item *dummy.Item, tags ...string) {
+	t.Helper()
+	for _, tag := range tags {
+		if _, err := svc.AddItemTag(context.Background(), &dummy.ItemTagPayload{ID: item.ID, Tag: tag, Token: token}); err != nil {
+			t.Fatalf("AddItemTag(%s, %s) error = %v", item.Name, tag, err)
+		}
+	}
+}
+
+func TestListItemsByTags(t *testing.T) {
+	svc := newTestService(t)
+	owner := newTestUser()
+	tagTestItem(t, svc, owner, createTestItem(t, svc, owner, "both", nil), "Go", "db")
+	tagTestItem(t, svc, owner, createTestItem(t, svc, owner, "go", nil), "go")
+	tagTestItem(t, svc, owner, createTestItem(t, svc, owner, "db", nil), "DB")
+	createTestItem(t, svc, owner, "untagged", nil)
+
+	tests := []struct {
+		name     string
+		tags     []string
+		tagMatch string
+		want     []string
+	}{
+		{name: "any", tags: []string{"GO", " db "}, tagMatch: tagMatchAny, want: []string{"db:owner", "go:owner", "both:owner"}},
+		{name: "all", tags: []string{"GO", " db "}, tagMatch: tagMatchAll, want: []string{"both:owner"}},
+		// Repeating a tag must not raise the number of tags an item needs.
+		{name: "all with duplicates", tags: []string{"go", "Go"}, tagMatch: tagMatchAll, want: []string{"go:owner", "both:owner"}},
+		{name: "unknown tag", tags: []string{"rust"}, tagMatch: tagMatchAny, want: nil},
+		{name: "blank tags", tags: []string{" "}, tagMatch: tagMatchAll, want: []string{"untagged:owner", "db:owner", "go:owner", "both:owner"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := dummy.ListItemsPayload{PageSize: 2, Tags: tt.tags, TagMatch: tt.tagMatch, Token: owner}
+			if got := listAll(t, svc, payload); !slices.Equal(got, tt.want) {
+				t.Errorf("listed %q, want %q", got, tt.want)
+			}
+
+			payload.IncludeTotal = true
+			result, err := svc.ListItems(context.Background(), &payload)
+			if err != nil {
+				t.Fatalf("ListItems() error = %v", err)
+			}
+			if result.Total == nil || *result.Total != int64(len(tt.want)) {
+				t.Errorf("total = %v, want %d", result.Total, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestSuggestTags(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner := newTestUser()
+	first := createTestItem(t, svc, owner, "first", nil)
+	second := createTestItem(t, svc, owner, "second", nil)
+	trashed := createTestItem(t, svc, owner, "trashed", nil)
+	// A tag keeps the spelling it was first added with.
+	tagTestItem(t, svc, owner, first, "Go", "golang", "db")
+	tagTestItem(t, svc, owner, second, "golang", "go")
+	tagTestItem(t, svc, owner, trashed, "gopher")
+	if err := svc.DeleteItem(ctx, &dummy.ItemIDPayload{ID: trashed.ID, Token: owner}); err != nil {
+		t.Fatalf("DeleteItem error = %v", err)
+	}
+	// Another user's tags are never suggested.
+	other := newTestUser()
+	tagTestItem(t, svc, other, createTestItem(t, svc, other, "other", nil), "gorm")
+
+	tests := []struct {
+		prefix string
+		limit  int
+		want   []string
+	}{
+		{prefix: "go", limit: 10, want: []string{"Go:2", "golang:2"}},
+		{prefix: " GOL ", limit: 10, want: []string{"golang:2"}},
+		{prefix: "", limit: 2, want: []string{"Go:2", "golang:2"}},
+		{prefix: "x", limit: 10, want: nil},
+	}
+	for _, tt := range tests {
+		result, err := svc.SuggestTags(ctx, &dummy.SuggestTagsPayload{Prefix: tt.prefix, Limit: tt.limit, Token: owner})
+		if err != nil {
+			t.Fatalf("SuggestTags(%q) error = %v", tt.prefix, err)
+		}
+		var got []string
+		for _, tag := range result.Tags {
+			got = append(got, tag.Name+":"+strconv.FormatInt(tag.ItemCount, 10))
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("SuggestTags(%q, %d) = %q, want %q", tt.prefix, tt.limit, got, tt.want)
+		}
+	}
+}
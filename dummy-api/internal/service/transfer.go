@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/itemio"
+)
+
+// maxImportRows bounds the rows of a file import, which is validated in
+// memory before anything is written.
+const maxImportRows = 10000
+
+// ExportItems streams the caller's items in the requested format. Rows are
+// written as they are read from the database, so an error part way through
+// ends the response early rather than changing its status.
+func (s *Service) ExportItems(ctx context.Context, payload *dummy.ExportItemsPayload) (*dummy.ItemsExport, io.ReadCloser, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ownerID, err := toUUID(claims.UserID)
+	if err != nil {
+		return nil, nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	reader, writer := io.Pipe()
+	go func() {
+		written, err := itemio.Export(ctx, s.queries, ownerID, writer, payload.Format)
+		if err != nil {
+			s.log.ErrorContext(ctx, "export items failed", "ownerID", claims.UserID, "written", written, "error", err)
+		} else {
+			s.log.InfoContext(ctx, "exported items", "ownerID", claims.UserID, "count", written, "format", payload.Format)
+		}
+		writer.CloseWithError(err)
+	}()
+
+	result := &dummy.ItemsExport{
+		ContentType:        itemio.ContentType(payload.Format),
+		ContentDisposition: mime.FormatMediaType("attachment", map[string]string{"filename": "items." + payload.Format}),
+	}
+	return result, reader, nil
+}
+
+// ImportItemsFile creates items from an uploaded file. The whole file is
+// validated before anything is written, and the items are created in one
+// transaction, so a file is imported completely or not at all.
+func (s *Service) ImportItemsFile(ctx context.Context, payload *dummy.ImportItemsFilePayload, body io.ReadCloser) (*dummy.ImportReport, error) {
+	defer body.Close()
+
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := toUUID(claims.UserID)
+	if err != nil {
+		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	rows, rowErrors, err := itemio.Parse(body, payload.Format, maxImportRows)
+	if err != nil {
+		if errors.Is(err, itemio.ErrTooManyRows) {
+			return nil, &dummy.DummyTooLargeError{Message: fmt.Sprintf("imports are limited to %d rows", maxImportRows)}
+		}
+		return nil, fmt.Errorf("parse import: %w", err)
+	}
+
+	report := &dummy.ImportReport{
+		DryRun: payload.DryRun,
+		Rows:   len(rows) + len(rowErrors),
+		Failed: len(rowErrors),
+		Errors: make([]*dummy.ImportRowError, 0, min(len(rowErrors), maxImportErrors)),
+	}
+	for _, rowError := range rowErrors[:min(len(rowErrors), maxImportErrors)] {
+		report.Errors = append(report.Errors, &dummy.ImportRowError{Line: rowError.Line, Message: rowError.Message})
+	}
+	if payload.DryRun || len(rowErrors) > 0 {
+		return report, nil
+	}
+
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	if err := itemio.Import(ctx, s.queries.WithTx(tx), ownerID, rows, s.searchLanguage); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit import: %w", err)
+	}
+	report.Imported = len(rows)
+
+	s.log.InfoContext(ctx, "imported items", "ownerID", claims.UserID, "count", report.Imported, "format", payload.Format)
+	return report, nil
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	db "github.com/vidwadeseram/go-boilerplate/dummy-api/internal/db/sqlc"
+)
+
+// ListTrash returns the caller's trashed items, most recently deleted first.
+func (s *Service) ListTrash(ctx context.Context, payload *dummy.ListTrashPayload) (*dummy.ItemsCollection, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := toUUID(claims.UserID)
+	if err != nil {
+		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	pageSize := payload.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	params := db.ListTrashParams{
+		OwnerID: ownerID,
+		// One extra row tells whether another page follows.
+		PageSize: int32(pageSize) + 1,
+	}
+	if payload.Cursor != nil && *payload.Cursor != "" {
+		params.CursorDeletedAt, params.CursorID, err = decodeCursor(*payload.Cursor, orderTrash)
+		if err != nil {
+			return nil, &dummy.DummyBadRequestError{Message: err.Error()}
+		}
+	}
+
+	rows, err := s.queries.ListTrash(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("list trash: %w", err)
+	}
+
+	result := &dummy.ItemsCollection{}
+	if len(rows) > pageSize {
+		rows = rows[:pageSize]
+		last := rows[len(rows)-1]
+		result.NextCursor = ptr(encodeCursor(last.DeletedAt, last.ID, orderTrash))
+	}
+	result.Items = make([]*dummy.Item, 0, len(rows))
+	for _, row := range rows {
+		result.Items = append(result.Items, mapItem(row, permissionOwner))
+	}
+	if err := s.attachTags(ctx, result.Items...); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RestoreItem moves a trashed entry owned by the caller back out of the trash.
+func (s *Service) RestoreItem(ctx context.Context, payload *dummy.ItemIDPayload) (*dummy.Item, error) {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID, err := toUUID(claims.UserID)
+	if err != nil {
+		return nil, &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	itemID, err := toUUID(payload.ID)
+	if err != nil {
+		return nil, &dummy.DummyNotFoundError{Message: "invalid item id"}
+	}
+
+	item, err := s.queries.RestoreItem(ctx, db.RestoreItemParams{ID: itemID, OwnerID: ownerID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &dummy.DummyNotFoundError{Message: "item not found in trash"}
+		}
+		return nil, fmt.Errorf("restore item: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "restored item", "itemID", payload.ID, "ownerID", claims.UserID)
+	result := mapItem(item, permissionOwner)
+	if err := s.attachTags(ctx, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// PurgeItem permanently deletes a trashed entry owned by the caller.
+func (s *Service) PurgeItem(ctx context.Context, payload *dummy.ItemIDPayload) error {
+	claims, err := s.authorize(ctx, payload.Token)
+	if err != nil {
+		return err
+	}
+
+	ownerID, err := toUUID(claims.UserID)
+	if err != nil {
+		return &dummy.DummyUnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	itemID, err := toUUID(payload.ID)
+	if err != nil {
+		return &dummy.DummyNotFoundError{Message: "invalid item id"}
+	}
+
+	rows, err := s.queries.PurgeItem(ctx, db.PurgeItemParams{ID: itemID, OwnerID: ownerID})
+	if err != nil {
+		return fmt.Errorf("purge item: %w", err)
+	}
+	if len(rows) == 0 {
+		return &dummy.DummyNotFoundError{Message: "item not found in trash"}
+	}
+	for _, row := range rows {
+		if row.StorageKey != nil {
+			s.deleteBlobs(ctx, *row.StorageKey)
+		}
+	}
+
+	s.log.InfoContext(ctx, "purged item", "itemID", payload.ID, "ownerID", claims.UserID)
+	return nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/gen/dummy"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/blob"
+	"github.com/vidwadeseram/go-boilerplate/dummy-api/internal/purge"
+)
+
+// trashNames returns the names of the caller's trashed items.
+func trashNames(t *testing.T, svc *Service, token string) []string {
+	t.Helper()
+	result, err := svc.ListTrash(context.Background(), &dummy.ListTrashPayload{PageSize: 100, Token: token})
+	if err != nil {
+		t.Fatalf("ListTrash error = %v", err)
+	}
+	var names []string
+	for _, item := range result.Items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func TestTrashRestoreAndPurge(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner := newTestUser()
+	kept := createTestItem(t, svc, owner, "kept", nil)
+	restored := createTestItem(t, svc, owner, "restored", nil)
+	purged := createTestItem(t, svc, owner, "purged", nil)
+
+	// Only trashed items can be purged.
+	assertNotFound(t, "PurgeItem of a live item", svc.PurgeItem(ctx, &dummy.ItemIDPayload{ID: kept.ID, Token: owner}))
+	_, err := svc.RestoreItem(ctx, &dummy.ItemIDPayload{ID: kept.ID, Token: owner})
+	assertNotFound(t, "RestoreItem of a live item", err)
+
+	for _, item := range []*dummy.Item{restored, purged} {
+		if err := svc.DeleteItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner}); err != nil {
+			t.Fatalf("DeleteItem(%s) error = %v", item.Name, err)
+		}
+	}
+	_, err = svc.GetItem(ctx, &dummy.ItemIDPayload{ID: restored.ID, Token: owner})
+	assertNotFound(t, "GetItem of a trashed item", err)
+	if got := listAll(t, svc, dummy.ListItemsPayload{PageSize: 10, Token: owner}); !slices.Equal(got, []string{"kept:owner"}) {
+		t.Errorf("listed %q, want only the live item", got)
+	}
+	if got := trashNames(t, svc, owner); !slices.Equal(got, []string{"purged", "restored"}) {
+		t.Errorf("trash = %q, want [purged restored]", got)
+	}
+
+	item, err := svc.RestoreItem(ctx, &dummy.ItemIDPayload{ID: restored.ID, Token: owner})
+	if err != nil {
+		t.Fatalf("RestoreItem error = %v", err)
+	}
+	if item.Name != "restored" || item.Version != restored.Version {
+		t.Errorf("restored item = %+v, want it unchanged", item)
+	}
+	if err := svc.PurgeItem(ctx, &dummy.ItemIDPayload{ID: purged.ID, Token: owner}); err != nil {
+		t.Fatalf("PurgeItem error = %v", err)
+	}
+	_, err = svc.RestoreItem(ctx, &dummy.ItemIDPayload{ID: purged.ID, Token: owner})
+	assertNotFound(t, "RestoreItem of a purged item", err)
+
+	if got := trashNames(t, svc, owner); len(got) != 0 {
+		t.Errorf("trash = %q, want it empty", got)
+	}
+	if got := listAll(t, svc, dummy.ListItemsPayload{PageSize: 10, Token: owner}); !slices.Equal(got, []string{"restored:owner", "kept:owner"}) {
+		t.Errorf("listed %q, want the restored and the live item", got)
+	}
+}
+
+func TestPurgerDeletesExpiredItems(t *testing.T) {
+	svc := newTestService(t)
+	ctx := context.Background()
+	owner := newTestUser()
+	expired := createTestItem(t, svc, owner, "expired", nil)
+	recent := createTestItem(t, svc, owner, "recent", nil)
+	for _, item := range []*dummy.Item{expired, recent} {
+		if err := svc.DeleteItem(ctx, &dummy.ItemIDPayload{ID: item.ID, Token: owner}); err != nil {
+			t.Fatalf("DeleteItem(%s) error = %v", item.Name, err)
+		}
+	}
+	if _, err := svc.pool.Exec(ctx, "UPDATE items SET deleted_at = NOW() - interval '2 days' WHERE id = $1", expired.ID); err != nil {
+		t.Fatalf("age trashed item: %v", err)
+	}
+
+	blobs, err := blob.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	// A batch size of one makes the purger work through several batches
+	// when earlier runs left expired items behind.
+	purger := purge.NewPurger(log, svc.queries, blobs, purge.Options{Retention: 24 * time.Hour, BatchSize: 1})
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() { done <- purger.Run(runCtx) }()
+	defer func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("Run() error = %v", err)
+		}
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		got := trashNames(t, svc, owner)
+		if slices.Equal(got, []string{"recent"}) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("trash = %q, want only the recently deleted item", got)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_items_owner;
+CREATE INDEX IF NOT EXISTS idx_items_owner ON items(owner_id);
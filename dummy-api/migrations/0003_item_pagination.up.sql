@@ -0,0 +1,4 @@
+-- Widen the owner index so keyset pagination over (created_at, id) is an
+-- index range scan in either direction.
+DROP INDEX IF EXISTS idx_items_owner;
+CREATE INDEX IF NOT EXISTS idx_items_owner ON items(owner_id, created_at, id);
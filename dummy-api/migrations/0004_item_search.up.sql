@@ -0,0 +1,9 @@
+-- Items are indexed with the text search configuration they were created
+-- with, so stemming matches the language of their text.
+ALTER TABLE items ADD COLUMN IF NOT EXISTS search_language regconfig NOT NULL DEFAULT 'english';
+ALTER TABLE items ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector(search_language, name), 'A') ||
+    setweight(to_tsvector(search_language, coalesce(description, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_items_search ON items USING GIN (search_vector);
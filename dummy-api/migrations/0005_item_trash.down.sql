@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_items_deleted_at;
+DROP INDEX IF EXISTS idx_items_trash;
+
+ALTER TABLE items DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,5 @@
+ALTER TABLE items ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Trash listings and the purger only look at deleted rows.
+CREATE INDEX IF NOT EXISTS idx_items_trash ON items(owner_id, deleted_at, id) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_items_deleted_at ON items(deleted_at) WHERE deleted_at IS NOT NULL;
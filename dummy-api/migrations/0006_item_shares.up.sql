@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS item_shares (
+    item_id UUID NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    permission TEXT NOT NULL CHECK (permission IN ('viewer', 'editor')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (item_id, user_id)
+);
+
+-- Listing finds the items shared with the caller.
+CREATE INDEX IF NOT EXISTS idx_item_shares_user ON item_shares(user_id, item_id);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS tags (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    owner_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Tag names are unique per owner regardless of case.
+CREATE UNIQUE INDEX IF NOT EXISTS tags_owner_name_key ON tags(owner_id, lower(name));
+
+CREATE TABLE IF NOT EXISTS item_tags (
+    item_id UUID NOT NULL REFERENCES items(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (item_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_item_tags_tag ON item_tags(tag_id, item_id);
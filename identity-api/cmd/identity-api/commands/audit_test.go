@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+func TestExportAuthEvents(t *testing.T) {
+	userID := pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true}
+	created := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	var stored []db.AuthEvent
+	for id := int64(1); id <= auditExportBatchSize+2; id++ {
+		event := db.AuthEvent{ID: id, EventType: "login", Success: id%2 == 0, CreatedAt: pgtype.Timestamptz{Time: created, Valid: true}}
+		if id == 1 {
+			event.UserID = userID
+			event.Email = ptr("jane@example.com")
+			event.Reason = ptr("account \"suspended\"\n")
+		}
+		stored = append(stored, event)
+	}
+
+	var calls []db.ExportAuthEventsParams
+	fetch := func(_ context.Context, params db.ExportAuthEventsParams) ([]db.AuthEvent, error) {
+		calls = append(calls, params)
+		var page []db.AuthEvent
+		for _, event := range stored {
+			if event.ID > params.AfterID && len(page) < int(params.RowLimit) {
+				page = append(page, event)
+			}
+		}
+		return page, nil
+	}
+
+	var out bytes.Buffer
+	params := db.ExportAuthEventsParams{EventType: ptr("login")}
+	if err := exportAuthEvents(context.Background(), fetch, params, &out); err != nil {
+		t.Fatalf("exportAuthEvents() error = %v", err)
+	}
+
+	if len(calls) != 2 || calls[0].AfterID != 0 || calls[1].AfterID != auditExportBatchSize {
+		t.Fatalf("fetched after ids %v, want 0 then %d", calls, auditExportBatchSize)
+	}
+	for _, call := range calls {
+		if call.RowLimit != auditExportBatchSize || call.EventType == nil || *call.EventType != "login" {
+			t.Errorf("fetch params = %+v, want the filters with a limit of %d", call, auditExportBatchSize)
+		}
+	}
+
+	scanner := bufio.NewScanner(&out)
+	var lines []map[string]any
+	for scanner.Scan() {
+		var line map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatalf("line %d is not JSON: %v", len(lines)+1, err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != len(stored) {
+		t.Fatalf("exported %d lines, want %d", len(lines), len(stored))
+	}
+	for i, line := range lines {
+		if line["id"] != float64(i+1) {
+			t.Fatalf("line %d has id %v, want %d", i+1, line["id"], i+1)
+		}
+	}
+
+	first := lines[0]
+	want := map[string]any{
+		"event_type": "login",
+		"success":    false,
+		"user_id":    userID.String(),
+		"email":      "jane@example.com",
+		"reason":     "account \"suspended\"\n",
+		"ip_address": nil,
+		"created_at": "2026-01-02T03:04:05Z",
+	}
+	for key, value := range want {
+		if first[key] != value {
+			t.Errorf("first event %s = %#v, want %#v", key, first[key], value)
+		}
+	}
+	if lines[1]["user_id"] != nil {
+		t.Errorf("event without a user has user_id %v, want null", lines[1]["user_id"])
+	}
+}
+
+func TestExportAuthEventsEmpty(t *testing.T) {
+	var out bytes.Buffer
+	fetch := func(context.Context, db.ExportAuthEventsParams) ([]db.AuthEvent, error) { return nil, nil }
+	if err := exportAuthEvents(context.Background(), fetch, db.ExportAuthEventsParams{}, &out); err != nil {
+		t.Fatalf("exportAuthEvents() error = %v", err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("output = %q, want nothing", out.String())
+	}
+}
+
+func TestExportAuthEventsError(t *testing.T) {
+	fetch := func(context.Context, db.ExportAuthEventsParams) ([]db.AuthEvent, error) {
+		return nil, errors.New("connection reset")
+	}
+	err := exportAuthEvents(context.Background(), fetch, db.ExportAuthEventsParams{}, &bytes.Buffer{})
+	if err == nil || !strings.Contains(err.Error(), "connection reset") {
+		t.Errorf("exportAuthEvents() error = %v, want the fetch error", err)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// requestDecoder extends goa's default decoder with support for
+// application/x-www-form-urlencoded bodies, which OAuth endpoints such as
+// token introspection are required to accept, and for structured JSON media
+// types such as application/scim+json.
+func requestDecoder(r *http.Request) goahttp.Decoder {
+	if mt, _, err := mime.ParseMediaType(r.Header.Get("Content-Type")); err == nil {
+		switch {
+		case mt == "application/x-www-form-urlencoded":
+			return &formDecoder{r: r}
+		case strings.HasSuffix(mt, "+json"):
+			return json.NewDecoder(r.Body)
+		}
+	}
+	return goahttp.RequestDecoder(r)
+}
+
+type formDecoder struct {
+	r *http.Request
+}
+
+// Decode maps the first value of every form field onto the JSON field of the
+// same name in v.
+func (d *formDecoder) Decode(v any) error {
+	if err := d.r.ParseForm(); err != nil {
+		return err
+	}
+	fields := make(map[string]string, len(d.r.PostForm))
+	for key := range d.r.PostForm {
+		fields[key] = d.r.PostForm.Get(key)
+	}
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	goahttp "goa.design/goa/v3/http"
+
+	httpclient "github.com/vidwadeseram/go-boilerplate/identity-api/gen/http/identity/client"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+)
+
+// deviceGrantType is the grant type of the OAuth 2.0 device flow.
+const deviceGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+func newLoginCmd() *cobra.Command {
+	var server, clientID, scope, tokenFile string
+	var audience []string
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Sign in from a terminal using the device authorization flow",
+		Long: "Starts an OAuth 2.0 device authorization, prints a code to enter in a browser " +
+			"and stores the resulting access token in a local file once the request is approved.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := cmd.Context()
+
+			if tokenFile == "" {
+				path, err := defaultTokenFile()
+				if err != nil {
+					return err
+				}
+				tokenFile = path
+			}
+
+			u, err := url.Parse(server)
+			if err != nil {
+				return fmt.Errorf("parse --server: %w", err)
+			}
+			client := httpclient.NewClient(u.Scheme, u.Host, http.DefaultClient, goahttp.RequestEncoder, goahttp.ResponseDecoder, false)
+
+			payload := &identity.DeviceAuthorizationPayload{ClientID: clientID, Audience: audience}
+			if scope != "" {
+				payload.Scope = &scope
+			}
+			res, err := client.DeviceAuthorization()(ctx, payload)
+			if err != nil {
+				return fmt.Errorf("start device authorization: %w", err)
+			}
+			auth := res.(*identity.DeviceAuthorizationResult)
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Open %s and enter the code %s\n", auth.VerificationURI, auth.UserCode)
+			fmt.Fprintf(out, "or visit %s\n", auth.VerificationURIComplete)
+
+			token, err := pollDeviceToken(ctx, client, auth, clientID)
+			if err != nil {
+				return err
+			}
+
+			if err := os.MkdirAll(filepath.Dir(tokenFile), 0o700); err != nil {
+				return fmt.Errorf("create token directory: %w", err)
+			}
+			if err := os.WriteFile(tokenFile, []byte(token.AccessToken+"\n"), 0o600); err != nil {
+				return fmt.Errorf("write token file: %w", err)
+			}
+			fmt.Fprintf(out, "Logged in; token saved to %s (expires in %s)\n", tokenFile, time.Duration(token.ExpiresIn)*time.Second)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&server, "server", "http://localhost:8081", "identity-api HTTP address")
+	cmd.Flags().StringVar(&clientID, "client-id", "cli", "OAuth client id registered for the device flow")
+	cmd.Flags().StringVar(&scope, "scope", "", "space-separated scopes to request")
+	cmd.Flags().StringSliceVar(&audience, "audience", nil, "services the token is intended for, such as dummy-api (default the identity service)")
+	cmd.Flags().StringVar(&tokenFile, "token-file", "", "file to store the access token in (default $XDG_CONFIG_HOME/go-boilerplate/token)")
+
+	return cmd
+}
+
+// pollDeviceToken polls the token endpoint at the advertised interval until
+// the user approves or denies the request or the device code expires.
+func pollDeviceToken(ctx context.Context, client *httpclient.Client, auth *identity.DeviceAuthorizationResult, clientID string) (*identity.DeviceTokenResult, error) {
+	interval := time.Duration(auth.Interval) * time.Second
+	deadline := time.Now().Add(time.Duration(auth.ExpiresIn) * time.Second)
+	endpoint := client.DeviceToken()
+	payload := &identity.DeviceTokenPayload{
+		GrantType:  deviceGrantType,
+		DeviceCode: auth.DeviceCode,
+		ClientID:   clientID,
+	}
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		res, err := endpoint(ctx, payload)
+		if err == nil {
+			return res.(*identity.DeviceTokenResult), nil
+		}
+		var oauthErr *identity.OAuthError
+		if !errors.As(err, &oauthErr) {
+			return nil, fmt.Errorf("poll device token: %w", err)
+		}
+		switch oauthErr.Code {
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		case "access_denied":
+			return nil, fmt.Errorf("login request was denied")
+		case "expired_token":
+			return nil, fmt.Errorf("login request expired; run login again")
+		default:
+			return nil, fmt.Errorf("poll device token: %s", oauthErr.Code)
+		}
+	}
+	return nil, fmt.Errorf("login request expired; run login again")
+}
+
+func defaultTokenFile() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("locate config directory: %w", err)
+	}
+	return filepath.Join(dir, "go-boilerplate", "token"), nil
+}
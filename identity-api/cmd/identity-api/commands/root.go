@@ -21,6 +21,8 @@ func newRootCmd() *cobra.Command {
 
 	cmd.AddCommand(newServeCmd())
 	cmd.AddCommand(newMigrateCmd())
+	cmd.AddCommand(newAuditCmd())
+	cmd.AddCommand(newLoginCmd())
 
 	return cmd
 }
@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// scimErrorBody is a SCIM error response (RFC 7644 section 3.12).
+type scimErrorBody struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+	code     int
+}
+
+// StatusCode implements goahttp.Statuser.
+func (b *scimErrorBody) StatusCode() int {
+	return b.code
+}
+
+// scimErrorFormatter renders errors raised by goa before a request reaches
+// the SCIM service, such as payload validation failures, as SCIM error
+// bodies so that provisioning clients can parse every failure.
+func scimErrorFormatter(ctx context.Context, err error) goahttp.Statuser {
+	code := goahttp.NewErrorResponse(ctx, err).StatusCode()
+	body := &scimErrorBody{
+		Schemas: []string{"urn:ietf:params:scim:api:messages:2.0:Error"},
+		Status:  strconv.Itoa(code),
+		Detail:  err.Error(),
+		code:    code,
+	}
+	if code == http.StatusBadRequest {
+		body.ScimType = "invalidSyntax"
+	}
+	return body
+}
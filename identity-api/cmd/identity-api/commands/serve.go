@@ -18,11 +18,17 @@ import (
 	identitypb "github.com/vidwadeseram/go-boilerplate/identity-api/gen/grpc/identity/pb"
 	grpcserver "github.com/vidwadeseram/go-boilerplate/identity-api/gen/grpc/identity/server"
 	httpserver "github.com/vidwadeseram/go-boilerplate/identity-api/gen/http/identity/server"
+	scimserver "github.com/vidwadeseram/go-boilerplate/identity-api/gen/http/scim/server"
 	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/scim"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
 	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/config"
 	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/mail"
 	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/security"
 	appservice "github.com/vidwadeseram/go-boilerplate/identity-api/internal/service"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
+	grpcmiddleware "goa.design/goa/v3/grpc/middleware"
 	goahttp "goa.design/goa/v3/http"
 	goahttpmiddleware "goa.design/goa/v3/http/middleware"
 )
@@ -48,17 +54,127 @@ func newServeCmd() *cobra.Command {
 			defer pool.Close()
 
 			queries := db.New(pool)
-			tokens := security.NewTokenManager(cfg.JWTSecret, time.Hour)
-			svc := appservice.New(logger, queries, tokens)
-
-			return runServers(ctx, cfg, svc, logger)
+			tokens := security.NewTokenManager(cfg.JWTSecret, time.Hour, security.TokenOptions{
+				Issuer:          cfg.JWTIssuer,
+				Audiences:       cfg.JWTAudiences,
+				DefaultAudience: cfg.JWTAudience,
+				Leeway:          cfg.JWTLeeway,
+			})
+			passwords, err := newPasswordPolicy(cfg)
+			if err != nil {
+				return err
+			}
+			mailer, err := newMailer(cfg)
+			if err != nil {
+				return err
+			}
+			enrichers, err := newEnricherRegistry(cfg)
+			if err != nil {
+				return err
+			}
+			recorder := audit.NewRecorder(logger, queries)
+			svc := appservice.New(logger, queries, tokens, appservice.Options{
+				Passwords:        passwords,
+				Usernames:        security.NewUsernamePolicy(cfg.ReservedUsernames),
+				Audit:            recorder,
+				Clients:          security.NewClientRegistry(cfg.OAuthClients),
+				AdminEmails:      cfg.AdminEmails,
+				ImpersonationTTL: cfg.ImpersonationTTL,
+				Mailer:           mailer,
+				MagicLink: appservice.MagicLinkOptions{
+					Enabled: cfg.MagicLinkEnabled,
+					TTL:     cfg.MagicLinkTTL,
+					URL:     cfg.MagicLinkURL,
+				},
+				DB:               pool,
+				OpenRegistration: cfg.OpenRegistration,
+				Invitations: appservice.InvitationOptions{
+					TTL: cfg.InvitationTTL,
+					URL: cfg.InvitationURL,
+				},
+				Device: appservice.DeviceOptions{
+					ClientIDs:       cfg.DeviceClientIDs,
+					Scopes:          cfg.DeviceScopes,
+					TTL:             cfg.DeviceCodeTTL,
+					Interval:        cfg.DevicePollInterval,
+					VerificationURL: cfg.DeviceVerificationURL,
+				},
+				Webhooks:       webhook.NewDispatcher(logger, queries),
+				Audience:       cfg.JWTAudience,
+				Enrichers:      enrichers,
+				StatusCacheTTL: cfg.UserStatusCacheTTL,
+			})
+
+			scimSvc := appservice.NewSCIM(svc, appservice.SCIMOptions{
+				Tokens:  cfg.SCIMTokens,
+				BaseURL: cfg.PublicURL,
+			})
+
+			worker := webhook.NewWorker(logger, queries, webhook.WorkerOptions{
+				PollInterval: cfg.WebhookPollInterval,
+				MaxAttempts:  cfg.WebhookMaxAttempts,
+				Timeout:      cfg.WebhookTimeout,
+				MinBackoff:   cfg.WebhookMinBackoff,
+				MaxBackoff:   cfg.WebhookMaxBackoff,
+			})
+
+			return runServers(ctx, cfg, svc, scimSvc, worker, logger)
 		},
 	}
 
 	return cmd
 }
 
-func runServers(ctx context.Context, cfg *config.Config, svc identity.Service, logger *slog.Logger) error {
+func newPasswordPolicy(cfg *config.Config) (*security.PasswordPolicy, error) {
+	policy := &security.PasswordPolicy{
+		MinLength:     cfg.PasswordMinLength,
+		MaxLength:     cfg.PasswordMaxLength,
+		RequireUpper:  cfg.PasswordRequireUpper,
+		RequireLower:  cfg.PasswordRequireLower,
+		RequireDigit:  cfg.PasswordRequireDigit,
+		RequireSymbol: cfg.PasswordRequireSymbol,
+	}
+	if cfg.BreachedPasswordsFile != "" {
+		corpus, err := security.LoadBreachedCorpus(cfg.BreachedPasswordsFile)
+		if err != nil {
+			return nil, err
+		}
+		policy.Breached = corpus
+	}
+	return policy, nil
+}
+
+func newEnricherRegistry(cfg *config.Config) (*security.EnricherRegistry, error) {
+	policy, err := security.ParseFailurePolicy(cfg.ClaimsEnricherFailurePolicy)
+	if err != nil {
+		return nil, err
+	}
+	opts := security.EnricherOptions{Timeout: cfg.ClaimsEnricherTimeout, Policy: policy}
+
+	registry := security.NewEnricherRegistry()
+	if len(cfg.TokenAttributeClaims) > 0 {
+		registry.Register("attributes", security.AttributeEnricher(cfg.TokenAttributeClaims), opts)
+	}
+	return registry, nil
+}
+
+func newMailer(cfg *config.Config) (mail.Mailer, error) {
+	switch cfg.Mailer {
+	case "file":
+		return mail.NewFileMailer(cfg.MailDir)
+	case "smtp":
+		return mail.NewSMTPMailer(cfg.SMTPAddr, cfg.MailFrom, cfg.SMTPUsername, cfg.SMTPPassword)
+	default:
+		return nil, fmt.Errorf("unknown mailer %s", cfg.Mailer)
+	}
+}
+
+func runServers(ctx context.Context, cfg *config.Config, svc identity.Service, scimSvc scim.Service, worker *webhook.Worker, logger *slog.Logger) error {
+	proxies, err := audit.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return err
+	}
+
 	endpoints := identity.NewEndpoints(svc)
 
 	hErrHandler := func(ctx context.Context, w http.ResponseWriter, err error) {
@@ -66,17 +182,32 @@ func runServers(ctx context.Context, cfg *config.Config, svc identity.Service, l
 	}
 
 	mux := goahttp.NewMuxer()
-	httpSrv := httpserver.New(endpoints, mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, hErrHandler, nil, http.Dir("."))
+	httpSrv := httpserver.New(endpoints, mux, requestDecoder, goahttp.ResponseEncoder, hErrHandler, nil, http.Dir("."), http.Dir("."))
 	httpSrv.Use(goahttpmiddleware.RequestID())
+	httpSrv.Use(audit.HTTPMiddleware(proxies))
 	httpSrv.Mount(mux)
 
+	scimSrv := scimserver.New(scim.NewEndpoints(scimSvc), mux, requestDecoder, goahttp.ResponseEncoder, hErrHandler, scimErrorFormatter)
+	scimSrv.Use(goahttpmiddleware.RequestID())
+	scimSrv.Use(audit.HTTPMiddleware(proxies))
+	scimSrv.Mount(mux)
+
 	httpServer := &http.Server{
 		Addr:    cfg.HTTPAddr,
 		Handler: mux,
 	}
 
-	grpcSrv := grpc.NewServer()
-	identitypb.RegisterIdentityServer(grpcSrv, grpcserver.New(endpoints, nil))
+	grpcSrv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			grpcmiddleware.UnaryRequestID(),
+			audit.UnaryServerInterceptor(proxies),
+		),
+		grpc.ChainStreamInterceptor(
+			grpcmiddleware.StreamRequestID(),
+			audit.StreamServerInterceptor(proxies),
+		),
+	)
+	identitypb.RegisterIdentityServer(grpcSrv, grpcserver.New(endpoints, nil, nil))
 
 	g, ctx := errgroup.WithContext(ctx)
 
@@ -113,5 +244,10 @@ func runServers(ctx context.Context, cfg *config.Config, svc identity.Service, l
 		return nil
 	})
 
+	g.Go(func() error {
+		logger.Info("webhook delivery worker started")
+		return worker.Run(ctx)
+	})
+
 	return g.Wait()
 }
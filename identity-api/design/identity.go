@@ -13,10 +13,12 @@ var _ = API("identity", func() {
 			URI("http://localhost:8081")
 			URI("grpc://localhost:9081")
 		})
-		Services("identity")
+		Services("identity", "scim")
 	})
 })
 
+var userStatuses = []any{"active", "suspended", "deactivated"}
+
 var User = ResultType("application/vnd.identity.user", func() {
 	TypeName("User")
 	Attributes(func() {
@@ -26,13 +28,27 @@ var User = ResultType("application/vnd.identity.user", func() {
 		Field(4, "created_at", String, "Creation timestamp", func() {
 			Format(FormatDateTime)
 		})
-		Required("id", "email", "display_name", "created_at")
+		Field(5, "attributes", MapOf(String, String), "Attributes assigned to the user, e.g. through an invitation")
+		Field(6, "status", String, "Account status; only active accounts can sign in", func() {
+			Enum(userStatuses...)
+		})
+		Field(7, "status_reason", String, "Reason given for the last status change")
+		Field(8, "status_changed_at", String, "Time of the last status change", func() {
+			Format(FormatDateTime)
+		})
+		Field(9, "username", String, "Unique handle, if the user chose one")
+		Required("id", "email", "display_name", "created_at", "status")
 	})
 	View("default", func() {
 		Attribute("id")
 		Attribute("email")
 		Attribute("display_name")
 		Attribute("created_at")
+		Attribute("attributes")
+		Attribute("status")
+		Attribute("status_reason")
+		Attribute("status_changed_at")
+		Attribute("username")
 	})
 })
 
@@ -62,16 +78,67 @@ var NotFoundError = Type("NotFoundError", func() {
 	Required("message")
 })
 
+var InvalidUsernameError = Type("InvalidUsernameError", func() {
+	Field(1, "message", String, "description of the broken rule")
+	Field(2, "id", String, "error identifier", func() {
+		Example("identity:invalid_username")
+	})
+	Required("message")
+})
+
+var ConflictError = Type("ConflictError", func() {
+	Field(1, "message", String, "description of the failure")
+	Field(2, "id", String, "error identifier", func() {
+		Example("identity:conflict")
+	})
+	Required("message")
+})
+
+var BadRequestError = Type("BadRequestError", func() {
+	Field(1, "message", String, "description of the invalid argument")
+	Field(2, "id", String, "error identifier", func() {
+		Example("identity:bad_request")
+	})
+	Required("message")
+})
+
+var PolicyViolation = Type("PolicyViolation", func() {
+	Field(1, "rule", String, "identifier of the failed rule", func() {
+		Example("min_length")
+	})
+	Field(2, "message", String, "description of the failed rule")
+	Required("rule", "message")
+})
+
+var PasswordPolicyError = Type("PasswordPolicyError", func() {
+	Field(1, "message", String, "description of the failure")
+	Field(2, "id", String, "error identifier", func() {
+		Example("identity:password_policy")
+	})
+	Field(3, "violations", ArrayOf(PolicyViolation), "every password rule that failed")
+	Required("message", "violations")
+})
+
+var Actor = Type("Actor", func() {
+	Description("Party acting on behalf of the token subject (RFC 8693 act claim)")
+	Field(1, "user_id", String, "Actor user identifier")
+	Field(2, "email", String, "Actor email address")
+	Required("user_id")
+})
+
 var ValidationResult = Type("ValidationResult", func() {
 	Field(1, "valid", Boolean)
 	Field(2, "user_id", String)
 	Field(3, "email", String)
 	Field(4, "reason", String)
+	Field(5, "actor", Actor, "Set when the token was issued through impersonation")
+	Field(6, "audience", ArrayOf(String), "Services the token is intended for")
+	Field(7, "claims", MapOf(String, String), "Custom claims added by claims enrichers when the token was issued")
 	Required("valid")
 })
 
 var Credentials = Type("Credentials", func() {
-	Field(1, "email", String, func() {
+	Field(1, "email", String, "Deprecated: use identifier", func() {
 		Format(FormatEmail)
 		Example("service@example.com")
 	})
@@ -79,45 +146,506 @@ var Credentials = Type("Credentials", func() {
 		MinLength(8)
 		Example("changeme123")
 	})
-	Required("email", "password")
+	Field(5, "identifier", String, "Email address or username", func() {
+		Example("service@example.com")
+	})
+	Required("password")
 })
 
-var RegisterPayload = Type("RegisterPayload", func() {
+var LoginPayload = Type("LoginPayload", func() {
 	Extend(Credentials)
+	Field(3, "token_format", String, "Format of the issued access token", func() {
+		Enum("jwt", "opaque")
+		Default("jwt")
+	})
+	Field(4, "audience", ArrayOf(String), "Services the token is intended for; each must be listed in IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE", func() {
+		Example([]string{"dummy-api"})
+	})
+})
+
+var RegisterPayload = Type("RegisterPayload", func() {
+	Field(1, "email", String, func() {
+		Format(FormatEmail)
+		Example("service@example.com")
+	})
+	Field(2, "password", String, "Password; may be omitted when magic link login is enabled", func() {
+		MinLength(8)
+		Example("changeme123")
+	})
 	Field(3, "display_name", String, func() {
 		MinLength(3)
 		Example("Service Admin")
 	})
-	Required("display_name")
+	Field(4, "username", String, "Optional unique handle that can be used to log in instead of the email", func() {
+		Example("service_admin")
+	})
+	Required("email", "display_name")
+})
+
+var RequestMagicLinkPayload = Type("RequestMagicLinkPayload", func() {
+	Field(1, "email", String, "Email address to send the login link to", func() {
+		Format(FormatEmail)
+		Example("service@example.com")
+	})
+	Required("email")
+})
+
+var ConsumeMagicLinkPayload = Type("ConsumeMagicLinkPayload", func() {
+	Field(1, "token", String, "Token from the emailed login link")
+	Required("token")
 })
 
 var ValidateTokenPayload = Type("ValidateTokenPayload", func() {
 	Field(1, "token", String, "JWT access token")
+	Field(2, "audience", String, "Audience of the calling service; when set the token must be intended for it", func() {
+		Example("dummy-api")
+	})
+	Required("token")
+})
+
+var ValidateTokensPayload = Type("ValidateTokensPayload", func() {
+	Field(1, "tokens", ArrayOf(String), "Access tokens to validate", func() {
+		MinLength(1)
+		MaxLength(100)
+	})
+	Field(2, "audience", String, "Audience of the calling service; when set every token must be intended for it", func() {
+		Example("dummy-api")
+	})
+	Required("tokens")
+})
+
+var ValidationResultsCollection = Type("ValidationResultsCollection", func() {
+	Field(1, "results", ArrayOf(ValidationResult), "One result per submitted token, in the same order")
+	Required("results")
+})
+
+var ValidateTokenStreamRequest = Type("ValidateTokenStreamRequest", func() {
+	Field(1, "id", String, "Caller-chosen identifier echoed in the response")
+	Field(2, "token", String, "Access token to validate")
+	Field(3, "audience", String, "Audience of the calling service; when set the token must be intended for it")
 	Required("token")
 })
 
+var ValidateTokenStreamResponse = Type("ValidateTokenStreamResponse", func() {
+	Field(1, "id", String, "Identifier of the request this result answers")
+	Field(2, "result", ValidationResult, "Validation result; unset when error is set")
+	Field(3, "error", String, "Set when the token could not be validated because of an internal error, in which case the request may be retried")
+})
+
+var authEventTypes = []any{"register", "login", "validate_token", "token_revoked", "token_exchange", "magic_link", "invitation", "device_authorization", "provisioning", "user_status"}
+
+var AuthEvent = Type("AuthEvent", func() {
+	Field(1, "id", Int64, "Event identifier")
+	Field(2, "type", String, "Event type", func() {
+		Enum(authEventTypes...)
+	})
+	Field(3, "success", Boolean, "Whether the operation succeeded")
+	Field(4, "user_id", String, "Acting user, when known")
+	Field(5, "email", String, "Email supplied by or resolved for the actor")
+	Field(6, "reason", String, "Failure reason")
+	Field(7, "ip_address", String, "Client IP address")
+	Field(8, "user_agent", String, "Client user agent")
+	Field(9, "request_id", String, "Request identifier")
+	Field(10, "created_at", String, "Event timestamp", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "type", "success", "created_at")
+})
+
+var AuthEventsCollection = Type("AuthEventsCollection", func() {
+	Field(1, "events", ArrayOf(AuthEvent), "Events ordered from newest to oldest")
+	Required("events")
+})
+
+var ListAuthEventsPayload = Type("ListAuthEventsPayload", func() {
+	Field(1, "token", String, "Bearer token of an administrator")
+	Field(2, "user_id", String, "Only return events for this user", func() {
+		Format(FormatUUID)
+	})
+	Field(3, "type", String, "Only return events of this type", func() {
+		Enum(authEventTypes...)
+	})
+	Field(4, "since", String, "Only return events at or after this time", func() {
+		Format(FormatDateTime)
+	})
+	Field(5, "until", String, "Only return events before this time", func() {
+		Format(FormatDateTime)
+	})
+	Field(6, "before_id", Int64, "Only return events older than this event id")
+	Field(7, "limit", Int, "Maximum number of events to return", func() {
+		Minimum(1)
+		Maximum(1000)
+		Default(100)
+	})
+	Required("token")
+})
+
+var ClientBasicAuth = BasicAuthSecurity("client_basic", func() {
+	Description("OAuth client credentials presented with HTTP Basic authentication")
+})
+
+var IntrospectPayload = Type("IntrospectPayload", func() {
+	Username("client_id", String, "OAuth client identifier", func() {
+		Meta("rpc:tag", "1")
+	})
+	Password("client_secret", String, "OAuth client secret", func() {
+		Meta("rpc:tag", "2")
+	})
+	Field(3, "token", String, "Token to introspect")
+	Field(4, "token_type_hint", String, "Hint about the type of the submitted token", func() {
+		Enum("access_token")
+	})
+	Required("client_id", "client_secret", "token")
+})
+
+var RevokeTokenPayload = Type("RevokeTokenPayload", func() {
+	Username("client_id", String, "OAuth client identifier", func() {
+		Meta("rpc:tag", "1")
+	})
+	Password("client_secret", String, "OAuth client secret", func() {
+		Meta("rpc:tag", "2")
+	})
+	Field(3, "token", String, "Token to revoke")
+	Field(4, "token_type_hint", String, "Hint about the type of the submitted token", func() {
+		Enum("access_token")
+	})
+	Required("client_id", "client_secret", "token")
+})
+
+var IntrospectionResult = Type("IntrospectionResult", func() {
+	Field(1, "active", Boolean, "Whether the token is currently active")
+	Field(2, "sub", String, "Subject of the token")
+	Field(3, "exp", Int64, "Expiration time in seconds since the epoch")
+	Field(4, "iat", Int64, "Issue time in seconds since the epoch")
+	Field(5, "scope", String, "Space-separated scopes granted to the token")
+	Field(6, "client_id", String, "Client the token was issued to")
+	Field(7, "token_type", String, "Type of the token")
+	Field(8, "username", String, "Email of the resource owner")
+	Field(9, "aud", ArrayOf(String), "Services the token is intended for")
+	Field(10, "iss", String, "Issuer of the token")
+	Required("active")
+})
+
+var TokenExchangePayload = Type("TokenExchangePayload", func() {
+	Field(1, "token", String, "Bearer token of the administrator performing the exchange")
+	Field(2, "grant_type", String, "OAuth 2.0 grant type", func() {
+		Enum("urn:ietf:params:oauth:grant-type:token-exchange")
+		Default("urn:ietf:params:oauth:grant-type:token-exchange")
+	})
+	Field(3, "requested_subject", String, "Identifier of the user to impersonate", func() {
+		Format(FormatUUID)
+	})
+	Field(4, "requested_token_type", String, "Type of the requested token", func() {
+		Enum("urn:ietf:params:oauth:token-type:access_token", "urn:ietf:params:oauth:token-type:jwt")
+		Default("urn:ietf:params:oauth:token-type:access_token")
+	})
+	Field(5, "reason", String, "Why impersonation is needed, recorded in the audit log", func() {
+		MinLength(3)
+		Example("Reproducing support ticket #1234")
+	})
+	Field(6, "audience", ArrayOf(String), "Services the token is intended for; each must be listed in IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE", func() {
+		Example([]string{"dummy-api"})
+	})
+	Required("token", "requested_subject", "reason")
+})
+
+var TokenExchangeResult = Type("TokenExchangeResult", func() {
+	Field(1, "access_token", String, "JWT acting as the requested subject")
+	Field(2, "issued_token_type", String, "Type of the issued token")
+	Field(3, "token_type", String, "How the token is presented")
+	Field(4, "expires_in", Int, "Token expiry window in seconds")
+	Required("access_token", "issued_token_type", "token_type", "expires_in")
+})
+
+var Invitation = Type("Invitation", func() {
+	Field(1, "id", String, "Invitation identifier")
+	Field(2, "email", String, "Email address of the invitee")
+	Field(3, "display_name", String, "Display name pre-assigned to the invitee")
+	Field(4, "attributes", MapOf(String, String), "Attributes pre-assigned to the invitee")
+	Field(5, "invited_by", String, "User who created the invitation")
+	Field(6, "status", String, "Invitation status", func() {
+		Enum("pending", "accepted", "revoked", "expired")
+	})
+	Field(7, "created_at", String, "Creation timestamp", func() {
+		Format(FormatDateTime)
+	})
+	Field(8, "expires_at", String, "Expiry timestamp", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "email", "invited_by", "status", "created_at", "expires_at")
+})
+
+var InvitationsCollection = Type("InvitationsCollection", func() {
+	Field(1, "invitations", ArrayOf(Invitation), "Invitations ordered from newest to oldest")
+	Required("invitations")
+})
+
+var InviteUserPayload = Type("InviteUserPayload", func() {
+	Field(1, "token", String, "Bearer token of the inviting user")
+	Field(2, "email", String, "Email address to invite", func() {
+		Format(FormatEmail)
+		Example("colleague@example.com")
+	})
+	Field(3, "display_name", String, "Display name to pre-assign", func() {
+		MinLength(3)
+	})
+	Field(4, "attributes", MapOf(String, String), "Attributes to pre-assign to the new account; restricted to administrators")
+	Required("token", "email")
+})
+
+var ListInvitationsPayload = Type("ListInvitationsPayload", func() {
+	Field(1, "token", String, "Bearer token of the inviting user")
+	Required("token")
+})
+
+var RevokeInvitationPayload = Type("RevokeInvitationPayload", func() {
+	Field(1, "token", String, "Bearer token of the inviting user")
+	Field(2, "id", String, "Invitation identifier", func() {
+		Format(FormatUUID)
+	})
+	Required("token", "id")
+})
+
+var AcceptInvitationPayload = Type("AcceptInvitationPayload", func() {
+	Field(1, "invitation_token", String, "Token from the emailed invitation link")
+	Field(2, "password", String, "Password; may be omitted when magic link login is enabled", func() {
+		MinLength(8)
+	})
+	Field(3, "display_name", String, "Overrides the pre-assigned display name", func() {
+		MinLength(3)
+	})
+	Required("invitation_token")
+})
+
+var OAuthError = Type("OAuthError", func() {
+	Description("OAuth 2.0 error response (RFC 6749 section 5.2)")
+	Field(1, "error", String, "Error code", func() {
+		Meta("struct:error:name")
+		Meta("struct:field:name", "Code")
+	})
+	Field(2, "error_description", String, "Human-readable description of the error")
+	Required("error")
+})
+
+var DeviceAuthorizationPayload = Type("DeviceAuthorizationPayload", func() {
+	Field(1, "client_id", String, "Identifier of the public client starting the flow", func() {
+		Example("cli")
+	})
+	Field(2, "scope", String, "Space-separated scopes requested by the client; each must be listed in IDENTITY_DEVICE_SCOPES")
+	Field(3, "audience", ArrayOf(String), "Services the token is intended for; each must be listed in IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE", func() {
+		Example([]string{"dummy-api"})
+	})
+	Required("client_id")
+})
+
+var DeviceAuthorizationResult = Type("DeviceAuthorizationResult", func() {
+	Field(1, "device_code", String, "Code the device polls the token endpoint with")
+	Field(2, "user_code", String, "Code the user enters on the verification page", func() {
+		Example("WDJB-MJHT")
+	})
+	Field(3, "verification_uri", String, "Page where the user approves the device")
+	Field(4, "verification_uri_complete", String, "Verification page with the user code filled in")
+	Field(5, "expires_in", Int, "Lifetime of the device and user codes in seconds")
+	Field(6, "interval", Int, "Minimum number of seconds between polling requests")
+	Required("device_code", "user_code", "verification_uri", "verification_uri_complete", "expires_in", "interval")
+})
+
+var DeviceTokenPayload = Type("DeviceTokenPayload", func() {
+	Field(1, "grant_type", String, "OAuth 2.0 grant type", func() {
+		Enum("urn:ietf:params:oauth:grant-type:device_code")
+	})
+	Field(2, "device_code", String, "Device code returned by device_authorization")
+	Field(3, "client_id", String, "Client that started the flow")
+	Required("grant_type", "device_code", "client_id")
+})
+
+var DeviceTokenResult = Type("DeviceTokenResult", func() {
+	Field(1, "access_token", String, "JWT access token")
+	Field(2, "token_type", String, "How the token is presented")
+	Field(3, "expires_in", Int, "Token expiry window in seconds")
+	Field(4, "scope", String, "Scopes granted to the token")
+	Required("access_token", "token_type", "expires_in")
+})
+
+var ApproveDevicePayload = Type("ApproveDevicePayload", func() {
+	Field(1, "token", String, "Bearer token of the user approving the device")
+	Field(2, "user_code", String, "Code displayed by the device", func() {
+		Example("WDJB-MJHT")
+	})
+	Field(3, "approve", Boolean, "False denies the request", func() {
+		Default(true)
+	})
+	Required("token", "user_code")
+})
+
+var webhookEventTypes = []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}
+
+var WebhookSubscription = Type("WebhookSubscription", func() {
+	Field(1, "id", String, "Subscription identifier")
+	Field(2, "url", String, "Endpoint that receives signed POST requests")
+	Field(3, "event_types", ArrayOf(String, func() {
+		Enum(webhookEventTypes...)
+	}), "Events delivered to the endpoint; empty means all events")
+	Field(4, "active", Boolean, "Whether new events are delivered")
+	Field(5, "secret", String, "HMAC-SHA256 signing secret; only returned when the subscription is created")
+	Field(6, "created_at", String, "Creation timestamp", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "url", "event_types", "active", "created_at")
+})
+
+var WebhookSubscriptionsCollection = Type("WebhookSubscriptionsCollection", func() {
+	Field(1, "subscriptions", ArrayOf(WebhookSubscription), "Subscriptions ordered from newest to oldest")
+	Required("subscriptions")
+})
+
+var WebhookDelivery = Type("WebhookDelivery", func() {
+	Field(1, "id", String, "Delivery identifier, sent in the X-Webhook-ID header")
+	Field(2, "subscription_id", String, "Subscription the delivery belongs to")
+	Field(3, "event_type", String, "Event type", func() {
+		Enum(webhookEventTypes...)
+	})
+	Field(4, "status", String, "Delivery status", func() {
+		Enum("pending", "succeeded", "failed")
+	})
+	Field(5, "attempts", Int, "Number of delivery attempts made")
+	Field(6, "response_status", Int, "HTTP status returned by the last attempt")
+	Field(7, "last_error", String, "Error of the last failed attempt")
+	Field(8, "created_at", String, "Creation timestamp", func() {
+		Format(FormatDateTime)
+	})
+	Field(9, "last_attempt_at", String, "Time of the last attempt", func() {
+		Format(FormatDateTime)
+	})
+	Field(10, "next_attempt_at", String, "Time of the next attempt while pending", func() {
+		Format(FormatDateTime)
+	})
+	Field(11, "delivered_at", String, "Time the endpoint acknowledged the delivery", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "subscription_id", "event_type", "status", "attempts", "created_at")
+})
+
+var WebhookDeliveriesCollection = Type("WebhookDeliveriesCollection", func() {
+	Field(1, "deliveries", ArrayOf(WebhookDelivery), "Deliveries ordered from newest to oldest")
+	Required("deliveries")
+})
+
+var CreateWebhookPayload = Type("CreateWebhookPayload", func() {
+	Field(1, "token", String, "Bearer token of an administrator")
+	Field(2, "url", String, "Endpoint that receives signed POST requests", func() {
+		Pattern(`^https?://`)
+		Example("https://hooks.example.com/identity")
+	})
+	Field(3, "event_types", ArrayOf(String, func() {
+		Enum(webhookEventTypes...)
+	}), "Events to deliver; omit to receive all events")
+	Required("token", "url")
+})
+
+var ListWebhooksPayload = Type("ListWebhooksPayload", func() {
+	Field(1, "token", String, "Bearer token of an administrator")
+	Required("token")
+})
+
+var DeleteWebhookPayload = Type("DeleteWebhookPayload", func() {
+	Field(1, "token", String, "Bearer token of an administrator")
+	Field(2, "id", String, "Subscription identifier", func() {
+		Format(FormatUUID)
+	})
+	Required("token", "id")
+})
+
+var ListWebhookDeliveriesPayload = Type("ListWebhookDeliveriesPayload", func() {
+	Field(1, "token", String, "Bearer token of an administrator")
+	Field(2, "id", String, "Subscription identifier", func() {
+		Format(FormatUUID)
+	})
+	Field(3, "limit", Int, "Maximum number of deliveries to return", func() {
+		Minimum(1)
+		Maximum(500)
+		Default(100)
+	})
+	Required("token", "id")
+})
+
+var RedeliverWebhookPayload = Type("RedeliverWebhookPayload", func() {
+	Field(1, "token", String, "Bearer token of an administrator")
+	Field(2, "id", String, "Identifier of the delivery to send again", func() {
+		Format(FormatUUID)
+	})
+	Required("token", "id")
+})
+
+var SetUserStatusPayload = Type("SetUserStatusPayload", func() {
+	Field(1, "token", String, "Bearer token of an administrator")
+	Field(2, "id", String, "User identifier", func() {
+		Format(FormatUUID)
+	})
+	Field(3, "status", String, "New account status", func() {
+		Enum(userStatuses...)
+	})
+	Field(4, "reason", String, "Why the status is changed, e.g. the abuse report being acted on", func() {
+		MaxLength(500)
+	})
+	Required("token", "id", "status")
+})
+
+var SetUsernamePayload = Type("SetUsernamePayload", func() {
+	Field(1, "token", String, "Bearer token of the user")
+	Field(2, "username", String, "New username", func() {
+		Example("service_admin")
+	})
+	Required("token", "username")
+})
+
+var CheckUsernamePayload = Type("CheckUsernamePayload", func() {
+	Field(1, "username", String, "Username to check", func() {
+		Example("service_admin")
+	})
+	Required("username")
+})
+
+var UsernameAvailability = Type("UsernameAvailability", func() {
+	Field(1, "username", String, "Checked username")
+	Field(2, "available", Boolean, "Whether the username can be claimed")
+	Field(3, "reason", String, "Why the username cannot be claimed")
+	Required("username", "available")
+})
+
 var _ = Service("identity", func() {
 	Description("Operations for user identities")
 
 	Error("unauthorized", UnauthorizedError)
 	Error("not_found", NotFoundError)
+	Error("conflict", ConflictError, "A user with this email or username already exists")
 
 	Method("register", func() {
 		Description("Registers a new user")
 		Payload(RegisterPayload)
 		Result(User)
+		Error("password_policy", PasswordPolicyError, "Password does not satisfy the password policy")
+		Error("invalid_username", InvalidUsernameError, "Username breaks the naming rules or is reserved")
 		HTTP(func() {
 			POST("/v1/identity/register")
 			Response(StatusCreated)
+			Response("password_policy", StatusBadRequest)
+			Response("invalid_username", StatusBadRequest)
+			Response("conflict", StatusConflict)
+			Response("unauthorized", StatusForbidden)
 		})
 		GRPC(func() {
 			Response(CodeOK)
+			Response("password_policy", CodeInvalidArgument)
+			Response("invalid_username", CodeInvalidArgument)
+			Response("conflict", CodeAlreadyExists)
+			Response("unauthorized", CodePermissionDenied)
 		})
 	})
 
 	Method("login", func() {
-		Description("Authenticates a user and issues a JWT")
-		Payload(Credentials)
+		Description("Authenticates a user by email or username and issues a JWT or opaque access token")
+		Payload(LoginPayload)
 		Result(TokenResult)
 		HTTP(func() {
 			POST("/v1/identity/login")
@@ -128,6 +656,70 @@ var _ = Service("identity", func() {
 		})
 	})
 
+	Method("set_username", func() {
+		Description("Sets or changes the username of the calling user")
+		Payload(SetUsernamePayload)
+		Result(User)
+		Error("invalid_username", InvalidUsernameError, "Username breaks the naming rules or is reserved")
+		HTTP(func() {
+			PUT("/v1/identity/me/username")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+			Response("invalid_username", StatusBadRequest)
+			Response("conflict", StatusConflict)
+			Response("unauthorized", StatusUnauthorized)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_username", CodeInvalidArgument)
+			Response("conflict", CodeAlreadyExists)
+			Response("unauthorized", CodeUnauthenticated)
+		})
+	})
+
+	Method("check_username", func() {
+		Description("Reports whether a username is valid and not yet taken")
+		Payload(CheckUsernamePayload)
+		Result(UsernameAvailability)
+		HTTP(func() {
+			GET("/v1/identity/usernames/{username}/availability")
+			Response(StatusOK)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("request_magic_link", func() {
+		Description("Emails a single-use login link; succeeds whether or not the account exists")
+		Payload(RequestMagicLinkPayload)
+		Result(Empty)
+		HTTP(func() {
+			POST("/v1/identity/magic-link")
+			Response(StatusAccepted)
+			Response("unauthorized", StatusForbidden)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodePermissionDenied)
+		})
+	})
+
+	Method("consume_magic_link", func() {
+		Description("Exchanges a login link token for an access token")
+		Payload(ConsumeMagicLinkPayload)
+		Result(TokenResult)
+		HTTP(func() {
+			POST("/v1/identity/magic-link/consume")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+		})
+	})
+
 	Method("validate_token", func() {
 		Description("Validates a JWT and returns the claims")
 		Payload(ValidateTokenPayload)
@@ -141,5 +733,361 @@ var _ = Service("identity", func() {
 		})
 	})
 
+	Method("validate_tokens", func() {
+		Description("Validates a batch of access tokens in one call")
+		Payload(ValidateTokensPayload)
+		Result(ValidationResultsCollection)
+		HTTP(func() {
+			POST("/v1/identity/validate/batch")
+			Response(StatusOK)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("validate_token_stream", func() {
+		Description("Validates access tokens sent over a long-lived bidirectional stream; results are returned in request order")
+		StreamingPayload(ValidateTokenStreamRequest)
+		StreamingResult(ValidateTokenStreamResponse)
+		GRPC(func() {
+			Response(CodeOK)
+		})
+	})
+
+	Method("list_auth_events", func() {
+		Description("Lists security audit events; restricted to administrators")
+		Payload(ListAuthEventsPayload)
+		Result(AuthEventsCollection)
+		Error("bad_request", BadRequestError, "A filter is malformed")
+		HTTP(func() {
+			GET("/v1/identity/admin/auth-events")
+			Header("token:Authorization", String, "Bearer token")
+			Param("user_id")
+			Param("type")
+			Param("since")
+			Param("until")
+			Param("before_id")
+			Param("limit")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("bad_request", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("bad_request", CodeInvalidArgument)
+		})
+	})
+
+	Method("introspect", func() {
+		Description("OAuth 2.0 token introspection (RFC 7662) for JWT and opaque access tokens")
+		Security(ClientBasicAuth)
+		Payload(IntrospectPayload)
+		Result(IntrospectionResult)
+		HTTP(func() {
+			POST("/oauth/introspect")
+			Body(func() {
+				Attribute("token")
+				Attribute("token_type_hint")
+			})
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+		})
+	})
+
+	Method("revoke_token", func() {
+		Description("OAuth 2.0 token revocation (RFC 7009) for opaque access tokens. Unknown and already revoked tokens are accepted silently; JWTs cannot be revoked and expire on their own")
+		Security(ClientBasicAuth)
+		Payload(RevokeTokenPayload)
+		Result(Empty)
+		Error("unsupported_token_type", OAuthError, "The token is a JWT, which cannot be revoked")
+		HTTP(func() {
+			POST("/oauth/revoke")
+			Body(func() {
+				Attribute("token")
+				Attribute("token_type_hint")
+			})
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("unsupported_token_type", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("unsupported_token_type", CodeInvalidArgument)
+		})
+	})
+
+	Method("exchange_token", func() {
+		Description("Exchanges an administrator token for a short-lived token impersonating another user (RFC 8693)")
+		Payload(TokenExchangePayload)
+		Result(TokenExchangeResult)
+		HTTP(func() {
+			POST("/v1/identity/token/exchange")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("invite_user", func() {
+		Description("Invites a colleague by email with optional pre-assigned attributes. Only administrators may pre-assign attributes and are told when the email is already registered")
+		Payload(InviteUserPayload)
+		Result(Invitation)
+		HTTP(func() {
+			POST("/v1/identity/invitations")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusCreated)
+			Response("conflict", StatusConflict)
+			Response("unauthorized", StatusUnauthorized)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("conflict", CodeAlreadyExists)
+			Response("unauthorized", CodeUnauthenticated)
+		})
+	})
+
+	Method("list_invitations", func() {
+		Description("Lists the invitations created by the caller")
+		Payload(ListInvitationsPayload)
+		Result(InvitationsCollection)
+		HTTP(func() {
+			GET("/v1/identity/invitations")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+		})
+	})
+
+	Method("revoke_invitation", func() {
+		Description("Revokes a pending invitation created by the caller")
+		Payload(RevokeInvitationPayload)
+		Result(Empty)
+		HTTP(func() {
+			DELETE("/v1/identity/invitations/{id}")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("accept_invitation", func() {
+		Description("Completes registration for an invited user")
+		Payload(AcceptInvitationPayload)
+		Result(User)
+		Error("password_policy", PasswordPolicyError, "Password does not satisfy the password policy")
+		HTTP(func() {
+			POST("/v1/identity/invitations/accept")
+			Response(StatusCreated)
+			Response("password_policy", StatusBadRequest)
+			Response("conflict", StatusConflict)
+			Response("unauthorized", StatusUnauthorized)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("password_policy", CodeInvalidArgument)
+			Response("conflict", CodeAlreadyExists)
+			Response("unauthorized", CodeUnauthenticated)
+		})
+	})
+
+	Method("device_authorization", func() {
+		Description("Starts the OAuth 2.0 device authorization grant (RFC 8628)")
+		Payload(DeviceAuthorizationPayload)
+		Result(DeviceAuthorizationResult)
+		Error("invalid_client", OAuthError, "Client is not allowed to use the device flow")
+		Error("invalid_scope", OAuthError, "A requested scope is not allowed")
+		Error("invalid_target", OAuthError, "A requested audience is not allowed")
+		HTTP(func() {
+			POST("/oauth/device_authorization")
+			Response(StatusOK)
+			Response("invalid_client", StatusUnauthorized)
+			Response("invalid_scope", StatusBadRequest)
+			Response("invalid_target", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_client", CodeUnauthenticated)
+			Response("invalid_scope", CodeInvalidArgument)
+			Response("invalid_target", CodeInvalidArgument)
+		})
+	})
+
+	Method("device_token", func() {
+		Description("Polls for the access token of a device authorization (RFC 8628 section 3.4)")
+		Payload(DeviceTokenPayload)
+		Result(DeviceTokenResult)
+		Error("authorization_pending", OAuthError, "The user has not yet approved the request")
+		Error("slow_down", OAuthError, "The device is polling too often; the interval was increased by 5 seconds")
+		Error("access_denied", OAuthError, "The user denied the request")
+		Error("expired_token", OAuthError, "The device code expired")
+		Error("invalid_grant", OAuthError, "The device code is unknown, already used or belongs to another client")
+		HTTP(func() {
+			POST("/oauth/token")
+			Response(StatusOK)
+			Response("authorization_pending", StatusBadRequest)
+			Response("slow_down", StatusBadRequest)
+			Response("access_denied", StatusBadRequest)
+			Response("expired_token", StatusBadRequest)
+			Response("invalid_grant", StatusBadRequest)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("authorization_pending", CodeFailedPrecondition)
+			Response("slow_down", CodeResourceExhausted)
+			Response("access_denied", CodePermissionDenied)
+			Response("expired_token", CodeDeadlineExceeded)
+			Response("invalid_grant", CodeInvalidArgument)
+		})
+	})
+
+	Method("approve_device", func() {
+		Description("Approves or denies a device authorization on behalf of the signed-in user")
+		Payload(ApproveDevicePayload)
+		Result(Empty)
+		HTTP(func() {
+			POST("/v1/identity/device/approve")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("create_webhook", func() {
+		Description("Subscribes an endpoint to user lifecycle events; restricted to administrators")
+		Payload(CreateWebhookPayload)
+		Result(WebhookSubscription)
+		HTTP(func() {
+			POST("/v1/identity/admin/webhooks")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusCreated)
+			Response("unauthorized", StatusUnauthorized)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+		})
+	})
+
+	Method("list_webhooks", func() {
+		Description("Lists webhook subscriptions; restricted to administrators")
+		Payload(ListWebhooksPayload)
+		Result(WebhookSubscriptionsCollection)
+		HTTP(func() {
+			GET("/v1/identity/admin/webhooks")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+		})
+	})
+
+	Method("delete_webhook", func() {
+		Description("Deletes a webhook subscription and its delivery log; restricted to administrators")
+		Payload(DeleteWebhookPayload)
+		Result(Empty)
+		HTTP(func() {
+			DELETE("/v1/identity/admin/webhooks/{id}")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusNoContent)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("list_webhook_deliveries", func() {
+		Description("Lists the delivery log of a webhook subscription; restricted to administrators")
+		Payload(ListWebhookDeliveriesPayload)
+		Result(WebhookDeliveriesCollection)
+		HTTP(func() {
+			GET("/v1/identity/admin/webhooks/{id}/deliveries")
+			Header("token:Authorization", String, "Bearer token")
+			Param("limit")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("redeliver_webhook", func() {
+		Description("Queues a new delivery of a previously sent event; restricted to administrators")
+		Payload(RedeliverWebhookPayload)
+		Result(WebhookDelivery)
+		HTTP(func() {
+			POST("/v1/identity/admin/webhook-deliveries/{id}/redeliver")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusAccepted)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Method("set_user_status", func() {
+		Description("Suspends, deactivates or reactivates an account; restricted to administrators. Accounts that are not active cannot sign in and their tokens stop validating")
+		Payload(SetUserStatusPayload)
+		Result(User)
+		HTTP(func() {
+			PUT("/v1/identity/admin/users/{id}/status")
+			Header("token:Authorization", String, "Bearer token")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+		})
+		GRPC(func() {
+			Response(CodeOK)
+			Response("unauthorized", CodeUnauthenticated)
+			Response("not_found", CodeNotFound)
+		})
+	})
+
+	Files("/device.html", "static/device.html", func() {
+		Description("Device verification page where users enter the code shown by the CLI")
+	})
 	Files("openapi.json", "gen/http/openapi.json")
 })
@@ -0,0 +1,231 @@
+package design
+
+import (
+	. "goa.design/goa/v3/dsl"
+)
+
+// scimMediaType is the content type of SCIM 2.0 requests and responses
+// (RFC 7644 section 3.1).
+const scimMediaType = "application/scim+json"
+
+var SCIMToken = APIKeySecurity("scim_token", func() {
+	Description("Static bearer token issued to a provisioning client, sent in the Authorization header")
+})
+
+var SCIMName = Type("SCIMName", func() {
+	Attribute("formatted", String, "Full name, mapped to the display name")
+	Attribute("givenName", String, "Given name")
+	Attribute("familyName", String, "Family name")
+})
+
+var SCIMEmail = Type("SCIMEmail", func() {
+	Attribute("value", String, "Email address", func() {
+		Format(FormatEmail)
+	})
+	Attribute("type", String, "Email type, e.g. work")
+	Attribute("primary", Boolean, "Whether this is the primary address")
+	Required("value")
+})
+
+var SCIMMeta = Type("SCIMMeta", func() {
+	Attribute("resourceType", String, "Resource type", func() {
+		Enum("User")
+	})
+	Attribute("created", String, "Creation timestamp", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("lastModified", String, "Last modification timestamp", func() {
+		Format(FormatDateTime)
+	})
+	Attribute("location", String, "URI of the resource")
+	Required("resourceType", "created", "lastModified", "location")
+})
+
+var SCIMUser = Type("SCIMUser", func() {
+	Description("SCIM 2.0 User resource (RFC 7643 section 4.1)")
+	Attribute("schemas", ArrayOf(String), "Schemas the resource conforms to")
+	Attribute("id", String, "Resource identifier")
+	Attribute("externalId", String, "Identifier assigned by the provisioning client")
+	Attribute("userName", String, "Unique user name, mapped to the email address")
+	Attribute("name", SCIMName, "Components of the user's name")
+	Attribute("displayName", String, "Display name")
+	Attribute("emails", ArrayOf(SCIMEmail), "Email addresses; the first is the user name")
+	Attribute("active", Boolean, "Whether the account may sign in")
+	Attribute("meta", SCIMMeta, "Resource metadata")
+	Required("schemas", "id", "userName", "active", "meta")
+})
+
+var SCIMListResponse = Type("SCIMListResponse", func() {
+	Description("SCIM 2.0 list response (RFC 7644 section 3.4.2)")
+	Attribute("schemas", ArrayOf(String), "Schemas the response conforms to")
+	Attribute("totalResults", Int, "Number of resources matching the query")
+	Attribute("startIndex", Int, "1-based index of the first returned resource")
+	Attribute("itemsPerPage", Int, "Number of resources returned")
+	Attribute("Resources", ArrayOf(SCIMUser), "Returned resources")
+	Required("schemas", "totalResults", "startIndex", "itemsPerPage", "Resources")
+})
+
+var SCIMPatchOperation = Type("SCIMPatchOperation", func() {
+	Attribute("op", String, "Operation: add, replace or remove (case-insensitive)")
+	Attribute("path", String, "Attribute path; when omitted value must be an object of attributes")
+	Attribute("value", Any, "New value")
+	Required("op")
+})
+
+// scimError declares a SCIM error body (RFC 7644 section 3.12). Each HTTP
+// status gets its own type so that goa can tell the errors apart.
+func scimError(name, status string) any {
+	return Type(name, func() {
+		Description("SCIM error response with status " + status)
+		Attribute("schemas", ArrayOf(String), "Schemas the response conforms to")
+		Attribute("status", String, "HTTP status code", func() {
+			Example(status)
+		})
+		Attribute("scimType", String, "SCIM detail error keyword, e.g. uniqueness or invalidFilter")
+		Attribute("detail", String, "Human-readable description of the error")
+		Required("schemas", "status", "detail")
+	})
+}
+
+var (
+	SCIMBadRequest   = scimError("SCIMBadRequest", "400")
+	SCIMUnauthorized = scimError("SCIMUnauthorized", "401")
+	SCIMNotFound     = scimError("SCIMNotFound", "404")
+	SCIMConflict     = scimError("SCIMConflict", "409")
+)
+
+var _ = Service("scim", func() {
+	Description("SCIM 2.0 user provisioning for identity providers and HR systems")
+
+	Security(SCIMToken)
+
+	Error("bad_request", SCIMBadRequest)
+	Error("unauthorized", SCIMUnauthorized)
+	Error("not_found", SCIMNotFound)
+	Error("conflict", SCIMConflict)
+
+	HTTP(func() {
+		Path("/scim/v2")
+		Response("bad_request", StatusBadRequest, func() {
+			ContentType(scimMediaType)
+		})
+		Response("unauthorized", StatusUnauthorized, func() {
+			ContentType(scimMediaType)
+		})
+		Response("not_found", StatusNotFound, func() {
+			ContentType(scimMediaType)
+		})
+		Response("conflict", StatusConflict, func() {
+			ContentType(scimMediaType)
+		})
+	})
+
+	Method("create_user", func() {
+		Description("Provisions a user")
+		Payload(func() {
+			APIKey("scim_token", "token", String, "Bearer token")
+			Attribute("schemas", ArrayOf(String), "Schemas the resource conforms to")
+			Attribute("externalId", String, "Identifier assigned by the provisioning client")
+			Attribute("userName", String, "Unique user name, mapped to the email address")
+			Attribute("name", SCIMName, "Components of the user's name")
+			Attribute("displayName", String, "Display name")
+			Attribute("emails", ArrayOf(SCIMEmail), "Email addresses")
+			Attribute("active", Boolean, "Whether the account may sign in", func() {
+				Default(true)
+			})
+			Attribute("password", String, "Initial password; omit for accounts that sign in without one")
+			Required("token", "userName")
+		})
+		Result(SCIMUser)
+		HTTP(func() {
+			POST("/Users")
+			Header("token:Authorization")
+			Response(StatusCreated, func() {
+				ContentType(scimMediaType)
+			})
+		})
+	})
+
+	Method("get_user", func() {
+		Description("Returns a provisioned user")
+		Payload(func() {
+			APIKey("scim_token", "token", String, "Bearer token")
+			Attribute("id", String, "Resource identifier")
+			Required("token", "id")
+		})
+		Result(SCIMUser)
+		HTTP(func() {
+			GET("/Users/{id}")
+			Header("token:Authorization")
+			Response(StatusOK, func() {
+				ContentType(scimMediaType)
+			})
+		})
+	})
+
+	Method("list_users", func() {
+		Description("Lists users, optionally filtered by userName or externalId equality")
+		Payload(func() {
+			APIKey("scim_token", "token", String, "Bearer token")
+			Attribute("filter", String, "SCIM filter; supports `userName eq \"...\"` and `externalId eq \"...\"`", func() {
+				Example(`userName eq "jane@example.com"`)
+			})
+			Attribute("startIndex", Int, "1-based index of the first resource", func() {
+				Minimum(1)
+				Default(1)
+			})
+			Attribute("count", Int, "Maximum number of resources to return", func() {
+				Minimum(0)
+				Maximum(200)
+				Default(100)
+			})
+			Required("token")
+		})
+		Result(SCIMListResponse)
+		HTTP(func() {
+			GET("/Users")
+			Header("token:Authorization")
+			Param("filter")
+			Param("startIndex")
+			Param("count")
+			Response(StatusOK, func() {
+				ContentType(scimMediaType)
+			})
+		})
+	})
+
+	Method("patch_user", func() {
+		Description("Modifies a user with a SCIM PatchOp request; setting active to false deactivates the account")
+		Payload(func() {
+			APIKey("scim_token", "token", String, "Bearer token")
+			Attribute("id", String, "Resource identifier")
+			Attribute("schemas", ArrayOf(String), "Schemas the request conforms to")
+			Attribute("Operations", ArrayOf(SCIMPatchOperation), "Operations to apply in order", func() {
+				MinLength(1)
+			})
+			Required("token", "id", "Operations")
+		})
+		Result(SCIMUser)
+		HTTP(func() {
+			PATCH("/Users/{id}")
+			Header("token:Authorization")
+			Response(StatusOK, func() {
+				ContentType(scimMediaType)
+			})
+		})
+	})
+
+	Method("delete_user", func() {
+		Description("Deletes a user")
+		Payload(func() {
+			APIKey("scim_token", "token", String, "Bearer token")
+			Attribute("id", String, "Resource identifier")
+			Required("token", "id")
+		})
+		HTTP(func() {
+			DELETE("/Users/{id}")
+			Header("token:Authorization")
+			Response(StatusNoContent)
+		})
+	})
+})
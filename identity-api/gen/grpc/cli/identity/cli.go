@@ -22,13 +22,13 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"identity (register|login|validate-token)",
+		"identity (register|login|set-username|check-username|request-magic-link|consume-magic-link|validate-token|validate-tokens|validate-token-stream|list-auth-events|introspect|revoke-token|exchange-token|invite-user|list-invitations|revoke-invitation|accept-invitation|device-authorization|device-token|approve-device|create-webhook|list-webhooks|delete-webhook|list-webhook-deliveries|redeliver-webhook|set-user-status)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "identity register --message '{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'" + "\n" +
+	return os.Args[0] + " " + "identity register --message '{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"username\": \"service_admin\"\n   }'" + "\n" +
 		""
 }
 
@@ -47,13 +47,108 @@ func ParseEndpoint(
 		identityLoginFlags       = flag.NewFlagSet("login", flag.ExitOnError)
 		identityLoginMessageFlag = identityLoginFlags.String("message", "", "")
 
+		identitySetUsernameFlags       = flag.NewFlagSet("set-username", flag.ExitOnError)
+		identitySetUsernameMessageFlag = identitySetUsernameFlags.String("message", "", "")
+
+		identityCheckUsernameFlags       = flag.NewFlagSet("check-username", flag.ExitOnError)
+		identityCheckUsernameMessageFlag = identityCheckUsernameFlags.String("message", "", "")
+
+		identityRequestMagicLinkFlags       = flag.NewFlagSet("request-magic-link", flag.ExitOnError)
+		identityRequestMagicLinkMessageFlag = identityRequestMagicLinkFlags.String("message", "", "")
+
+		identityConsumeMagicLinkFlags       = flag.NewFlagSet("consume-magic-link", flag.ExitOnError)
+		identityConsumeMagicLinkMessageFlag = identityConsumeMagicLinkFlags.String("message", "", "")
+
 		identityValidateTokenFlags       = flag.NewFlagSet("validate-token", flag.ExitOnError)
 		identityValidateTokenMessageFlag = identityValidateTokenFlags.String("message", "", "")
+
+		identityValidateTokensFlags       = flag.NewFlagSet("validate-tokens", flag.ExitOnError)
+		identityValidateTokensMessageFlag = identityValidateTokensFlags.String("message", "", "")
+
+		identityValidateTokenStreamFlags = flag.NewFlagSet("validate-token-stream", flag.ExitOnError)
+
+		identityListAuthEventsFlags       = flag.NewFlagSet("list-auth-events", flag.ExitOnError)
+		identityListAuthEventsMessageFlag = identityListAuthEventsFlags.String("message", "", "")
+
+		identityIntrospectFlags            = flag.NewFlagSet("introspect", flag.ExitOnError)
+		identityIntrospectMessageFlag      = identityIntrospectFlags.String("message", "", "")
+		identityIntrospectClientIDFlag     = identityIntrospectFlags.String("client-id", "REQUIRED", "")
+		identityIntrospectClientSecretFlag = identityIntrospectFlags.String("client-secret", "REQUIRED", "")
+
+		identityRevokeTokenFlags            = flag.NewFlagSet("revoke-token", flag.ExitOnError)
+		identityRevokeTokenMessageFlag      = identityRevokeTokenFlags.String("message", "", "")
+		identityRevokeTokenClientIDFlag     = identityRevokeTokenFlags.String("client-id", "REQUIRED", "")
+		identityRevokeTokenClientSecretFlag = identityRevokeTokenFlags.String("client-secret", "REQUIRED", "")
+
+		identityExchangeTokenFlags       = flag.NewFlagSet("exchange-token", flag.ExitOnError)
+		identityExchangeTokenMessageFlag = identityExchangeTokenFlags.String("message", "", "")
+
+		identityInviteUserFlags       = flag.NewFlagSet("invite-user", flag.ExitOnError)
+		identityInviteUserMessageFlag = identityInviteUserFlags.String("message", "", "")
+
+		identityListInvitationsFlags       = flag.NewFlagSet("list-invitations", flag.ExitOnError)
+		identityListInvitationsMessageFlag = identityListInvitationsFlags.String("message", "", "")
+
+		identityRevokeInvitationFlags       = flag.NewFlagSet("revoke-invitation", flag.ExitOnError)
+		identityRevokeInvitationMessageFlag = identityRevokeInvitationFlags.String("message", "", "")
+
+		identityAcceptInvitationFlags       = flag.NewFlagSet("accept-invitation", flag.ExitOnError)
+		identityAcceptInvitationMessageFlag = identityAcceptInvitationFlags.String("message", "", "")
+
+		identityDeviceAuthorizationFlags       = flag.NewFlagSet("device-authorization", flag.ExitOnError)
+		identityDeviceAuthorizationMessageFlag = identityDeviceAuthorizationFlags.String("message", "", "")
+
+		identityDeviceTokenFlags       = flag.NewFlagSet("device-token", flag.ExitOnError)
+		identityDeviceTokenMessageFlag = identityDeviceTokenFlags.String("message", "", "")
+
+		identityApproveDeviceFlags       = flag.NewFlagSet("approve-device", flag.ExitOnError)
+		identityApproveDeviceMessageFlag = identityApproveDeviceFlags.String("message", "", "")
+
+		identityCreateWebhookFlags       = flag.NewFlagSet("create-webhook", flag.ExitOnError)
+		identityCreateWebhookMessageFlag = identityCreateWebhookFlags.String("message", "", "")
+
+		identityListWebhooksFlags       = flag.NewFlagSet("list-webhooks", flag.ExitOnError)
+		identityListWebhooksMessageFlag = identityListWebhooksFlags.String("message", "", "")
+
+		identityDeleteWebhookFlags       = flag.NewFlagSet("delete-webhook", flag.ExitOnError)
+		identityDeleteWebhookMessageFlag = identityDeleteWebhookFlags.String("message", "", "")
+
+		identityListWebhookDeliveriesFlags       = flag.NewFlagSet("list-webhook-deliveries", flag.ExitOnError)
+		identityListWebhookDeliveriesMessageFlag = identityListWebhookDeliveriesFlags.String("message", "", "")
+
+		identityRedeliverWebhookFlags       = flag.NewFlagSet("redeliver-webhook", flag.ExitOnError)
+		identityRedeliverWebhookMessageFlag = identityRedeliverWebhookFlags.String("message", "", "")
+
+		identitySetUserStatusFlags       = flag.NewFlagSet("set-user-status", flag.ExitOnError)
+		identitySetUserStatusMessageFlag = identitySetUserStatusFlags.String("message", "", "")
 	)
 	identityFlags.Usage = identityUsage
 	identityRegisterFlags.Usage = identityRegisterUsage
 	identityLoginFlags.Usage = identityLoginUsage
+	identitySetUsernameFlags.Usage = identitySetUsernameUsage
+	identityCheckUsernameFlags.Usage = identityCheckUsernameUsage
+	identityRequestMagicLinkFlags.Usage = identityRequestMagicLinkUsage
+	identityConsumeMagicLinkFlags.Usage = identityConsumeMagicLinkUsage
 	identityValidateTokenFlags.Usage = identityValidateTokenUsage
+	identityValidateTokensFlags.Usage = identityValidateTokensUsage
+	identityValidateTokenStreamFlags.Usage = identityValidateTokenStreamUsage
+	identityListAuthEventsFlags.Usage = identityListAuthEventsUsage
+	identityIntrospectFlags.Usage = identityIntrospectUsage
+	identityRevokeTokenFlags.Usage = identityRevokeTokenUsage
+	identityExchangeTokenFlags.Usage = identityExchangeTokenUsage
+	identityInviteUserFlags.Usage = identityInviteUserUsage
+	identityListInvitationsFlags.Usage = identityListInvitationsUsage
+	identityRevokeInvitationFlags.Usage = identityRevokeInvitationUsage
+	identityAcceptInvitationFlags.Usage = identityAcceptInvitationUsage
+	identityDeviceAuthorizationFlags.Usage = identityDeviceAuthorizationUsage
+	identityDeviceTokenFlags.Usage = identityDeviceTokenUsage
+	identityApproveDeviceFlags.Usage = identityApproveDeviceUsage
+	identityCreateWebhookFlags.Usage = identityCreateWebhookUsage
+	identityListWebhooksFlags.Usage = identityListWebhooksUsage
+	identityDeleteWebhookFlags.Usage = identityDeleteWebhookUsage
+	identityListWebhookDeliveriesFlags.Usage = identityListWebhookDeliveriesUsage
+	identityRedeliverWebhookFlags.Usage = identityRedeliverWebhookUsage
+	identitySetUserStatusFlags.Usage = identitySetUserStatusUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -95,9 +190,78 @@ func ParseEndpoint(
 			case "login":
 				epf = identityLoginFlags
 
+			case "set-username":
+				epf = identitySetUsernameFlags
+
+			case "check-username":
+				epf = identityCheckUsernameFlags
+
+			case "request-magic-link":
+				epf = identityRequestMagicLinkFlags
+
+			case "consume-magic-link":
+				epf = identityConsumeMagicLinkFlags
+
 			case "validate-token":
 				epf = identityValidateTokenFlags
 
+			case "validate-tokens":
+				epf = identityValidateTokensFlags
+
+			case "validate-token-stream":
+				epf = identityValidateTokenStreamFlags
+
+			case "list-auth-events":
+				epf = identityListAuthEventsFlags
+
+			case "introspect":
+				epf = identityIntrospectFlags
+
+			case "revoke-token":
+				epf = identityRevokeTokenFlags
+
+			case "exchange-token":
+				epf = identityExchangeTokenFlags
+
+			case "invite-user":
+				epf = identityInviteUserFlags
+
+			case "list-invitations":
+				epf = identityListInvitationsFlags
+
+			case "revoke-invitation":
+				epf = identityRevokeInvitationFlags
+
+			case "accept-invitation":
+				epf = identityAcceptInvitationFlags
+
+			case "device-authorization":
+				epf = identityDeviceAuthorizationFlags
+
+			case "device-token":
+				epf = identityDeviceTokenFlags
+
+			case "approve-device":
+				epf = identityApproveDeviceFlags
+
+			case "create-webhook":
+				epf = identityCreateWebhookFlags
+
+			case "list-webhooks":
+				epf = identityListWebhooksFlags
+
+			case "delete-webhook":
+				epf = identityDeleteWebhookFlags
+
+			case "list-webhook-deliveries":
+				epf = identityListWebhookDeliveriesFlags
+
+			case "redeliver-webhook":
+				epf = identityRedeliverWebhookFlags
+
+			case "set-user-status":
+				epf = identitySetUserStatusFlags
+
 			}
 
 		}
@@ -129,9 +293,77 @@ func ParseEndpoint(
 			case "login":
 				endpoint = c.Login()
 				data, err = identityc.BuildLoginPayload(*identityLoginMessageFlag)
+			case "set-username":
+				endpoint = c.SetUsername()
+				data, err = identityc.BuildSetUsernamePayload(*identitySetUsernameMessageFlag)
+			case "check-username":
+				endpoint = c.CheckUsername()
+				data, err = identityc.BuildCheckUsernamePayload(*identityCheckUsernameMessageFlag)
+			case "request-magic-link":
+				endpoint = c.RequestMagicLink()
+				data, err = identityc.BuildRequestMagicLinkPayload(*identityRequestMagicLinkMessageFlag)
+			case "consume-magic-link":
+				endpoint = c.ConsumeMagicLink()
+				data, err = identityc.BuildConsumeMagicLinkPayload(*identityConsumeMagicLinkMessageFlag)
 			case "validate-token":
 				endpoint = c.ValidateToken()
 				data, err = identityc.BuildValidateTokenPayload(*identityValidateTokenMessageFlag)
+			case "validate-tokens":
+				endpoint = c.ValidateTokens()
+				data, err = identityc.BuildValidateTokensPayload(*identityValidateTokensMessageFlag)
+			case "validate-token-stream":
+				endpoint = c.ValidateTokenStream()
+			case "list-auth-events":
+				endpoint = c.ListAuthEvents()
+				data, err = identityc.BuildListAuthEventsPayload(*identityListAuthEventsMessageFlag)
+			case "introspect":
+				endpoint = c.Introspect()
+				data, err = identityc.BuildIntrospectPayload(*identityIntrospectMessageFlag, *identityIntrospectClientIDFlag, *identityIntrospectClientSecretFlag)
+			case "revoke-token":
+				endpoint = c.RevokeToken()
+				data, err = identityc.BuildRevokeTokenPayload(*identityRevokeTokenMessageFlag, *identityRevokeTokenClientIDFlag, *identityRevokeTokenClientSecretFlag)
+			case "exchange-token":
+				endpoint = c.ExchangeToken()
+				data, err = identityc.BuildExchangeTokenPayload(*identityExchangeTokenMessageFlag)
+			case "invite-user":
+				endpoint = c.InviteUser()
+				data, err = identityc.BuildInviteUserPayload(*identityInviteUserMessageFlag)
+			case "list-invitations":
+				endpoint = c.ListInvitations()
+				data, err = identityc.BuildListInvitationsPayload(*identityListInvitationsMessageFlag)
+			case "revoke-invitation":
+				endpoint = c.RevokeInvitation()
+				data, err = identityc.BuildRevokeInvitationPayload(*identityRevokeInvitationMessageFlag)
+			case "accept-invitation":
+				endpoint = c.AcceptInvitation()
+				data, err = identityc.BuildAcceptInvitationPayload(*identityAcceptInvitationMessageFlag)
+			case "device-authorization":
+				endpoint = c.DeviceAuthorization()
+				data, err = identityc.BuildDeviceAuthorizationPayload(*identityDeviceAuthorizationMessageFlag)
+			case "device-token":
+				endpoint = c.DeviceToken()
+				data, err = identityc.BuildDeviceTokenPayload(*identityDeviceTokenMessageFlag)
+			case "approve-device":
+				endpoint = c.ApproveDevice()
+				data, err = identityc.BuildApproveDevicePayload(*identityApproveDeviceMessageFlag)
+			case "create-webhook":
+				endpoint = c.CreateWebhook()
+				data, err = identityc.BuildCreateWebhookPayload(*identityCreateWebhookMessageFlag)
+			case "list-webhooks":
+				endpoint = c.ListWebhooks()
+				data, err = identityc.BuildListWebhooksPayload(*identityListWebhooksMessageFlag)
+			case "delete-webhook":
+				endpoint = c.DeleteWebhook()
+				data, err = identityc.BuildDeleteWebhookPayload(*identityDeleteWebhookMessageFlag)
+			case "list-webhook-deliveries":
+				endpoint = c.ListWebhookDeliveries()
+				data, err = identityc.BuildListWebhookDeliveriesPayload(*identityListWebhookDeliveriesMessageFlag)
+			case "redeliver-webhook":
+				endpoint = c.RedeliverWebhook()
+				data, err = identityc.BuildRedeliverWebhookPayload(*identityRedeliverWebhookMessageFlag)
+			case "set-user-status":
+				endpoint = c.SetUserStatus()
+				data, err = identityc.BuildSetUserStatusPayload(*identitySetUserStatusMessageFlag)
 			}
 		}
 	}
@@ -148,8 +380,31 @@ func identityUsage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] identity COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    register: Registers a new user`)
-	fmt.Fprintln(os.Stderr, `    login: Authenticates a user and issues a JWT`)
+	fmt.Fprintln(os.Stderr, `    login: Authenticates a user by email or username and issues a JWT or opaque access token`)
+	fmt.Fprintln(os.Stderr, `    set-username: Sets or changes the username of the calling user`)
+	fmt.Fprintln(os.Stderr, `    check-username: Reports whether a username is valid and not yet taken`)
+	fmt.Fprintln(os.Stderr, `    request-magic-link: Emails a single-use login link; succeeds whether or not the account exists`)
+	fmt.Fprintln(os.Stderr, `    consume-magic-link: Exchanges a login link token for an access token`)
 	fmt.Fprintln(os.Stderr, `    validate-token: Validates a JWT and returns the claims`)
+	fmt.Fprintln(os.Stderr, `    validate-tokens: Validates a batch of access tokens in one call`)
+	fmt.Fprintln(os.Stderr, `    validate-token-stream: Validates access tokens sent over a long-lived bidirectional stream; results are returned in request order`)
+	fmt.Fprintln(os.Stderr, `    list-auth-events: Lists security audit events; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    introspect: OAuth 2.0 token introspection (RFC 7662) for JWT and opaque access tokens`)
+	fmt.Fprintln(os.Stderr, `    revoke-token: OAuth 2.0 token revocation (RFC 7009) for opaque access tokens. Unknown and already revoked tokens are accepted silently; JWTs cannot be revoked and expire on their own`)
+	fmt.Fprintln(os.Stderr, `    exchange-token: Exchanges an administrator token for a short-lived token impersonating another user (RFC 8693)`)
+	fmt.Fprintln(os.Stderr, `    invite-user: Invites a colleague by email with optional pre-assigned attributes. Only administrators may pre-assign attributes and are told when the email is already registered`)
+	fmt.Fprintln(os.Stderr, `    list-invitations: Lists the invitations created by the caller`)
+	fmt.Fprintln(os.Stderr, `    revoke-invitation: Revokes a pending invitation created by the caller`)
+	fmt.Fprintln(os.Stderr, `    accept-invitation: Completes registration for an invited user`)
+	fmt.Fprintln(os.Stderr, `    device-authorization: Starts the OAuth 2.0 device authorization grant (RFC 8628)`)
+	fmt.Fprintln(os.Stderr, `    device-token: Polls for the access token of a device authorization (RFC 8628 section 3.4)`)
+	fmt.Fprintln(os.Stderr, `    approve-device: Approves or denies a device authorization on behalf of the signed-in user`)
+	fmt.Fprintln(os.Stderr, `    create-webhook: Subscribes an endpoint to user lifecycle events; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    list-webhooks: Lists webhook subscriptions; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    delete-webhook: Deletes a webhook subscription and its delivery log; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    list-webhook-deliveries: Lists the delivery log of a webhook subscription; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    redeliver-webhook: Queues a new delivery of a previously sent event; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    set-user-status: Suspends, deactivates or reactivates an account; restricted to administrators. Accounts that are not active cannot sign in and their tokens stop validating`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s identity COMMAND --help\n", os.Args[0])
@@ -169,7 +424,7 @@ func identityRegisterUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity register --message '{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity register --message '{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"username\": \"service_admin\"\n   }'")
 }
 
 func identityLoginUsage() {
@@ -180,14 +435,86 @@ func identityLoginUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Authenticates a user and issues a JWT`)
+	fmt.Fprintln(os.Stderr, `Authenticates a user by email or username and issues a JWT or opaque access token`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -message JSON: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity login --message '{\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity login --message '{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"email\": \"service@example.com\",\n      \"identifier\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"token_format\": \"jwt\"\n   }'")
+}
+
+func identitySetUsernameUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity set-username", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Sets or changes the username of the calling user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity set-username --message '{\n      \"token\": \"Libero eaque.\",\n      \"username\": \"service_admin\"\n   }'")
+}
+
+func identityCheckUsernameUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity check-username", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Reports whether a username is valid and not yet taken`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity check-username --message '{\n      \"username\": \"service_admin\"\n   }'")
+}
+
+func identityRequestMagicLinkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity request-magic-link", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Emails a single-use login link; succeeds whether or not the account exists`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity request-magic-link --message '{\n      \"email\": \"service@example.com\"\n   }'")
+}
+
+func identityConsumeMagicLinkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity consume-magic-link", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Exchanges a login link token for an access token`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity consume-magic-link --message '{\n      \"token\": \"Quisquam impedit soluta quis unde qui.\"\n   }'")
 }
 
 func identityValidateTokenUsage() {
@@ -205,5 +532,353 @@ func identityValidateTokenUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity validate-token --message '{\n      \"token\": \"Repellendus est libero quod optio.\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity validate-token --message '{\n      \"audience\": \"dummy-api\",\n      \"token\": \"Consectetur et quis quo nisi occaecati cum.\"\n   }'")
+}
+
+func identityValidateTokensUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity validate-tokens", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Validates a batch of access tokens in one call`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity validate-tokens --message '{\n      \"audience\": \"dummy-api\",\n      \"tokens\": [\n         \"Minus ut.\"\n      ]\n   }'")
+}
+
+func identityValidateTokenStreamUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity validate-token-stream", os.Args[0])
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Validates access tokens sent over a long-lived bidirectional stream; results are returned in request order`)
+
+	// Flags list
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity validate-token-stream")
+}
+
+func identityListAuthEventsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity list-auth-events", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists security audit events; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity list-auth-events --message '{\n      \"before_id\": 8562728044361935058,\n      \"limit\": 66,\n      \"since\": \"1998-03-21T15:16:19Z\",\n      \"token\": \"Molestias aut aspernatur illo esse.\",\n      \"type\": \"invitation\",\n      \"until\": \"2010-04-13T04:54:18Z\",\n      \"user_id\": \"74f8b72e-473c-4c2c-8497-3cc587d7a3ef\"\n   }'")
+}
+
+func identityIntrospectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity introspect", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -client-id STRING")
+	fmt.Fprint(os.Stderr, " -client-secret STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `OAuth 2.0 token introspection (RFC 7662) for JWT and opaque access tokens`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -client-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -client-secret STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity introspect --message '{\n      \"token\": \"Eum molestiae explicabo autem corporis consequatur fugiat.\",\n      \"token_type_hint\": \"access_token\"\n   }' --client-id \"Eum optio et velit.\" --client-secret \"Asperiores ipsam voluptatem ut accusamus.\"")
+}
+
+func identityRevokeTokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity revoke-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprint(os.Stderr, " -client-id STRING")
+	fmt.Fprint(os.Stderr, " -client-secret STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `OAuth 2.0 token revocation (RFC 7009) for opaque access tokens. Unknown and already revoked tokens are accepted silently; JWTs cannot be revoked and expire on their own`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+	fmt.Fprintln(os.Stderr, `    -client-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -client-secret STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity revoke-token --message '{\n      \"token\": \"Autem officia.\",\n      \"token_type_hint\": \"access_token\"\n   }' --client-id \"Qui omnis.\" --client-secret \"Id rerum tempora.\"")
+}
+
+func identityExchangeTokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity exchange-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Exchanges an administrator token for a short-lived token impersonating another user (RFC 8693)`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity exchange-token --message '{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:token-exchange\",\n      \"reason\": \"Reproducing support ticket #1234\",\n      \"requested_subject\": \"dc543c4b-c11c-441d-8e30-53e8bae85956\",\n      \"requested_token_type\": \"urn:ietf:params:oauth:token-type:access_token\",\n      \"token\": \"Fuga nesciunt at.\"\n   }'")
+}
+
+func identityInviteUserUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity invite-user", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Invites a colleague by email with optional pre-assigned attributes. Only administrators may pre-assign attributes and are told when the email is already registered`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity invite-user --message '{\n      \"attributes\": {\n         \"Qui sed odit.\": \"Ea harum.\"\n      },\n      \"display_name\": \"d13\",\n      \"email\": \"colleague@example.com\",\n      \"token\": \"Aliquid hic.\"\n   }'")
+}
+
+func identityListInvitationsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity list-invitations", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the invitations created by the caller`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity list-invitations --message '{\n      \"token\": \"Aut quidem neque animi quia.\"\n   }'")
+}
+
+func identityRevokeInvitationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity revoke-invitation", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Revokes a pending invitation created by the caller`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity revoke-invitation --message '{\n      \"id\": \"6c23c21c-7994-40ae-a7e2-baf08ffda1a3\",\n      \"token\": \"Id numquam fuga ut deserunt.\"\n   }'")
+}
+
+func identityAcceptInvitationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity accept-invitation", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Completes registration for an invited user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity accept-invitation --message '{\n      \"display_name\": \"4y7\",\n      \"invitation_token\": \"Nemo fugit error.\",\n      \"password\": \"2dz\"\n   }'")
+}
+
+func identityDeviceAuthorizationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity device-authorization", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Starts the OAuth 2.0 device authorization grant (RFC 8628)`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity device-authorization --message '{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"client_id\": \"cli\",\n      \"scope\": \"At quibusdam quia laborum sed.\"\n   }'")
+}
+
+func identityDeviceTokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity device-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Polls for the access token of a device authorization (RFC 8628 section 3.4)`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity device-token --message '{\n      \"client_id\": \"Est culpa temporibus vel debitis.\",\n      \"device_code\": \"Sapiente non.\",\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:device_code\"\n   }'")
+}
+
+func identityApproveDeviceUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity approve-device", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Approves or denies a device authorization on behalf of the signed-in user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity approve-device --message '{\n      \"approve\": true,\n      \"token\": \"Dolorem temporibus aliquid perferendis.\",\n      \"user_code\": \"WDJB-MJHT\"\n   }'")
+}
+
+func identityCreateWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity create-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Subscribes an endpoint to user lifecycle events; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity create-webhook --message '{\n      \"event_types\": [\n         \"user.deleted\",\n         \"user.disabled\",\n         \"user.registered\",\n         \"user.updated\"\n      ],\n      \"token\": \"Et debitis.\",\n      \"url\": \"https://hooks.example.com/identity\"\n   }'")
+}
+
+func identityListWebhooksUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity list-webhooks", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists webhook subscriptions; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity list-webhooks --message '{\n      \"token\": \"Consequatur eligendi qui.\"\n   }'")
+}
+
+func identityDeleteWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity delete-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Deletes a webhook subscription and its delivery log; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity delete-webhook --message '{\n      \"id\": \"fa9c2ad1-7bfb-4f6e-adf2-06ba5a4bb4de\",\n      \"token\": \"Ea vel sed dolores.\"\n   }'")
+}
+
+func identityListWebhookDeliveriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity list-webhook-deliveries", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the delivery log of a webhook subscription; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity list-webhook-deliveries --message '{\n      \"id\": \"736b7d14-af6b-41bd-a40f-6a61a31deba5\",\n      \"limit\": 90,\n      \"token\": \"Quis repudiandae.\"\n   }'")
+}
+
+func identityRedeliverWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity redeliver-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Queues a new delivery of a previously sent event; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity redeliver-webhook --message '{\n      \"id\": \"0cb7d5af-c6c1-400c-8256-3af9bc7a274d\",\n      \"token\": \"Veniam maxime voluptates non pariatur.\"\n   }'")
+}
+
+func identitySetUserStatusUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity set-user-status", os.Args[0])
+	fmt.Fprint(os.Stderr, " -message JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Suspends, deactivates or reactivates an account; restricted to administrators. Accounts that are not active cannot sign in and their tokens stop validating`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -message JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity set-user-status --message '{\n      \"id\": \"8ed74667-5273-4f7e-b60d-20a8a8ba4a05\",\n      \"reason\": \"32o\",\n      \"status\": \"deactivated\",\n      \"token\": \"Sed laboriosam nihil autem a.\"\n   }'")
 }
@@ -24,14 +24,15 @@ func BuildRegisterPayload(identityRegisterMessage string) (*identity.RegisterPay
 		if identityRegisterMessage != "" {
 			err = json.Unmarshal([]byte(identityRegisterMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"username\": \"service_admin\"\n   }'")
 			}
 		}
 	}
 	v := &identity.RegisterPayload{
-		DisplayName: message.DisplayName,
 		Email:       message.Email,
 		Password:    message.Password,
+		DisplayName: message.DisplayName,
+		Username:    message.Username,
 	}
 
 	return v, nil
@@ -39,20 +40,114 @@ func BuildRegisterPayload(identityRegisterMessage string) (*identity.RegisterPay
 
 // BuildLoginPayload builds the payload for the identity login endpoint from
 // CLI flags.
-func BuildLoginPayload(identityLoginMessage string) (*identity.Credentials, error) {
+func BuildLoginPayload(identityLoginMessage string) (*identity.LoginPayload, error) {
 	var err error
 	var message identitypb.LoginRequest
 	{
 		if identityLoginMessage != "" {
 			err = json.Unmarshal([]byte(identityLoginMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"email\": \"service@example.com\",\n      \"identifier\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"token_format\": \"jwt\"\n   }'")
+			}
+		}
+	}
+	v := &identity.LoginPayload{
+		Email:      message.Email,
+		Password:   message.Password,
+		Identifier: message.Identifier,
+	}
+	if message.TokenFormat != nil {
+		v.TokenFormat = *message.TokenFormat
+	}
+	if message.TokenFormat == nil {
+		v.TokenFormat = "jwt"
+	}
+	if message.Audience != nil {
+		v.Audience = make([]string, len(message.Audience))
+		for i, val := range message.Audience {
+			v.Audience[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildSetUsernamePayload builds the payload for the identity set_username
+// endpoint from CLI flags.
+func BuildSetUsernamePayload(identitySetUsernameMessage string) (*identity.SetUsernamePayload, error) {
+	var err error
+	var message identitypb.SetUsernameRequest
+	{
+		if identitySetUsernameMessage != "" {
+			err = json.Unmarshal([]byte(identitySetUsernameMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Libero eaque.\",\n      \"username\": \"service_admin\"\n   }'")
+			}
+		}
+	}
+	v := &identity.SetUsernamePayload{
+		Token:    message.Token,
+		Username: message.Username,
+	}
+
+	return v, nil
+}
+
+// BuildCheckUsernamePayload builds the payload for the identity check_username
+// endpoint from CLI flags.
+func BuildCheckUsernamePayload(identityCheckUsernameMessage string) (*identity.CheckUsernamePayload, error) {
+	var err error
+	var message identitypb.CheckUsernameRequest
+	{
+		if identityCheckUsernameMessage != "" {
+			err = json.Unmarshal([]byte(identityCheckUsernameMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"username\": \"service_admin\"\n   }'")
+			}
+		}
+	}
+	v := &identity.CheckUsernamePayload{
+		Username: message.Username,
+	}
+
+	return v, nil
+}
+
+// BuildRequestMagicLinkPayload builds the payload for the identity
+// request_magic_link endpoint from CLI flags.
+func BuildRequestMagicLinkPayload(identityRequestMagicLinkMessage string) (*identity.RequestMagicLinkPayload, error) {
+	var err error
+	var message identitypb.RequestMagicLinkRequest
+	{
+		if identityRequestMagicLinkMessage != "" {
+			err = json.Unmarshal([]byte(identityRequestMagicLinkMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"service@example.com\"\n   }'")
 			}
 		}
 	}
-	v := &identity.Credentials{
-		Email:    message.Email,
-		Password: message.Password,
+	v := &identity.RequestMagicLinkPayload{
+		Email: message.Email,
+	}
+
+	return v, nil
+}
+
+// BuildConsumeMagicLinkPayload builds the payload for the identity
+// consume_magic_link endpoint from CLI flags.
+func BuildConsumeMagicLinkPayload(identityConsumeMagicLinkMessage string) (*identity.ConsumeMagicLinkPayload, error) {
+	var err error
+	var message identitypb.ConsumeMagicLinkRequest
+	{
+		if identityConsumeMagicLinkMessage != "" {
+			err = json.Unmarshal([]byte(identityConsumeMagicLinkMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Quisquam impedit soluta quis unde qui.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.ConsumeMagicLinkPayload{
+		Token: message.Token,
 	}
 
 	return v, nil
@@ -67,12 +162,480 @@ func BuildValidateTokenPayload(identityValidateTokenMessage string) (*identity.V
 		if identityValidateTokenMessage != "" {
 			err = json.Unmarshal([]byte(identityValidateTokenMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Repellendus est libero quod optio.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": \"dummy-api\",\n      \"token\": \"Consectetur et quis quo nisi occaecati cum.\"\n   }'")
 			}
 		}
 	}
 	v := &identity.ValidateTokenPayload{
+		Token:    message.Token,
+		Audience: message.Audience,
+	}
+
+	return v, nil
+}
+
+// BuildValidateTokensPayload builds the payload for the identity
+// validate_tokens endpoint from CLI flags.
+func BuildValidateTokensPayload(identityValidateTokensMessage string) (*identity.ValidateTokensPayload, error) {
+	var err error
+	var message identitypb.ValidateTokensRequest
+	{
+		if identityValidateTokensMessage != "" {
+			err = json.Unmarshal([]byte(identityValidateTokensMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": \"dummy-api\",\n      \"tokens\": [\n         \"Minus ut.\"\n      ]\n   }'")
+			}
+		}
+	}
+	v := &identity.ValidateTokensPayload{
+		Audience: message.Audience,
+	}
+	if message.Tokens != nil {
+		v.Tokens = make([]string, len(message.Tokens))
+		for i, val := range message.Tokens {
+			v.Tokens[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildListAuthEventsPayload builds the payload for the identity
+// list_auth_events endpoint from CLI flags.
+func BuildListAuthEventsPayload(identityListAuthEventsMessage string) (*identity.ListAuthEventsPayload, error) {
+	var err error
+	var message identitypb.ListAuthEventsRequest
+	{
+		if identityListAuthEventsMessage != "" {
+			err = json.Unmarshal([]byte(identityListAuthEventsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"before_id\": 8562728044361935058,\n      \"limit\": 66,\n      \"since\": \"1998-03-21T15:16:19Z\",\n      \"token\": \"Molestias aut aspernatur illo esse.\",\n      \"type\": \"invitation\",\n      \"until\": \"2010-04-13T04:54:18Z\",\n      \"user_id\": \"74f8b72e-473c-4c2c-8497-3cc587d7a3ef\"\n   }'")
+			}
+		}
+	}
+	v := &identity.ListAuthEventsPayload{
+		Token:    message.Token,
+		UserID:   message.UserId,
+		Type:     message.Type,
+		Since:    message.Since,
+		Until:    message.Until,
+		BeforeID: message.BeforeId,
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Limit == nil {
+		v.Limit = 100
+	}
+
+	return v, nil
+}
+
+// BuildIntrospectPayload builds the payload for the identity introspect
+// endpoint from CLI flags.
+func BuildIntrospectPayload(identityIntrospectMessage string, identityIntrospectClientID string, identityIntrospectClientSecret string) (*identity.IntrospectPayload, error) {
+	var err error
+	var message identitypb.IntrospectRequest
+	{
+		if identityIntrospectMessage != "" {
+			err = json.Unmarshal([]byte(identityIntrospectMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Eum molestiae explicabo autem corporis consequatur fugiat.\",\n      \"token_type_hint\": \"access_token\"\n   }'")
+			}
+		}
+	}
+	var clientID string
+	{
+		clientID = identityIntrospectClientID
+	}
+	var clientSecret string
+	{
+		clientSecret = identityIntrospectClientSecret
+	}
+	v := &identity.IntrospectPayload{
+		Token:         message.Token,
+		TokenTypeHint: message.TokenTypeHint,
+	}
+	v.ClientID = clientID
+	v.ClientSecret = clientSecret
+
+	return v, nil
+}
+
+// BuildRevokeTokenPayload builds the payload for the identity revoke_token
+// endpoint from CLI flags.
+func BuildRevokeTokenPayload(identityRevokeTokenMessage string, identityRevokeTokenClientID string, identityRevokeTokenClientSecret string) (*identity.RevokeTokenPayload, error) {
+	var err error
+	var message identitypb.RevokeTokenRequest
+	{
+		if identityRevokeTokenMessage != "" {
+			err = json.Unmarshal([]byte(identityRevokeTokenMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Autem officia.\",\n      \"token_type_hint\": \"access_token\"\n   }'")
+			}
+		}
+	}
+	var clientID string
+	{
+		clientID = identityRevokeTokenClientID
+	}
+	var clientSecret string
+	{
+		clientSecret = identityRevokeTokenClientSecret
+	}
+	v := &identity.RevokeTokenPayload{
+		Token:         message.Token,
+		TokenTypeHint: message.TokenTypeHint,
+	}
+	v.ClientID = clientID
+	v.ClientSecret = clientSecret
+
+	return v, nil
+}
+
+// BuildExchangeTokenPayload builds the payload for the identity exchange_token
+// endpoint from CLI flags.
+func BuildExchangeTokenPayload(identityExchangeTokenMessage string) (*identity.TokenExchangePayload, error) {
+	var err error
+	var message identitypb.ExchangeTokenRequest
+	{
+		if identityExchangeTokenMessage != "" {
+			err = json.Unmarshal([]byte(identityExchangeTokenMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:token-exchange\",\n      \"reason\": \"Reproducing support ticket #1234\",\n      \"requested_subject\": \"dc543c4b-c11c-441d-8e30-53e8bae85956\",\n      \"requested_token_type\": \"urn:ietf:params:oauth:token-type:access_token\",\n      \"token\": \"Fuga nesciunt at.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.TokenExchangePayload{
+		Token:            message.Token,
+		RequestedSubject: message.RequestedSubject,
+		Reason:           message.Reason,
+	}
+	if message.GrantType != nil {
+		v.GrantType = *message.GrantType
+	}
+	if message.RequestedTokenType != nil {
+		v.RequestedTokenType = *message.RequestedTokenType
+	}
+	if message.GrantType == nil {
+		v.GrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	}
+	if message.RequestedTokenType == nil {
+		v.RequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+	}
+	if message.Audience != nil {
+		v.Audience = make([]string, len(message.Audience))
+		for i, val := range message.Audience {
+			v.Audience[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildInviteUserPayload builds the payload for the identity invite_user
+// endpoint from CLI flags.
+func BuildInviteUserPayload(identityInviteUserMessage string) (*identity.InviteUserPayload, error) {
+	var err error
+	var message identitypb.InviteUserRequest
+	{
+		if identityInviteUserMessage != "" {
+			err = json.Unmarshal([]byte(identityInviteUserMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attributes\": {\n         \"Qui sed odit.\": \"Ea harum.\"\n      },\n      \"display_name\": \"d13\",\n      \"email\": \"colleague@example.com\",\n      \"token\": \"Aliquid hic.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.InviteUserPayload{
+		Token:       message.Token,
+		Email:       message.Email,
+		DisplayName: message.DisplayName,
+	}
+	if message.Attributes != nil {
+		v.Attributes = make(map[string]string, len(message.Attributes))
+		for key, val := range message.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+
+	return v, nil
+}
+
+// BuildListInvitationsPayload builds the payload for the identity
+// list_invitations endpoint from CLI flags.
+func BuildListInvitationsPayload(identityListInvitationsMessage string) (*identity.ListInvitationsPayload, error) {
+	var err error
+	var message identitypb.ListInvitationsRequest
+	{
+		if identityListInvitationsMessage != "" {
+			err = json.Unmarshal([]byte(identityListInvitationsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Aut quidem neque animi quia.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.ListInvitationsPayload{
+		Token: message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildRevokeInvitationPayload builds the payload for the identity
+// revoke_invitation endpoint from CLI flags.
+func BuildRevokeInvitationPayload(identityRevokeInvitationMessage string) (*identity.RevokeInvitationPayload, error) {
+	var err error
+	var message identitypb.RevokeInvitationRequest
+	{
+		if identityRevokeInvitationMessage != "" {
+			err = json.Unmarshal([]byte(identityRevokeInvitationMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"6c23c21c-7994-40ae-a7e2-baf08ffda1a3\",\n      \"token\": \"Id numquam fuga ut deserunt.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.RevokeInvitationPayload{
+		Token: message.Token,
+		ID:    message.Id,
+	}
+
+	return v, nil
+}
+
+// BuildAcceptInvitationPayload builds the payload for the identity
+// accept_invitation endpoint from CLI flags.
+func BuildAcceptInvitationPayload(identityAcceptInvitationMessage string) (*identity.AcceptInvitationPayload, error) {
+	var err error
+	var message identitypb.AcceptInvitationRequest
+	{
+		if identityAcceptInvitationMessage != "" {
+			err = json.Unmarshal([]byte(identityAcceptInvitationMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"display_name\": \"4y7\",\n      \"invitation_token\": \"Nemo fugit error.\",\n      \"password\": \"2dz\"\n   }'")
+			}
+		}
+	}
+	v := &identity.AcceptInvitationPayload{
+		InvitationToken: message.InvitationToken,
+		Password:        message.Password,
+		DisplayName:     message.DisplayName,
+	}
+
+	return v, nil
+}
+
+// BuildDeviceAuthorizationPayload builds the payload for the identity
+// device_authorization endpoint from CLI flags.
+func BuildDeviceAuthorizationPayload(identityDeviceAuthorizationMessage string) (*identity.DeviceAuthorizationPayload, error) {
+	var err error
+	var message identitypb.DeviceAuthorizationRequest
+	{
+		if identityDeviceAuthorizationMessage != "" {
+			err = json.Unmarshal([]byte(identityDeviceAuthorizationMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"client_id\": \"cli\",\n      \"scope\": \"At quibusdam quia laborum sed.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.DeviceAuthorizationPayload{
+		ClientID: message.ClientId,
+		Scope:    message.Scope,
+	}
+	if message.Audience != nil {
+		v.Audience = make([]string, len(message.Audience))
+		for i, val := range message.Audience {
+			v.Audience[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildDeviceTokenPayload builds the payload for the identity device_token
+// endpoint from CLI flags.
+func BuildDeviceTokenPayload(identityDeviceTokenMessage string) (*identity.DeviceTokenPayload, error) {
+	var err error
+	var message identitypb.DeviceTokenRequest
+	{
+		if identityDeviceTokenMessage != "" {
+			err = json.Unmarshal([]byte(identityDeviceTokenMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"client_id\": \"Est culpa temporibus vel debitis.\",\n      \"device_code\": \"Sapiente non.\",\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:device_code\"\n   }'")
+			}
+		}
+	}
+	v := &identity.DeviceTokenPayload{
+		GrantType:  message.GrantType,
+		DeviceCode: message.DeviceCode,
+		ClientID:   message.ClientId,
+	}
+
+	return v, nil
+}
+
+// BuildApproveDevicePayload builds the payload for the identity approve_device
+// endpoint from CLI flags.
+func BuildApproveDevicePayload(identityApproveDeviceMessage string) (*identity.ApproveDevicePayload, error) {
+	var err error
+	var message identitypb.ApproveDeviceRequest
+	{
+		if identityApproveDeviceMessage != "" {
+			err = json.Unmarshal([]byte(identityApproveDeviceMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"approve\": true,\n      \"token\": \"Dolorem temporibus aliquid perferendis.\",\n      \"user_code\": \"WDJB-MJHT\"\n   }'")
+			}
+		}
+	}
+	v := &identity.ApproveDevicePayload{
+		Token:    message.Token,
+		UserCode: message.UserCode,
+	}
+	if message.Approve != nil {
+		v.Approve = *message.Approve
+	}
+	if message.Approve == nil {
+		v.Approve = true
+	}
+
+	return v, nil
+}
+
+// BuildCreateWebhookPayload builds the payload for the identity create_webhook
+// endpoint from CLI flags.
+func BuildCreateWebhookPayload(identityCreateWebhookMessage string) (*identity.CreateWebhookPayload, error) {
+	var err error
+	var message identitypb.CreateWebhookRequest
+	{
+		if identityCreateWebhookMessage != "" {
+			err = json.Unmarshal([]byte(identityCreateWebhookMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"event_types\": [\n         \"user.deleted\",\n         \"user.disabled\",\n         \"user.registered\",\n         \"user.updated\"\n      ],\n      \"token\": \"Et debitis.\",\n      \"url\": \"https://hooks.example.com/identity\"\n   }'")
+			}
+		}
+	}
+	v := &identity.CreateWebhookPayload{
+		Token: message.Token,
+		URL:   message.Url,
+	}
+	if message.EventTypes != nil {
+		v.EventTypes = make([]string, len(message.EventTypes))
+		for i, val := range message.EventTypes {
+			v.EventTypes[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildListWebhooksPayload builds the payload for the identity list_webhooks
+// endpoint from CLI flags.
+func BuildListWebhooksPayload(identityListWebhooksMessage string) (*identity.ListWebhooksPayload, error) {
+	var err error
+	var message identitypb.ListWebhooksRequest
+	{
+		if identityListWebhooksMessage != "" {
+			err = json.Unmarshal([]byte(identityListWebhooksMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Consequatur eligendi qui.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.ListWebhooksPayload{
+		Token: message.Token,
+	}
+
+	return v, nil
+}
+
+// BuildDeleteWebhookPayload builds the payload for the identity delete_webhook
+// endpoint from CLI flags.
+func BuildDeleteWebhookPayload(identityDeleteWebhookMessage string) (*identity.DeleteWebhookPayload, error) {
+	var err error
+	var message identitypb.DeleteWebhookRequest
+	{
+		if identityDeleteWebhookMessage != "" {
+			err = json.Unmarshal([]byte(identityDeleteWebhookMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"fa9c2ad1-7bfb-4f6e-adf2-06ba5a4bb4de\",\n      \"token\": \"Ea vel sed dolores.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.DeleteWebhookPayload{
 		Token: message.Token,
+		ID:    message.Id,
+	}
+
+	return v, nil
+}
+
+// BuildListWebhookDeliveriesPayload builds the payload for the identity
+// list_webhook_deliveries endpoint from CLI flags.
+func BuildListWebhookDeliveriesPayload(identityListWebhookDeliveriesMessage string) (*identity.ListWebhookDeliveriesPayload, error) {
+	var err error
+	var message identitypb.ListWebhookDeliveriesRequest
+	{
+		if identityListWebhookDeliveriesMessage != "" {
+			err = json.Unmarshal([]byte(identityListWebhookDeliveriesMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"736b7d14-af6b-41bd-a40f-6a61a31deba5\",\n      \"limit\": 90,\n      \"token\": \"Quis repudiandae.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.ListWebhookDeliveriesPayload{
+		Token: message.Token,
+		ID:    message.Id,
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Limit == nil {
+		v.Limit = 100
+	}
+
+	return v, nil
+}
+
+// BuildRedeliverWebhookPayload builds the payload for the identity
+// redeliver_webhook endpoint from CLI flags.
+func BuildRedeliverWebhookPayload(identityRedeliverWebhookMessage string) (*identity.RedeliverWebhookPayload, error) {
+	var err error
+	var message identitypb.RedeliverWebhookRequest
+	{
+		if identityRedeliverWebhookMessage != "" {
+			err = json.Unmarshal([]byte(identityRedeliverWebhookMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"0cb7d5af-c6c1-400c-8256-3af9bc7a274d\",\n      \"token\": \"Veniam maxime voluptates non pariatur.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.RedeliverWebhookPayload{
+		Token: message.Token,
+		ID:    message.Id,
+	}
+
+	return v, nil
+}
+
+// BuildSetUserStatusPayload builds the payload for the identity
+// set_user_status endpoint from CLI flags.
+func BuildSetUserStatusPayload(identitySetUserStatusMessage string) (*identity.SetUserStatusPayload, error) {
+	var err error
+	var message identitypb.SetUserStatusRequest
+	{
+		if identitySetUserStatusMessage != "" {
+			err = json.Unmarshal([]byte(identitySetUserStatusMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"id\": \"8ed74667-5273-4f7e-b60d-20a8a8ba4a05\",\n      \"reason\": \"32o\",\n      \"status\": \"deactivated\",\n      \"token\": \"Sed laboriosam nihil autem a.\"\n   }'")
+			}
+		}
+	}
+	v := &identity.SetUserStatusPayload{
+		Token:  message.Token,
+		ID:     message.Id,
+		Status: message.Status,
+		Reason: message.Reason,
 	}
 
 	return v, nil
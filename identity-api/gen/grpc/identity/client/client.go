@@ -11,6 +11,7 @@ import (
 	"context"
 
 	identitypb "github.com/vidwadeseram/go-boilerplate/identity-api/gen/grpc/identity/pb"
+	identity "github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
 	goagrpc "goa.design/goa/v3/grpc"
 	goapb "goa.design/goa/v3/grpc/pb"
 	goa "goa.design/goa/v3/pkg"
@@ -23,6 +24,12 @@ type Client struct {
 	opts    []grpc.CallOption
 }
 
+// ValidateTokenStreamClientStream implements the
+// identity.ValidateTokenStreamClientStream interface.
+type ValidateTokenStreamClientStream struct {
+	stream identitypb.Identity_ValidateTokenStreamClient
+}
+
 // NewClient instantiates gRPC client for all the identity service servers.
 func NewClient(cc *grpc.ClientConn, opts ...grpc.CallOption) *Client {
 	return &Client{
@@ -41,12 +48,24 @@ func (c *Client) Register() goa.Endpoint {
 			DecodeRegisterResponse)
 		res, err := inv.Invoke(ctx, v)
 		if err != nil {
-			// Try to decode a Goa error response detail before falling back to Fault.
 			resp := goagrpc.DecodeError(err)
-			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
-				return nil, goagrpc.NewServiceError(eresp)
+			switch message := resp.(type) {
+			case *identitypb.RegisterPasswordPolicyError:
+				if err := ValidateRegisterPasswordPolicyError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewRegisterPasswordPolicyError(message)
+			case *identitypb.RegisterInvalidUsernameError:
+				return nil, NewRegisterInvalidUsernameError(message)
+			case *identitypb.RegisterConflictError:
+				return nil, NewRegisterConflictError(message)
+			case *identitypb.RegisterUnauthorizedError:
+				return nil, NewRegisterUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
 			}
-			return nil, goa.Fault("%s", err.Error())
 		}
 		return res, nil
 	}
@@ -72,6 +91,103 @@ func (c *Client) Login() goa.Endpoint {
 	}
 }
 
+// SetUsername calls the "SetUsername" function in identitypb.IdentityClient
+// interface.
+func (c *Client) SetUsername() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildSetUsernameFunc(c.grpccli, c.opts...),
+			EncodeSetUsernameRequest,
+			DecodeSetUsernameResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.SetUsernameInvalidUsernameError:
+				return nil, NewSetUsernameInvalidUsernameError(message)
+			case *identitypb.SetUsernameConflictError:
+				return nil, NewSetUsernameConflictError(message)
+			case *identitypb.SetUsernameUnauthorizedError:
+				return nil, NewSetUsernameUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CheckUsername calls the "CheckUsername" function in
+// identitypb.IdentityClient interface.
+func (c *Client) CheckUsername() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCheckUsernameFunc(c.grpccli, c.opts...),
+			EncodeCheckUsernameRequest,
+			DecodeCheckUsernameResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			// Try to decode a Goa error response detail before falling back to Fault.
+			resp := goagrpc.DecodeError(err)
+			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
+				return nil, goagrpc.NewServiceError(eresp)
+			}
+			return nil, goa.Fault("%s", err.Error())
+		}
+		return res, nil
+	}
+}
+
+// RequestMagicLink calls the "RequestMagicLink" function in
+// identitypb.IdentityClient interface.
+func (c *Client) RequestMagicLink() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRequestMagicLinkFunc(c.grpccli, c.opts...),
+			EncodeRequestMagicLinkRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.RequestMagicLinkUnauthorizedError:
+				return nil, NewRequestMagicLinkUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ConsumeMagicLink calls the "ConsumeMagicLink" function in
+// identitypb.IdentityClient interface.
+func (c *Client) ConsumeMagicLink() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildConsumeMagicLinkFunc(c.grpccli, c.opts...),
+			EncodeConsumeMagicLinkRequest,
+			DecodeConsumeMagicLinkResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.ConsumeMagicLinkUnauthorizedError:
+				return nil, NewConsumeMagicLinkUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
 // ValidateToken calls the "ValidateToken" function in
 // identitypb.IdentityClient interface.
 func (c *Client) ValidateToken() goa.Endpoint {
@@ -92,3 +208,528 @@ func (c *Client) ValidateToken() goa.Endpoint {
 		return res, nil
 	}
 }
+
+// ValidateTokens calls the "ValidateTokens" function in
+// identitypb.IdentityClient interface.
+func (c *Client) ValidateTokens() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildValidateTokensFunc(c.grpccli, c.opts...),
+			EncodeValidateTokensRequest,
+			DecodeValidateTokensResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			// Try to decode a Goa error response detail before falling back to Fault.
+			resp := goagrpc.DecodeError(err)
+			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
+				return nil, goagrpc.NewServiceError(eresp)
+			}
+			return nil, goa.Fault("%s", err.Error())
+		}
+		return res, nil
+	}
+}
+
+// ValidateTokenStream calls the "ValidateTokenStream" function in
+// identitypb.IdentityClient interface.
+func (c *Client) ValidateTokenStream() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildValidateTokenStreamFunc(c.grpccli, c.opts...),
+			nil,
+			DecodeValidateTokenStreamResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			// Try to decode a Goa error response detail before falling back to Fault.
+			resp := goagrpc.DecodeError(err)
+			if eresp, ok := resp.(*goapb.ErrorResponse); ok {
+				return nil, goagrpc.NewServiceError(eresp)
+			}
+			return nil, goa.Fault("%s", err.Error())
+		}
+		return res, nil
+	}
+}
+
+// ListAuthEvents calls the "ListAuthEvents" function in
+// identitypb.IdentityClient interface.
+func (c *Client) ListAuthEvents() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListAuthEventsFunc(c.grpccli, c.opts...),
+			EncodeListAuthEventsRequest,
+			DecodeListAuthEventsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.ListAuthEventsUnauthorizedError:
+				return nil, NewListAuthEventsUnauthorizedError(message)
+			case *identitypb.ListAuthEventsBadRequestError:
+				return nil, NewListAuthEventsBadRequestError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Introspect calls the "Introspect" function in identitypb.IdentityClient
+// interface.
+func (c *Client) Introspect() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildIntrospectFunc(c.grpccli, c.opts...),
+			EncodeIntrospectRequest,
+			DecodeIntrospectResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.IntrospectUnauthorizedError:
+				return nil, NewIntrospectUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// RevokeToken calls the "RevokeToken" function in identitypb.IdentityClient
+// interface.
+func (c *Client) RevokeToken() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRevokeTokenFunc(c.grpccli, c.opts...),
+			EncodeRevokeTokenRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.RevokeTokenUnauthorizedError:
+				return nil, NewRevokeTokenUnauthorizedError(message)
+			case *identitypb.RevokeTokenUnsupportedTokenTypeError:
+				return nil, NewRevokeTokenUnsupportedTokenTypeError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ExchangeToken calls the "ExchangeToken" function in
+// identitypb.IdentityClient interface.
+func (c *Client) ExchangeToken() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildExchangeTokenFunc(c.grpccli, c.opts...),
+			EncodeExchangeTokenRequest,
+			DecodeExchangeTokenResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.ExchangeTokenUnauthorizedError:
+				return nil, NewExchangeTokenUnauthorizedError(message)
+			case *identitypb.ExchangeTokenNotFoundError:
+				return nil, NewExchangeTokenNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// InviteUser calls the "InviteUser" function in identitypb.IdentityClient
+// interface.
+func (c *Client) InviteUser() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildInviteUserFunc(c.grpccli, c.opts...),
+			EncodeInviteUserRequest,
+			DecodeInviteUserResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.InviteUserConflictError:
+				return nil, NewInviteUserConflictError(message)
+			case *identitypb.InviteUserUnauthorizedError:
+				return nil, NewInviteUserUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListInvitations calls the "ListInvitations" function in
+// identitypb.IdentityClient interface.
+func (c *Client) ListInvitations() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListInvitationsFunc(c.grpccli, c.opts...),
+			EncodeListInvitationsRequest,
+			DecodeListInvitationsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.ListInvitationsUnauthorizedError:
+				return nil, NewListInvitationsUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// RevokeInvitation calls the "RevokeInvitation" function in
+// identitypb.IdentityClient interface.
+func (c *Client) RevokeInvitation() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRevokeInvitationFunc(c.grpccli, c.opts...),
+			EncodeRevokeInvitationRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.RevokeInvitationUnauthorizedError:
+				return nil, NewRevokeInvitationUnauthorizedError(message)
+			case *identitypb.RevokeInvitationNotFoundError:
+				return nil, NewRevokeInvitationNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// AcceptInvitation calls the "AcceptInvitation" function in
+// identitypb.IdentityClient interface.
+func (c *Client) AcceptInvitation() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildAcceptInvitationFunc(c.grpccli, c.opts...),
+			EncodeAcceptInvitationRequest,
+			DecodeAcceptInvitationResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.AcceptInvitationPasswordPolicyError:
+				if err := ValidateAcceptInvitationPasswordPolicyError(message); err != nil {
+					return nil, err
+				}
+				return nil, NewAcceptInvitationPasswordPolicyError(message)
+			case *identitypb.AcceptInvitationConflictError:
+				return nil, NewAcceptInvitationConflictError(message)
+			case *identitypb.AcceptInvitationUnauthorizedError:
+				return nil, NewAcceptInvitationUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// DeviceAuthorization calls the "DeviceAuthorization" function in
+// identitypb.IdentityClient interface.
+func (c *Client) DeviceAuthorization() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildDeviceAuthorizationFunc(c.grpccli, c.opts...),
+			EncodeDeviceAuthorizationRequest,
+			DecodeDeviceAuthorizationResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.DeviceAuthorizationInvalidClientError:
+				return nil, NewDeviceAuthorizationInvalidClientError(message)
+			case *identitypb.DeviceAuthorizationInvalidScopeError:
+				return nil, NewDeviceAuthorizationInvalidScopeError(message)
+			case *identitypb.DeviceAuthorizationInvalidTargetError:
+				return nil, NewDeviceAuthorizationInvalidTargetError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// DeviceToken calls the "DeviceToken" function in identitypb.IdentityClient
+// interface.
+func (c *Client) DeviceToken() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildDeviceTokenFunc(c.grpccli, c.opts...),
+			EncodeDeviceTokenRequest,
+			DecodeDeviceTokenResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.DeviceTokenAuthorizationPendingError:
+				return nil, NewDeviceTokenAuthorizationPendingError(message)
+			case *identitypb.DeviceTokenSlowDownError:
+				return nil, NewDeviceTokenSlowDownError(message)
+			case *identitypb.DeviceTokenAccessDeniedError:
+				return nil, NewDeviceTokenAccessDeniedError(message)
+			case *identitypb.DeviceTokenExpiredTokenError:
+				return nil, NewDeviceTokenExpiredTokenError(message)
+			case *identitypb.DeviceTokenInvalidGrantError:
+				return nil, NewDeviceTokenInvalidGrantError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ApproveDevice calls the "ApproveDevice" function in
+// identitypb.IdentityClient interface.
+func (c *Client) ApproveDevice() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildApproveDeviceFunc(c.grpccli, c.opts...),
+			EncodeApproveDeviceRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.ApproveDeviceUnauthorizedError:
+				return nil, NewApproveDeviceUnauthorizedError(message)
+			case *identitypb.ApproveDeviceNotFoundError:
+				return nil, NewApproveDeviceNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// CreateWebhook calls the "CreateWebhook" function in
+// identitypb.IdentityClient interface.
+func (c *Client) CreateWebhook() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildCreateWebhookFunc(c.grpccli, c.opts...),
+			EncodeCreateWebhookRequest,
+			DecodeCreateWebhookResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.CreateWebhookUnauthorizedError:
+				return nil, NewCreateWebhookUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListWebhooks calls the "ListWebhooks" function in identitypb.IdentityClient
+// interface.
+func (c *Client) ListWebhooks() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListWebhooksFunc(c.grpccli, c.opts...),
+			EncodeListWebhooksRequest,
+			DecodeListWebhooksResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.ListWebhooksUnauthorizedError:
+				return nil, NewListWebhooksUnauthorizedError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// DeleteWebhook calls the "DeleteWebhook" function in
+// identitypb.IdentityClient interface.
+func (c *Client) DeleteWebhook() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildDeleteWebhookFunc(c.grpccli, c.opts...),
+			EncodeDeleteWebhookRequest,
+			nil)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.DeleteWebhookUnauthorizedError:
+				return nil, NewDeleteWebhookUnauthorizedError(message)
+			case *identitypb.DeleteWebhookNotFoundError:
+				return nil, NewDeleteWebhookNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// ListWebhookDeliveries calls the "ListWebhookDeliveries" function in
+// identitypb.IdentityClient interface.
+func (c *Client) ListWebhookDeliveries() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildListWebhookDeliveriesFunc(c.grpccli, c.opts...),
+			EncodeListWebhookDeliveriesRequest,
+			DecodeListWebhookDeliveriesResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.ListWebhookDeliveriesUnauthorizedError:
+				return nil, NewListWebhookDeliveriesUnauthorizedError(message)
+			case *identitypb.ListWebhookDeliveriesNotFoundError:
+				return nil, NewListWebhookDeliveriesNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// RedeliverWebhook calls the "RedeliverWebhook" function in
+// identitypb.IdentityClient interface.
+func (c *Client) RedeliverWebhook() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildRedeliverWebhookFunc(c.grpccli, c.opts...),
+			EncodeRedeliverWebhookRequest,
+			DecodeRedeliverWebhookResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.RedeliverWebhookUnauthorizedError:
+				return nil, NewRedeliverWebhookUnauthorizedError(message)
+			case *identitypb.RedeliverWebhookNotFoundError:
+				return nil, NewRedeliverWebhookNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// SetUserStatus calls the "SetUserStatus" function in
+// identitypb.IdentityClient interface.
+func (c *Client) SetUserStatus() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildSetUserStatusFunc(c.grpccli, c.opts...),
+			EncodeSetUserStatusRequest,
+			DecodeSetUserStatusResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *identitypb.SetUserStatusUnauthorizedError:
+				return nil, NewSetUserStatusUnauthorizedError(message)
+			case *identitypb.SetUserStatusNotFoundError:
+				return nil, NewSetUserStatusNotFoundError(message)
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
+// Recv reads instances of "identitypb.ValidateTokenStreamResponse" from the
+// "validate_token_stream" endpoint gRPC stream.
+func (s *ValidateTokenStreamClientStream) Recv() (*identity.ValidateTokenStreamResponse, error) {
+	var res *identity.ValidateTokenStreamResponse
+	v, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+	return NewValidateTokenStreamResponseValidateTokenStreamResponse(v), nil
+}
+
+// RecvWithContext reads instances of "identitypb.ValidateTokenStreamResponse"
+// from the "validate_token_stream" endpoint gRPC stream with context.
+func (s *ValidateTokenStreamClientStream) RecvWithContext(ctx context.Context) (*identity.ValidateTokenStreamResponse, error) {
+	return s.Recv()
+}
+
+// Send streams instances of "identitypb.ValidateTokenStreamStreamingRequest"
+// to the "validate_token_stream" endpoint gRPC stream.
+func (s *ValidateTokenStreamClientStream) Send(res *identity.ValidateTokenStreamRequest) error {
+	v := NewProtoValidateTokenStreamRequestValidateTokenStreamStreamingRequest(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of
+// "identitypb.ValidateTokenStreamStreamingRequest" to the
+// "validate_token_stream" endpoint gRPC stream with context.
+func (s *ValidateTokenStreamClientStream) SendWithContext(ctx context.Context, res *identity.ValidateTokenStreamRequest) error {
+	return s.Send(res)
+}
+
+func (s *ValidateTokenStreamClientStream) Close() error {
+	// Close the send direction of the stream
+	return s.stream.CloseSend()
+}
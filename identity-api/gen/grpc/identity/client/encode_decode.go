@@ -77,9 +77,9 @@ func BuildLoginFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOptio
 
 // EncodeLoginRequest encodes requests sent to identity login endpoint.
 func EncodeLoginRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
-	payload, ok := v.(*identity.Credentials)
+	payload, ok := v.(*identity.LoginPayload)
 	if !ok {
-		return nil, goagrpc.ErrInvalidType("identity", "login", "*identity.Credentials", v)
+		return nil, goagrpc.ErrInvalidType("identity", "login", "*identity.LoginPayload", v)
 	}
 	return NewProtoLoginRequest(payload), nil
 }
@@ -94,6 +94,145 @@ func DecodeLoginResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any
 	return res, nil
 }
 
+// BuildSetUsernameFunc builds the remote method to invoke for "identity"
+// service "set_username" endpoint.
+func BuildSetUsernameFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.SetUsername(ctx, reqpb.(*identitypb.SetUsernameRequest), opts...)
+		}
+		return grpccli.SetUsername(ctx, &identitypb.SetUsernameRequest{}, opts...)
+	}
+}
+
+// EncodeSetUsernameRequest encodes requests sent to identity set_username
+// endpoint.
+func EncodeSetUsernameRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.SetUsernamePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "set_username", "*identity.SetUsernamePayload", v)
+	}
+	return NewProtoSetUsernameRequest(payload), nil
+}
+
+// DecodeSetUsernameResponse decodes responses from the identity set_username
+// endpoint.
+func DecodeSetUsernameResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*identitypb.SetUsernameResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "set_username", "*identitypb.SetUsernameResponse", v)
+	}
+	res := NewSetUsernameResult(message)
+	vres := &identityviews.User{Projected: res, View: view}
+	if err := identityviews.ValidateUser(vres); err != nil {
+		return nil, err
+	}
+	return identity.NewUser(vres), nil
+}
+
+// BuildCheckUsernameFunc builds the remote method to invoke for "identity"
+// service "check_username" endpoint.
+func BuildCheckUsernameFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CheckUsername(ctx, reqpb.(*identitypb.CheckUsernameRequest), opts...)
+		}
+		return grpccli.CheckUsername(ctx, &identitypb.CheckUsernameRequest{}, opts...)
+	}
+}
+
+// EncodeCheckUsernameRequest encodes requests sent to identity check_username
+// endpoint.
+func EncodeCheckUsernameRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.CheckUsernamePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "check_username", "*identity.CheckUsernamePayload", v)
+	}
+	return NewProtoCheckUsernameRequest(payload), nil
+}
+
+// DecodeCheckUsernameResponse decodes responses from the identity
+// check_username endpoint.
+func DecodeCheckUsernameResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.CheckUsernameResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "check_username", "*identitypb.CheckUsernameResponse", v)
+	}
+	res := NewCheckUsernameResult(message)
+	return res, nil
+}
+
+// BuildRequestMagicLinkFunc builds the remote method to invoke for "identity"
+// service "request_magic_link" endpoint.
+func BuildRequestMagicLinkFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RequestMagicLink(ctx, reqpb.(*identitypb.RequestMagicLinkRequest), opts...)
+		}
+		return grpccli.RequestMagicLink(ctx, &identitypb.RequestMagicLinkRequest{}, opts...)
+	}
+}
+
+// EncodeRequestMagicLinkRequest encodes requests sent to identity
+// request_magic_link endpoint.
+func EncodeRequestMagicLinkRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.RequestMagicLinkPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "request_magic_link", "*identity.RequestMagicLinkPayload", v)
+	}
+	return NewProtoRequestMagicLinkRequest(payload), nil
+}
+
+// BuildConsumeMagicLinkFunc builds the remote method to invoke for "identity"
+// service "consume_magic_link" endpoint.
+func BuildConsumeMagicLinkFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ConsumeMagicLink(ctx, reqpb.(*identitypb.ConsumeMagicLinkRequest), opts...)
+		}
+		return grpccli.ConsumeMagicLink(ctx, &identitypb.ConsumeMagicLinkRequest{}, opts...)
+	}
+}
+
+// EncodeConsumeMagicLinkRequest encodes requests sent to identity
+// consume_magic_link endpoint.
+func EncodeConsumeMagicLinkRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.ConsumeMagicLinkPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "consume_magic_link", "*identity.ConsumeMagicLinkPayload", v)
+	}
+	return NewProtoConsumeMagicLinkRequest(payload), nil
+}
+
+// DecodeConsumeMagicLinkResponse decodes responses from the identity
+// consume_magic_link endpoint.
+func DecodeConsumeMagicLinkResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.ConsumeMagicLinkResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "consume_magic_link", "*identitypb.ConsumeMagicLinkResponse", v)
+	}
+	res := NewConsumeMagicLinkResult(message)
+	return res, nil
+}
+
 // BuildValidateTokenFunc builds the remote method to invoke for "identity"
 // service "validate_token" endpoint.
 func BuildValidateTokenFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
@@ -128,3 +267,659 @@ func DecodeValidateTokenResponse(ctx context.Context, v any, hdr, trlr metadata.
 	res := NewValidateTokenResult(message)
 	return res, nil
 }
+
+// BuildValidateTokensFunc builds the remote method to invoke for "identity"
+// service "validate_tokens" endpoint.
+func BuildValidateTokensFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ValidateTokens(ctx, reqpb.(*identitypb.ValidateTokensRequest), opts...)
+		}
+		return grpccli.ValidateTokens(ctx, &identitypb.ValidateTokensRequest{}, opts...)
+	}
+}
+
+// EncodeValidateTokensRequest encodes requests sent to identity
+// validate_tokens endpoint.
+func EncodeValidateTokensRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.ValidateTokensPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "validate_tokens", "*identity.ValidateTokensPayload", v)
+	}
+	return NewProtoValidateTokensRequest(payload), nil
+}
+
+// DecodeValidateTokensResponse decodes responses from the identity
+// validate_tokens endpoint.
+func DecodeValidateTokensResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.ValidateTokensResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "validate_tokens", "*identitypb.ValidateTokensResponse", v)
+	}
+	if err := ValidateValidateTokensResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewValidateTokensResult(message)
+	return res, nil
+}
+
+// BuildValidateTokenStreamFunc builds the remote method to invoke for
+// "identity" service "validate_token_stream" endpoint.
+func BuildValidateTokenStreamFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ValidateTokenStream(ctx, opts...)
+		}
+		return grpccli.ValidateTokenStream(ctx, opts...)
+	}
+}
+
+// DecodeValidateTokenStreamResponse decodes responses from the identity
+// validate_token_stream endpoint.
+func DecodeValidateTokenStreamResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	return &ValidateTokenStreamClientStream{
+		stream: v.(identitypb.Identity_ValidateTokenStreamClient),
+	}, nil
+}
+
+// BuildListAuthEventsFunc builds the remote method to invoke for "identity"
+// service "list_auth_events" endpoint.
+func BuildListAuthEventsFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListAuthEvents(ctx, reqpb.(*identitypb.ListAuthEventsRequest), opts...)
+		}
+		return grpccli.ListAuthEvents(ctx, &identitypb.ListAuthEventsRequest{}, opts...)
+	}
+}
+
+// EncodeListAuthEventsRequest encodes requests sent to identity
+// list_auth_events endpoint.
+func EncodeListAuthEventsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.ListAuthEventsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_auth_events", "*identity.ListAuthEventsPayload", v)
+	}
+	return NewProtoListAuthEventsRequest(payload), nil
+}
+
+// DecodeListAuthEventsResponse decodes responses from the identity
+// list_auth_events endpoint.
+func DecodeListAuthEventsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.ListAuthEventsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_auth_events", "*identitypb.ListAuthEventsResponse", v)
+	}
+	if err := ValidateListAuthEventsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListAuthEventsResult(message)
+	return res, nil
+}
+
+// BuildIntrospectFunc builds the remote method to invoke for "identity"
+// service "introspect" endpoint.
+func BuildIntrospectFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.Introspect(ctx, reqpb.(*identitypb.IntrospectRequest), opts...)
+		}
+		return grpccli.Introspect(ctx, &identitypb.IntrospectRequest{}, opts...)
+	}
+}
+
+// EncodeIntrospectRequest encodes requests sent to identity introspect
+// endpoint.
+func EncodeIntrospectRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.IntrospectPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "introspect", "*identity.IntrospectPayload", v)
+	}
+	(*md).Append("client_id", payload.ClientID)
+	(*md).Append("client_secret", payload.ClientSecret)
+	return NewProtoIntrospectRequest(payload), nil
+}
+
+// DecodeIntrospectResponse decodes responses from the identity introspect
+// endpoint.
+func DecodeIntrospectResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.IntrospectResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "introspect", "*identitypb.IntrospectResponse", v)
+	}
+	res := NewIntrospectResult(message)
+	return res, nil
+}
+
+// BuildRevokeTokenFunc builds the remote method to invoke for "identity"
+// service "revoke_token" endpoint.
+func BuildRevokeTokenFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RevokeToken(ctx, reqpb.(*identitypb.RevokeTokenRequest), opts...)
+		}
+		return grpccli.RevokeToken(ctx, &identitypb.RevokeTokenRequest{}, opts...)
+	}
+}
+
+// EncodeRevokeTokenRequest encodes requests sent to identity revoke_token
+// endpoint.
+func EncodeRevokeTokenRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.RevokeTokenPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "revoke_token", "*identity.RevokeTokenPayload", v)
+	}
+	(*md).Append("client_id", payload.ClientID)
+	(*md).Append("client_secret", payload.ClientSecret)
+	return NewProtoRevokeTokenRequest(payload), nil
+}
+
+// BuildExchangeTokenFunc builds the remote method to invoke for "identity"
+// service "exchange_token" endpoint.
+func BuildExchangeTokenFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ExchangeToken(ctx, reqpb.(*identitypb.ExchangeTokenRequest), opts...)
+		}
+		return grpccli.ExchangeToken(ctx, &identitypb.ExchangeTokenRequest{}, opts...)
+	}
+}
+
+// EncodeExchangeTokenRequest encodes requests sent to identity exchange_token
+// endpoint.
+func EncodeExchangeTokenRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.TokenExchangePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "exchange_token", "*identity.TokenExchangePayload", v)
+	}
+	return NewProtoExchangeTokenRequest(payload), nil
+}
+
+// DecodeExchangeTokenResponse decodes responses from the identity
+// exchange_token endpoint.
+func DecodeExchangeTokenResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.ExchangeTokenResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "exchange_token", "*identitypb.ExchangeTokenResponse", v)
+	}
+	res := NewExchangeTokenResult(message)
+	return res, nil
+}
+
+// BuildInviteUserFunc builds the remote method to invoke for "identity"
+// service "invite_user" endpoint.
+func BuildInviteUserFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.InviteUser(ctx, reqpb.(*identitypb.InviteUserRequest), opts...)
+		}
+		return grpccli.InviteUser(ctx, &identitypb.InviteUserRequest{}, opts...)
+	}
+}
+
+// EncodeInviteUserRequest encodes requests sent to identity invite_user
+// endpoint.
+func EncodeInviteUserRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.InviteUserPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "invite_user", "*identity.InviteUserPayload", v)
+	}
+	return NewProtoInviteUserRequest(payload), nil
+}
+
+// DecodeInviteUserResponse decodes responses from the identity invite_user
+// endpoint.
+func DecodeInviteUserResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.InviteUserResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "invite_user", "*identitypb.InviteUserResponse", v)
+	}
+	if err := ValidateInviteUserResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewInviteUserResult(message)
+	return res, nil
+}
+
+// BuildListInvitationsFunc builds the remote method to invoke for "identity"
+// service "list_invitations" endpoint.
+func BuildListInvitationsFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListInvitations(ctx, reqpb.(*identitypb.ListInvitationsRequest), opts...)
+		}
+		return grpccli.ListInvitations(ctx, &identitypb.ListInvitationsRequest{}, opts...)
+	}
+}
+
+// EncodeListInvitationsRequest encodes requests sent to identity
+// list_invitations endpoint.
+func EncodeListInvitationsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.ListInvitationsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_invitations", "*identity.ListInvitationsPayload", v)
+	}
+	return NewProtoListInvitationsRequest(payload), nil
+}
+
+// DecodeListInvitationsResponse decodes responses from the identity
+// list_invitations endpoint.
+func DecodeListInvitationsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.ListInvitationsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_invitations", "*identitypb.ListInvitationsResponse", v)
+	}
+	if err := ValidateListInvitationsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListInvitationsResult(message)
+	return res, nil
+}
+
+// BuildRevokeInvitationFunc builds the remote method to invoke for "identity"
+// service "revoke_invitation" endpoint.
+func BuildRevokeInvitationFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RevokeInvitation(ctx, reqpb.(*identitypb.RevokeInvitationRequest), opts...)
+		}
+		return grpccli.RevokeInvitation(ctx, &identitypb.RevokeInvitationRequest{}, opts...)
+	}
+}
+
+// EncodeRevokeInvitationRequest encodes requests sent to identity
+// revoke_invitation endpoint.
+func EncodeRevokeInvitationRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.RevokeInvitationPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "revoke_invitation", "*identity.RevokeInvitationPayload", v)
+	}
+	return NewProtoRevokeInvitationRequest(payload), nil
+}
+
+// BuildAcceptInvitationFunc builds the remote method to invoke for "identity"
+// service "accept_invitation" endpoint.
+func BuildAcceptInvitationFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.AcceptInvitation(ctx, reqpb.(*identitypb.AcceptInvitationRequest), opts...)
+		}
+		return grpccli.AcceptInvitation(ctx, &identitypb.AcceptInvitationRequest{}, opts...)
+	}
+}
+
+// EncodeAcceptInvitationRequest encodes requests sent to identity
+// accept_invitation endpoint.
+func EncodeAcceptInvitationRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.AcceptInvitationPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "accept_invitation", "*identity.AcceptInvitationPayload", v)
+	}
+	return NewProtoAcceptInvitationRequest(payload), nil
+}
+
+// DecodeAcceptInvitationResponse decodes responses from the identity
+// accept_invitation endpoint.
+func DecodeAcceptInvitationResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*identitypb.AcceptInvitationResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "accept_invitation", "*identitypb.AcceptInvitationResponse", v)
+	}
+	res := NewAcceptInvitationResult(message)
+	vres := &identityviews.User{Projected: res, View: view}
+	if err := identityviews.ValidateUser(vres); err != nil {
+		return nil, err
+	}
+	return identity.NewUser(vres), nil
+}
+
+// BuildDeviceAuthorizationFunc builds the remote method to invoke for
+// "identity" service "device_authorization" endpoint.
+func BuildDeviceAuthorizationFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.DeviceAuthorization(ctx, reqpb.(*identitypb.DeviceAuthorizationRequest), opts...)
+		}
+		return grpccli.DeviceAuthorization(ctx, &identitypb.DeviceAuthorizationRequest{}, opts...)
+	}
+}
+
+// EncodeDeviceAuthorizationRequest encodes requests sent to identity
+// device_authorization endpoint.
+func EncodeDeviceAuthorizationRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.DeviceAuthorizationPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "device_authorization", "*identity.DeviceAuthorizationPayload", v)
+	}
+	return NewProtoDeviceAuthorizationRequest(payload), nil
+}
+
+// DecodeDeviceAuthorizationResponse decodes responses from the identity
+// device_authorization endpoint.
+func DecodeDeviceAuthorizationResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.DeviceAuthorizationResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "device_authorization", "*identitypb.DeviceAuthorizationResponse", v)
+	}
+	res := NewDeviceAuthorizationResult(message)
+	return res, nil
+}
+
+// BuildDeviceTokenFunc builds the remote method to invoke for "identity"
+// service "device_token" endpoint.
+func BuildDeviceTokenFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.DeviceToken(ctx, reqpb.(*identitypb.DeviceTokenRequest), opts...)
+		}
+		return grpccli.DeviceToken(ctx, &identitypb.DeviceTokenRequest{}, opts...)
+	}
+}
+
+// EncodeDeviceTokenRequest encodes requests sent to identity device_token
+// endpoint.
+func EncodeDeviceTokenRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.DeviceTokenPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "device_token", "*identity.DeviceTokenPayload", v)
+	}
+	return NewProtoDeviceTokenRequest(payload), nil
+}
+
+// DecodeDeviceTokenResponse decodes responses from the identity device_token
+// endpoint.
+func DecodeDeviceTokenResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.DeviceTokenResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "device_token", "*identitypb.DeviceTokenResponse", v)
+	}
+	res := NewDeviceTokenResult(message)
+	return res, nil
+}
+
+// BuildApproveDeviceFunc builds the remote method to invoke for "identity"
+// service "approve_device" endpoint.
+func BuildApproveDeviceFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ApproveDevice(ctx, reqpb.(*identitypb.ApproveDeviceRequest), opts...)
+		}
+		return grpccli.ApproveDevice(ctx, &identitypb.ApproveDeviceRequest{}, opts...)
+	}
+}
+
+// EncodeApproveDeviceRequest encodes requests sent to identity approve_device
+// endpoint.
+func EncodeApproveDeviceRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.ApproveDevicePayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "approve_device", "*identity.ApproveDevicePayload", v)
+	}
+	return NewProtoApproveDeviceRequest(payload), nil
+}
+
+// BuildCreateWebhookFunc builds the remote method to invoke for "identity"
+// service "create_webhook" endpoint.
+func BuildCreateWebhookFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.CreateWebhook(ctx, reqpb.(*identitypb.CreateWebhookRequest), opts...)
+		}
+		return grpccli.CreateWebhook(ctx, &identitypb.CreateWebhookRequest{}, opts...)
+	}
+}
+
+// EncodeCreateWebhookRequest encodes requests sent to identity create_webhook
+// endpoint.
+func EncodeCreateWebhookRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.CreateWebhookPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "create_webhook", "*identity.CreateWebhookPayload", v)
+	}
+	return NewProtoCreateWebhookRequest(payload), nil
+}
+
+// DecodeCreateWebhookResponse decodes responses from the identity
+// create_webhook endpoint.
+func DecodeCreateWebhookResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.CreateWebhookResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "create_webhook", "*identitypb.CreateWebhookResponse", v)
+	}
+	if err := ValidateCreateWebhookResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewCreateWebhookResult(message)
+	return res, nil
+}
+
+// BuildListWebhooksFunc builds the remote method to invoke for "identity"
+// service "list_webhooks" endpoint.
+func BuildListWebhooksFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListWebhooks(ctx, reqpb.(*identitypb.ListWebhooksRequest), opts...)
+		}
+		return grpccli.ListWebhooks(ctx, &identitypb.ListWebhooksRequest{}, opts...)
+	}
+}
+
+// EncodeListWebhooksRequest encodes requests sent to identity list_webhooks
+// endpoint.
+func EncodeListWebhooksRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.ListWebhooksPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_webhooks", "*identity.ListWebhooksPayload", v)
+	}
+	return NewProtoListWebhooksRequest(payload), nil
+}
+
+// DecodeListWebhooksResponse decodes responses from the identity list_webhooks
+// endpoint.
+func DecodeListWebhooksResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.ListWebhooksResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_webhooks", "*identitypb.ListWebhooksResponse", v)
+	}
+	if err := ValidateListWebhooksResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListWebhooksResult(message)
+	return res, nil
+}
+
+// BuildDeleteWebhookFunc builds the remote method to invoke for "identity"
+// service "delete_webhook" endpoint.
+func BuildDeleteWebhookFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.DeleteWebhook(ctx, reqpb.(*identitypb.DeleteWebhookRequest), opts...)
+		}
+		return grpccli.DeleteWebhook(ctx, &identitypb.DeleteWebhookRequest{}, opts...)
+	}
+}
+
+// EncodeDeleteWebhookRequest encodes requests sent to identity delete_webhook
+// endpoint.
+func EncodeDeleteWebhookRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.DeleteWebhookPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "delete_webhook", "*identity.DeleteWebhookPayload", v)
+	}
+	return NewProtoDeleteWebhookRequest(payload), nil
+}
+
+// BuildListWebhookDeliveriesFunc builds the remote method to invoke for
+// "identity" service "list_webhook_deliveries" endpoint.
+func BuildListWebhookDeliveriesFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.ListWebhookDeliveries(ctx, reqpb.(*identitypb.ListWebhookDeliveriesRequest), opts...)
+		}
+		return grpccli.ListWebhookDeliveries(ctx, &identitypb.ListWebhookDeliveriesRequest{}, opts...)
+	}
+}
+
+// EncodeListWebhookDeliveriesRequest encodes requests sent to identity
+// list_webhook_deliveries endpoint.
+func EncodeListWebhookDeliveriesRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.ListWebhookDeliveriesPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_webhook_deliveries", "*identity.ListWebhookDeliveriesPayload", v)
+	}
+	return NewProtoListWebhookDeliveriesRequest(payload), nil
+}
+
+// DecodeListWebhookDeliveriesResponse decodes responses from the identity
+// list_webhook_deliveries endpoint.
+func DecodeListWebhookDeliveriesResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.ListWebhookDeliveriesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_webhook_deliveries", "*identitypb.ListWebhookDeliveriesResponse", v)
+	}
+	if err := ValidateListWebhookDeliveriesResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewListWebhookDeliveriesResult(message)
+	return res, nil
+}
+
+// BuildRedeliverWebhookFunc builds the remote method to invoke for "identity"
+// service "redeliver_webhook" endpoint.
+func BuildRedeliverWebhookFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.RedeliverWebhook(ctx, reqpb.(*identitypb.RedeliverWebhookRequest), opts...)
+		}
+		return grpccli.RedeliverWebhook(ctx, &identitypb.RedeliverWebhookRequest{}, opts...)
+	}
+}
+
+// EncodeRedeliverWebhookRequest encodes requests sent to identity
+// redeliver_webhook endpoint.
+func EncodeRedeliverWebhookRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.RedeliverWebhookPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "redeliver_webhook", "*identity.RedeliverWebhookPayload", v)
+	}
+	return NewProtoRedeliverWebhookRequest(payload), nil
+}
+
+// DecodeRedeliverWebhookResponse decodes responses from the identity
+// redeliver_webhook endpoint.
+func DecodeRedeliverWebhookResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*identitypb.RedeliverWebhookResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "redeliver_webhook", "*identitypb.RedeliverWebhookResponse", v)
+	}
+	if err := ValidateRedeliverWebhookResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewRedeliverWebhookResult(message)
+	return res, nil
+}
+
+// BuildSetUserStatusFunc builds the remote method to invoke for "identity"
+// service "set_user_status" endpoint.
+func BuildSetUserStatusFunc(grpccli identitypb.IdentityClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.SetUserStatus(ctx, reqpb.(*identitypb.SetUserStatusRequest), opts...)
+		}
+		return grpccli.SetUserStatus(ctx, &identitypb.SetUserStatusRequest{}, opts...)
+	}
+}
+
+// EncodeSetUserStatusRequest encodes requests sent to identity set_user_status
+// endpoint.
+func EncodeSetUserStatusRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*identity.SetUserStatusPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "set_user_status", "*identity.SetUserStatusPayload", v)
+	}
+	return NewProtoSetUserStatusRequest(payload), nil
+}
+
+// DecodeSetUserStatusResponse decodes responses from the identity
+// set_user_status endpoint.
+func DecodeSetUserStatusResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	var view string
+	{
+		if vals := hdr.Get("goa-view"); len(vals) > 0 {
+			view = vals[0]
+		}
+	}
+	message, ok := v.(*identitypb.SetUserStatusResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "set_user_status", "*identitypb.SetUserStatusResponse", v)
+	}
+	res := NewSetUserStatusResult(message)
+	vres := &identityviews.User{Projected: res, View: view}
+	if err := identityviews.ValidateUser(vres); err != nil {
+		return nil, err
+	}
+	return identity.NewUser(vres), nil
+}
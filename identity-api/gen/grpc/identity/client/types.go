@@ -18,9 +18,10 @@ import (
 // "register" endpoint of the "identity" service.
 func NewProtoRegisterRequest(payload *identity.RegisterPayload) *identitypb.RegisterRequest {
 	message := &identitypb.RegisterRequest{
-		DisplayName: payload.DisplayName,
 		Email:       payload.Email,
 		Password:    payload.Password,
+		DisplayName: payload.DisplayName,
+		Username:    payload.Username,
 	}
 	return message
 }
@@ -29,20 +30,91 @@ func NewProtoRegisterRequest(payload *identity.RegisterPayload) *identitypb.Regi
 // "identity" service from the gRPC response type.
 func NewRegisterResult(message *identitypb.RegisterResponse) *identityviews.UserView {
 	result := &identityviews.UserView{
-		ID:          &message.Id,
-		Email:       &message.Email,
-		DisplayName: &message.DisplayName,
-		CreatedAt:   &message.CreatedAt,
+		ID:              &message.Id,
+		Email:           &message.Email,
+		DisplayName:     &message.DisplayName,
+		CreatedAt:       &message.CreatedAt,
+		Status:          &message.Status,
+		StatusReason:    message.StatusReason,
+		StatusChangedAt: message.StatusChangedAt,
+		Username:        message.Username,
+	}
+	if message.Attributes != nil {
+		result.Attributes = make(map[string]string, len(message.Attributes))
+		for key, val := range message.Attributes {
+			tk := key
+			tv := val
+			result.Attributes[tk] = tv
+		}
 	}
 	return result
 }
 
+// NewRegisterPasswordPolicyError builds the error type of the "register"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewRegisterPasswordPolicyError(message *identitypb.RegisterPasswordPolicyError) *identity.PasswordPolicyError {
+	er := &identity.PasswordPolicyError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	if message.Violations != nil {
+		er.Violations = make([]*identity.PolicyViolation, len(message.Violations))
+		for i, val := range message.Violations {
+			er.Violations[i] = &identity.PolicyViolation{
+				Rule:    val.Rule,
+				Message: val.Message_,
+			}
+		}
+	}
+	return er
+}
+
+// NewRegisterInvalidUsernameError builds the error type of the "register"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewRegisterInvalidUsernameError(message *identitypb.RegisterInvalidUsernameError) *identity.InvalidUsernameError {
+	er := &identity.InvalidUsernameError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	return er
+}
+
+// NewRegisterConflictError builds the error type of the "register" endpoint of
+// the "identity" service from the gRPC error response type.
+func NewRegisterConflictError(message *identitypb.RegisterConflictError) *identity.ConflictError {
+	er := &identity.ConflictError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	return er
+}
+
+// NewRegisterUnauthorizedError builds the error type of the "register"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewRegisterUnauthorizedError(message *identitypb.RegisterUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
 // NewProtoLoginRequest builds the gRPC request type from the payload of the
 // "login" endpoint of the "identity" service.
-func NewProtoLoginRequest(payload *identity.Credentials) *identitypb.LoginRequest {
+func NewProtoLoginRequest(payload *identity.LoginPayload) *identitypb.LoginRequest {
 	message := &identitypb.LoginRequest{
-		Email:    payload.Email,
-		Password: payload.Password,
+		TokenFormat: &payload.TokenFormat,
+		Email:       payload.Email,
+		Password:    payload.Password,
+		Identifier:  payload.Identifier,
+	}
+	if payload.Audience != nil {
+		message.Audience = make([]string, len(payload.Audience))
+		for i, val := range payload.Audience {
+			message.Audience[i] = val
+		}
 	}
 	return message
 }
@@ -57,11 +129,153 @@ func NewLoginResult(message *identitypb.LoginResponse) *identity.TokenResult {
 	return result
 }
 
+// NewProtoSetUsernameRequest builds the gRPC request type from the payload of
+// the "set_username" endpoint of the "identity" service.
+func NewProtoSetUsernameRequest(payload *identity.SetUsernamePayload) *identitypb.SetUsernameRequest {
+	message := &identitypb.SetUsernameRequest{
+		Token:    payload.Token,
+		Username: payload.Username,
+	}
+	return message
+}
+
+// NewSetUsernameResult builds the result type of the "set_username" endpoint
+// of the "identity" service from the gRPC response type.
+func NewSetUsernameResult(message *identitypb.SetUsernameResponse) *identityviews.UserView {
+	result := &identityviews.UserView{
+		ID:              &message.Id,
+		Email:           &message.Email,
+		DisplayName:     &message.DisplayName,
+		CreatedAt:       &message.CreatedAt,
+		Status:          &message.Status,
+		StatusReason:    message.StatusReason,
+		StatusChangedAt: message.StatusChangedAt,
+		Username:        message.Username,
+	}
+	if message.Attributes != nil {
+		result.Attributes = make(map[string]string, len(message.Attributes))
+		for key, val := range message.Attributes {
+			tk := key
+			tv := val
+			result.Attributes[tk] = tv
+		}
+	}
+	return result
+}
+
+// NewSetUsernameInvalidUsernameError builds the error type of the
+// "set_username" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewSetUsernameInvalidUsernameError(message *identitypb.SetUsernameInvalidUsernameError) *identity.InvalidUsernameError {
+	er := &identity.InvalidUsernameError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	return er
+}
+
+// NewSetUsernameConflictError builds the error type of the "set_username"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewSetUsernameConflictError(message *identitypb.SetUsernameConflictError) *identity.ConflictError {
+	er := &identity.ConflictError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	return er
+}
+
+// NewSetUsernameUnauthorizedError builds the error type of the "set_username"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewSetUsernameUnauthorizedError(message *identitypb.SetUsernameUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoCheckUsernameRequest builds the gRPC request type from the payload
+// of the "check_username" endpoint of the "identity" service.
+func NewProtoCheckUsernameRequest(payload *identity.CheckUsernamePayload) *identitypb.CheckUsernameRequest {
+	message := &identitypb.CheckUsernameRequest{
+		Username: payload.Username,
+	}
+	return message
+}
+
+// NewCheckUsernameResult builds the result type of the "check_username"
+// endpoint of the "identity" service from the gRPC response type.
+func NewCheckUsernameResult(message *identitypb.CheckUsernameResponse) *identity.UsernameAvailability {
+	result := &identity.UsernameAvailability{
+		Username:  message.Username,
+		Available: message.Available,
+		Reason:    message.Reason,
+	}
+	return result
+}
+
+// NewProtoRequestMagicLinkRequest builds the gRPC request type from the
+// payload of the "request_magic_link" endpoint of the "identity" service.
+func NewProtoRequestMagicLinkRequest(payload *identity.RequestMagicLinkPayload) *identitypb.RequestMagicLinkRequest {
+	message := &identitypb.RequestMagicLinkRequest{
+		Email: payload.Email,
+	}
+	return message
+}
+
+// NewRequestMagicLinkUnauthorizedError builds the error type of the
+// "request_magic_link" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewRequestMagicLinkUnauthorizedError(message *identitypb.RequestMagicLinkUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoConsumeMagicLinkRequest builds the gRPC request type from the
+// payload of the "consume_magic_link" endpoint of the "identity" service.
+func NewProtoConsumeMagicLinkRequest(payload *identity.ConsumeMagicLinkPayload) *identitypb.ConsumeMagicLinkRequest {
+	message := &identitypb.ConsumeMagicLinkRequest{
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewConsumeMagicLinkResult builds the result type of the "consume_magic_link"
+// endpoint of the "identity" service from the gRPC response type.
+func NewConsumeMagicLinkResult(message *identitypb.ConsumeMagicLinkResponse) *identity.TokenResult {
+	result := &identity.TokenResult{
+		AccessToken: message.AccessToken,
+		ExpiresIn:   int(message.ExpiresIn),
+	}
+	return result
+}
+
+// NewConsumeMagicLinkUnauthorizedError builds the error type of the
+// "consume_magic_link" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewConsumeMagicLinkUnauthorizedError(message *identitypb.ConsumeMagicLinkUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
 // NewProtoValidateTokenRequest builds the gRPC request type from the payload
 // of the "validate_token" endpoint of the "identity" service.
 func NewProtoValidateTokenRequest(payload *identity.ValidateTokenPayload) *identitypb.ValidateTokenRequest {
 	message := &identitypb.ValidateTokenRequest{
-		Token: payload.Token,
+		Token:    payload.Token,
+		Audience: payload.Audience,
 	}
 	return message
 }
@@ -75,11 +289,1351 @@ func NewValidateTokenResult(message *identitypb.ValidateTokenResponse) *identity
 		Email:  message.Email,
 		Reason: message.Reason,
 	}
+	if message.Actor != nil {
+		result.Actor = protobufIdentitypbActorToIdentityActor(message.Actor)
+	}
+	if message.Audience != nil {
+		result.Audience = make([]string, len(message.Audience))
+		for i, val := range message.Audience {
+			result.Audience[i] = val
+		}
+	}
+	if message.Claims != nil {
+		result.Claims = make(map[string]string, len(message.Claims))
+		for key, val := range message.Claims {
+			tk := key
+			tv := val
+			result.Claims[tk] = tv
+		}
+	}
+	return result
+}
+
+// NewProtoValidateTokensRequest builds the gRPC request type from the payload
+// of the "validate_tokens" endpoint of the "identity" service.
+func NewProtoValidateTokensRequest(payload *identity.ValidateTokensPayload) *identitypb.ValidateTokensRequest {
+	message := &identitypb.ValidateTokensRequest{
+		Audience: payload.Audience,
+	}
+	if payload.Tokens != nil {
+		message.Tokens = make([]string, len(payload.Tokens))
+		for i, val := range payload.Tokens {
+			message.Tokens[i] = val
+		}
+	}
+	return message
+}
+
+// NewValidateTokensResult builds the result type of the "validate_tokens"
+// endpoint of the "identity" service from the gRPC response type.
+func NewValidateTokensResult(message *identitypb.ValidateTokensResponse) *identity.ValidationResultsCollection {
+	result := &identity.ValidationResultsCollection{}
+	if message.Results != nil {
+		result.Results = make([]*identity.ValidationResult, len(message.Results))
+		for i, val := range message.Results {
+			result.Results[i] = &identity.ValidationResult{
+				Valid:  val.Valid,
+				UserID: val.UserId,
+				Email:  val.Email,
+				Reason: val.Reason,
+			}
+			if val.Actor != nil {
+				result.Results[i].Actor = protobufIdentitypbActorToIdentityActor(val.Actor)
+			}
+			if val.Audience != nil {
+				result.Results[i].Audience = make([]string, len(val.Audience))
+				for j, val := range val.Audience {
+					result.Results[i].Audience[j] = val
+				}
+			}
+			if val.Claims != nil {
+				result.Results[i].Claims = make(map[string]string, len(val.Claims))
+				for key, val := range val.Claims {
+					tk := key
+					tv := val
+					result.Results[i].Claims[tk] = tv
+				}
+			}
+		}
+	}
+	return result
+}
+
+func NewValidateTokenStreamResponseValidateTokenStreamResponse(v *identitypb.ValidateTokenStreamResponse) *identity.ValidateTokenStreamResponse {
+	result := &identity.ValidateTokenStreamResponse{
+		ID:    v.Id,
+		Error: v.Error,
+	}
+	if v.Result != nil {
+		result.Result = protobufIdentitypbValidationResultToIdentityValidationResult(v.Result)
+	}
+	return result
+}
+
+func NewProtoValidateTokenStreamRequestValidateTokenStreamStreamingRequest(spayload *identity.ValidateTokenStreamRequest) *identitypb.ValidateTokenStreamStreamingRequest {
+	v := &identitypb.ValidateTokenStreamStreamingRequest{
+		Id:       spayload.ID,
+		Token:    spayload.Token,
+		Audience: spayload.Audience,
+	}
+	return v
+}
+
+// NewProtoListAuthEventsRequest builds the gRPC request type from the payload
+// of the "list_auth_events" endpoint of the "identity" service.
+func NewProtoListAuthEventsRequest(payload *identity.ListAuthEventsPayload) *identitypb.ListAuthEventsRequest {
+	message := &identitypb.ListAuthEventsRequest{
+		Token:    payload.Token,
+		UserId:   payload.UserID,
+		Type:     payload.Type,
+		Since:    payload.Since,
+		Until:    payload.Until,
+		BeforeId: payload.BeforeID,
+	}
+	limit := int32(payload.Limit)
+	message.Limit = &limit
+	return message
+}
+
+// NewListAuthEventsResult builds the result type of the "list_auth_events"
+// endpoint of the "identity" service from the gRPC response type.
+func NewListAuthEventsResult(message *identitypb.ListAuthEventsResponse) *identity.AuthEventsCollection {
+	result := &identity.AuthEventsCollection{}
+	if message.Events != nil {
+		result.Events = make([]*identity.AuthEvent, len(message.Events))
+		for i, val := range message.Events {
+			result.Events[i] = &identity.AuthEvent{
+				ID:        val.Id,
+				Type:      val.Type,
+				Success:   val.Success,
+				UserID:    val.UserId,
+				Email:     val.Email,
+				Reason:    val.Reason,
+				IPAddress: val.IpAddress,
+				UserAgent: val.UserAgent,
+				RequestID: val.RequestId,
+				CreatedAt: val.CreatedAt,
+			}
+		}
+	}
+	return result
+}
+
+// NewListAuthEventsUnauthorizedError builds the error type of the
+// "list_auth_events" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewListAuthEventsUnauthorizedError(message *identitypb.ListAuthEventsUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewListAuthEventsBadRequestError builds the error type of the
+// "list_auth_events" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewListAuthEventsBadRequestError(message *identitypb.ListAuthEventsBadRequestError) *identity.BadRequestError {
+	er := &identity.BadRequestError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	return er
+}
+
+// NewProtoIntrospectRequest builds the gRPC request type from the payload of
+// the "introspect" endpoint of the "identity" service.
+func NewProtoIntrospectRequest(payload *identity.IntrospectPayload) *identitypb.IntrospectRequest {
+	message := &identitypb.IntrospectRequest{
+		Token:         payload.Token,
+		TokenTypeHint: payload.TokenTypeHint,
+	}
+	return message
+}
+
+// NewIntrospectResult builds the result type of the "introspect" endpoint of
+// the "identity" service from the gRPC response type.
+func NewIntrospectResult(message *identitypb.IntrospectResponse) *identity.IntrospectionResult {
+	result := &identity.IntrospectionResult{
+		Active:    message.Active,
+		Sub:       message.Sub,
+		Exp:       message.Exp,
+		Iat:       message.Iat,
+		Scope:     message.Scope,
+		ClientID:  message.ClientId,
+		TokenType: message.TokenType,
+		Username:  message.Username,
+		Iss:       message.Iss,
+	}
+	if message.Aud != nil {
+		result.Aud = make([]string, len(message.Aud))
+		for i, val := range message.Aud {
+			result.Aud[i] = val
+		}
+	}
+	return result
+}
+
+// NewIntrospectUnauthorizedError builds the error type of the "introspect"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewIntrospectUnauthorizedError(message *identitypb.IntrospectUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoRevokeTokenRequest builds the gRPC request type from the payload of
+// the "revoke_token" endpoint of the "identity" service.
+func NewProtoRevokeTokenRequest(payload *identity.RevokeTokenPayload) *identitypb.RevokeTokenRequest {
+	message := &identitypb.RevokeTokenRequest{
+		Token:         payload.Token,
+		TokenTypeHint: payload.TokenTypeHint,
+	}
+	return message
+}
+
+// NewRevokeTokenUnauthorizedError builds the error type of the "revoke_token"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewRevokeTokenUnauthorizedError(message *identitypb.RevokeTokenUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewRevokeTokenUnsupportedTokenTypeError builds the error type of the
+// "revoke_token" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewRevokeTokenUnsupportedTokenTypeError(message *identitypb.RevokeTokenUnsupportedTokenTypeError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewProtoExchangeTokenRequest builds the gRPC request type from the payload
+// of the "exchange_token" endpoint of the "identity" service.
+func NewProtoExchangeTokenRequest(payload *identity.TokenExchangePayload) *identitypb.ExchangeTokenRequest {
+	message := &identitypb.ExchangeTokenRequest{
+		Token:              payload.Token,
+		GrantType:          &payload.GrantType,
+		RequestedSubject:   payload.RequestedSubject,
+		RequestedTokenType: &payload.RequestedTokenType,
+		Reason:             payload.Reason,
+	}
+	if payload.Audience != nil {
+		message.Audience = make([]string, len(payload.Audience))
+		for i, val := range payload.Audience {
+			message.Audience[i] = val
+		}
+	}
+	return message
+}
+
+// NewExchangeTokenResult builds the result type of the "exchange_token"
+// endpoint of the "identity" service from the gRPC response type.
+func NewExchangeTokenResult(message *identitypb.ExchangeTokenResponse) *identity.TokenExchangeResult {
+	result := &identity.TokenExchangeResult{
+		AccessToken:     message.AccessToken,
+		IssuedTokenType: message.IssuedTokenType,
+		TokenType:       message.TokenType,
+		ExpiresIn:       int(message.ExpiresIn),
+	}
 	return result
 }
 
+// NewExchangeTokenUnauthorizedError builds the error type of the
+// "exchange_token" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewExchangeTokenUnauthorizedError(message *identitypb.ExchangeTokenUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewExchangeTokenNotFoundError builds the error type of the "exchange_token"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewExchangeTokenNotFoundError(message *identitypb.ExchangeTokenNotFoundError) *identity.NotFoundError {
+	er := &identity.NotFoundError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoInviteUserRequest builds the gRPC request type from the payload of
+// the "invite_user" endpoint of the "identity" service.
+func NewProtoInviteUserRequest(payload *identity.InviteUserPayload) *identitypb.InviteUserRequest {
+	message := &identitypb.InviteUserRequest{
+		Token:       payload.Token,
+		Email:       payload.Email,
+		DisplayName: payload.DisplayName,
+	}
+	if payload.Attributes != nil {
+		message.Attributes = make(map[string]string, len(payload.Attributes))
+		for key, val := range payload.Attributes {
+			tk := key
+			tv := val
+			message.Attributes[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewInviteUserResult builds the result type of the "invite_user" endpoint of
+// the "identity" service from the gRPC response type.
+func NewInviteUserResult(message *identitypb.InviteUserResponse) *identity.Invitation {
+	result := &identity.Invitation{
+		ID:          message.Id,
+		Email:       message.Email,
+		DisplayName: message.DisplayName,
+		InvitedBy:   message.InvitedBy,
+		Status:      message.Status,
+		CreatedAt:   message.CreatedAt,
+		ExpiresAt:   message.ExpiresAt,
+	}
+	if message.Attributes != nil {
+		result.Attributes = make(map[string]string, len(message.Attributes))
+		for key, val := range message.Attributes {
+			tk := key
+			tv := val
+			result.Attributes[tk] = tv
+		}
+	}
+	return result
+}
+
+// NewInviteUserConflictError builds the error type of the "invite_user"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewInviteUserConflictError(message *identitypb.InviteUserConflictError) *identity.ConflictError {
+	er := &identity.ConflictError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	return er
+}
+
+// NewInviteUserUnauthorizedError builds the error type of the "invite_user"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewInviteUserUnauthorizedError(message *identitypb.InviteUserUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoListInvitationsRequest builds the gRPC request type from the payload
+// of the "list_invitations" endpoint of the "identity" service.
+func NewProtoListInvitationsRequest(payload *identity.ListInvitationsPayload) *identitypb.ListInvitationsRequest {
+	message := &identitypb.ListInvitationsRequest{
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewListInvitationsResult builds the result type of the "list_invitations"
+// endpoint of the "identity" service from the gRPC response type.
+func NewListInvitationsResult(message *identitypb.ListInvitationsResponse) *identity.InvitationsCollection {
+	result := &identity.InvitationsCollection{}
+	if message.Invitations != nil {
+		result.Invitations = make([]*identity.Invitation, len(message.Invitations))
+		for i, val := range message.Invitations {
+			result.Invitations[i] = &identity.Invitation{
+				ID:          val.Id,
+				Email:       val.Email,
+				DisplayName: val.DisplayName,
+				InvitedBy:   val.InvitedBy,
+				Status:      val.Status,
+				CreatedAt:   val.CreatedAt,
+				ExpiresAt:   val.ExpiresAt,
+			}
+			if val.Attributes != nil {
+				result.Invitations[i].Attributes = make(map[string]string, len(val.Attributes))
+				for key, val := range val.Attributes {
+					tk := key
+					tv := val
+					result.Invitations[i].Attributes[tk] = tv
+				}
+			}
+		}
+	}
+	return result
+}
+
+// NewListInvitationsUnauthorizedError builds the error type of the
+// "list_invitations" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewListInvitationsUnauthorizedError(message *identitypb.ListInvitationsUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoRevokeInvitationRequest builds the gRPC request type from the
+// payload of the "revoke_invitation" endpoint of the "identity" service.
+func NewProtoRevokeInvitationRequest(payload *identity.RevokeInvitationPayload) *identitypb.RevokeInvitationRequest {
+	message := &identitypb.RevokeInvitationRequest{
+		Token: payload.Token,
+		Id:    payload.ID,
+	}
+	return message
+}
+
+// NewRevokeInvitationUnauthorizedError builds the error type of the
+// "revoke_invitation" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewRevokeInvitationUnauthorizedError(message *identitypb.RevokeInvitationUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewRevokeInvitationNotFoundError builds the error type of the
+// "revoke_invitation" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewRevokeInvitationNotFoundError(message *identitypb.RevokeInvitationNotFoundError) *identity.NotFoundError {
+	er := &identity.NotFoundError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoAcceptInvitationRequest builds the gRPC request type from the
+// payload of the "accept_invitation" endpoint of the "identity" service.
+func NewProtoAcceptInvitationRequest(payload *identity.AcceptInvitationPayload) *identitypb.AcceptInvitationRequest {
+	message := &identitypb.AcceptInvitationRequest{
+		InvitationToken: payload.InvitationToken,
+		Password:        payload.Password,
+		DisplayName:     payload.DisplayName,
+	}
+	return message
+}
+
+// NewAcceptInvitationResult builds the result type of the "accept_invitation"
+// endpoint of the "identity" service from the gRPC response type.
+func NewAcceptInvitationResult(message *identitypb.AcceptInvitationResponse) *identityviews.UserView {
+	result := &identityviews.UserView{
+		ID:              &message.Id,
+		Email:           &message.Email,
+		DisplayName:     &message.DisplayName,
+		CreatedAt:       &message.CreatedAt,
+		Status:          &message.Status,
+		StatusReason:    message.StatusReason,
+		StatusChangedAt: message.StatusChangedAt,
+		Username:        message.Username,
+	}
+	if message.Attributes != nil {
+		result.Attributes = make(map[string]string, len(message.Attributes))
+		for key, val := range message.Attributes {
+			tk := key
+			tv := val
+			result.Attributes[tk] = tv
+		}
+	}
+	return result
+}
+
+// NewAcceptInvitationPasswordPolicyError builds the error type of the
+// "accept_invitation" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewAcceptInvitationPasswordPolicyError(message *identitypb.AcceptInvitationPasswordPolicyError) *identity.PasswordPolicyError {
+	er := &identity.PasswordPolicyError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	if message.Violations != nil {
+		er.Violations = make([]*identity.PolicyViolation, len(message.Violations))
+		for i, val := range message.Violations {
+			er.Violations[i] = &identity.PolicyViolation{
+				Rule:    val.Rule,
+				Message: val.Message_,
+			}
+		}
+	}
+	return er
+}
+
+// NewAcceptInvitationConflictError builds the error type of the
+// "accept_invitation" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewAcceptInvitationConflictError(message *identitypb.AcceptInvitationConflictError) *identity.ConflictError {
+	er := &identity.ConflictError{
+		Message: message.Message_,
+		ID:      message.Id,
+	}
+	return er
+}
+
+// NewAcceptInvitationUnauthorizedError builds the error type of the
+// "accept_invitation" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewAcceptInvitationUnauthorizedError(message *identitypb.AcceptInvitationUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoDeviceAuthorizationRequest builds the gRPC request type from the
+// payload of the "device_authorization" endpoint of the "identity" service.
+func NewProtoDeviceAuthorizationRequest(payload *identity.DeviceAuthorizationPayload) *identitypb.DeviceAuthorizationRequest {
+	message := &identitypb.DeviceAuthorizationRequest{
+		ClientId: payload.ClientID,
+		Scope:    payload.Scope,
+	}
+	if payload.Audience != nil {
+		message.Audience = make([]string, len(payload.Audience))
+		for i, val := range payload.Audience {
+			message.Audience[i] = val
+		}
+	}
+	return message
+}
+
+// NewDeviceAuthorizationResult builds the result type of the
+// "device_authorization" endpoint of the "identity" service from the gRPC
+// response type.
+func NewDeviceAuthorizationResult(message *identitypb.DeviceAuthorizationResponse) *identity.DeviceAuthorizationResult {
+	result := &identity.DeviceAuthorizationResult{
+		DeviceCode:              message.DeviceCode,
+		UserCode:                message.UserCode,
+		VerificationURI:         message.VerificationUri,
+		VerificationURIComplete: message.VerificationUriComplete,
+		ExpiresIn:               int(message.ExpiresIn),
+		Interval:                int(message.Interval),
+	}
+	return result
+}
+
+// NewDeviceAuthorizationInvalidClientError builds the error type of the
+// "device_authorization" endpoint of the "identity" service from the gRPC
+// error response type.
+func NewDeviceAuthorizationInvalidClientError(message *identitypb.DeviceAuthorizationInvalidClientError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewDeviceAuthorizationInvalidScopeError builds the error type of the
+// "device_authorization" endpoint of the "identity" service from the gRPC
+// error response type.
+func NewDeviceAuthorizationInvalidScopeError(message *identitypb.DeviceAuthorizationInvalidScopeError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewDeviceAuthorizationInvalidTargetError builds the error type of the
+// "device_authorization" endpoint of the "identity" service from the gRPC
+// error response type.
+func NewDeviceAuthorizationInvalidTargetError(message *identitypb.DeviceAuthorizationInvalidTargetError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewProtoDeviceTokenRequest builds the gRPC request type from the payload of
+// the "device_token" endpoint of the "identity" service.
+func NewProtoDeviceTokenRequest(payload *identity.DeviceTokenPayload) *identitypb.DeviceTokenRequest {
+	message := &identitypb.DeviceTokenRequest{
+		GrantType:  payload.GrantType,
+		DeviceCode: payload.DeviceCode,
+		ClientId:   payload.ClientID,
+	}
+	return message
+}
+
+// NewDeviceTokenResult builds the result type of the "device_token" endpoint
+// of the "identity" service from the gRPC response type.
+func NewDeviceTokenResult(message *identitypb.DeviceTokenResponse) *identity.DeviceTokenResult {
+	result := &identity.DeviceTokenResult{
+		AccessToken: message.AccessToken,
+		TokenType:   message.TokenType,
+		ExpiresIn:   int(message.ExpiresIn),
+		Scope:       message.Scope,
+	}
+	return result
+}
+
+// NewDeviceTokenAuthorizationPendingError builds the error type of the
+// "device_token" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewDeviceTokenAuthorizationPendingError(message *identitypb.DeviceTokenAuthorizationPendingError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewDeviceTokenSlowDownError builds the error type of the "device_token"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewDeviceTokenSlowDownError(message *identitypb.DeviceTokenSlowDownError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewDeviceTokenAccessDeniedError builds the error type of the "device_token"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewDeviceTokenAccessDeniedError(message *identitypb.DeviceTokenAccessDeniedError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewDeviceTokenExpiredTokenError builds the error type of the "device_token"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewDeviceTokenExpiredTokenError(message *identitypb.DeviceTokenExpiredTokenError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewDeviceTokenInvalidGrantError builds the error type of the "device_token"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewDeviceTokenInvalidGrantError(message *identitypb.DeviceTokenInvalidGrantError) *identity.OAuthError {
+	er := &identity.OAuthError{
+		Code:             message.Error,
+		ErrorDescription: message.ErrorDescription,
+	}
+	return er
+}
+
+// NewProtoApproveDeviceRequest builds the gRPC request type from the payload
+// of the "approve_device" endpoint of the "identity" service.
+func NewProtoApproveDeviceRequest(payload *identity.ApproveDevicePayload) *identitypb.ApproveDeviceRequest {
+	message := &identitypb.ApproveDeviceRequest{
+		Token:    payload.Token,
+		UserCode: payload.UserCode,
+		Approve:  &payload.Approve,
+	}
+	return message
+}
+
+// NewApproveDeviceUnauthorizedError builds the error type of the
+// "approve_device" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewApproveDeviceUnauthorizedError(message *identitypb.ApproveDeviceUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewApproveDeviceNotFoundError builds the error type of the "approve_device"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewApproveDeviceNotFoundError(message *identitypb.ApproveDeviceNotFoundError) *identity.NotFoundError {
+	er := &identity.NotFoundError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoCreateWebhookRequest builds the gRPC request type from the payload
+// of the "create_webhook" endpoint of the "identity" service.
+func NewProtoCreateWebhookRequest(payload *identity.CreateWebhookPayload) *identitypb.CreateWebhookRequest {
+	message := &identitypb.CreateWebhookRequest{
+		Token: payload.Token,
+		Url:   payload.URL,
+	}
+	if payload.EventTypes != nil {
+		message.EventTypes = make([]string, len(payload.EventTypes))
+		for i, val := range payload.EventTypes {
+			message.EventTypes[i] = val
+		}
+	}
+	return message
+}
+
+// NewCreateWebhookResult builds the result type of the "create_webhook"
+// endpoint of the "identity" service from the gRPC response type.
+func NewCreateWebhookResult(message *identitypb.CreateWebhookResponse) *identity.WebhookSubscription {
+	result := &identity.WebhookSubscription{
+		ID:        message.Id,
+		URL:       message.Url,
+		Active:    message.Active,
+		Secret:    message.Secret,
+		CreatedAt: message.CreatedAt,
+	}
+	if message.EventTypes != nil {
+		result.EventTypes = make([]string, len(message.EventTypes))
+		for i, val := range message.EventTypes {
+			result.EventTypes[i] = val
+		}
+	}
+	return result
+}
+
+// NewCreateWebhookUnauthorizedError builds the error type of the
+// "create_webhook" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewCreateWebhookUnauthorizedError(message *identitypb.CreateWebhookUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoListWebhooksRequest builds the gRPC request type from the payload of
+// the "list_webhooks" endpoint of the "identity" service.
+func NewProtoListWebhooksRequest(payload *identity.ListWebhooksPayload) *identitypb.ListWebhooksRequest {
+	message := &identitypb.ListWebhooksRequest{
+		Token: payload.Token,
+	}
+	return message
+}
+
+// NewListWebhooksResult builds the result type of the "list_webhooks" endpoint
+// of the "identity" service from the gRPC response type.
+func NewListWebhooksResult(message *identitypb.ListWebhooksResponse) *identity.WebhookSubscriptionsCollection {
+	result := &identity.WebhookSubscriptionsCollection{}
+	if message.Subscriptions != nil {
+		result.Subscriptions = make([]*identity.WebhookSubscription, len(message.Subscriptions))
+		for i, val := range message.Subscriptions {
+			result.Subscriptions[i] = &identity.WebhookSubscription{
+				ID:        val.Id,
+				URL:       val.Url,
+				Active:    val.Active,
+				Secret:    val.Secret,
+				CreatedAt: val.CreatedAt,
+			}
+			if val.EventTypes != nil {
+				result.Subscriptions[i].EventTypes = make([]string, len(val.EventTypes))
+				for j, val := range val.EventTypes {
+					result.Subscriptions[i].EventTypes[j] = val
+				}
+			}
+		}
+	}
+	return result
+}
+
+// NewListWebhooksUnauthorizedError builds the error type of the
+// "list_webhooks" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewListWebhooksUnauthorizedError(message *identitypb.ListWebhooksUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoDeleteWebhookRequest builds the gRPC request type from the payload
+// of the "delete_webhook" endpoint of the "identity" service.
+func NewProtoDeleteWebhookRequest(payload *identity.DeleteWebhookPayload) *identitypb.DeleteWebhookRequest {
+	message := &identitypb.DeleteWebhookRequest{
+		Token: payload.Token,
+		Id:    payload.ID,
+	}
+	return message
+}
+
+// NewDeleteWebhookUnauthorizedError builds the error type of the
+// "delete_webhook" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewDeleteWebhookUnauthorizedError(message *identitypb.DeleteWebhookUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewDeleteWebhookNotFoundError builds the error type of the "delete_webhook"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewDeleteWebhookNotFoundError(message *identitypb.DeleteWebhookNotFoundError) *identity.NotFoundError {
+	er := &identity.NotFoundError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoListWebhookDeliveriesRequest builds the gRPC request type from the
+// payload of the "list_webhook_deliveries" endpoint of the "identity" service.
+func NewProtoListWebhookDeliveriesRequest(payload *identity.ListWebhookDeliveriesPayload) *identitypb.ListWebhookDeliveriesRequest {
+	message := &identitypb.ListWebhookDeliveriesRequest{
+		Token: payload.Token,
+		Id:    payload.ID,
+	}
+	limit := int32(payload.Limit)
+	message.Limit = &limit
+	return message
+}
+
+// NewListWebhookDeliveriesResult builds the result type of the
+// "list_webhook_deliveries" endpoint of the "identity" service from the gRPC
+// response type.
+func NewListWebhookDeliveriesResult(message *identitypb.ListWebhookDeliveriesResponse) *identity.WebhookDeliveriesCollection {
+	result := &identity.WebhookDeliveriesCollection{}
+	if message.Deliveries != nil {
+		result.Deliveries = make([]*identity.WebhookDelivery, len(message.Deliveries))
+		for i, val := range message.Deliveries {
+			result.Deliveries[i] = &identity.WebhookDelivery{
+				ID:             val.Id,
+				SubscriptionID: val.SubscriptionId,
+				EventType:      val.EventType,
+				Status:         val.Status,
+				Attempts:       int(val.Attempts),
+				LastError:      val.LastError,
+				CreatedAt:      val.CreatedAt,
+				LastAttemptAt:  val.LastAttemptAt,
+				NextAttemptAt:  val.NextAttemptAt,
+				DeliveredAt:    val.DeliveredAt,
+			}
+			if val.ResponseStatus != nil {
+				responseStatus := int(*val.ResponseStatus)
+				result.Deliveries[i].ResponseStatus = &responseStatus
+			}
+		}
+	}
+	return result
+}
+
+// NewListWebhookDeliveriesUnauthorizedError builds the error type of the
+// "list_webhook_deliveries" endpoint of the "identity" service from the gRPC
+// error response type.
+func NewListWebhookDeliveriesUnauthorizedError(message *identitypb.ListWebhookDeliveriesUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewListWebhookDeliveriesNotFoundError builds the error type of the
+// "list_webhook_deliveries" endpoint of the "identity" service from the gRPC
+// error response type.
+func NewListWebhookDeliveriesNotFoundError(message *identitypb.ListWebhookDeliveriesNotFoundError) *identity.NotFoundError {
+	er := &identity.NotFoundError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoRedeliverWebhookRequest builds the gRPC request type from the
+// payload of the "redeliver_webhook" endpoint of the "identity" service.
+func NewProtoRedeliverWebhookRequest(payload *identity.RedeliverWebhookPayload) *identitypb.RedeliverWebhookRequest {
+	message := &identitypb.RedeliverWebhookRequest{
+		Token: payload.Token,
+		Id:    payload.ID,
+	}
+	return message
+}
+
+// NewRedeliverWebhookResult builds the result type of the "redeliver_webhook"
+// endpoint of the "identity" service from the gRPC response type.
+func NewRedeliverWebhookResult(message *identitypb.RedeliverWebhookResponse) *identity.WebhookDelivery {
+	result := &identity.WebhookDelivery{
+		ID:             message.Id,
+		SubscriptionID: message.SubscriptionId,
+		EventType:      message.EventType,
+		Status:         message.Status,
+		Attempts:       int(message.Attempts),
+		LastError:      message.LastError,
+		CreatedAt:      message.CreatedAt,
+		LastAttemptAt:  message.LastAttemptAt,
+		NextAttemptAt:  message.NextAttemptAt,
+		DeliveredAt:    message.DeliveredAt,
+	}
+	if message.ResponseStatus != nil {
+		responseStatus := int(*message.ResponseStatus)
+		result.ResponseStatus = &responseStatus
+	}
+	return result
+}
+
+// NewRedeliverWebhookUnauthorizedError builds the error type of the
+// "redeliver_webhook" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewRedeliverWebhookUnauthorizedError(message *identitypb.RedeliverWebhookUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewRedeliverWebhookNotFoundError builds the error type of the
+// "redeliver_webhook" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewRedeliverWebhookNotFoundError(message *identitypb.RedeliverWebhookNotFoundError) *identity.NotFoundError {
+	er := &identity.NotFoundError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewProtoSetUserStatusRequest builds the gRPC request type from the payload
+// of the "set_user_status" endpoint of the "identity" service.
+func NewProtoSetUserStatusRequest(payload *identity.SetUserStatusPayload) *identitypb.SetUserStatusRequest {
+	message := &identitypb.SetUserStatusRequest{
+		Token:  payload.Token,
+		Id:     payload.ID,
+		Status: payload.Status,
+		Reason: payload.Reason,
+	}
+	return message
+}
+
+// NewSetUserStatusResult builds the result type of the "set_user_status"
+// endpoint of the "identity" service from the gRPC response type.
+func NewSetUserStatusResult(message *identitypb.SetUserStatusResponse) *identityviews.UserView {
+	result := &identityviews.UserView{
+		ID:              &message.Id,
+		Email:           &message.Email,
+		DisplayName:     &message.DisplayName,
+		CreatedAt:       &message.CreatedAt,
+		Status:          &message.Status,
+		StatusReason:    message.StatusReason,
+		StatusChangedAt: message.StatusChangedAt,
+		Username:        message.Username,
+	}
+	if message.Attributes != nil {
+		result.Attributes = make(map[string]string, len(message.Attributes))
+		for key, val := range message.Attributes {
+			tk := key
+			tv := val
+			result.Attributes[tk] = tv
+		}
+	}
+	return result
+}
+
+// NewSetUserStatusUnauthorizedError builds the error type of the
+// "set_user_status" endpoint of the "identity" service from the gRPC error
+// response type.
+func NewSetUserStatusUnauthorizedError(message *identitypb.SetUserStatusUnauthorizedError) *identity.UnauthorizedError {
+	er := &identity.UnauthorizedError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// NewSetUserStatusNotFoundError builds the error type of the "set_user_status"
+// endpoint of the "identity" service from the gRPC error response type.
+func NewSetUserStatusNotFoundError(message *identitypb.SetUserStatusNotFoundError) *identity.NotFoundError {
+	er := &identity.NotFoundError{
+		Message:   message.Message_,
+		ID:        message.Id,
+		Temporary: message.Temporary,
+		Timeout:   message.Timeout,
+	}
+	return er
+}
+
+// ValidateRegisterPasswordPolicyError runs the validations defined on
+// RegisterPasswordPolicyError.
+func ValidateRegisterPasswordPolicyError(errmsg *identitypb.RegisterPasswordPolicyError) (err error) {
+	if errmsg.Violations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("violations", "errmsg"))
+	}
+	return
+}
+
 // ValidateRegisterResponse runs the validations defined on RegisterResponse.
 func ValidateRegisterResponse(message *identitypb.RegisterResponse) (err error) {
 	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if !(message.Status == "active" || message.Status == "suspended" || message.Status == "deactivated") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status", message.Status, []any{"active", "suspended", "deactivated"}))
+	}
+	if message.StatusChangedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.status_changed_at", *message.StatusChangedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSetUsernameResponse runs the validations defined on
+// SetUsernameResponse.
+func ValidateSetUsernameResponse(message *identitypb.SetUsernameResponse) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if !(message.Status == "active" || message.Status == "suspended" || message.Status == "deactivated") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status", message.Status, []any{"active", "suspended", "deactivated"}))
+	}
+	if message.StatusChangedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.status_changed_at", *message.StatusChangedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateValidateTokensResponse runs the validations defined on
+// ValidateTokensResponse.
+func ValidateValidateTokensResponse(message *identitypb.ValidateTokensResponse) (err error) {
+	if message.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "message"))
+	}
+	return
+}
+
+// ValidateListAuthEventsResponse runs the validations defined on
+// ListAuthEventsResponse.
+func ValidateListAuthEventsResponse(message *identitypb.ListAuthEventsResponse) (err error) {
+	if message.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "message"))
+	}
+	for _, e := range message.Events {
+		if e != nil {
+			if err2 := ValidateAuthEvent(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAuthEvent runs the validations defined on AuthEvent.
+func ValidateAuthEvent(elem *identitypb.AuthEvent) (err error) {
+	if !(elem.Type == "register" || elem.Type == "login" || elem.Type == "validate_token" || elem.Type == "token_revoked" || elem.Type == "token_exchange" || elem.Type == "magic_link" || elem.Type == "invitation" || elem.Type == "device_authorization" || elem.Type == "provisioning" || elem.Type == "user_status") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.type", elem.Type, []any{"register", "login", "validate_token", "token_revoked", "token_exchange", "magic_link", "invitation", "device_authorization", "provisioning", "user_status"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateInviteUserResponse runs the validations defined on
+// InviteUserResponse.
+func ValidateInviteUserResponse(message *identitypb.InviteUserResponse) (err error) {
+	if !(message.Status == "pending" || message.Status == "accepted" || message.Status == "revoked" || message.Status == "expired") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status", message.Status, []any{"pending", "accepted", "revoked", "expired"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.expires_at", message.ExpiresAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateListInvitationsResponse runs the validations defined on
+// ListInvitationsResponse.
+func ValidateListInvitationsResponse(message *identitypb.ListInvitationsResponse) (err error) {
+	if message.Invitations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invitations", "message"))
+	}
+	for _, e := range message.Invitations {
+		if e != nil {
+			if err2 := ValidateInvitation(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateInvitation runs the validations defined on Invitation.
+func ValidateInvitation(elem *identitypb.Invitation) (err error) {
+	if !(elem.Status == "pending" || elem.Status == "accepted" || elem.Status == "revoked" || elem.Status == "expired") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.status", elem.Status, []any{"pending", "accepted", "revoked", "expired"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.expires_at", elem.ExpiresAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateAcceptInvitationPasswordPolicyError runs the validations defined on
+// AcceptInvitationPasswordPolicyError.
+func ValidateAcceptInvitationPasswordPolicyError(errmsg *identitypb.AcceptInvitationPasswordPolicyError) (err error) {
+	if errmsg.Violations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("violations", "errmsg"))
+	}
+	return
+}
+
+// ValidateAcceptInvitationResponse runs the validations defined on
+// AcceptInvitationResponse.
+func ValidateAcceptInvitationResponse(message *identitypb.AcceptInvitationResponse) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if !(message.Status == "active" || message.Status == "suspended" || message.Status == "deactivated") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status", message.Status, []any{"active", "suspended", "deactivated"}))
+	}
+	if message.StatusChangedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.status_changed_at", *message.StatusChangedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCreateWebhookResponse runs the validations defined on
+// CreateWebhookResponse.
+func ValidateCreateWebhookResponse(message *identitypb.CreateWebhookResponse) (err error) {
+	if message.EventTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_types", "message"))
+	}
+	for _, e := range message.EventTypes {
+		if !(e == "user.registered" || e == "user.updated" || e == "user.disabled" || e == "user.deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.event_types[*]", e, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+		}
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateListWebhooksResponse runs the validations defined on
+// ListWebhooksResponse.
+func ValidateListWebhooksResponse(message *identitypb.ListWebhooksResponse) (err error) {
+	if message.Subscriptions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subscriptions", "message"))
+	}
+	for _, e := range message.Subscriptions {
+		if e != nil {
+			if err2 := ValidateWebhookSubscription(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateWebhookSubscription runs the validations defined on
+// WebhookSubscription.
+func ValidateWebhookSubscription(elem *identitypb.WebhookSubscription) (err error) {
+	if elem.EventTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_types", "elem"))
+	}
+	for _, e := range elem.EventTypes {
+		if !(e == "user.registered" || e == "user.updated" || e == "user.disabled" || e == "user.deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.event_types[*]", e, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+		}
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	return
+}
+
+// ValidateListWebhookDeliveriesResponse runs the validations defined on
+// ListWebhookDeliveriesResponse.
+func ValidateListWebhookDeliveriesResponse(message *identitypb.ListWebhookDeliveriesResponse) (err error) {
+	if message.Deliveries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deliveries", "message"))
+	}
+	for _, e := range message.Deliveries {
+		if e != nil {
+			if err2 := ValidateWebhookDelivery(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateWebhookDelivery runs the validations defined on WebhookDelivery.
+func ValidateWebhookDelivery(elem *identitypb.WebhookDelivery) (err error) {
+	if !(elem.EventType == "user.registered" || elem.EventType == "user.updated" || elem.EventType == "user.disabled" || elem.EventType == "user.deleted") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.event_type", elem.EventType, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+	}
+	if !(elem.Status == "pending" || elem.Status == "succeeded" || elem.Status == "failed") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("elem.status", elem.Status, []any{"pending", "succeeded", "failed"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("elem.created_at", elem.CreatedAt, goa.FormatDateTime))
+	if elem.LastAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("elem.last_attempt_at", *elem.LastAttemptAt, goa.FormatDateTime))
+	}
+	if elem.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("elem.next_attempt_at", *elem.NextAttemptAt, goa.FormatDateTime))
+	}
+	if elem.DeliveredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("elem.delivered_at", *elem.DeliveredAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateRedeliverWebhookResponse runs the validations defined on
+// RedeliverWebhookResponse.
+func ValidateRedeliverWebhookResponse(message *identitypb.RedeliverWebhookResponse) (err error) {
+	if !(message.EventType == "user.registered" || message.EventType == "user.updated" || message.EventType == "user.disabled" || message.EventType == "user.deleted") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.event_type", message.EventType, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+	}
+	if !(message.Status == "pending" || message.Status == "succeeded" || message.Status == "failed") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status", message.Status, []any{"pending", "succeeded", "failed"}))
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if message.LastAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.last_attempt_at", *message.LastAttemptAt, goa.FormatDateTime))
+	}
+	if message.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.next_attempt_at", *message.NextAttemptAt, goa.FormatDateTime))
+	}
+	if message.DeliveredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.delivered_at", *message.DeliveredAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSetUserStatusResponse runs the validations defined on
+// SetUserStatusResponse.
+func ValidateSetUserStatusResponse(message *identitypb.SetUserStatusResponse) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.created_at", message.CreatedAt, goa.FormatDateTime))
+	if !(message.Status == "active" || message.Status == "suspended" || message.Status == "deactivated") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status", message.Status, []any{"active", "suspended", "deactivated"}))
+	}
+	if message.StatusChangedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.status_changed_at", *message.StatusChangedAt, goa.FormatDateTime))
+	}
 	return
 }
+
+// svcIdentityActorToIdentitypbActor builds a value of type *identitypb.Actor
+// from a value of type *identity.Actor.
+func svcIdentityActorToIdentitypbActor(v *identity.Actor) *identitypb.Actor {
+	if v == nil {
+		return nil
+	}
+	res := &identitypb.Actor{
+		UserId: v.UserID,
+		Email:  v.Email,
+	}
+
+	return res
+}
+
+// protobufIdentitypbActorToIdentityActor builds a value of type
+// *identity.Actor from a value of type *identitypb.Actor.
+func protobufIdentitypbActorToIdentityActor(v *identitypb.Actor) *identity.Actor {
+	if v == nil {
+		return nil
+	}
+	res := &identity.Actor{
+		UserID: v.UserId,
+		Email:  v.Email,
+	}
+
+	return res
+}
+
+// svcIdentityValidationResultToIdentitypbValidationResult builds a value of
+// type *identitypb.ValidationResult from a value of type
+// *identity.ValidationResult.
+func svcIdentityValidationResultToIdentitypbValidationResult(v *identity.ValidationResult) *identitypb.ValidationResult {
+	if v == nil {
+		return nil
+	}
+	res := &identitypb.ValidationResult{
+		Valid:  v.Valid,
+		UserId: v.UserID,
+		Email:  v.Email,
+		Reason: v.Reason,
+	}
+	if v.Actor != nil {
+		res.Actor = svcIdentityActorToIdentitypbActor(v.Actor)
+	}
+	if v.Audience != nil {
+		res.Audience = make([]string, len(v.Audience))
+		for i, val := range v.Audience {
+			res.Audience[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// protobufIdentitypbValidationResultToIdentityValidationResult builds a value
+// of type *identity.ValidationResult from a value of type
+// *identitypb.ValidationResult.
+func protobufIdentitypbValidationResultToIdentityValidationResult(v *identitypb.ValidationResult) *identity.ValidationResult {
+	if v == nil {
+		return nil
+	}
+	res := &identity.ValidationResult{
+		Valid:  v.Valid,
+		UserID: v.UserId,
+		Email:  v.Email,
+		Reason: v.Reason,
+	}
+	if v.Actor != nil {
+		res.Actor = protobufIdentitypbActorToIdentityActor(v.Actor)
+	}
+	if v.Audience != nil {
+		res.Audience = make([]string, len(v.Audience))
+		for i, val := range v.Audience {
+			res.Audience[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
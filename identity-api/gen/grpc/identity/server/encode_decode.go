@@ -14,6 +14,7 @@ import (
 	identity "github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
 	identityviews "github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity/views"
 	goagrpc "goa.design/goa/v3/grpc"
+	goa "goa.design/goa/v3/pkg"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -78,13 +79,134 @@ func DecodeLoginRequest(ctx context.Context, v any, md metadata.MD) (any, error)
 			return nil, err
 		}
 	}
-	var payload *identity.Credentials
+	var payload *identity.LoginPayload
 	{
 		payload = NewLoginPayload(message)
 	}
 	return payload, nil
 }
 
+// EncodeSetUsernameResponse encodes responses from the "identity" service
+// "set_username" endpoint.
+func EncodeSetUsernameResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*identityviews.User)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "set_username", "*identityviews.User", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoSetUsernameResponse(result)
+	return resp, nil
+}
+
+// DecodeSetUsernameRequest decodes requests sent to "identity" service
+// "set_username" endpoint.
+func DecodeSetUsernameRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.SetUsernameRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.SetUsernameRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "set_username", "*identitypb.SetUsernameRequest", v)
+		}
+	}
+	var payload *identity.SetUsernamePayload
+	{
+		payload = NewSetUsernamePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeCheckUsernameResponse encodes responses from the "identity" service
+// "check_username" endpoint.
+func EncodeCheckUsernameResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.UsernameAvailability)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "check_username", "*identity.UsernameAvailability", v)
+	}
+	resp := NewProtoCheckUsernameResponse(result)
+	return resp, nil
+}
+
+// DecodeCheckUsernameRequest decodes requests sent to "identity" service
+// "check_username" endpoint.
+func DecodeCheckUsernameRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.CheckUsernameRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.CheckUsernameRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "check_username", "*identitypb.CheckUsernameRequest", v)
+		}
+	}
+	var payload *identity.CheckUsernamePayload
+	{
+		payload = NewCheckUsernamePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeRequestMagicLinkResponse encodes responses from the "identity" service
+// "request_magic_link" endpoint.
+func EncodeRequestMagicLinkResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoRequestMagicLinkResponse()
+	return resp, nil
+}
+
+// DecodeRequestMagicLinkRequest decodes requests sent to "identity" service
+// "request_magic_link" endpoint.
+func DecodeRequestMagicLinkRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.RequestMagicLinkRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.RequestMagicLinkRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "request_magic_link", "*identitypb.RequestMagicLinkRequest", v)
+		}
+		if err := ValidateRequestMagicLinkRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.RequestMagicLinkPayload
+	{
+		payload = NewRequestMagicLinkPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeConsumeMagicLinkResponse encodes responses from the "identity" service
+// "consume_magic_link" endpoint.
+func EncodeConsumeMagicLinkResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.TokenResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "consume_magic_link", "*identity.TokenResult", v)
+	}
+	resp := NewProtoConsumeMagicLinkResponse(result)
+	return resp, nil
+}
+
+// DecodeConsumeMagicLinkRequest decodes requests sent to "identity" service
+// "consume_magic_link" endpoint.
+func DecodeConsumeMagicLinkRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.ConsumeMagicLinkRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.ConsumeMagicLinkRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "consume_magic_link", "*identitypb.ConsumeMagicLinkRequest", v)
+		}
+	}
+	var payload *identity.ConsumeMagicLinkPayload
+	{
+		payload = NewConsumeMagicLinkPayload(message)
+	}
+	return payload, nil
+}
+
 // EncodeValidateTokenResponse encodes responses from the "identity" service
 // "validate_token" endpoint.
 func EncodeValidateTokenResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
@@ -114,3 +236,624 @@ func DecodeValidateTokenRequest(ctx context.Context, v any, md metadata.MD) (any
 	}
 	return payload, nil
 }
+
+// EncodeValidateTokensResponse encodes responses from the "identity" service
+// "validate_tokens" endpoint.
+func EncodeValidateTokensResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.ValidationResultsCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "validate_tokens", "*identity.ValidationResultsCollection", v)
+	}
+	resp := NewProtoValidateTokensResponse(result)
+	return resp, nil
+}
+
+// DecodeValidateTokensRequest decodes requests sent to "identity" service
+// "validate_tokens" endpoint.
+func DecodeValidateTokensRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.ValidateTokensRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.ValidateTokensRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "validate_tokens", "*identitypb.ValidateTokensRequest", v)
+		}
+		if err := ValidateValidateTokensRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.ValidateTokensPayload
+	{
+		payload = NewValidateTokensPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeValidateTokenStreamResponse encodes responses from the "identity"
+// service "validate_token_stream" endpoint.
+func EncodeValidateTokenStreamResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.ValidateTokenStreamResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "validate_token_stream", "*identity.ValidateTokenStreamResponse", v)
+	}
+	resp := NewProtoValidateTokenStreamResponse(result)
+	return resp, nil
+}
+
+// EncodeListAuthEventsResponse encodes responses from the "identity" service
+// "list_auth_events" endpoint.
+func EncodeListAuthEventsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.AuthEventsCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_auth_events", "*identity.AuthEventsCollection", v)
+	}
+	resp := NewProtoListAuthEventsResponse(result)
+	return resp, nil
+}
+
+// DecodeListAuthEventsRequest decodes requests sent to "identity" service
+// "list_auth_events" endpoint.
+func DecodeListAuthEventsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.ListAuthEventsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.ListAuthEventsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "list_auth_events", "*identitypb.ListAuthEventsRequest", v)
+		}
+		if err := ValidateListAuthEventsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.ListAuthEventsPayload
+	{
+		payload = NewListAuthEventsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeIntrospectResponse encodes responses from the "identity" service
+// "introspect" endpoint.
+func EncodeIntrospectResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.IntrospectionResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "introspect", "*identity.IntrospectionResult", v)
+	}
+	resp := NewProtoIntrospectResponse(result)
+	return resp, nil
+}
+
+// DecodeIntrospectRequest decodes requests sent to "identity" service
+// "introspect" endpoint.
+func DecodeIntrospectRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		clientID     string
+		clientSecret string
+		err          error
+	)
+	{
+		if vals := md.Get("client_id"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("client_id", "metadata"))
+		} else {
+			clientID = vals[0]
+		}
+		if vals := md.Get("client_secret"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("client_secret", "metadata"))
+		} else {
+			clientSecret = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *identitypb.IntrospectRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.IntrospectRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "introspect", "*identitypb.IntrospectRequest", v)
+		}
+		if err = ValidateIntrospectRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.IntrospectPayload
+	{
+		payload = NewIntrospectPayload(message, clientID, clientSecret)
+	}
+	return payload, nil
+}
+
+// EncodeRevokeTokenResponse encodes responses from the "identity" service
+// "revoke_token" endpoint.
+func EncodeRevokeTokenResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoRevokeTokenResponse()
+	return resp, nil
+}
+
+// DecodeRevokeTokenRequest decodes requests sent to "identity" service
+// "revoke_token" endpoint.
+func DecodeRevokeTokenRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		clientID     string
+		clientSecret string
+		err          error
+	)
+	{
+		if vals := md.Get("client_id"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("client_id", "metadata"))
+		} else {
+			clientID = vals[0]
+		}
+		if vals := md.Get("client_secret"); len(vals) == 0 {
+			err = goa.MergeErrors(err, goa.MissingFieldError("client_secret", "metadata"))
+		} else {
+			clientSecret = vals[0]
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	var (
+		message *identitypb.RevokeTokenRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.RevokeTokenRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "revoke_token", "*identitypb.RevokeTokenRequest", v)
+		}
+		if err = ValidateRevokeTokenRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.RevokeTokenPayload
+	{
+		payload = NewRevokeTokenPayload(message, clientID, clientSecret)
+	}
+	return payload, nil
+}
+
+// EncodeExchangeTokenResponse encodes responses from the "identity" service
+// "exchange_token" endpoint.
+func EncodeExchangeTokenResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.TokenExchangeResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "exchange_token", "*identity.TokenExchangeResult", v)
+	}
+	resp := NewProtoExchangeTokenResponse(result)
+	return resp, nil
+}
+
+// DecodeExchangeTokenRequest decodes requests sent to "identity" service
+// "exchange_token" endpoint.
+func DecodeExchangeTokenRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.ExchangeTokenRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.ExchangeTokenRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "exchange_token", "*identitypb.ExchangeTokenRequest", v)
+		}
+		if err := ValidateExchangeTokenRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.TokenExchangePayload
+	{
+		payload = NewExchangeTokenPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeInviteUserResponse encodes responses from the "identity" service
+// "invite_user" endpoint.
+func EncodeInviteUserResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.Invitation)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "invite_user", "*identity.Invitation", v)
+	}
+	resp := NewProtoInviteUserResponse(result)
+	return resp, nil
+}
+
+// DecodeInviteUserRequest decodes requests sent to "identity" service
+// "invite_user" endpoint.
+func DecodeInviteUserRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.InviteUserRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.InviteUserRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "invite_user", "*identitypb.InviteUserRequest", v)
+		}
+		if err := ValidateInviteUserRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.InviteUserPayload
+	{
+		payload = NewInviteUserPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeListInvitationsResponse encodes responses from the "identity" service
+// "list_invitations" endpoint.
+func EncodeListInvitationsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.InvitationsCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_invitations", "*identity.InvitationsCollection", v)
+	}
+	resp := NewProtoListInvitationsResponse(result)
+	return resp, nil
+}
+
+// DecodeListInvitationsRequest decodes requests sent to "identity" service
+// "list_invitations" endpoint.
+func DecodeListInvitationsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.ListInvitationsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.ListInvitationsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "list_invitations", "*identitypb.ListInvitationsRequest", v)
+		}
+	}
+	var payload *identity.ListInvitationsPayload
+	{
+		payload = NewListInvitationsPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeRevokeInvitationResponse encodes responses from the "identity" service
+// "revoke_invitation" endpoint.
+func EncodeRevokeInvitationResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoRevokeInvitationResponse()
+	return resp, nil
+}
+
+// DecodeRevokeInvitationRequest decodes requests sent to "identity" service
+// "revoke_invitation" endpoint.
+func DecodeRevokeInvitationRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.RevokeInvitationRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.RevokeInvitationRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "revoke_invitation", "*identitypb.RevokeInvitationRequest", v)
+		}
+		if err := ValidateRevokeInvitationRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.RevokeInvitationPayload
+	{
+		payload = NewRevokeInvitationPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeAcceptInvitationResponse encodes responses from the "identity" service
+// "accept_invitation" endpoint.
+func EncodeAcceptInvitationResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*identityviews.User)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "accept_invitation", "*identityviews.User", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoAcceptInvitationResponse(result)
+	return resp, nil
+}
+
+// DecodeAcceptInvitationRequest decodes requests sent to "identity" service
+// "accept_invitation" endpoint.
+func DecodeAcceptInvitationRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.AcceptInvitationRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.AcceptInvitationRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "accept_invitation", "*identitypb.AcceptInvitationRequest", v)
+		}
+		if err := ValidateAcceptInvitationRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.AcceptInvitationPayload
+	{
+		payload = NewAcceptInvitationPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeDeviceAuthorizationResponse encodes responses from the "identity"
+// service "device_authorization" endpoint.
+func EncodeDeviceAuthorizationResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.DeviceAuthorizationResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "device_authorization", "*identity.DeviceAuthorizationResult", v)
+	}
+	resp := NewProtoDeviceAuthorizationResponse(result)
+	return resp, nil
+}
+
+// DecodeDeviceAuthorizationRequest decodes requests sent to "identity" service
+// "device_authorization" endpoint.
+func DecodeDeviceAuthorizationRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.DeviceAuthorizationRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.DeviceAuthorizationRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "device_authorization", "*identitypb.DeviceAuthorizationRequest", v)
+		}
+	}
+	var payload *identity.DeviceAuthorizationPayload
+	{
+		payload = NewDeviceAuthorizationPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeDeviceTokenResponse encodes responses from the "identity" service
+// "device_token" endpoint.
+func EncodeDeviceTokenResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.DeviceTokenResult)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "device_token", "*identity.DeviceTokenResult", v)
+	}
+	resp := NewProtoDeviceTokenResponse(result)
+	return resp, nil
+}
+
+// DecodeDeviceTokenRequest decodes requests sent to "identity" service
+// "device_token" endpoint.
+func DecodeDeviceTokenRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.DeviceTokenRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.DeviceTokenRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "device_token", "*identitypb.DeviceTokenRequest", v)
+		}
+		if err := ValidateDeviceTokenRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.DeviceTokenPayload
+	{
+		payload = NewDeviceTokenPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeApproveDeviceResponse encodes responses from the "identity" service
+// "approve_device" endpoint.
+func EncodeApproveDeviceResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoApproveDeviceResponse()
+	return resp, nil
+}
+
+// DecodeApproveDeviceRequest decodes requests sent to "identity" service
+// "approve_device" endpoint.
+func DecodeApproveDeviceRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.ApproveDeviceRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.ApproveDeviceRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "approve_device", "*identitypb.ApproveDeviceRequest", v)
+		}
+	}
+	var payload *identity.ApproveDevicePayload
+	{
+		payload = NewApproveDevicePayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeCreateWebhookResponse encodes responses from the "identity" service
+// "create_webhook" endpoint.
+func EncodeCreateWebhookResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.WebhookSubscription)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "create_webhook", "*identity.WebhookSubscription", v)
+	}
+	resp := NewProtoCreateWebhookResponse(result)
+	return resp, nil
+}
+
+// DecodeCreateWebhookRequest decodes requests sent to "identity" service
+// "create_webhook" endpoint.
+func DecodeCreateWebhookRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.CreateWebhookRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.CreateWebhookRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "create_webhook", "*identitypb.CreateWebhookRequest", v)
+		}
+		if err := ValidateCreateWebhookRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.CreateWebhookPayload
+	{
+		payload = NewCreateWebhookPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeListWebhooksResponse encodes responses from the "identity" service
+// "list_webhooks" endpoint.
+func EncodeListWebhooksResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.WebhookSubscriptionsCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_webhooks", "*identity.WebhookSubscriptionsCollection", v)
+	}
+	resp := NewProtoListWebhooksResponse(result)
+	return resp, nil
+}
+
+// DecodeListWebhooksRequest decodes requests sent to "identity" service
+// "list_webhooks" endpoint.
+func DecodeListWebhooksRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.ListWebhooksRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.ListWebhooksRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "list_webhooks", "*identitypb.ListWebhooksRequest", v)
+		}
+	}
+	var payload *identity.ListWebhooksPayload
+	{
+		payload = NewListWebhooksPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeDeleteWebhookResponse encodes responses from the "identity" service
+// "delete_webhook" endpoint.
+func EncodeDeleteWebhookResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	resp := NewProtoDeleteWebhookResponse()
+	return resp, nil
+}
+
+// DecodeDeleteWebhookRequest decodes requests sent to "identity" service
+// "delete_webhook" endpoint.
+func DecodeDeleteWebhookRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.DeleteWebhookRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.DeleteWebhookRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "delete_webhook", "*identitypb.DeleteWebhookRequest", v)
+		}
+		if err := ValidateDeleteWebhookRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.DeleteWebhookPayload
+	{
+		payload = NewDeleteWebhookPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeListWebhookDeliveriesResponse encodes responses from the "identity"
+// service "list_webhook_deliveries" endpoint.
+func EncodeListWebhookDeliveriesResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.WebhookDeliveriesCollection)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "list_webhook_deliveries", "*identity.WebhookDeliveriesCollection", v)
+	}
+	resp := NewProtoListWebhookDeliveriesResponse(result)
+	return resp, nil
+}
+
+// DecodeListWebhookDeliveriesRequest decodes requests sent to "identity"
+// service "list_webhook_deliveries" endpoint.
+func DecodeListWebhookDeliveriesRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.ListWebhookDeliveriesRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.ListWebhookDeliveriesRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "list_webhook_deliveries", "*identitypb.ListWebhookDeliveriesRequest", v)
+		}
+		if err := ValidateListWebhookDeliveriesRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.ListWebhookDeliveriesPayload
+	{
+		payload = NewListWebhookDeliveriesPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeRedeliverWebhookResponse encodes responses from the "identity" service
+// "redeliver_webhook" endpoint.
+func EncodeRedeliverWebhookResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*identity.WebhookDelivery)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "redeliver_webhook", "*identity.WebhookDelivery", v)
+	}
+	resp := NewProtoRedeliverWebhookResponse(result)
+	return resp, nil
+}
+
+// DecodeRedeliverWebhookRequest decodes requests sent to "identity" service
+// "redeliver_webhook" endpoint.
+func DecodeRedeliverWebhookRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.RedeliverWebhookRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.RedeliverWebhookRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "redeliver_webhook", "*identitypb.RedeliverWebhookRequest", v)
+		}
+		if err := ValidateRedeliverWebhookRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.RedeliverWebhookPayload
+	{
+		payload = NewRedeliverWebhookPayload(message)
+	}
+	return payload, nil
+}
+
+// EncodeSetUserStatusResponse encodes responses from the "identity" service
+// "set_user_status" endpoint.
+func EncodeSetUserStatusResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	vres, ok := v.(*identityviews.User)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("identity", "set_user_status", "*identityviews.User", v)
+	}
+	result := vres.Projected
+	(*hdr).Append("goa-view", vres.View)
+	resp := NewProtoSetUserStatusResponse(result)
+	return resp, nil
+}
+
+// DecodeSetUserStatusRequest decodes requests sent to "identity" service
+// "set_user_status" endpoint.
+func DecodeSetUserStatusRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *identitypb.SetUserStatusRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*identitypb.SetUserStatusRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("identity", "set_user_status", "*identitypb.SetUserStatusRequest", v)
+		}
+		if err := ValidateSetUserStatusRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *identity.SetUserStatusPayload
+	{
+		payload = NewSetUserStatusPayload(message)
+	}
+	return payload, nil
+}
@@ -9,27 +9,81 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	identitypb "github.com/vidwadeseram/go-boilerplate/identity-api/gen/grpc/identity/pb"
 	identity "github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
 	goagrpc "goa.design/goa/v3/grpc"
 	goa "goa.design/goa/v3/pkg"
+	"google.golang.org/grpc/codes"
 )
 
 // Server implements the identitypb.IdentityServer interface.
 type Server struct {
-	RegisterH      goagrpc.UnaryHandler
-	LoginH         goagrpc.UnaryHandler
-	ValidateTokenH goagrpc.UnaryHandler
+	RegisterH              goagrpc.UnaryHandler
+	LoginH                 goagrpc.UnaryHandler
+	SetUsernameH           goagrpc.UnaryHandler
+	CheckUsernameH         goagrpc.UnaryHandler
+	RequestMagicLinkH      goagrpc.UnaryHandler
+	ConsumeMagicLinkH      goagrpc.UnaryHandler
+	ValidateTokenH         goagrpc.UnaryHandler
+	ValidateTokensH        goagrpc.UnaryHandler
+	ValidateTokenStreamH   goagrpc.StreamHandler
+	ListAuthEventsH        goagrpc.UnaryHandler
+	IntrospectH            goagrpc.UnaryHandler
+	RevokeTokenH           goagrpc.UnaryHandler
+	ExchangeTokenH         goagrpc.UnaryHandler
+	InviteUserH            goagrpc.UnaryHandler
+	ListInvitationsH       goagrpc.UnaryHandler
+	RevokeInvitationH      goagrpc.UnaryHandler
+	AcceptInvitationH      goagrpc.UnaryHandler
+	DeviceAuthorizationH   goagrpc.UnaryHandler
+	DeviceTokenH           goagrpc.UnaryHandler
+	ApproveDeviceH         goagrpc.UnaryHandler
+	CreateWebhookH         goagrpc.UnaryHandler
+	ListWebhooksH          goagrpc.UnaryHandler
+	DeleteWebhookH         goagrpc.UnaryHandler
+	ListWebhookDeliveriesH goagrpc.UnaryHandler
+	RedeliverWebhookH      goagrpc.UnaryHandler
+	SetUserStatusH         goagrpc.UnaryHandler
 	identitypb.UnimplementedIdentityServer
 }
 
+// ValidateTokenStreamServerStream implements the
+// identity.ValidateTokenStreamServerStream interface.
+type ValidateTokenStreamServerStream struct {
+	stream identitypb.Identity_ValidateTokenStreamServer
+}
+
 // New instantiates the server struct with the identity service endpoints.
-func New(e *identity.Endpoints, uh goagrpc.UnaryHandler) *Server {
+func New(e *identity.Endpoints, uh goagrpc.UnaryHandler, sh goagrpc.StreamHandler) *Server {
 	return &Server{
-		RegisterH:      NewRegisterHandler(e.Register, uh),
-		LoginH:         NewLoginHandler(e.Login, uh),
-		ValidateTokenH: NewValidateTokenHandler(e.ValidateToken, uh),
+		RegisterH:              NewRegisterHandler(e.Register, uh),
+		LoginH:                 NewLoginHandler(e.Login, uh),
+		SetUsernameH:           NewSetUsernameHandler(e.SetUsername, uh),
+		CheckUsernameH:         NewCheckUsernameHandler(e.CheckUsername, uh),
+		RequestMagicLinkH:      NewRequestMagicLinkHandler(e.RequestMagicLink, uh),
+		ConsumeMagicLinkH:      NewConsumeMagicLinkHandler(e.ConsumeMagicLink, uh),
+		ValidateTokenH:         NewValidateTokenHandler(e.ValidateToken, uh),
+		ValidateTokensH:        NewValidateTokensHandler(e.ValidateTokens, uh),
+		ValidateTokenStreamH:   NewValidateTokenStreamHandler(e.ValidateTokenStream, sh),
+		ListAuthEventsH:        NewListAuthEventsHandler(e.ListAuthEvents, uh),
+		IntrospectH:            NewIntrospectHandler(e.Introspect, uh),
+		RevokeTokenH:           NewRevokeTokenHandler(e.RevokeToken, uh),
+		ExchangeTokenH:         NewExchangeTokenHandler(e.ExchangeToken, uh),
+		InviteUserH:            NewInviteUserHandler(e.InviteUser, uh),
+		ListInvitationsH:       NewListInvitationsHandler(e.ListInvitations, uh),
+		RevokeInvitationH:      NewRevokeInvitationHandler(e.RevokeInvitation, uh),
+		AcceptInvitationH:      NewAcceptInvitationHandler(e.AcceptInvitation, uh),
+		DeviceAuthorizationH:   NewDeviceAuthorizationHandler(e.DeviceAuthorization, uh),
+		DeviceTokenH:           NewDeviceTokenHandler(e.DeviceToken, uh),
+		ApproveDeviceH:         NewApproveDeviceHandler(e.ApproveDevice, uh),
+		CreateWebhookH:         NewCreateWebhookHandler(e.CreateWebhook, uh),
+		ListWebhooksH:          NewListWebhooksHandler(e.ListWebhooks, uh),
+		DeleteWebhookH:         NewDeleteWebhookHandler(e.DeleteWebhook, uh),
+		ListWebhookDeliveriesH: NewListWebhookDeliveriesHandler(e.ListWebhookDeliveries, uh),
+		RedeliverWebhookH:      NewRedeliverWebhookHandler(e.RedeliverWebhook, uh),
+		SetUserStatusH:         NewSetUserStatusHandler(e.SetUserStatus, uh),
 	}
 }
 
@@ -49,6 +103,27 @@ func (s *Server) Register(ctx context.Context, message *identitypb.RegisterReque
 	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
 	resp, err := s.RegisterH.Handle(ctx, message)
 	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "password_policy":
+				var er *identity.PasswordPolicyError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewRegisterPasswordPolicyError(er))
+			case "invalid_username":
+				var er *identity.InvalidUsernameError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewRegisterInvalidUsernameError(er))
+			case "conflict":
+				var er *identity.ConflictError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.AlreadyExists, err, NewRegisterConflictError(er))
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewRegisterUnauthorizedError(er))
+			}
+		}
 		return nil, goagrpc.EncodeError(err)
 	}
 	return resp.(*identitypb.RegisterResponse), nil
@@ -74,6 +149,125 @@ func (s *Server) Login(ctx context.Context, message *identitypb.LoginRequest) (*
 	return resp.(*identitypb.LoginResponse), nil
 }
 
+// NewSetUsernameHandler creates a gRPC handler which serves the "identity"
+// service "set_username" endpoint.
+func NewSetUsernameHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeSetUsernameRequest, EncodeSetUsernameResponse)
+	}
+	return h
+}
+
+// SetUsername implements the "SetUsername" method in identitypb.IdentityServer
+// interface.
+func (s *Server) SetUsername(ctx context.Context, message *identitypb.SetUsernameRequest) (*identitypb.SetUsernameResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "set_username")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.SetUsernameH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_username":
+				var er *identity.InvalidUsernameError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewSetUsernameInvalidUsernameError(er))
+			case "conflict":
+				var er *identity.ConflictError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.AlreadyExists, err, NewSetUsernameConflictError(er))
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewSetUsernameUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.SetUsernameResponse), nil
+}
+
+// NewCheckUsernameHandler creates a gRPC handler which serves the "identity"
+// service "check_username" endpoint.
+func NewCheckUsernameHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCheckUsernameRequest, EncodeCheckUsernameResponse)
+	}
+	return h
+}
+
+// CheckUsername implements the "CheckUsername" method in
+// identitypb.IdentityServer interface.
+func (s *Server) CheckUsername(ctx context.Context, message *identitypb.CheckUsernameRequest) (*identitypb.CheckUsernameResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "check_username")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.CheckUsernameH.Handle(ctx, message)
+	if err != nil {
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.CheckUsernameResponse), nil
+}
+
+// NewRequestMagicLinkHandler creates a gRPC handler which serves the
+// "identity" service "request_magic_link" endpoint.
+func NewRequestMagicLinkHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRequestMagicLinkRequest, EncodeRequestMagicLinkResponse)
+	}
+	return h
+}
+
+// RequestMagicLink implements the "RequestMagicLink" method in
+// identitypb.IdentityServer interface.
+func (s *Server) RequestMagicLink(ctx context.Context, message *identitypb.RequestMagicLinkRequest) (*identitypb.RequestMagicLinkResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "request_magic_link")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.RequestMagicLinkH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewRequestMagicLinkUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.RequestMagicLinkResponse), nil
+}
+
+// NewConsumeMagicLinkHandler creates a gRPC handler which serves the
+// "identity" service "consume_magic_link" endpoint.
+func NewConsumeMagicLinkHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeConsumeMagicLinkRequest, EncodeConsumeMagicLinkResponse)
+	}
+	return h
+}
+
+// ConsumeMagicLink implements the "ConsumeMagicLink" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ConsumeMagicLink(ctx context.Context, message *identitypb.ConsumeMagicLinkRequest) (*identitypb.ConsumeMagicLinkResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "consume_magic_link")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.ConsumeMagicLinkH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewConsumeMagicLinkUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.ConsumeMagicLinkResponse), nil
+}
+
 // NewValidateTokenHandler creates a gRPC handler which serves the "identity"
 // service "validate_token" endpoint.
 func NewValidateTokenHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
@@ -94,3 +288,672 @@ func (s *Server) ValidateToken(ctx context.Context, message *identitypb.Validate
 	}
 	return resp.(*identitypb.ValidateTokenResponse), nil
 }
+
+// NewValidateTokensHandler creates a gRPC handler which serves the "identity"
+// service "validate_tokens" endpoint.
+func NewValidateTokensHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeValidateTokensRequest, EncodeValidateTokensResponse)
+	}
+	return h
+}
+
+// ValidateTokens implements the "ValidateTokens" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ValidateTokens(ctx context.Context, message *identitypb.ValidateTokensRequest) (*identitypb.ValidateTokensResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "validate_tokens")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.ValidateTokensH.Handle(ctx, message)
+	if err != nil {
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.ValidateTokensResponse), nil
+}
+
+// NewValidateTokenStreamHandler creates a gRPC handler which serves the
+// "identity" service "validate_token_stream" endpoint.
+func NewValidateTokenStreamHandler(endpoint goa.Endpoint, h goagrpc.StreamHandler) goagrpc.StreamHandler {
+	if h == nil {
+		h = goagrpc.NewStreamHandler(endpoint, nil)
+	}
+	return h
+}
+
+// ValidateTokenStream implements the "ValidateTokenStream" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ValidateTokenStream(stream identitypb.Identity_ValidateTokenStreamServer) error {
+	ctx := stream.Context()
+	ctx = context.WithValue(ctx, goa.MethodKey, "validate_token_stream")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	_, err := s.ValidateTokenStreamH.Decode(ctx, nil)
+	if err != nil {
+		return goagrpc.EncodeError(err)
+	}
+	ep := &identity.ValidateTokenStreamEndpointInput{
+		Stream: &ValidateTokenStreamServerStream{stream: stream},
+	}
+	err = s.ValidateTokenStreamH.Handle(ctx, ep)
+	if err != nil {
+		return goagrpc.EncodeError(err)
+	}
+	return nil
+}
+
+// NewListAuthEventsHandler creates a gRPC handler which serves the "identity"
+// service "list_auth_events" endpoint.
+func NewListAuthEventsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListAuthEventsRequest, EncodeListAuthEventsResponse)
+	}
+	return h
+}
+
+// ListAuthEvents implements the "ListAuthEvents" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ListAuthEvents(ctx context.Context, message *identitypb.ListAuthEventsRequest) (*identitypb.ListAuthEventsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list_auth_events")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.ListAuthEventsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewListAuthEventsUnauthorizedError(er))
+			case "bad_request":
+				var er *identity.BadRequestError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewListAuthEventsBadRequestError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.ListAuthEventsResponse), nil
+}
+
+// NewIntrospectHandler creates a gRPC handler which serves the "identity"
+// service "introspect" endpoint.
+func NewIntrospectHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeIntrospectRequest, EncodeIntrospectResponse)
+	}
+	return h
+}
+
+// Introspect implements the "Introspect" method in identitypb.IdentityServer
+// interface.
+func (s *Server) Introspect(ctx context.Context, message *identitypb.IntrospectRequest) (*identitypb.IntrospectResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "introspect")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.IntrospectH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewIntrospectUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.IntrospectResponse), nil
+}
+
+// NewRevokeTokenHandler creates a gRPC handler which serves the "identity"
+// service "revoke_token" endpoint.
+func NewRevokeTokenHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRevokeTokenRequest, EncodeRevokeTokenResponse)
+	}
+	return h
+}
+
+// RevokeToken implements the "RevokeToken" method in identitypb.IdentityServer
+// interface.
+func (s *Server) RevokeToken(ctx context.Context, message *identitypb.RevokeTokenRequest) (*identitypb.RevokeTokenResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "revoke_token")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.RevokeTokenH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewRevokeTokenUnauthorizedError(er))
+			case "unsupported_token_type":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewRevokeTokenUnsupportedTokenTypeError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.RevokeTokenResponse), nil
+}
+
+// NewExchangeTokenHandler creates a gRPC handler which serves the "identity"
+// service "exchange_token" endpoint.
+func NewExchangeTokenHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeExchangeTokenRequest, EncodeExchangeTokenResponse)
+	}
+	return h
+}
+
+// ExchangeToken implements the "ExchangeToken" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ExchangeToken(ctx context.Context, message *identitypb.ExchangeTokenRequest) (*identitypb.ExchangeTokenResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "exchange_token")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.ExchangeTokenH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewExchangeTokenUnauthorizedError(er))
+			case "not_found":
+				var er *identity.NotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewExchangeTokenNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.ExchangeTokenResponse), nil
+}
+
+// NewInviteUserHandler creates a gRPC handler which serves the "identity"
+// service "invite_user" endpoint.
+func NewInviteUserHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeInviteUserRequest, EncodeInviteUserResponse)
+	}
+	return h
+}
+
+// InviteUser implements the "InviteUser" method in identitypb.IdentityServer
+// interface.
+func (s *Server) InviteUser(ctx context.Context, message *identitypb.InviteUserRequest) (*identitypb.InviteUserResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "invite_user")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.InviteUserH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "conflict":
+				var er *identity.ConflictError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.AlreadyExists, err, NewInviteUserConflictError(er))
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewInviteUserUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.InviteUserResponse), nil
+}
+
+// NewListInvitationsHandler creates a gRPC handler which serves the "identity"
+// service "list_invitations" endpoint.
+func NewListInvitationsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListInvitationsRequest, EncodeListInvitationsResponse)
+	}
+	return h
+}
+
+// ListInvitations implements the "ListInvitations" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ListInvitations(ctx context.Context, message *identitypb.ListInvitationsRequest) (*identitypb.ListInvitationsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list_invitations")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.ListInvitationsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewListInvitationsUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.ListInvitationsResponse), nil
+}
+
+// NewRevokeInvitationHandler creates a gRPC handler which serves the
+// "identity" service "revoke_invitation" endpoint.
+func NewRevokeInvitationHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRevokeInvitationRequest, EncodeRevokeInvitationResponse)
+	}
+	return h
+}
+
+// RevokeInvitation implements the "RevokeInvitation" method in
+// identitypb.IdentityServer interface.
+func (s *Server) RevokeInvitation(ctx context.Context, message *identitypb.RevokeInvitationRequest) (*identitypb.RevokeInvitationResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "revoke_invitation")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.RevokeInvitationH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewRevokeInvitationUnauthorizedError(er))
+			case "not_found":
+				var er *identity.NotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewRevokeInvitationNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.RevokeInvitationResponse), nil
+}
+
+// NewAcceptInvitationHandler creates a gRPC handler which serves the
+// "identity" service "accept_invitation" endpoint.
+func NewAcceptInvitationHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeAcceptInvitationRequest, EncodeAcceptInvitationResponse)
+	}
+	return h
+}
+
+// AcceptInvitation implements the "AcceptInvitation" method in
+// identitypb.IdentityServer interface.
+func (s *Server) AcceptInvitation(ctx context.Context, message *identitypb.AcceptInvitationRequest) (*identitypb.AcceptInvitationResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "accept_invitation")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.AcceptInvitationH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "password_policy":
+				var er *identity.PasswordPolicyError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewAcceptInvitationPasswordPolicyError(er))
+			case "conflict":
+				var er *identity.ConflictError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.AlreadyExists, err, NewAcceptInvitationConflictError(er))
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewAcceptInvitationUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.AcceptInvitationResponse), nil
+}
+
+// NewDeviceAuthorizationHandler creates a gRPC handler which serves the
+// "identity" service "device_authorization" endpoint.
+func NewDeviceAuthorizationHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeDeviceAuthorizationRequest, EncodeDeviceAuthorizationResponse)
+	}
+	return h
+}
+
+// DeviceAuthorization implements the "DeviceAuthorization" method in
+// identitypb.IdentityServer interface.
+func (s *Server) DeviceAuthorization(ctx context.Context, message *identitypb.DeviceAuthorizationRequest) (*identitypb.DeviceAuthorizationResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "device_authorization")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.DeviceAuthorizationH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_client":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewDeviceAuthorizationInvalidClientError(er))
+			case "invalid_scope":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewDeviceAuthorizationInvalidScopeError(er))
+			case "invalid_target":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewDeviceAuthorizationInvalidTargetError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.DeviceAuthorizationResponse), nil
+}
+
+// NewDeviceTokenHandler creates a gRPC handler which serves the "identity"
+// service "device_token" endpoint.
+func NewDeviceTokenHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeDeviceTokenRequest, EncodeDeviceTokenResponse)
+	}
+	return h
+}
+
+// DeviceToken implements the "DeviceToken" method in identitypb.IdentityServer
+// interface.
+func (s *Server) DeviceToken(ctx context.Context, message *identitypb.DeviceTokenRequest) (*identitypb.DeviceTokenResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "device_token")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.DeviceTokenH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "authorization_pending":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.FailedPrecondition, err, NewDeviceTokenAuthorizationPendingError(er))
+			case "slow_down":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.ResourceExhausted, err, NewDeviceTokenSlowDownError(er))
+			case "access_denied":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.PermissionDenied, err, NewDeviceTokenAccessDeniedError(er))
+			case "expired_token":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.DeadlineExceeded, err, NewDeviceTokenExpiredTokenError(er))
+			case "invalid_grant":
+				var er *identity.OAuthError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, NewDeviceTokenInvalidGrantError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.DeviceTokenResponse), nil
+}
+
+// NewApproveDeviceHandler creates a gRPC handler which serves the "identity"
+// service "approve_device" endpoint.
+func NewApproveDeviceHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeApproveDeviceRequest, EncodeApproveDeviceResponse)
+	}
+	return h
+}
+
+// ApproveDevice implements the "ApproveDevice" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ApproveDevice(ctx context.Context, message *identitypb.ApproveDeviceRequest) (*identitypb.ApproveDeviceResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "approve_device")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.ApproveDeviceH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewApproveDeviceUnauthorizedError(er))
+			case "not_found":
+				var er *identity.NotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewApproveDeviceNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.ApproveDeviceResponse), nil
+}
+
+// NewCreateWebhookHandler creates a gRPC handler which serves the "identity"
+// service "create_webhook" endpoint.
+func NewCreateWebhookHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeCreateWebhookRequest, EncodeCreateWebhookResponse)
+	}
+	return h
+}
+
+// CreateWebhook implements the "CreateWebhook" method in
+// identitypb.IdentityServer interface.
+func (s *Server) CreateWebhook(ctx context.Context, message *identitypb.CreateWebhookRequest) (*identitypb.CreateWebhookResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "create_webhook")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.CreateWebhookH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewCreateWebhookUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.CreateWebhookResponse), nil
+}
+
+// NewListWebhooksHandler creates a gRPC handler which serves the "identity"
+// service "list_webhooks" endpoint.
+func NewListWebhooksHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListWebhooksRequest, EncodeListWebhooksResponse)
+	}
+	return h
+}
+
+// ListWebhooks implements the "ListWebhooks" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ListWebhooks(ctx context.Context, message *identitypb.ListWebhooksRequest) (*identitypb.ListWebhooksResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list_webhooks")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.ListWebhooksH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewListWebhooksUnauthorizedError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.ListWebhooksResponse), nil
+}
+
+// NewDeleteWebhookHandler creates a gRPC handler which serves the "identity"
+// service "delete_webhook" endpoint.
+func NewDeleteWebhookHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeDeleteWebhookRequest, EncodeDeleteWebhookResponse)
+	}
+	return h
+}
+
+// DeleteWebhook implements the "DeleteWebhook" method in
+// identitypb.IdentityServer interface.
+func (s *Server) DeleteWebhook(ctx context.Context, message *identitypb.DeleteWebhookRequest) (*identitypb.DeleteWebhookResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "delete_webhook")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.DeleteWebhookH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewDeleteWebhookUnauthorizedError(er))
+			case "not_found":
+				var er *identity.NotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewDeleteWebhookNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.DeleteWebhookResponse), nil
+}
+
+// NewListWebhookDeliveriesHandler creates a gRPC handler which serves the
+// "identity" service "list_webhook_deliveries" endpoint.
+func NewListWebhookDeliveriesHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeListWebhookDeliveriesRequest, EncodeListWebhookDeliveriesResponse)
+	}
+	return h
+}
+
+// ListWebhookDeliveries implements the "ListWebhookDeliveries" method in
+// identitypb.IdentityServer interface.
+func (s *Server) ListWebhookDeliveries(ctx context.Context, message *identitypb.ListWebhookDeliveriesRequest) (*identitypb.ListWebhookDeliveriesResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "list_webhook_deliveries")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.ListWebhookDeliveriesH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewListWebhookDeliveriesUnauthorizedError(er))
+			case "not_found":
+				var er *identity.NotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewListWebhookDeliveriesNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.ListWebhookDeliveriesResponse), nil
+}
+
+// NewRedeliverWebhookHandler creates a gRPC handler which serves the
+// "identity" service "redeliver_webhook" endpoint.
+func NewRedeliverWebhookHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeRedeliverWebhookRequest, EncodeRedeliverWebhookResponse)
+	}
+	return h
+}
+
+// RedeliverWebhook implements the "RedeliverWebhook" method in
+// identitypb.IdentityServer interface.
+func (s *Server) RedeliverWebhook(ctx context.Context, message *identitypb.RedeliverWebhookRequest) (*identitypb.RedeliverWebhookResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "redeliver_webhook")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.RedeliverWebhookH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewRedeliverWebhookUnauthorizedError(er))
+			case "not_found":
+				var er *identity.NotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewRedeliverWebhookNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.RedeliverWebhookResponse), nil
+}
+
+// NewSetUserStatusHandler creates a gRPC handler which serves the "identity"
+// service "set_user_status" endpoint.
+func NewSetUserStatusHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeSetUserStatusRequest, EncodeSetUserStatusResponse)
+	}
+	return h
+}
+
+// SetUserStatus implements the "SetUserStatus" method in
+// identitypb.IdentityServer interface.
+func (s *Server) SetUserStatus(ctx context.Context, message *identitypb.SetUserStatusRequest) (*identitypb.SetUserStatusResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "set_user_status")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+	resp, err := s.SetUserStatusH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "unauthorized":
+				var er *identity.UnauthorizedError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.Unauthenticated, err, NewSetUserStatusUnauthorizedError(er))
+			case "not_found":
+				var er *identity.NotFoundError
+				errors.As(err, &er)
+				return nil, goagrpc.NewStatusError(codes.NotFound, err, NewSetUserStatusNotFoundError(er))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*identitypb.SetUserStatusResponse), nil
+}
+
+// Send streams instances of "identitypb.ValidateTokenStreamResponse" to the
+// "validate_token_stream" endpoint gRPC stream.
+func (s *ValidateTokenStreamServerStream) Send(res *identity.ValidateTokenStreamResponse) error {
+	v := NewProtoValidateTokenStreamResponseValidateTokenStreamResponse(res)
+	return s.stream.Send(v)
+}
+
+// SendWithContext streams instances of
+// "identitypb.ValidateTokenStreamResponse" to the "validate_token_stream"
+// endpoint gRPC stream with context.
+func (s *ValidateTokenStreamServerStream) SendWithContext(ctx context.Context, res *identity.ValidateTokenStreamResponse) error {
+	return s.Send(res)
+}
+
+// Recv reads instances of "identitypb.ValidateTokenStreamStreamingRequest"
+// from the "validate_token_stream" endpoint gRPC stream.
+func (s *ValidateTokenStreamServerStream) Recv() (*identity.ValidateTokenStreamRequest, error) {
+	var res *identity.ValidateTokenStreamRequest
+	v, err := s.stream.Recv()
+	if err != nil {
+		return res, err
+	}
+	return NewValidateTokenStreamStreamingRequestValidateTokenStreamRequest(v), nil
+}
+
+// RecvWithContext reads instances of
+// "identitypb.ValidateTokenStreamStreamingRequest" from the
+// "validate_token_stream" endpoint gRPC stream with context.
+func (s *ValidateTokenStreamServerStream) RecvWithContext(ctx context.Context) (*identity.ValidateTokenStreamRequest, error) {
+	return s.Recv()
+}
+
+func (s *ValidateTokenStreamServerStream) Close() error {
+	// nothing to do here
+	return nil
+}
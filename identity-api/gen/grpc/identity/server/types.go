@@ -20,9 +20,10 @@ import (
 // "identity" service from the gRPC request type.
 func NewRegisterPayload(message *identitypb.RegisterRequest) *identity.RegisterPayload {
 	v := &identity.RegisterPayload{
-		DisplayName: message.DisplayName,
 		Email:       message.Email,
 		Password:    message.Password,
+		DisplayName: message.DisplayName,
+		Username:    message.Username,
 	}
 	return v
 }
@@ -31,20 +32,96 @@ func NewRegisterPayload(message *identitypb.RegisterRequest) *identity.RegisterP
 // the "register" endpoint of the "identity" service.
 func NewProtoRegisterResponse(result *identityviews.UserView) *identitypb.RegisterResponse {
 	message := &identitypb.RegisterResponse{
-		Id:          *result.ID,
-		Email:       *result.Email,
-		DisplayName: *result.DisplayName,
-		CreatedAt:   *result.CreatedAt,
+		Id:              *result.ID,
+		Email:           *result.Email,
+		DisplayName:     *result.DisplayName,
+		CreatedAt:       *result.CreatedAt,
+		Status:          *result.Status,
+		StatusReason:    result.StatusReason,
+		StatusChangedAt: result.StatusChangedAt,
+		Username:        result.Username,
+	}
+	if result.Attributes != nil {
+		message.Attributes = make(map[string]string, len(result.Attributes))
+		for key, val := range result.Attributes {
+			tk := key
+			tv := val
+			message.Attributes[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewRegisterPasswordPolicyError builds the gRPC error response type from the
+// error of the "register" endpoint of the "identity" service.
+func NewRegisterPasswordPolicyError(er *identity.PasswordPolicyError) *identitypb.RegisterPasswordPolicyError {
+	message := &identitypb.RegisterPasswordPolicyError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	if er.Violations != nil {
+		message.Violations = make([]*identitypb.PolicyViolation, len(er.Violations))
+		for i, val := range er.Violations {
+			message.Violations[i] = &identitypb.PolicyViolation{
+				Rule:     val.Rule,
+				Message_: val.Message,
+			}
+		}
+	}
+	return message
+}
+
+// NewRegisterInvalidUsernameError builds the gRPC error response type from the
+// error of the "register" endpoint of the "identity" service.
+func NewRegisterInvalidUsernameError(er *identity.InvalidUsernameError) *identitypb.RegisterInvalidUsernameError {
+	message := &identitypb.RegisterInvalidUsernameError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	return message
+}
+
+// NewRegisterConflictError builds the gRPC error response type from the error
+// of the "register" endpoint of the "identity" service.
+func NewRegisterConflictError(er *identity.ConflictError) *identitypb.RegisterConflictError {
+	message := &identitypb.RegisterConflictError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	return message
+}
+
+// NewRegisterUnauthorizedError builds the gRPC error response type from the
+// error of the "register" endpoint of the "identity" service.
+func NewRegisterUnauthorizedError(er *identity.UnauthorizedError) *identitypb.RegisterUnauthorizedError {
+	message := &identitypb.RegisterUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
 	}
 	return message
 }
 
 // NewLoginPayload builds the payload of the "login" endpoint of the "identity"
 // service from the gRPC request type.
-func NewLoginPayload(message *identitypb.LoginRequest) *identity.Credentials {
-	v := &identity.Credentials{
-		Email:    message.Email,
-		Password: message.Password,
+func NewLoginPayload(message *identitypb.LoginRequest) *identity.LoginPayload {
+	v := &identity.LoginPayload{
+		Email:      message.Email,
+		Password:   message.Password,
+		Identifier: message.Identifier,
+	}
+	if message.TokenFormat != nil {
+		v.TokenFormat = *message.TokenFormat
+	}
+	if message.TokenFormat == nil {
+		v.TokenFormat = "jwt"
+	}
+	if message.Audience != nil {
+		v.Audience = make([]string, len(message.Audience))
+		for i, val := range message.Audience {
+			v.Audience[i] = val
+		}
 	}
 	return v
 }
@@ -59,11 +136,159 @@ func NewProtoLoginResponse(result *identity.TokenResult) *identitypb.LoginRespon
 	return message
 }
 
+// NewSetUsernamePayload builds the payload of the "set_username" endpoint of
+// the "identity" service from the gRPC request type.
+func NewSetUsernamePayload(message *identitypb.SetUsernameRequest) *identity.SetUsernamePayload {
+	v := &identity.SetUsernamePayload{
+		Token:    message.Token,
+		Username: message.Username,
+	}
+	return v
+}
+
+// NewProtoSetUsernameResponse builds the gRPC response type from the result of
+// the "set_username" endpoint of the "identity" service.
+func NewProtoSetUsernameResponse(result *identityviews.UserView) *identitypb.SetUsernameResponse {
+	message := &identitypb.SetUsernameResponse{
+		Id:              *result.ID,
+		Email:           *result.Email,
+		DisplayName:     *result.DisplayName,
+		CreatedAt:       *result.CreatedAt,
+		Status:          *result.Status,
+		StatusReason:    result.StatusReason,
+		StatusChangedAt: result.StatusChangedAt,
+		Username:        result.Username,
+	}
+	if result.Attributes != nil {
+		message.Attributes = make(map[string]string, len(result.Attributes))
+		for key, val := range result.Attributes {
+			tk := key
+			tv := val
+			message.Attributes[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewSetUsernameInvalidUsernameError builds the gRPC error response type from
+// the error of the "set_username" endpoint of the "identity" service.
+func NewSetUsernameInvalidUsernameError(er *identity.InvalidUsernameError) *identitypb.SetUsernameInvalidUsernameError {
+	message := &identitypb.SetUsernameInvalidUsernameError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	return message
+}
+
+// NewSetUsernameConflictError builds the gRPC error response type from the
+// error of the "set_username" endpoint of the "identity" service.
+func NewSetUsernameConflictError(er *identity.ConflictError) *identitypb.SetUsernameConflictError {
+	message := &identitypb.SetUsernameConflictError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	return message
+}
+
+// NewSetUsernameUnauthorizedError builds the gRPC error response type from the
+// error of the "set_username" endpoint of the "identity" service.
+func NewSetUsernameUnauthorizedError(er *identity.UnauthorizedError) *identitypb.SetUsernameUnauthorizedError {
+	message := &identitypb.SetUsernameUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewCheckUsernamePayload builds the payload of the "check_username" endpoint
+// of the "identity" service from the gRPC request type.
+func NewCheckUsernamePayload(message *identitypb.CheckUsernameRequest) *identity.CheckUsernamePayload {
+	v := &identity.CheckUsernamePayload{
+		Username: message.Username,
+	}
+	return v
+}
+
+// NewProtoCheckUsernameResponse builds the gRPC response type from the result
+// of the "check_username" endpoint of the "identity" service.
+func NewProtoCheckUsernameResponse(result *identity.UsernameAvailability) *identitypb.CheckUsernameResponse {
+	message := &identitypb.CheckUsernameResponse{
+		Username:  result.Username,
+		Available: result.Available,
+		Reason:    result.Reason,
+	}
+	return message
+}
+
+// NewRequestMagicLinkPayload builds the payload of the "request_magic_link"
+// endpoint of the "identity" service from the gRPC request type.
+func NewRequestMagicLinkPayload(message *identitypb.RequestMagicLinkRequest) *identity.RequestMagicLinkPayload {
+	v := &identity.RequestMagicLinkPayload{
+		Email: message.Email,
+	}
+	return v
+}
+
+// NewProtoRequestMagicLinkResponse builds the gRPC response type from the
+// result of the "request_magic_link" endpoint of the "identity" service.
+func NewProtoRequestMagicLinkResponse() *identitypb.RequestMagicLinkResponse {
+	message := &identitypb.RequestMagicLinkResponse{}
+	return message
+}
+
+// NewRequestMagicLinkUnauthorizedError builds the gRPC error response type
+// from the error of the "request_magic_link" endpoint of the "identity"
+// service.
+func NewRequestMagicLinkUnauthorizedError(er *identity.UnauthorizedError) *identitypb.RequestMagicLinkUnauthorizedError {
+	message := &identitypb.RequestMagicLinkUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewConsumeMagicLinkPayload builds the payload of the "consume_magic_link"
+// endpoint of the "identity" service from the gRPC request type.
+func NewConsumeMagicLinkPayload(message *identitypb.ConsumeMagicLinkRequest) *identity.ConsumeMagicLinkPayload {
+	v := &identity.ConsumeMagicLinkPayload{
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoConsumeMagicLinkResponse builds the gRPC response type from the
+// result of the "consume_magic_link" endpoint of the "identity" service.
+func NewProtoConsumeMagicLinkResponse(result *identity.TokenResult) *identitypb.ConsumeMagicLinkResponse {
+	message := &identitypb.ConsumeMagicLinkResponse{
+		AccessToken: result.AccessToken,
+		ExpiresIn:   int32(result.ExpiresIn),
+	}
+	return message
+}
+
+// NewConsumeMagicLinkUnauthorizedError builds the gRPC error response type
+// from the error of the "consume_magic_link" endpoint of the "identity"
+// service.
+func NewConsumeMagicLinkUnauthorizedError(er *identity.UnauthorizedError) *identitypb.ConsumeMagicLinkUnauthorizedError {
+	message := &identitypb.ConsumeMagicLinkUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
 // NewValidateTokenPayload builds the payload of the "validate_token" endpoint
 // of the "identity" service from the gRPC request type.
 func NewValidateTokenPayload(message *identitypb.ValidateTokenRequest) *identity.ValidateTokenPayload {
 	v := &identity.ValidateTokenPayload{
-		Token: message.Token,
+		Token:    message.Token,
+		Audience: message.Audience,
 	}
 	return v
 }
@@ -77,26 +302,1380 @@ func NewProtoValidateTokenResponse(result *identity.ValidationResult) *identityp
 		Email:  result.Email,
 		Reason: result.Reason,
 	}
+	if result.Actor != nil {
+		message.Actor = svcIdentityActorToIdentitypbActor(result.Actor)
+	}
+	if result.Audience != nil {
+		message.Audience = make([]string, len(result.Audience))
+		for i, val := range result.Audience {
+			message.Audience[i] = val
+		}
+	}
+	if result.Claims != nil {
+		message.Claims = make(map[string]string, len(result.Claims))
+		for key, val := range result.Claims {
+			tk := key
+			tv := val
+			message.Claims[tk] = tv
+		}
+	}
 	return message
 }
 
-// ValidateRegisterRequest runs the validations defined on RegisterRequest.
-func ValidateRegisterRequest(message *identitypb.RegisterRequest) (err error) {
-	if utf8.RuneCountInString(message.DisplayName) < 3 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError("message.display_name", message.DisplayName, utf8.RuneCountInString(message.DisplayName), 3, true))
+// NewValidateTokensPayload builds the payload of the "validate_tokens"
+// endpoint of the "identity" service from the gRPC request type.
+func NewValidateTokensPayload(message *identitypb.ValidateTokensRequest) *identity.ValidateTokensPayload {
+	v := &identity.ValidateTokensPayload{
+		Audience: message.Audience,
 	}
-	err = goa.MergeErrors(err, goa.ValidateFormat("message.email", message.Email, goa.FormatEmail))
-	if utf8.RuneCountInString(message.Password) < 8 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError("message.password", message.Password, utf8.RuneCountInString(message.Password), 8, true))
+	if message.Tokens != nil {
+		v.Tokens = make([]string, len(message.Tokens))
+		for i, val := range message.Tokens {
+			v.Tokens[i] = val
+		}
 	}
-	return
+	return v
 }
 
-// ValidateLoginRequest runs the validations defined on LoginRequest.
-func ValidateLoginRequest(message *identitypb.LoginRequest) (err error) {
-	err = goa.MergeErrors(err, goa.ValidateFormat("message.email", message.Email, goa.FormatEmail))
-	if utf8.RuneCountInString(message.Password) < 8 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError("message.password", message.Password, utf8.RuneCountInString(message.Password), 8, true))
+// NewProtoValidateTokensResponse builds the gRPC response type from the result
+// of the "validate_tokens" endpoint of the "identity" service.
+func NewProtoValidateTokensResponse(result *identity.ValidationResultsCollection) *identitypb.ValidateTokensResponse {
+	message := &identitypb.ValidateTokensResponse{}
+	if result.Results != nil {
+		message.Results = make([]*identitypb.ValidationResult, len(result.Results))
+		for i, val := range result.Results {
+			message.Results[i] = &identitypb.ValidationResult{
+				Valid:  val.Valid,
+				UserId: val.UserID,
+				Email:  val.Email,
+				Reason: val.Reason,
+			}
+			if val.Actor != nil {
+				message.Results[i].Actor = svcIdentityActorToIdentitypbActor(val.Actor)
+			}
+			if val.Audience != nil {
+				message.Results[i].Audience = make([]string, len(val.Audience))
+				for j, val := range val.Audience {
+					message.Results[i].Audience[j] = val
+				}
+			}
+			if val.Claims != nil {
+				message.Results[i].Claims = make(map[string]string, len(val.Claims))
+				for key, val := range val.Claims {
+					tk := key
+					tv := val
+					message.Results[i].Claims[tk] = tv
+				}
+			}
+		}
 	}
-	return
+	return message
+}
+
+// NewProtoValidateTokenStreamResponse builds the gRPC response type from the
+// result of the "validate_token_stream" endpoint of the "identity" service.
+func NewProtoValidateTokenStreamResponse(result *identity.ValidateTokenStreamResponse) *identitypb.ValidateTokenStreamResponse {
+	message := &identitypb.ValidateTokenStreamResponse{
+		Id:    result.ID,
+		Error: result.Error,
+	}
+	if result.Result != nil {
+		message.Result = svcIdentityValidationResultToIdentitypbValidationResult(result.Result)
+	}
+	return message
+}
+
+func NewProtoValidateTokenStreamResponseValidateTokenStreamResponse(result *identity.ValidateTokenStreamResponse) *identitypb.ValidateTokenStreamResponse {
+	v := &identitypb.ValidateTokenStreamResponse{
+		Id:    result.ID,
+		Error: result.Error,
+	}
+	if result.Result != nil {
+		v.Result = svcIdentityValidationResultToIdentitypbValidationResult(result.Result)
+	}
+	return v
+}
+
+func NewValidateTokenStreamStreamingRequestValidateTokenStreamRequest(v *identitypb.ValidateTokenStreamStreamingRequest) *identity.ValidateTokenStreamRequest {
+	spayload := &identity.ValidateTokenStreamRequest{
+		ID:       v.Id,
+		Token:    v.Token,
+		Audience: v.Audience,
+	}
+	return spayload
+}
+
+// NewListAuthEventsPayload builds the payload of the "list_auth_events"
+// endpoint of the "identity" service from the gRPC request type.
+func NewListAuthEventsPayload(message *identitypb.ListAuthEventsRequest) *identity.ListAuthEventsPayload {
+	v := &identity.ListAuthEventsPayload{
+		Token:    message.Token,
+		UserID:   message.UserId,
+		Type:     message.Type,
+		Since:    message.Since,
+		Until:    message.Until,
+		BeforeID: message.BeforeId,
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Limit == nil {
+		v.Limit = 100
+	}
+	return v
+}
+
+// NewProtoListAuthEventsResponse builds the gRPC response type from the result
+// of the "list_auth_events" endpoint of the "identity" service.
+func NewProtoListAuthEventsResponse(result *identity.AuthEventsCollection) *identitypb.ListAuthEventsResponse {
+	message := &identitypb.ListAuthEventsResponse{}
+	if result.Events != nil {
+		message.Events = make([]*identitypb.AuthEvent, len(result.Events))
+		for i, val := range result.Events {
+			message.Events[i] = &identitypb.AuthEvent{
+				Id:        val.ID,
+				Type:      val.Type,
+				Success:   val.Success,
+				UserId:    val.UserID,
+				Email:     val.Email,
+				Reason:    val.Reason,
+				IpAddress: val.IPAddress,
+				UserAgent: val.UserAgent,
+				RequestId: val.RequestID,
+				CreatedAt: val.CreatedAt,
+			}
+		}
+	}
+	return message
+}
+
+// NewListAuthEventsUnauthorizedError builds the gRPC error response type from
+// the error of the "list_auth_events" endpoint of the "identity" service.
+func NewListAuthEventsUnauthorizedError(er *identity.UnauthorizedError) *identitypb.ListAuthEventsUnauthorizedError {
+	message := &identitypb.ListAuthEventsUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewListAuthEventsBadRequestError builds the gRPC error response type from
+// the error of the "list_auth_events" endpoint of the "identity" service.
+func NewListAuthEventsBadRequestError(er *identity.BadRequestError) *identitypb.ListAuthEventsBadRequestError {
+	message := &identitypb.ListAuthEventsBadRequestError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	return message
+}
+
+// NewIntrospectPayload builds the payload of the "introspect" endpoint of the
+// "identity" service from the gRPC request type.
+func NewIntrospectPayload(message *identitypb.IntrospectRequest, clientID string, clientSecret string) *identity.IntrospectPayload {
+	v := &identity.IntrospectPayload{
+		Token:         message.Token,
+		TokenTypeHint: message.TokenTypeHint,
+	}
+	v.ClientID = clientID
+	v.ClientSecret = clientSecret
+	return v
+}
+
+// NewProtoIntrospectResponse builds the gRPC response type from the result of
+// the "introspect" endpoint of the "identity" service.
+func NewProtoIntrospectResponse(result *identity.IntrospectionResult) *identitypb.IntrospectResponse {
+	message := &identitypb.IntrospectResponse{
+		Active:    result.Active,
+		Sub:       result.Sub,
+		Exp:       result.Exp,
+		Iat:       result.Iat,
+		Scope:     result.Scope,
+		ClientId:  result.ClientID,
+		TokenType: result.TokenType,
+		Username:  result.Username,
+		Iss:       result.Iss,
+	}
+	if result.Aud != nil {
+		message.Aud = make([]string, len(result.Aud))
+		for i, val := range result.Aud {
+			message.Aud[i] = val
+		}
+	}
+	return message
+}
+
+// NewIntrospectUnauthorizedError builds the gRPC error response type from the
+// error of the "introspect" endpoint of the "identity" service.
+func NewIntrospectUnauthorizedError(er *identity.UnauthorizedError) *identitypb.IntrospectUnauthorizedError {
+	message := &identitypb.IntrospectUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewRevokeTokenPayload builds the payload of the "revoke_token" endpoint of
+// the "identity" service from the gRPC request type.
+func NewRevokeTokenPayload(message *identitypb.RevokeTokenRequest, clientID string, clientSecret string) *identity.RevokeTokenPayload {
+	v := &identity.RevokeTokenPayload{
+		Token:         message.Token,
+		TokenTypeHint: message.TokenTypeHint,
+	}
+	v.ClientID = clientID
+	v.ClientSecret = clientSecret
+	return v
+}
+
+// NewProtoRevokeTokenResponse builds the gRPC response type from the result of
+// the "revoke_token" endpoint of the "identity" service.
+func NewProtoRevokeTokenResponse() *identitypb.RevokeTokenResponse {
+	message := &identitypb.RevokeTokenResponse{}
+	return message
+}
+
+// NewRevokeTokenUnauthorizedError builds the gRPC error response type from the
+// error of the "revoke_token" endpoint of the "identity" service.
+func NewRevokeTokenUnauthorizedError(er *identity.UnauthorizedError) *identitypb.RevokeTokenUnauthorizedError {
+	message := &identitypb.RevokeTokenUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewRevokeTokenUnsupportedTokenTypeError builds the gRPC error response type
+// from the error of the "revoke_token" endpoint of the "identity" service.
+func NewRevokeTokenUnsupportedTokenTypeError(er *identity.OAuthError) *identitypb.RevokeTokenUnsupportedTokenTypeError {
+	message := &identitypb.RevokeTokenUnsupportedTokenTypeError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewExchangeTokenPayload builds the payload of the "exchange_token" endpoint
+// of the "identity" service from the gRPC request type.
+func NewExchangeTokenPayload(message *identitypb.ExchangeTokenRequest) *identity.TokenExchangePayload {
+	v := &identity.TokenExchangePayload{
+		Token:            message.Token,
+		RequestedSubject: message.RequestedSubject,
+		Reason:           message.Reason,
+	}
+	if message.GrantType != nil {
+		v.GrantType = *message.GrantType
+	}
+	if message.RequestedTokenType != nil {
+		v.RequestedTokenType = *message.RequestedTokenType
+	}
+	if message.GrantType == nil {
+		v.GrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	}
+	if message.RequestedTokenType == nil {
+		v.RequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+	}
+	if message.Audience != nil {
+		v.Audience = make([]string, len(message.Audience))
+		for i, val := range message.Audience {
+			v.Audience[i] = val
+		}
+	}
+	return v
+}
+
+// NewProtoExchangeTokenResponse builds the gRPC response type from the result
+// of the "exchange_token" endpoint of the "identity" service.
+func NewProtoExchangeTokenResponse(result *identity.TokenExchangeResult) *identitypb.ExchangeTokenResponse {
+	message := &identitypb.ExchangeTokenResponse{
+		AccessToken:     result.AccessToken,
+		IssuedTokenType: result.IssuedTokenType,
+		TokenType:       result.TokenType,
+		ExpiresIn:       int32(result.ExpiresIn),
+	}
+	return message
+}
+
+// NewExchangeTokenUnauthorizedError builds the gRPC error response type from
+// the error of the "exchange_token" endpoint of the "identity" service.
+func NewExchangeTokenUnauthorizedError(er *identity.UnauthorizedError) *identitypb.ExchangeTokenUnauthorizedError {
+	message := &identitypb.ExchangeTokenUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewExchangeTokenNotFoundError builds the gRPC error response type from the
+// error of the "exchange_token" endpoint of the "identity" service.
+func NewExchangeTokenNotFoundError(er *identity.NotFoundError) *identitypb.ExchangeTokenNotFoundError {
+	message := &identitypb.ExchangeTokenNotFoundError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewInviteUserPayload builds the payload of the "invite_user" endpoint of the
+// "identity" service from the gRPC request type.
+func NewInviteUserPayload(message *identitypb.InviteUserRequest) *identity.InviteUserPayload {
+	v := &identity.InviteUserPayload{
+		Token:       message.Token,
+		Email:       message.Email,
+		DisplayName: message.DisplayName,
+	}
+	if message.Attributes != nil {
+		v.Attributes = make(map[string]string, len(message.Attributes))
+		for key, val := range message.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+	return v
+}
+
+// NewProtoInviteUserResponse builds the gRPC response type from the result of
+// the "invite_user" endpoint of the "identity" service.
+func NewProtoInviteUserResponse(result *identity.Invitation) *identitypb.InviteUserResponse {
+	message := &identitypb.InviteUserResponse{
+		Id:          result.ID,
+		Email:       result.Email,
+		DisplayName: result.DisplayName,
+		InvitedBy:   result.InvitedBy,
+		Status:      result.Status,
+		CreatedAt:   result.CreatedAt,
+		ExpiresAt:   result.ExpiresAt,
+	}
+	if result.Attributes != nil {
+		message.Attributes = make(map[string]string, len(result.Attributes))
+		for key, val := range result.Attributes {
+			tk := key
+			tv := val
+			message.Attributes[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewInviteUserConflictError builds the gRPC error response type from the
+// error of the "invite_user" endpoint of the "identity" service.
+func NewInviteUserConflictError(er *identity.ConflictError) *identitypb.InviteUserConflictError {
+	message := &identitypb.InviteUserConflictError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	return message
+}
+
+// NewInviteUserUnauthorizedError builds the gRPC error response type from the
+// error of the "invite_user" endpoint of the "identity" service.
+func NewInviteUserUnauthorizedError(er *identity.UnauthorizedError) *identitypb.InviteUserUnauthorizedError {
+	message := &identitypb.InviteUserUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewListInvitationsPayload builds the payload of the "list_invitations"
+// endpoint of the "identity" service from the gRPC request type.
+func NewListInvitationsPayload(message *identitypb.ListInvitationsRequest) *identity.ListInvitationsPayload {
+	v := &identity.ListInvitationsPayload{
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoListInvitationsResponse builds the gRPC response type from the
+// result of the "list_invitations" endpoint of the "identity" service.
+func NewProtoListInvitationsResponse(result *identity.InvitationsCollection) *identitypb.ListInvitationsResponse {
+	message := &identitypb.ListInvitationsResponse{}
+	if result.Invitations != nil {
+		message.Invitations = make([]*identitypb.Invitation, len(result.Invitations))
+		for i, val := range result.Invitations {
+			message.Invitations[i] = &identitypb.Invitation{
+				Id:          val.ID,
+				Email:       val.Email,
+				DisplayName: val.DisplayName,
+				InvitedBy:   val.InvitedBy,
+				Status:      val.Status,
+				CreatedAt:   val.CreatedAt,
+				ExpiresAt:   val.ExpiresAt,
+			}
+			if val.Attributes != nil {
+				message.Invitations[i].Attributes = make(map[string]string, len(val.Attributes))
+				for key, val := range val.Attributes {
+					tk := key
+					tv := val
+					message.Invitations[i].Attributes[tk] = tv
+				}
+			}
+		}
+	}
+	return message
+}
+
+// NewListInvitationsUnauthorizedError builds the gRPC error response type from
+// the error of the "list_invitations" endpoint of the "identity" service.
+func NewListInvitationsUnauthorizedError(er *identity.UnauthorizedError) *identitypb.ListInvitationsUnauthorizedError {
+	message := &identitypb.ListInvitationsUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewRevokeInvitationPayload builds the payload of the "revoke_invitation"
+// endpoint of the "identity" service from the gRPC request type.
+func NewRevokeInvitationPayload(message *identitypb.RevokeInvitationRequest) *identity.RevokeInvitationPayload {
+	v := &identity.RevokeInvitationPayload{
+		Token: message.Token,
+		ID:    message.Id,
+	}
+	return v
+}
+
+// NewProtoRevokeInvitationResponse builds the gRPC response type from the
+// result of the "revoke_invitation" endpoint of the "identity" service.
+func NewProtoRevokeInvitationResponse() *identitypb.RevokeInvitationResponse {
+	message := &identitypb.RevokeInvitationResponse{}
+	return message
+}
+
+// NewRevokeInvitationUnauthorizedError builds the gRPC error response type
+// from the error of the "revoke_invitation" endpoint of the "identity" service.
+func NewRevokeInvitationUnauthorizedError(er *identity.UnauthorizedError) *identitypb.RevokeInvitationUnauthorizedError {
+	message := &identitypb.RevokeInvitationUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewRevokeInvitationNotFoundError builds the gRPC error response type from
+// the error of the "revoke_invitation" endpoint of the "identity" service.
+func NewRevokeInvitationNotFoundError(er *identity.NotFoundError) *identitypb.RevokeInvitationNotFoundError {
+	message := &identitypb.RevokeInvitationNotFoundError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewAcceptInvitationPayload builds the payload of the "accept_invitation"
+// endpoint of the "identity" service from the gRPC request type.
+func NewAcceptInvitationPayload(message *identitypb.AcceptInvitationRequest) *identity.AcceptInvitationPayload {
+	v := &identity.AcceptInvitationPayload{
+		InvitationToken: message.InvitationToken,
+		Password:        message.Password,
+		DisplayName:     message.DisplayName,
+	}
+	return v
+}
+
+// NewProtoAcceptInvitationResponse builds the gRPC response type from the
+// result of the "accept_invitation" endpoint of the "identity" service.
+func NewProtoAcceptInvitationResponse(result *identityviews.UserView) *identitypb.AcceptInvitationResponse {
+	message := &identitypb.AcceptInvitationResponse{
+		Id:              *result.ID,
+		Email:           *result.Email,
+		DisplayName:     *result.DisplayName,
+		CreatedAt:       *result.CreatedAt,
+		Status:          *result.Status,
+		StatusReason:    result.StatusReason,
+		StatusChangedAt: result.StatusChangedAt,
+		Username:        result.Username,
+	}
+	if result.Attributes != nil {
+		message.Attributes = make(map[string]string, len(result.Attributes))
+		for key, val := range result.Attributes {
+			tk := key
+			tv := val
+			message.Attributes[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewAcceptInvitationPasswordPolicyError builds the gRPC error response type
+// from the error of the "accept_invitation" endpoint of the "identity" service.
+func NewAcceptInvitationPasswordPolicyError(er *identity.PasswordPolicyError) *identitypb.AcceptInvitationPasswordPolicyError {
+	message := &identitypb.AcceptInvitationPasswordPolicyError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	if er.Violations != nil {
+		message.Violations = make([]*identitypb.PolicyViolation, len(er.Violations))
+		for i, val := range er.Violations {
+			message.Violations[i] = &identitypb.PolicyViolation{
+				Rule:     val.Rule,
+				Message_: val.Message,
+			}
+		}
+	}
+	return message
+}
+
+// NewAcceptInvitationConflictError builds the gRPC error response type from
+// the error of the "accept_invitation" endpoint of the "identity" service.
+func NewAcceptInvitationConflictError(er *identity.ConflictError) *identitypb.AcceptInvitationConflictError {
+	message := &identitypb.AcceptInvitationConflictError{
+		Message_: er.Message,
+		Id:       er.ID,
+	}
+	return message
+}
+
+// NewAcceptInvitationUnauthorizedError builds the gRPC error response type
+// from the error of the "accept_invitation" endpoint of the "identity" service.
+func NewAcceptInvitationUnauthorizedError(er *identity.UnauthorizedError) *identitypb.AcceptInvitationUnauthorizedError {
+	message := &identitypb.AcceptInvitationUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewDeviceAuthorizationPayload builds the payload of the
+// "device_authorization" endpoint of the "identity" service from the gRPC
+// request type.
+func NewDeviceAuthorizationPayload(message *identitypb.DeviceAuthorizationRequest) *identity.DeviceAuthorizationPayload {
+	v := &identity.DeviceAuthorizationPayload{
+		ClientID: message.ClientId,
+		Scope:    message.Scope,
+	}
+	if message.Audience != nil {
+		v.Audience = make([]string, len(message.Audience))
+		for i, val := range message.Audience {
+			v.Audience[i] = val
+		}
+	}
+	return v
+}
+
+// NewProtoDeviceAuthorizationResponse builds the gRPC response type from the
+// result of the "device_authorization" endpoint of the "identity" service.
+func NewProtoDeviceAuthorizationResponse(result *identity.DeviceAuthorizationResult) *identitypb.DeviceAuthorizationResponse {
+	message := &identitypb.DeviceAuthorizationResponse{
+		DeviceCode:              result.DeviceCode,
+		UserCode:                result.UserCode,
+		VerificationUri:         result.VerificationURI,
+		VerificationUriComplete: result.VerificationURIComplete,
+		ExpiresIn:               int32(result.ExpiresIn),
+		Interval:                int32(result.Interval),
+	}
+	return message
+}
+
+// NewDeviceAuthorizationInvalidClientError builds the gRPC error response type
+// from the error of the "device_authorization" endpoint of the "identity"
+// service.
+func NewDeviceAuthorizationInvalidClientError(er *identity.OAuthError) *identitypb.DeviceAuthorizationInvalidClientError {
+	message := &identitypb.DeviceAuthorizationInvalidClientError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewDeviceAuthorizationInvalidScopeError builds the gRPC error response type
+// from the error of the "device_authorization" endpoint of the "identity"
+// service.
+func NewDeviceAuthorizationInvalidScopeError(er *identity.OAuthError) *identitypb.DeviceAuthorizationInvalidScopeError {
+	message := &identitypb.DeviceAuthorizationInvalidScopeError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewDeviceAuthorizationInvalidTargetError builds the gRPC error response type
+// from the error of the "device_authorization" endpoint of the "identity"
+// service.
+func NewDeviceAuthorizationInvalidTargetError(er *identity.OAuthError) *identitypb.DeviceAuthorizationInvalidTargetError {
+	message := &identitypb.DeviceAuthorizationInvalidTargetError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewDeviceTokenPayload builds the payload of the "device_token" endpoint of
+// the "identity" service from the gRPC request type.
+func NewDeviceTokenPayload(message *identitypb.DeviceTokenRequest) *identity.DeviceTokenPayload {
+	v := &identity.DeviceTokenPayload{
+		GrantType:  message.GrantType,
+		DeviceCode: message.DeviceCode,
+		ClientID:   message.ClientId,
+	}
+	return v
+}
+
+// NewProtoDeviceTokenResponse builds the gRPC response type from the result of
+// the "device_token" endpoint of the "identity" service.
+func NewProtoDeviceTokenResponse(result *identity.DeviceTokenResult) *identitypb.DeviceTokenResponse {
+	message := &identitypb.DeviceTokenResponse{
+		AccessToken: result.AccessToken,
+		TokenType:   result.TokenType,
+		ExpiresIn:   int32(result.ExpiresIn),
+		Scope:       result.Scope,
+	}
+	return message
+}
+
+// NewDeviceTokenAuthorizationPendingError builds the gRPC error response type
+// from the error of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenAuthorizationPendingError(er *identity.OAuthError) *identitypb.DeviceTokenAuthorizationPendingError {
+	message := &identitypb.DeviceTokenAuthorizationPendingError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewDeviceTokenSlowDownError builds the gRPC error response type from the
+// error of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenSlowDownError(er *identity.OAuthError) *identitypb.DeviceTokenSlowDownError {
+	message := &identitypb.DeviceTokenSlowDownError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewDeviceTokenAccessDeniedError builds the gRPC error response type from the
+// error of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenAccessDeniedError(er *identity.OAuthError) *identitypb.DeviceTokenAccessDeniedError {
+	message := &identitypb.DeviceTokenAccessDeniedError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewDeviceTokenExpiredTokenError builds the gRPC error response type from the
+// error of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenExpiredTokenError(er *identity.OAuthError) *identitypb.DeviceTokenExpiredTokenError {
+	message := &identitypb.DeviceTokenExpiredTokenError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewDeviceTokenInvalidGrantError builds the gRPC error response type from the
+// error of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenInvalidGrantError(er *identity.OAuthError) *identitypb.DeviceTokenInvalidGrantError {
+	message := &identitypb.DeviceTokenInvalidGrantError{
+		Error:            er.Code,
+		ErrorDescription: er.ErrorDescription,
+	}
+	return message
+}
+
+// NewApproveDevicePayload builds the payload of the "approve_device" endpoint
+// of the "identity" service from the gRPC request type.
+func NewApproveDevicePayload(message *identitypb.ApproveDeviceRequest) *identity.ApproveDevicePayload {
+	v := &identity.ApproveDevicePayload{
+		Token:    message.Token,
+		UserCode: message.UserCode,
+	}
+	if message.Approve != nil {
+		v.Approve = *message.Approve
+	}
+	if message.Approve == nil {
+		v.Approve = true
+	}
+	return v
+}
+
+// NewProtoApproveDeviceResponse builds the gRPC response type from the result
+// of the "approve_device" endpoint of the "identity" service.
+func NewProtoApproveDeviceResponse() *identitypb.ApproveDeviceResponse {
+	message := &identitypb.ApproveDeviceResponse{}
+	return message
+}
+
+// NewApproveDeviceUnauthorizedError builds the gRPC error response type from
+// the error of the "approve_device" endpoint of the "identity" service.
+func NewApproveDeviceUnauthorizedError(er *identity.UnauthorizedError) *identitypb.ApproveDeviceUnauthorizedError {
+	message := &identitypb.ApproveDeviceUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewApproveDeviceNotFoundError builds the gRPC error response type from the
+// error of the "approve_device" endpoint of the "identity" service.
+func NewApproveDeviceNotFoundError(er *identity.NotFoundError) *identitypb.ApproveDeviceNotFoundError {
+	message := &identitypb.ApproveDeviceNotFoundError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewCreateWebhookPayload builds the payload of the "create_webhook" endpoint
+// of the "identity" service from the gRPC request type.
+func NewCreateWebhookPayload(message *identitypb.CreateWebhookRequest) *identity.CreateWebhookPayload {
+	v := &identity.CreateWebhookPayload{
+		Token: message.Token,
+		URL:   message.Url,
+	}
+	if message.EventTypes != nil {
+		v.EventTypes = make([]string, len(message.EventTypes))
+		for i, val := range message.EventTypes {
+			v.EventTypes[i] = val
+		}
+	}
+	return v
+}
+
+// NewProtoCreateWebhookResponse builds the gRPC response type from the result
+// of the "create_webhook" endpoint of the "identity" service.
+func NewProtoCreateWebhookResponse(result *identity.WebhookSubscription) *identitypb.CreateWebhookResponse {
+	message := &identitypb.CreateWebhookResponse{
+		Id:        result.ID,
+		Url:       result.URL,
+		Active:    result.Active,
+		Secret:    result.Secret,
+		CreatedAt: result.CreatedAt,
+	}
+	if result.EventTypes != nil {
+		message.EventTypes = make([]string, len(result.EventTypes))
+		for i, val := range result.EventTypes {
+			message.EventTypes[i] = val
+		}
+	}
+	return message
+}
+
+// NewCreateWebhookUnauthorizedError builds the gRPC error response type from
+// the error of the "create_webhook" endpoint of the "identity" service.
+func NewCreateWebhookUnauthorizedError(er *identity.UnauthorizedError) *identitypb.CreateWebhookUnauthorizedError {
+	message := &identitypb.CreateWebhookUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewListWebhooksPayload builds the payload of the "list_webhooks" endpoint of
+// the "identity" service from the gRPC request type.
+func NewListWebhooksPayload(message *identitypb.ListWebhooksRequest) *identity.ListWebhooksPayload {
+	v := &identity.ListWebhooksPayload{
+		Token: message.Token,
+	}
+	return v
+}
+
+// NewProtoListWebhooksResponse builds the gRPC response type from the result
+// of the "list_webhooks" endpoint of the "identity" service.
+func NewProtoListWebhooksResponse(result *identity.WebhookSubscriptionsCollection) *identitypb.ListWebhooksResponse {
+	message := &identitypb.ListWebhooksResponse{}
+	if result.Subscriptions != nil {
+		message.Subscriptions = make([]*identitypb.WebhookSubscription, len(result.Subscriptions))
+		for i, val := range result.Subscriptions {
+			message.Subscriptions[i] = &identitypb.WebhookSubscription{
+				Id:        val.ID,
+				Url:       val.URL,
+				Active:    val.Active,
+				Secret:    val.Secret,
+				CreatedAt: val.CreatedAt,
+			}
+			if val.EventTypes != nil {
+				message.Subscriptions[i].EventTypes = make([]string, len(val.EventTypes))
+				for j, val := range val.EventTypes {
+					message.Subscriptions[i].EventTypes[j] = val
+				}
+			}
+		}
+	}
+	return message
+}
+
+// NewListWebhooksUnauthorizedError builds the gRPC error response type from
+// the error of the "list_webhooks" endpoint of the "identity" service.
+func NewListWebhooksUnauthorizedError(er *identity.UnauthorizedError) *identitypb.ListWebhooksUnauthorizedError {
+	message := &identitypb.ListWebhooksUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewDeleteWebhookPayload builds the payload of the "delete_webhook" endpoint
+// of the "identity" service from the gRPC request type.
+func NewDeleteWebhookPayload(message *identitypb.DeleteWebhookRequest) *identity.DeleteWebhookPayload {
+	v := &identity.DeleteWebhookPayload{
+		Token: message.Token,
+		ID:    message.Id,
+	}
+	return v
+}
+
+// NewProtoDeleteWebhookResponse builds the gRPC response type from the result
+// of the "delete_webhook" endpoint of the "identity" service.
+func NewProtoDeleteWebhookResponse() *identitypb.DeleteWebhookResponse {
+	message := &identitypb.DeleteWebhookResponse{}
+	return message
+}
+
+// NewDeleteWebhookUnauthorizedError builds the gRPC error response type from
+// the error of the "delete_webhook" endpoint of the "identity" service.
+func NewDeleteWebhookUnauthorizedError(er *identity.UnauthorizedError) *identitypb.DeleteWebhookUnauthorizedError {
+	message := &identitypb.DeleteWebhookUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewDeleteWebhookNotFoundError builds the gRPC error response type from the
+// error of the "delete_webhook" endpoint of the "identity" service.
+func NewDeleteWebhookNotFoundError(er *identity.NotFoundError) *identitypb.DeleteWebhookNotFoundError {
+	message := &identitypb.DeleteWebhookNotFoundError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewListWebhookDeliveriesPayload builds the payload of the
+// "list_webhook_deliveries" endpoint of the "identity" service from the gRPC
+// request type.
+func NewListWebhookDeliveriesPayload(message *identitypb.ListWebhookDeliveriesRequest) *identity.ListWebhookDeliveriesPayload {
+	v := &identity.ListWebhookDeliveriesPayload{
+		Token: message.Token,
+		ID:    message.Id,
+	}
+	if message.Limit != nil {
+		v.Limit = int(*message.Limit)
+	}
+	if message.Limit == nil {
+		v.Limit = 100
+	}
+	return v
+}
+
+// NewProtoListWebhookDeliveriesResponse builds the gRPC response type from the
+// result of the "list_webhook_deliveries" endpoint of the "identity" service.
+func NewProtoListWebhookDeliveriesResponse(result *identity.WebhookDeliveriesCollection) *identitypb.ListWebhookDeliveriesResponse {
+	message := &identitypb.ListWebhookDeliveriesResponse{}
+	if result.Deliveries != nil {
+		message.Deliveries = make([]*identitypb.WebhookDelivery, len(result.Deliveries))
+		for i, val := range result.Deliveries {
+			message.Deliveries[i] = &identitypb.WebhookDelivery{
+				Id:             val.ID,
+				SubscriptionId: val.SubscriptionID,
+				EventType:      val.EventType,
+				Status:         val.Status,
+				Attempts:       int32(val.Attempts),
+				LastError:      val.LastError,
+				CreatedAt:      val.CreatedAt,
+				LastAttemptAt:  val.LastAttemptAt,
+				NextAttemptAt:  val.NextAttemptAt,
+				DeliveredAt:    val.DeliveredAt,
+			}
+			if val.ResponseStatus != nil {
+				responseStatus := int32(*val.ResponseStatus)
+				message.Deliveries[i].ResponseStatus = &responseStatus
+			}
+		}
+	}
+	return message
+}
+
+// NewListWebhookDeliveriesUnauthorizedError builds the gRPC error response
+// type from the error of the "list_webhook_deliveries" endpoint of the
+// "identity" service.
+func NewListWebhookDeliveriesUnauthorizedError(er *identity.UnauthorizedError) *identitypb.ListWebhookDeliveriesUnauthorizedError {
+	message := &identitypb.ListWebhookDeliveriesUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewListWebhookDeliveriesNotFoundError builds the gRPC error response type
+// from the error of the "list_webhook_deliveries" endpoint of the "identity"
+// service.
+func NewListWebhookDeliveriesNotFoundError(er *identity.NotFoundError) *identitypb.ListWebhookDeliveriesNotFoundError {
+	message := &identitypb.ListWebhookDeliveriesNotFoundError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewRedeliverWebhookPayload builds the payload of the "redeliver_webhook"
+// endpoint of the "identity" service from the gRPC request type.
+func NewRedeliverWebhookPayload(message *identitypb.RedeliverWebhookRequest) *identity.RedeliverWebhookPayload {
+	v := &identity.RedeliverWebhookPayload{
+		Token: message.Token,
+		ID:    message.Id,
+	}
+	return v
+}
+
+// NewProtoRedeliverWebhookResponse builds the gRPC response type from the
+// result of the "redeliver_webhook" endpoint of the "identity" service.
+func NewProtoRedeliverWebhookResponse(result *identity.WebhookDelivery) *identitypb.RedeliverWebhookResponse {
+	message := &identitypb.RedeliverWebhookResponse{
+		Id:             result.ID,
+		SubscriptionId: result.SubscriptionID,
+		EventType:      result.EventType,
+		Status:         result.Status,
+		Attempts:       int32(result.Attempts),
+		LastError:      result.LastError,
+		CreatedAt:      result.CreatedAt,
+		LastAttemptAt:  result.LastAttemptAt,
+		NextAttemptAt:  result.NextAttemptAt,
+		DeliveredAt:    result.DeliveredAt,
+	}
+	if result.ResponseStatus != nil {
+		responseStatus := int32(*result.ResponseStatus)
+		message.ResponseStatus = &responseStatus
+	}
+	return message
+}
+
+// NewRedeliverWebhookUnauthorizedError builds the gRPC error response type
+// from the error of the "redeliver_webhook" endpoint of the "identity" service.
+func NewRedeliverWebhookUnauthorizedError(er *identity.UnauthorizedError) *identitypb.RedeliverWebhookUnauthorizedError {
+	message := &identitypb.RedeliverWebhookUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewRedeliverWebhookNotFoundError builds the gRPC error response type from
+// the error of the "redeliver_webhook" endpoint of the "identity" service.
+func NewRedeliverWebhookNotFoundError(er *identity.NotFoundError) *identitypb.RedeliverWebhookNotFoundError {
+	message := &identitypb.RedeliverWebhookNotFoundError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewSetUserStatusPayload builds the payload of the "set_user_status" endpoint
+// of the "identity" service from the gRPC request type.
+func NewSetUserStatusPayload(message *identitypb.SetUserStatusRequest) *identity.SetUserStatusPayload {
+	v := &identity.SetUserStatusPayload{
+		Token:  message.Token,
+		ID:     message.Id,
+		Status: message.Status,
+		Reason: message.Reason,
+	}
+	return v
+}
+
+// NewProtoSetUserStatusResponse builds the gRPC response type from the result
+// of the "set_user_status" endpoint of the "identity" service.
+func NewProtoSetUserStatusResponse(result *identityviews.UserView) *identitypb.SetUserStatusResponse {
+	message := &identitypb.SetUserStatusResponse{
+		Id:              *result.ID,
+		Email:           *result.Email,
+		DisplayName:     *result.DisplayName,
+		CreatedAt:       *result.CreatedAt,
+		Status:          *result.Status,
+		StatusReason:    result.StatusReason,
+		StatusChangedAt: result.StatusChangedAt,
+		Username:        result.Username,
+	}
+	if result.Attributes != nil {
+		message.Attributes = make(map[string]string, len(result.Attributes))
+		for key, val := range result.Attributes {
+			tk := key
+			tv := val
+			message.Attributes[tk] = tv
+		}
+	}
+	return message
+}
+
+// NewSetUserStatusUnauthorizedError builds the gRPC error response type from
+// the error of the "set_user_status" endpoint of the "identity" service.
+func NewSetUserStatusUnauthorizedError(er *identity.UnauthorizedError) *identitypb.SetUserStatusUnauthorizedError {
+	message := &identitypb.SetUserStatusUnauthorizedError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// NewSetUserStatusNotFoundError builds the gRPC error response type from the
+// error of the "set_user_status" endpoint of the "identity" service.
+func NewSetUserStatusNotFoundError(er *identity.NotFoundError) *identitypb.SetUserStatusNotFoundError {
+	message := &identitypb.SetUserStatusNotFoundError{
+		Message_:  er.Message,
+		Id:        er.ID,
+		Temporary: er.Temporary,
+		Timeout:   er.Timeout,
+	}
+	return message
+}
+
+// ValidateRegisterRequest runs the validations defined on RegisterRequest.
+func ValidateRegisterRequest(message *identitypb.RegisterRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.email", message.Email, goa.FormatEmail))
+	if message.Password != nil {
+		if utf8.RuneCountInString(*message.Password) < 8 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.password", *message.Password, utf8.RuneCountInString(*message.Password), 8, true))
+		}
+	}
+	if utf8.RuneCountInString(message.DisplayName) < 3 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.display_name", message.DisplayName, utf8.RuneCountInString(message.DisplayName), 3, true))
+	}
+	return
+}
+
+// ValidateLoginRequest runs the validations defined on LoginRequest.
+func ValidateLoginRequest(message *identitypb.LoginRequest) (err error) {
+	if message.TokenFormat != nil {
+		if !(*message.TokenFormat == "jwt" || *message.TokenFormat == "opaque") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.token_format", *message.TokenFormat, []any{"jwt", "opaque"}))
+		}
+	}
+	if message.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.email", *message.Email, goa.FormatEmail))
+	}
+	if utf8.RuneCountInString(message.Password) < 8 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.password", message.Password, utf8.RuneCountInString(message.Password), 8, true))
+	}
+	return
+}
+
+// ValidateRequestMagicLinkRequest runs the validations defined on
+// RequestMagicLinkRequest.
+func ValidateRequestMagicLinkRequest(message *identitypb.RequestMagicLinkRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.email", message.Email, goa.FormatEmail))
+	return
+}
+
+// ValidateValidateTokensRequest runs the validations defined on
+// ValidateTokensRequest.
+func ValidateValidateTokensRequest(message *identitypb.ValidateTokensRequest) (err error) {
+	if message.Tokens == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tokens", "message"))
+	}
+	if len(message.Tokens) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.tokens", message.Tokens, len(message.Tokens), 1, true))
+	}
+	if len(message.Tokens) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.tokens", message.Tokens, len(message.Tokens), 100, false))
+	}
+	return
+}
+
+// ValidateListAuthEventsRequest runs the validations defined on
+// ListAuthEventsRequest.
+func ValidateListAuthEventsRequest(message *identitypb.ListAuthEventsRequest) (err error) {
+	if message.UserId != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.user_id", *message.UserId, goa.FormatUUID))
+	}
+	if message.Type != nil {
+		if !(*message.Type == "register" || *message.Type == "login" || *message.Type == "validate_token" || *message.Type == "token_revoked" || *message.Type == "token_exchange" || *message.Type == "magic_link" || *message.Type == "invitation" || *message.Type == "device_authorization" || *message.Type == "provisioning" || *message.Type == "user_status") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.type", *message.Type, []any{"register", "login", "validate_token", "token_revoked", "token_exchange", "magic_link", "invitation", "device_authorization", "provisioning", "user_status"}))
+		}
+	}
+	if message.Since != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.since", *message.Since, goa.FormatDateTime))
+	}
+	if message.Until != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("message.until", *message.Until, goa.FormatDateTime))
+	}
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1000, false))
+		}
+	}
+	return
+}
+
+// ValidateIntrospectRequest runs the validations defined on IntrospectRequest.
+func ValidateIntrospectRequest(message *identitypb.IntrospectRequest) (err error) {
+	if message.TokenTypeHint != nil {
+		if !(*message.TokenTypeHint == "access_token") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.token_type_hint", *message.TokenTypeHint, []any{"access_token"}))
+		}
+	}
+	return
+}
+
+// ValidateRevokeTokenRequest runs the validations defined on
+// RevokeTokenRequest.
+func ValidateRevokeTokenRequest(message *identitypb.RevokeTokenRequest) (err error) {
+	if message.TokenTypeHint != nil {
+		if !(*message.TokenTypeHint == "access_token") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.token_type_hint", *message.TokenTypeHint, []any{"access_token"}))
+		}
+	}
+	return
+}
+
+// ValidateExchangeTokenRequest runs the validations defined on
+// ExchangeTokenRequest.
+func ValidateExchangeTokenRequest(message *identitypb.ExchangeTokenRequest) (err error) {
+	if message.GrantType != nil {
+		if !(*message.GrantType == "urn:ietf:params:oauth:grant-type:token-exchange") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.grant_type", *message.GrantType, []any{"urn:ietf:params:oauth:grant-type:token-exchange"}))
+		}
+	}
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.requested_subject", message.RequestedSubject, goa.FormatUUID))
+	if message.RequestedTokenType != nil {
+		if !(*message.RequestedTokenType == "urn:ietf:params:oauth:token-type:access_token" || *message.RequestedTokenType == "urn:ietf:params:oauth:token-type:jwt") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.requested_token_type", *message.RequestedTokenType, []any{"urn:ietf:params:oauth:token-type:access_token", "urn:ietf:params:oauth:token-type:jwt"}))
+		}
+	}
+	if utf8.RuneCountInString(message.Reason) < 3 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("message.reason", message.Reason, utf8.RuneCountInString(message.Reason), 3, true))
+	}
+	return
+}
+
+// ValidateInviteUserRequest runs the validations defined on InviteUserRequest.
+func ValidateInviteUserRequest(message *identitypb.InviteUserRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.email", message.Email, goa.FormatEmail))
+	if message.DisplayName != nil {
+		if utf8.RuneCountInString(*message.DisplayName) < 3 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.display_name", *message.DisplayName, utf8.RuneCountInString(*message.DisplayName), 3, true))
+		}
+	}
+	return
+}
+
+// ValidateRevokeInvitationRequest runs the validations defined on
+// RevokeInvitationRequest.
+func ValidateRevokeInvitationRequest(message *identitypb.RevokeInvitationRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.id", message.Id, goa.FormatUUID))
+	return
+}
+
+// ValidateAcceptInvitationRequest runs the validations defined on
+// AcceptInvitationRequest.
+func ValidateAcceptInvitationRequest(message *identitypb.AcceptInvitationRequest) (err error) {
+	if message.Password != nil {
+		if utf8.RuneCountInString(*message.Password) < 8 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.password", *message.Password, utf8.RuneCountInString(*message.Password), 8, true))
+		}
+	}
+	if message.DisplayName != nil {
+		if utf8.RuneCountInString(*message.DisplayName) < 3 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.display_name", *message.DisplayName, utf8.RuneCountInString(*message.DisplayName), 3, true))
+		}
+	}
+	return
+}
+
+// ValidateDeviceTokenRequest runs the validations defined on
+// DeviceTokenRequest.
+func ValidateDeviceTokenRequest(message *identitypb.DeviceTokenRequest) (err error) {
+	if !(message.GrantType == "urn:ietf:params:oauth:grant-type:device_code") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.grant_type", message.GrantType, []any{"urn:ietf:params:oauth:grant-type:device_code"}))
+	}
+	return
+}
+
+// ValidateCreateWebhookRequest runs the validations defined on
+// CreateWebhookRequest.
+func ValidateCreateWebhookRequest(message *identitypb.CreateWebhookRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("message.url", message.Url, "^https?://"))
+	for _, e := range message.EventTypes {
+		if !(e == "user.registered" || e == "user.updated" || e == "user.disabled" || e == "user.deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.event_types[*]", e, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+		}
+	}
+	return
+}
+
+// ValidateDeleteWebhookRequest runs the validations defined on
+// DeleteWebhookRequest.
+func ValidateDeleteWebhookRequest(message *identitypb.DeleteWebhookRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.id", message.Id, goa.FormatUUID))
+	return
+}
+
+// ValidateListWebhookDeliveriesRequest runs the validations defined on
+// ListWebhookDeliveriesRequest.
+func ValidateListWebhookDeliveriesRequest(message *identitypb.ListWebhookDeliveriesRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.id", message.Id, goa.FormatUUID))
+	if message.Limit != nil {
+		if *message.Limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 1, true))
+		}
+	}
+	if message.Limit != nil {
+		if *message.Limit > 500 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("message.limit", *message.Limit, 500, false))
+		}
+	}
+	return
+}
+
+// ValidateRedeliverWebhookRequest runs the validations defined on
+// RedeliverWebhookRequest.
+func ValidateRedeliverWebhookRequest(message *identitypb.RedeliverWebhookRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.id", message.Id, goa.FormatUUID))
+	return
+}
+
+// ValidateSetUserStatusRequest runs the validations defined on
+// SetUserStatusRequest.
+func ValidateSetUserStatusRequest(message *identitypb.SetUserStatusRequest) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("message.id", message.Id, goa.FormatUUID))
+	if !(message.Status == "active" || message.Status == "suspended" || message.Status == "deactivated") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("message.status", message.Status, []any{"active", "suspended", "deactivated"}))
+	}
+	if message.Reason != nil {
+		if utf8.RuneCountInString(*message.Reason) > 500 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("message.reason", *message.Reason, utf8.RuneCountInString(*message.Reason), 500, false))
+		}
+	}
+	return
+}
+
+// svcIdentityActorToIdentitypbActor builds a value of type *identitypb.Actor
+// from a value of type *identity.Actor.
+func svcIdentityActorToIdentitypbActor(v *identity.Actor) *identitypb.Actor {
+	if v == nil {
+		return nil
+	}
+	res := &identitypb.Actor{
+		UserId: v.UserID,
+		Email:  v.Email,
+	}
+
+	return res
+}
+
+// protobufIdentitypbActorToIdentityActor builds a value of type
+// *identity.Actor from a value of type *identitypb.Actor.
+func protobufIdentitypbActorToIdentityActor(v *identitypb.Actor) *identity.Actor {
+	if v == nil {
+		return nil
+	}
+	res := &identity.Actor{
+		UserID: v.UserId,
+		Email:  v.Email,
+	}
+
+	return res
+}
+
+// svcIdentityValidationResultToIdentitypbValidationResult builds a value of
+// type *identitypb.ValidationResult from a value of type
+// *identity.ValidationResult.
+func svcIdentityValidationResultToIdentitypbValidationResult(v *identity.ValidationResult) *identitypb.ValidationResult {
+	if v == nil {
+		return nil
+	}
+	res := &identitypb.ValidationResult{
+		Valid:  v.Valid,
+		UserId: v.UserID,
+		Email:  v.Email,
+		Reason: v.Reason,
+	}
+	if v.Actor != nil {
+		res.Actor = svcIdentityActorToIdentitypbActor(v.Actor)
+	}
+	if v.Audience != nil {
+		res.Audience = make([]string, len(v.Audience))
+		for i, val := range v.Audience {
+			res.Audience[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// protobufIdentitypbValidationResultToIdentityValidationResult builds a value
+// of type *identity.ValidationResult from a value of type
+// *identitypb.ValidationResult.
+func protobufIdentitypbValidationResultToIdentityValidationResult(v *identitypb.ValidationResult) *identity.ValidationResult {
+	if v == nil {
+		return nil
+	}
+	res := &identity.ValidationResult{
+		Valid:  v.Valid,
+		UserID: v.UserId,
+		Email:  v.Email,
+		Reason: v.Reason,
+	}
+	if v.Actor != nil {
+		res.Actor = protobufIdentitypbActorToIdentityActor(v.Actor)
+	}
+	if v.Audience != nil {
+		res.Audience = make([]string, len(v.Audience))
+		for i, val := range v.Audience {
+			res.Audience[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
 }
@@ -14,6 +14,7 @@ import (
 	"os"
 
 	identityc "github.com/vidwadeseram/go-boilerplate/identity-api/gen/http/identity/client"
+	scimc "github.com/vidwadeseram/go-boilerplate/identity-api/gen/http/scim/client"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -23,13 +24,15 @@ import (
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() []string {
 	return []string{
-		"identity (register|login|validate-token)",
+		"identity (register|login|set-username|check-username|request-magic-link|consume-magic-link|validate-token|validate-tokens|list-auth-events|introspect|revoke-token|exchange-token|invite-user|list-invitations|revoke-invitation|accept-invitation|device-authorization|device-token|approve-device|create-webhook|list-webhooks|delete-webhook|list-webhook-deliveries|redeliver-webhook|set-user-status)",
+		"scim (create-user|get-user|list-users|patch-user|delete-user)",
 	}
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + " " + "identity register --body '{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'" + "\n" +
+	return os.Args[0] + " " + "identity register --body '{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"username\": \"service_admin\"\n   }'" + "\n" +
+		os.Args[0] + " " + "scim create-user --body '{\n      \"active\": false,\n      \"displayName\": \"Voluptatem voluptatibus similique ea ipsa aut.\",\n      \"emails\": [\n         {\n            \"primary\": false,\n            \"type\": \"Explicabo excepturi libero deleniti quo explicabo sed.\",\n            \"value\": \"pasquale@nolan.org\"\n         },\n         {\n            \"primary\": false,\n            \"type\": \"Explicabo excepturi libero deleniti quo explicabo sed.\",\n            \"value\": \"pasquale@nolan.org\"\n         }\n      ],\n      \"externalId\": \"Dolorem ratione voluptas esse.\",\n      \"name\": {\n         \"familyName\": \"Quae cum odio nobis quia.\",\n         \"formatted\": \"Architecto qui.\",\n         \"givenName\": \"Non a ut repellat.\"\n      },\n      \"password\": \"Debitis repudiandae magni earum earum non nobis.\",\n      \"schemas\": [\n         \"Nesciunt alias.\",\n         \"Aperiam impedit voluptate sit non error praesentium.\",\n         \"Voluptas amet soluta unde rerum nihil.\",\n         \"Deserunt quibusdam aut.\"\n      ],\n      \"userName\": \"Unde ut dolorem eius molestias.\"\n   }' --token \"Quisquam atque.\"" + "\n" +
 		""
 }
 
@@ -51,13 +54,155 @@ func ParseEndpoint(
 		identityLoginFlags    = flag.NewFlagSet("login", flag.ExitOnError)
 		identityLoginBodyFlag = identityLoginFlags.String("body", "REQUIRED", "")
 
+		identitySetUsernameFlags     = flag.NewFlagSet("set-username", flag.ExitOnError)
+		identitySetUsernameBodyFlag  = identitySetUsernameFlags.String("body", "REQUIRED", "")
+		identitySetUsernameTokenFlag = identitySetUsernameFlags.String("token", "REQUIRED", "")
+
+		identityCheckUsernameFlags        = flag.NewFlagSet("check-username", flag.ExitOnError)
+		identityCheckUsernameUsernameFlag = identityCheckUsernameFlags.String("username", "REQUIRED", "Username to check")
+
+		identityRequestMagicLinkFlags    = flag.NewFlagSet("request-magic-link", flag.ExitOnError)
+		identityRequestMagicLinkBodyFlag = identityRequestMagicLinkFlags.String("body", "REQUIRED", "")
+
+		identityConsumeMagicLinkFlags    = flag.NewFlagSet("consume-magic-link", flag.ExitOnError)
+		identityConsumeMagicLinkBodyFlag = identityConsumeMagicLinkFlags.String("body", "REQUIRED", "")
+
 		identityValidateTokenFlags    = flag.NewFlagSet("validate-token", flag.ExitOnError)
 		identityValidateTokenBodyFlag = identityValidateTokenFlags.String("body", "REQUIRED", "")
+
+		identityValidateTokensFlags    = flag.NewFlagSet("validate-tokens", flag.ExitOnError)
+		identityValidateTokensBodyFlag = identityValidateTokensFlags.String("body", "REQUIRED", "")
+
+		identityListAuthEventsFlags        = flag.NewFlagSet("list-auth-events", flag.ExitOnError)
+		identityListAuthEventsUserIDFlag   = identityListAuthEventsFlags.String("user-id", "", "")
+		identityListAuthEventsTypeFlag     = identityListAuthEventsFlags.String("type", "", "")
+		identityListAuthEventsSinceFlag    = identityListAuthEventsFlags.String("since", "", "")
+		identityListAuthEventsUntilFlag    = identityListAuthEventsFlags.String("until", "", "")
+		identityListAuthEventsBeforeIDFlag = identityListAuthEventsFlags.String("before-id", "", "")
+		identityListAuthEventsLimitFlag    = identityListAuthEventsFlags.String("limit", "100", "")
+		identityListAuthEventsTokenFlag    = identityListAuthEventsFlags.String("token", "REQUIRED", "")
+
+		identityIntrospectFlags            = flag.NewFlagSet("introspect", flag.ExitOnError)
+		identityIntrospectBodyFlag         = identityIntrospectFlags.String("body", "REQUIRED", "")
+		identityIntrospectClientIDFlag     = identityIntrospectFlags.String("client-id", "REQUIRED", "OAuth client identifier")
+		identityIntrospectClientSecretFlag = identityIntrospectFlags.String("client-secret", "REQUIRED", "OAuth client secret")
+
+		identityRevokeTokenFlags            = flag.NewFlagSet("revoke-token", flag.ExitOnError)
+		identityRevokeTokenBodyFlag         = identityRevokeTokenFlags.String("body", "REQUIRED", "")
+		identityRevokeTokenClientIDFlag     = identityRevokeTokenFlags.String("client-id", "REQUIRED", "OAuth client identifier")
+		identityRevokeTokenClientSecretFlag = identityRevokeTokenFlags.String("client-secret", "REQUIRED", "OAuth client secret")
+
+		identityExchangeTokenFlags     = flag.NewFlagSet("exchange-token", flag.ExitOnError)
+		identityExchangeTokenBodyFlag  = identityExchangeTokenFlags.String("body", "REQUIRED", "")
+		identityExchangeTokenTokenFlag = identityExchangeTokenFlags.String("token", "REQUIRED", "")
+
+		identityInviteUserFlags     = flag.NewFlagSet("invite-user", flag.ExitOnError)
+		identityInviteUserBodyFlag  = identityInviteUserFlags.String("body", "REQUIRED", "")
+		identityInviteUserTokenFlag = identityInviteUserFlags.String("token", "REQUIRED", "")
+
+		identityListInvitationsFlags     = flag.NewFlagSet("list-invitations", flag.ExitOnError)
+		identityListInvitationsTokenFlag = identityListInvitationsFlags.String("token", "REQUIRED", "")
+
+		identityRevokeInvitationFlags     = flag.NewFlagSet("revoke-invitation", flag.ExitOnError)
+		identityRevokeInvitationIDFlag    = identityRevokeInvitationFlags.String("id", "REQUIRED", "Invitation identifier")
+		identityRevokeInvitationTokenFlag = identityRevokeInvitationFlags.String("token", "REQUIRED", "")
+
+		identityAcceptInvitationFlags    = flag.NewFlagSet("accept-invitation", flag.ExitOnError)
+		identityAcceptInvitationBodyFlag = identityAcceptInvitationFlags.String("body", "REQUIRED", "")
+
+		identityDeviceAuthorizationFlags    = flag.NewFlagSet("device-authorization", flag.ExitOnError)
+		identityDeviceAuthorizationBodyFlag = identityDeviceAuthorizationFlags.String("body", "REQUIRED", "")
+
+		identityDeviceTokenFlags    = flag.NewFlagSet("device-token", flag.ExitOnError)
+		identityDeviceTokenBodyFlag = identityDeviceTokenFlags.String("body", "REQUIRED", "")
+
+		identityApproveDeviceFlags     = flag.NewFlagSet("approve-device", flag.ExitOnError)
+		identityApproveDeviceBodyFlag  = identityApproveDeviceFlags.String("body", "REQUIRED", "")
+		identityApproveDeviceTokenFlag = identityApproveDeviceFlags.String("token", "REQUIRED", "")
+
+		identityCreateWebhookFlags     = flag.NewFlagSet("create-webhook", flag.ExitOnError)
+		identityCreateWebhookBodyFlag  = identityCreateWebhookFlags.String("body", "REQUIRED", "")
+		identityCreateWebhookTokenFlag = identityCreateWebhookFlags.String("token", "REQUIRED", "")
+
+		identityListWebhooksFlags     = flag.NewFlagSet("list-webhooks", flag.ExitOnError)
+		identityListWebhooksTokenFlag = identityListWebhooksFlags.String("token", "REQUIRED", "")
+
+		identityDeleteWebhookFlags     = flag.NewFlagSet("delete-webhook", flag.ExitOnError)
+		identityDeleteWebhookIDFlag    = identityDeleteWebhookFlags.String("id", "REQUIRED", "Subscription identifier")
+		identityDeleteWebhookTokenFlag = identityDeleteWebhookFlags.String("token", "REQUIRED", "")
+
+		identityListWebhookDeliveriesFlags     = flag.NewFlagSet("list-webhook-deliveries", flag.ExitOnError)
+		identityListWebhookDeliveriesIDFlag    = identityListWebhookDeliveriesFlags.String("id", "REQUIRED", "Subscription identifier")
+		identityListWebhookDeliveriesLimitFlag = identityListWebhookDeliveriesFlags.String("limit", "100", "")
+		identityListWebhookDeliveriesTokenFlag = identityListWebhookDeliveriesFlags.String("token", "REQUIRED", "")
+
+		identityRedeliverWebhookFlags     = flag.NewFlagSet("redeliver-webhook", flag.ExitOnError)
+		identityRedeliverWebhookIDFlag    = identityRedeliverWebhookFlags.String("id", "REQUIRED", "Identifier of the delivery to send again")
+		identityRedeliverWebhookTokenFlag = identityRedeliverWebhookFlags.String("token", "REQUIRED", "")
+
+		identitySetUserStatusFlags     = flag.NewFlagSet("set-user-status", flag.ExitOnError)
+		identitySetUserStatusBodyFlag  = identitySetUserStatusFlags.String("body", "REQUIRED", "")
+		identitySetUserStatusIDFlag    = identitySetUserStatusFlags.String("id", "REQUIRED", "User identifier")
+		identitySetUserStatusTokenFlag = identitySetUserStatusFlags.String("token", "REQUIRED", "")
+
+		scimFlags = flag.NewFlagSet("scim", flag.ContinueOnError)
+
+		scimCreateUserFlags     = flag.NewFlagSet("create-user", flag.ExitOnError)
+		scimCreateUserBodyFlag  = scimCreateUserFlags.String("body", "REQUIRED", "")
+		scimCreateUserTokenFlag = scimCreateUserFlags.String("token", "REQUIRED", "")
+
+		scimGetUserFlags     = flag.NewFlagSet("get-user", flag.ExitOnError)
+		scimGetUserIDFlag    = scimGetUserFlags.String("id", "REQUIRED", "Resource identifier")
+		scimGetUserTokenFlag = scimGetUserFlags.String("token", "REQUIRED", "")
+
+		scimListUsersFlags          = flag.NewFlagSet("list-users", flag.ExitOnError)
+		scimListUsersFilterFlag     = scimListUsersFlags.String("filter", "", "")
+		scimListUsersStartIndexFlag = scimListUsersFlags.String("start-index", "1", "")
+		scimListUsersCountFlag      = scimListUsersFlags.String("count", "100", "")
+		scimListUsersTokenFlag      = scimListUsersFlags.String("token", "REQUIRED", "")
+
+		scimPatchUserFlags     = flag.NewFlagSet("patch-user", flag.ExitOnError)
+		scimPatchUserBodyFlag  = scimPatchUserFlags.String("body", "REQUIRED", "")
+		scimPatchUserIDFlag    = scimPatchUserFlags.String("id", "REQUIRED", "Resource identifier")
+		scimPatchUserTokenFlag = scimPatchUserFlags.String("token", "REQUIRED", "")
+
+		scimDeleteUserFlags     = flag.NewFlagSet("delete-user", flag.ExitOnError)
+		scimDeleteUserIDFlag    = scimDeleteUserFlags.String("id", "REQUIRED", "Resource identifier")
+		scimDeleteUserTokenFlag = scimDeleteUserFlags.String("token", "REQUIRED", "")
 	)
 	identityFlags.Usage = identityUsage
 	identityRegisterFlags.Usage = identityRegisterUsage
 	identityLoginFlags.Usage = identityLoginUsage
+	identitySetUsernameFlags.Usage = identitySetUsernameUsage
+	identityCheckUsernameFlags.Usage = identityCheckUsernameUsage
+	identityRequestMagicLinkFlags.Usage = identityRequestMagicLinkUsage
+	identityConsumeMagicLinkFlags.Usage = identityConsumeMagicLinkUsage
 	identityValidateTokenFlags.Usage = identityValidateTokenUsage
+	identityValidateTokensFlags.Usage = identityValidateTokensUsage
+	identityListAuthEventsFlags.Usage = identityListAuthEventsUsage
+	identityIntrospectFlags.Usage = identityIntrospectUsage
+	identityRevokeTokenFlags.Usage = identityRevokeTokenUsage
+	identityExchangeTokenFlags.Usage = identityExchangeTokenUsage
+	identityInviteUserFlags.Usage = identityInviteUserUsage
+	identityListInvitationsFlags.Usage = identityListInvitationsUsage
+	identityRevokeInvitationFlags.Usage = identityRevokeInvitationUsage
+	identityAcceptInvitationFlags.Usage = identityAcceptInvitationUsage
+	identityDeviceAuthorizationFlags.Usage = identityDeviceAuthorizationUsage
+	identityDeviceTokenFlags.Usage = identityDeviceTokenUsage
+	identityApproveDeviceFlags.Usage = identityApproveDeviceUsage
+	identityCreateWebhookFlags.Usage = identityCreateWebhookUsage
+	identityListWebhooksFlags.Usage = identityListWebhooksUsage
+	identityDeleteWebhookFlags.Usage = identityDeleteWebhookUsage
+	identityListWebhookDeliveriesFlags.Usage = identityListWebhookDeliveriesUsage
+	identityRedeliverWebhookFlags.Usage = identityRedeliverWebhookUsage
+	identitySetUserStatusFlags.Usage = identitySetUserStatusUsage
+
+	scimFlags.Usage = scimUsage
+	scimCreateUserFlags.Usage = scimCreateUserUsage
+	scimGetUserFlags.Usage = scimGetUserUsage
+	scimListUsersFlags.Usage = scimListUsersUsage
+	scimPatchUserFlags.Usage = scimPatchUserUsage
+	scimDeleteUserFlags.Usage = scimDeleteUserUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
 		return nil, nil, err
@@ -76,6 +221,8 @@ func ParseEndpoint(
 		switch svcn {
 		case "identity":
 			svcf = identityFlags
+		case "scim":
+			svcf = scimFlags
 		default:
 			return nil, nil, fmt.Errorf("unknown service %q", svcn)
 		}
@@ -99,9 +246,94 @@ func ParseEndpoint(
 			case "login":
 				epf = identityLoginFlags
 
+			case "set-username":
+				epf = identitySetUsernameFlags
+
+			case "check-username":
+				epf = identityCheckUsernameFlags
+
+			case "request-magic-link":
+				epf = identityRequestMagicLinkFlags
+
+			case "consume-magic-link":
+				epf = identityConsumeMagicLinkFlags
+
 			case "validate-token":
 				epf = identityValidateTokenFlags
 
+			case "validate-tokens":
+				epf = identityValidateTokensFlags
+
+			case "list-auth-events":
+				epf = identityListAuthEventsFlags
+
+			case "introspect":
+				epf = identityIntrospectFlags
+
+			case "revoke-token":
+				epf = identityRevokeTokenFlags
+
+			case "exchange-token":
+				epf = identityExchangeTokenFlags
+
+			case "invite-user":
+				epf = identityInviteUserFlags
+
+			case "list-invitations":
+				epf = identityListInvitationsFlags
+
+			case "revoke-invitation":
+				epf = identityRevokeInvitationFlags
+
+			case "accept-invitation":
+				epf = identityAcceptInvitationFlags
+
+			case "device-authorization":
+				epf = identityDeviceAuthorizationFlags
+
+			case "device-token":
+				epf = identityDeviceTokenFlags
+
+			case "approve-device":
+				epf = identityApproveDeviceFlags
+
+			case "create-webhook":
+				epf = identityCreateWebhookFlags
+
+			case "list-webhooks":
+				epf = identityListWebhooksFlags
+
+			case "delete-webhook":
+				epf = identityDeleteWebhookFlags
+
+			case "list-webhook-deliveries":
+				epf = identityListWebhookDeliveriesFlags
+
+			case "redeliver-webhook":
+				epf = identityRedeliverWebhookFlags
+
+			case "set-user-status":
+				epf = identitySetUserStatusFlags
+
+			}
+
+		case "scim":
+			switch epn {
+			case "create-user":
+				epf = scimCreateUserFlags
+
+			case "get-user":
+				epf = scimGetUserFlags
+
+			case "list-users":
+				epf = scimListUsersFlags
+
+			case "patch-user":
+				epf = scimPatchUserFlags
+
+			case "delete-user":
+				epf = scimDeleteUserFlags
+
 			}
 
 		}
@@ -133,9 +365,94 @@ func ParseEndpoint(
 			case "login":
 				endpoint = c.Login()
 				data, err = identityc.BuildLoginPayload(*identityLoginBodyFlag)
+			case "set-username":
+				endpoint = c.SetUsername()
+				data, err = identityc.BuildSetUsernamePayload(*identitySetUsernameBodyFlag, *identitySetUsernameTokenFlag)
+			case "check-username":
+				endpoint = c.CheckUsername()
+				data, err = identityc.BuildCheckUsernamePayload(*identityCheckUsernameUsernameFlag)
+			case "request-magic-link":
+				endpoint = c.RequestMagicLink()
+				data, err = identityc.BuildRequestMagicLinkPayload(*identityRequestMagicLinkBodyFlag)
+			case "consume-magic-link":
+				endpoint = c.ConsumeMagicLink()
+				data, err = identityc.BuildConsumeMagicLinkPayload(*identityConsumeMagicLinkBodyFlag)
 			case "validate-token":
 				endpoint = c.ValidateToken()
 				data, err = identityc.BuildValidateTokenPayload(*identityValidateTokenBodyFlag)
+			case "validate-tokens":
+				endpoint = c.ValidateTokens()
+				data, err = identityc.BuildValidateTokensPayload(*identityValidateTokensBodyFlag)
+			case "list-auth-events":
+				endpoint = c.ListAuthEvents()
+				data, err = identityc.BuildListAuthEventsPayload(*identityListAuthEventsUserIDFlag, *identityListAuthEventsTypeFlag, *identityListAuthEventsSinceFlag, *identityListAuthEventsUntilFlag, *identityListAuthEventsBeforeIDFlag, *identityListAuthEventsLimitFlag, *identityListAuthEventsTokenFlag)
+			case "introspect":
+				endpoint = c.Introspect()
+				data, err = identityc.BuildIntrospectPayload(*identityIntrospectBodyFlag, *identityIntrospectClientIDFlag, *identityIntrospectClientSecretFlag)
+			case "revoke-token":
+				endpoint = c.RevokeToken()
+				data, err = identityc.BuildRevokeTokenPayload(*identityRevokeTokenBodyFlag, *identityRevokeTokenClientIDFlag, *identityRevokeTokenClientSecretFlag)
+			case "exchange-token":
+				endpoint = c.ExchangeToken()
+				data, err = identityc.BuildExchangeTokenPayload(*identityExchangeTokenBodyFlag, *identityExchangeTokenTokenFlag)
+			case "invite-user":
+				endpoint = c.InviteUser()
+				data, err = identityc.BuildInviteUserPayload(*identityInviteUserBodyFlag, *identityInviteUserTokenFlag)
+			case "list-invitations":
+				endpoint = c.ListInvitations()
+				data, err = identityc.BuildListInvitationsPayload(*identityListInvitationsTokenFlag)
+			case "revoke-invitation":
+				endpoint = c.RevokeInvitation()
+				data, err = identityc.BuildRevokeInvitationPayload(*identityRevokeInvitationIDFlag, *identityRevokeInvitationTokenFlag)
+			case "accept-invitation":
+				endpoint = c.AcceptInvitation()
+				data, err = identityc.BuildAcceptInvitationPayload(*identityAcceptInvitationBodyFlag)
+			case "device-authorization":
+				endpoint = c.DeviceAuthorization()
+				data, err = identityc.BuildDeviceAuthorizationPayload(*identityDeviceAuthorizationBodyFlag)
+			case "device-token":
+				endpoint = c.DeviceToken()
+				data, err = identityc.BuildDeviceTokenPayload(*identityDeviceTokenBodyFlag)
+			case "approve-device":
+				endpoint = c.ApproveDevice()
+				data, err = identityc.BuildApproveDevicePayload(*identityApproveDeviceBodyFlag, *identityApproveDeviceTokenFlag)
+			case "create-webhook":
+				endpoint = c.CreateWebhook()
+				data, err = identityc.BuildCreateWebhookPayload(*identityCreateWebhookBodyFlag, *identityCreateWebhookTokenFlag)
+			case "list-webhooks":
+				endpoint = c.ListWebhooks()
+				data, err = identityc.BuildListWebhooksPayload(*identityListWebhooksTokenFlag)
+			case "delete-webhook":
+				endpoint = c.DeleteWebhook()
+				data, err = identityc.BuildDeleteWebhookPayload(*identityDeleteWebhookIDFlag, *identityDeleteWebhookTokenFlag)
+			case "list-webhook-deliveries":
+				endpoint = c.ListWebhookDeliveries()
+				data, err = identityc.BuildListWebhookDeliveriesPayload(*identityListWebhookDeliveriesIDFlag, *identityListWebhookDeliveriesLimitFlag, *identityListWebhookDeliveriesTokenFlag)
+			case "redeliver-webhook":
+				endpoint = c.RedeliverWebhook()
+				data, err = identityc.BuildRedeliverWebhookPayload(*identityRedeliverWebhookIDFlag, *identityRedeliverWebhookTokenFlag)
+			case "set-user-status":
+				endpoint = c.SetUserStatus()
+				data, err = identityc.BuildSetUserStatusPayload(*identitySetUserStatusBodyFlag, *identitySetUserStatusIDFlag, *identitySetUserStatusTokenFlag)
+			}
+		case "scim":
+			c := scimc.NewClient(scheme, host, doer, enc, dec, restore)
+			switch epn {
+			case "create-user":
+				endpoint = c.CreateUser()
+				data, err = scimc.BuildCreateUserPayload(*scimCreateUserBodyFlag, *scimCreateUserTokenFlag)
+			case "get-user":
+				endpoint = c.GetUser()
+				data, err = scimc.BuildGetUserPayload(*scimGetUserIDFlag, *scimGetUserTokenFlag)
+			case "list-users":
+				endpoint = c.ListUsers()
+				data, err = scimc.BuildListUsersPayload(*scimListUsersFilterFlag, *scimListUsersStartIndexFlag, *scimListUsersCountFlag, *scimListUsersTokenFlag)
+			case "patch-user":
+				endpoint = c.PatchUser()
+				data, err = scimc.BuildPatchUserPayload(*scimPatchUserBodyFlag, *scimPatchUserIDFlag, *scimPatchUserTokenFlag)
+			case "delete-user":
+				endpoint = c.DeleteUser()
+				data, err = scimc.BuildDeleteUserPayload(*scimDeleteUserIDFlag, *scimDeleteUserTokenFlag)
 			}
 		}
 	}
@@ -152,8 +469,30 @@ func identityUsage() {
 	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] identity COMMAND [flags]\n\n", os.Args[0])
 	fmt.Fprintln(os.Stderr, "COMMAND:")
 	fmt.Fprintln(os.Stderr, `    register: Registers a new user`)
-	fmt.Fprintln(os.Stderr, `    login: Authenticates a user and issues a JWT`)
+	fmt.Fprintln(os.Stderr, `    login: Authenticates a user by email or username and issues a JWT or opaque access token`)
+	fmt.Fprintln(os.Stderr, `    set-username: Sets or changes the username of the calling user`)
+	fmt.Fprintln(os.Stderr, `    check-username: Reports whether a username is valid and not yet taken`)
+	fmt.Fprintln(os.Stderr, `    request-magic-link: Emails a single-use login link; succeeds whether or not the account exists`)
+	fmt.Fprintln(os.Stderr, `    consume-magic-link: Exchanges a login link token for an access token`)
 	fmt.Fprintln(os.Stderr, `    validate-token: Validates a JWT and returns the claims`)
+	fmt.Fprintln(os.Stderr, `    validate-tokens: Validates a batch of access tokens in one call`)
+	fmt.Fprintln(os.Stderr, `    list-auth-events: Lists security audit events; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    introspect: OAuth 2.0 token introspection (RFC 7662) for JWT and opaque access tokens`)
+	fmt.Fprintln(os.Stderr, `    revoke-token: OAuth 2.0 token revocation (RFC 7009) for opaque access tokens. Unknown and already revoked tokens are accepted silently; JWTs cannot be revoked and expire on their own`)
+	fmt.Fprintln(os.Stderr, `    exchange-token: Exchanges an administrator token for a short-lived token impersonating another user (RFC 8693)`)
+	fmt.Fprintln(os.Stderr, `    invite-user: Invites a colleague by email with optional pre-assigned attributes. Only administrators may pre-assign attributes and are told when the email is already registered`)
+	fmt.Fprintln(os.Stderr, `    list-invitations: Lists the invitations created by the caller`)
+	fmt.Fprintln(os.Stderr, `    revoke-invitation: Revokes a pending invitation created by the caller`)
+	fmt.Fprintln(os.Stderr, `    accept-invitation: Completes registration for an invited user`)
+	fmt.Fprintln(os.Stderr, `    device-authorization: Starts the OAuth 2.0 device authorization grant (RFC 8628)`)
+	fmt.Fprintln(os.Stderr, `    device-token: Polls for the access token of a device authorization (RFC 8628 section 3.4)`)
+	fmt.Fprintln(os.Stderr, `    approve-device: Approves or denies a device authorization on behalf of the signed-in user`)
+	fmt.Fprintln(os.Stderr, `    create-webhook: Subscribes an endpoint to user lifecycle events; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    list-webhooks: Lists webhook subscriptions; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    delete-webhook: Deletes a webhook subscription and its delivery log; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    list-webhook-deliveries: Lists the delivery log of a webhook subscription; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    redeliver-webhook: Queues a new delivery of a previously sent event; restricted to administrators`)
+	fmt.Fprintln(os.Stderr, `    set-user-status: Suspends, deactivates or reactivates an account; restricted to administrators. Accounts that are not active cannot sign in and their tokens stop validating`)
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Additional help:")
 	fmt.Fprintf(os.Stderr, "    %s identity COMMAND --help\n", os.Args[0])
@@ -173,7 +512,7 @@ func identityRegisterUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity register --body '{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity register --body '{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"username\": \"service_admin\"\n   }'")
 }
 
 func identityLoginUsage() {
@@ -184,14 +523,88 @@ func identityLoginUsage() {
 
 	// Description
 	fmt.Fprintln(os.Stderr)
-	fmt.Fprintln(os.Stderr, `Authenticates a user and issues a JWT`)
+	fmt.Fprintln(os.Stderr, `Authenticates a user by email or username and issues a JWT or opaque access token`)
 
 	// Flags list
 	fmt.Fprintln(os.Stderr, `    -body JSON: `)
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity login --body '{\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity login --body '{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"email\": \"service@example.com\",\n      \"identifier\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"token_format\": \"jwt\"\n   }'")
+}
+
+func identitySetUsernameUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity set-username", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Sets or changes the username of the calling user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity set-username --body '{\n      \"username\": \"service_admin\"\n   }' --token \"Doloremque quis nam quam nam.\"")
+}
+
+func identityCheckUsernameUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity check-username", os.Args[0])
+	fmt.Fprint(os.Stderr, " -username STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Reports whether a username is valid and not yet taken`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -username STRING: Username to check`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity check-username --username \"service_admin\"")
+}
+
+func identityRequestMagicLinkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity request-magic-link", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Emails a single-use login link; succeeds whether or not the account exists`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity request-magic-link --body '{\n      \"email\": \"service@example.com\"\n   }'")
+}
+
+func identityConsumeMagicLinkUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity consume-magic-link", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Exchanges a login link token for an access token`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity consume-magic-link --body '{\n      \"token\": \"Accusamus ut culpa omnis est molestiae.\"\n   }'")
 }
 
 func identityValidateTokenUsage() {
@@ -209,5 +622,491 @@ func identityValidateTokenUsage() {
 
 	fmt.Fprintln(os.Stderr)
 	fmt.Fprintln(os.Stderr, "Example:")
-	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity validate-token --body '{\n      \"token\": \"Magnam sit numquam.\"\n   }'")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity validate-token --body '{\n      \"audience\": \"dummy-api\",\n      \"token\": \"Deleniti quam quis ducimus sint.\"\n   }'")
+}
+
+func identityValidateTokensUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity validate-tokens", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Validates a batch of access tokens in one call`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity validate-tokens --body '{\n      \"audience\": \"dummy-api\",\n      \"tokens\": [\n         \"Iure aut esse.\",\n         \"Tenetur possimus et placeat est qui quidem.\",\n         \"Non adipisci incidunt.\"\n      ]\n   }'")
+}
+
+func identityListAuthEventsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity list-auth-events", os.Args[0])
+	fmt.Fprint(os.Stderr, " -user-id STRING")
+	fmt.Fprint(os.Stderr, " -type STRING")
+	fmt.Fprint(os.Stderr, " -since STRING")
+	fmt.Fprint(os.Stderr, " -until STRING")
+	fmt.Fprint(os.Stderr, " -before-id INT64")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists security audit events; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -user-id STRING: `)
+	fmt.Fprintln(os.Stderr, `    -type STRING: `)
+	fmt.Fprintln(os.Stderr, `    -since STRING: `)
+	fmt.Fprintln(os.Stderr, `    -until STRING: `)
+	fmt.Fprintln(os.Stderr, `    -before-id INT64: `)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity list-auth-events --user-id \"1b24a87c-195b-4d80-86ba-d1e598ce1933\" --type \"provisioning\" --since \"1971-12-12T06:27:17Z\" --until \"1991-11-02T12:45:08Z\" --before-id 6394166992550553838 --limit 960 --token \"Corrupti vero animi veniam est.\"")
+}
+
+func identityIntrospectUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity introspect", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -client-id STRING")
+	fmt.Fprint(os.Stderr, " -client-secret STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `OAuth 2.0 token introspection (RFC 7662) for JWT and opaque access tokens`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -client-id STRING: OAuth client identifier`)
+	fmt.Fprintln(os.Stderr, `    -client-secret STRING: OAuth client secret`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity introspect --body '{\n      \"token\": \"Dignissimos quod in facere.\",\n      \"token_type_hint\": \"access_token\"\n   }' --client-id \"Dolores id alias.\" --client-secret \"Possimus consequatur incidunt aliquid officia optio.\"")
+}
+
+func identityRevokeTokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity revoke-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -client-id STRING")
+	fmt.Fprint(os.Stderr, " -client-secret STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `OAuth 2.0 token revocation (RFC 7009) for opaque access tokens. Unknown and already revoked tokens are accepted silently; JWTs cannot be revoked and expire on their own`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -client-id STRING: OAuth client identifier`)
+	fmt.Fprintln(os.Stderr, `    -client-secret STRING: OAuth client secret`)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity revoke-token --body '{\n      \"token\": \"Repudiandae voluptate beatae dicta ut.\",\n      \"token_type_hint\": \"access_token\"\n   }' --client-id \"Id molestiae qui eos quia.\" --client-secret \"In dolores nihil.\"")
+}
+
+func identityExchangeTokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity exchange-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Exchanges an administrator token for a short-lived token impersonating another user (RFC 8693)`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity exchange-token --body '{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:token-exchange\",\n      \"reason\": \"Reproducing support ticket #1234\",\n      \"requested_subject\": \"e9f6832e-309a-447b-b616-2476b0a7881c\",\n      \"requested_token_type\": \"urn:ietf:params:oauth:token-type:access_token\"\n   }' --token \"Et consequuntur ullam saepe adipisci dolor laboriosam.\"")
+}
+
+func identityInviteUserUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity invite-user", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Invites a colleague by email with optional pre-assigned attributes. Only administrators may pre-assign attributes and are told when the email is already registered`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity invite-user --body '{\n      \"attributes\": {\n         \"Enim qui magnam ea quis.\": \"Harum similique est iusto sit vitae.\",\n         \"Et eos tempore vel rerum iusto quia.\": \"Repellendus culpa autem.\"\n      },\n      \"display_name\": \"qu4\",\n      \"email\": \"colleague@example.com\"\n   }' --token \"Quidem non.\"")
+}
+
+func identityListInvitationsUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity list-invitations", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the invitations created by the caller`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity list-invitations --token \"Incidunt et ipsam.\"")
+}
+
+func identityRevokeInvitationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity revoke-invitation", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Revokes a pending invitation created by the caller`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Invitation identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity revoke-invitation --id \"f7fe5d24-88cd-451b-bf70-a4b8389f5e6e\" --token \"Eligendi et commodi.\"")
+}
+
+func identityAcceptInvitationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity accept-invitation", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Completes registration for an invited user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity accept-invitation --body '{\n      \"display_name\": \"81n\",\n      \"invitation_token\": \"Ea et.\",\n      \"password\": \"7zg\"\n   }'")
+}
+
+func identityDeviceAuthorizationUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity device-authorization", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Starts the OAuth 2.0 device authorization grant (RFC 8628)`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity device-authorization --body '{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"client_id\": \"cli\",\n      \"scope\": \"Et voluptatem est est.\"\n   }'")
+}
+
+func identityDeviceTokenUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity device-token", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Polls for the access token of a device authorization (RFC 8628 section 3.4)`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity device-token --body '{\n      \"client_id\": \"Dignissimos sequi cupiditate.\",\n      \"device_code\": \"Sint sequi dignissimos totam dolorem et aut.\",\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:device_code\"\n   }'")
+}
+
+func identityApproveDeviceUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity approve-device", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Approves or denies a device authorization on behalf of the signed-in user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity approve-device --body '{\n      \"approve\": false,\n      \"user_code\": \"WDJB-MJHT\"\n   }' --token \"Quisquam itaque possimus nihil ea ratione occaecati.\"")
+}
+
+func identityCreateWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity create-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Subscribes an endpoint to user lifecycle events; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity create-webhook --body '{\n      \"event_types\": [\n         \"user.updated\",\n         \"user.updated\"\n      ],\n      \"url\": \"https://hooks.example.com/identity\"\n   }' --token \"Quia voluptatem.\"")
+}
+
+func identityListWebhooksUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity list-webhooks", os.Args[0])
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists webhook subscriptions; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity list-webhooks --token \"Amet dolore nulla veniam dolore.\"")
+}
+
+func identityDeleteWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity delete-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Deletes a webhook subscription and its delivery log; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Subscription identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity delete-webhook --id \"dfccd331-36b6-40eb-b693-cf84bfeedbf9\" --token \"Deserunt excepturi.\"")
+}
+
+func identityListWebhookDeliveriesUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity list-webhook-deliveries", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -limit INT")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists the delivery log of a webhook subscription; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Subscription identifier`)
+	fmt.Fprintln(os.Stderr, `    -limit INT: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity list-webhook-deliveries --id \"fa01e690-30df-4743-a2bf-9ee6f6edd699\" --limit 191 --token \"Fugiat officia aliquam ut facere eum molestias.\"")
+}
+
+func identityRedeliverWebhookUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity redeliver-webhook", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Queues a new delivery of a previously sent event; restricted to administrators`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Identifier of the delivery to send again`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity redeliver-webhook --id \"89c549b1-89c4-4cc0-b21d-6a3350c33f64\" --token \"Nostrum est quaerat tempora voluptas vel quo.\"")
+}
+
+func identitySetUserStatusUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] identity set-user-status", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Suspends, deactivates or reactivates an account; restricted to administrators. Accounts that are not active cannot sign in and their tokens stop validating`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: User identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "identity set-user-status --body '{\n      \"reason\": \"8w1\",\n      \"status\": \"active\"\n   }' --id \"37ca6b53-50a9-4bc6-8d5c-020ad7830f25\" --token \"Iure eos.\"")
+}
+
+// scimUsage displays the usage of the scim command and its subcommands.
+func scimUsage() {
+	fmt.Fprintln(os.Stderr, `SCIM 2.0 user provisioning for identity providers and HR systems`)
+	fmt.Fprintf(os.Stderr, "Usage:\n    %s [globalflags] scim COMMAND [flags]\n\n", os.Args[0])
+	fmt.Fprintln(os.Stderr, "COMMAND:")
+	fmt.Fprintln(os.Stderr, `    create-user: Provisions a user`)
+	fmt.Fprintln(os.Stderr, `    get-user: Returns a provisioned user`)
+	fmt.Fprintln(os.Stderr, `    list-users: Lists users, optionally filtered by userName or externalId equality`)
+	fmt.Fprintln(os.Stderr, `    patch-user: Modifies a user with a SCIM PatchOp request; setting active to false deactivates the account`)
+	fmt.Fprintln(os.Stderr, `    delete-user: Deletes a user`)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Additional help:")
+	fmt.Fprintf(os.Stderr, "    %s scim COMMAND --help\n", os.Args[0])
+}
+func scimCreateUserUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] scim create-user", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Provisions a user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "scim create-user --body '{\n      \"active\": false,\n      \"displayName\": \"Voluptatem voluptatibus similique ea ipsa aut.\",\n      \"emails\": [\n         {\n            \"primary\": false,\n            \"type\": \"Explicabo excepturi libero deleniti quo explicabo sed.\",\n            \"value\": \"pasquale@nolan.org\"\n         },\n         {\n            \"primary\": false,\n            \"type\": \"Explicabo excepturi libero deleniti quo explicabo sed.\",\n            \"value\": \"pasquale@nolan.org\"\n         }\n      ],\n      \"externalId\": \"Dolorem ratione voluptas esse.\",\n      \"name\": {\n         \"familyName\": \"Quae cum odio nobis quia.\",\n         \"formatted\": \"Architecto qui.\",\n         \"givenName\": \"Non a ut repellat.\"\n      },\n      \"password\": \"Debitis repudiandae magni earum earum non nobis.\",\n      \"schemas\": [\n         \"Nesciunt alias.\",\n         \"Aperiam impedit voluptate sit non error praesentium.\",\n         \"Voluptas amet soluta unde rerum nihil.\",\n         \"Deserunt quibusdam aut.\"\n      ],\n      \"userName\": \"Unde ut dolorem eius molestias.\"\n   }' --token \"Quisquam atque.\"")
+}
+
+func scimGetUserUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] scim get-user", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Returns a provisioned user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Resource identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "scim get-user --id \"Magni sed sit itaque et occaecati.\" --token \"Corporis blanditiis maiores cum dolor labore aut.\"")
+}
+
+func scimListUsersUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] scim list-users", os.Args[0])
+	fmt.Fprint(os.Stderr, " -filter STRING")
+	fmt.Fprint(os.Stderr, " -start-index INT")
+	fmt.Fprint(os.Stderr, " -count INT")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Lists users, optionally filtered by userName or externalId equality`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -filter STRING: `)
+	fmt.Fprintln(os.Stderr, `    -start-index INT: `)
+	fmt.Fprintln(os.Stderr, `    -count INT: `)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "scim list-users --filter \"userName eq \\\"jane@example.com\\\"\" --start-index 2830999872459063720 --count 168 --token \"Harum aut eos quia dolor.\"")
+}
+
+func scimPatchUserUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] scim patch-user", os.Args[0])
+	fmt.Fprint(os.Stderr, " -body JSON")
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Modifies a user with a SCIM PatchOp request; setting active to false deactivates the account`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -body JSON: `)
+	fmt.Fprintln(os.Stderr, `    -id STRING: Resource identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "scim patch-user --body '{\n      \"Operations\": [\n         {\n            \"op\": \"Molestiae et alias itaque dolore.\",\n            \"path\": \"Doloremque illum iusto id fuga.\",\n            \"value\": \"Deserunt quam maxime ea assumenda.\"\n         },\n         {\n            \"op\": \"Molestiae et alias itaque dolore.\",\n            \"path\": \"Doloremque illum iusto id fuga.\",\n            \"value\": \"Deserunt quam maxime ea assumenda.\"\n         }\n      ],\n      \"schemas\": [\n         \"Omnis omnis.\",\n         \"Consequatur quia et.\",\n         \"Corrupti consequatur hic totam.\",\n         \"Assumenda assumenda nobis.\"\n      ]\n   }' --id \"Accusamus nulla error.\" --token \"Perferendis sunt quia iure.\"")
+}
+
+func scimDeleteUserUsage() {
+	// Header with flags
+	fmt.Fprintf(os.Stderr, "%s [flags] scim delete-user", os.Args[0])
+	fmt.Fprint(os.Stderr, " -id STRING")
+	fmt.Fprint(os.Stderr, " -token STRING")
+	fmt.Fprintln(os.Stderr)
+
+	// Description
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, `Deletes a user`)
+
+	// Flags list
+	fmt.Fprintln(os.Stderr, `    -id STRING: Resource identifier`)
+	fmt.Fprintln(os.Stderr, `    -token STRING: `)
+
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Example:")
+	fmt.Fprintf(os.Stderr, "    %s %s\n", os.Args[0], "scim delete-user --id \"Sit illo.\" --token \"Numquam est commodi fugit veritatis totam ut.\"")
 }
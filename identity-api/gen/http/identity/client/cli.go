@@ -10,6 +10,7 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"unicode/utf8"
 
 	identity "github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
@@ -24,23 +25,26 @@ func BuildRegisterPayload(identityRegisterBody string) (*identity.RegisterPayloa
 	{
 		err = json.Unmarshal([]byte(identityRegisterBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"display_name\": \"Service Admin\",\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"username\": \"service_admin\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
+		if body.Password != nil {
+			if utf8.RuneCountInString(*body.Password) < 8 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.password", *body.Password, utf8.RuneCountInString(*body.Password), 8, true))
+			}
 		}
 		if utf8.RuneCountInString(body.DisplayName) < 3 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", body.DisplayName, utf8.RuneCountInString(body.DisplayName), 3, true))
 		}
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
-		if utf8.RuneCountInString(body.Password) < 8 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.password", body.Password, utf8.RuneCountInString(body.Password), 8, true))
-		}
 		if err != nil {
 			return nil, err
 		}
 	}
 	v := &identity.RegisterPayload{
-		DisplayName: body.DisplayName,
 		Email:       body.Email,
 		Password:    body.Password,
+		DisplayName: body.DisplayName,
+		Username:    body.Username,
 	}
 
 	return v, nil
@@ -48,15 +52,20 @@ func BuildRegisterPayload(identityRegisterBody string) (*identity.RegisterPayloa
 
 // BuildLoginPayload builds the payload for the identity login endpoint from
 // CLI flags.
-func BuildLoginPayload(identityLoginBody string) (*identity.Credentials, error) {
+func BuildLoginPayload(identityLoginBody string) (*identity.LoginPayload, error) {
 	var err error
 	var body LoginRequestBody
 	{
 		err = json.Unmarshal([]byte(identityLoginBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"service@example.com\",\n      \"password\": \"changeme123\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"email\": \"service@example.com\",\n      \"identifier\": \"service@example.com\",\n      \"password\": \"changeme123\",\n      \"token_format\": \"jwt\"\n   }'")
+		}
+		if !(body.TokenFormat == "jwt" || body.TokenFormat == "opaque") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_format", body.TokenFormat, []any{"jwt", "opaque"}))
+		}
+		if body.Email != nil {
+			err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
 		}
-		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
 		if utf8.RuneCountInString(body.Password) < 8 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.password", body.Password, utf8.RuneCountInString(body.Password), 8, true))
 		}
@@ -64,9 +73,99 @@ func BuildLoginPayload(identityLoginBody string) (*identity.Credentials, error)
 			return nil, err
 		}
 	}
-	v := &identity.Credentials{
-		Email:    body.Email,
-		Password: body.Password,
+	v := &identity.LoginPayload{
+		TokenFormat: body.TokenFormat,
+		Email:       body.Email,
+		Password:    body.Password,
+		Identifier:  body.Identifier,
+	}
+	{
+		var zero string
+		if v.TokenFormat == zero {
+			v.TokenFormat = "jwt"
+		}
+	}
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildSetUsernamePayload builds the payload for the identity set_username
+// endpoint from CLI flags.
+func BuildSetUsernamePayload(identitySetUsernameBody string, identitySetUsernameToken string) (*identity.SetUsernamePayload, error) {
+	var err error
+	var body SetUsernameRequestBody
+	{
+		err = json.Unmarshal([]byte(identitySetUsernameBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"username\": \"service_admin\"\n   }'")
+		}
+	}
+	var token string
+	{
+		token = identitySetUsernameToken
+	}
+	v := &identity.SetUsernamePayload{
+		Username: body.Username,
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCheckUsernamePayload builds the payload for the identity check_username
+// endpoint from CLI flags.
+func BuildCheckUsernamePayload(identityCheckUsernameUsername string) (*identity.CheckUsernamePayload, error) {
+	var username string
+	{
+		username = identityCheckUsernameUsername
+	}
+	v := &identity.CheckUsernamePayload{}
+	v.Username = username
+
+	return v, nil
+}
+
+// BuildRequestMagicLinkPayload builds the payload for the identity
+// request_magic_link endpoint from CLI flags.
+func BuildRequestMagicLinkPayload(identityRequestMagicLinkBody string) (*identity.RequestMagicLinkPayload, error) {
+	var err error
+	var body RequestMagicLinkRequestBody
+	{
+		err = json.Unmarshal([]byte(identityRequestMagicLinkBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"email\": \"service@example.com\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &identity.RequestMagicLinkPayload{
+		Email: body.Email,
+	}
+
+	return v, nil
+}
+
+// BuildConsumeMagicLinkPayload builds the payload for the identity
+// consume_magic_link endpoint from CLI flags.
+func BuildConsumeMagicLinkPayload(identityConsumeMagicLinkBody string) (*identity.ConsumeMagicLinkPayload, error) {
+	var err error
+	var body ConsumeMagicLinkRequestBody
+	{
+		err = json.Unmarshal([]byte(identityConsumeMagicLinkBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Accusamus ut culpa omnis est molestiae.\"\n   }'")
+		}
+	}
+	v := &identity.ConsumeMagicLinkPayload{
+		Token: body.Token,
 	}
 
 	return v, nil
@@ -80,12 +179,648 @@ func BuildValidateTokenPayload(identityValidateTokenBody string) (*identity.Vali
 	{
 		err = json.Unmarshal([]byte(identityValidateTokenBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Magnam sit numquam.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": \"dummy-api\",\n      \"token\": \"Deleniti quam quis ducimus sint.\"\n   }'")
 		}
 	}
 	v := &identity.ValidateTokenPayload{
-		Token: body.Token,
+		Token:    body.Token,
+		Audience: body.Audience,
+	}
+
+	return v, nil
+}
+
+// BuildValidateTokensPayload builds the payload for the identity
+// validate_tokens endpoint from CLI flags.
+func BuildValidateTokensPayload(identityValidateTokensBody string) (*identity.ValidateTokensPayload, error) {
+	var err error
+	var body ValidateTokensRequestBody
+	{
+		err = json.Unmarshal([]byte(identityValidateTokensBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": \"dummy-api\",\n      \"tokens\": [\n         \"Iure aut esse.\",\n         \"Tenetur possimus et placeat est qui quidem.\",\n         \"Non adipisci incidunt.\"\n      ]\n   }'")
+		}
+		if body.Tokens == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("tokens", "body"))
+		}
+		if len(body.Tokens) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tokens", body.Tokens, len(body.Tokens), 1, true))
+		}
+		if len(body.Tokens) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tokens", body.Tokens, len(body.Tokens), 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &identity.ValidateTokensPayload{
+		Audience: body.Audience,
+	}
+	if body.Tokens != nil {
+		v.Tokens = make([]string, len(body.Tokens))
+		for i, val := range body.Tokens {
+			v.Tokens[i] = val
+		}
+	} else {
+		v.Tokens = []string{}
+	}
+
+	return v, nil
+}
+
+// BuildListAuthEventsPayload builds the payload for the identity
+// list_auth_events endpoint from CLI flags.
+func BuildListAuthEventsPayload(identityListAuthEventsUserID string, identityListAuthEventsType string, identityListAuthEventsSince string, identityListAuthEventsUntil string, identityListAuthEventsBeforeID string, identityListAuthEventsLimit string, identityListAuthEventsToken string) (*identity.ListAuthEventsPayload, error) {
+	var err error
+	var userID *string
+	{
+		if identityListAuthEventsUserID != "" {
+			userID = &identityListAuthEventsUserID
+			err = goa.MergeErrors(err, goa.ValidateFormat("user_id", *userID, goa.FormatUUID))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var type_ *string
+	{
+		if identityListAuthEventsType != "" {
+			type_ = &identityListAuthEventsType
+			if !(*type_ == "register" || *type_ == "login" || *type_ == "validate_token" || *type_ == "token_revoked" || *type_ == "token_exchange" || *type_ == "magic_link" || *type_ == "invitation" || *type_ == "device_authorization" || *type_ == "provisioning" || *type_ == "user_status") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("type", *type_, []any{"register", "login", "validate_token", "token_revoked", "token_exchange", "magic_link", "invitation", "device_authorization", "provisioning", "user_status"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var since *string
+	{
+		if identityListAuthEventsSince != "" {
+			since = &identityListAuthEventsSince
+			err = goa.MergeErrors(err, goa.ValidateFormat("since", *since, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var until *string
+	{
+		if identityListAuthEventsUntil != "" {
+			until = &identityListAuthEventsUntil
+			err = goa.MergeErrors(err, goa.ValidateFormat("until", *until, goa.FormatDateTime))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var beforeID *int64
+	{
+		if identityListAuthEventsBeforeID != "" {
+			val, err := strconv.ParseInt(identityListAuthEventsBeforeID, 10, 64)
+			beforeID = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for beforeID, must be INT64")
+			}
+		}
+	}
+	var limit int
+	{
+		if identityListAuthEventsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(identityListAuthEventsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = identityListAuthEventsToken
+	}
+	v := &identity.ListAuthEventsPayload{}
+	v.UserID = userID
+	v.Type = type_
+	v.Since = since
+	v.Until = until
+	v.BeforeID = beforeID
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildIntrospectPayload builds the payload for the identity introspect
+// endpoint from CLI flags.
+func BuildIntrospectPayload(identityIntrospectBody string, identityIntrospectClientID string, identityIntrospectClientSecret string) (*identity.IntrospectPayload, error) {
+	var err error
+	var body struct {
+		// Token to introspect
+		Token *string `form:"token" json:"token" xml:"token"`
+		// Hint about the type of the submitted token
+		TokenTypeHint *string `form:"token_type_hint" json:"token_type_hint" xml:"token_type_hint"`
+	}
+	{
+		err = json.Unmarshal([]byte(identityIntrospectBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Dignissimos quod in facere.\",\n      \"token_type_hint\": \"access_token\"\n   }'")
+		}
+	}
+	var client_id string
+	{
+		client_id = identityIntrospectClientID
+	}
+	var client_secret string
+	{
+		client_secret = identityIntrospectClientSecret
+	}
+	v := &identity.IntrospectPayload{
+		TokenTypeHint: body.TokenTypeHint,
+	}
+	if body.Token != nil {
+		v.Token = *body.Token
+	}
+	v.ClientID = client_id
+	v.ClientSecret = client_secret
+
+	return v, nil
+}
+
+// BuildRevokeTokenPayload builds the payload for the identity revoke_token
+// endpoint from CLI flags.
+func BuildRevokeTokenPayload(identityRevokeTokenBody string, identityRevokeTokenClientID string, identityRevokeTokenClientSecret string) (*identity.RevokeTokenPayload, error) {
+	var err error
+	var body struct {
+		// Token to revoke
+		Token *string `form:"token" json:"token" xml:"token"`
+		// Hint about the type of the submitted token
+		TokenTypeHint *string `form:"token_type_hint" json:"token_type_hint" xml:"token_type_hint"`
+	}
+	{
+		err = json.Unmarshal([]byte(identityRevokeTokenBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"token\": \"Repudiandae voluptate beatae dicta ut.\",\n      \"token_type_hint\": \"access_token\"\n   }'")
+		}
+	}
+	var client_id string
+	{
+		client_id = identityRevokeTokenClientID
+	}
+	var client_secret string
+	{
+		client_secret = identityRevokeTokenClientSecret
+	}
+	v := &identity.RevokeTokenPayload{
+		TokenTypeHint: body.TokenTypeHint,
+	}
+	if body.Token != nil {
+		v.Token = *body.Token
+	}
+	v.ClientID = client_id
+	v.ClientSecret = client_secret
+
+	return v, nil
+}
+
+// BuildExchangeTokenPayload builds the payload for the identity exchange_token
+// endpoint from CLI flags.
+func BuildExchangeTokenPayload(identityExchangeTokenBody string, identityExchangeTokenToken string) (*identity.TokenExchangePayload, error) {
+	var err error
+	var body ExchangeTokenRequestBody
+	{
+		err = json.Unmarshal([]byte(identityExchangeTokenBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:token-exchange\",\n      \"reason\": \"Reproducing support ticket #1234\",\n      \"requested_subject\": \"e9f6832e-309a-447b-b616-2476b0a7881c\",\n      \"requested_token_type\": \"urn:ietf:params:oauth:token-type:access_token\"\n   }'")
+		}
+		if !(body.GrantType == "urn:ietf:params:oauth:grant-type:token-exchange") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.grant_type", body.GrantType, []any{"urn:ietf:params:oauth:grant-type:token-exchange"}))
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.requested_subject", body.RequestedSubject, goa.FormatUUID))
+		if !(body.RequestedTokenType == "urn:ietf:params:oauth:token-type:access_token" || body.RequestedTokenType == "urn:ietf:params:oauth:token-type:jwt") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.requested_token_type", body.RequestedTokenType, []any{"urn:ietf:params:oauth:token-type:access_token", "urn:ietf:params:oauth:token-type:jwt"}))
+		}
+		if utf8.RuneCountInString(body.Reason) < 3 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", body.Reason, utf8.RuneCountInString(body.Reason), 3, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = identityExchangeTokenToken
+	}
+	v := &identity.TokenExchangePayload{
+		GrantType:          body.GrantType,
+		RequestedSubject:   body.RequestedSubject,
+		RequestedTokenType: body.RequestedTokenType,
+		Reason:             body.Reason,
+	}
+	{
+		var zero string
+		if v.GrantType == zero {
+			v.GrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+		}
+	}
+	{
+		var zero string
+		if v.RequestedTokenType == zero {
+			v.RequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+		}
+	}
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildInviteUserPayload builds the payload for the identity invite_user
+// endpoint from CLI flags.
+func BuildInviteUserPayload(identityInviteUserBody string, identityInviteUserToken string) (*identity.InviteUserPayload, error) {
+	var err error
+	var body InviteUserRequestBody
+	{
+		err = json.Unmarshal([]byte(identityInviteUserBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"attributes\": {\n         \"Enim qui magnam ea quis.\": \"Harum similique est iusto sit vitae.\",\n         \"Et eos tempore vel rerum iusto quia.\": \"Repellendus culpa autem.\"\n      },\n      \"display_name\": \"qu4\",\n      \"email\": \"colleague@example.com\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
+		if body.DisplayName != nil {
+			if utf8.RuneCountInString(*body.DisplayName) < 3 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 3, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = identityInviteUserToken
+	}
+	v := &identity.InviteUserPayload{
+		Email:       body.Email,
+		DisplayName: body.DisplayName,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListInvitationsPayload builds the payload for the identity
+// list_invitations endpoint from CLI flags.
+func BuildListInvitationsPayload(identityListInvitationsToken string) (*identity.ListInvitationsPayload, error) {
+	var token string
+	{
+		token = identityListInvitationsToken
+	}
+	v := &identity.ListInvitationsPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRevokeInvitationPayload builds the payload for the identity
+// revoke_invitation endpoint from CLI flags.
+func BuildRevokeInvitationPayload(identityRevokeInvitationID string, identityRevokeInvitationToken string) (*identity.RevokeInvitationPayload, error) {
+	var err error
+	var id string
+	{
+		id = identityRevokeInvitationID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = identityRevokeInvitationToken
+	}
+	v := &identity.RevokeInvitationPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildAcceptInvitationPayload builds the payload for the identity
+// accept_invitation endpoint from CLI flags.
+func BuildAcceptInvitationPayload(identityAcceptInvitationBody string) (*identity.AcceptInvitationPayload, error) {
+	var err error
+	var body AcceptInvitationRequestBody
+	{
+		err = json.Unmarshal([]byte(identityAcceptInvitationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"display_name\": \"81n\",\n      \"invitation_token\": \"Ea et.\",\n      \"password\": \"7zg\"\n   }'")
+		}
+		if body.Password != nil {
+			if utf8.RuneCountInString(*body.Password) < 8 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.password", *body.Password, utf8.RuneCountInString(*body.Password), 8, true))
+			}
+		}
+		if body.DisplayName != nil {
+			if utf8.RuneCountInString(*body.DisplayName) < 3 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 3, true))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &identity.AcceptInvitationPayload{
+		InvitationToken: body.InvitationToken,
+		Password:        body.Password,
+		DisplayName:     body.DisplayName,
+	}
+
+	return v, nil
+}
+
+// BuildDeviceAuthorizationPayload builds the payload for the identity
+// device_authorization endpoint from CLI flags.
+func BuildDeviceAuthorizationPayload(identityDeviceAuthorizationBody string) (*identity.DeviceAuthorizationPayload, error) {
+	var err error
+	var body DeviceAuthorizationRequestBody
+	{
+		err = json.Unmarshal([]byte(identityDeviceAuthorizationBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"audience\": [\n         \"dummy-api\"\n      ],\n      \"client_id\": \"cli\",\n      \"scope\": \"Et voluptatem est est.\"\n   }'")
+		}
+	}
+	v := &identity.DeviceAuthorizationPayload{
+		ClientID: body.ClientID,
+		Scope:    body.Scope,
+	}
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildDeviceTokenPayload builds the payload for the identity device_token
+// endpoint from CLI flags.
+func BuildDeviceTokenPayload(identityDeviceTokenBody string) (*identity.DeviceTokenPayload, error) {
+	var err error
+	var body DeviceTokenRequestBody
+	{
+		err = json.Unmarshal([]byte(identityDeviceTokenBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"client_id\": \"Dignissimos sequi cupiditate.\",\n      \"device_code\": \"Sint sequi dignissimos totam dolorem et aut.\",\n      \"grant_type\": \"urn:ietf:params:oauth:grant-type:device_code\"\n   }'")
+		}
+		if !(body.GrantType == "urn:ietf:params:oauth:grant-type:device_code") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.grant_type", body.GrantType, []any{"urn:ietf:params:oauth:grant-type:device_code"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &identity.DeviceTokenPayload{
+		GrantType:  body.GrantType,
+		DeviceCode: body.DeviceCode,
+		ClientID:   body.ClientID,
+	}
+
+	return v, nil
+}
+
+// BuildApproveDevicePayload builds the payload for the identity approve_device
+// endpoint from CLI flags.
+func BuildApproveDevicePayload(identityApproveDeviceBody string, identityApproveDeviceToken string) (*identity.ApproveDevicePayload, error) {
+	var err error
+	var body ApproveDeviceRequestBody
+	{
+		err = json.Unmarshal([]byte(identityApproveDeviceBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"approve\": false,\n      \"user_code\": \"WDJB-MJHT\"\n   }'")
+		}
+	}
+	var token string
+	{
+		token = identityApproveDeviceToken
+	}
+	v := &identity.ApproveDevicePayload{
+		UserCode: body.UserCode,
+		Approve:  body.Approve,
+	}
+	{
+		var zero bool
+		if v.Approve == zero {
+			v.Approve = true
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildCreateWebhookPayload builds the payload for the identity create_webhook
+// endpoint from CLI flags.
+func BuildCreateWebhookPayload(identityCreateWebhookBody string, identityCreateWebhookToken string) (*identity.CreateWebhookPayload, error) {
+	var err error
+	var body CreateWebhookRequestBody
+	{
+		err = json.Unmarshal([]byte(identityCreateWebhookBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"event_types\": [\n         \"user.updated\",\n         \"user.updated\"\n      ],\n      \"url\": \"https://hooks.example.com/identity\"\n   }'")
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.url", body.URL, "^https?://"))
+		for _, e := range body.EventTypes {
+			if !(e == "user.registered" || e == "user.updated" || e == "user.disabled" || e == "user.deleted") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event_types[*]", e, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = identityCreateWebhookToken
+	}
+	v := &identity.CreateWebhookPayload{
+		URL: body.URL,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]string, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = val
+		}
+	}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListWebhooksPayload builds the payload for the identity list_webhooks
+// endpoint from CLI flags.
+func BuildListWebhooksPayload(identityListWebhooksToken string) (*identity.ListWebhooksPayload, error) {
+	var token string
+	{
+		token = identityListWebhooksToken
+	}
+	v := &identity.ListWebhooksPayload{}
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildDeleteWebhookPayload builds the payload for the identity delete_webhook
+// endpoint from CLI flags.
+func BuildDeleteWebhookPayload(identityDeleteWebhookID string, identityDeleteWebhookToken string) (*identity.DeleteWebhookPayload, error) {
+	var err error
+	var id string
+	{
+		id = identityDeleteWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = identityDeleteWebhookToken
+	}
+	v := &identity.DeleteWebhookPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildListWebhookDeliveriesPayload builds the payload for the identity
+// list_webhook_deliveries endpoint from CLI flags.
+func BuildListWebhookDeliveriesPayload(identityListWebhookDeliveriesID string, identityListWebhookDeliveriesLimit string, identityListWebhookDeliveriesToken string) (*identity.ListWebhookDeliveriesPayload, error) {
+	var err error
+	var id string
+	{
+		id = identityListWebhookDeliveriesID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var limit int
+	{
+		if identityListWebhookDeliveriesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(identityListWebhookDeliveriesLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 500 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 500, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var token string
+	{
+		token = identityListWebhookDeliveriesToken
+	}
+	v := &identity.ListWebhookDeliveriesPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildRedeliverWebhookPayload builds the payload for the identity
+// redeliver_webhook endpoint from CLI flags.
+func BuildRedeliverWebhookPayload(identityRedeliverWebhookID string, identityRedeliverWebhookToken string) (*identity.RedeliverWebhookPayload, error) {
+	var err error
+	var id string
+	{
+		id = identityRedeliverWebhookID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = identityRedeliverWebhookToken
+	}
+	v := &identity.RedeliverWebhookPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v, nil
+}
+
+// BuildSetUserStatusPayload builds the payload for the identity
+// set_user_status endpoint from CLI flags.
+func BuildSetUserStatusPayload(identitySetUserStatusBody string, identitySetUserStatusID string, identitySetUserStatusToken string) (*identity.SetUserStatusPayload, error) {
+	var err error
+	var body SetUserStatusRequestBody
+	{
+		err = json.Unmarshal([]byte(identitySetUserStatusBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"reason\": \"8w1\",\n      \"status\": \"active\"\n   }'")
+		}
+		if !(body.Status == "active" || body.Status == "suspended" || body.Status == "deactivated") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", body.Status, []any{"active", "suspended", "deactivated"}))
+		}
+		if body.Reason != nil {
+			if utf8.RuneCountInString(*body.Reason) > 500 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", *body.Reason, utf8.RuneCountInString(*body.Reason), 500, false))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id string
+	{
+		id = identitySetUserStatusID
+		err = goa.MergeErrors(err, goa.ValidateFormat("id", id, goa.FormatUUID))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var token string
+	{
+		token = identitySetUserStatusToken
+	}
+	v := &identity.SetUserStatusPayload{
+		Status: body.Status,
+		Reason: body.Reason,
 	}
+	v.ID = id
+	v.Token = token
 
 	return v, nil
 }
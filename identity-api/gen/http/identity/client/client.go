@@ -24,10 +24,98 @@ type Client struct {
 	// Login Doer is the HTTP client used to make requests to the login endpoint.
 	LoginDoer goahttp.Doer
 
+	// SetUsername Doer is the HTTP client used to make requests to the
+	// set_username endpoint.
+	SetUsernameDoer goahttp.Doer
+
+	// CheckUsername Doer is the HTTP client used to make requests to the
+	// check_username endpoint.
+	CheckUsernameDoer goahttp.Doer
+
+	// RequestMagicLink Doer is the HTTP client used to make requests to the
+	// request_magic_link endpoint.
+	RequestMagicLinkDoer goahttp.Doer
+
+	// ConsumeMagicLink Doer is the HTTP client used to make requests to the
+	// consume_magic_link endpoint.
+	ConsumeMagicLinkDoer goahttp.Doer
+
 	// ValidateToken Doer is the HTTP client used to make requests to the
 	// validate_token endpoint.
 	ValidateTokenDoer goahttp.Doer
 
+	// ValidateTokens Doer is the HTTP client used to make requests to the
+	// validate_tokens endpoint.
+	ValidateTokensDoer goahttp.Doer
+
+	// ListAuthEvents Doer is the HTTP client used to make requests to the
+	// list_auth_events endpoint.
+	ListAuthEventsDoer goahttp.Doer
+
+	// Introspect Doer is the HTTP client used to make requests to the introspect
+	// endpoint.
+	IntrospectDoer goahttp.Doer
+
+	// RevokeToken Doer is the HTTP client used to make requests to the
+	// revoke_token endpoint.
+	RevokeTokenDoer goahttp.Doer
+
+	// ExchangeToken Doer is the HTTP client used to make requests to the
+	// exchange_token endpoint.
+	ExchangeTokenDoer goahttp.Doer
+
+	// InviteUser Doer is the HTTP client used to make requests to the invite_user
+	// endpoint.
+	InviteUserDoer goahttp.Doer
+
+	// ListInvitations Doer is the HTTP client used to make requests to the
+	// list_invitations endpoint.
+	ListInvitationsDoer goahttp.Doer
+
+	// RevokeInvitation Doer is the HTTP client used to make requests to the
+	// revoke_invitation endpoint.
+	RevokeInvitationDoer goahttp.Doer
+
+	// AcceptInvitation Doer is the HTTP client used to make requests to the
+	// accept_invitation endpoint.
+	AcceptInvitationDoer goahttp.Doer
+
+	// DeviceAuthorization Doer is the HTTP client used to make requests to the
+	// device_authorization endpoint.
+	DeviceAuthorizationDoer goahttp.Doer
+
+	// DeviceToken Doer is the HTTP client used to make requests to the
+	// device_token endpoint.
+	DeviceTokenDoer goahttp.Doer
+
+	// ApproveDevice Doer is the HTTP client used to make requests to the
+	// approve_device endpoint.
+	ApproveDeviceDoer goahttp.Doer
+
+	// CreateWebhook Doer is the HTTP client used to make requests to the
+	// create_webhook endpoint.
+	CreateWebhookDoer goahttp.Doer
+
+	// ListWebhooks Doer is the HTTP client used to make requests to the
+	// list_webhooks endpoint.
+	ListWebhooksDoer goahttp.Doer
+
+	// DeleteWebhook Doer is the HTTP client used to make requests to the
+	// delete_webhook endpoint.
+	DeleteWebhookDoer goahttp.Doer
+
+	// ListWebhookDeliveries Doer is the HTTP client used to make requests to the
+	// list_webhook_deliveries endpoint.
+	ListWebhookDeliveriesDoer goahttp.Doer
+
+	// RedeliverWebhook Doer is the HTTP client used to make requests to the
+	// redeliver_webhook endpoint.
+	RedeliverWebhookDoer goahttp.Doer
+
+	// SetUserStatus Doer is the HTTP client used to make requests to the
+	// set_user_status endpoint.
+	SetUserStatusDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -48,14 +136,36 @@ func NewClient(
 	restoreBody bool,
 ) *Client {
 	return &Client{
-		RegisterDoer:        doer,
-		LoginDoer:           doer,
-		ValidateTokenDoer:   doer,
-		RestoreResponseBody: restoreBody,
-		scheme:              scheme,
-		host:                host,
-		decoder:             dec,
-		encoder:             enc,
+		RegisterDoer:              doer,
+		LoginDoer:                 doer,
+		SetUsernameDoer:           doer,
+		CheckUsernameDoer:         doer,
+		RequestMagicLinkDoer:      doer,
+		ConsumeMagicLinkDoer:      doer,
+		ValidateTokenDoer:         doer,
+		ValidateTokensDoer:        doer,
+		ListAuthEventsDoer:        doer,
+		IntrospectDoer:            doer,
+		RevokeTokenDoer:           doer,
+		ExchangeTokenDoer:         doer,
+		InviteUserDoer:            doer,
+		ListInvitationsDoer:       doer,
+		RevokeInvitationDoer:      doer,
+		AcceptInvitationDoer:      doer,
+		DeviceAuthorizationDoer:   doer,
+		DeviceTokenDoer:           doer,
+		ApproveDeviceDoer:         doer,
+		CreateWebhookDoer:         doer,
+		ListWebhooksDoer:          doer,
+		DeleteWebhookDoer:         doer,
+		ListWebhookDeliveriesDoer: doer,
+		RedeliverWebhookDoer:      doer,
+		SetUserStatusDoer:         doer,
+		RestoreResponseBody:       restoreBody,
+		scheme:                    scheme,
+		host:                      host,
+		decoder:                   dec,
+		encoder:                   enc,
 	}
 }
 
@@ -107,6 +217,97 @@ func (c *Client) Login() goa.Endpoint {
 	}
 }
 
+// SetUsername returns an endpoint that makes HTTP requests to the identity
+// service set_username server.
+func (c *Client) SetUsername() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetUsernameRequest(c.encoder)
+		decodeResponse = DecodeSetUsernameResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetUsernameRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetUsernameDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "set_username", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CheckUsername returns an endpoint that makes HTTP requests to the identity
+// service check_username server.
+func (c *Client) CheckUsername() goa.Endpoint {
+	var (
+		decodeResponse = DecodeCheckUsernameResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCheckUsernameRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CheckUsernameDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "check_username", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RequestMagicLink returns an endpoint that makes HTTP requests to the
+// identity service request_magic_link server.
+func (c *Client) RequestMagicLink() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRequestMagicLinkRequest(c.encoder)
+		decodeResponse = DecodeRequestMagicLinkResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRequestMagicLinkRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RequestMagicLinkDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "request_magic_link", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ConsumeMagicLink returns an endpoint that makes HTTP requests to the
+// identity service consume_magic_link server.
+func (c *Client) ConsumeMagicLink() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeConsumeMagicLinkRequest(c.encoder)
+		decodeResponse = DecodeConsumeMagicLinkResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildConsumeMagicLinkRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ConsumeMagicLinkDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "consume_magic_link", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // ValidateToken returns an endpoint that makes HTTP requests to the identity
 // service validate_token server.
 func (c *Client) ValidateToken() goa.Endpoint {
@@ -130,3 +331,435 @@ func (c *Client) ValidateToken() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ValidateTokens returns an endpoint that makes HTTP requests to the identity
+// service validate_tokens server.
+func (c *Client) ValidateTokens() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeValidateTokensRequest(c.encoder)
+		decodeResponse = DecodeValidateTokensResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildValidateTokensRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ValidateTokensDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "validate_tokens", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListAuthEvents returns an endpoint that makes HTTP requests to the identity
+// service list_auth_events server.
+func (c *Client) ListAuthEvents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAuthEventsRequest(c.encoder)
+		decodeResponse = DecodeListAuthEventsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAuthEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAuthEventsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "list_auth_events", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// Introspect returns an endpoint that makes HTTP requests to the identity
+// service introspect server.
+func (c *Client) Introspect() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeIntrospectRequest(c.encoder)
+		decodeResponse = DecodeIntrospectResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildIntrospectRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.IntrospectDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "introspect", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeToken returns an endpoint that makes HTTP requests to the identity
+// service revoke_token server.
+func (c *Client) RevokeToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeTokenRequest(c.encoder)
+		decodeResponse = DecodeRevokeTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "revoke_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ExchangeToken returns an endpoint that makes HTTP requests to the identity
+// service exchange_token server.
+func (c *Client) ExchangeToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExchangeTokenRequest(c.encoder)
+		decodeResponse = DecodeExchangeTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExchangeTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExchangeTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "exchange_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// InviteUser returns an endpoint that makes HTTP requests to the identity
+// service invite_user server.
+func (c *Client) InviteUser() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeInviteUserRequest(c.encoder)
+		decodeResponse = DecodeInviteUserResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildInviteUserRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.InviteUserDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "invite_user", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListInvitations returns an endpoint that makes HTTP requests to the identity
+// service list_invitations server.
+func (c *Client) ListInvitations() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListInvitationsRequest(c.encoder)
+		decodeResponse = DecodeListInvitationsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListInvitationsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListInvitationsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "list_invitations", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RevokeInvitation returns an endpoint that makes HTTP requests to the
+// identity service revoke_invitation server.
+func (c *Client) RevokeInvitation() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRevokeInvitationRequest(c.encoder)
+		decodeResponse = DecodeRevokeInvitationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRevokeInvitationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RevokeInvitationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "revoke_invitation", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AcceptInvitation returns an endpoint that makes HTTP requests to the
+// identity service accept_invitation server.
+func (c *Client) AcceptInvitation() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAcceptInvitationRequest(c.encoder)
+		decodeResponse = DecodeAcceptInvitationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAcceptInvitationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AcceptInvitationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "accept_invitation", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeviceAuthorization returns an endpoint that makes HTTP requests to the
+// identity service device_authorization server.
+func (c *Client) DeviceAuthorization() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeviceAuthorizationRequest(c.encoder)
+		decodeResponse = DecodeDeviceAuthorizationResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeviceAuthorizationRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeviceAuthorizationDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "device_authorization", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeviceToken returns an endpoint that makes HTTP requests to the identity
+// service device_token server.
+func (c *Client) DeviceToken() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeviceTokenRequest(c.encoder)
+		decodeResponse = DecodeDeviceTokenResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeviceTokenRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeviceTokenDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "device_token", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ApproveDevice returns an endpoint that makes HTTP requests to the identity
+// service approve_device server.
+func (c *Client) ApproveDevice() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeApproveDeviceRequest(c.encoder)
+		decodeResponse = DecodeApproveDeviceResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildApproveDeviceRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ApproveDeviceDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "approve_device", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateWebhook returns an endpoint that makes HTTP requests to the identity
+// service create_webhook server.
+func (c *Client) CreateWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateWebhookRequest(c.encoder)
+		decodeResponse = DecodeCreateWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "create_webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhooks returns an endpoint that makes HTTP requests to the identity
+// service list_webhooks server.
+func (c *Client) ListWebhooks() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhooksRequest(c.encoder)
+		decodeResponse = DecodeListWebhooksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhooksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhooksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "list_webhooks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteWebhook returns an endpoint that makes HTTP requests to the identity
+// service delete_webhook server.
+func (c *Client) DeleteWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteWebhookRequest(c.encoder)
+		decodeResponse = DecodeDeleteWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "delete_webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListWebhookDeliveries returns an endpoint that makes HTTP requests to the
+// identity service list_webhook_deliveries server.
+func (c *Client) ListWebhookDeliveries() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListWebhookDeliveriesRequest(c.encoder)
+		decodeResponse = DecodeListWebhookDeliveriesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListWebhookDeliveriesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListWebhookDeliveriesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "list_webhook_deliveries", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RedeliverWebhook returns an endpoint that makes HTTP requests to the
+// identity service redeliver_webhook server.
+func (c *Client) RedeliverWebhook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRedeliverWebhookRequest(c.encoder)
+		decodeResponse = DecodeRedeliverWebhookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRedeliverWebhookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RedeliverWebhookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "redeliver_webhook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// SetUserStatus returns an endpoint that makes HTTP requests to the identity
+// service set_user_status server.
+func (c *Client) SetUserStatus() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSetUserStatusRequest(c.encoder)
+		decodeResponse = DecodeSetUserStatusResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSetUserStatusRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SetUserStatusDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("identity", "set_user_status", err)
+		}
+		return decodeResponse(resp)
+	}
+}
@@ -10,6 +10,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
@@ -53,6 +54,12 @@ func EncodeRegisterRequest(encoder func(*http.Request) goahttp.Encoder) func(*ht
 // DecodeRegisterResponse returns a decoder for responses returned by the
 // identity register endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
+// DecodeRegisterResponse may return the following errors:
+//   - "conflict" (type *identity.ConflictError): http.StatusConflict
+//   - "invalid_username" (type *identity.InvalidUsernameError): http.StatusBadRequest
+//   - "password_policy" (type *identity.PasswordPolicyError): http.StatusBadRequest
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusForbidden
+//   - error: internal error
 func DecodeRegisterResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
@@ -85,6 +92,69 @@ func DecodeRegisterResponse(decoder func(*http.Response) goahttp.Decoder, restor
 			}
 			res := identity.NewUser(vres)
 			return res, nil
+		case http.StatusConflict:
+			var (
+				body RegisterConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "register", err)
+			}
+			err = ValidateRegisterConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "register", err)
+			}
+			return nil, NewRegisterConflict(&body)
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invalid_username":
+				var (
+					body RegisterInvalidUsernameResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "register", err)
+				}
+				err = ValidateRegisterInvalidUsernameResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "register", err)
+				}
+				return nil, NewRegisterInvalidUsername(&body)
+			case "password_policy":
+				var (
+					body RegisterPasswordPolicyResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "register", err)
+				}
+				err = ValidateRegisterPasswordPolicyResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "register", err)
+				}
+				return nil, NewRegisterPasswordPolicy(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("identity", "register", resp.StatusCode, string(body))
+			}
+		case http.StatusForbidden:
+			var (
+				body RegisterUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "register", err)
+			}
+			err = ValidateRegisterUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "register", err)
+			}
+			return nil, NewRegisterUnauthorized(&body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("identity", "register", resp.StatusCode, string(body))
@@ -111,9 +181,9 @@ func (c *Client) BuildLoginRequest(ctx context.Context, v any) (*http.Request, e
 // login server.
 func EncodeLoginRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*identity.Credentials)
+		p, ok := v.(*identity.LoginPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("identity", "login", "*identity.Credentials", v)
+			return goahttp.ErrInvalidType("identity", "login", "*identity.LoginPayload", v)
 		}
 		body := NewLoginRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
@@ -163,6 +233,358 @@ func DecodeLoginResponse(decoder func(*http.Response) goahttp.Decoder, restoreBo
 	}
 }
 
+// BuildSetUsernameRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "set_username" endpoint
+func (c *Client) BuildSetUsernameRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetUsernameIdentityPath()}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "set_username", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetUsernameRequest returns an encoder for requests sent to the
+// identity set_username server.
+func EncodeSetUsernameRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.SetUsernamePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "set_username", "*identity.SetUsernamePayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewSetUsernameRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "set_username", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetUsernameResponse returns a decoder for responses returned by the
+// identity set_username endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeSetUsernameResponse may return the following errors:
+//   - "conflict" (type *identity.ConflictError): http.StatusConflict
+//   - "invalid_username" (type *identity.InvalidUsernameError): http.StatusBadRequest
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSetUsernameResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetUsernameResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "set_username", err)
+			}
+			p := NewSetUsernameUserOK(&body)
+			view := "default"
+			vres := &identityviews.User{Projected: p, View: view}
+			if err = identityviews.ValidateUser(vres); err != nil {
+				return nil, goahttp.ErrValidationError("identity", "set_username", err)
+			}
+			res := identity.NewUser(vres)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body SetUsernameConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "set_username", err)
+			}
+			err = ValidateSetUsernameConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "set_username", err)
+			}
+			return nil, NewSetUsernameConflict(&body)
+		case http.StatusBadRequest:
+			var (
+				body SetUsernameInvalidUsernameResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "set_username", err)
+			}
+			err = ValidateSetUsernameInvalidUsernameResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "set_username", err)
+			}
+			return nil, NewSetUsernameInvalidUsername(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetUsernameUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "set_username", err)
+			}
+			err = ValidateSetUsernameUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "set_username", err)
+			}
+			return nil, NewSetUsernameUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "set_username", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCheckUsernameRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "check_username" endpoint
+func (c *Client) BuildCheckUsernameRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		username string
+	)
+	{
+		p, ok := v.(*identity.CheckUsernamePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("identity", "check_username", "*identity.CheckUsernamePayload", v)
+		}
+		username = p.Username
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CheckUsernameIdentityPath(username)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "check_username", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeCheckUsernameResponse returns a decoder for responses returned by the
+// identity check_username endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeCheckUsernameResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body CheckUsernameResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "check_username", err)
+			}
+			err = ValidateCheckUsernameResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "check_username", err)
+			}
+			res := NewCheckUsernameUsernameAvailabilityOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "check_username", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRequestMagicLinkRequest instantiates a HTTP request object with method
+// and path set to call the "identity" service "request_magic_link" endpoint
+func (c *Client) BuildRequestMagicLinkRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RequestMagicLinkIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "request_magic_link", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRequestMagicLinkRequest returns an encoder for requests sent to the
+// identity request_magic_link server.
+func EncodeRequestMagicLinkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.RequestMagicLinkPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "request_magic_link", "*identity.RequestMagicLinkPayload", v)
+		}
+		body := NewRequestMagicLinkRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "request_magic_link", err)
+		}
+		return nil
+	}
+}
+
+// DecodeRequestMagicLinkResponse returns a decoder for responses returned by
+// the identity request_magic_link endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRequestMagicLinkResponse may return the following errors:
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusForbidden
+//   - error: internal error
+func DecodeRequestMagicLinkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			return nil, nil
+		case http.StatusForbidden:
+			var (
+				body RequestMagicLinkUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "request_magic_link", err)
+			}
+			err = ValidateRequestMagicLinkUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "request_magic_link", err)
+			}
+			return nil, NewRequestMagicLinkUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "request_magic_link", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildConsumeMagicLinkRequest instantiates a HTTP request object with method
+// and path set to call the "identity" service "consume_magic_link" endpoint
+func (c *Client) BuildConsumeMagicLinkRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ConsumeMagicLinkIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "consume_magic_link", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeConsumeMagicLinkRequest returns an encoder for requests sent to the
+// identity consume_magic_link server.
+func EncodeConsumeMagicLinkRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.ConsumeMagicLinkPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "consume_magic_link", "*identity.ConsumeMagicLinkPayload", v)
+		}
+		body := NewConsumeMagicLinkRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "consume_magic_link", err)
+		}
+		return nil
+	}
+}
+
+// DecodeConsumeMagicLinkResponse returns a decoder for responses returned by
+// the identity consume_magic_link endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeConsumeMagicLinkResponse may return the following errors:
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeConsumeMagicLinkResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ConsumeMagicLinkResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "consume_magic_link", err)
+			}
+			err = ValidateConsumeMagicLinkResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "consume_magic_link", err)
+			}
+			res := NewConsumeMagicLinkTokenResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ConsumeMagicLinkUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "consume_magic_link", err)
+			}
+			err = ValidateConsumeMagicLinkUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "consume_magic_link", err)
+			}
+			return nil, NewConsumeMagicLinkUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "consume_magic_link", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildValidateTokenRequest instantiates a HTTP request object with method and
 // path set to call the "identity" service "validate_token" endpoint
 func (c *Client) BuildValidateTokenRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -233,3 +655,2041 @@ func DecodeValidateTokenResponse(decoder func(*http.Response) goahttp.Decoder, r
 		}
 	}
 }
+
+// BuildValidateTokensRequest instantiates a HTTP request object with method
+// and path set to call the "identity" service "validate_tokens" endpoint
+func (c *Client) BuildValidateTokensRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ValidateTokensIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "validate_tokens", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeValidateTokensRequest returns an encoder for requests sent to the
+// identity validate_tokens server.
+func EncodeValidateTokensRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.ValidateTokensPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "validate_tokens", "*identity.ValidateTokensPayload", v)
+		}
+		body := NewValidateTokensRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "validate_tokens", err)
+		}
+		return nil
+	}
+}
+
+// DecodeValidateTokensResponse returns a decoder for responses returned by the
+// identity validate_tokens endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeValidateTokensResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ValidateTokensResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "validate_tokens", err)
+			}
+			err = ValidateValidateTokensResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "validate_tokens", err)
+			}
+			res := NewValidateTokensValidationResultsCollectionOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "validate_tokens", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListAuthEventsRequest instantiates a HTTP request object with method
+// and path set to call the "identity" service "list_auth_events" endpoint
+func (c *Client) BuildListAuthEventsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAuthEventsIdentityPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "list_auth_events", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListAuthEventsRequest returns an encoder for requests sent to the
+// identity list_auth_events server.
+func EncodeListAuthEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.ListAuthEventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "list_auth_events", "*identity.ListAuthEventsPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		if p.UserID != nil {
+			values.Add("user_id", *p.UserID)
+		}
+		if p.Type != nil {
+			values.Add("type", *p.Type)
+		}
+		if p.Since != nil {
+			values.Add("since", *p.Since)
+		}
+		if p.Until != nil {
+			values.Add("until", *p.Until)
+		}
+		if p.BeforeID != nil {
+			values.Add("before_id", fmt.Sprintf("%v", *p.BeforeID))
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListAuthEventsResponse returns a decoder for responses returned by the
+// identity list_auth_events endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListAuthEventsResponse may return the following errors:
+//   - "bad_request" (type *identity.BadRequestError): http.StatusBadRequest
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListAuthEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListAuthEventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_auth_events", err)
+			}
+			err = ValidateListAuthEventsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_auth_events", err)
+			}
+			res := NewListAuthEventsAuthEventsCollectionOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListAuthEventsBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_auth_events", err)
+			}
+			err = ValidateListAuthEventsBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_auth_events", err)
+			}
+			return nil, NewListAuthEventsBadRequest(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListAuthEventsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_auth_events", err)
+			}
+			err = ValidateListAuthEventsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_auth_events", err)
+			}
+			return nil, NewListAuthEventsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "list_auth_events", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildIntrospectRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "introspect" endpoint
+func (c *Client) BuildIntrospectRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: IntrospectIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "introspect", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeIntrospectRequest returns an encoder for requests sent to the identity
+// introspect server.
+func EncodeIntrospectRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.IntrospectPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "introspect", "*identity.IntrospectPayload", v)
+		}
+		body := p
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "introspect", err)
+		}
+		req.SetBasicAuth(p.ClientID, p.ClientSecret)
+		return nil
+	}
+}
+
+// DecodeIntrospectResponse returns a decoder for responses returned by the
+// identity introspect endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeIntrospectResponse may return the following errors:
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeIntrospectResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body IntrospectResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "introspect", err)
+			}
+			err = ValidateIntrospectResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "introspect", err)
+			}
+			res := NewIntrospectionResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body IntrospectUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "introspect", err)
+			}
+			err = ValidateIntrospectUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "introspect", err)
+			}
+			return nil, NewIntrospectUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "introspect", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeTokenRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "revoke_token" endpoint
+func (c *Client) BuildRevokeTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeTokenIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "revoke_token", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeTokenRequest returns an encoder for requests sent to the
+// identity revoke_token server.
+func EncodeRevokeTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.RevokeTokenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "revoke_token", "*identity.RevokeTokenPayload", v)
+		}
+		body := p
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "revoke_token", err)
+		}
+		req.SetBasicAuth(p.ClientID, p.ClientSecret)
+		return nil
+	}
+}
+
+// DecodeRevokeTokenResponse returns a decoder for responses returned by the
+// identity revoke_token endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeRevokeTokenResponse may return the following errors:
+//   - "unsupported_token_type" (type *identity.OAuthError): http.StatusBadRequest
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRevokeTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body RevokeTokenUnsupportedTokenTypeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "revoke_token", err)
+			}
+			err = ValidateRevokeTokenUnsupportedTokenTypeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "revoke_token", err)
+			}
+			return nil, NewRevokeTokenUnsupportedTokenType(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RevokeTokenUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "revoke_token", err)
+			}
+			err = ValidateRevokeTokenUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "revoke_token", err)
+			}
+			return nil, NewRevokeTokenUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "revoke_token", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildExchangeTokenRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "exchange_token" endpoint
+func (c *Client) BuildExchangeTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExchangeTokenIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "exchange_token", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeExchangeTokenRequest returns an encoder for requests sent to the
+// identity exchange_token server.
+func EncodeExchangeTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.TokenExchangePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "exchange_token", "*identity.TokenExchangePayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewExchangeTokenRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "exchange_token", err)
+		}
+		return nil
+	}
+}
+
+// DecodeExchangeTokenResponse returns a decoder for responses returned by the
+// identity exchange_token endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeExchangeTokenResponse may return the following errors:
+//   - "not_found" (type *identity.NotFoundError): http.StatusNotFound
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeExchangeTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ExchangeTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "exchange_token", err)
+			}
+			err = ValidateExchangeTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "exchange_token", err)
+			}
+			res := NewExchangeTokenTokenExchangeResultOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ExchangeTokenNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "exchange_token", err)
+			}
+			err = ValidateExchangeTokenNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "exchange_token", err)
+			}
+			return nil, NewExchangeTokenNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ExchangeTokenUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "exchange_token", err)
+			}
+			err = ValidateExchangeTokenUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "exchange_token", err)
+			}
+			return nil, NewExchangeTokenUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "exchange_token", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildInviteUserRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "invite_user" endpoint
+func (c *Client) BuildInviteUserRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: InviteUserIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "invite_user", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeInviteUserRequest returns an encoder for requests sent to the identity
+// invite_user server.
+func EncodeInviteUserRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.InviteUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "invite_user", "*identity.InviteUserPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewInviteUserRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "invite_user", err)
+		}
+		return nil
+	}
+}
+
+// DecodeInviteUserResponse returns a decoder for responses returned by the
+// identity invite_user endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeInviteUserResponse may return the following errors:
+//   - "conflict" (type *identity.ConflictError): http.StatusConflict
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeInviteUserResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body InviteUserResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "invite_user", err)
+			}
+			err = ValidateInviteUserResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "invite_user", err)
+			}
+			res := NewInviteUserInvitationCreated(&body)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body InviteUserConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "invite_user", err)
+			}
+			err = ValidateInviteUserConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "invite_user", err)
+			}
+			return nil, NewInviteUserConflict(&body)
+		case http.StatusUnauthorized:
+			var (
+				body InviteUserUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "invite_user", err)
+			}
+			err = ValidateInviteUserUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "invite_user", err)
+			}
+			return nil, NewInviteUserUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "invite_user", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListInvitationsRequest instantiates a HTTP request object with method
+// and path set to call the "identity" service "list_invitations" endpoint
+func (c *Client) BuildListInvitationsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListInvitationsIdentityPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "list_invitations", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListInvitationsRequest returns an encoder for requests sent to the
+// identity list_invitations server.
+func EncodeListInvitationsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.ListInvitationsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "list_invitations", "*identity.ListInvitationsPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeListInvitationsResponse returns a decoder for responses returned by
+// the identity list_invitations endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListInvitationsResponse may return the following errors:
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListInvitationsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListInvitationsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_invitations", err)
+			}
+			err = ValidateListInvitationsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_invitations", err)
+			}
+			res := NewListInvitationsInvitationsCollectionOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListInvitationsUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_invitations", err)
+			}
+			err = ValidateListInvitationsUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_invitations", err)
+			}
+			return nil, NewListInvitationsUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "list_invitations", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRevokeInvitationRequest instantiates a HTTP request object with method
+// and path set to call the "identity" service "revoke_invitation" endpoint
+func (c *Client) BuildRevokeInvitationRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*identity.RevokeInvitationPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("identity", "revoke_invitation", "*identity.RevokeInvitationPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RevokeInvitationIdentityPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "revoke_invitation", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRevokeInvitationRequest returns an encoder for requests sent to the
+// identity revoke_invitation server.
+func EncodeRevokeInvitationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.RevokeInvitationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "revoke_invitation", "*identity.RevokeInvitationPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeRevokeInvitationResponse returns a decoder for responses returned by
+// the identity revoke_invitation endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRevokeInvitationResponse may return the following errors:
+//   - "not_found" (type *identity.NotFoundError): http.StatusNotFound
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRevokeInvitationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body RevokeInvitationNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "revoke_invitation", err)
+			}
+			err = ValidateRevokeInvitationNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "revoke_invitation", err)
+			}
+			return nil, NewRevokeInvitationNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RevokeInvitationUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "revoke_invitation", err)
+			}
+			err = ValidateRevokeInvitationUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "revoke_invitation", err)
+			}
+			return nil, NewRevokeInvitationUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "revoke_invitation", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAcceptInvitationRequest instantiates a HTTP request object with method
+// and path set to call the "identity" service "accept_invitation" endpoint
+func (c *Client) BuildAcceptInvitationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AcceptInvitationIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "accept_invitation", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAcceptInvitationRequest returns an encoder for requests sent to the
+// identity accept_invitation server.
+func EncodeAcceptInvitationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.AcceptInvitationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "accept_invitation", "*identity.AcceptInvitationPayload", v)
+		}
+		body := NewAcceptInvitationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "accept_invitation", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAcceptInvitationResponse returns a decoder for responses returned by
+// the identity accept_invitation endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeAcceptInvitationResponse may return the following errors:
+//   - "conflict" (type *identity.ConflictError): http.StatusConflict
+//   - "password_policy" (type *identity.PasswordPolicyError): http.StatusBadRequest
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeAcceptInvitationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body AcceptInvitationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "accept_invitation", err)
+			}
+			p := NewAcceptInvitationUserCreated(&body)
+			view := "default"
+			vres := &identityviews.User{Projected: p, View: view}
+			if err = identityviews.ValidateUser(vres); err != nil {
+				return nil, goahttp.ErrValidationError("identity", "accept_invitation", err)
+			}
+			res := identity.NewUser(vres)
+			return res, nil
+		case http.StatusConflict:
+			var (
+				body AcceptInvitationConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "accept_invitation", err)
+			}
+			err = ValidateAcceptInvitationConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "accept_invitation", err)
+			}
+			return nil, NewAcceptInvitationConflict(&body)
+		case http.StatusBadRequest:
+			var (
+				body AcceptInvitationPasswordPolicyResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "accept_invitation", err)
+			}
+			err = ValidateAcceptInvitationPasswordPolicyResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "accept_invitation", err)
+			}
+			return nil, NewAcceptInvitationPasswordPolicy(&body)
+		case http.StatusUnauthorized:
+			var (
+				body AcceptInvitationUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "accept_invitation", err)
+			}
+			err = ValidateAcceptInvitationUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "accept_invitation", err)
+			}
+			return nil, NewAcceptInvitationUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "accept_invitation", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeviceAuthorizationRequest instantiates a HTTP request object with
+// method and path set to call the "identity" service "device_authorization"
+// endpoint
+func (c *Client) BuildDeviceAuthorizationRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeviceAuthorizationIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "device_authorization", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeviceAuthorizationRequest returns an encoder for requests sent to the
+// identity device_authorization server.
+func EncodeDeviceAuthorizationRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.DeviceAuthorizationPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "device_authorization", "*identity.DeviceAuthorizationPayload", v)
+		}
+		body := NewDeviceAuthorizationRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "device_authorization", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDeviceAuthorizationResponse returns a decoder for responses returned
+// by the identity device_authorization endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeDeviceAuthorizationResponse may return the following errors:
+//   - "invalid_client" (type *identity.OAuthError): http.StatusUnauthorized
+//   - "invalid_scope" (type *identity.OAuthError): http.StatusBadRequest
+//   - "invalid_target" (type *identity.OAuthError): http.StatusBadRequest
+//   - error: internal error
+func DecodeDeviceAuthorizationResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeviceAuthorizationResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "device_authorization", err)
+			}
+			err = ValidateDeviceAuthorizationResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "device_authorization", err)
+			}
+			res := NewDeviceAuthorizationResultOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body DeviceAuthorizationInvalidClientResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "device_authorization", err)
+			}
+			err = ValidateDeviceAuthorizationInvalidClientResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "device_authorization", err)
+			}
+			return nil, NewDeviceAuthorizationInvalidClient(&body)
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "invalid_scope":
+				var (
+					body DeviceAuthorizationInvalidScopeResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "device_authorization", err)
+				}
+				err = ValidateDeviceAuthorizationInvalidScopeResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "device_authorization", err)
+				}
+				return nil, NewDeviceAuthorizationInvalidScope(&body)
+			case "invalid_target":
+				var (
+					body DeviceAuthorizationInvalidTargetResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "device_authorization", err)
+				}
+				err = ValidateDeviceAuthorizationInvalidTargetResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "device_authorization", err)
+				}
+				return nil, NewDeviceAuthorizationInvalidTarget(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("identity", "device_authorization", resp.StatusCode, string(body))
+			}
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "device_authorization", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeviceTokenRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "device_token" endpoint
+func (c *Client) BuildDeviceTokenRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeviceTokenIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "device_token", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeviceTokenRequest returns an encoder for requests sent to the
+// identity device_token server.
+func EncodeDeviceTokenRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.DeviceTokenPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "device_token", "*identity.DeviceTokenPayload", v)
+		}
+		body := NewDeviceTokenRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "device_token", err)
+		}
+		return nil
+	}
+}
+
+// DecodeDeviceTokenResponse returns a decoder for responses returned by the
+// identity device_token endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeviceTokenResponse may return the following errors:
+//   - "authorization_pending" (type *identity.OAuthError): http.StatusBadRequest
+//   - "slow_down" (type *identity.OAuthError): http.StatusBadRequest
+//   - "access_denied" (type *identity.OAuthError): http.StatusBadRequest
+//   - "expired_token" (type *identity.OAuthError): http.StatusBadRequest
+//   - "invalid_grant" (type *identity.OAuthError): http.StatusBadRequest
+//   - error: internal error
+func DecodeDeviceTokenResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeviceTokenResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "device_token", err)
+			}
+			err = ValidateDeviceTokenResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "device_token", err)
+			}
+			res := NewDeviceTokenResultOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "authorization_pending":
+				var (
+					body DeviceTokenAuthorizationPendingResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "device_token", err)
+				}
+				err = ValidateDeviceTokenAuthorizationPendingResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "device_token", err)
+				}
+				return nil, NewDeviceTokenAuthorizationPending(&body)
+			case "slow_down":
+				var (
+					body DeviceTokenSlowDownResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "device_token", err)
+				}
+				err = ValidateDeviceTokenSlowDownResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "device_token", err)
+				}
+				return nil, NewDeviceTokenSlowDown(&body)
+			case "access_denied":
+				var (
+					body DeviceTokenAccessDeniedResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "device_token", err)
+				}
+				err = ValidateDeviceTokenAccessDeniedResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "device_token", err)
+				}
+				return nil, NewDeviceTokenAccessDenied(&body)
+			case "expired_token":
+				var (
+					body DeviceTokenExpiredTokenResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "device_token", err)
+				}
+				err = ValidateDeviceTokenExpiredTokenResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "device_token", err)
+				}
+				return nil, NewDeviceTokenExpiredToken(&body)
+			case "invalid_grant":
+				var (
+					body DeviceTokenInvalidGrantResponseBody
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("identity", "device_token", err)
+				}
+				err = ValidateDeviceTokenInvalidGrantResponseBody(&body)
+				if err != nil {
+					return nil, goahttp.ErrValidationError("identity", "device_token", err)
+				}
+				return nil, NewDeviceTokenInvalidGrant(&body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("identity", "device_token", resp.StatusCode, string(body))
+			}
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "device_token", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildApproveDeviceRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "approve_device" endpoint
+func (c *Client) BuildApproveDeviceRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ApproveDeviceIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "approve_device", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeApproveDeviceRequest returns an encoder for requests sent to the
+// identity approve_device server.
+func EncodeApproveDeviceRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.ApproveDevicePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "approve_device", "*identity.ApproveDevicePayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewApproveDeviceRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "approve_device", err)
+		}
+		return nil
+	}
+}
+
+// DecodeApproveDeviceResponse returns a decoder for responses returned by the
+// identity approve_device endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeApproveDeviceResponse may return the following errors:
+//   - "not_found" (type *identity.NotFoundError): http.StatusNotFound
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeApproveDeviceResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body ApproveDeviceNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "approve_device", err)
+			}
+			err = ValidateApproveDeviceNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "approve_device", err)
+			}
+			return nil, NewApproveDeviceNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ApproveDeviceUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "approve_device", err)
+			}
+			err = ValidateApproveDeviceUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "approve_device", err)
+			}
+			return nil, NewApproveDeviceUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "approve_device", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "create_webhook" endpoint
+func (c *Client) BuildCreateWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateWebhookIdentityPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "create_webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateWebhookRequest returns an encoder for requests sent to the
+// identity create_webhook server.
+func EncodeCreateWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.CreateWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "create_webhook", "*identity.CreateWebhookPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewCreateWebhookRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "create_webhook", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateWebhookResponse returns a decoder for responses returned by the
+// identity create_webhook endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateWebhookResponse may return the following errors:
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "create_webhook", err)
+			}
+			err = ValidateCreateWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "create_webhook", err)
+			}
+			res := NewCreateWebhookWebhookSubscriptionCreated(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body CreateWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "create_webhook", err)
+			}
+			err = ValidateCreateWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "create_webhook", err)
+			}
+			return nil, NewCreateWebhookUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "create_webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhooksRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "list_webhooks" endpoint
+func (c *Client) BuildListWebhooksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhooksIdentityPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "list_webhooks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhooksRequest returns an encoder for requests sent to the
+// identity list_webhooks server.
+func EncodeListWebhooksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.ListWebhooksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "list_webhooks", "*identity.ListWebhooksPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeListWebhooksResponse returns a decoder for responses returned by the
+// identity list_webhooks endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeListWebhooksResponse may return the following errors:
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListWebhooksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhooksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_webhooks", err)
+			}
+			err = ValidateListWebhooksResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_webhooks", err)
+			}
+			res := NewListWebhooksWebhookSubscriptionsCollectionOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body ListWebhooksUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_webhooks", err)
+			}
+			err = ValidateListWebhooksUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_webhooks", err)
+			}
+			return nil, NewListWebhooksUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "list_webhooks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteWebhookRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "delete_webhook" endpoint
+func (c *Client) BuildDeleteWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*identity.DeleteWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("identity", "delete_webhook", "*identity.DeleteWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteWebhookIdentityPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "delete_webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteWebhookRequest returns an encoder for requests sent to the
+// identity delete_webhook server.
+func EncodeDeleteWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.DeleteWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "delete_webhook", "*identity.DeleteWebhookPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteWebhookResponse returns a decoder for responses returned by the
+// identity delete_webhook endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeleteWebhookResponse may return the following errors:
+//   - "not_found" (type *identity.NotFoundError): http.StatusNotFound
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusNotFound:
+			var (
+				body DeleteWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "delete_webhook", err)
+			}
+			err = ValidateDeleteWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "delete_webhook", err)
+			}
+			return nil, NewDeleteWebhookNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "delete_webhook", err)
+			}
+			err = ValidateDeleteWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "delete_webhook", err)
+			}
+			return nil, NewDeleteWebhookUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "delete_webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListWebhookDeliveriesRequest instantiates a HTTP request object with
+// method and path set to call the "identity" service "list_webhook_deliveries"
+// endpoint
+func (c *Client) BuildListWebhookDeliveriesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*identity.ListWebhookDeliveriesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("identity", "list_webhook_deliveries", "*identity.ListWebhookDeliveriesPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListWebhookDeliveriesIdentityPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "list_webhook_deliveries", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListWebhookDeliveriesRequest returns an encoder for requests sent to
+// the identity list_webhook_deliveries server.
+func EncodeListWebhookDeliveriesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.ListWebhookDeliveriesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "list_webhook_deliveries", "*identity.ListWebhookDeliveriesPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListWebhookDeliveriesResponse returns a decoder for responses returned
+// by the identity list_webhook_deliveries endpoint. restoreBody controls
+// whether the response body should be restored after having been read.
+// DecodeListWebhookDeliveriesResponse may return the following errors:
+//   - "not_found" (type *identity.NotFoundError): http.StatusNotFound
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListWebhookDeliveriesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListWebhookDeliveriesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_webhook_deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_webhook_deliveries", err)
+			}
+			res := NewListWebhookDeliveriesWebhookDeliveriesCollectionOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body ListWebhookDeliveriesNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_webhook_deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_webhook_deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListWebhookDeliveriesUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "list_webhook_deliveries", err)
+			}
+			err = ValidateListWebhookDeliveriesUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "list_webhook_deliveries", err)
+			}
+			return nil, NewListWebhookDeliveriesUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "list_webhook_deliveries", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRedeliverWebhookRequest instantiates a HTTP request object with method
+// and path set to call the "identity" service "redeliver_webhook" endpoint
+func (c *Client) BuildRedeliverWebhookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*identity.RedeliverWebhookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("identity", "redeliver_webhook", "*identity.RedeliverWebhookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RedeliverWebhookIdentityPath(id)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "redeliver_webhook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRedeliverWebhookRequest returns an encoder for requests sent to the
+// identity redeliver_webhook server.
+func EncodeRedeliverWebhookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.RedeliverWebhookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "redeliver_webhook", "*identity.RedeliverWebhookPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeRedeliverWebhookResponse returns a decoder for responses returned by
+// the identity redeliver_webhook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRedeliverWebhookResponse may return the following errors:
+//   - "not_found" (type *identity.NotFoundError): http.StatusNotFound
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRedeliverWebhookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body RedeliverWebhookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "redeliver_webhook", err)
+			}
+			err = ValidateRedeliverWebhookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "redeliver_webhook", err)
+			}
+			res := NewRedeliverWebhookWebhookDeliveryAccepted(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body RedeliverWebhookNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "redeliver_webhook", err)
+			}
+			err = ValidateRedeliverWebhookNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "redeliver_webhook", err)
+			}
+			return nil, NewRedeliverWebhookNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body RedeliverWebhookUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "redeliver_webhook", err)
+			}
+			err = ValidateRedeliverWebhookUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "redeliver_webhook", err)
+			}
+			return nil, NewRedeliverWebhookUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "redeliver_webhook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSetUserStatusRequest instantiates a HTTP request object with method and
+// path set to call the "identity" service "set_user_status" endpoint
+func (c *Client) BuildSetUserStatusRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*identity.SetUserStatusPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("identity", "set_user_status", "*identity.SetUserStatusPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SetUserStatusIdentityPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("identity", "set_user_status", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSetUserStatusRequest returns an encoder for requests sent to the
+// identity set_user_status server.
+func EncodeSetUserStatusRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*identity.SetUserStatusPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("identity", "set_user_status", "*identity.SetUserStatusPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewSetUserStatusRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("identity", "set_user_status", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSetUserStatusResponse returns a decoder for responses returned by the
+// identity set_user_status endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeSetUserStatusResponse may return the following errors:
+//   - "not_found" (type *identity.NotFoundError): http.StatusNotFound
+//   - "unauthorized" (type *identity.UnauthorizedError): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSetUserStatusResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body SetUserStatusResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "set_user_status", err)
+			}
+			p := NewSetUserStatusUserOK(&body)
+			view := "default"
+			vres := &identityviews.User{Projected: p, View: view}
+			if err = identityviews.ValidateUser(vres); err != nil {
+				return nil, goahttp.ErrValidationError("identity", "set_user_status", err)
+			}
+			res := identity.NewUser(vres)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body SetUserStatusNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "set_user_status", err)
+			}
+			err = ValidateSetUserStatusNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "set_user_status", err)
+			}
+			return nil, NewSetUserStatusNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body SetUserStatusUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("identity", "set_user_status", err)
+			}
+			err = ValidateSetUserStatusUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("identity", "set_user_status", err)
+			}
+			return nil, NewSetUserStatusUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("identity", "set_user_status", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalPolicyViolationResponseBodyToIdentityPolicyViolation builds a value
+// of type *identity.PolicyViolation from a value of type
+// *PolicyViolationResponseBody.
+func unmarshalPolicyViolationResponseBodyToIdentityPolicyViolation(v *PolicyViolationResponseBody) *identity.PolicyViolation {
+	res := &identity.PolicyViolation{
+		Rule:    *v.Rule,
+		Message: *v.Message,
+	}
+
+	return res
+}
+
+// unmarshalActorResponseBodyToIdentityActor builds a value of type
+// *identity.Actor from a value of type *ActorResponseBody.
+func unmarshalActorResponseBodyToIdentityActor(v *ActorResponseBody) *identity.Actor {
+	if v == nil {
+		return nil
+	}
+	res := &identity.Actor{
+		UserID: *v.UserID,
+		Email:  v.Email,
+	}
+
+	return res
+}
+
+// unmarshalValidationResultResponseBodyToIdentityValidationResult builds a
+// value of type *identity.ValidationResult from a value of type
+// *ValidationResultResponseBody.
+func unmarshalValidationResultResponseBodyToIdentityValidationResult(v *ValidationResultResponseBody) *identity.ValidationResult {
+	res := &identity.ValidationResult{
+		Valid:  *v.Valid,
+		UserID: v.UserID,
+		Email:  v.Email,
+		Reason: v.Reason,
+	}
+	if v.Actor != nil {
+		res.Actor = unmarshalActorResponseBodyToIdentityActor(v.Actor)
+	}
+	if v.Audience != nil {
+		res.Audience = make([]string, len(v.Audience))
+		for i, val := range v.Audience {
+			res.Audience[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalAuthEventResponseBodyToIdentityAuthEvent builds a value of type
+// *identity.AuthEvent from a value of type *AuthEventResponseBody.
+func unmarshalAuthEventResponseBodyToIdentityAuthEvent(v *AuthEventResponseBody) *identity.AuthEvent {
+	res := &identity.AuthEvent{
+		ID:        *v.ID,
+		Type:      *v.Type,
+		Success:   *v.Success,
+		UserID:    v.UserID,
+		Email:     v.Email,
+		Reason:    v.Reason,
+		IPAddress: v.IPAddress,
+		UserAgent: v.UserAgent,
+		RequestID: v.RequestID,
+		CreatedAt: *v.CreatedAt,
+	}
+
+	return res
+}
+
+// unmarshalInvitationResponseBodyToIdentityInvitation builds a value of type
+// *identity.Invitation from a value of type *InvitationResponseBody.
+func unmarshalInvitationResponseBodyToIdentityInvitation(v *InvitationResponseBody) *identity.Invitation {
+	res := &identity.Invitation{
+		ID:          *v.ID,
+		Email:       *v.Email,
+		DisplayName: v.DisplayName,
+		InvitedBy:   *v.InvitedBy,
+		Status:      *v.Status,
+		CreatedAt:   *v.CreatedAt,
+		ExpiresAt:   *v.ExpiresAt,
+	}
+	if v.Attributes != nil {
+		res.Attributes = make(map[string]string, len(v.Attributes))
+		for key, val := range v.Attributes {
+			tk := key
+			tv := val
+			res.Attributes[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalWebhookSubscriptionResponseBodyToIdentityWebhookSubscription builds
+// a value of type *identity.WebhookSubscription from a value of type
+// *WebhookSubscriptionResponseBody.
+func unmarshalWebhookSubscriptionResponseBodyToIdentityWebhookSubscription(v *WebhookSubscriptionResponseBody) *identity.WebhookSubscription {
+	res := &identity.WebhookSubscription{
+		ID:        *v.ID,
+		URL:       *v.URL,
+		Active:    *v.Active,
+		Secret:    v.Secret,
+		CreatedAt: *v.CreatedAt,
+	}
+	res.EventTypes = make([]string, len(v.EventTypes))
+	for i, val := range v.EventTypes {
+		res.EventTypes[i] = val
+	}
+
+	return res
+}
+
+// unmarshalWebhookDeliveryResponseBodyToIdentityWebhookDelivery builds a value
+// of type *identity.WebhookDelivery from a value of type
+// *WebhookDeliveryResponseBody.
+func unmarshalWebhookDeliveryResponseBodyToIdentityWebhookDelivery(v *WebhookDeliveryResponseBody) *identity.WebhookDelivery {
+	res := &identity.WebhookDelivery{
+		ID:             *v.ID,
+		SubscriptionID: *v.SubscriptionID,
+		EventType:      *v.EventType,
+		Status:         *v.Status,
+		Attempts:       *v.Attempts,
+		ResponseStatus: v.ResponseStatus,
+		LastError:      v.LastError,
+		CreatedAt:      *v.CreatedAt,
+		LastAttemptAt:  v.LastAttemptAt,
+		NextAttemptAt:  v.NextAttemptAt,
+		DeliveredAt:    v.DeliveredAt,
+	}
+
+	return res
+}
@@ -16,16 +16,48 @@ import (
 // RegisterRequestBody is the type of the "identity" service "register"
 // endpoint HTTP request body.
 type RegisterRequestBody struct {
-	DisplayName string `form:"display_name" json:"display_name" xml:"display_name"`
-	Email       string `form:"email" json:"email" xml:"email"`
-	Password    string `form:"password" json:"password" xml:"password"`
+	Email string `form:"email" json:"email" xml:"email"`
+	// Password; may be omitted when magic link login is enabled
+	Password    *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	DisplayName string  `form:"display_name" json:"display_name" xml:"display_name"`
+	// Optional unique handle that can be used to log in instead of the email
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 }
 
 // LoginRequestBody is the type of the "identity" service "login" endpoint HTTP
 // request body.
 type LoginRequestBody struct {
-	Email    string `form:"email" json:"email" xml:"email"`
-	Password string `form:"password" json:"password" xml:"password"`
+	// Format of the issued access token
+	TokenFormat string `form:"token_format" json:"token_format" xml:"token_format"`
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+	// Deprecated: use identifier
+	Email    *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	Password string  `form:"password" json:"password" xml:"password"`
+	// Email address or username
+	Identifier *string `form:"identifier,omitempty" json:"identifier,omitempty" xml:"identifier,omitempty"`
+}
+
+// SetUsernameRequestBody is the type of the "identity" service "set_username"
+// endpoint HTTP request body.
+type SetUsernameRequestBody struct {
+	// New username
+	Username string `form:"username" json:"username" xml:"username"`
+}
+
+// RequestMagicLinkRequestBody is the type of the "identity" service
+// "request_magic_link" endpoint HTTP request body.
+type RequestMagicLinkRequestBody struct {
+	// Email address to send the login link to
+	Email string `form:"email" json:"email" xml:"email"`
+}
+
+// ConsumeMagicLinkRequestBody is the type of the "identity" service
+// "consume_magic_link" endpoint HTTP request body.
+type ConsumeMagicLinkRequestBody struct {
+	// Token from the emailed login link
+	Token string `form:"token" json:"token" xml:"token"`
 }
 
 // ValidateTokenRequestBody is the type of the "identity" service
@@ -33,6 +65,106 @@ type LoginRequestBody struct {
 type ValidateTokenRequestBody struct {
 	// JWT access token
 	Token string `form:"token" json:"token" xml:"token"`
+	// Audience of the calling service; when set the token must be intended for it
+	Audience *string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+}
+
+// ValidateTokensRequestBody is the type of the "identity" service
+// "validate_tokens" endpoint HTTP request body.
+type ValidateTokensRequestBody struct {
+	// Access tokens to validate
+	Tokens []string `form:"tokens" json:"tokens" xml:"tokens"`
+	// Audience of the calling service; when set every token must be intended for it
+	Audience *string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+}
+
+// ExchangeTokenRequestBody is the type of the "identity" service
+// "exchange_token" endpoint HTTP request body.
+type ExchangeTokenRequestBody struct {
+	// OAuth 2.0 grant type
+	GrantType string `form:"grant_type" json:"grant_type" xml:"grant_type"`
+	// Identifier of the user to impersonate
+	RequestedSubject string `form:"requested_subject" json:"requested_subject" xml:"requested_subject"`
+	// Type of the requested token
+	RequestedTokenType string `form:"requested_token_type" json:"requested_token_type" xml:"requested_token_type"`
+	// Why impersonation is needed, recorded in the audit log
+	Reason string `form:"reason" json:"reason" xml:"reason"`
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+}
+
+// InviteUserRequestBody is the type of the "identity" service "invite_user"
+// endpoint HTTP request body.
+type InviteUserRequestBody struct {
+	// Email address to invite
+	Email string `form:"email" json:"email" xml:"email"`
+	// Display name to pre-assign
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Attributes to pre-assign to the new account; restricted to administrators
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+}
+
+// AcceptInvitationRequestBody is the type of the "identity" service
+// "accept_invitation" endpoint HTTP request body.
+type AcceptInvitationRequestBody struct {
+	// Token from the emailed invitation link
+	InvitationToken string `form:"invitation_token" json:"invitation_token" xml:"invitation_token"`
+	// Password; may be omitted when magic link login is enabled
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Overrides the pre-assigned display name
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+}
+
+// DeviceAuthorizationRequestBody is the type of the "identity" service
+// "device_authorization" endpoint HTTP request body.
+type DeviceAuthorizationRequestBody struct {
+	// Identifier of the public client starting the flow
+	ClientID string `form:"client_id" json:"client_id" xml:"client_id"`
+	// Space-separated scopes requested by the client; each must be listed in
+	// IDENTITY_DEVICE_SCOPES
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+}
+
+// DeviceTokenRequestBody is the type of the "identity" service "device_token"
+// endpoint HTTP request body.
+type DeviceTokenRequestBody struct {
+	// OAuth 2.0 grant type
+	GrantType string `form:"grant_type" json:"grant_type" xml:"grant_type"`
+	// Device code returned by device_authorization
+	DeviceCode string `form:"device_code" json:"device_code" xml:"device_code"`
+	// Client that started the flow
+	ClientID string `form:"client_id" json:"client_id" xml:"client_id"`
+}
+
+// ApproveDeviceRequestBody is the type of the "identity" service
+// "approve_device" endpoint HTTP request body.
+type ApproveDeviceRequestBody struct {
+	// Code displayed by the device
+	UserCode string `form:"user_code" json:"user_code" xml:"user_code"`
+	// False denies the request
+	Approve bool `form:"approve" json:"approve" xml:"approve"`
+}
+
+// CreateWebhookRequestBody is the type of the "identity" service
+// "create_webhook" endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// Endpoint that receives signed POST requests
+	URL string `form:"url" json:"url" xml:"url"`
+	// Events to deliver; omit to receive all events
+	EventTypes []string `form:"event_types,omitempty" json:"event_types,omitempty" xml:"event_types,omitempty"`
+}
+
+// SetUserStatusRequestBody is the type of the "identity" service
+// "set_user_status" endpoint HTTP request body.
+type SetUserStatusRequestBody struct {
+	// New account status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Why the status is changed, e.g. the abuse report being acted on
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
 }
 
 // RegisterResponseBody is the type of the "identity" service "register"
@@ -46,6 +178,16 @@ type RegisterResponseBody struct {
 	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
 	// Creation timestamp
 	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Account status; only active accounts can sign in
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Reason given for the last status change
+	StatusReason *string `form:"status_reason,omitempty" json:"status_reason,omitempty" xml:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *string `form:"status_changed_at,omitempty" json:"status_changed_at,omitempty" xml:"status_changed_at,omitempty"`
+	// Unique handle, if the user chose one
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 }
 
 // LoginResponseBody is the type of the "identity" service "login" endpoint
@@ -57,6 +199,49 @@ type LoginResponseBody struct {
 	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
 }
 
+// SetUsernameResponseBody is the type of the "identity" service "set_username"
+// endpoint HTTP response body.
+type SetUsernameResponseBody struct {
+	// User identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Display name
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Account status; only active accounts can sign in
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Reason given for the last status change
+	StatusReason *string `form:"status_reason,omitempty" json:"status_reason,omitempty" xml:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *string `form:"status_changed_at,omitempty" json:"status_changed_at,omitempty" xml:"status_changed_at,omitempty"`
+	// Unique handle, if the user chose one
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// CheckUsernameResponseBody is the type of the "identity" service
+// "check_username" endpoint HTTP response body.
+type CheckUsernameResponseBody struct {
+	// Checked username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Whether the username can be claimed
+	Available *bool `form:"available,omitempty" json:"available,omitempty" xml:"available,omitempty"`
+	// Why the username cannot be claimed
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// ConsumeMagicLinkResponseBody is the type of the "identity" service
+// "consume_magic_link" endpoint HTTP response body.
+type ConsumeMagicLinkResponseBody struct {
+	// JWT access token
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
+	// Token expiry window in seconds
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+}
+
 // ValidateTokenResponseBody is the type of the "identity" service
 // "validate_token" endpoint HTTP response body.
 type ValidateTokenResponseBody struct {
@@ -64,91 +249,2876 @@ type ValidateTokenResponseBody struct {
 	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
 	Email  *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
 	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Set when the token was issued through impersonation
+	Actor *ActorResponseBody `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Services the token is intended for
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+	// Custom claims added by claims enrichers when the token was issued
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
 }
 
-// NewRegisterRequestBody builds the HTTP request body from the payload of the
-// "register" endpoint of the "identity" service.
-func NewRegisterRequestBody(p *identity.RegisterPayload) *RegisterRequestBody {
-	body := &RegisterRequestBody{
-		DisplayName: p.DisplayName,
-		Email:       p.Email,
-		Password:    p.Password,
-	}
-	return body
+// ValidateTokensResponseBody is the type of the "identity" service
+// "validate_tokens" endpoint HTTP response body.
+type ValidateTokensResponseBody struct {
+	// One result per submitted token, in the same order
+	Results []*ValidationResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
 }
 
-// NewLoginRequestBody builds the HTTP request body from the payload of the
-// "login" endpoint of the "identity" service.
-func NewLoginRequestBody(p *identity.Credentials) *LoginRequestBody {
-	body := &LoginRequestBody{
-		Email:    p.Email,
-		Password: p.Password,
-	}
-	return body
+// ListAuthEventsResponseBody is the type of the "identity" service
+// "list_auth_events" endpoint HTTP response body.
+type ListAuthEventsResponseBody struct {
+	// Events ordered from newest to oldest
+	Events []*AuthEventResponseBody `form:"events,omitempty" json:"events,omitempty" xml:"events,omitempty"`
 }
 
-// NewValidateTokenRequestBody builds the HTTP request body from the payload of
-// the "validate_token" endpoint of the "identity" service.
-func NewValidateTokenRequestBody(p *identity.ValidateTokenPayload) *ValidateTokenRequestBody {
-	body := &ValidateTokenRequestBody{
-		Token: p.Token,
-	}
-	return body
+// IntrospectResponseBody is the type of the "identity" service "introspect"
+// endpoint HTTP response body.
+type IntrospectResponseBody struct {
+	// Whether the token is currently active
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Subject of the token
+	Sub *string `form:"sub,omitempty" json:"sub,omitempty" xml:"sub,omitempty"`
+	// Expiration time in seconds since the epoch
+	Exp *int64 `form:"exp,omitempty" json:"exp,omitempty" xml:"exp,omitempty"`
+	// Issue time in seconds since the epoch
+	Iat *int64 `form:"iat,omitempty" json:"iat,omitempty" xml:"iat,omitempty"`
+	// Space-separated scopes granted to the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Client the token was issued to
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// Type of the token
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Email of the resource owner
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Services the token is intended for
+	Aud []string `form:"aud,omitempty" json:"aud,omitempty" xml:"aud,omitempty"`
+	// Issuer of the token
+	Iss *string `form:"iss,omitempty" json:"iss,omitempty" xml:"iss,omitempty"`
 }
 
-// NewRegisterUserCreated builds a "identity" service "register" endpoint
-// result from a HTTP "Created" response.
-func NewRegisterUserCreated(body *RegisterResponseBody) *identityviews.UserView {
-	v := &identityviews.UserView{
-		ID:          body.ID,
-		Email:       body.Email,
-		DisplayName: body.DisplayName,
-		CreatedAt:   body.CreatedAt,
-	}
+// ExchangeTokenResponseBody is the type of the "identity" service
+// "exchange_token" endpoint HTTP response body.
+type ExchangeTokenResponseBody struct {
+	// JWT acting as the requested subject
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
+	// Type of the issued token
+	IssuedTokenType *string `form:"issued_token_type,omitempty" json:"issued_token_type,omitempty" xml:"issued_token_type,omitempty"`
+	// How the token is presented
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiry window in seconds
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+}
 
-	return v
+// InviteUserResponseBody is the type of the "identity" service "invite_user"
+// endpoint HTTP response body.
+type InviteUserResponseBody struct {
+	// Invitation identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Email address of the invitee
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Display name pre-assigned to the invitee
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Attributes pre-assigned to the invitee
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// User who created the invitation
+	InvitedBy *string `form:"invited_by,omitempty" json:"invited_by,omitempty" xml:"invited_by,omitempty"`
+	// Invitation status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Expiry timestamp
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
 }
 
-// NewLoginTokenResultOK builds a "identity" service "login" endpoint result
-// from a HTTP "OK" response.
-func NewLoginTokenResultOK(body *LoginResponseBody) *identity.TokenResult {
-	v := &identity.TokenResult{
-		AccessToken: *body.AccessToken,
-		ExpiresIn:   *body.ExpiresIn,
-	}
+// ListInvitationsResponseBody is the type of the "identity" service
+// "list_invitations" endpoint HTTP response body.
+type ListInvitationsResponseBody struct {
+	// Invitations ordered from newest to oldest
+	Invitations []*InvitationResponseBody `form:"invitations,omitempty" json:"invitations,omitempty" xml:"invitations,omitempty"`
+}
 
-	return v
+// AcceptInvitationResponseBody is the type of the "identity" service
+// "accept_invitation" endpoint HTTP response body.
+type AcceptInvitationResponseBody struct {
+	// User identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Display name
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Account status; only active accounts can sign in
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Reason given for the last status change
+	StatusReason *string `form:"status_reason,omitempty" json:"status_reason,omitempty" xml:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *string `form:"status_changed_at,omitempty" json:"status_changed_at,omitempty" xml:"status_changed_at,omitempty"`
+	// Unique handle, if the user chose one
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
 }
 
-// NewValidateTokenValidationResultOK builds a "identity" service
-// "validate_token" endpoint result from a HTTP "OK" response.
-func NewValidateTokenValidationResultOK(body *ValidateTokenResponseBody) *identity.ValidationResult {
-	v := &identity.ValidationResult{
-		Valid:  *body.Valid,
-		UserID: body.UserID,
-		Email:  body.Email,
-		Reason: body.Reason,
-	}
+// DeviceAuthorizationResponseBody is the type of the "identity" service
+// "device_authorization" endpoint HTTP response body.
+type DeviceAuthorizationResponseBody struct {
+	// Code the device polls the token endpoint with
+	DeviceCode *string `form:"device_code,omitempty" json:"device_code,omitempty" xml:"device_code,omitempty"`
+	// Code the user enters on the verification page
+	UserCode *string `form:"user_code,omitempty" json:"user_code,omitempty" xml:"user_code,omitempty"`
+	// Page where the user approves the device
+	VerificationURI *string `form:"verification_uri,omitempty" json:"verification_uri,omitempty" xml:"verification_uri,omitempty"`
+	// Verification page with the user code filled in
+	VerificationURIComplete *string `form:"verification_uri_complete,omitempty" json:"verification_uri_complete,omitempty" xml:"verification_uri_complete,omitempty"`
+	// Lifetime of the device and user codes in seconds
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+	// Minimum number of seconds between polling requests
+	Interval *int `form:"interval,omitempty" json:"interval,omitempty" xml:"interval,omitempty"`
+}
 
-	return v
+// DeviceTokenResponseBody is the type of the "identity" service "device_token"
+// endpoint HTTP response body.
+type DeviceTokenResponseBody struct {
+	// JWT access token
+	AccessToken *string `form:"access_token,omitempty" json:"access_token,omitempty" xml:"access_token,omitempty"`
+	// How the token is presented
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Token expiry window in seconds
+	ExpiresIn *int `form:"expires_in,omitempty" json:"expires_in,omitempty" xml:"expires_in,omitempty"`
+	// Scopes granted to the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
 }
 
-// ValidateLoginResponseBody runs the validations defined on LoginResponseBody
-func ValidateLoginResponseBody(body *LoginResponseBody) (err error) {
-	if body.AccessToken == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
-	}
-	if body.ExpiresIn == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
-	}
-	return
+// CreateWebhookResponseBody is the type of the "identity" service
+// "create_webhook" endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	// Subscription identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Endpoint that receives signed POST requests
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Events delivered to the endpoint; empty means all events
+	EventTypes []string `form:"event_types,omitempty" json:"event_types,omitempty" xml:"event_types,omitempty"`
+	// Whether new events are delivered
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// HMAC-SHA256 signing secret; only returned when the subscription is created
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 }
 
-// ValidateValidateTokenResponseBody runs the validations defined on
-// validate_token_response_body
-func ValidateValidateTokenResponseBody(body *ValidateTokenResponseBody) (err error) {
-	if body.Valid == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("valid", "body"))
+// ListWebhooksResponseBody is the type of the "identity" service
+// "list_webhooks" endpoint HTTP response body.
+type ListWebhooksResponseBody struct {
+	// Subscriptions ordered from newest to oldest
+	Subscriptions []*WebhookSubscriptionResponseBody `form:"subscriptions,omitempty" json:"subscriptions,omitempty" xml:"subscriptions,omitempty"`
+}
+
+// ListWebhookDeliveriesResponseBody is the type of the "identity" service
+// "list_webhook_deliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody struct {
+	// Deliveries ordered from newest to oldest
+	Deliveries []*WebhookDeliveryResponseBody `form:"deliveries,omitempty" json:"deliveries,omitempty" xml:"deliveries,omitempty"`
+}
+
+// RedeliverWebhookResponseBody is the type of the "identity" service
+// "redeliver_webhook" endpoint HTTP response body.
+type RedeliverWebhookResponseBody struct {
+	// Delivery identifier, sent in the X-Webhook-ID header
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Subscription the delivery belongs to
+	SubscriptionID *string `form:"subscription_id,omitempty" json:"subscription_id,omitempty" xml:"subscription_id,omitempty"`
+	// Event type
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// Delivery status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of delivery attempts made
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// HTTP status returned by the last attempt
+	ResponseStatus *int `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Time of the last attempt
+	LastAttemptAt *string `form:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty" xml:"last_attempt_at,omitempty"`
+	// Time of the next attempt while pending
+	NextAttemptAt *string `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// Time the endpoint acknowledged the delivery
+	DeliveredAt *string `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// SetUserStatusResponseBody is the type of the "identity" service
+// "set_user_status" endpoint HTTP response body.
+type SetUserStatusResponseBody struct {
+	// User identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Display name
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Account status; only active accounts can sign in
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Reason given for the last status change
+	StatusReason *string `form:"status_reason,omitempty" json:"status_reason,omitempty" xml:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *string `form:"status_changed_at,omitempty" json:"status_changed_at,omitempty" xml:"status_changed_at,omitempty"`
+	// Unique handle, if the user chose one
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// RegisterConflictResponseBody is the type of the "identity" service
+// "register" endpoint HTTP response body for the "conflict" error.
+type RegisterConflictResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// RegisterInvalidUsernameResponseBody is the type of the "identity" service
+// "register" endpoint HTTP response body for the "invalid_username" error.
+type RegisterInvalidUsernameResponseBody struct {
+	// description of the broken rule
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// RegisterPasswordPolicyResponseBody is the type of the "identity" service
+// "register" endpoint HTTP response body for the "password_policy" error.
+type RegisterPasswordPolicyResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// every password rule that failed
+	Violations []*PolicyViolationResponseBody `form:"violations,omitempty" json:"violations,omitempty" xml:"violations,omitempty"`
+}
+
+// RegisterUnauthorizedResponseBody is the type of the "identity" service
+// "register" endpoint HTTP response body for the "unauthorized" error.
+type RegisterUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// SetUsernameConflictResponseBody is the type of the "identity" service
+// "set_username" endpoint HTTP response body for the "conflict" error.
+type SetUsernameConflictResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// SetUsernameInvalidUsernameResponseBody is the type of the "identity" service
+// "set_username" endpoint HTTP response body for the "invalid_username" error.
+type SetUsernameInvalidUsernameResponseBody struct {
+	// description of the broken rule
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// SetUsernameUnauthorizedResponseBody is the type of the "identity" service
+// "set_username" endpoint HTTP response body for the "unauthorized" error.
+type SetUsernameUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RequestMagicLinkUnauthorizedResponseBody is the type of the "identity"
+// service "request_magic_link" endpoint HTTP response body for the
+// "unauthorized" error.
+type RequestMagicLinkUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ConsumeMagicLinkUnauthorizedResponseBody is the type of the "identity"
+// service "consume_magic_link" endpoint HTTP response body for the
+// "unauthorized" error.
+type ConsumeMagicLinkUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListAuthEventsBadRequestResponseBody is the type of the "identity" service
+// "list_auth_events" endpoint HTTP response body for the "bad_request" error.
+type ListAuthEventsBadRequestResponseBody struct {
+	// description of the invalid argument
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// ListAuthEventsUnauthorizedResponseBody is the type of the "identity" service
+// "list_auth_events" endpoint HTTP response body for the "unauthorized" error.
+type ListAuthEventsUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// IntrospectUnauthorizedResponseBody is the type of the "identity" service
+// "introspect" endpoint HTTP response body for the "unauthorized" error.
+type IntrospectUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RevokeTokenUnsupportedTokenTypeResponseBody is the type of the "identity"
+// service "revoke_token" endpoint HTTP response body for the
+// "unsupported_token_type" error.
+type RevokeTokenUnsupportedTokenTypeResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// RevokeTokenUnauthorizedResponseBody is the type of the "identity" service
+// "revoke_token" endpoint HTTP response body for the "unauthorized" error.
+type RevokeTokenUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ExchangeTokenNotFoundResponseBody is the type of the "identity" service
+// "exchange_token" endpoint HTTP response body for the "not_found" error.
+type ExchangeTokenNotFoundResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ExchangeTokenUnauthorizedResponseBody is the type of the "identity" service
+// "exchange_token" endpoint HTTP response body for the "unauthorized" error.
+type ExchangeTokenUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// InviteUserConflictResponseBody is the type of the "identity" service
+// "invite_user" endpoint HTTP response body for the "conflict" error.
+type InviteUserConflictResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// InviteUserUnauthorizedResponseBody is the type of the "identity" service
+// "invite_user" endpoint HTTP response body for the "unauthorized" error.
+type InviteUserUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListInvitationsUnauthorizedResponseBody is the type of the "identity"
+// service "list_invitations" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListInvitationsUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RevokeInvitationNotFoundResponseBody is the type of the "identity" service
+// "revoke_invitation" endpoint HTTP response body for the "not_found" error.
+type RevokeInvitationNotFoundResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RevokeInvitationUnauthorizedResponseBody is the type of the "identity"
+// service "revoke_invitation" endpoint HTTP response body for the
+// "unauthorized" error.
+type RevokeInvitationUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// AcceptInvitationConflictResponseBody is the type of the "identity" service
+// "accept_invitation" endpoint HTTP response body for the "conflict" error.
+type AcceptInvitationConflictResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// AcceptInvitationPasswordPolicyResponseBody is the type of the "identity"
+// service "accept_invitation" endpoint HTTP response body for the
+// "password_policy" error.
+type AcceptInvitationPasswordPolicyResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// every password rule that failed
+	Violations []*PolicyViolationResponseBody `form:"violations,omitempty" json:"violations,omitempty" xml:"violations,omitempty"`
+}
+
+// AcceptInvitationUnauthorizedResponseBody is the type of the "identity"
+// service "accept_invitation" endpoint HTTP response body for the
+// "unauthorized" error.
+type AcceptInvitationUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// DeviceAuthorizationInvalidClientResponseBody is the type of the "identity"
+// service "device_authorization" endpoint HTTP response body for the
+// "invalid_client" error.
+type DeviceAuthorizationInvalidClientResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceAuthorizationInvalidScopeResponseBody is the type of the "identity"
+// service "device_authorization" endpoint HTTP response body for the
+// "invalid_scope" error.
+type DeviceAuthorizationInvalidScopeResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceAuthorizationInvalidTargetResponseBody is the type of the "identity"
+// service "device_authorization" endpoint HTTP response body for the
+// "invalid_target" error.
+type DeviceAuthorizationInvalidTargetResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenAuthorizationPendingResponseBody is the type of the "identity"
+// service "device_token" endpoint HTTP response body for the
+// "authorization_pending" error.
+type DeviceTokenAuthorizationPendingResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenSlowDownResponseBody is the type of the "identity" service
+// "device_token" endpoint HTTP response body for the "slow_down" error.
+type DeviceTokenSlowDownResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenAccessDeniedResponseBody is the type of the "identity" service
+// "device_token" endpoint HTTP response body for the "access_denied" error.
+type DeviceTokenAccessDeniedResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenExpiredTokenResponseBody is the type of the "identity" service
+// "device_token" endpoint HTTP response body for the "expired_token" error.
+type DeviceTokenExpiredTokenResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenInvalidGrantResponseBody is the type of the "identity" service
+// "device_token" endpoint HTTP response body for the "invalid_grant" error.
+type DeviceTokenInvalidGrantResponseBody struct {
+	// Error code
+	Code *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// ApproveDeviceNotFoundResponseBody is the type of the "identity" service
+// "approve_device" endpoint HTTP response body for the "not_found" error.
+type ApproveDeviceNotFoundResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ApproveDeviceUnauthorizedResponseBody is the type of the "identity" service
+// "approve_device" endpoint HTTP response body for the "unauthorized" error.
+type ApproveDeviceUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// CreateWebhookUnauthorizedResponseBody is the type of the "identity" service
+// "create_webhook" endpoint HTTP response body for the "unauthorized" error.
+type CreateWebhookUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListWebhooksUnauthorizedResponseBody is the type of the "identity" service
+// "list_webhooks" endpoint HTTP response body for the "unauthorized" error.
+type ListWebhooksUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// DeleteWebhookNotFoundResponseBody is the type of the "identity" service
+// "delete_webhook" endpoint HTTP response body for the "not_found" error.
+type DeleteWebhookNotFoundResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// DeleteWebhookUnauthorizedResponseBody is the type of the "identity" service
+// "delete_webhook" endpoint HTTP response body for the "unauthorized" error.
+type DeleteWebhookUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListWebhookDeliveriesNotFoundResponseBody is the type of the "identity"
+// service "list_webhook_deliveries" endpoint HTTP response body for the
+// "not_found" error.
+type ListWebhookDeliveriesNotFoundResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListWebhookDeliveriesUnauthorizedResponseBody is the type of the "identity"
+// service "list_webhook_deliveries" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListWebhookDeliveriesUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RedeliverWebhookNotFoundResponseBody is the type of the "identity" service
+// "redeliver_webhook" endpoint HTTP response body for the "not_found" error.
+type RedeliverWebhookNotFoundResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RedeliverWebhookUnauthorizedResponseBody is the type of the "identity"
+// service "redeliver_webhook" endpoint HTTP response body for the
+// "unauthorized" error.
+type RedeliverWebhookUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// SetUserStatusNotFoundResponseBody is the type of the "identity" service
+// "set_user_status" endpoint HTTP response body for the "not_found" error.
+type SetUserStatusNotFoundResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// SetUserStatusUnauthorizedResponseBody is the type of the "identity" service
+// "set_user_status" endpoint HTTP response body for the "unauthorized" error.
+type SetUserStatusUnauthorizedResponseBody struct {
+	// description of the failure
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// PolicyViolationResponseBody is used to define fields on response body types.
+type PolicyViolationResponseBody struct {
+	// identifier of the failed rule
+	Rule *string `form:"rule,omitempty" json:"rule,omitempty" xml:"rule,omitempty"`
+	// description of the failed rule
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// ActorResponseBody is used to define fields on response body types.
+type ActorResponseBody struct {
+	// Actor user identifier
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Actor email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+}
+
+// ValidationResultResponseBody is used to define fields on response body types.
+type ValidationResultResponseBody struct {
+	Valid  *bool   `form:"valid,omitempty" json:"valid,omitempty" xml:"valid,omitempty"`
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	Email  *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Set when the token was issued through impersonation
+	Actor *ActorResponseBody `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Services the token is intended for
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+	// Custom claims added by claims enrichers when the token was issued
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+}
+
+// AuthEventResponseBody is used to define fields on response body types.
+type AuthEventResponseBody struct {
+	// Event identifier
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Event type
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Whether the operation succeeded
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Acting user, when known
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Email supplied by or resolved for the actor
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Failure reason
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Client IP address
+	IPAddress *string `form:"ip_address,omitempty" json:"ip_address,omitempty" xml:"ip_address,omitempty"`
+	// Client user agent
+	UserAgent *string `form:"user_agent,omitempty" json:"user_agent,omitempty" xml:"user_agent,omitempty"`
+	// Request identifier
+	RequestID *string `form:"request_id,omitempty" json:"request_id,omitempty" xml:"request_id,omitempty"`
+	// Event timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// InvitationResponseBody is used to define fields on response body types.
+type InvitationResponseBody struct {
+	// Invitation identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Email address of the invitee
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Display name pre-assigned to the invitee
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Attributes pre-assigned to the invitee
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// User who created the invitation
+	InvitedBy *string `form:"invited_by,omitempty" json:"invited_by,omitempty" xml:"invited_by,omitempty"`
+	// Invitation status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Expiry timestamp
+	ExpiresAt *string `form:"expires_at,omitempty" json:"expires_at,omitempty" xml:"expires_at,omitempty"`
+}
+
+// WebhookSubscriptionResponseBody is used to define fields on response body
+// types.
+type WebhookSubscriptionResponseBody struct {
+	// Subscription identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Endpoint that receives signed POST requests
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Events delivered to the endpoint; empty means all events
+	EventTypes []string `form:"event_types,omitempty" json:"event_types,omitempty" xml:"event_types,omitempty"`
+	// Whether new events are delivered
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// HMAC-SHA256 signing secret; only returned when the subscription is created
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+}
+
+// WebhookDeliveryResponseBody is used to define fields on response body types.
+type WebhookDeliveryResponseBody struct {
+	// Delivery identifier, sent in the X-Webhook-ID header
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Subscription the delivery belongs to
+	SubscriptionID *string `form:"subscription_id,omitempty" json:"subscription_id,omitempty" xml:"subscription_id,omitempty"`
+	// Event type
+	EventType *string `form:"event_type,omitempty" json:"event_type,omitempty" xml:"event_type,omitempty"`
+	// Delivery status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of delivery attempts made
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// HTTP status returned by the last attempt
+	ResponseStatus *int `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	// Creation timestamp
+	CreatedAt *string `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Time of the last attempt
+	LastAttemptAt *string `form:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty" xml:"last_attempt_at,omitempty"`
+	// Time of the next attempt while pending
+	NextAttemptAt *string `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// Time the endpoint acknowledged the delivery
+	DeliveredAt *string `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// NewRegisterRequestBody builds the HTTP request body from the payload of the
+// "register" endpoint of the "identity" service.
+func NewRegisterRequestBody(p *identity.RegisterPayload) *RegisterRequestBody {
+	body := &RegisterRequestBody{
+		Email:       p.Email,
+		Password:    p.Password,
+		DisplayName: p.DisplayName,
+		Username:    p.Username,
+	}
+	return body
+}
+
+// NewLoginRequestBody builds the HTTP request body from the payload of the
+// "login" endpoint of the "identity" service.
+func NewLoginRequestBody(p *identity.LoginPayload) *LoginRequestBody {
+	body := &LoginRequestBody{
+		TokenFormat: p.TokenFormat,
+		Email:       p.Email,
+		Password:    p.Password,
+		Identifier:  p.Identifier,
+	}
+	{
+		var zero string
+		if body.TokenFormat == zero {
+			body.TokenFormat = "jwt"
+		}
+	}
+	if p.Audience != nil {
+		body.Audience = make([]string, len(p.Audience))
+		for i, val := range p.Audience {
+			body.Audience[i] = val
+		}
+	}
+	return body
+}
+
+// NewSetUsernameRequestBody builds the HTTP request body from the payload of
+// the "set_username" endpoint of the "identity" service.
+func NewSetUsernameRequestBody(p *identity.SetUsernamePayload) *SetUsernameRequestBody {
+	body := &SetUsernameRequestBody{
+		Username: p.Username,
+	}
+	return body
+}
+
+// NewRequestMagicLinkRequestBody builds the HTTP request body from the payload
+// of the "request_magic_link" endpoint of the "identity" service.
+func NewRequestMagicLinkRequestBody(p *identity.RequestMagicLinkPayload) *RequestMagicLinkRequestBody {
+	body := &RequestMagicLinkRequestBody{
+		Email: p.Email,
+	}
+	return body
+}
+
+// NewConsumeMagicLinkRequestBody builds the HTTP request body from the payload
+// of the "consume_magic_link" endpoint of the "identity" service.
+func NewConsumeMagicLinkRequestBody(p *identity.ConsumeMagicLinkPayload) *ConsumeMagicLinkRequestBody {
+	body := &ConsumeMagicLinkRequestBody{
+		Token: p.Token,
+	}
+	return body
+}
+
+// NewValidateTokenRequestBody builds the HTTP request body from the payload of
+// the "validate_token" endpoint of the "identity" service.
+func NewValidateTokenRequestBody(p *identity.ValidateTokenPayload) *ValidateTokenRequestBody {
+	body := &ValidateTokenRequestBody{
+		Token:    p.Token,
+		Audience: p.Audience,
+	}
+	return body
+}
+
+// NewValidateTokensRequestBody builds the HTTP request body from the payload
+// of the "validate_tokens" endpoint of the "identity" service.
+func NewValidateTokensRequestBody(p *identity.ValidateTokensPayload) *ValidateTokensRequestBody {
+	body := &ValidateTokensRequestBody{
+		Audience: p.Audience,
+	}
+	if p.Tokens != nil {
+		body.Tokens = make([]string, len(p.Tokens))
+		for i, val := range p.Tokens {
+			body.Tokens[i] = val
+		}
+	} else {
+		body.Tokens = []string{}
+	}
+	return body
+}
+
+// NewExchangeTokenRequestBody builds the HTTP request body from the payload of
+// the "exchange_token" endpoint of the "identity" service.
+func NewExchangeTokenRequestBody(p *identity.TokenExchangePayload) *ExchangeTokenRequestBody {
+	body := &ExchangeTokenRequestBody{
+		GrantType:          p.GrantType,
+		RequestedSubject:   p.RequestedSubject,
+		RequestedTokenType: p.RequestedTokenType,
+		Reason:             p.Reason,
+	}
+	{
+		var zero string
+		if body.GrantType == zero {
+			body.GrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+		}
+	}
+	{
+		var zero string
+		if body.RequestedTokenType == zero {
+			body.RequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+		}
+	}
+	if p.Audience != nil {
+		body.Audience = make([]string, len(p.Audience))
+		for i, val := range p.Audience {
+			body.Audience[i] = val
+		}
+	}
+	return body
+}
+
+// NewInviteUserRequestBody builds the HTTP request body from the payload of
+// the "invite_user" endpoint of the "identity" service.
+func NewInviteUserRequestBody(p *identity.InviteUserPayload) *InviteUserRequestBody {
+	body := &InviteUserRequestBody{
+		Email:       p.Email,
+		DisplayName: p.DisplayName,
+	}
+	if p.Attributes != nil {
+		body.Attributes = make(map[string]string, len(p.Attributes))
+		for key, val := range p.Attributes {
+			tk := key
+			tv := val
+			body.Attributes[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewAcceptInvitationRequestBody builds the HTTP request body from the payload
+// of the "accept_invitation" endpoint of the "identity" service.
+func NewAcceptInvitationRequestBody(p *identity.AcceptInvitationPayload) *AcceptInvitationRequestBody {
+	body := &AcceptInvitationRequestBody{
+		InvitationToken: p.InvitationToken,
+		Password:        p.Password,
+		DisplayName:     p.DisplayName,
+	}
+	return body
+}
+
+// NewDeviceAuthorizationRequestBody builds the HTTP request body from the
+// payload of the "device_authorization" endpoint of the "identity" service.
+func NewDeviceAuthorizationRequestBody(p *identity.DeviceAuthorizationPayload) *DeviceAuthorizationRequestBody {
+	body := &DeviceAuthorizationRequestBody{
+		ClientID: p.ClientID,
+		Scope:    p.Scope,
+	}
+	if p.Audience != nil {
+		body.Audience = make([]string, len(p.Audience))
+		for i, val := range p.Audience {
+			body.Audience[i] = val
+		}
+	}
+	return body
+}
+
+// NewDeviceTokenRequestBody builds the HTTP request body from the payload of
+// the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenRequestBody(p *identity.DeviceTokenPayload) *DeviceTokenRequestBody {
+	body := &DeviceTokenRequestBody{
+		GrantType:  p.GrantType,
+		DeviceCode: p.DeviceCode,
+		ClientID:   p.ClientID,
+	}
+	return body
+}
+
+// NewApproveDeviceRequestBody builds the HTTP request body from the payload of
+// the "approve_device" endpoint of the "identity" service.
+func NewApproveDeviceRequestBody(p *identity.ApproveDevicePayload) *ApproveDeviceRequestBody {
+	body := &ApproveDeviceRequestBody{
+		UserCode: p.UserCode,
+		Approve:  p.Approve,
+	}
+	{
+		var zero bool
+		if body.Approve == zero {
+			body.Approve = true
+		}
+	}
+	return body
+}
+
+// NewCreateWebhookRequestBody builds the HTTP request body from the payload of
+// the "create_webhook" endpoint of the "identity" service.
+func NewCreateWebhookRequestBody(p *identity.CreateWebhookPayload) *CreateWebhookRequestBody {
+	body := &CreateWebhookRequestBody{
+		URL: p.URL,
+	}
+	if p.EventTypes != nil {
+		body.EventTypes = make([]string, len(p.EventTypes))
+		for i, val := range p.EventTypes {
+			body.EventTypes[i] = val
+		}
+	}
+	return body
+}
+
+// NewSetUserStatusRequestBody builds the HTTP request body from the payload of
+// the "set_user_status" endpoint of the "identity" service.
+func NewSetUserStatusRequestBody(p *identity.SetUserStatusPayload) *SetUserStatusRequestBody {
+	body := &SetUserStatusRequestBody{
+		Status: p.Status,
+		Reason: p.Reason,
+	}
+	return body
+}
+
+// NewRegisterUserCreated builds a "identity" service "register" endpoint
+// result from a HTTP "Created" response.
+func NewRegisterUserCreated(body *RegisterResponseBody) *identityviews.UserView {
+	v := &identityviews.UserView{
+		ID:              body.ID,
+		Email:           body.Email,
+		DisplayName:     body.DisplayName,
+		CreatedAt:       body.CreatedAt,
+		Status:          body.Status,
+		StatusReason:    body.StatusReason,
+		StatusChangedAt: body.StatusChangedAt,
+		Username:        body.Username,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewRegisterConflict builds a identity service register endpoint conflict
+// error.
+func NewRegisterConflict(body *RegisterConflictResponseBody) *identity.ConflictError {
+	v := &identity.ConflictError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+
+	return v
+}
+
+// NewRegisterInvalidUsername builds a identity service register endpoint
+// invalid_username error.
+func NewRegisterInvalidUsername(body *RegisterInvalidUsernameResponseBody) *identity.InvalidUsernameError {
+	v := &identity.InvalidUsernameError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+
+	return v
+}
+
+// NewRegisterPasswordPolicy builds a identity service register endpoint
+// password_policy error.
+func NewRegisterPasswordPolicy(body *RegisterPasswordPolicyResponseBody) *identity.PasswordPolicyError {
+	v := &identity.PasswordPolicyError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+	v.Violations = make([]*identity.PolicyViolation, len(body.Violations))
+	for i, val := range body.Violations {
+		if val == nil {
+			v.Violations[i] = nil
+			continue
+		}
+		v.Violations[i] = unmarshalPolicyViolationResponseBodyToIdentityPolicyViolation(val)
+	}
+
+	return v
+}
+
+// NewRegisterUnauthorized builds a identity service register endpoint
+// unauthorized error.
+func NewRegisterUnauthorized(body *RegisterUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewLoginTokenResultOK builds a "identity" service "login" endpoint result
+// from a HTTP "OK" response.
+func NewLoginTokenResultOK(body *LoginResponseBody) *identity.TokenResult {
+	v := &identity.TokenResult{
+		AccessToken: *body.AccessToken,
+		ExpiresIn:   *body.ExpiresIn,
+	}
+
+	return v
+}
+
+// NewSetUsernameUserOK builds a "identity" service "set_username" endpoint
+// result from a HTTP "OK" response.
+func NewSetUsernameUserOK(body *SetUsernameResponseBody) *identityviews.UserView {
+	v := &identityviews.UserView{
+		ID:              body.ID,
+		Email:           body.Email,
+		DisplayName:     body.DisplayName,
+		CreatedAt:       body.CreatedAt,
+		Status:          body.Status,
+		StatusReason:    body.StatusReason,
+		StatusChangedAt: body.StatusChangedAt,
+		Username:        body.Username,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewSetUsernameConflict builds a identity service set_username endpoint
+// conflict error.
+func NewSetUsernameConflict(body *SetUsernameConflictResponseBody) *identity.ConflictError {
+	v := &identity.ConflictError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+
+	return v
+}
+
+// NewSetUsernameInvalidUsername builds a identity service set_username
+// endpoint invalid_username error.
+func NewSetUsernameInvalidUsername(body *SetUsernameInvalidUsernameResponseBody) *identity.InvalidUsernameError {
+	v := &identity.InvalidUsernameError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+
+	return v
+}
+
+// NewSetUsernameUnauthorized builds a identity service set_username endpoint
+// unauthorized error.
+func NewSetUsernameUnauthorized(body *SetUsernameUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewCheckUsernameUsernameAvailabilityOK builds a "identity" service
+// "check_username" endpoint result from a HTTP "OK" response.
+func NewCheckUsernameUsernameAvailabilityOK(body *CheckUsernameResponseBody) *identity.UsernameAvailability {
+	v := &identity.UsernameAvailability{
+		Username:  *body.Username,
+		Available: *body.Available,
+		Reason:    body.Reason,
+	}
+
+	return v
+}
+
+// NewRequestMagicLinkUnauthorized builds a identity service request_magic_link
+// endpoint unauthorized error.
+func NewRequestMagicLinkUnauthorized(body *RequestMagicLinkUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewConsumeMagicLinkTokenResultOK builds a "identity" service
+// "consume_magic_link" endpoint result from a HTTP "OK" response.
+func NewConsumeMagicLinkTokenResultOK(body *ConsumeMagicLinkResponseBody) *identity.TokenResult {
+	v := &identity.TokenResult{
+		AccessToken: *body.AccessToken,
+		ExpiresIn:   *body.ExpiresIn,
+	}
+
+	return v
+}
+
+// NewConsumeMagicLinkUnauthorized builds a identity service consume_magic_link
+// endpoint unauthorized error.
+func NewConsumeMagicLinkUnauthorized(body *ConsumeMagicLinkUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewValidateTokenValidationResultOK builds a "identity" service
+// "validate_token" endpoint result from a HTTP "OK" response.
+func NewValidateTokenValidationResultOK(body *ValidateTokenResponseBody) *identity.ValidationResult {
+	v := &identity.ValidationResult{
+		Valid:  *body.Valid,
+		UserID: body.UserID,
+		Email:  body.Email,
+		Reason: body.Reason,
+	}
+	if body.Actor != nil {
+		v.Actor = unmarshalActorResponseBodyToIdentityActor(body.Actor)
+	}
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
+	}
+	if body.Claims != nil {
+		v.Claims = make(map[string]string, len(body.Claims))
+		for key, val := range body.Claims {
+			tk := key
+			tv := val
+			v.Claims[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewValidateTokensValidationResultsCollectionOK builds a "identity" service
+// "validate_tokens" endpoint result from a HTTP "OK" response.
+func NewValidateTokensValidationResultsCollectionOK(body *ValidateTokensResponseBody) *identity.ValidationResultsCollection {
+	v := &identity.ValidationResultsCollection{}
+	v.Results = make([]*identity.ValidationResult, len(body.Results))
+	for i, val := range body.Results {
+		if val == nil {
+			v.Results[i] = nil
+			continue
+		}
+		v.Results[i] = unmarshalValidationResultResponseBodyToIdentityValidationResult(val)
+	}
+
+	return v
+}
+
+// NewListAuthEventsAuthEventsCollectionOK builds a "identity" service
+// "list_auth_events" endpoint result from a HTTP "OK" response.
+func NewListAuthEventsAuthEventsCollectionOK(body *ListAuthEventsResponseBody) *identity.AuthEventsCollection {
+	v := &identity.AuthEventsCollection{}
+	v.Events = make([]*identity.AuthEvent, len(body.Events))
+	for i, val := range body.Events {
+		if val == nil {
+			v.Events[i] = nil
+			continue
+		}
+		v.Events[i] = unmarshalAuthEventResponseBodyToIdentityAuthEvent(val)
+	}
+
+	return v
+}
+
+// NewListAuthEventsBadRequest builds a identity service list_auth_events
+// endpoint bad_request error.
+func NewListAuthEventsBadRequest(body *ListAuthEventsBadRequestResponseBody) *identity.BadRequestError {
+	v := &identity.BadRequestError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+
+	return v
+}
+
+// NewListAuthEventsUnauthorized builds a identity service list_auth_events
+// endpoint unauthorized error.
+func NewListAuthEventsUnauthorized(body *ListAuthEventsUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewIntrospectionResultOK builds a "identity" service "introspect" endpoint
+// result from a HTTP "OK" response.
+func NewIntrospectionResultOK(body *IntrospectResponseBody) *identity.IntrospectionResult {
+	v := &identity.IntrospectionResult{
+		Active:    *body.Active,
+		Sub:       body.Sub,
+		Exp:       body.Exp,
+		Iat:       body.Iat,
+		Scope:     body.Scope,
+		ClientID:  body.ClientID,
+		TokenType: body.TokenType,
+		Username:  body.Username,
+		Iss:       body.Iss,
+	}
+	if body.Aud != nil {
+		v.Aud = make([]string, len(body.Aud))
+		for i, val := range body.Aud {
+			v.Aud[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewIntrospectUnauthorized builds a identity service introspect endpoint
+// unauthorized error.
+func NewIntrospectUnauthorized(body *IntrospectUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewRevokeTokenUnsupportedTokenType builds a identity service revoke_token
+// endpoint unsupported_token_type error.
+func NewRevokeTokenUnsupportedTokenType(body *RevokeTokenUnsupportedTokenTypeResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewRevokeTokenUnauthorized builds a identity service revoke_token endpoint
+// unauthorized error.
+func NewRevokeTokenUnauthorized(body *RevokeTokenUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewExchangeTokenTokenExchangeResultOK builds a "identity" service
+// "exchange_token" endpoint result from a HTTP "OK" response.
+func NewExchangeTokenTokenExchangeResultOK(body *ExchangeTokenResponseBody) *identity.TokenExchangeResult {
+	v := &identity.TokenExchangeResult{
+		AccessToken:     *body.AccessToken,
+		IssuedTokenType: *body.IssuedTokenType,
+		TokenType:       *body.TokenType,
+		ExpiresIn:       *body.ExpiresIn,
+	}
+
+	return v
+}
+
+// NewExchangeTokenNotFound builds a identity service exchange_token endpoint
+// not_found error.
+func NewExchangeTokenNotFound(body *ExchangeTokenNotFoundResponseBody) *identity.NotFoundError {
+	v := &identity.NotFoundError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewExchangeTokenUnauthorized builds a identity service exchange_token
+// endpoint unauthorized error.
+func NewExchangeTokenUnauthorized(body *ExchangeTokenUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewInviteUserInvitationCreated builds a "identity" service "invite_user"
+// endpoint result from a HTTP "Created" response.
+func NewInviteUserInvitationCreated(body *InviteUserResponseBody) *identity.Invitation {
+	v := &identity.Invitation{
+		ID:          *body.ID,
+		Email:       *body.Email,
+		DisplayName: body.DisplayName,
+		InvitedBy:   *body.InvitedBy,
+		Status:      *body.Status,
+		CreatedAt:   *body.CreatedAt,
+		ExpiresAt:   *body.ExpiresAt,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewInviteUserConflict builds a identity service invite_user endpoint
+// conflict error.
+func NewInviteUserConflict(body *InviteUserConflictResponseBody) *identity.ConflictError {
+	v := &identity.ConflictError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+
+	return v
+}
+
+// NewInviteUserUnauthorized builds a identity service invite_user endpoint
+// unauthorized error.
+func NewInviteUserUnauthorized(body *InviteUserUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewListInvitationsInvitationsCollectionOK builds a "identity" service
+// "list_invitations" endpoint result from a HTTP "OK" response.
+func NewListInvitationsInvitationsCollectionOK(body *ListInvitationsResponseBody) *identity.InvitationsCollection {
+	v := &identity.InvitationsCollection{}
+	v.Invitations = make([]*identity.Invitation, len(body.Invitations))
+	for i, val := range body.Invitations {
+		if val == nil {
+			v.Invitations[i] = nil
+			continue
+		}
+		v.Invitations[i] = unmarshalInvitationResponseBodyToIdentityInvitation(val)
+	}
+
+	return v
+}
+
+// NewListInvitationsUnauthorized builds a identity service list_invitations
+// endpoint unauthorized error.
+func NewListInvitationsUnauthorized(body *ListInvitationsUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewRevokeInvitationNotFound builds a identity service revoke_invitation
+// endpoint not_found error.
+func NewRevokeInvitationNotFound(body *RevokeInvitationNotFoundResponseBody) *identity.NotFoundError {
+	v := &identity.NotFoundError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewRevokeInvitationUnauthorized builds a identity service revoke_invitation
+// endpoint unauthorized error.
+func NewRevokeInvitationUnauthorized(body *RevokeInvitationUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewAcceptInvitationUserCreated builds a "identity" service
+// "accept_invitation" endpoint result from a HTTP "Created" response.
+func NewAcceptInvitationUserCreated(body *AcceptInvitationResponseBody) *identityviews.UserView {
+	v := &identityviews.UserView{
+		ID:              body.ID,
+		Email:           body.Email,
+		DisplayName:     body.DisplayName,
+		CreatedAt:       body.CreatedAt,
+		Status:          body.Status,
+		StatusReason:    body.StatusReason,
+		StatusChangedAt: body.StatusChangedAt,
+		Username:        body.Username,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewAcceptInvitationConflict builds a identity service accept_invitation
+// endpoint conflict error.
+func NewAcceptInvitationConflict(body *AcceptInvitationConflictResponseBody) *identity.ConflictError {
+	v := &identity.ConflictError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+
+	return v
+}
+
+// NewAcceptInvitationPasswordPolicy builds a identity service
+// accept_invitation endpoint password_policy error.
+func NewAcceptInvitationPasswordPolicy(body *AcceptInvitationPasswordPolicyResponseBody) *identity.PasswordPolicyError {
+	v := &identity.PasswordPolicyError{
+		Message: *body.Message,
+		ID:      body.ID,
+	}
+	v.Violations = make([]*identity.PolicyViolation, len(body.Violations))
+	for i, val := range body.Violations {
+		if val == nil {
+			v.Violations[i] = nil
+			continue
+		}
+		v.Violations[i] = unmarshalPolicyViolationResponseBodyToIdentityPolicyViolation(val)
+	}
+
+	return v
+}
+
+// NewAcceptInvitationUnauthorized builds a identity service accept_invitation
+// endpoint unauthorized error.
+func NewAcceptInvitationUnauthorized(body *AcceptInvitationUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewDeviceAuthorizationResultOK builds a "identity" service
+// "device_authorization" endpoint result from a HTTP "OK" response.
+func NewDeviceAuthorizationResultOK(body *DeviceAuthorizationResponseBody) *identity.DeviceAuthorizationResult {
+	v := &identity.DeviceAuthorizationResult{
+		DeviceCode:              *body.DeviceCode,
+		UserCode:                *body.UserCode,
+		VerificationURI:         *body.VerificationURI,
+		VerificationURIComplete: *body.VerificationURIComplete,
+		ExpiresIn:               *body.ExpiresIn,
+		Interval:                *body.Interval,
+	}
+
+	return v
+}
+
+// NewDeviceAuthorizationInvalidClient builds a identity service
+// device_authorization endpoint invalid_client error.
+func NewDeviceAuthorizationInvalidClient(body *DeviceAuthorizationInvalidClientResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewDeviceAuthorizationInvalidScope builds a identity service
+// device_authorization endpoint invalid_scope error.
+func NewDeviceAuthorizationInvalidScope(body *DeviceAuthorizationInvalidScopeResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewDeviceAuthorizationInvalidTarget builds a identity service
+// device_authorization endpoint invalid_target error.
+func NewDeviceAuthorizationInvalidTarget(body *DeviceAuthorizationInvalidTargetResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewDeviceTokenResultOK builds a "identity" service "device_token" endpoint
+// result from a HTTP "OK" response.
+func NewDeviceTokenResultOK(body *DeviceTokenResponseBody) *identity.DeviceTokenResult {
+	v := &identity.DeviceTokenResult{
+		AccessToken: *body.AccessToken,
+		TokenType:   *body.TokenType,
+		ExpiresIn:   *body.ExpiresIn,
+		Scope:       body.Scope,
+	}
+
+	return v
+}
+
+// NewDeviceTokenAuthorizationPending builds a identity service device_token
+// endpoint authorization_pending error.
+func NewDeviceTokenAuthorizationPending(body *DeviceTokenAuthorizationPendingResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewDeviceTokenSlowDown builds a identity service device_token endpoint
+// slow_down error.
+func NewDeviceTokenSlowDown(body *DeviceTokenSlowDownResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewDeviceTokenAccessDenied builds a identity service device_token endpoint
+// access_denied error.
+func NewDeviceTokenAccessDenied(body *DeviceTokenAccessDeniedResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewDeviceTokenExpiredToken builds a identity service device_token endpoint
+// expired_token error.
+func NewDeviceTokenExpiredToken(body *DeviceTokenExpiredTokenResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewDeviceTokenInvalidGrant builds a identity service device_token endpoint
+// invalid_grant error.
+func NewDeviceTokenInvalidGrant(body *DeviceTokenInvalidGrantResponseBody) *identity.OAuthError {
+	v := &identity.OAuthError{
+		Code:             *body.Code,
+		ErrorDescription: body.ErrorDescription,
+	}
+
+	return v
+}
+
+// NewApproveDeviceNotFound builds a identity service approve_device endpoint
+// not_found error.
+func NewApproveDeviceNotFound(body *ApproveDeviceNotFoundResponseBody) *identity.NotFoundError {
+	v := &identity.NotFoundError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewApproveDeviceUnauthorized builds a identity service approve_device
+// endpoint unauthorized error.
+func NewApproveDeviceUnauthorized(body *ApproveDeviceUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewCreateWebhookWebhookSubscriptionCreated builds a "identity" service
+// "create_webhook" endpoint result from a HTTP "Created" response.
+func NewCreateWebhookWebhookSubscriptionCreated(body *CreateWebhookResponseBody) *identity.WebhookSubscription {
+	v := &identity.WebhookSubscription{
+		ID:        *body.ID,
+		URL:       *body.URL,
+		Active:    *body.Active,
+		Secret:    body.Secret,
+		CreatedAt: *body.CreatedAt,
+	}
+	v.EventTypes = make([]string, len(body.EventTypes))
+	for i, val := range body.EventTypes {
+		v.EventTypes[i] = val
+	}
+
+	return v
+}
+
+// NewCreateWebhookUnauthorized builds a identity service create_webhook
+// endpoint unauthorized error.
+func NewCreateWebhookUnauthorized(body *CreateWebhookUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewListWebhooksWebhookSubscriptionsCollectionOK builds a "identity" service
+// "list_webhooks" endpoint result from a HTTP "OK" response.
+func NewListWebhooksWebhookSubscriptionsCollectionOK(body *ListWebhooksResponseBody) *identity.WebhookSubscriptionsCollection {
+	v := &identity.WebhookSubscriptionsCollection{}
+	v.Subscriptions = make([]*identity.WebhookSubscription, len(body.Subscriptions))
+	for i, val := range body.Subscriptions {
+		if val == nil {
+			v.Subscriptions[i] = nil
+			continue
+		}
+		v.Subscriptions[i] = unmarshalWebhookSubscriptionResponseBodyToIdentityWebhookSubscription(val)
+	}
+
+	return v
+}
+
+// NewListWebhooksUnauthorized builds a identity service list_webhooks endpoint
+// unauthorized error.
+func NewListWebhooksUnauthorized(body *ListWebhooksUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewDeleteWebhookNotFound builds a identity service delete_webhook endpoint
+// not_found error.
+func NewDeleteWebhookNotFound(body *DeleteWebhookNotFoundResponseBody) *identity.NotFoundError {
+	v := &identity.NotFoundError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewDeleteWebhookUnauthorized builds a identity service delete_webhook
+// endpoint unauthorized error.
+func NewDeleteWebhookUnauthorized(body *DeleteWebhookUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewListWebhookDeliveriesWebhookDeliveriesCollectionOK builds a "identity"
+// service "list_webhook_deliveries" endpoint result from a HTTP "OK" response.
+func NewListWebhookDeliveriesWebhookDeliveriesCollectionOK(body *ListWebhookDeliveriesResponseBody) *identity.WebhookDeliveriesCollection {
+	v := &identity.WebhookDeliveriesCollection{}
+	v.Deliveries = make([]*identity.WebhookDelivery, len(body.Deliveries))
+	for i, val := range body.Deliveries {
+		if val == nil {
+			v.Deliveries[i] = nil
+			continue
+		}
+		v.Deliveries[i] = unmarshalWebhookDeliveryResponseBodyToIdentityWebhookDelivery(val)
+	}
+
+	return v
+}
+
+// NewListWebhookDeliveriesNotFound builds a identity service
+// list_webhook_deliveries endpoint not_found error.
+func NewListWebhookDeliveriesNotFound(body *ListWebhookDeliveriesNotFoundResponseBody) *identity.NotFoundError {
+	v := &identity.NotFoundError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewListWebhookDeliveriesUnauthorized builds a identity service
+// list_webhook_deliveries endpoint unauthorized error.
+func NewListWebhookDeliveriesUnauthorized(body *ListWebhookDeliveriesUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewRedeliverWebhookWebhookDeliveryAccepted builds a "identity" service
+// "redeliver_webhook" endpoint result from a HTTP "Accepted" response.
+func NewRedeliverWebhookWebhookDeliveryAccepted(body *RedeliverWebhookResponseBody) *identity.WebhookDelivery {
+	v := &identity.WebhookDelivery{
+		ID:             *body.ID,
+		SubscriptionID: *body.SubscriptionID,
+		EventType:      *body.EventType,
+		Status:         *body.Status,
+		Attempts:       *body.Attempts,
+		ResponseStatus: body.ResponseStatus,
+		LastError:      body.LastError,
+		CreatedAt:      *body.CreatedAt,
+		LastAttemptAt:  body.LastAttemptAt,
+		NextAttemptAt:  body.NextAttemptAt,
+		DeliveredAt:    body.DeliveredAt,
+	}
+
+	return v
+}
+
+// NewRedeliverWebhookNotFound builds a identity service redeliver_webhook
+// endpoint not_found error.
+func NewRedeliverWebhookNotFound(body *RedeliverWebhookNotFoundResponseBody) *identity.NotFoundError {
+	v := &identity.NotFoundError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewRedeliverWebhookUnauthorized builds a identity service redeliver_webhook
+// endpoint unauthorized error.
+func NewRedeliverWebhookUnauthorized(body *RedeliverWebhookUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewSetUserStatusUserOK builds a "identity" service "set_user_status"
+// endpoint result from a HTTP "OK" response.
+func NewSetUserStatusUserOK(body *SetUserStatusResponseBody) *identityviews.UserView {
+	v := &identityviews.UserView{
+		ID:              body.ID,
+		Email:           body.Email,
+		DisplayName:     body.DisplayName,
+		CreatedAt:       body.CreatedAt,
+		Status:          body.Status,
+		StatusReason:    body.StatusReason,
+		StatusChangedAt: body.StatusChangedAt,
+		Username:        body.Username,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewSetUserStatusNotFound builds a identity service set_user_status endpoint
+// not_found error.
+func NewSetUserStatusNotFound(body *SetUserStatusNotFoundResponseBody) *identity.NotFoundError {
+	v := &identity.NotFoundError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// NewSetUserStatusUnauthorized builds a identity service set_user_status
+// endpoint unauthorized error.
+func NewSetUserStatusUnauthorized(body *SetUserStatusUnauthorizedResponseBody) *identity.UnauthorizedError {
+	v := &identity.UnauthorizedError{
+		Message:   *body.Message,
+		ID:        body.ID,
+		Temporary: body.Temporary,
+		Timeout:   body.Timeout,
+	}
+
+	return v
+}
+
+// ValidateLoginResponseBody runs the validations defined on LoginResponseBody
+func ValidateLoginResponseBody(body *LoginResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	return
+}
+
+// ValidateCheckUsernameResponseBody runs the validations defined on
+// check_username_response_body
+func ValidateCheckUsernameResponseBody(body *CheckUsernameResponseBody) (err error) {
+	if body.Username == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("username", "body"))
+	}
+	if body.Available == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("available", "body"))
+	}
+	return
+}
+
+// ValidateConsumeMagicLinkResponseBody runs the validations defined on
+// consume_magic_link_response_body
+func ValidateConsumeMagicLinkResponseBody(body *ConsumeMagicLinkResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	return
+}
+
+// ValidateValidateTokenResponseBody runs the validations defined on
+// validate_token_response_body
+func ValidateValidateTokenResponseBody(body *ValidateTokenResponseBody) (err error) {
+	if body.Valid == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("valid", "body"))
+	}
+	if body.Actor != nil {
+		if err2 := ValidateActorResponseBody(body.Actor); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateValidateTokensResponseBody runs the validations defined on
+// validate_tokens_response_body
+func ValidateValidateTokensResponseBody(body *ValidateTokensResponseBody) (err error) {
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateValidationResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListAuthEventsResponseBody runs the validations defined on
+// list_auth_events_response_body
+func ValidateListAuthEventsResponseBody(body *ListAuthEventsResponseBody) (err error) {
+	if body.Events == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("events", "body"))
+	}
+	for _, e := range body.Events {
+		if e != nil {
+			if err2 := ValidateAuthEventResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateIntrospectResponseBody runs the validations defined on
+// IntrospectResponseBody
+func ValidateIntrospectResponseBody(body *IntrospectResponseBody) (err error) {
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	return
+}
+
+// ValidateExchangeTokenResponseBody runs the validations defined on
+// exchange_token_response_body
+func ValidateExchangeTokenResponseBody(body *ExchangeTokenResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.IssuedTokenType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("issued_token_type", "body"))
+	}
+	if body.TokenType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token_type", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	return
+}
+
+// ValidateInviteUserResponseBody runs the validations defined on
+// invite_user_response_body
+func ValidateInviteUserResponseBody(body *InviteUserResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.InvitedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invited_by", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "accepted" || *body.Status == "revoked" || *body.Status == "expired") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "accepted", "revoked", "expired"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListInvitationsResponseBody runs the validations defined on
+// list_invitations_response_body
+func ValidateListInvitationsResponseBody(body *ListInvitationsResponseBody) (err error) {
+	if body.Invitations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invitations", "body"))
+	}
+	for _, e := range body.Invitations {
+		if e != nil {
+			if err2 := ValidateInvitationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeviceAuthorizationResponseBody runs the validations defined on
+// device_authorization_response_body
+func ValidateDeviceAuthorizationResponseBody(body *DeviceAuthorizationResponseBody) (err error) {
+	if body.DeviceCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("device_code", "body"))
+	}
+	if body.UserCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_code", "body"))
+	}
+	if body.VerificationURI == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("verification_uri", "body"))
+	}
+	if body.VerificationURIComplete == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("verification_uri_complete", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	if body.Interval == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("interval", "body"))
+	}
+	return
+}
+
+// ValidateDeviceTokenResponseBody runs the validations defined on
+// device_token_response_body
+func ValidateDeviceTokenResponseBody(body *DeviceTokenResponseBody) (err error) {
+	if body.AccessToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("access_token", "body"))
+	}
+	if body.TokenType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token_type", "body"))
+	}
+	if body.ExpiresIn == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_in", "body"))
+	}
+	return
+}
+
+// ValidateCreateWebhookResponseBody runs the validations defined on
+// create_webhook_response_body
+func ValidateCreateWebhookResponseBody(body *CreateWebhookResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.EventTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_types", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	for _, e := range body.EventTypes {
+		if !(e == "user.registered" || e == "user.updated" || e == "user.disabled" || e == "user.deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event_types[*]", e, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateListWebhooksResponseBody runs the validations defined on
+// list_webhooks_response_body
+func ValidateListWebhooksResponseBody(body *ListWebhooksResponseBody) (err error) {
+	if body.Subscriptions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subscriptions", "body"))
+	}
+	for _, e := range body.Subscriptions {
+		if e != nil {
+			if err2 := ValidateWebhookSubscriptionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateListWebhookDeliveriesResponseBody runs the validations defined on
+// list_webhook_deliveries_response_body
+func ValidateListWebhookDeliveriesResponseBody(body *ListWebhookDeliveriesResponseBody) (err error) {
+	if body.Deliveries == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("deliveries", "body"))
+	}
+	for _, e := range body.Deliveries {
+		if e != nil {
+			if err2 := ValidateWebhookDeliveryResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRedeliverWebhookResponseBody runs the validations defined on
+// redeliver_webhook_response_body
+func ValidateRedeliverWebhookResponseBody(body *RedeliverWebhookResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.SubscriptionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subscription_id", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.EventType != nil {
+		if !(*body.EventType == "user.registered" || *body.EventType == "user.updated" || *body.EventType == "user.disabled" || *body.EventType == "user.deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event_type", *body.EventType, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "succeeded" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "succeeded", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.LastAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_attempt_at", *body.LastAttemptAt, goa.FormatDateTime))
+	}
+	if body.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_attempt_at", *body.NextAttemptAt, goa.FormatDateTime))
+	}
+	if body.DeliveredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.delivered_at", *body.DeliveredAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateRegisterConflictResponseBody runs the validations defined on
+// register_conflict_response_body
+func ValidateRegisterConflictResponseBody(body *RegisterConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRegisterInvalidUsernameResponseBody runs the validations defined on
+// register_invalid_username_response_body
+func ValidateRegisterInvalidUsernameResponseBody(body *RegisterInvalidUsernameResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRegisterPasswordPolicyResponseBody runs the validations defined on
+// register_password_policy_response_body
+func ValidateRegisterPasswordPolicyResponseBody(body *RegisterPasswordPolicyResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Violations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("violations", "body"))
+	}
+	for _, e := range body.Violations {
+		if e != nil {
+			if err2 := ValidatePolicyViolationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRegisterUnauthorizedResponseBody runs the validations defined on
+// register_unauthorized_response_body
+func ValidateRegisterUnauthorizedResponseBody(body *RegisterUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetUsernameConflictResponseBody runs the validations defined on
+// set_username_conflict_response_body
+func ValidateSetUsernameConflictResponseBody(body *SetUsernameConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetUsernameInvalidUsernameResponseBody runs the validations defined
+// on set_username_invalid_username_response_body
+func ValidateSetUsernameInvalidUsernameResponseBody(body *SetUsernameInvalidUsernameResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetUsernameUnauthorizedResponseBody runs the validations defined on
+// set_username_unauthorized_response_body
+func ValidateSetUsernameUnauthorizedResponseBody(body *SetUsernameUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRequestMagicLinkUnauthorizedResponseBody runs the validations
+// defined on request_magic_link_unauthorized_response_body
+func ValidateRequestMagicLinkUnauthorizedResponseBody(body *RequestMagicLinkUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateConsumeMagicLinkUnauthorizedResponseBody runs the validations
+// defined on consume_magic_link_unauthorized_response_body
+func ValidateConsumeMagicLinkUnauthorizedResponseBody(body *ConsumeMagicLinkUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListAuthEventsBadRequestResponseBody runs the validations defined on
+// list_auth_events_bad_request_response_body
+func ValidateListAuthEventsBadRequestResponseBody(body *ListAuthEventsBadRequestResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListAuthEventsUnauthorizedResponseBody runs the validations defined
+// on list_auth_events_unauthorized_response_body
+func ValidateListAuthEventsUnauthorizedResponseBody(body *ListAuthEventsUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateIntrospectUnauthorizedResponseBody runs the validations defined on
+// introspect_unauthorized_response_body
+func ValidateIntrospectUnauthorizedResponseBody(body *IntrospectUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeTokenUnsupportedTokenTypeResponseBody runs the validations
+// defined on revoke_token_unsupported_token_type_response_body
+func ValidateRevokeTokenUnsupportedTokenTypeResponseBody(body *RevokeTokenUnsupportedTokenTypeResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateRevokeTokenUnauthorizedResponseBody runs the validations defined on
+// revoke_token_unauthorized_response_body
+func ValidateRevokeTokenUnauthorizedResponseBody(body *RevokeTokenUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateExchangeTokenNotFoundResponseBody runs the validations defined on
+// exchange_token_not_found_response_body
+func ValidateExchangeTokenNotFoundResponseBody(body *ExchangeTokenNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateExchangeTokenUnauthorizedResponseBody runs the validations defined
+// on exchange_token_unauthorized_response_body
+func ValidateExchangeTokenUnauthorizedResponseBody(body *ExchangeTokenUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateInviteUserConflictResponseBody runs the validations defined on
+// invite_user_conflict_response_body
+func ValidateInviteUserConflictResponseBody(body *InviteUserConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateInviteUserUnauthorizedResponseBody runs the validations defined on
+// invite_user_unauthorized_response_body
+func ValidateInviteUserUnauthorizedResponseBody(body *InviteUserUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListInvitationsUnauthorizedResponseBody runs the validations defined
+// on list_invitations_unauthorized_response_body
+func ValidateListInvitationsUnauthorizedResponseBody(body *ListInvitationsUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeInvitationNotFoundResponseBody runs the validations defined on
+// revoke_invitation_not_found_response_body
+func ValidateRevokeInvitationNotFoundResponseBody(body *RevokeInvitationNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRevokeInvitationUnauthorizedResponseBody runs the validations
+// defined on revoke_invitation_unauthorized_response_body
+func ValidateRevokeInvitationUnauthorizedResponseBody(body *RevokeInvitationUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAcceptInvitationConflictResponseBody runs the validations defined on
+// accept_invitation_conflict_response_body
+func ValidateAcceptInvitationConflictResponseBody(body *AcceptInvitationConflictResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAcceptInvitationPasswordPolicyResponseBody runs the validations
+// defined on accept_invitation_password_policy_response_body
+func ValidateAcceptInvitationPasswordPolicyResponseBody(body *AcceptInvitationPasswordPolicyResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.Violations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("violations", "body"))
+	}
+	for _, e := range body.Violations {
+		if e != nil {
+			if err2 := ValidatePolicyViolationResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAcceptInvitationUnauthorizedResponseBody runs the validations
+// defined on accept_invitation_unauthorized_response_body
+func ValidateAcceptInvitationUnauthorizedResponseBody(body *AcceptInvitationUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeviceAuthorizationInvalidClientResponseBody runs the validations
+// defined on device_authorization_invalid_client_response_body
+func ValidateDeviceAuthorizationInvalidClientResponseBody(body *DeviceAuthorizationInvalidClientResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateDeviceAuthorizationInvalidScopeResponseBody runs the validations
+// defined on device_authorization_invalid_scope_response_body
+func ValidateDeviceAuthorizationInvalidScopeResponseBody(body *DeviceAuthorizationInvalidScopeResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateDeviceAuthorizationInvalidTargetResponseBody runs the validations
+// defined on device_authorization_invalid_target_response_body
+func ValidateDeviceAuthorizationInvalidTargetResponseBody(body *DeviceAuthorizationInvalidTargetResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateDeviceTokenAuthorizationPendingResponseBody runs the validations
+// defined on device_token_authorization_pending_response_body
+func ValidateDeviceTokenAuthorizationPendingResponseBody(body *DeviceTokenAuthorizationPendingResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateDeviceTokenSlowDownResponseBody runs the validations defined on
+// device_token_slow_down_response_body
+func ValidateDeviceTokenSlowDownResponseBody(body *DeviceTokenSlowDownResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateDeviceTokenAccessDeniedResponseBody runs the validations defined on
+// device_token_access_denied_response_body
+func ValidateDeviceTokenAccessDeniedResponseBody(body *DeviceTokenAccessDeniedResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateDeviceTokenExpiredTokenResponseBody runs the validations defined on
+// device_token_expired_token_response_body
+func ValidateDeviceTokenExpiredTokenResponseBody(body *DeviceTokenExpiredTokenResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateDeviceTokenInvalidGrantResponseBody runs the validations defined on
+// device_token_invalid_grant_response_body
+func ValidateDeviceTokenInvalidGrantResponseBody(body *DeviceTokenInvalidGrantResponseBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("error", "body"))
+	}
+	return
+}
+
+// ValidateApproveDeviceNotFoundResponseBody runs the validations defined on
+// approve_device_not_found_response_body
+func ValidateApproveDeviceNotFoundResponseBody(body *ApproveDeviceNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateApproveDeviceUnauthorizedResponseBody runs the validations defined
+// on approve_device_unauthorized_response_body
+func ValidateApproveDeviceUnauthorizedResponseBody(body *ApproveDeviceUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateWebhookUnauthorizedResponseBody runs the validations defined
+// on create_webhook_unauthorized_response_body
+func ValidateCreateWebhookUnauthorizedResponseBody(body *CreateWebhookUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListWebhooksUnauthorizedResponseBody runs the validations defined on
+// list_webhooks_unauthorized_response_body
+func ValidateListWebhooksUnauthorizedResponseBody(body *ListWebhooksUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteWebhookNotFoundResponseBody runs the validations defined on
+// delete_webhook_not_found_response_body
+func ValidateDeleteWebhookNotFoundResponseBody(body *DeleteWebhookNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteWebhookUnauthorizedResponseBody runs the validations defined
+// on delete_webhook_unauthorized_response_body
+func ValidateDeleteWebhookUnauthorizedResponseBody(body *DeleteWebhookUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListWebhookDeliveriesNotFoundResponseBody runs the validations
+// defined on list_webhook_deliveries_not_found_response_body
+func ValidateListWebhookDeliveriesNotFoundResponseBody(body *ListWebhookDeliveriesNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateListWebhookDeliveriesUnauthorizedResponseBody runs the validations
+// defined on list_webhook_deliveries_unauthorized_response_body
+func ValidateListWebhookDeliveriesUnauthorizedResponseBody(body *ListWebhookDeliveriesUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRedeliverWebhookNotFoundResponseBody runs the validations defined on
+// redeliver_webhook_not_found_response_body
+func ValidateRedeliverWebhookNotFoundResponseBody(body *RedeliverWebhookNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRedeliverWebhookUnauthorizedResponseBody runs the validations
+// defined on redeliver_webhook_unauthorized_response_body
+func ValidateRedeliverWebhookUnauthorizedResponseBody(body *RedeliverWebhookUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetUserStatusNotFoundResponseBody runs the validations defined on
+// set_user_status_not_found_response_body
+func ValidateSetUserStatusNotFoundResponseBody(body *SetUserStatusNotFoundResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSetUserStatusUnauthorizedResponseBody runs the validations defined
+// on set_user_status_unauthorized_response_body
+func ValidateSetUserStatusUnauthorizedResponseBody(body *SetUserStatusUnauthorizedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidatePolicyViolationResponseBody runs the validations defined on
+// PolicyViolationResponseBody
+func ValidatePolicyViolationResponseBody(body *PolicyViolationResponseBody) (err error) {
+	if body.Rule == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rule", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateActorResponseBody runs the validations defined on ActorResponseBody
+func ValidateActorResponseBody(body *ActorResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	return
+}
+
+// ValidateValidationResultResponseBody runs the validations defined on
+// ValidationResultResponseBody
+func ValidateValidationResultResponseBody(body *ValidationResultResponseBody) (err error) {
+	if body.Valid == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("valid", "body"))
+	}
+	if body.Actor != nil {
+		if err2 := ValidateActorResponseBody(body.Actor); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateAuthEventResponseBody runs the validations defined on
+// AuthEventResponseBody
+func ValidateAuthEventResponseBody(body *AuthEventResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "register" || *body.Type == "login" || *body.Type == "validate_token" || *body.Type == "token_revoked" || *body.Type == "token_exchange" || *body.Type == "magic_link" || *body.Type == "invitation" || *body.Type == "device_authorization" || *body.Type == "provisioning" || *body.Type == "user_status") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"register", "login", "validate_token", "token_revoked", "token_exchange", "magic_link", "invitation", "device_authorization", "provisioning", "user_status"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateInvitationResponseBody runs the validations defined on
+// InvitationResponseBody
+func ValidateInvitationResponseBody(body *InvitationResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.InvitedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invited_by", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expires_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "accepted" || *body.Status == "revoked" || *body.Status == "expired") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "accepted", "revoked", "expired"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expires_at", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateWebhookSubscriptionResponseBody runs the validations defined on
+// WebhookSubscriptionResponseBody
+func ValidateWebhookSubscriptionResponseBody(body *WebhookSubscriptionResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.EventTypes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_types", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	for _, e := range body.EventTypes {
+		if !(e == "user.registered" || e == "user.updated" || e == "user.disabled" || e == "user.deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event_types[*]", e, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateWebhookDeliveryResponseBody runs the validations defined on
+// WebhookDeliveryResponseBody
+func ValidateWebhookDeliveryResponseBody(body *WebhookDeliveryResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.SubscriptionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("subscription_id", "body"))
+	}
+	if body.EventType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("event_type", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.EventType != nil {
+		if !(*body.EventType == "user.registered" || *body.EventType == "user.updated" || *body.EventType == "user.disabled" || *body.EventType == "user.deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event_type", *body.EventType, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "succeeded" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "succeeded", "failed"}))
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created_at", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.LastAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.last_attempt_at", *body.LastAttemptAt, goa.FormatDateTime))
+	}
+	if body.NextAttemptAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.next_attempt_at", *body.NextAttemptAt, goa.FormatDateTime))
+	}
+	if body.DeliveredAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.delivered_at", *body.DeliveredAt, goa.FormatDateTime))
 	}
 	return
 }
@@ -7,6 +7,10 @@
 
 package server
 
+import (
+	"fmt"
+)
+
 // RegisterIdentityPath returns the URL path to the identity service register HTTP endpoint.
 func RegisterIdentityPath() string {
 	return "/v1/identity/register"
@@ -17,7 +21,117 @@ func LoginIdentityPath() string {
 	return "/v1/identity/login"
 }
 
+// SetUsernameIdentityPath returns the URL path to the identity service set_username HTTP endpoint.
+func SetUsernameIdentityPath() string {
+	return "/v1/identity/me/username"
+}
+
+// CheckUsernameIdentityPath returns the URL path to the identity service check_username HTTP endpoint.
+func CheckUsernameIdentityPath(username string) string {
+	return fmt.Sprintf("/v1/identity/usernames/%v/availability", username)
+}
+
+// RequestMagicLinkIdentityPath returns the URL path to the identity service request_magic_link HTTP endpoint.
+func RequestMagicLinkIdentityPath() string {
+	return "/v1/identity/magic-link"
+}
+
+// ConsumeMagicLinkIdentityPath returns the URL path to the identity service consume_magic_link HTTP endpoint.
+func ConsumeMagicLinkIdentityPath() string {
+	return "/v1/identity/magic-link/consume"
+}
+
 // ValidateTokenIdentityPath returns the URL path to the identity service validate_token HTTP endpoint.
 func ValidateTokenIdentityPath() string {
 	return "/v1/identity/validate"
 }
+
+// ValidateTokensIdentityPath returns the URL path to the identity service validate_tokens HTTP endpoint.
+func ValidateTokensIdentityPath() string {
+	return "/v1/identity/validate/batch"
+}
+
+// ListAuthEventsIdentityPath returns the URL path to the identity service list_auth_events HTTP endpoint.
+func ListAuthEventsIdentityPath() string {
+	return "/v1/identity/admin/auth-events"
+}
+
+// IntrospectIdentityPath returns the URL path to the identity service introspect HTTP endpoint.
+func IntrospectIdentityPath() string {
+	return "/oauth/introspect"
+}
+
+// RevokeTokenIdentityPath returns the URL path to the identity service revoke_token HTTP endpoint.
+func RevokeTokenIdentityPath() string {
+	return "/oauth/revoke"
+}
+
+// ExchangeTokenIdentityPath returns the URL path to the identity service exchange_token HTTP endpoint.
+func ExchangeTokenIdentityPath() string {
+	return "/v1/identity/token/exchange"
+}
+
+// InviteUserIdentityPath returns the URL path to the identity service invite_user HTTP endpoint.
+func InviteUserIdentityPath() string {
+	return "/v1/identity/invitations"
+}
+
+// ListInvitationsIdentityPath returns the URL path to the identity service list_invitations HTTP endpoint.
+func ListInvitationsIdentityPath() string {
+	return "/v1/identity/invitations"
+}
+
+// RevokeInvitationIdentityPath returns the URL path to the identity service revoke_invitation HTTP endpoint.
+func RevokeInvitationIdentityPath(id string) string {
+	return fmt.Sprintf("/v1/identity/invitations/%v", id)
+}
+
+// AcceptInvitationIdentityPath returns the URL path to the identity service accept_invitation HTTP endpoint.
+func AcceptInvitationIdentityPath() string {
+	return "/v1/identity/invitations/accept"
+}
+
+// DeviceAuthorizationIdentityPath returns the URL path to the identity service device_authorization HTTP endpoint.
+func DeviceAuthorizationIdentityPath() string {
+	return "/oauth/device_authorization"
+}
+
+// DeviceTokenIdentityPath returns the URL path to the identity service device_token HTTP endpoint.
+func DeviceTokenIdentityPath() string {
+	return "/oauth/token"
+}
+
+// ApproveDeviceIdentityPath returns the URL path to the identity service approve_device HTTP endpoint.
+func ApproveDeviceIdentityPath() string {
+	return "/v1/identity/device/approve"
+}
+
+// CreateWebhookIdentityPath returns the URL path to the identity service create_webhook HTTP endpoint.
+func CreateWebhookIdentityPath() string {
+	return "/v1/identity/admin/webhooks"
+}
+
+// ListWebhooksIdentityPath returns the URL path to the identity service list_webhooks HTTP endpoint.
+func ListWebhooksIdentityPath() string {
+	return "/v1/identity/admin/webhooks"
+}
+
+// DeleteWebhookIdentityPath returns the URL path to the identity service delete_webhook HTTP endpoint.
+func DeleteWebhookIdentityPath(id string) string {
+	return fmt.Sprintf("/v1/identity/admin/webhooks/%v", id)
+}
+
+// ListWebhookDeliveriesIdentityPath returns the URL path to the identity service list_webhook_deliveries HTTP endpoint.
+func ListWebhookDeliveriesIdentityPath(id string) string {
+	return fmt.Sprintf("/v1/identity/admin/webhooks/%v/deliveries", id)
+}
+
+// RedeliverWebhookIdentityPath returns the URL path to the identity service redeliver_webhook HTTP endpoint.
+func RedeliverWebhookIdentityPath(id string) string {
+	return fmt.Sprintf("/v1/identity/admin/webhook-deliveries/%v/redeliver", id)
+}
+
+// SetUserStatusIdentityPath returns the URL path to the identity service set_user_status HTTP endpoint.
+func SetUserStatusIdentityPath(id string) string {
+	return fmt.Sprintf("/v1/identity/admin/users/%v/status", id)
+}
@@ -19,11 +19,34 @@ import (
 
 // Server lists the identity service endpoint HTTP handlers.
 type Server struct {
-	Mounts             []*MountPoint
-	Register           http.Handler
-	Login              http.Handler
-	ValidateToken      http.Handler
-	GenHTTPOpenapiJSON http.Handler
+	Mounts                []*MountPoint
+	Register              http.Handler
+	Login                 http.Handler
+	SetUsername           http.Handler
+	CheckUsername         http.Handler
+	RequestMagicLink      http.Handler
+	ConsumeMagicLink      http.Handler
+	ValidateToken         http.Handler
+	ValidateTokens        http.Handler
+	ListAuthEvents        http.Handler
+	Introspect            http.Handler
+	RevokeToken           http.Handler
+	ExchangeToken         http.Handler
+	InviteUser            http.Handler
+	ListInvitations       http.Handler
+	RevokeInvitation      http.Handler
+	AcceptInvitation      http.Handler
+	DeviceAuthorization   http.Handler
+	DeviceToken           http.Handler
+	ApproveDevice         http.Handler
+	CreateWebhook         http.Handler
+	ListWebhooks          http.Handler
+	DeleteWebhook         http.Handler
+	ListWebhookDeliveries http.Handler
+	RedeliverWebhook      http.Handler
+	SetUserStatus         http.Handler
+	StaticDeviceHTML      http.Handler
+	GenHTTPOpenapiJSON    http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -50,8 +73,13 @@ func New(
 	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
 	errhandler func(context.Context, http.ResponseWriter, error),
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	fileSystemStaticDeviceHTML http.FileSystem,
 	fileSystemGenHTTPOpenapiJSON http.FileSystem,
 ) *Server {
+	if fileSystemStaticDeviceHTML == nil {
+		fileSystemStaticDeviceHTML = http.Dir(".")
+	}
+	fileSystemStaticDeviceHTML = appendPrefix(fileSystemStaticDeviceHTML, "/static")
 	if fileSystemGenHTTPOpenapiJSON == nil {
 		fileSystemGenHTTPOpenapiJSON = http.Dir(".")
 	}
@@ -60,13 +88,59 @@ func New(
 		Mounts: []*MountPoint{
 			{"Register", "POST", "/v1/identity/register"},
 			{"Login", "POST", "/v1/identity/login"},
+			{"SetUsername", "PUT", "/v1/identity/me/username"},
+			{"CheckUsername", "GET", "/v1/identity/usernames/{username}/availability"},
+			{"RequestMagicLink", "POST", "/v1/identity/magic-link"},
+			{"ConsumeMagicLink", "POST", "/v1/identity/magic-link/consume"},
 			{"ValidateToken", "POST", "/v1/identity/validate"},
+			{"ValidateTokens", "POST", "/v1/identity/validate/batch"},
+			{"ListAuthEvents", "GET", "/v1/identity/admin/auth-events"},
+			{"Introspect", "POST", "/oauth/introspect"},
+			{"RevokeToken", "POST", "/oauth/revoke"},
+			{"ExchangeToken", "POST", "/v1/identity/token/exchange"},
+			{"InviteUser", "POST", "/v1/identity/invitations"},
+			{"ListInvitations", "GET", "/v1/identity/invitations"},
+			{"RevokeInvitation", "DELETE", "/v1/identity/invitations/{id}"},
+			{"AcceptInvitation", "POST", "/v1/identity/invitations/accept"},
+			{"DeviceAuthorization", "POST", "/oauth/device_authorization"},
+			{"DeviceToken", "POST", "/oauth/token"},
+			{"ApproveDevice", "POST", "/v1/identity/device/approve"},
+			{"CreateWebhook", "POST", "/v1/identity/admin/webhooks"},
+			{"ListWebhooks", "GET", "/v1/identity/admin/webhooks"},
+			{"DeleteWebhook", "DELETE", "/v1/identity/admin/webhooks/{id}"},
+			{"ListWebhookDeliveries", "GET", "/v1/identity/admin/webhooks/{id}/deliveries"},
+			{"RedeliverWebhook", "POST", "/v1/identity/admin/webhook-deliveries/{id}/redeliver"},
+			{"SetUserStatus", "PUT", "/v1/identity/admin/users/{id}/status"},
+			{"Serve static/device.html", "GET", "/device.html"},
 			{"Serve gen/http/openapi.json", "GET", "/openapi.json"},
 		},
-		Register:           NewRegisterHandler(e.Register, mux, decoder, encoder, errhandler, formatter),
-		Login:              NewLoginHandler(e.Login, mux, decoder, encoder, errhandler, formatter),
-		ValidateToken:      NewValidateTokenHandler(e.ValidateToken, mux, decoder, encoder, errhandler, formatter),
-		GenHTTPOpenapiJSON: http.FileServer(fileSystemGenHTTPOpenapiJSON),
+		Register:              NewRegisterHandler(e.Register, mux, decoder, encoder, errhandler, formatter),
+		Login:                 NewLoginHandler(e.Login, mux, decoder, encoder, errhandler, formatter),
+		SetUsername:           NewSetUsernameHandler(e.SetUsername, mux, decoder, encoder, errhandler, formatter),
+		CheckUsername:         NewCheckUsernameHandler(e.CheckUsername, mux, decoder, encoder, errhandler, formatter),
+		RequestMagicLink:      NewRequestMagicLinkHandler(e.RequestMagicLink, mux, decoder, encoder, errhandler, formatter),
+		ConsumeMagicLink:      NewConsumeMagicLinkHandler(e.ConsumeMagicLink, mux, decoder, encoder, errhandler, formatter),
+		ValidateToken:         NewValidateTokenHandler(e.ValidateToken, mux, decoder, encoder, errhandler, formatter),
+		ValidateTokens:        NewValidateTokensHandler(e.ValidateTokens, mux, decoder, encoder, errhandler, formatter),
+		ListAuthEvents:        NewListAuthEventsHandler(e.ListAuthEvents, mux, decoder, encoder, errhandler, formatter),
+		Introspect:            NewIntrospectHandler(e.Introspect, mux, decoder, encoder, errhandler, formatter),
+		RevokeToken:           NewRevokeTokenHandler(e.RevokeToken, mux, decoder, encoder, errhandler, formatter),
+		ExchangeToken:         NewExchangeTokenHandler(e.ExchangeToken, mux, decoder, encoder, errhandler, formatter),
+		InviteUser:            NewInviteUserHandler(e.InviteUser, mux, decoder, encoder, errhandler, formatter),
+		ListInvitations:       NewListInvitationsHandler(e.ListInvitations, mux, decoder, encoder, errhandler, formatter),
+		RevokeInvitation:      NewRevokeInvitationHandler(e.RevokeInvitation, mux, decoder, encoder, errhandler, formatter),
+		AcceptInvitation:      NewAcceptInvitationHandler(e.AcceptInvitation, mux, decoder, encoder, errhandler, formatter),
+		DeviceAuthorization:   NewDeviceAuthorizationHandler(e.DeviceAuthorization, mux, decoder, encoder, errhandler, formatter),
+		DeviceToken:           NewDeviceTokenHandler(e.DeviceToken, mux, decoder, encoder, errhandler, formatter),
+		ApproveDevice:         NewApproveDeviceHandler(e.ApproveDevice, mux, decoder, encoder, errhandler, formatter),
+		CreateWebhook:         NewCreateWebhookHandler(e.CreateWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhooks:          NewListWebhooksHandler(e.ListWebhooks, mux, decoder, encoder, errhandler, formatter),
+		DeleteWebhook:         NewDeleteWebhookHandler(e.DeleteWebhook, mux, decoder, encoder, errhandler, formatter),
+		ListWebhookDeliveries: NewListWebhookDeliveriesHandler(e.ListWebhookDeliveries, mux, decoder, encoder, errhandler, formatter),
+		RedeliverWebhook:      NewRedeliverWebhookHandler(e.RedeliverWebhook, mux, decoder, encoder, errhandler, formatter),
+		SetUserStatus:         NewSetUserStatusHandler(e.SetUserStatus, mux, decoder, encoder, errhandler, formatter),
+		StaticDeviceHTML:      http.FileServer(fileSystemStaticDeviceHTML),
+		GenHTTPOpenapiJSON:    http.FileServer(fileSystemGenHTTPOpenapiJSON),
 	}
 }
 
@@ -77,7 +151,29 @@ func (s *Server) Service() string { return "identity" }
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Register = m(s.Register)
 	s.Login = m(s.Login)
+	s.SetUsername = m(s.SetUsername)
+	s.CheckUsername = m(s.CheckUsername)
+	s.RequestMagicLink = m(s.RequestMagicLink)
+	s.ConsumeMagicLink = m(s.ConsumeMagicLink)
 	s.ValidateToken = m(s.ValidateToken)
+	s.ValidateTokens = m(s.ValidateTokens)
+	s.ListAuthEvents = m(s.ListAuthEvents)
+	s.Introspect = m(s.Introspect)
+	s.RevokeToken = m(s.RevokeToken)
+	s.ExchangeToken = m(s.ExchangeToken)
+	s.InviteUser = m(s.InviteUser)
+	s.ListInvitations = m(s.ListInvitations)
+	s.RevokeInvitation = m(s.RevokeInvitation)
+	s.AcceptInvitation = m(s.AcceptInvitation)
+	s.DeviceAuthorization = m(s.DeviceAuthorization)
+	s.DeviceToken = m(s.DeviceToken)
+	s.ApproveDevice = m(s.ApproveDevice)
+	s.CreateWebhook = m(s.CreateWebhook)
+	s.ListWebhooks = m(s.ListWebhooks)
+	s.DeleteWebhook = m(s.DeleteWebhook)
+	s.ListWebhookDeliveries = m(s.ListWebhookDeliveries)
+	s.RedeliverWebhook = m(s.RedeliverWebhook)
+	s.SetUserStatus = m(s.SetUserStatus)
 }
 
 // MethodNames returns the methods served.
@@ -87,7 +183,30 @@ func (s *Server) MethodNames() []string { return identity.MethodNames[:] }
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountRegisterHandler(mux, h.Register)
 	MountLoginHandler(mux, h.Login)
+	MountSetUsernameHandler(mux, h.SetUsername)
+	MountCheckUsernameHandler(mux, h.CheckUsername)
+	MountRequestMagicLinkHandler(mux, h.RequestMagicLink)
+	MountConsumeMagicLinkHandler(mux, h.ConsumeMagicLink)
 	MountValidateTokenHandler(mux, h.ValidateToken)
+	MountValidateTokensHandler(mux, h.ValidateTokens)
+	MountListAuthEventsHandler(mux, h.ListAuthEvents)
+	MountIntrospectHandler(mux, h.Introspect)
+	MountRevokeTokenHandler(mux, h.RevokeToken)
+	MountExchangeTokenHandler(mux, h.ExchangeToken)
+	MountInviteUserHandler(mux, h.InviteUser)
+	MountListInvitationsHandler(mux, h.ListInvitations)
+	MountRevokeInvitationHandler(mux, h.RevokeInvitation)
+	MountAcceptInvitationHandler(mux, h.AcceptInvitation)
+	MountDeviceAuthorizationHandler(mux, h.DeviceAuthorization)
+	MountDeviceTokenHandler(mux, h.DeviceToken)
+	MountApproveDeviceHandler(mux, h.ApproveDevice)
+	MountCreateWebhookHandler(mux, h.CreateWebhook)
+	MountListWebhooksHandler(mux, h.ListWebhooks)
+	MountDeleteWebhookHandler(mux, h.DeleteWebhook)
+	MountListWebhookDeliveriesHandler(mux, h.ListWebhookDeliveries)
+	MountRedeliverWebhookHandler(mux, h.RedeliverWebhook)
+	MountSetUserStatusHandler(mux, h.SetUserStatus)
+	MountStaticDeviceHTML(mux, h.StaticDeviceHTML)
 	MountGenHTTPOpenapiJSON(mux, h.GenHTTPOpenapiJSON)
 }
 
@@ -121,7 +240,7 @@ func NewRegisterHandler(
 	var (
 		decodeRequest  = DecodeRegisterRequest(mux, decoder)
 		encodeResponse = EncodeRegisterResponse(encoder)
-		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+		encodeError    = EncodeRegisterError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
@@ -202,6 +321,218 @@ func NewLoginHandler(
 	})
 }
 
+// MountSetUsernameHandler configures the mux to serve the "identity" service
+// "set_username" endpoint.
+func MountSetUsernameHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/identity/me/username", f)
+}
+
+// NewSetUsernameHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "set_username" endpoint.
+func NewSetUsernameHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetUsernameRequest(mux, decoder)
+		encodeResponse = EncodeSetUsernameResponse(encoder)
+		encodeError    = EncodeSetUsernameError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "set_username")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCheckUsernameHandler configures the mux to serve the "identity" service
+// "check_username" endpoint.
+func MountCheckUsernameHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/identity/usernames/{username}/availability", f)
+}
+
+// NewCheckUsernameHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "check_username" endpoint.
+func NewCheckUsernameHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCheckUsernameRequest(mux, decoder)
+		encodeResponse = EncodeCheckUsernameResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "check_username")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRequestMagicLinkHandler configures the mux to serve the "identity"
+// service "request_magic_link" endpoint.
+func MountRequestMagicLinkHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/magic-link", f)
+}
+
+// NewRequestMagicLinkHandler creates a HTTP handler which loads the HTTP
+// request and calls the "identity" service "request_magic_link" endpoint.
+func NewRequestMagicLinkHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRequestMagicLinkRequest(mux, decoder)
+		encodeResponse = EncodeRequestMagicLinkResponse(encoder)
+		encodeError    = EncodeRequestMagicLinkError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "request_magic_link")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountConsumeMagicLinkHandler configures the mux to serve the "identity"
+// service "consume_magic_link" endpoint.
+func MountConsumeMagicLinkHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/magic-link/consume", f)
+}
+
+// NewConsumeMagicLinkHandler creates a HTTP handler which loads the HTTP
+// request and calls the "identity" service "consume_magic_link" endpoint.
+func NewConsumeMagicLinkHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeConsumeMagicLinkRequest(mux, decoder)
+		encodeResponse = EncodeConsumeMagicLinkResponse(encoder)
+		encodeError    = EncodeConsumeMagicLinkError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "consume_magic_link")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
 // MountValidateTokenHandler configures the mux to serve the "identity" service
 // "validate_token" endpoint.
 func MountValidateTokenHandler(mux goahttp.Muxer, h http.Handler) {
@@ -255,25 +586,985 @@ func NewValidateTokenHandler(
 	})
 }
 
-// appendFS is a custom implementation of fs.FS that appends a specified prefix
-// to the file paths before delegating the Open call to the underlying fs.FS.
-type appendFS struct {
-	prefix string
-	fs     http.FileSystem
+// MountValidateTokensHandler configures the mux to serve the "identity"
+// service "validate_tokens" endpoint.
+func MountValidateTokensHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/validate/batch", f)
 }
 
-// Open opens the named file, appending the prefix to the file path before
-// passing it to the underlying fs.FS.
-func (s appendFS) Open(name string) (http.File, error) {
-	switch name {
+// NewValidateTokensHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "validate_tokens" endpoint.
+func NewValidateTokensHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeValidateTokensRequest(mux, decoder)
+		encodeResponse = EncodeValidateTokensResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "validate_tokens")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListAuthEventsHandler configures the mux to serve the "identity"
+// service "list_auth_events" endpoint.
+func MountListAuthEventsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
 	}
-	return s.fs.Open(path.Join(s.prefix, name))
+	mux.Handle("GET", "/v1/identity/admin/auth-events", f)
 }
 
-// appendPrefix returns a new fs.FS that appends the specified prefix to file paths
-// before delegating to the provided embed.FS.
-func appendPrefix(fsys http.FileSystem, prefix string) http.FileSystem {
-	return appendFS{prefix: prefix, fs: fsys}
+// NewListAuthEventsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "list_auth_events" endpoint.
+func NewListAuthEventsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAuthEventsRequest(mux, decoder)
+		encodeResponse = EncodeListAuthEventsResponse(encoder)
+		encodeError    = EncodeListAuthEventsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_auth_events")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountIntrospectHandler configures the mux to serve the "identity" service
+// "introspect" endpoint.
+func MountIntrospectHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/oauth/introspect", f)
+}
+
+// NewIntrospectHandler creates a HTTP handler which loads the HTTP request and
+// calls the "identity" service "introspect" endpoint.
+func NewIntrospectHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeIntrospectRequest(mux, decoder)
+		encodeResponse = EncodeIntrospectResponse(encoder)
+		encodeError    = EncodeIntrospectError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "introspect")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRevokeTokenHandler configures the mux to serve the "identity" service
+// "revoke_token" endpoint.
+func MountRevokeTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/oauth/revoke", f)
+}
+
+// NewRevokeTokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "revoke_token" endpoint.
+func NewRevokeTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeTokenRequest(mux, decoder)
+		encodeResponse = EncodeRevokeTokenResponse(encoder)
+		encodeError    = EncodeRevokeTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revoke_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountExchangeTokenHandler configures the mux to serve the "identity" service
+// "exchange_token" endpoint.
+func MountExchangeTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/token/exchange", f)
+}
+
+// NewExchangeTokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "exchange_token" endpoint.
+func NewExchangeTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExchangeTokenRequest(mux, decoder)
+		encodeResponse = EncodeExchangeTokenResponse(encoder)
+		encodeError    = EncodeExchangeTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "exchange_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountInviteUserHandler configures the mux to serve the "identity" service
+// "invite_user" endpoint.
+func MountInviteUserHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/invitations", f)
+}
+
+// NewInviteUserHandler creates a HTTP handler which loads the HTTP request and
+// calls the "identity" service "invite_user" endpoint.
+func NewInviteUserHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeInviteUserRequest(mux, decoder)
+		encodeResponse = EncodeInviteUserResponse(encoder)
+		encodeError    = EncodeInviteUserError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "invite_user")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListInvitationsHandler configures the mux to serve the "identity"
+// service "list_invitations" endpoint.
+func MountListInvitationsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/identity/invitations", f)
+}
+
+// NewListInvitationsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "identity" service "list_invitations" endpoint.
+func NewListInvitationsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListInvitationsRequest(mux, decoder)
+		encodeResponse = EncodeListInvitationsResponse(encoder)
+		encodeError    = EncodeListInvitationsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_invitations")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRevokeInvitationHandler configures the mux to serve the "identity"
+// service "revoke_invitation" endpoint.
+func MountRevokeInvitationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/identity/invitations/{id}", f)
+}
+
+// NewRevokeInvitationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "identity" service "revoke_invitation" endpoint.
+func NewRevokeInvitationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRevokeInvitationRequest(mux, decoder)
+		encodeResponse = EncodeRevokeInvitationResponse(encoder)
+		encodeError    = EncodeRevokeInvitationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "revoke_invitation")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountAcceptInvitationHandler configures the mux to serve the "identity"
+// service "accept_invitation" endpoint.
+func MountAcceptInvitationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/invitations/accept", f)
+}
+
+// NewAcceptInvitationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "identity" service "accept_invitation" endpoint.
+func NewAcceptInvitationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAcceptInvitationRequest(mux, decoder)
+		encodeResponse = EncodeAcceptInvitationResponse(encoder)
+		encodeError    = EncodeAcceptInvitationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "accept_invitation")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeviceAuthorizationHandler configures the mux to serve the "identity"
+// service "device_authorization" endpoint.
+func MountDeviceAuthorizationHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/oauth/device_authorization", f)
+}
+
+// NewDeviceAuthorizationHandler creates a HTTP handler which loads the HTTP
+// request and calls the "identity" service "device_authorization" endpoint.
+func NewDeviceAuthorizationHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeviceAuthorizationRequest(mux, decoder)
+		encodeResponse = EncodeDeviceAuthorizationResponse(encoder)
+		encodeError    = EncodeDeviceAuthorizationError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "device_authorization")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeviceTokenHandler configures the mux to serve the "identity" service
+// "device_token" endpoint.
+func MountDeviceTokenHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/oauth/token", f)
+}
+
+// NewDeviceTokenHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "device_token" endpoint.
+func NewDeviceTokenHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeviceTokenRequest(mux, decoder)
+		encodeResponse = EncodeDeviceTokenResponse(encoder)
+		encodeError    = EncodeDeviceTokenError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "device_token")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountApproveDeviceHandler configures the mux to serve the "identity" service
+// "approve_device" endpoint.
+func MountApproveDeviceHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/device/approve", f)
+}
+
+// NewApproveDeviceHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "approve_device" endpoint.
+func NewApproveDeviceHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeApproveDeviceRequest(mux, decoder)
+		encodeResponse = EncodeApproveDeviceResponse(encoder)
+		encodeError    = EncodeApproveDeviceError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "approve_device")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountCreateWebhookHandler configures the mux to serve the "identity" service
+// "create_webhook" endpoint.
+func MountCreateWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/admin/webhooks", f)
+}
+
+// NewCreateWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "create_webhook" endpoint.
+func NewCreateWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateWebhookRequest(mux, decoder)
+		encodeResponse = EncodeCreateWebhookResponse(encoder)
+		encodeError    = EncodeCreateWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "create_webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListWebhooksHandler configures the mux to serve the "identity" service
+// "list_webhooks" endpoint.
+func MountListWebhooksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/identity/admin/webhooks", f)
+}
+
+// NewListWebhooksHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "list_webhooks" endpoint.
+func NewListWebhooksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWebhooksRequest(mux, decoder)
+		encodeResponse = EncodeListWebhooksResponse(encoder)
+		encodeError    = EncodeListWebhooksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_webhooks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountDeleteWebhookHandler configures the mux to serve the "identity" service
+// "delete_webhook" endpoint.
+func MountDeleteWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/identity/admin/webhooks/{id}", f)
+}
+
+// NewDeleteWebhookHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "delete_webhook" endpoint.
+func NewDeleteWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteWebhookRequest(mux, decoder)
+		encodeResponse = EncodeDeleteWebhookResponse(encoder)
+		encodeError    = EncodeDeleteWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "delete_webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountListWebhookDeliveriesHandler configures the mux to serve the "identity"
+// service "list_webhook_deliveries" endpoint.
+func MountListWebhookDeliveriesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/identity/admin/webhooks/{id}/deliveries", f)
+}
+
+// NewListWebhookDeliveriesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "identity" service "list_webhook_deliveries" endpoint.
+func NewListWebhookDeliveriesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListWebhookDeliveriesRequest(mux, decoder)
+		encodeResponse = EncodeListWebhookDeliveriesResponse(encoder)
+		encodeError    = EncodeListWebhookDeliveriesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "list_webhook_deliveries")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountRedeliverWebhookHandler configures the mux to serve the "identity"
+// service "redeliver_webhook" endpoint.
+func MountRedeliverWebhookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/identity/admin/webhook-deliveries/{id}/redeliver", f)
+}
+
+// NewRedeliverWebhookHandler creates a HTTP handler which loads the HTTP
+// request and calls the "identity" service "redeliver_webhook" endpoint.
+func NewRedeliverWebhookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRedeliverWebhookRequest(mux, decoder)
+		encodeResponse = EncodeRedeliverWebhookResponse(encoder)
+		encodeError    = EncodeRedeliverWebhookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "redeliver_webhook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// MountSetUserStatusHandler configures the mux to serve the "identity" service
+// "set_user_status" endpoint.
+func MountSetUserStatusHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/identity/admin/users/{id}/status", f)
+}
+
+// NewSetUserStatusHandler creates a HTTP handler which loads the HTTP request
+// and calls the "identity" service "set_user_status" endpoint.
+func NewSetUserStatusHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSetUserStatusRequest(mux, decoder)
+		encodeResponse = EncodeSetUserStatusResponse(encoder)
+		encodeError    = EncodeSetUserStatusError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "set_user_status")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "identity")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil && errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			if errhandler != nil {
+				errhandler(ctx, w, err)
+			}
+		}
+	})
+}
+
+// appendFS is a custom implementation of fs.FS that appends a specified prefix
+// to the file paths before delegating the Open call to the underlying fs.FS.
+type appendFS struct {
+	prefix string
+	fs     http.FileSystem
+}
+
+// Open opens the named file, appending the prefix to the file path before
+// passing it to the underlying fs.FS.
+func (s appendFS) Open(name string) (http.File, error) {
+	switch name {
+	}
+	return s.fs.Open(path.Join(s.prefix, name))
+}
+
+// appendPrefix returns a new fs.FS that appends the specified prefix to file paths
+// before delegating to the provided embed.FS.
+func appendPrefix(fsys http.FileSystem, prefix string) http.FileSystem {
+	return appendFS{prefix: prefix, fs: fsys}
+}
+
+// MountStaticDeviceHTML configures the mux to serve GET request made to
+// "/device.html".
+func MountStaticDeviceHTML(mux goahttp.Muxer, h http.Handler) {
+	mux.Handle("GET", "/device.html", h.ServeHTTP)
 }
 
 // MountGenHTTPOpenapiJSON configures the mux to serve GET request made to
@@ -18,117 +18,2326 @@ import (
 // RegisterRequestBody is the type of the "identity" service "register"
 // endpoint HTTP request body.
 type RegisterRequestBody struct {
-	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
-	Email       *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Password; may be omitted when magic link login is enabled
 	Password    *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Optional unique handle that can be used to log in instead of the email
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// LoginRequestBody is the type of the "identity" service "login" endpoint HTTP
+// request body.
+type LoginRequestBody struct {
+	// Format of the issued access token
+	TokenFormat *string `form:"token_format,omitempty" json:"token_format,omitempty" xml:"token_format,omitempty"`
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+	// Deprecated: use identifier
+	Email    *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Email address or username
+	Identifier *string `form:"identifier,omitempty" json:"identifier,omitempty" xml:"identifier,omitempty"`
+}
+
+// SetUsernameRequestBody is the type of the "identity" service "set_username"
+// endpoint HTTP request body.
+type SetUsernameRequestBody struct {
+	// New username
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// RequestMagicLinkRequestBody is the type of the "identity" service
+// "request_magic_link" endpoint HTTP request body.
+type RequestMagicLinkRequestBody struct {
+	// Email address to send the login link to
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+}
+
+// ConsumeMagicLinkRequestBody is the type of the "identity" service
+// "consume_magic_link" endpoint HTTP request body.
+type ConsumeMagicLinkRequestBody struct {
+	// Token from the emailed login link
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+}
+
+// ValidateTokenRequestBody is the type of the "identity" service
+// "validate_token" endpoint HTTP request body.
+type ValidateTokenRequestBody struct {
+	// JWT access token
+	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+	// Audience of the calling service; when set the token must be intended for it
+	Audience *string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+}
+
+// ValidateTokensRequestBody is the type of the "identity" service
+// "validate_tokens" endpoint HTTP request body.
+type ValidateTokensRequestBody struct {
+	// Access tokens to validate
+	Tokens []string `form:"tokens,omitempty" json:"tokens,omitempty" xml:"tokens,omitempty"`
+	// Audience of the calling service; when set every token must be intended for it
+	Audience *string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+}
+
+// ExchangeTokenRequestBody is the type of the "identity" service
+// "exchange_token" endpoint HTTP request body.
+type ExchangeTokenRequestBody struct {
+	// OAuth 2.0 grant type
+	GrantType *string `form:"grant_type,omitempty" json:"grant_type,omitempty" xml:"grant_type,omitempty"`
+	// Identifier of the user to impersonate
+	RequestedSubject *string `form:"requested_subject,omitempty" json:"requested_subject,omitempty" xml:"requested_subject,omitempty"`
+	// Type of the requested token
+	RequestedTokenType *string `form:"requested_token_type,omitempty" json:"requested_token_type,omitempty" xml:"requested_token_type,omitempty"`
+	// Why impersonation is needed, recorded in the audit log
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+}
+
+// InviteUserRequestBody is the type of the "identity" service "invite_user"
+// endpoint HTTP request body.
+type InviteUserRequestBody struct {
+	// Email address to invite
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Display name to pre-assign
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Attributes to pre-assign to the new account; restricted to administrators
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+}
+
+// AcceptInvitationRequestBody is the type of the "identity" service
+// "accept_invitation" endpoint HTTP request body.
+type AcceptInvitationRequestBody struct {
+	// Token from the emailed invitation link
+	InvitationToken *string `form:"invitation_token,omitempty" json:"invitation_token,omitempty" xml:"invitation_token,omitempty"`
+	// Password; may be omitted when magic link login is enabled
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+	// Overrides the pre-assigned display name
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+}
+
+// DeviceAuthorizationRequestBody is the type of the "identity" service
+// "device_authorization" endpoint HTTP request body.
+type DeviceAuthorizationRequestBody struct {
+	// Identifier of the public client starting the flow
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// Space-separated scopes requested by the client; each must be listed in
+	// IDENTITY_DEVICE_SCOPES
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+}
+
+// DeviceTokenRequestBody is the type of the "identity" service "device_token"
+// endpoint HTTP request body.
+type DeviceTokenRequestBody struct {
+	// OAuth 2.0 grant type
+	GrantType *string `form:"grant_type,omitempty" json:"grant_type,omitempty" xml:"grant_type,omitempty"`
+	// Device code returned by device_authorization
+	DeviceCode *string `form:"device_code,omitempty" json:"device_code,omitempty" xml:"device_code,omitempty"`
+	// Client that started the flow
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+}
+
+// ApproveDeviceRequestBody is the type of the "identity" service
+// "approve_device" endpoint HTTP request body.
+type ApproveDeviceRequestBody struct {
+	// Code displayed by the device
+	UserCode *string `form:"user_code,omitempty" json:"user_code,omitempty" xml:"user_code,omitempty"`
+	// False denies the request
+	Approve *bool `form:"approve,omitempty" json:"approve,omitempty" xml:"approve,omitempty"`
+}
+
+// CreateWebhookRequestBody is the type of the "identity" service
+// "create_webhook" endpoint HTTP request body.
+type CreateWebhookRequestBody struct {
+	// Endpoint that receives signed POST requests
+	URL *string `form:"url,omitempty" json:"url,omitempty" xml:"url,omitempty"`
+	// Events to deliver; omit to receive all events
+	EventTypes []string `form:"event_types,omitempty" json:"event_types,omitempty" xml:"event_types,omitempty"`
+}
+
+// SetUserStatusRequestBody is the type of the "identity" service
+// "set_user_status" endpoint HTTP request body.
+type SetUserStatusRequestBody struct {
+	// New account status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Why the status is changed, e.g. the abuse report being acted on
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// RegisterResponseBody is the type of the "identity" service "register"
+// endpoint HTTP response body.
+type RegisterResponseBody struct {
+	// User identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Email address
+	Email string `form:"email" json:"email" xml:"email"`
+	// Display name
+	DisplayName string `form:"display_name" json:"display_name" xml:"display_name"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Account status; only active accounts can sign in
+	Status string `form:"status" json:"status" xml:"status"`
+	// Reason given for the last status change
+	StatusReason *string `form:"status_reason,omitempty" json:"status_reason,omitempty" xml:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *string `form:"status_changed_at,omitempty" json:"status_changed_at,omitempty" xml:"status_changed_at,omitempty"`
+	// Unique handle, if the user chose one
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// LoginResponseBody is the type of the "identity" service "login" endpoint
+// HTTP response body.
+type LoginResponseBody struct {
+	// JWT access token
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// Token expiry window in seconds
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// SetUsernameResponseBody is the type of the "identity" service "set_username"
+// endpoint HTTP response body.
+type SetUsernameResponseBody struct {
+	// User identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Email address
+	Email string `form:"email" json:"email" xml:"email"`
+	// Display name
+	DisplayName string `form:"display_name" json:"display_name" xml:"display_name"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Account status; only active accounts can sign in
+	Status string `form:"status" json:"status" xml:"status"`
+	// Reason given for the last status change
+	StatusReason *string `form:"status_reason,omitempty" json:"status_reason,omitempty" xml:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *string `form:"status_changed_at,omitempty" json:"status_changed_at,omitempty" xml:"status_changed_at,omitempty"`
+	// Unique handle, if the user chose one
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// CheckUsernameResponseBody is the type of the "identity" service
+// "check_username" endpoint HTTP response body.
+type CheckUsernameResponseBody struct {
+	// Checked username
+	Username string `form:"username" json:"username" xml:"username"`
+	// Whether the username can be claimed
+	Available bool `form:"available" json:"available" xml:"available"`
+	// Why the username cannot be claimed
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+}
+
+// ConsumeMagicLinkResponseBody is the type of the "identity" service
+// "consume_magic_link" endpoint HTTP response body.
+type ConsumeMagicLinkResponseBody struct {
+	// JWT access token
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// Token expiry window in seconds
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// ValidateTokenResponseBody is the type of the "identity" service
+// "validate_token" endpoint HTTP response body.
+type ValidateTokenResponseBody struct {
+	Valid  bool    `form:"valid" json:"valid" xml:"valid"`
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	Email  *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Set when the token was issued through impersonation
+	Actor *ActorResponseBody `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Services the token is intended for
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+	// Custom claims added by claims enrichers when the token was issued
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+}
+
+// ValidateTokensResponseBody is the type of the "identity" service
+// "validate_tokens" endpoint HTTP response body.
+type ValidateTokensResponseBody struct {
+	// One result per submitted token, in the same order
+	Results []*ValidationResultResponseBody `form:"results" json:"results" xml:"results"`
+}
+
+// ListAuthEventsResponseBody is the type of the "identity" service
+// "list_auth_events" endpoint HTTP response body.
+type ListAuthEventsResponseBody struct {
+	// Events ordered from newest to oldest
+	Events []*AuthEventResponseBody `form:"events" json:"events" xml:"events"`
+}
+
+// IntrospectResponseBody is the type of the "identity" service "introspect"
+// endpoint HTTP response body.
+type IntrospectResponseBody struct {
+	// Whether the token is currently active
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Subject of the token
+	Sub *string `form:"sub,omitempty" json:"sub,omitempty" xml:"sub,omitempty"`
+	// Expiration time in seconds since the epoch
+	Exp *int64 `form:"exp,omitempty" json:"exp,omitempty" xml:"exp,omitempty"`
+	// Issue time in seconds since the epoch
+	Iat *int64 `form:"iat,omitempty" json:"iat,omitempty" xml:"iat,omitempty"`
+	// Space-separated scopes granted to the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Client the token was issued to
+	ClientID *string `form:"client_id,omitempty" json:"client_id,omitempty" xml:"client_id,omitempty"`
+	// Type of the token
+	TokenType *string `form:"token_type,omitempty" json:"token_type,omitempty" xml:"token_type,omitempty"`
+	// Email of the resource owner
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+	// Services the token is intended for
+	Aud []string `form:"aud,omitempty" json:"aud,omitempty" xml:"aud,omitempty"`
+	// Issuer of the token
+	Iss *string `form:"iss,omitempty" json:"iss,omitempty" xml:"iss,omitempty"`
+}
+
+// ExchangeTokenResponseBody is the type of the "identity" service
+// "exchange_token" endpoint HTTP response body.
+type ExchangeTokenResponseBody struct {
+	// JWT acting as the requested subject
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// Type of the issued token
+	IssuedTokenType string `form:"issued_token_type" json:"issued_token_type" xml:"issued_token_type"`
+	// How the token is presented
+	TokenType string `form:"token_type" json:"token_type" xml:"token_type"`
+	// Token expiry window in seconds
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// InviteUserResponseBody is the type of the "identity" service "invite_user"
+// endpoint HTTP response body.
+type InviteUserResponseBody struct {
+	// Invitation identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Email address of the invitee
+	Email string `form:"email" json:"email" xml:"email"`
+	// Display name pre-assigned to the invitee
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Attributes pre-assigned to the invitee
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// User who created the invitation
+	InvitedBy string `form:"invited_by" json:"invited_by" xml:"invited_by"`
+	// Invitation status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Expiry timestamp
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+}
+
+// ListInvitationsResponseBody is the type of the "identity" service
+// "list_invitations" endpoint HTTP response body.
+type ListInvitationsResponseBody struct {
+	// Invitations ordered from newest to oldest
+	Invitations []*InvitationResponseBody `form:"invitations" json:"invitations" xml:"invitations"`
+}
+
+// AcceptInvitationResponseBody is the type of the "identity" service
+// "accept_invitation" endpoint HTTP response body.
+type AcceptInvitationResponseBody struct {
+	// User identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Email address
+	Email string `form:"email" json:"email" xml:"email"`
+	// Display name
+	DisplayName string `form:"display_name" json:"display_name" xml:"display_name"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Account status; only active accounts can sign in
+	Status string `form:"status" json:"status" xml:"status"`
+	// Reason given for the last status change
+	StatusReason *string `form:"status_reason,omitempty" json:"status_reason,omitempty" xml:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *string `form:"status_changed_at,omitempty" json:"status_changed_at,omitempty" xml:"status_changed_at,omitempty"`
+	// Unique handle, if the user chose one
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// DeviceAuthorizationResponseBody is the type of the "identity" service
+// "device_authorization" endpoint HTTP response body.
+type DeviceAuthorizationResponseBody struct {
+	// Code the device polls the token endpoint with
+	DeviceCode string `form:"device_code" json:"device_code" xml:"device_code"`
+	// Code the user enters on the verification page
+	UserCode string `form:"user_code" json:"user_code" xml:"user_code"`
+	// Page where the user approves the device
+	VerificationURI string `form:"verification_uri" json:"verification_uri" xml:"verification_uri"`
+	// Verification page with the user code filled in
+	VerificationURIComplete string `form:"verification_uri_complete" json:"verification_uri_complete" xml:"verification_uri_complete"`
+	// Lifetime of the device and user codes in seconds
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Minimum number of seconds between polling requests
+	Interval int `form:"interval" json:"interval" xml:"interval"`
+}
+
+// DeviceTokenResponseBody is the type of the "identity" service "device_token"
+// endpoint HTTP response body.
+type DeviceTokenResponseBody struct {
+	// JWT access token
+	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
+	// How the token is presented
+	TokenType string `form:"token_type" json:"token_type" xml:"token_type"`
+	// Token expiry window in seconds
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Scopes granted to the token
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+}
+
+// CreateWebhookResponseBody is the type of the "identity" service
+// "create_webhook" endpoint HTTP response body.
+type CreateWebhookResponseBody struct {
+	// Subscription identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Endpoint that receives signed POST requests
+	URL string `form:"url" json:"url" xml:"url"`
+	// Events delivered to the endpoint; empty means all events
+	EventTypes []string `form:"event_types" json:"event_types" xml:"event_types"`
+	// Whether new events are delivered
+	Active bool `form:"active" json:"active" xml:"active"`
+	// HMAC-SHA256 signing secret; only returned when the subscription is created
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// ListWebhooksResponseBody is the type of the "identity" service
+// "list_webhooks" endpoint HTTP response body.
+type ListWebhooksResponseBody struct {
+	// Subscriptions ordered from newest to oldest
+	Subscriptions []*WebhookSubscriptionResponseBody `form:"subscriptions" json:"subscriptions" xml:"subscriptions"`
+}
+
+// ListWebhookDeliveriesResponseBody is the type of the "identity" service
+// "list_webhook_deliveries" endpoint HTTP response body.
+type ListWebhookDeliveriesResponseBody struct {
+	// Deliveries ordered from newest to oldest
+	Deliveries []*WebhookDeliveryResponseBody `form:"deliveries" json:"deliveries" xml:"deliveries"`
+}
+
+// RedeliverWebhookResponseBody is the type of the "identity" service
+// "redeliver_webhook" endpoint HTTP response body.
+type RedeliverWebhookResponseBody struct {
+	// Delivery identifier, sent in the X-Webhook-ID header
+	ID string `form:"id" json:"id" xml:"id"`
+	// Subscription the delivery belongs to
+	SubscriptionID string `form:"subscription_id" json:"subscription_id" xml:"subscription_id"`
+	// Event type
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// Delivery status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of delivery attempts made
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// HTTP status returned by the last attempt
+	ResponseStatus *int `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Time of the last attempt
+	LastAttemptAt *string `form:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty" xml:"last_attempt_at,omitempty"`
+	// Time of the next attempt while pending
+	NextAttemptAt *string `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// Time the endpoint acknowledged the delivery
+	DeliveredAt *string `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// SetUserStatusResponseBody is the type of the "identity" service
+// "set_user_status" endpoint HTTP response body.
+type SetUserStatusResponseBody struct {
+	// User identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Email address
+	Email string `form:"email" json:"email" xml:"email"`
+	// Display name
+	DisplayName string `form:"display_name" json:"display_name" xml:"display_name"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// Account status; only active accounts can sign in
+	Status string `form:"status" json:"status" xml:"status"`
+	// Reason given for the last status change
+	StatusReason *string `form:"status_reason,omitempty" json:"status_reason,omitempty" xml:"status_reason,omitempty"`
+	// Time of the last status change
+	StatusChangedAt *string `form:"status_changed_at,omitempty" json:"status_changed_at,omitempty" xml:"status_changed_at,omitempty"`
+	// Unique handle, if the user chose one
+	Username *string `form:"username,omitempty" json:"username,omitempty" xml:"username,omitempty"`
+}
+
+// RegisterConflictResponseBody is the type of the "identity" service
+// "register" endpoint HTTP response body for the "conflict" error.
+type RegisterConflictResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// RegisterInvalidUsernameResponseBody is the type of the "identity" service
+// "register" endpoint HTTP response body for the "invalid_username" error.
+type RegisterInvalidUsernameResponseBody struct {
+	// description of the broken rule
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// RegisterPasswordPolicyResponseBody is the type of the "identity" service
+// "register" endpoint HTTP response body for the "password_policy" error.
+type RegisterPasswordPolicyResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// every password rule that failed
+	Violations []*PolicyViolationResponseBody `form:"violations" json:"violations" xml:"violations"`
+}
+
+// RegisterUnauthorizedResponseBody is the type of the "identity" service
+// "register" endpoint HTTP response body for the "unauthorized" error.
+type RegisterUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// SetUsernameConflictResponseBody is the type of the "identity" service
+// "set_username" endpoint HTTP response body for the "conflict" error.
+type SetUsernameConflictResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// SetUsernameInvalidUsernameResponseBody is the type of the "identity" service
+// "set_username" endpoint HTTP response body for the "invalid_username" error.
+type SetUsernameInvalidUsernameResponseBody struct {
+	// description of the broken rule
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// SetUsernameUnauthorizedResponseBody is the type of the "identity" service
+// "set_username" endpoint HTTP response body for the "unauthorized" error.
+type SetUsernameUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RequestMagicLinkUnauthorizedResponseBody is the type of the "identity"
+// service "request_magic_link" endpoint HTTP response body for the
+// "unauthorized" error.
+type RequestMagicLinkUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ConsumeMagicLinkUnauthorizedResponseBody is the type of the "identity"
+// service "consume_magic_link" endpoint HTTP response body for the
+// "unauthorized" error.
+type ConsumeMagicLinkUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListAuthEventsBadRequestResponseBody is the type of the "identity" service
+// "list_auth_events" endpoint HTTP response body for the "bad_request" error.
+type ListAuthEventsBadRequestResponseBody struct {
+	// description of the invalid argument
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// ListAuthEventsUnauthorizedResponseBody is the type of the "identity" service
+// "list_auth_events" endpoint HTTP response body for the "unauthorized" error.
+type ListAuthEventsUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// IntrospectUnauthorizedResponseBody is the type of the "identity" service
+// "introspect" endpoint HTTP response body for the "unauthorized" error.
+type IntrospectUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RevokeTokenUnsupportedTokenTypeResponseBody is the type of the "identity"
+// service "revoke_token" endpoint HTTP response body for the
+// "unsupported_token_type" error.
+type RevokeTokenUnsupportedTokenTypeResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// RevokeTokenUnauthorizedResponseBody is the type of the "identity" service
+// "revoke_token" endpoint HTTP response body for the "unauthorized" error.
+type RevokeTokenUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ExchangeTokenNotFoundResponseBody is the type of the "identity" service
+// "exchange_token" endpoint HTTP response body for the "not_found" error.
+type ExchangeTokenNotFoundResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ExchangeTokenUnauthorizedResponseBody is the type of the "identity" service
+// "exchange_token" endpoint HTTP response body for the "unauthorized" error.
+type ExchangeTokenUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// InviteUserConflictResponseBody is the type of the "identity" service
+// "invite_user" endpoint HTTP response body for the "conflict" error.
+type InviteUserConflictResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// InviteUserUnauthorizedResponseBody is the type of the "identity" service
+// "invite_user" endpoint HTTP response body for the "unauthorized" error.
+type InviteUserUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListInvitationsUnauthorizedResponseBody is the type of the "identity"
+// service "list_invitations" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListInvitationsUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RevokeInvitationNotFoundResponseBody is the type of the "identity" service
+// "revoke_invitation" endpoint HTTP response body for the "not_found" error.
+type RevokeInvitationNotFoundResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RevokeInvitationUnauthorizedResponseBody is the type of the "identity"
+// service "revoke_invitation" endpoint HTTP response body for the
+// "unauthorized" error.
+type RevokeInvitationUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// AcceptInvitationConflictResponseBody is the type of the "identity" service
+// "accept_invitation" endpoint HTTP response body for the "conflict" error.
+type AcceptInvitationConflictResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// AcceptInvitationPasswordPolicyResponseBody is the type of the "identity"
+// service "accept_invitation" endpoint HTTP response body for the
+// "password_policy" error.
+type AcceptInvitationPasswordPolicyResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// every password rule that failed
+	Violations []*PolicyViolationResponseBody `form:"violations" json:"violations" xml:"violations"`
+}
+
+// AcceptInvitationUnauthorizedResponseBody is the type of the "identity"
+// service "accept_invitation" endpoint HTTP response body for the
+// "unauthorized" error.
+type AcceptInvitationUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// DeviceAuthorizationInvalidClientResponseBody is the type of the "identity"
+// service "device_authorization" endpoint HTTP response body for the
+// "invalid_client" error.
+type DeviceAuthorizationInvalidClientResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceAuthorizationInvalidScopeResponseBody is the type of the "identity"
+// service "device_authorization" endpoint HTTP response body for the
+// "invalid_scope" error.
+type DeviceAuthorizationInvalidScopeResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceAuthorizationInvalidTargetResponseBody is the type of the "identity"
+// service "device_authorization" endpoint HTTP response body for the
+// "invalid_target" error.
+type DeviceAuthorizationInvalidTargetResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenAuthorizationPendingResponseBody is the type of the "identity"
+// service "device_token" endpoint HTTP response body for the
+// "authorization_pending" error.
+type DeviceTokenAuthorizationPendingResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenSlowDownResponseBody is the type of the "identity" service
+// "device_token" endpoint HTTP response body for the "slow_down" error.
+type DeviceTokenSlowDownResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenAccessDeniedResponseBody is the type of the "identity" service
+// "device_token" endpoint HTTP response body for the "access_denied" error.
+type DeviceTokenAccessDeniedResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenExpiredTokenResponseBody is the type of the "identity" service
+// "device_token" endpoint HTTP response body for the "expired_token" error.
+type DeviceTokenExpiredTokenResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// DeviceTokenInvalidGrantResponseBody is the type of the "identity" service
+// "device_token" endpoint HTTP response body for the "invalid_grant" error.
+type DeviceTokenInvalidGrantResponseBody struct {
+	// Error code
+	Code string `form:"error" json:"error" xml:"error"`
+	// Human-readable description of the error
+	ErrorDescription *string `form:"error_description,omitempty" json:"error_description,omitempty" xml:"error_description,omitempty"`
+}
+
+// ApproveDeviceNotFoundResponseBody is the type of the "identity" service
+// "approve_device" endpoint HTTP response body for the "not_found" error.
+type ApproveDeviceNotFoundResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ApproveDeviceUnauthorizedResponseBody is the type of the "identity" service
+// "approve_device" endpoint HTTP response body for the "unauthorized" error.
+type ApproveDeviceUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// CreateWebhookUnauthorizedResponseBody is the type of the "identity" service
+// "create_webhook" endpoint HTTP response body for the "unauthorized" error.
+type CreateWebhookUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListWebhooksUnauthorizedResponseBody is the type of the "identity" service
+// "list_webhooks" endpoint HTTP response body for the "unauthorized" error.
+type ListWebhooksUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// DeleteWebhookNotFoundResponseBody is the type of the "identity" service
+// "delete_webhook" endpoint HTTP response body for the "not_found" error.
+type DeleteWebhookNotFoundResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// DeleteWebhookUnauthorizedResponseBody is the type of the "identity" service
+// "delete_webhook" endpoint HTTP response body for the "unauthorized" error.
+type DeleteWebhookUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListWebhookDeliveriesNotFoundResponseBody is the type of the "identity"
+// service "list_webhook_deliveries" endpoint HTTP response body for the
+// "not_found" error.
+type ListWebhookDeliveriesNotFoundResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// ListWebhookDeliveriesUnauthorizedResponseBody is the type of the "identity"
+// service "list_webhook_deliveries" endpoint HTTP response body for the
+// "unauthorized" error.
+type ListWebhookDeliveriesUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RedeliverWebhookNotFoundResponseBody is the type of the "identity" service
+// "redeliver_webhook" endpoint HTTP response body for the "not_found" error.
+type RedeliverWebhookNotFoundResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// RedeliverWebhookUnauthorizedResponseBody is the type of the "identity"
+// service "redeliver_webhook" endpoint HTTP response body for the
+// "unauthorized" error.
+type RedeliverWebhookUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// SetUserStatusNotFoundResponseBody is the type of the "identity" service
+// "set_user_status" endpoint HTTP response body for the "not_found" error.
+type SetUserStatusNotFoundResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID        *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	Temporary *bool   `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	Timeout   *bool   `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// SetUserStatusUnauthorizedResponseBody is the type of the "identity" service
+// "set_user_status" endpoint HTTP response body for the "unauthorized" error.
+type SetUserStatusUnauthorizedResponseBody struct {
+	// description of the failure
+	Message string `form:"message" json:"message" xml:"message"`
+	// error identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// true if the error is temporary
+	Temporary *bool `form:"temporary,omitempty" json:"temporary,omitempty" xml:"temporary,omitempty"`
+	// true if the error is retryable
+	Timeout *bool `form:"timeout,omitempty" json:"timeout,omitempty" xml:"timeout,omitempty"`
+}
+
+// PolicyViolationResponseBody is used to define fields on response body types.
+type PolicyViolationResponseBody struct {
+	// identifier of the failed rule
+	Rule string `form:"rule" json:"rule" xml:"rule"`
+	// description of the failed rule
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// ActorResponseBody is used to define fields on response body types.
+type ActorResponseBody struct {
+	// Actor user identifier
+	UserID string `form:"user_id" json:"user_id" xml:"user_id"`
+	// Actor email address
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+}
+
+// ValidationResultResponseBody is used to define fields on response body types.
+type ValidationResultResponseBody struct {
+	Valid  bool    `form:"valid" json:"valid" xml:"valid"`
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	Email  *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Set when the token was issued through impersonation
+	Actor *ActorResponseBody `form:"actor,omitempty" json:"actor,omitempty" xml:"actor,omitempty"`
+	// Services the token is intended for
+	Audience []string `form:"audience,omitempty" json:"audience,omitempty" xml:"audience,omitempty"`
+	// Custom claims added by claims enrichers when the token was issued
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+}
+
+// AuthEventResponseBody is used to define fields on response body types.
+type AuthEventResponseBody struct {
+	// Event identifier
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Event type
+	Type string `form:"type" json:"type" xml:"type"`
+	// Whether the operation succeeded
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Acting user, when known
+	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Email supplied by or resolved for the actor
+	Email *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
+	// Failure reason
+	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+	// Client IP address
+	IPAddress *string `form:"ip_address,omitempty" json:"ip_address,omitempty" xml:"ip_address,omitempty"`
+	// Client user agent
+	UserAgent *string `form:"user_agent,omitempty" json:"user_agent,omitempty" xml:"user_agent,omitempty"`
+	// Request identifier
+	RequestID *string `form:"request_id,omitempty" json:"request_id,omitempty" xml:"request_id,omitempty"`
+	// Event timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// InvitationResponseBody is used to define fields on response body types.
+type InvitationResponseBody struct {
+	// Invitation identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Email address of the invitee
+	Email string `form:"email" json:"email" xml:"email"`
+	// Display name pre-assigned to the invitee
+	DisplayName *string `form:"display_name,omitempty" json:"display_name,omitempty" xml:"display_name,omitempty"`
+	// Attributes pre-assigned to the invitee
+	Attributes map[string]string `form:"attributes,omitempty" json:"attributes,omitempty" xml:"attributes,omitempty"`
+	// User who created the invitation
+	InvitedBy string `form:"invited_by" json:"invited_by" xml:"invited_by"`
+	// Invitation status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Expiry timestamp
+	ExpiresAt string `form:"expires_at" json:"expires_at" xml:"expires_at"`
+}
+
+// WebhookSubscriptionResponseBody is used to define fields on response body
+// types.
+type WebhookSubscriptionResponseBody struct {
+	// Subscription identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Endpoint that receives signed POST requests
+	URL string `form:"url" json:"url" xml:"url"`
+	// Events delivered to the endpoint; empty means all events
+	EventTypes []string `form:"event_types" json:"event_types" xml:"event_types"`
+	// Whether new events are delivered
+	Active bool `form:"active" json:"active" xml:"active"`
+	// HMAC-SHA256 signing secret; only returned when the subscription is created
+	Secret *string `form:"secret,omitempty" json:"secret,omitempty" xml:"secret,omitempty"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+}
+
+// WebhookDeliveryResponseBody is used to define fields on response body types.
+type WebhookDeliveryResponseBody struct {
+	// Delivery identifier, sent in the X-Webhook-ID header
+	ID string `form:"id" json:"id" xml:"id"`
+	// Subscription the delivery belongs to
+	SubscriptionID string `form:"subscription_id" json:"subscription_id" xml:"subscription_id"`
+	// Event type
+	EventType string `form:"event_type" json:"event_type" xml:"event_type"`
+	// Delivery status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of delivery attempts made
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// HTTP status returned by the last attempt
+	ResponseStatus *int `form:"response_status,omitempty" json:"response_status,omitempty" xml:"response_status,omitempty"`
+	// Error of the last failed attempt
+	LastError *string `form:"last_error,omitempty" json:"last_error,omitempty" xml:"last_error,omitempty"`
+	// Creation timestamp
+	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+	// Time of the last attempt
+	LastAttemptAt *string `form:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty" xml:"last_attempt_at,omitempty"`
+	// Time of the next attempt while pending
+	NextAttemptAt *string `form:"next_attempt_at,omitempty" json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	// Time the endpoint acknowledged the delivery
+	DeliveredAt *string `form:"delivered_at,omitempty" json:"delivered_at,omitempty" xml:"delivered_at,omitempty"`
+}
+
+// NewRegisterResponseBody builds the HTTP response body from the result of the
+// "register" endpoint of the "identity" service.
+func NewRegisterResponseBody(res *identityviews.UserView) *RegisterResponseBody {
+	body := &RegisterResponseBody{
+		ID:              *res.ID,
+		Email:           *res.Email,
+		DisplayName:     *res.DisplayName,
+		CreatedAt:       *res.CreatedAt,
+		Status:          *res.Status,
+		StatusReason:    res.StatusReason,
+		StatusChangedAt: res.StatusChangedAt,
+		Username:        res.Username,
+	}
+	if res.Attributes != nil {
+		body.Attributes = make(map[string]string, len(res.Attributes))
+		for key, val := range res.Attributes {
+			tk := key
+			tv := val
+			body.Attributes[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewLoginResponseBody builds the HTTP response body from the result of the
+// "login" endpoint of the "identity" service.
+func NewLoginResponseBody(res *identity.TokenResult) *LoginResponseBody {
+	body := &LoginResponseBody{
+		AccessToken: res.AccessToken,
+		ExpiresIn:   res.ExpiresIn,
+	}
+	return body
+}
+
+// NewSetUsernameResponseBody builds the HTTP response body from the result of
+// the "set_username" endpoint of the "identity" service.
+func NewSetUsernameResponseBody(res *identityviews.UserView) *SetUsernameResponseBody {
+	body := &SetUsernameResponseBody{
+		ID:              *res.ID,
+		Email:           *res.Email,
+		DisplayName:     *res.DisplayName,
+		CreatedAt:       *res.CreatedAt,
+		Status:          *res.Status,
+		StatusReason:    res.StatusReason,
+		StatusChangedAt: res.StatusChangedAt,
+		Username:        res.Username,
+	}
+	if res.Attributes != nil {
+		body.Attributes = make(map[string]string, len(res.Attributes))
+		for key, val := range res.Attributes {
+			tk := key
+			tv := val
+			body.Attributes[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewCheckUsernameResponseBody builds the HTTP response body from the result
+// of the "check_username" endpoint of the "identity" service.
+func NewCheckUsernameResponseBody(res *identity.UsernameAvailability) *CheckUsernameResponseBody {
+	body := &CheckUsernameResponseBody{
+		Username:  res.Username,
+		Available: res.Available,
+		Reason:    res.Reason,
+	}
+	return body
+}
+
+// NewConsumeMagicLinkResponseBody builds the HTTP response body from the
+// result of the "consume_magic_link" endpoint of the "identity" service.
+func NewConsumeMagicLinkResponseBody(res *identity.TokenResult) *ConsumeMagicLinkResponseBody {
+	body := &ConsumeMagicLinkResponseBody{
+		AccessToken: res.AccessToken,
+		ExpiresIn:   res.ExpiresIn,
+	}
+	return body
+}
+
+// NewValidateTokenResponseBody builds the HTTP response body from the result
+// of the "validate_token" endpoint of the "identity" service.
+func NewValidateTokenResponseBody(res *identity.ValidationResult) *ValidateTokenResponseBody {
+	body := &ValidateTokenResponseBody{
+		Valid:  res.Valid,
+		UserID: res.UserID,
+		Email:  res.Email,
+		Reason: res.Reason,
+	}
+	if res.Actor != nil {
+		body.Actor = marshalIdentityActorToActorResponseBody(res.Actor)
+	}
+	if res.Audience != nil {
+		body.Audience = make([]string, len(res.Audience))
+		for i, val := range res.Audience {
+			body.Audience[i] = val
+		}
+	}
+	if res.Claims != nil {
+		body.Claims = make(map[string]string, len(res.Claims))
+		for key, val := range res.Claims {
+			tk := key
+			tv := val
+			body.Claims[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewValidateTokensResponseBody builds the HTTP response body from the result
+// of the "validate_tokens" endpoint of the "identity" service.
+func NewValidateTokensResponseBody(res *identity.ValidationResultsCollection) *ValidateTokensResponseBody {
+	body := &ValidateTokensResponseBody{}
+	if res.Results != nil {
+		body.Results = make([]*ValidationResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			if val == nil {
+				body.Results[i] = nil
+				continue
+			}
+			body.Results[i] = marshalIdentityValidationResultToValidationResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*ValidationResultResponseBody{}
+	}
+	return body
+}
+
+// NewListAuthEventsResponseBody builds the HTTP response body from the result
+// of the "list_auth_events" endpoint of the "identity" service.
+func NewListAuthEventsResponseBody(res *identity.AuthEventsCollection) *ListAuthEventsResponseBody {
+	body := &ListAuthEventsResponseBody{}
+	if res.Events != nil {
+		body.Events = make([]*AuthEventResponseBody, len(res.Events))
+		for i, val := range res.Events {
+			if val == nil {
+				body.Events[i] = nil
+				continue
+			}
+			body.Events[i] = marshalIdentityAuthEventToAuthEventResponseBody(val)
+		}
+	} else {
+		body.Events = []*AuthEventResponseBody{}
+	}
+	return body
+}
+
+// NewIntrospectResponseBody builds the HTTP response body from the result of
+// the "introspect" endpoint of the "identity" service.
+func NewIntrospectResponseBody(res *identity.IntrospectionResult) *IntrospectResponseBody {
+	body := &IntrospectResponseBody{
+		Active:    res.Active,
+		Sub:       res.Sub,
+		Exp:       res.Exp,
+		Iat:       res.Iat,
+		Scope:     res.Scope,
+		ClientID:  res.ClientID,
+		TokenType: res.TokenType,
+		Username:  res.Username,
+		Iss:       res.Iss,
+	}
+	if res.Aud != nil {
+		body.Aud = make([]string, len(res.Aud))
+		for i, val := range res.Aud {
+			body.Aud[i] = val
+		}
+	}
+	return body
+}
+
+// NewExchangeTokenResponseBody builds the HTTP response body from the result
+// of the "exchange_token" endpoint of the "identity" service.
+func NewExchangeTokenResponseBody(res *identity.TokenExchangeResult) *ExchangeTokenResponseBody {
+	body := &ExchangeTokenResponseBody{
+		AccessToken:     res.AccessToken,
+		IssuedTokenType: res.IssuedTokenType,
+		TokenType:       res.TokenType,
+		ExpiresIn:       res.ExpiresIn,
+	}
+	return body
+}
+
+// NewInviteUserResponseBody builds the HTTP response body from the result of
+// the "invite_user" endpoint of the "identity" service.
+func NewInviteUserResponseBody(res *identity.Invitation) *InviteUserResponseBody {
+	body := &InviteUserResponseBody{
+		ID:          res.ID,
+		Email:       res.Email,
+		DisplayName: res.DisplayName,
+		InvitedBy:   res.InvitedBy,
+		Status:      res.Status,
+		CreatedAt:   res.CreatedAt,
+		ExpiresAt:   res.ExpiresAt,
+	}
+	if res.Attributes != nil {
+		body.Attributes = make(map[string]string, len(res.Attributes))
+		for key, val := range res.Attributes {
+			tk := key
+			tv := val
+			body.Attributes[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewListInvitationsResponseBody builds the HTTP response body from the result
+// of the "list_invitations" endpoint of the "identity" service.
+func NewListInvitationsResponseBody(res *identity.InvitationsCollection) *ListInvitationsResponseBody {
+	body := &ListInvitationsResponseBody{}
+	if res.Invitations != nil {
+		body.Invitations = make([]*InvitationResponseBody, len(res.Invitations))
+		for i, val := range res.Invitations {
+			if val == nil {
+				body.Invitations[i] = nil
+				continue
+			}
+			body.Invitations[i] = marshalIdentityInvitationToInvitationResponseBody(val)
+		}
+	} else {
+		body.Invitations = []*InvitationResponseBody{}
+	}
+	return body
+}
+
+// NewAcceptInvitationResponseBody builds the HTTP response body from the
+// result of the "accept_invitation" endpoint of the "identity" service.
+func NewAcceptInvitationResponseBody(res *identityviews.UserView) *AcceptInvitationResponseBody {
+	body := &AcceptInvitationResponseBody{
+		ID:              *res.ID,
+		Email:           *res.Email,
+		DisplayName:     *res.DisplayName,
+		CreatedAt:       *res.CreatedAt,
+		Status:          *res.Status,
+		StatusReason:    res.StatusReason,
+		StatusChangedAt: res.StatusChangedAt,
+		Username:        res.Username,
+	}
+	if res.Attributes != nil {
+		body.Attributes = make(map[string]string, len(res.Attributes))
+		for key, val := range res.Attributes {
+			tk := key
+			tv := val
+			body.Attributes[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewDeviceAuthorizationResponseBody builds the HTTP response body from the
+// result of the "device_authorization" endpoint of the "identity" service.
+func NewDeviceAuthorizationResponseBody(res *identity.DeviceAuthorizationResult) *DeviceAuthorizationResponseBody {
+	body := &DeviceAuthorizationResponseBody{
+		DeviceCode:              res.DeviceCode,
+		UserCode:                res.UserCode,
+		VerificationURI:         res.VerificationURI,
+		VerificationURIComplete: res.VerificationURIComplete,
+		ExpiresIn:               res.ExpiresIn,
+		Interval:                res.Interval,
+	}
+	return body
+}
+
+// NewDeviceTokenResponseBody builds the HTTP response body from the result of
+// the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenResponseBody(res *identity.DeviceTokenResult) *DeviceTokenResponseBody {
+	body := &DeviceTokenResponseBody{
+		AccessToken: res.AccessToken,
+		TokenType:   res.TokenType,
+		ExpiresIn:   res.ExpiresIn,
+		Scope:       res.Scope,
+	}
+	return body
+}
+
+// NewCreateWebhookResponseBody builds the HTTP response body from the result
+// of the "create_webhook" endpoint of the "identity" service.
+func NewCreateWebhookResponseBody(res *identity.WebhookSubscription) *CreateWebhookResponseBody {
+	body := &CreateWebhookResponseBody{
+		ID:        res.ID,
+		URL:       res.URL,
+		Active:    res.Active,
+		Secret:    res.Secret,
+		CreatedAt: res.CreatedAt,
+	}
+	if res.EventTypes != nil {
+		body.EventTypes = make([]string, len(res.EventTypes))
+		for i, val := range res.EventTypes {
+			body.EventTypes[i] = val
+		}
+	} else {
+		body.EventTypes = []string{}
+	}
+	return body
+}
+
+// NewListWebhooksResponseBody builds the HTTP response body from the result of
+// the "list_webhooks" endpoint of the "identity" service.
+func NewListWebhooksResponseBody(res *identity.WebhookSubscriptionsCollection) *ListWebhooksResponseBody {
+	body := &ListWebhooksResponseBody{}
+	if res.Subscriptions != nil {
+		body.Subscriptions = make([]*WebhookSubscriptionResponseBody, len(res.Subscriptions))
+		for i, val := range res.Subscriptions {
+			if val == nil {
+				body.Subscriptions[i] = nil
+				continue
+			}
+			body.Subscriptions[i] = marshalIdentityWebhookSubscriptionToWebhookSubscriptionResponseBody(val)
+		}
+	} else {
+		body.Subscriptions = []*WebhookSubscriptionResponseBody{}
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesResponseBody builds the HTTP response body from the
+// result of the "list_webhook_deliveries" endpoint of the "identity" service.
+func NewListWebhookDeliveriesResponseBody(res *identity.WebhookDeliveriesCollection) *ListWebhookDeliveriesResponseBody {
+	body := &ListWebhookDeliveriesResponseBody{}
+	if res.Deliveries != nil {
+		body.Deliveries = make([]*WebhookDeliveryResponseBody, len(res.Deliveries))
+		for i, val := range res.Deliveries {
+			if val == nil {
+				body.Deliveries[i] = nil
+				continue
+			}
+			body.Deliveries[i] = marshalIdentityWebhookDeliveryToWebhookDeliveryResponseBody(val)
+		}
+	} else {
+		body.Deliveries = []*WebhookDeliveryResponseBody{}
+	}
+	return body
+}
+
+// NewRedeliverWebhookResponseBody builds the HTTP response body from the
+// result of the "redeliver_webhook" endpoint of the "identity" service.
+func NewRedeliverWebhookResponseBody(res *identity.WebhookDelivery) *RedeliverWebhookResponseBody {
+	body := &RedeliverWebhookResponseBody{
+		ID:             res.ID,
+		SubscriptionID: res.SubscriptionID,
+		EventType:      res.EventType,
+		Status:         res.Status,
+		Attempts:       res.Attempts,
+		ResponseStatus: res.ResponseStatus,
+		LastError:      res.LastError,
+		CreatedAt:      res.CreatedAt,
+		LastAttemptAt:  res.LastAttemptAt,
+		NextAttemptAt:  res.NextAttemptAt,
+		DeliveredAt:    res.DeliveredAt,
+	}
+	return body
+}
+
+// NewSetUserStatusResponseBody builds the HTTP response body from the result
+// of the "set_user_status" endpoint of the "identity" service.
+func NewSetUserStatusResponseBody(res *identityviews.UserView) *SetUserStatusResponseBody {
+	body := &SetUserStatusResponseBody{
+		ID:              *res.ID,
+		Email:           *res.Email,
+		DisplayName:     *res.DisplayName,
+		CreatedAt:       *res.CreatedAt,
+		Status:          *res.Status,
+		StatusReason:    res.StatusReason,
+		StatusChangedAt: res.StatusChangedAt,
+		Username:        res.Username,
+	}
+	if res.Attributes != nil {
+		body.Attributes = make(map[string]string, len(res.Attributes))
+		for key, val := range res.Attributes {
+			tk := key
+			tv := val
+			body.Attributes[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewRegisterConflictResponseBody builds the HTTP response body from the
+// result of the "register" endpoint of the "identity" service.
+func NewRegisterConflictResponseBody(res *identity.ConflictError) *RegisterConflictResponseBody {
+	body := &RegisterConflictResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewRegisterInvalidUsernameResponseBody builds the HTTP response body from
+// the result of the "register" endpoint of the "identity" service.
+func NewRegisterInvalidUsernameResponseBody(res *identity.InvalidUsernameError) *RegisterInvalidUsernameResponseBody {
+	body := &RegisterInvalidUsernameResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewRegisterPasswordPolicyResponseBody builds the HTTP response body from the
+// result of the "register" endpoint of the "identity" service.
+func NewRegisterPasswordPolicyResponseBody(res *identity.PasswordPolicyError) *RegisterPasswordPolicyResponseBody {
+	body := &RegisterPasswordPolicyResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	if res.Violations != nil {
+		body.Violations = make([]*PolicyViolationResponseBody, len(res.Violations))
+		for i, val := range res.Violations {
+			if val == nil {
+				body.Violations[i] = nil
+				continue
+			}
+			body.Violations[i] = marshalIdentityPolicyViolationToPolicyViolationResponseBody(val)
+		}
+	} else {
+		body.Violations = []*PolicyViolationResponseBody{}
+	}
+	return body
+}
+
+// NewRegisterUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "register" endpoint of the "identity" service.
+func NewRegisterUnauthorizedResponseBody(res *identity.UnauthorizedError) *RegisterUnauthorizedResponseBody {
+	body := &RegisterUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewSetUsernameConflictResponseBody builds the HTTP response body from the
+// result of the "set_username" endpoint of the "identity" service.
+func NewSetUsernameConflictResponseBody(res *identity.ConflictError) *SetUsernameConflictResponseBody {
+	body := &SetUsernameConflictResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewSetUsernameInvalidUsernameResponseBody builds the HTTP response body from
+// the result of the "set_username" endpoint of the "identity" service.
+func NewSetUsernameInvalidUsernameResponseBody(res *identity.InvalidUsernameError) *SetUsernameInvalidUsernameResponseBody {
+	body := &SetUsernameInvalidUsernameResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewSetUsernameUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "set_username" endpoint of the "identity" service.
+func NewSetUsernameUnauthorizedResponseBody(res *identity.UnauthorizedError) *SetUsernameUnauthorizedResponseBody {
+	body := &SetUsernameUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewRequestMagicLinkUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "request_magic_link" endpoint of the "identity"
+// service.
+func NewRequestMagicLinkUnauthorizedResponseBody(res *identity.UnauthorizedError) *RequestMagicLinkUnauthorizedResponseBody {
+	body := &RequestMagicLinkUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewConsumeMagicLinkUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "consume_magic_link" endpoint of the "identity"
+// service.
+func NewConsumeMagicLinkUnauthorizedResponseBody(res *identity.UnauthorizedError) *ConsumeMagicLinkUnauthorizedResponseBody {
+	body := &ConsumeMagicLinkUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewListAuthEventsBadRequestResponseBody builds the HTTP response body from
+// the result of the "list_auth_events" endpoint of the "identity" service.
+func NewListAuthEventsBadRequestResponseBody(res *identity.BadRequestError) *ListAuthEventsBadRequestResponseBody {
+	body := &ListAuthEventsBadRequestResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewListAuthEventsUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list_auth_events" endpoint of the "identity" service.
+func NewListAuthEventsUnauthorizedResponseBody(res *identity.UnauthorizedError) *ListAuthEventsUnauthorizedResponseBody {
+	body := &ListAuthEventsUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewIntrospectUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "introspect" endpoint of the "identity" service.
+func NewIntrospectUnauthorizedResponseBody(res *identity.UnauthorizedError) *IntrospectUnauthorizedResponseBody {
+	body := &IntrospectUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewRevokeTokenUnsupportedTokenTypeResponseBody builds the HTTP response body
+// from the result of the "revoke_token" endpoint of the "identity" service.
+func NewRevokeTokenUnsupportedTokenTypeResponseBody(res *identity.OAuthError) *RevokeTokenUnsupportedTokenTypeResponseBody {
+	body := &RevokeTokenUnsupportedTokenTypeResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewRevokeTokenUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "revoke_token" endpoint of the "identity" service.
+func NewRevokeTokenUnauthorizedResponseBody(res *identity.UnauthorizedError) *RevokeTokenUnauthorizedResponseBody {
+	body := &RevokeTokenUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewExchangeTokenNotFoundResponseBody builds the HTTP response body from the
+// result of the "exchange_token" endpoint of the "identity" service.
+func NewExchangeTokenNotFoundResponseBody(res *identity.NotFoundError) *ExchangeTokenNotFoundResponseBody {
+	body := &ExchangeTokenNotFoundResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewExchangeTokenUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "exchange_token" endpoint of the "identity" service.
+func NewExchangeTokenUnauthorizedResponseBody(res *identity.UnauthorizedError) *ExchangeTokenUnauthorizedResponseBody {
+	body := &ExchangeTokenUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewInviteUserConflictResponseBody builds the HTTP response body from the
+// result of the "invite_user" endpoint of the "identity" service.
+func NewInviteUserConflictResponseBody(res *identity.ConflictError) *InviteUserConflictResponseBody {
+	body := &InviteUserConflictResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewInviteUserUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "invite_user" endpoint of the "identity" service.
+func NewInviteUserUnauthorizedResponseBody(res *identity.UnauthorizedError) *InviteUserUnauthorizedResponseBody {
+	body := &InviteUserUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewListInvitationsUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "list_invitations" endpoint of the "identity" service.
+func NewListInvitationsUnauthorizedResponseBody(res *identity.UnauthorizedError) *ListInvitationsUnauthorizedResponseBody {
+	body := &ListInvitationsUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewRevokeInvitationNotFoundResponseBody builds the HTTP response body from
+// the result of the "revoke_invitation" endpoint of the "identity" service.
+func NewRevokeInvitationNotFoundResponseBody(res *identity.NotFoundError) *RevokeInvitationNotFoundResponseBody {
+	body := &RevokeInvitationNotFoundResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewRevokeInvitationUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "revoke_invitation" endpoint of the "identity"
+// service.
+func NewRevokeInvitationUnauthorizedResponseBody(res *identity.UnauthorizedError) *RevokeInvitationUnauthorizedResponseBody {
+	body := &RevokeInvitationUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewAcceptInvitationConflictResponseBody builds the HTTP response body from
+// the result of the "accept_invitation" endpoint of the "identity" service.
+func NewAcceptInvitationConflictResponseBody(res *identity.ConflictError) *AcceptInvitationConflictResponseBody {
+	body := &AcceptInvitationConflictResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	return body
+}
+
+// NewAcceptInvitationPasswordPolicyResponseBody builds the HTTP response body
+// from the result of the "accept_invitation" endpoint of the "identity"
+// service.
+func NewAcceptInvitationPasswordPolicyResponseBody(res *identity.PasswordPolicyError) *AcceptInvitationPasswordPolicyResponseBody {
+	body := &AcceptInvitationPasswordPolicyResponseBody{
+		Message: res.Message,
+		ID:      res.ID,
+	}
+	if res.Violations != nil {
+		body.Violations = make([]*PolicyViolationResponseBody, len(res.Violations))
+		for i, val := range res.Violations {
+			if val == nil {
+				body.Violations[i] = nil
+				continue
+			}
+			body.Violations[i] = marshalIdentityPolicyViolationToPolicyViolationResponseBody(val)
+		}
+	} else {
+		body.Violations = []*PolicyViolationResponseBody{}
+	}
+	return body
+}
+
+// NewAcceptInvitationUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "accept_invitation" endpoint of the "identity"
+// service.
+func NewAcceptInvitationUnauthorizedResponseBody(res *identity.UnauthorizedError) *AcceptInvitationUnauthorizedResponseBody {
+	body := &AcceptInvitationUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewDeviceAuthorizationInvalidClientResponseBody builds the HTTP response
+// body from the result of the "device_authorization" endpoint of the
+// "identity" service.
+func NewDeviceAuthorizationInvalidClientResponseBody(res *identity.OAuthError) *DeviceAuthorizationInvalidClientResponseBody {
+	body := &DeviceAuthorizationInvalidClientResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewDeviceAuthorizationInvalidScopeResponseBody builds the HTTP response body
+// from the result of the "device_authorization" endpoint of the "identity"
+// service.
+func NewDeviceAuthorizationInvalidScopeResponseBody(res *identity.OAuthError) *DeviceAuthorizationInvalidScopeResponseBody {
+	body := &DeviceAuthorizationInvalidScopeResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewDeviceAuthorizationInvalidTargetResponseBody builds the HTTP response
+// body from the result of the "device_authorization" endpoint of the
+// "identity" service.
+func NewDeviceAuthorizationInvalidTargetResponseBody(res *identity.OAuthError) *DeviceAuthorizationInvalidTargetResponseBody {
+	body := &DeviceAuthorizationInvalidTargetResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewDeviceTokenAuthorizationPendingResponseBody builds the HTTP response body
+// from the result of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenAuthorizationPendingResponseBody(res *identity.OAuthError) *DeviceTokenAuthorizationPendingResponseBody {
+	body := &DeviceTokenAuthorizationPendingResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewDeviceTokenSlowDownResponseBody builds the HTTP response body from the
+// result of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenSlowDownResponseBody(res *identity.OAuthError) *DeviceTokenSlowDownResponseBody {
+	body := &DeviceTokenSlowDownResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewDeviceTokenAccessDeniedResponseBody builds the HTTP response body from
+// the result of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenAccessDeniedResponseBody(res *identity.OAuthError) *DeviceTokenAccessDeniedResponseBody {
+	body := &DeviceTokenAccessDeniedResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewDeviceTokenExpiredTokenResponseBody builds the HTTP response body from
+// the result of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenExpiredTokenResponseBody(res *identity.OAuthError) *DeviceTokenExpiredTokenResponseBody {
+	body := &DeviceTokenExpiredTokenResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewDeviceTokenInvalidGrantResponseBody builds the HTTP response body from
+// the result of the "device_token" endpoint of the "identity" service.
+func NewDeviceTokenInvalidGrantResponseBody(res *identity.OAuthError) *DeviceTokenInvalidGrantResponseBody {
+	body := &DeviceTokenInvalidGrantResponseBody{
+		Code:             res.Code,
+		ErrorDescription: res.ErrorDescription,
+	}
+	return body
+}
+
+// NewApproveDeviceNotFoundResponseBody builds the HTTP response body from the
+// result of the "approve_device" endpoint of the "identity" service.
+func NewApproveDeviceNotFoundResponseBody(res *identity.NotFoundError) *ApproveDeviceNotFoundResponseBody {
+	body := &ApproveDeviceNotFoundResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewApproveDeviceUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "approve_device" endpoint of the "identity" service.
+func NewApproveDeviceUnauthorizedResponseBody(res *identity.UnauthorizedError) *ApproveDeviceUnauthorizedResponseBody {
+	body := &ApproveDeviceUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewCreateWebhookUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "create_webhook" endpoint of the "identity" service.
+func NewCreateWebhookUnauthorizedResponseBody(res *identity.UnauthorizedError) *CreateWebhookUnauthorizedResponseBody {
+	body := &CreateWebhookUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewListWebhooksUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "list_webhooks" endpoint of the "identity" service.
+func NewListWebhooksUnauthorizedResponseBody(res *identity.UnauthorizedError) *ListWebhooksUnauthorizedResponseBody {
+	body := &ListWebhooksUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewDeleteWebhookNotFoundResponseBody builds the HTTP response body from the
+// result of the "delete_webhook" endpoint of the "identity" service.
+func NewDeleteWebhookNotFoundResponseBody(res *identity.NotFoundError) *DeleteWebhookNotFoundResponseBody {
+	body := &DeleteWebhookNotFoundResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewDeleteWebhookUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "delete_webhook" endpoint of the "identity" service.
+func NewDeleteWebhookUnauthorizedResponseBody(res *identity.UnauthorizedError) *DeleteWebhookUnauthorizedResponseBody {
+	body := &DeleteWebhookUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesNotFoundResponseBody builds the HTTP response body
+// from the result of the "list_webhook_deliveries" endpoint of the "identity"
+// service.
+func NewListWebhookDeliveriesNotFoundResponseBody(res *identity.NotFoundError) *ListWebhookDeliveriesNotFoundResponseBody {
+	body := &ListWebhookDeliveriesNotFoundResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewListWebhookDeliveriesUnauthorizedResponseBody builds the HTTP response
+// body from the result of the "list_webhook_deliveries" endpoint of the
+// "identity" service.
+func NewListWebhookDeliveriesUnauthorizedResponseBody(res *identity.UnauthorizedError) *ListWebhookDeliveriesUnauthorizedResponseBody {
+	body := &ListWebhookDeliveriesUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewRedeliverWebhookNotFoundResponseBody builds the HTTP response body from
+// the result of the "redeliver_webhook" endpoint of the "identity" service.
+func NewRedeliverWebhookNotFoundResponseBody(res *identity.NotFoundError) *RedeliverWebhookNotFoundResponseBody {
+	body := &RedeliverWebhookNotFoundResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewRedeliverWebhookUnauthorizedResponseBody builds the HTTP response body
+// from the result of the "redeliver_webhook" endpoint of the "identity"
+// service.
+func NewRedeliverWebhookUnauthorizedResponseBody(res *identity.UnauthorizedError) *RedeliverWebhookUnauthorizedResponseBody {
+	body := &RedeliverWebhookUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewSetUserStatusNotFoundResponseBody builds the HTTP response body from the
+// result of the "set_user_status" endpoint of the "identity" service.
+func NewSetUserStatusNotFoundResponseBody(res *identity.NotFoundError) *SetUserStatusNotFoundResponseBody {
+	body := &SetUserStatusNotFoundResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewSetUserStatusUnauthorizedResponseBody builds the HTTP response body from
+// the result of the "set_user_status" endpoint of the "identity" service.
+func NewSetUserStatusUnauthorizedResponseBody(res *identity.UnauthorizedError) *SetUserStatusUnauthorizedResponseBody {
+	body := &SetUserStatusUnauthorizedResponseBody{
+		Message:   res.Message,
+		ID:        res.ID,
+		Temporary: res.Temporary,
+		Timeout:   res.Timeout,
+	}
+	return body
+}
+
+// NewRegisterPayload builds a identity service register endpoint payload.
+func NewRegisterPayload(body *RegisterRequestBody) *identity.RegisterPayload {
+	v := &identity.RegisterPayload{
+		Email:       *body.Email,
+		Password:    body.Password,
+		DisplayName: *body.DisplayName,
+		Username:    body.Username,
+	}
+
+	return v
+}
+
+// NewLoginPayload builds a identity service login endpoint payload.
+func NewLoginPayload(body *LoginRequestBody) *identity.LoginPayload {
+	v := &identity.LoginPayload{
+		Email:      body.Email,
+		Password:   *body.Password,
+		Identifier: body.Identifier,
+	}
+	if body.TokenFormat != nil {
+		v.TokenFormat = *body.TokenFormat
+	}
+	if body.TokenFormat == nil {
+		v.TokenFormat = "jwt"
+	}
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewSetUsernamePayload builds a identity service set_username endpoint
+// payload.
+func NewSetUsernamePayload(body *SetUsernameRequestBody, token string) *identity.SetUsernamePayload {
+	v := &identity.SetUsernamePayload{
+		Username: *body.Username,
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewCheckUsernamePayload builds a identity service check_username endpoint
+// payload.
+func NewCheckUsernamePayload(username string) *identity.CheckUsernamePayload {
+	v := &identity.CheckUsernamePayload{}
+	v.Username = username
+
+	return v
+}
+
+// NewRequestMagicLinkPayload builds a identity service request_magic_link
+// endpoint payload.
+func NewRequestMagicLinkPayload(body *RequestMagicLinkRequestBody) *identity.RequestMagicLinkPayload {
+	v := &identity.RequestMagicLinkPayload{
+		Email: *body.Email,
+	}
+
+	return v
+}
+
+// NewConsumeMagicLinkPayload builds a identity service consume_magic_link
+// endpoint payload.
+func NewConsumeMagicLinkPayload(body *ConsumeMagicLinkRequestBody) *identity.ConsumeMagicLinkPayload {
+	v := &identity.ConsumeMagicLinkPayload{
+		Token: *body.Token,
+	}
+
+	return v
+}
+
+// NewValidateTokenPayload builds a identity service validate_token endpoint
+// payload.
+func NewValidateTokenPayload(body *ValidateTokenRequestBody) *identity.ValidateTokenPayload {
+	v := &identity.ValidateTokenPayload{
+		Token:    *body.Token,
+		Audience: body.Audience,
+	}
+
+	return v
+}
+
+// NewValidateTokensPayload builds a identity service validate_tokens endpoint
+// payload.
+func NewValidateTokensPayload(body *ValidateTokensRequestBody) *identity.ValidateTokensPayload {
+	v := &identity.ValidateTokensPayload{
+		Audience: body.Audience,
+	}
+	v.Tokens = make([]string, len(body.Tokens))
+	for i, val := range body.Tokens {
+		v.Tokens[i] = val
+	}
+
+	return v
 }
 
-// LoginRequestBody is the type of the "identity" service "login" endpoint HTTP
-// request body.
-type LoginRequestBody struct {
-	Email    *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
-	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+// NewListAuthEventsPayload builds a identity service list_auth_events endpoint
+// payload.
+func NewListAuthEventsPayload(userID *string, type_ *string, since *string, until *string, beforeID *int64, limit int, token string) *identity.ListAuthEventsPayload {
+	v := &identity.ListAuthEventsPayload{}
+	v.UserID = userID
+	v.Type = type_
+	v.Since = since
+	v.Until = until
+	v.BeforeID = beforeID
+	v.Limit = limit
+	v.Token = token
+
+	return v
 }
 
-// ValidateTokenRequestBody is the type of the "identity" service
-// "validate_token" endpoint HTTP request body.
-type ValidateTokenRequestBody struct {
-	// JWT access token
-	Token *string `form:"token,omitempty" json:"token,omitempty" xml:"token,omitempty"`
+// NewIntrospectPayload builds a identity service introspect endpoint payload.
+func NewIntrospectPayload(body struct {
+	// Token to introspect
+	Token *string `form:"token" json:"token" xml:"token"`
+	// Hint about the type of the submitted token
+	TokenTypeHint *string `form:"token_type_hint" json:"token_type_hint" xml:"token_type_hint"`
+}) *identity.IntrospectPayload {
+	v := &identity.IntrospectPayload{
+		TokenTypeHint: body.TokenTypeHint,
+	}
+	if body.Token != nil {
+		v.Token = *body.Token
+	}
+
+	return v
 }
 
-// RegisterResponseBody is the type of the "identity" service "register"
-// endpoint HTTP response body.
-type RegisterResponseBody struct {
-	// User identifier
-	ID string `form:"id" json:"id" xml:"id"`
-	// Email address
-	Email string `form:"email" json:"email" xml:"email"`
-	// Display name
-	DisplayName string `form:"display_name" json:"display_name" xml:"display_name"`
-	// Creation timestamp
-	CreatedAt string `form:"created_at" json:"created_at" xml:"created_at"`
+// NewRevokeTokenPayload builds a identity service revoke_token endpoint
+// payload.
+func NewRevokeTokenPayload(body struct {
+	// Token to revoke
+	Token *string `form:"token" json:"token" xml:"token"`
+	// Hint about the type of the submitted token
+	TokenTypeHint *string `form:"token_type_hint" json:"token_type_hint" xml:"token_type_hint"`
+}) *identity.RevokeTokenPayload {
+	v := &identity.RevokeTokenPayload{
+		TokenTypeHint: body.TokenTypeHint,
+	}
+	if body.Token != nil {
+		v.Token = *body.Token
+	}
+
+	return v
 }
 
-// LoginResponseBody is the type of the "identity" service "login" endpoint
-// HTTP response body.
-type LoginResponseBody struct {
-	// JWT access token
-	AccessToken string `form:"access_token" json:"access_token" xml:"access_token"`
-	// Token expiry window in seconds
-	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+// NewExchangeTokenTokenExchangePayload builds a identity service
+// exchange_token endpoint payload.
+func NewExchangeTokenTokenExchangePayload(body *ExchangeTokenRequestBody, token string) *identity.TokenExchangePayload {
+	v := &identity.TokenExchangePayload{
+		RequestedSubject: *body.RequestedSubject,
+		Reason:           *body.Reason,
+	}
+	if body.GrantType != nil {
+		v.GrantType = *body.GrantType
+	}
+	if body.RequestedTokenType != nil {
+		v.RequestedTokenType = *body.RequestedTokenType
+	}
+	if body.GrantType == nil {
+		v.GrantType = "urn:ietf:params:oauth:grant-type:token-exchange"
+	}
+	if body.RequestedTokenType == nil {
+		v.RequestedTokenType = "urn:ietf:params:oauth:token-type:access_token"
+	}
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
+	}
+	v.Token = token
+
+	return v
 }
 
-// ValidateTokenResponseBody is the type of the "identity" service
-// "validate_token" endpoint HTTP response body.
-type ValidateTokenResponseBody struct {
-	Valid  bool    `form:"valid" json:"valid" xml:"valid"`
-	UserID *string `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
-	Email  *string `form:"email,omitempty" json:"email,omitempty" xml:"email,omitempty"`
-	Reason *string `form:"reason,omitempty" json:"reason,omitempty" xml:"reason,omitempty"`
+// NewInviteUserPayload builds a identity service invite_user endpoint payload.
+func NewInviteUserPayload(body *InviteUserRequestBody, token string) *identity.InviteUserPayload {
+	v := &identity.InviteUserPayload{
+		Email:       *body.Email,
+		DisplayName: body.DisplayName,
+	}
+	if body.Attributes != nil {
+		v.Attributes = make(map[string]string, len(body.Attributes))
+		for key, val := range body.Attributes {
+			tk := key
+			tv := val
+			v.Attributes[tk] = tv
+		}
+	}
+	v.Token = token
+
+	return v
 }
 
-// NewRegisterResponseBody builds the HTTP response body from the result of the
-// "register" endpoint of the "identity" service.
-func NewRegisterResponseBody(res *identityviews.UserView) *RegisterResponseBody {
-	body := &RegisterResponseBody{
-		ID:          *res.ID,
-		Email:       *res.Email,
-		DisplayName: *res.DisplayName,
-		CreatedAt:   *res.CreatedAt,
+// NewListInvitationsPayload builds a identity service list_invitations
+// endpoint payload.
+func NewListInvitationsPayload(token string) *identity.ListInvitationsPayload {
+	v := &identity.ListInvitationsPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewRevokeInvitationPayload builds a identity service revoke_invitation
+// endpoint payload.
+func NewRevokeInvitationPayload(id string, token string) *identity.RevokeInvitationPayload {
+	v := &identity.RevokeInvitationPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewAcceptInvitationPayload builds a identity service accept_invitation
+// endpoint payload.
+func NewAcceptInvitationPayload(body *AcceptInvitationRequestBody) *identity.AcceptInvitationPayload {
+	v := &identity.AcceptInvitationPayload{
+		InvitationToken: *body.InvitationToken,
+		Password:        body.Password,
+		DisplayName:     body.DisplayName,
 	}
-	return body
+
+	return v
 }
 
-// NewLoginResponseBody builds the HTTP response body from the result of the
-// "login" endpoint of the "identity" service.
-func NewLoginResponseBody(res *identity.TokenResult) *LoginResponseBody {
-	body := &LoginResponseBody{
-		AccessToken: res.AccessToken,
-		ExpiresIn:   res.ExpiresIn,
+// NewDeviceAuthorizationPayload builds a identity service device_authorization
+// endpoint payload.
+func NewDeviceAuthorizationPayload(body *DeviceAuthorizationRequestBody) *identity.DeviceAuthorizationPayload {
+	v := &identity.DeviceAuthorizationPayload{
+		ClientID: *body.ClientID,
+		Scope:    body.Scope,
 	}
-	return body
+	if body.Audience != nil {
+		v.Audience = make([]string, len(body.Audience))
+		for i, val := range body.Audience {
+			v.Audience[i] = val
+		}
+	}
+
+	return v
 }
 
-// NewValidateTokenResponseBody builds the HTTP response body from the result
-// of the "validate_token" endpoint of the "identity" service.
-func NewValidateTokenResponseBody(res *identity.ValidationResult) *ValidateTokenResponseBody {
-	body := &ValidateTokenResponseBody{
-		Valid:  res.Valid,
-		UserID: res.UserID,
-		Email:  res.Email,
-		Reason: res.Reason,
+// NewDeviceTokenPayload builds a identity service device_token endpoint
+// payload.
+func NewDeviceTokenPayload(body *DeviceTokenRequestBody) *identity.DeviceTokenPayload {
+	v := &identity.DeviceTokenPayload{
+		GrantType:  *body.GrantType,
+		DeviceCode: *body.DeviceCode,
+		ClientID:   *body.ClientID,
 	}
-	return body
+
+	return v
 }
 
-// NewRegisterPayload builds a identity service register endpoint payload.
-func NewRegisterPayload(body *RegisterRequestBody) *identity.RegisterPayload {
-	v := &identity.RegisterPayload{
-		DisplayName: *body.DisplayName,
-		Email:       *body.Email,
-		Password:    *body.Password,
+// NewApproveDevicePayload builds a identity service approve_device endpoint
+// payload.
+func NewApproveDevicePayload(body *ApproveDeviceRequestBody, token string) *identity.ApproveDevicePayload {
+	v := &identity.ApproveDevicePayload{
+		UserCode: *body.UserCode,
+	}
+	if body.Approve != nil {
+		v.Approve = *body.Approve
 	}
+	if body.Approve == nil {
+		v.Approve = true
+	}
+	v.Token = token
 
 	return v
 }
 
-// NewLoginCredentials builds a identity service login endpoint payload.
-func NewLoginCredentials(body *LoginRequestBody) *identity.Credentials {
-	v := &identity.Credentials{
-		Email:    *body.Email,
-		Password: *body.Password,
+// NewCreateWebhookPayload builds a identity service create_webhook endpoint
+// payload.
+func NewCreateWebhookPayload(body *CreateWebhookRequestBody, token string) *identity.CreateWebhookPayload {
+	v := &identity.CreateWebhookPayload{
+		URL: *body.URL,
+	}
+	if body.EventTypes != nil {
+		v.EventTypes = make([]string, len(body.EventTypes))
+		for i, val := range body.EventTypes {
+			v.EventTypes[i] = val
+		}
 	}
+	v.Token = token
 
 	return v
 }
 
-// NewValidateTokenPayload builds a identity service validate_token endpoint
+// NewListWebhooksPayload builds a identity service list_webhooks endpoint
 // payload.
-func NewValidateTokenPayload(body *ValidateTokenRequestBody) *identity.ValidateTokenPayload {
-	v := &identity.ValidateTokenPayload{
-		Token: *body.Token,
+func NewListWebhooksPayload(token string) *identity.ListWebhooksPayload {
+	v := &identity.ListWebhooksPayload{}
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteWebhookPayload builds a identity service delete_webhook endpoint
+// payload.
+func NewDeleteWebhookPayload(id string, token string) *identity.DeleteWebhookPayload {
+	v := &identity.DeleteWebhookPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListWebhookDeliveriesPayload builds a identity service
+// list_webhook_deliveries endpoint payload.
+func NewListWebhookDeliveriesPayload(id string, limit int, token string) *identity.ListWebhookDeliveriesPayload {
+	v := &identity.ListWebhookDeliveriesPayload{}
+	v.ID = id
+	v.Limit = limit
+	v.Token = token
+
+	return v
+}
+
+// NewRedeliverWebhookPayload builds a identity service redeliver_webhook
+// endpoint payload.
+func NewRedeliverWebhookPayload(id string, token string) *identity.RedeliverWebhookPayload {
+	v := &identity.RedeliverWebhookPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewSetUserStatusPayload builds a identity service set_user_status endpoint
+// payload.
+func NewSetUserStatusPayload(body *SetUserStatusRequestBody, id string, token string) *identity.SetUserStatusPayload {
+	v := &identity.SetUserStatusPayload{
+		Status: *body.Status,
+		Reason: body.Reason,
 	}
+	v.ID = id
+	v.Token = token
 
 	return v
 }
@@ -136,19 +2345,11 @@ func NewValidateTokenPayload(body *ValidateTokenRequestBody) *identity.ValidateT
 // ValidateRegisterRequestBody runs the validations defined on
 // RegisterRequestBody
 func ValidateRegisterRequestBody(body *RegisterRequestBody) (err error) {
-	if body.DisplayName == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("display_name", "body"))
-	}
 	if body.Email == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
 	}
-	if body.Password == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("password", "body"))
-	}
-	if body.DisplayName != nil {
-		if utf8.RuneCountInString(*body.DisplayName) < 3 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 3, true))
-		}
+	if body.DisplayName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("display_name", "body"))
 	}
 	if body.Email != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
@@ -158,17 +2359,24 @@ func ValidateRegisterRequestBody(body *RegisterRequestBody) (err error) {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.password", *body.Password, utf8.RuneCountInString(*body.Password), 8, true))
 		}
 	}
+	if body.DisplayName != nil {
+		if utf8.RuneCountInString(*body.DisplayName) < 3 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 3, true))
+		}
+	}
 	return
 }
 
 // ValidateLoginRequestBody runs the validations defined on LoginRequestBody
 func ValidateLoginRequestBody(body *LoginRequestBody) (err error) {
-	if body.Email == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
-	}
 	if body.Password == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("password", "body"))
 	}
+	if body.TokenFormat != nil {
+		if !(*body.TokenFormat == "jwt" || *body.TokenFormat == "opaque") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.token_format", *body.TokenFormat, []any{"jwt", "opaque"}))
+		}
+	}
 	if body.Email != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
 	}
@@ -180,6 +2388,36 @@ func ValidateLoginRequestBody(body *LoginRequestBody) (err error) {
 	return
 }
 
+// ValidateSetUsernameRequestBody runs the validations defined on
+// set_username_request_body
+func ValidateSetUsernameRequestBody(body *SetUsernameRequestBody) (err error) {
+	if body.Username == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("username", "body"))
+	}
+	return
+}
+
+// ValidateRequestMagicLinkRequestBody runs the validations defined on
+// request_magic_link_request_body
+func ValidateRequestMagicLinkRequestBody(body *RequestMagicLinkRequestBody) (err error) {
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateConsumeMagicLinkRequestBody runs the validations defined on
+// consume_magic_link_request_body
+func ValidateConsumeMagicLinkRequestBody(body *ConsumeMagicLinkRequestBody) (err error) {
+	if body.Token == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("token", "body"))
+	}
+	return
+}
+
 // ValidateValidateTokenRequestBody runs the validations defined on
 // validate_token_request_body
 func ValidateValidateTokenRequestBody(body *ValidateTokenRequestBody) (err error) {
@@ -188,3 +2426,158 @@ func ValidateValidateTokenRequestBody(body *ValidateTokenRequestBody) (err error
 	}
 	return
 }
+
+// ValidateValidateTokensRequestBody runs the validations defined on
+// validate_tokens_request_body
+func ValidateValidateTokensRequestBody(body *ValidateTokensRequestBody) (err error) {
+	if body.Tokens == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tokens", "body"))
+	}
+	if len(body.Tokens) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tokens", body.Tokens, len(body.Tokens), 1, true))
+	}
+	if len(body.Tokens) > 100 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tokens", body.Tokens, len(body.Tokens), 100, false))
+	}
+	return
+}
+
+// ValidateExchangeTokenRequestBody runs the validations defined on
+// exchange_token_request_body
+func ValidateExchangeTokenRequestBody(body *ExchangeTokenRequestBody) (err error) {
+	if body.RequestedSubject == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("requested_subject", "body"))
+	}
+	if body.Reason == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reason", "body"))
+	}
+	if body.GrantType != nil {
+		if !(*body.GrantType == "urn:ietf:params:oauth:grant-type:token-exchange") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.grant_type", *body.GrantType, []any{"urn:ietf:params:oauth:grant-type:token-exchange"}))
+		}
+	}
+	if body.RequestedSubject != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.requested_subject", *body.RequestedSubject, goa.FormatUUID))
+	}
+	if body.RequestedTokenType != nil {
+		if !(*body.RequestedTokenType == "urn:ietf:params:oauth:token-type:access_token" || *body.RequestedTokenType == "urn:ietf:params:oauth:token-type:jwt") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.requested_token_type", *body.RequestedTokenType, []any{"urn:ietf:params:oauth:token-type:access_token", "urn:ietf:params:oauth:token-type:jwt"}))
+		}
+	}
+	if body.Reason != nil {
+		if utf8.RuneCountInString(*body.Reason) < 3 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", *body.Reason, utf8.RuneCountInString(*body.Reason), 3, true))
+		}
+	}
+	return
+}
+
+// ValidateInviteUserRequestBody runs the validations defined on
+// invite_user_request_body
+func ValidateInviteUserRequestBody(body *InviteUserRequestBody) (err error) {
+	if body.Email == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("email", "body"))
+	}
+	if body.Email != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
+	}
+	if body.DisplayName != nil {
+		if utf8.RuneCountInString(*body.DisplayName) < 3 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 3, true))
+		}
+	}
+	return
+}
+
+// ValidateAcceptInvitationRequestBody runs the validations defined on
+// accept_invitation_request_body
+func ValidateAcceptInvitationRequestBody(body *AcceptInvitationRequestBody) (err error) {
+	if body.InvitationToken == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("invitation_token", "body"))
+	}
+	if body.Password != nil {
+		if utf8.RuneCountInString(*body.Password) < 8 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.password", *body.Password, utf8.RuneCountInString(*body.Password), 8, true))
+		}
+	}
+	if body.DisplayName != nil {
+		if utf8.RuneCountInString(*body.DisplayName) < 3 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.display_name", *body.DisplayName, utf8.RuneCountInString(*body.DisplayName), 3, true))
+		}
+	}
+	return
+}
+
+// ValidateDeviceAuthorizationRequestBody runs the validations defined on
+// device_authorization_request_body
+func ValidateDeviceAuthorizationRequestBody(body *DeviceAuthorizationRequestBody) (err error) {
+	if body.ClientID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("client_id", "body"))
+	}
+	return
+}
+
+// ValidateDeviceTokenRequestBody runs the validations defined on
+// device_token_request_body
+func ValidateDeviceTokenRequestBody(body *DeviceTokenRequestBody) (err error) {
+	if body.GrantType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("grant_type", "body"))
+	}
+	if body.DeviceCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("device_code", "body"))
+	}
+	if body.ClientID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("client_id", "body"))
+	}
+	if body.GrantType != nil {
+		if !(*body.GrantType == "urn:ietf:params:oauth:grant-type:device_code") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.grant_type", *body.GrantType, []any{"urn:ietf:params:oauth:grant-type:device_code"}))
+		}
+	}
+	return
+}
+
+// ValidateApproveDeviceRequestBody runs the validations defined on
+// approve_device_request_body
+func ValidateApproveDeviceRequestBody(body *ApproveDeviceRequestBody) (err error) {
+	if body.UserCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_code", "body"))
+	}
+	return
+}
+
+// ValidateCreateWebhookRequestBody runs the validations defined on
+// create_webhook_request_body
+func ValidateCreateWebhookRequestBody(body *CreateWebhookRequestBody) (err error) {
+	if body.URL == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("url", "body"))
+	}
+	if body.URL != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.url", *body.URL, "^https?://"))
+	}
+	for _, e := range body.EventTypes {
+		if !(e == "user.registered" || e == "user.updated" || e == "user.disabled" || e == "user.deleted") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.event_types[*]", e, []any{"user.registered", "user.updated", "user.disabled", "user.deleted"}))
+		}
+	}
+	return
+}
+
+// ValidateSetUserStatusRequestBody runs the validations defined on
+// set_user_status_request_body
+func ValidateSetUserStatusRequestBody(body *SetUserStatusRequestBody) (err error) {
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "active" || *body.Status == "suspended" || *body.Status == "deactivated") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"active", "suspended", "deactivated"}))
+		}
+	}
+	if body.Reason != nil {
+		if utf8.RuneCountInString(*body.Reason) > 500 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.reason", *body.Reason, utf8.RuneCountInString(*body.Reason), 500, false))
+		}
+	}
+	return
+}
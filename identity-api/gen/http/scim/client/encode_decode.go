@@ -0,0 +1,878 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// scim HTTP client encoders and decoders
+//
+// Command:
+// $ goa gen github.com/vidwadeseram/go-boilerplate/identity-api/design
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	scim "github.com/vidwadeseram/go-boilerplate/identity-api/gen/scim"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// BuildCreateUserRequest instantiates a HTTP request object with method and
+// path set to call the "scim" service "create_user" endpoint
+func (c *Client) BuildCreateUserRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateUserScimPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("scim", "create_user", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateUserRequest returns an encoder for requests sent to the scim
+// create_user server.
+func EncodeCreateUserRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*scim.CreateUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("scim", "create_user", "*scim.CreateUserPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewCreateUserRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("scim", "create_user", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateUserResponse returns a decoder for responses returned by the
+// scim create_user endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCreateUserResponse may return the following errors:
+//   - "bad_request" (type *scim.SCIMBadRequest): http.StatusBadRequest
+//   - "conflict" (type *scim.SCIMConflict): http.StatusConflict
+//   - "not_found" (type *scim.SCIMNotFound): http.StatusNotFound
+//   - "unauthorized" (type *scim.SCIMUnauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateUserResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateUserResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "create_user", err)
+			}
+			err = ValidateCreateUserResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "create_user", err)
+			}
+			res := NewCreateUserSCIMUserCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body CreateUserBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "create_user", err)
+			}
+			err = ValidateCreateUserBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "create_user", err)
+			}
+			return nil, NewCreateUserBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body CreateUserConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "create_user", err)
+			}
+			err = ValidateCreateUserConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "create_user", err)
+			}
+			return nil, NewCreateUserConflict(&body)
+		case http.StatusNotFound:
+			var (
+				body CreateUserNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "create_user", err)
+			}
+			err = ValidateCreateUserNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "create_user", err)
+			}
+			return nil, NewCreateUserNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body CreateUserUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "create_user", err)
+			}
+			err = ValidateCreateUserUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "create_user", err)
+			}
+			return nil, NewCreateUserUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("scim", "create_user", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetUserRequest instantiates a HTTP request object with method and path
+// set to call the "scim" service "get_user" endpoint
+func (c *Client) BuildGetUserRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*scim.GetUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("scim", "get_user", "*scim.GetUserPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetUserScimPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("scim", "get_user", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetUserRequest returns an encoder for requests sent to the scim
+// get_user server.
+func EncodeGetUserRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*scim.GetUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("scim", "get_user", "*scim.GetUserPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeGetUserResponse returns a decoder for responses returned by the scim
+// get_user endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+// DecodeGetUserResponse may return the following errors:
+//   - "bad_request" (type *scim.SCIMBadRequest): http.StatusBadRequest
+//   - "conflict" (type *scim.SCIMConflict): http.StatusConflict
+//   - "not_found" (type *scim.SCIMNotFound): http.StatusNotFound
+//   - "unauthorized" (type *scim.SCIMUnauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetUserResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetUserResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "get_user", err)
+			}
+			err = ValidateGetUserResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "get_user", err)
+			}
+			res := NewGetUserSCIMUserOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body GetUserBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "get_user", err)
+			}
+			err = ValidateGetUserBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "get_user", err)
+			}
+			return nil, NewGetUserBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body GetUserConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "get_user", err)
+			}
+			err = ValidateGetUserConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "get_user", err)
+			}
+			return nil, NewGetUserConflict(&body)
+		case http.StatusNotFound:
+			var (
+				body GetUserNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "get_user", err)
+			}
+			err = ValidateGetUserNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "get_user", err)
+			}
+			return nil, NewGetUserNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body GetUserUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "get_user", err)
+			}
+			err = ValidateGetUserUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "get_user", err)
+			}
+			return nil, NewGetUserUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("scim", "get_user", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListUsersRequest instantiates a HTTP request object with method and
+// path set to call the "scim" service "list_users" endpoint
+func (c *Client) BuildListUsersRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListUsersScimPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("scim", "list_users", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListUsersRequest returns an encoder for requests sent to the scim
+// list_users server.
+func EncodeListUsersRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*scim.ListUsersPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("scim", "list_users", "*scim.ListUsersPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		values := req.URL.Query()
+		if p.Filter != nil {
+			values.Add("filter", *p.Filter)
+		}
+		values.Add("startIndex", fmt.Sprintf("%v", p.StartIndex))
+		values.Add("count", fmt.Sprintf("%v", p.Count))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListUsersResponse returns a decoder for responses returned by the scim
+// list_users endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+// DecodeListUsersResponse may return the following errors:
+//   - "bad_request" (type *scim.SCIMBadRequest): http.StatusBadRequest
+//   - "conflict" (type *scim.SCIMConflict): http.StatusConflict
+//   - "not_found" (type *scim.SCIMNotFound): http.StatusNotFound
+//   - "unauthorized" (type *scim.SCIMUnauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListUsersResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListUsersResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "list_users", err)
+			}
+			err = ValidateListUsersResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "list_users", err)
+			}
+			res := NewListUsersSCIMListResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body ListUsersBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "list_users", err)
+			}
+			err = ValidateListUsersBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "list_users", err)
+			}
+			return nil, NewListUsersBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body ListUsersConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "list_users", err)
+			}
+			err = ValidateListUsersConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "list_users", err)
+			}
+			return nil, NewListUsersConflict(&body)
+		case http.StatusNotFound:
+			var (
+				body ListUsersNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "list_users", err)
+			}
+			err = ValidateListUsersNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "list_users", err)
+			}
+			return nil, NewListUsersNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body ListUsersUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "list_users", err)
+			}
+			err = ValidateListUsersUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "list_users", err)
+			}
+			return nil, NewListUsersUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("scim", "list_users", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildPatchUserRequest instantiates a HTTP request object with method and
+// path set to call the "scim" service "patch_user" endpoint
+func (c *Client) BuildPatchUserRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*scim.PatchUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("scim", "patch_user", "*scim.PatchUserPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: PatchUserScimPath(id)}
+	req, err := http.NewRequest("PATCH", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("scim", "patch_user", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodePatchUserRequest returns an encoder for requests sent to the scim
+// patch_user server.
+func EncodePatchUserRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*scim.PatchUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("scim", "patch_user", "*scim.PatchUserPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		body := NewPatchUserRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("scim", "patch_user", err)
+		}
+		return nil
+	}
+}
+
+// DecodePatchUserResponse returns a decoder for responses returned by the scim
+// patch_user endpoint. restoreBody controls whether the response body should
+// be restored after having been read.
+// DecodePatchUserResponse may return the following errors:
+//   - "bad_request" (type *scim.SCIMBadRequest): http.StatusBadRequest
+//   - "conflict" (type *scim.SCIMConflict): http.StatusConflict
+//   - "not_found" (type *scim.SCIMNotFound): http.StatusNotFound
+//   - "unauthorized" (type *scim.SCIMUnauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodePatchUserResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body PatchUserResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "patch_user", err)
+			}
+			err = ValidatePatchUserResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "patch_user", err)
+			}
+			res := NewPatchUserSCIMUserOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body PatchUserBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "patch_user", err)
+			}
+			err = ValidatePatchUserBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "patch_user", err)
+			}
+			return nil, NewPatchUserBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body PatchUserConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "patch_user", err)
+			}
+			err = ValidatePatchUserConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "patch_user", err)
+			}
+			return nil, NewPatchUserConflict(&body)
+		case http.StatusNotFound:
+			var (
+				body PatchUserNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "patch_user", err)
+			}
+			err = ValidatePatchUserNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "patch_user", err)
+			}
+			return nil, NewPatchUserNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body PatchUserUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "patch_user", err)
+			}
+			err = ValidatePatchUserUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "patch_user", err)
+			}
+			return nil, NewPatchUserUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("scim", "patch_user", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteUserRequest instantiates a HTTP request object with method and
+// path set to call the "scim" service "delete_user" endpoint
+func (c *Client) BuildDeleteUserRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*scim.DeleteUserPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("scim", "delete_user", "*scim.DeleteUserPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteUserScimPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("scim", "delete_user", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteUserRequest returns an encoder for requests sent to the scim
+// delete_user server.
+func EncodeDeleteUserRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*scim.DeleteUserPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("scim", "delete_user", "*scim.DeleteUserPayload", v)
+		}
+		{
+			head := p.Token
+			req.Header.Set("Authorization", head)
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteUserResponse returns a decoder for responses returned by the
+// scim delete_user endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteUserResponse may return the following errors:
+//   - "bad_request" (type *scim.SCIMBadRequest): http.StatusBadRequest
+//   - "conflict" (type *scim.SCIMConflict): http.StatusConflict
+//   - "not_found" (type *scim.SCIMNotFound): http.StatusNotFound
+//   - "unauthorized" (type *scim.SCIMUnauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteUserResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		case http.StatusBadRequest:
+			var (
+				body DeleteUserBadRequestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "delete_user", err)
+			}
+			err = ValidateDeleteUserBadRequestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "delete_user", err)
+			}
+			return nil, NewDeleteUserBadRequest(&body)
+		case http.StatusConflict:
+			var (
+				body DeleteUserConflictResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "delete_user", err)
+			}
+			err = ValidateDeleteUserConflictResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "delete_user", err)
+			}
+			return nil, NewDeleteUserConflict(&body)
+		case http.StatusNotFound:
+			var (
+				body DeleteUserNotFoundResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "delete_user", err)
+			}
+			err = ValidateDeleteUserNotFoundResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "delete_user", err)
+			}
+			return nil, NewDeleteUserNotFound(&body)
+		case http.StatusUnauthorized:
+			var (
+				body DeleteUserUnauthorizedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("scim", "delete_user", err)
+			}
+			err = ValidateDeleteUserUnauthorizedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("scim", "delete_user", err)
+			}
+			return nil, NewDeleteUserUnauthorized(&body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("scim", "delete_user", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// marshalScimSCIMNameToSCIMNameRequestBody builds a value of type
+// *SCIMNameRequestBody from a value of type *scim.SCIMName.
+func marshalScimSCIMNameToSCIMNameRequestBody(v *scim.SCIMName) *SCIMNameRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &SCIMNameRequestBody{
+		Formatted:  v.Formatted,
+		GivenName:  v.GivenName,
+		FamilyName: v.FamilyName,
+	}
+
+	return res
+}
+
+// marshalScimSCIMEmailToSCIMEmailRequestBody builds a value of type
+// *SCIMEmailRequestBody from a value of type *scim.SCIMEmail.
+func marshalScimSCIMEmailToSCIMEmailRequestBody(v *scim.SCIMEmail) *SCIMEmailRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &SCIMEmailRequestBody{
+		Value:   v.Value,
+		Type:    v.Type,
+		Primary: v.Primary,
+	}
+
+	return res
+}
+
+// marshalSCIMNameRequestBodyToScimSCIMName builds a value of type
+// *scim.SCIMName from a value of type *SCIMNameRequestBody.
+func marshalSCIMNameRequestBodyToScimSCIMName(v *SCIMNameRequestBody) *scim.SCIMName {
+	if v == nil {
+		return nil
+	}
+	res := &scim.SCIMName{
+		Formatted:  v.Formatted,
+		GivenName:  v.GivenName,
+		FamilyName: v.FamilyName,
+	}
+
+	return res
+}
+
+// marshalSCIMEmailRequestBodyToScimSCIMEmail builds a value of type
+// *scim.SCIMEmail from a value of type *SCIMEmailRequestBody.
+func marshalSCIMEmailRequestBodyToScimSCIMEmail(v *SCIMEmailRequestBody) *scim.SCIMEmail {
+	if v == nil {
+		return nil
+	}
+	res := &scim.SCIMEmail{
+		Value:   v.Value,
+		Type:    v.Type,
+		Primary: v.Primary,
+	}
+
+	return res
+}
+
+// unmarshalSCIMNameResponseBodyToScimSCIMName builds a value of type
+// *scim.SCIMName from a value of type *SCIMNameResponseBody.
+func unmarshalSCIMNameResponseBodyToScimSCIMName(v *SCIMNameResponseBody) *scim.SCIMName {
+	if v == nil {
+		return nil
+	}
+	res := &scim.SCIMName{
+		Formatted:  v.Formatted,
+		GivenName:  v.GivenName,
+		FamilyName: v.FamilyName,
+	}
+
+	return res
+}
+
+// unmarshalSCIMEmailResponseBodyToScimSCIMEmail builds a value of type
+// *scim.SCIMEmail from a value of type *SCIMEmailResponseBody.
+func unmarshalSCIMEmailResponseBodyToScimSCIMEmail(v *SCIMEmailResponseBody) *scim.SCIMEmail {
+	if v == nil {
+		return nil
+	}
+	res := &scim.SCIMEmail{
+		Value:   *v.Value,
+		Type:    v.Type,
+		Primary: v.Primary,
+	}
+
+	return res
+}
+
+// unmarshalSCIMMetaResponseBodyToScimSCIMMeta builds a value of type
+// *scim.SCIMMeta from a value of type *SCIMMetaResponseBody.
+func unmarshalSCIMMetaResponseBodyToScimSCIMMeta(v *SCIMMetaResponseBody) *scim.SCIMMeta {
+	res := &scim.SCIMMeta{
+		ResourceType: *v.ResourceType,
+		Created:      *v.Created,
+		LastModified: *v.LastModified,
+		Location:     *v.Location,
+	}
+
+	return res
+}
+
+// unmarshalSCIMUserResponseBodyToScimSCIMUser builds a value of type
+// *scim.SCIMUser from a value of type *SCIMUserResponseBody.
+func unmarshalSCIMUserResponseBodyToScimSCIMUser(v *SCIMUserResponseBody) *scim.SCIMUser {
+	res := &scim.SCIMUser{
+		ID:          *v.ID,
+		ExternalID:  v.ExternalID,
+		UserName:    *v.UserName,
+		DisplayName: v.DisplayName,
+		Active:      *v.Active,
+	}
+	res.Schemas = make([]string, len(v.Schemas))
+	for i, val := range v.Schemas {
+		res.Schemas[i] = val
+	}
+	if v.Name != nil {
+		res.Name = unmarshalSCIMNameResponseBodyToScimSCIMName(v.Name)
+	}
+	if v.Emails != nil {
+		res.Emails = make([]*scim.SCIMEmail, len(v.Emails))
+		for i, val := range v.Emails {
+			if val == nil {
+				res.Emails[i] = nil
+				continue
+			}
+			res.Emails[i] = unmarshalSCIMEmailResponseBodyToScimSCIMEmail(val)
+		}
+	}
+	res.Meta = unmarshalSCIMMetaResponseBodyToScimSCIMMeta(v.Meta)
+
+	return res
+}
+
+// marshalScimSCIMPatchOperationToSCIMPatchOperationRequestBody builds a value
+// of type *SCIMPatchOperationRequestBody from a value of type
+// *scim.SCIMPatchOperation.
+func marshalScimSCIMPatchOperationToSCIMPatchOperationRequestBody(v *scim.SCIMPatchOperation) *SCIMPatchOperationRequestBody {
+	res := &SCIMPatchOperationRequestBody{
+		Op:    v.Op,
+		Path:  v.Path,
+		Value: v.Value,
+	}
+
+	return res
+}
+
+// marshalSCIMPatchOperationRequestBodyToScimSCIMPatchOperation builds a value
+// of type *scim.SCIMPatchOperation from a value of type
+// *SCIMPatchOperationRequestBody.
+func marshalSCIMPatchOperationRequestBodyToScimSCIMPatchOperation(v *SCIMPatchOperationRequestBody) *scim.SCIMPatchOperation {
+	res := &scim.SCIMPatchOperation{
+		Op:    v.Op,
+		Path:  v.Path,
+		Value: v.Value,
+	}
+
+	return res
+}
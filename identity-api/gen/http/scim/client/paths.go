@@ -0,0 +1,37 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// HTTP request path constructors for the scim service.
+//
+// Command:
+// $ goa gen github.com/vidwadeseram/go-boilerplate/identity-api/design
+
+package client
+
+import (
+	"fmt"
+)
+
+// CreateUserScimPath returns the URL path to the scim service create_user HTTP endpoint.
+func CreateUserScimPath() string {
+	return "/scim/v2/Users"
+}
+
+// GetUserScimPath returns the URL path to the scim service get_user HTTP endpoint.
+func GetUserScimPath(id string) string {
+	return fmt.Sprintf("/scim/v2/Users/%v", id)
+}
+
+// ListUsersScimPath returns the URL path to the scim service list_users HTTP endpoint.
+func ListUsersScimPath() string {
+	return "/scim/v2/Users"
+}
+
+// PatchUserScimPath returns the URL path to the scim service patch_user HTTP endpoint.
+func PatchUserScimPath(id string) string {
+	return fmt.Sprintf("/scim/v2/Users/%v", id)
+}
+
+// DeleteUserScimPath returns the URL path to the scim service delete_user HTTP endpoint.
+func DeleteUserScimPath(id string) string {
+	return fmt.Sprintf("/scim/v2/Users/%v", id)
+}
@@ -0,0 +1,1480 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// scim HTTP client types
+//
+// Command:
+// $ goa gen github.com/vidwadeseram/go-boilerplate/identity-api/design
+
+package client
+
+import (
+	scim "github.com/vidwadeseram/go-boilerplate/identity-api/gen/scim"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateUserRequestBody is the type of the "scim" service "create_user"
+// endpoint HTTP request body.
+type CreateUserRequestBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName string `form:"userName" json:"userName" xml:"userName"`
+	// Components of the user's name
+	Name *SCIMNameRequestBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses
+	Emails []*SCIMEmailRequestBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Initial password; omit for accounts that sign in without one
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+}
+
+// PatchUserRequestBody is the type of the "scim" service "patch_user" endpoint
+// HTTP request body.
+type PatchUserRequestBody struct {
+	// Schemas the request conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Operations to apply in order
+	Operations []*SCIMPatchOperationRequestBody `form:"Operations" json:"Operations" xml:"Operations"`
+}
+
+// CreateUserResponseBody is the type of the "scim" service "create_user"
+// endpoint HTTP response body.
+type CreateUserResponseBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Resource identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName *string `form:"userName,omitempty" json:"userName,omitempty" xml:"userName,omitempty"`
+	// Components of the user's name
+	Name *SCIMNameResponseBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses; the first is the user name
+	Emails []*SCIMEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Resource metadata
+	Meta *SCIMMetaResponseBody `form:"meta,omitempty" json:"meta,omitempty" xml:"meta,omitempty"`
+}
+
+// GetUserResponseBody is the type of the "scim" service "get_user" endpoint
+// HTTP response body.
+type GetUserResponseBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Resource identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName *string `form:"userName,omitempty" json:"userName,omitempty" xml:"userName,omitempty"`
+	// Components of the user's name
+	Name *SCIMNameResponseBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses; the first is the user name
+	Emails []*SCIMEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Resource metadata
+	Meta *SCIMMetaResponseBody `form:"meta,omitempty" json:"meta,omitempty" xml:"meta,omitempty"`
+}
+
+// ListUsersResponseBody is the type of the "scim" service "list_users"
+// endpoint HTTP response body.
+type ListUsersResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Number of resources matching the query
+	TotalResults *int `form:"totalResults,omitempty" json:"totalResults,omitempty" xml:"totalResults,omitempty"`
+	// 1-based index of the first returned resource
+	StartIndex *int `form:"startIndex,omitempty" json:"startIndex,omitempty" xml:"startIndex,omitempty"`
+	// Number of resources returned
+	ItemsPerPage *int `form:"itemsPerPage,omitempty" json:"itemsPerPage,omitempty" xml:"itemsPerPage,omitempty"`
+	// Returned resources
+	Resources []*SCIMUserResponseBody `form:"Resources,omitempty" json:"Resources,omitempty" xml:"Resources,omitempty"`
+}
+
+// PatchUserResponseBody is the type of the "scim" service "patch_user"
+// endpoint HTTP response body.
+type PatchUserResponseBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Resource identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName *string `form:"userName,omitempty" json:"userName,omitempty" xml:"userName,omitempty"`
+	// Components of the user's name
+	Name *SCIMNameResponseBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses; the first is the user name
+	Emails []*SCIMEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Resource metadata
+	Meta *SCIMMetaResponseBody `form:"meta,omitempty" json:"meta,omitempty" xml:"meta,omitempty"`
+}
+
+// CreateUserBadRequestResponseBody is the type of the "scim" service
+// "create_user" endpoint HTTP response body for the "bad_request" error.
+type CreateUserBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// CreateUserConflictResponseBody is the type of the "scim" service
+// "create_user" endpoint HTTP response body for the "conflict" error.
+type CreateUserConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// CreateUserNotFoundResponseBody is the type of the "scim" service
+// "create_user" endpoint HTTP response body for the "not_found" error.
+type CreateUserNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// CreateUserUnauthorizedResponseBody is the type of the "scim" service
+// "create_user" endpoint HTTP response body for the "unauthorized" error.
+type CreateUserUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// GetUserBadRequestResponseBody is the type of the "scim" service "get_user"
+// endpoint HTTP response body for the "bad_request" error.
+type GetUserBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// GetUserConflictResponseBody is the type of the "scim" service "get_user"
+// endpoint HTTP response body for the "conflict" error.
+type GetUserConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// GetUserNotFoundResponseBody is the type of the "scim" service "get_user"
+// endpoint HTTP response body for the "not_found" error.
+type GetUserNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// GetUserUnauthorizedResponseBody is the type of the "scim" service "get_user"
+// endpoint HTTP response body for the "unauthorized" error.
+type GetUserUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// ListUsersBadRequestResponseBody is the type of the "scim" service
+// "list_users" endpoint HTTP response body for the "bad_request" error.
+type ListUsersBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// ListUsersConflictResponseBody is the type of the "scim" service "list_users"
+// endpoint HTTP response body for the "conflict" error.
+type ListUsersConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// ListUsersNotFoundResponseBody is the type of the "scim" service "list_users"
+// endpoint HTTP response body for the "not_found" error.
+type ListUsersNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// ListUsersUnauthorizedResponseBody is the type of the "scim" service
+// "list_users" endpoint HTTP response body for the "unauthorized" error.
+type ListUsersUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// PatchUserBadRequestResponseBody is the type of the "scim" service
+// "patch_user" endpoint HTTP response body for the "bad_request" error.
+type PatchUserBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// PatchUserConflictResponseBody is the type of the "scim" service "patch_user"
+// endpoint HTTP response body for the "conflict" error.
+type PatchUserConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// PatchUserNotFoundResponseBody is the type of the "scim" service "patch_user"
+// endpoint HTTP response body for the "not_found" error.
+type PatchUserNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// PatchUserUnauthorizedResponseBody is the type of the "scim" service
+// "patch_user" endpoint HTTP response body for the "unauthorized" error.
+type PatchUserUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// DeleteUserBadRequestResponseBody is the type of the "scim" service
+// "delete_user" endpoint HTTP response body for the "bad_request" error.
+type DeleteUserBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// DeleteUserConflictResponseBody is the type of the "scim" service
+// "delete_user" endpoint HTTP response body for the "conflict" error.
+type DeleteUserConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// DeleteUserNotFoundResponseBody is the type of the "scim" service
+// "delete_user" endpoint HTTP response body for the "not_found" error.
+type DeleteUserNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// DeleteUserUnauthorizedResponseBody is the type of the "scim" service
+// "delete_user" endpoint HTTP response body for the "unauthorized" error.
+type DeleteUserUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// HTTP status code
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail *string `form:"detail,omitempty" json:"detail,omitempty" xml:"detail,omitempty"`
+}
+
+// SCIMNameRequestBody is used to define fields on request body types.
+type SCIMNameRequestBody struct {
+	// Full name, mapped to the display name
+	Formatted *string `form:"formatted,omitempty" json:"formatted,omitempty" xml:"formatted,omitempty"`
+	// Given name
+	GivenName *string `form:"givenName,omitempty" json:"givenName,omitempty" xml:"givenName,omitempty"`
+	// Family name
+	FamilyName *string `form:"familyName,omitempty" json:"familyName,omitempty" xml:"familyName,omitempty"`
+}
+
+// SCIMEmailRequestBody is used to define fields on request body types.
+type SCIMEmailRequestBody struct {
+	// Email address
+	Value string `form:"value" json:"value" xml:"value"`
+	// Email type, e.g. work
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Whether this is the primary address
+	Primary *bool `form:"primary,omitempty" json:"primary,omitempty" xml:"primary,omitempty"`
+}
+
+// SCIMNameResponseBody is used to define fields on response body types.
+type SCIMNameResponseBody struct {
+	// Full name, mapped to the display name
+	Formatted *string `form:"formatted,omitempty" json:"formatted,omitempty" xml:"formatted,omitempty"`
+	// Given name
+	GivenName *string `form:"givenName,omitempty" json:"givenName,omitempty" xml:"givenName,omitempty"`
+	// Family name
+	FamilyName *string `form:"familyName,omitempty" json:"familyName,omitempty" xml:"familyName,omitempty"`
+}
+
+// SCIMEmailResponseBody is used to define fields on response body types.
+type SCIMEmailResponseBody struct {
+	// Email address
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Email type, e.g. work
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Whether this is the primary address
+	Primary *bool `form:"primary,omitempty" json:"primary,omitempty" xml:"primary,omitempty"`
+}
+
+// SCIMMetaResponseBody is used to define fields on response body types.
+type SCIMMetaResponseBody struct {
+	// Resource type
+	ResourceType *string `form:"resourceType,omitempty" json:"resourceType,omitempty" xml:"resourceType,omitempty"`
+	// Creation timestamp
+	Created *string `form:"created,omitempty" json:"created,omitempty" xml:"created,omitempty"`
+	// Last modification timestamp
+	LastModified *string `form:"lastModified,omitempty" json:"lastModified,omitempty" xml:"lastModified,omitempty"`
+	// URI of the resource
+	Location *string `form:"location,omitempty" json:"location,omitempty" xml:"location,omitempty"`
+}
+
+// SCIMUserResponseBody is used to define fields on response body types.
+type SCIMUserResponseBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Resource identifier
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName *string `form:"userName,omitempty" json:"userName,omitempty" xml:"userName,omitempty"`
+	// Components of the user's name
+	Name *SCIMNameResponseBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses; the first is the user name
+	Emails []*SCIMEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Resource metadata
+	Meta *SCIMMetaResponseBody `form:"meta,omitempty" json:"meta,omitempty" xml:"meta,omitempty"`
+}
+
+// SCIMPatchOperationRequestBody is used to define fields on request body types.
+type SCIMPatchOperationRequestBody struct {
+	// Operation: add, replace or remove (case-insensitive)
+	Op string `form:"op" json:"op" xml:"op"`
+	// Attribute path; when omitted value must be an object of attributes
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// New value
+	Value any `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// NewCreateUserRequestBody builds the HTTP request body from the payload of
+// the "create_user" endpoint of the "scim" service.
+func NewCreateUserRequestBody(p *scim.CreateUserPayload) *CreateUserRequestBody {
+	body := &CreateUserRequestBody{
+		ExternalID:  p.ExternalID,
+		UserName:    p.UserName,
+		DisplayName: p.DisplayName,
+		Active:      p.Active,
+		Password:    p.Password,
+	}
+	if p.Schemas != nil {
+		body.Schemas = make([]string, len(p.Schemas))
+		for i, val := range p.Schemas {
+			body.Schemas[i] = val
+		}
+	}
+	if p.Name != nil {
+		body.Name = marshalScimSCIMNameToSCIMNameRequestBody(p.Name)
+	}
+	if p.Emails != nil {
+		body.Emails = make([]*SCIMEmailRequestBody, len(p.Emails))
+		for i, val := range p.Emails {
+			if val == nil {
+				body.Emails[i] = nil
+				continue
+			}
+			body.Emails[i] = marshalScimSCIMEmailToSCIMEmailRequestBody(val)
+		}
+	}
+	{
+		var zero bool
+		if body.Active == zero {
+			body.Active = true
+		}
+	}
+	return body
+}
+
+// NewPatchUserRequestBody builds the HTTP request body from the payload of the
+// "patch_user" endpoint of the "scim" service.
+func NewPatchUserRequestBody(p *scim.PatchUserPayload) *PatchUserRequestBody {
+	body := &PatchUserRequestBody{}
+	if p.Schemas != nil {
+		body.Schemas = make([]string, len(p.Schemas))
+		for i, val := range p.Schemas {
+			body.Schemas[i] = val
+		}
+	}
+	if p.Operations != nil {
+		body.Operations = make([]*SCIMPatchOperationRequestBody, len(p.Operations))
+		for i, val := range p.Operations {
+			if val == nil {
+				body.Operations[i] = nil
+				continue
+			}
+			body.Operations[i] = marshalScimSCIMPatchOperationToSCIMPatchOperationRequestBody(val)
+		}
+	} else {
+		body.Operations = []*SCIMPatchOperationRequestBody{}
+	}
+	return body
+}
+
+// NewCreateUserSCIMUserCreated builds a "scim" service "create_user" endpoint
+// result from a HTTP "Created" response.
+func NewCreateUserSCIMUserCreated(body *CreateUserResponseBody) *scim.SCIMUser {
+	v := &scim.SCIMUser{
+		ID:          *body.ID,
+		ExternalID:  body.ExternalID,
+		UserName:    *body.UserName,
+		DisplayName: body.DisplayName,
+		Active:      *body.Active,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+	if body.Name != nil {
+		v.Name = unmarshalSCIMNameResponseBodyToScimSCIMName(body.Name)
+	}
+	if body.Emails != nil {
+		v.Emails = make([]*scim.SCIMEmail, len(body.Emails))
+		for i, val := range body.Emails {
+			if val == nil {
+				v.Emails[i] = nil
+				continue
+			}
+			v.Emails[i] = unmarshalSCIMEmailResponseBodyToScimSCIMEmail(val)
+		}
+	}
+	v.Meta = unmarshalSCIMMetaResponseBodyToScimSCIMMeta(body.Meta)
+
+	return v
+}
+
+// NewCreateUserBadRequest builds a scim service create_user endpoint
+// bad_request error.
+func NewCreateUserBadRequest(body *CreateUserBadRequestResponseBody) *scim.SCIMBadRequest {
+	v := &scim.SCIMBadRequest{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewCreateUserConflict builds a scim service create_user endpoint conflict
+// error.
+func NewCreateUserConflict(body *CreateUserConflictResponseBody) *scim.SCIMConflict {
+	v := &scim.SCIMConflict{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewCreateUserNotFound builds a scim service create_user endpoint not_found
+// error.
+func NewCreateUserNotFound(body *CreateUserNotFoundResponseBody) *scim.SCIMNotFound {
+	v := &scim.SCIMNotFound{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewCreateUserUnauthorized builds a scim service create_user endpoint
+// unauthorized error.
+func NewCreateUserUnauthorized(body *CreateUserUnauthorizedResponseBody) *scim.SCIMUnauthorized {
+	v := &scim.SCIMUnauthorized{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewGetUserSCIMUserOK builds a "scim" service "get_user" endpoint result from
+// a HTTP "OK" response.
+func NewGetUserSCIMUserOK(body *GetUserResponseBody) *scim.SCIMUser {
+	v := &scim.SCIMUser{
+		ID:          *body.ID,
+		ExternalID:  body.ExternalID,
+		UserName:    *body.UserName,
+		DisplayName: body.DisplayName,
+		Active:      *body.Active,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+	if body.Name != nil {
+		v.Name = unmarshalSCIMNameResponseBodyToScimSCIMName(body.Name)
+	}
+	if body.Emails != nil {
+		v.Emails = make([]*scim.SCIMEmail, len(body.Emails))
+		for i, val := range body.Emails {
+			if val == nil {
+				v.Emails[i] = nil
+				continue
+			}
+			v.Emails[i] = unmarshalSCIMEmailResponseBodyToScimSCIMEmail(val)
+		}
+	}
+	v.Meta = unmarshalSCIMMetaResponseBodyToScimSCIMMeta(body.Meta)
+
+	return v
+}
+
+// NewGetUserBadRequest builds a scim service get_user endpoint bad_request
+// error.
+func NewGetUserBadRequest(body *GetUserBadRequestResponseBody) *scim.SCIMBadRequest {
+	v := &scim.SCIMBadRequest{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewGetUserConflict builds a scim service get_user endpoint conflict error.
+func NewGetUserConflict(body *GetUserConflictResponseBody) *scim.SCIMConflict {
+	v := &scim.SCIMConflict{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewGetUserNotFound builds a scim service get_user endpoint not_found error.
+func NewGetUserNotFound(body *GetUserNotFoundResponseBody) *scim.SCIMNotFound {
+	v := &scim.SCIMNotFound{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewGetUserUnauthorized builds a scim service get_user endpoint unauthorized
+// error.
+func NewGetUserUnauthorized(body *GetUserUnauthorizedResponseBody) *scim.SCIMUnauthorized {
+	v := &scim.SCIMUnauthorized{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewListUsersSCIMListResponseOK builds a "scim" service "list_users" endpoint
+// result from a HTTP "OK" response.
+func NewListUsersSCIMListResponseOK(body *ListUsersResponseBody) *scim.SCIMListResponse {
+	v := &scim.SCIMListResponse{
+		TotalResults: *body.TotalResults,
+		StartIndex:   *body.StartIndex,
+		ItemsPerPage: *body.ItemsPerPage,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+	v.Resources = make([]*scim.SCIMUser, len(body.Resources))
+	for i, val := range body.Resources {
+		if val == nil {
+			v.Resources[i] = nil
+			continue
+		}
+		v.Resources[i] = unmarshalSCIMUserResponseBodyToScimSCIMUser(val)
+	}
+
+	return v
+}
+
+// NewListUsersBadRequest builds a scim service list_users endpoint bad_request
+// error.
+func NewListUsersBadRequest(body *ListUsersBadRequestResponseBody) *scim.SCIMBadRequest {
+	v := &scim.SCIMBadRequest{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewListUsersConflict builds a scim service list_users endpoint conflict
+// error.
+func NewListUsersConflict(body *ListUsersConflictResponseBody) *scim.SCIMConflict {
+	v := &scim.SCIMConflict{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewListUsersNotFound builds a scim service list_users endpoint not_found
+// error.
+func NewListUsersNotFound(body *ListUsersNotFoundResponseBody) *scim.SCIMNotFound {
+	v := &scim.SCIMNotFound{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewListUsersUnauthorized builds a scim service list_users endpoint
+// unauthorized error.
+func NewListUsersUnauthorized(body *ListUsersUnauthorizedResponseBody) *scim.SCIMUnauthorized {
+	v := &scim.SCIMUnauthorized{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewPatchUserSCIMUserOK builds a "scim" service "patch_user" endpoint result
+// from a HTTP "OK" response.
+func NewPatchUserSCIMUserOK(body *PatchUserResponseBody) *scim.SCIMUser {
+	v := &scim.SCIMUser{
+		ID:          *body.ID,
+		ExternalID:  body.ExternalID,
+		UserName:    *body.UserName,
+		DisplayName: body.DisplayName,
+		Active:      *body.Active,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+	if body.Name != nil {
+		v.Name = unmarshalSCIMNameResponseBodyToScimSCIMName(body.Name)
+	}
+	if body.Emails != nil {
+		v.Emails = make([]*scim.SCIMEmail, len(body.Emails))
+		for i, val := range body.Emails {
+			if val == nil {
+				v.Emails[i] = nil
+				continue
+			}
+			v.Emails[i] = unmarshalSCIMEmailResponseBodyToScimSCIMEmail(val)
+		}
+	}
+	v.Meta = unmarshalSCIMMetaResponseBodyToScimSCIMMeta(body.Meta)
+
+	return v
+}
+
+// NewPatchUserBadRequest builds a scim service patch_user endpoint bad_request
+// error.
+func NewPatchUserBadRequest(body *PatchUserBadRequestResponseBody) *scim.SCIMBadRequest {
+	v := &scim.SCIMBadRequest{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewPatchUserConflict builds a scim service patch_user endpoint conflict
+// error.
+func NewPatchUserConflict(body *PatchUserConflictResponseBody) *scim.SCIMConflict {
+	v := &scim.SCIMConflict{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewPatchUserNotFound builds a scim service patch_user endpoint not_found
+// error.
+func NewPatchUserNotFound(body *PatchUserNotFoundResponseBody) *scim.SCIMNotFound {
+	v := &scim.SCIMNotFound{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewPatchUserUnauthorized builds a scim service patch_user endpoint
+// unauthorized error.
+func NewPatchUserUnauthorized(body *PatchUserUnauthorizedResponseBody) *scim.SCIMUnauthorized {
+	v := &scim.SCIMUnauthorized{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewDeleteUserBadRequest builds a scim service delete_user endpoint
+// bad_request error.
+func NewDeleteUserBadRequest(body *DeleteUserBadRequestResponseBody) *scim.SCIMBadRequest {
+	v := &scim.SCIMBadRequest{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewDeleteUserConflict builds a scim service delete_user endpoint conflict
+// error.
+func NewDeleteUserConflict(body *DeleteUserConflictResponseBody) *scim.SCIMConflict {
+	v := &scim.SCIMConflict{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewDeleteUserNotFound builds a scim service delete_user endpoint not_found
+// error.
+func NewDeleteUserNotFound(body *DeleteUserNotFoundResponseBody) *scim.SCIMNotFound {
+	v := &scim.SCIMNotFound{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// NewDeleteUserUnauthorized builds a scim service delete_user endpoint
+// unauthorized error.
+func NewDeleteUserUnauthorized(body *DeleteUserUnauthorizedResponseBody) *scim.SCIMUnauthorized {
+	v := &scim.SCIMUnauthorized{
+		Status:   *body.Status,
+		ScimType: body.ScimType,
+		Detail:   *body.Detail,
+	}
+	v.Schemas = make([]string, len(body.Schemas))
+	for i, val := range body.Schemas {
+		v.Schemas[i] = val
+	}
+
+	return v
+}
+
+// ValidateCreateUserResponseBody runs the validations defined on
+// create_user_response_body
+func ValidateCreateUserResponseBody(body *CreateUserResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userName", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.Meta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meta", "body"))
+	}
+	for _, e := range body.Emails {
+		if e != nil {
+			if err2 := ValidateSCIMEmailResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Meta != nil {
+		if err2 := ValidateSCIMMetaResponseBody(body.Meta); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetUserResponseBody runs the validations defined on
+// get_user_response_body
+func ValidateGetUserResponseBody(body *GetUserResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userName", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.Meta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meta", "body"))
+	}
+	for _, e := range body.Emails {
+		if e != nil {
+			if err2 := ValidateSCIMEmailResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Meta != nil {
+		if err2 := ValidateSCIMMetaResponseBody(body.Meta); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateListUsersResponseBody runs the validations defined on
+// list_users_response_body
+func ValidateListUsersResponseBody(body *ListUsersResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.TotalResults == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("totalResults", "body"))
+	}
+	if body.StartIndex == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("startIndex", "body"))
+	}
+	if body.ItemsPerPage == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("itemsPerPage", "body"))
+	}
+	if body.Resources == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("Resources", "body"))
+	}
+	for _, e := range body.Resources {
+		if e != nil {
+			if err2 := ValidateSCIMUserResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePatchUserResponseBody runs the validations defined on
+// patch_user_response_body
+func ValidatePatchUserResponseBody(body *PatchUserResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userName", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.Meta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meta", "body"))
+	}
+	for _, e := range body.Emails {
+		if e != nil {
+			if err2 := ValidateSCIMEmailResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Meta != nil {
+		if err2 := ValidateSCIMMetaResponseBody(body.Meta); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateCreateUserBadRequestResponseBody runs the validations defined on
+// create_user_bad_request_response_body
+func ValidateCreateUserBadRequestResponseBody(body *CreateUserBadRequestResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateCreateUserConflictResponseBody runs the validations defined on
+// create_user_conflict_response_body
+func ValidateCreateUserConflictResponseBody(body *CreateUserConflictResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateCreateUserNotFoundResponseBody runs the validations defined on
+// create_user_not_found_response_body
+func ValidateCreateUserNotFoundResponseBody(body *CreateUserNotFoundResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateCreateUserUnauthorizedResponseBody runs the validations defined on
+// create_user_unauthorized_response_body
+func ValidateCreateUserUnauthorizedResponseBody(body *CreateUserUnauthorizedResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateGetUserBadRequestResponseBody runs the validations defined on
+// get_user_bad_request_response_body
+func ValidateGetUserBadRequestResponseBody(body *GetUserBadRequestResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateGetUserConflictResponseBody runs the validations defined on
+// get_user_conflict_response_body
+func ValidateGetUserConflictResponseBody(body *GetUserConflictResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateGetUserNotFoundResponseBody runs the validations defined on
+// get_user_not_found_response_body
+func ValidateGetUserNotFoundResponseBody(body *GetUserNotFoundResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateGetUserUnauthorizedResponseBody runs the validations defined on
+// get_user_unauthorized_response_body
+func ValidateGetUserUnauthorizedResponseBody(body *GetUserUnauthorizedResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateListUsersBadRequestResponseBody runs the validations defined on
+// list_users_bad_request_response_body
+func ValidateListUsersBadRequestResponseBody(body *ListUsersBadRequestResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateListUsersConflictResponseBody runs the validations defined on
+// list_users_conflict_response_body
+func ValidateListUsersConflictResponseBody(body *ListUsersConflictResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateListUsersNotFoundResponseBody runs the validations defined on
+// list_users_not_found_response_body
+func ValidateListUsersNotFoundResponseBody(body *ListUsersNotFoundResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateListUsersUnauthorizedResponseBody runs the validations defined on
+// list_users_unauthorized_response_body
+func ValidateListUsersUnauthorizedResponseBody(body *ListUsersUnauthorizedResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidatePatchUserBadRequestResponseBody runs the validations defined on
+// patch_user_bad_request_response_body
+func ValidatePatchUserBadRequestResponseBody(body *PatchUserBadRequestResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidatePatchUserConflictResponseBody runs the validations defined on
+// patch_user_conflict_response_body
+func ValidatePatchUserConflictResponseBody(body *PatchUserConflictResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidatePatchUserNotFoundResponseBody runs the validations defined on
+// patch_user_not_found_response_body
+func ValidatePatchUserNotFoundResponseBody(body *PatchUserNotFoundResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidatePatchUserUnauthorizedResponseBody runs the validations defined on
+// patch_user_unauthorized_response_body
+func ValidatePatchUserUnauthorizedResponseBody(body *PatchUserUnauthorizedResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateDeleteUserBadRequestResponseBody runs the validations defined on
+// delete_user_bad_request_response_body
+func ValidateDeleteUserBadRequestResponseBody(body *DeleteUserBadRequestResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateDeleteUserConflictResponseBody runs the validations defined on
+// delete_user_conflict_response_body
+func ValidateDeleteUserConflictResponseBody(body *DeleteUserConflictResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateDeleteUserNotFoundResponseBody runs the validations defined on
+// delete_user_not_found_response_body
+func ValidateDeleteUserNotFoundResponseBody(body *DeleteUserNotFoundResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateDeleteUserUnauthorizedResponseBody runs the validations defined on
+// delete_user_unauthorized_response_body
+func ValidateDeleteUserUnauthorizedResponseBody(body *DeleteUserUnauthorizedResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Detail == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("detail", "body"))
+	}
+	return
+}
+
+// ValidateSCIMEmailRequestBody runs the validations defined on
+// SCIMEmailRequestBody
+func ValidateSCIMEmailRequestBody(body *SCIMEmailRequestBody) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("body.value", body.Value, goa.FormatEmail))
+	return
+}
+
+// ValidateSCIMEmailResponseBody runs the validations defined on
+// SCIMEmailResponseBody
+func ValidateSCIMEmailResponseBody(body *SCIMEmailResponseBody) (err error) {
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.Value != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.value", *body.Value, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateSCIMMetaResponseBody runs the validations defined on
+// SCIMMetaResponseBody
+func ValidateSCIMMetaResponseBody(body *SCIMMetaResponseBody) (err error) {
+	if body.ResourceType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("resourceType", "body"))
+	}
+	if body.Created == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created", "body"))
+	}
+	if body.LastModified == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("lastModified", "body"))
+	}
+	if body.Location == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("location", "body"))
+	}
+	if body.ResourceType != nil {
+		if !(*body.ResourceType == "User") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.resourceType", *body.ResourceType, []any{"User"}))
+		}
+	}
+	if body.Created != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.created", *body.Created, goa.FormatDateTime))
+	}
+	if body.LastModified != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.lastModified", *body.LastModified, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateSCIMUserResponseBody runs the validations defined on
+// SCIMUserResponseBody
+func ValidateSCIMUserResponseBody(body *SCIMUserResponseBody) (err error) {
+	if body.Schemas == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("schemas", "body"))
+	}
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.UserName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userName", "body"))
+	}
+	if body.Active == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("active", "body"))
+	}
+	if body.Meta == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("meta", "body"))
+	}
+	for _, e := range body.Emails {
+		if e != nil {
+			if err2 := ValidateSCIMEmailResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Meta != nil {
+		if err2 := ValidateSCIMMetaResponseBody(body.Meta); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
@@ -0,0 +1,787 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// scim HTTP server encoders and decoders
+//
+// Command:
+// $ goa gen github.com/vidwadeseram/go-boilerplate/identity-api/design
+
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	scim "github.com/vidwadeseram/go-boilerplate/identity-api/gen/scim"
+	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// EncodeCreateUserResponse returns an encoder for responses returned by the
+// scim create_user endpoint.
+func EncodeCreateUserResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*scim.SCIMUser)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+		enc := encoder(ctx, w)
+		body := NewCreateUserResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateUserRequest returns a decoder for requests sent to the scim
+// create_user endpoint.
+func DecodeCreateUserRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*scim.CreateUserPayload, error) {
+	return func(r *http.Request) (*scim.CreateUserPayload, error) {
+		var (
+			body CreateUserRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateUserRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			token string
+		)
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateUserPayload(&body, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateUserError returns an encoder for errors returned by the
+// create_user scim endpoint.
+func EncodeCreateUserError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res *scim.SCIMBadRequest
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateUserBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "conflict":
+			var res *scim.SCIMConflict
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateUserConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_found":
+			var res *scim.SCIMNotFound
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateUserNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *scim.SCIMUnauthorized
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateUserUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetUserResponse returns an encoder for responses returned by the scim
+// get_user endpoint.
+func EncodeGetUserResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*scim.SCIMUser)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+		enc := encoder(ctx, w)
+		body := NewGetUserResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetUserRequest returns a decoder for requests sent to the scim
+// get_user endpoint.
+func DecodeGetUserRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*scim.GetUserPayload, error) {
+	return func(r *http.Request) (*scim.GetUserPayload, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetUserPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeGetUserError returns an encoder for errors returned by the get_user
+// scim endpoint.
+func EncodeGetUserError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res *scim.SCIMBadRequest
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetUserBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "conflict":
+			var res *scim.SCIMConflict
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetUserConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_found":
+			var res *scim.SCIMNotFound
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetUserNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *scim.SCIMUnauthorized
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewGetUserUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListUsersResponse returns an encoder for responses returned by the
+// scim list_users endpoint.
+func EncodeListUsersResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*scim.SCIMListResponse)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+		enc := encoder(ctx, w)
+		body := NewListUsersResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListUsersRequest returns a decoder for requests sent to the scim
+// list_users endpoint.
+func DecodeListUsersRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*scim.ListUsersPayload, error) {
+	return func(r *http.Request) (*scim.ListUsersPayload, error) {
+		var (
+			filter     *string
+			startIndex int
+			count      int
+			token      string
+			err        error
+		)
+		qp := r.URL.Query()
+		filterRaw := qp.Get("filter")
+		if filterRaw != "" {
+			filter = &filterRaw
+		}
+		{
+			startIndexRaw := qp.Get("startIndex")
+			if startIndexRaw == "" {
+				startIndex = 1
+			} else {
+				v, err2 := strconv.ParseInt(startIndexRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("startIndex", startIndexRaw, "integer"))
+				}
+				startIndex = int(v)
+			}
+		}
+		if startIndex < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("startIndex", startIndex, 1, true))
+		}
+		{
+			countRaw := qp.Get("count")
+			if countRaw == "" {
+				count = 100
+			} else {
+				v, err2 := strconv.ParseInt(countRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("count", countRaw, "integer"))
+				}
+				count = int(v)
+			}
+		}
+		if count < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("count", count, 0, true))
+		}
+		if count > 200 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("count", count, 200, false))
+		}
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListUsersPayload(filter, startIndex, count, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeListUsersError returns an encoder for errors returned by the
+// list_users scim endpoint.
+func EncodeListUsersError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res *scim.SCIMBadRequest
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "conflict":
+			var res *scim.SCIMConflict
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_found":
+			var res *scim.SCIMNotFound
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *scim.SCIMUnauthorized
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewListUsersUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodePatchUserResponse returns an encoder for responses returned by the
+// scim patch_user endpoint.
+func EncodePatchUserResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*scim.SCIMUser)
+		ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+		enc := encoder(ctx, w)
+		body := NewPatchUserResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodePatchUserRequest returns a decoder for requests sent to the scim
+// patch_user endpoint.
+func DecodePatchUserRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*scim.PatchUserPayload, error) {
+	return func(r *http.Request) (*scim.PatchUserPayload, error) {
+		var (
+			body PatchUserRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidatePatchUserRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id    string
+			token string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewPatchUserPayload(&body, id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodePatchUserError returns an encoder for errors returned by the
+// patch_user scim endpoint.
+func EncodePatchUserError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res *scim.SCIMBadRequest
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchUserBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "conflict":
+			var res *scim.SCIMConflict
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchUserConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_found":
+			var res *scim.SCIMNotFound
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchUserNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *scim.SCIMUnauthorized
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewPatchUserUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteUserResponse returns an encoder for responses returned by the
+// scim delete_user endpoint.
+func EncodeDeleteUserResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteUserRequest returns a decoder for requests sent to the scim
+// delete_user endpoint.
+func DecodeDeleteUserRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (*scim.DeleteUserPayload, error) {
+	return func(r *http.Request) (*scim.DeleteUserPayload, error) {
+		var (
+			id    string
+			token string
+			err   error
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		token = r.Header.Get("Authorization")
+		if token == "" {
+			err = goa.MergeErrors(err, goa.MissingFieldError("token", "header"))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteUserPayload(id, token)
+		if strings.Contains(payload.Token, " ") {
+			// Remove authorization scheme prefix (e.g. "Bearer")
+			cred := strings.SplitN(payload.Token, " ", 2)[1]
+			payload.Token = cred
+		}
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteUserError returns an encoder for errors returned by the
+// delete_user scim endpoint.
+func EncodeDeleteUserError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "bad_request":
+			var res *scim.SCIMBadRequest
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteUserBadRequestResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "conflict":
+			var res *scim.SCIMConflict
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteUserConflictResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "not_found":
+			var res *scim.SCIMNotFound
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteUserNotFoundResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res *scim.SCIMUnauthorized
+			errors.As(v, &res)
+			ctx = context.WithValue(ctx, goahttp.ContentTypeKey, "application/scim+json")
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewDeleteUserUnauthorizedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// unmarshalSCIMNameRequestBodyToScimSCIMName builds a value of type
+// *scim.SCIMName from a value of type *SCIMNameRequestBody.
+func unmarshalSCIMNameRequestBodyToScimSCIMName(v *SCIMNameRequestBody) *scim.SCIMName {
+	if v == nil {
+		return nil
+	}
+	res := &scim.SCIMName{
+		Formatted:  v.Formatted,
+		GivenName:  v.GivenName,
+		FamilyName: v.FamilyName,
+	}
+
+	return res
+}
+
+// unmarshalSCIMEmailRequestBodyToScimSCIMEmail builds a value of type
+// *scim.SCIMEmail from a value of type *SCIMEmailRequestBody.
+func unmarshalSCIMEmailRequestBodyToScimSCIMEmail(v *SCIMEmailRequestBody) *scim.SCIMEmail {
+	if v == nil {
+		return nil
+	}
+	res := &scim.SCIMEmail{
+		Value:   *v.Value,
+		Type:    v.Type,
+		Primary: v.Primary,
+	}
+
+	return res
+}
+
+// marshalScimSCIMNameToSCIMNameResponseBody builds a value of type
+// *SCIMNameResponseBody from a value of type *scim.SCIMName.
+func marshalScimSCIMNameToSCIMNameResponseBody(v *scim.SCIMName) *SCIMNameResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &SCIMNameResponseBody{
+		Formatted:  v.Formatted,
+		GivenName:  v.GivenName,
+		FamilyName: v.FamilyName,
+	}
+
+	return res
+}
+
+// marshalScimSCIMEmailToSCIMEmailResponseBody builds a value of type
+// *SCIMEmailResponseBody from a value of type *scim.SCIMEmail.
+func marshalScimSCIMEmailToSCIMEmailResponseBody(v *scim.SCIMEmail) *SCIMEmailResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &SCIMEmailResponseBody{
+		Value:   v.Value,
+		Type:    v.Type,
+		Primary: v.Primary,
+	}
+
+	return res
+}
+
+// marshalScimSCIMMetaToSCIMMetaResponseBody builds a value of type
+// *SCIMMetaResponseBody from a value of type *scim.SCIMMeta.
+func marshalScimSCIMMetaToSCIMMetaResponseBody(v *scim.SCIMMeta) *SCIMMetaResponseBody {
+	res := &SCIMMetaResponseBody{
+		ResourceType: v.ResourceType,
+		Created:      v.Created,
+		LastModified: v.LastModified,
+		Location:     v.Location,
+	}
+
+	return res
+}
+
+// marshalScimSCIMUserToSCIMUserResponseBody builds a value of type
+// *SCIMUserResponseBody from a value of type *scim.SCIMUser.
+func marshalScimSCIMUserToSCIMUserResponseBody(v *scim.SCIMUser) *SCIMUserResponseBody {
+	res := &SCIMUserResponseBody{
+		ID:          v.ID,
+		ExternalID:  v.ExternalID,
+		UserName:    v.UserName,
+		DisplayName: v.DisplayName,
+		Active:      v.Active,
+	}
+	if v.Schemas != nil {
+		res.Schemas = make([]string, len(v.Schemas))
+		for i, val := range v.Schemas {
+			res.Schemas[i] = val
+		}
+	} else {
+		res.Schemas = []string{}
+	}
+	if v.Name != nil {
+		res.Name = marshalScimSCIMNameToSCIMNameResponseBody(v.Name)
+	}
+	if v.Emails != nil {
+		res.Emails = make([]*SCIMEmailResponseBody, len(v.Emails))
+		for i, val := range v.Emails {
+			if val == nil {
+				res.Emails[i] = nil
+				continue
+			}
+			res.Emails[i] = marshalScimSCIMEmailToSCIMEmailResponseBody(val)
+		}
+	}
+	if v.Meta != nil {
+		res.Meta = marshalScimSCIMMetaToSCIMMetaResponseBody(v.Meta)
+	}
+
+	return res
+}
+
+// unmarshalSCIMPatchOperationRequestBodyToScimSCIMPatchOperation builds a
+// value of type *scim.SCIMPatchOperation from a value of type
+// *SCIMPatchOperationRequestBody.
+func unmarshalSCIMPatchOperationRequestBodyToScimSCIMPatchOperation(v *SCIMPatchOperationRequestBody) *scim.SCIMPatchOperation {
+	res := &scim.SCIMPatchOperation{
+		Op:    *v.Op,
+		Path:  v.Path,
+		Value: v.Value,
+	}
+
+	return res
+}
@@ -0,0 +1,1139 @@
+// Code generated by goa v3.23.4, DO NOT EDIT.
+//
+// scim HTTP server types
+//
+// Command:
+// $ goa gen github.com/vidwadeseram/go-boilerplate/identity-api/design
+
+package server
+
+import (
+	scim "github.com/vidwadeseram/go-boilerplate/identity-api/gen/scim"
+	goa "goa.design/goa/v3/pkg"
+)
+
+// CreateUserRequestBody is the type of the "scim" service "create_user"
+// endpoint HTTP request body.
+type CreateUserRequestBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName *string `form:"userName,omitempty" json:"userName,omitempty" xml:"userName,omitempty"`
+	// Components of the user's name
+	Name *SCIMNameRequestBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses
+	Emails []*SCIMEmailRequestBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active *bool `form:"active,omitempty" json:"active,omitempty" xml:"active,omitempty"`
+	// Initial password; omit for accounts that sign in without one
+	Password *string `form:"password,omitempty" json:"password,omitempty" xml:"password,omitempty"`
+}
+
+// PatchUserRequestBody is the type of the "scim" service "patch_user" endpoint
+// HTTP request body.
+type PatchUserRequestBody struct {
+	// Schemas the request conforms to
+	Schemas []string `form:"schemas,omitempty" json:"schemas,omitempty" xml:"schemas,omitempty"`
+	// Operations to apply in order
+	Operations []*SCIMPatchOperationRequestBody `form:"Operations,omitempty" json:"Operations,omitempty" xml:"Operations,omitempty"`
+}
+
+// CreateUserResponseBody is the type of the "scim" service "create_user"
+// endpoint HTTP response body.
+type CreateUserResponseBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// Resource identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName string `form:"userName" json:"userName" xml:"userName"`
+	// Components of the user's name
+	Name *SCIMNameResponseBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses; the first is the user name
+	Emails []*SCIMEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Resource metadata
+	Meta *SCIMMetaResponseBody `form:"meta" json:"meta" xml:"meta"`
+}
+
+// GetUserResponseBody is the type of the "scim" service "get_user" endpoint
+// HTTP response body.
+type GetUserResponseBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// Resource identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName string `form:"userName" json:"userName" xml:"userName"`
+	// Components of the user's name
+	Name *SCIMNameResponseBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses; the first is the user name
+	Emails []*SCIMEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Resource metadata
+	Meta *SCIMMetaResponseBody `form:"meta" json:"meta" xml:"meta"`
+}
+
+// ListUsersResponseBody is the type of the "scim" service "list_users"
+// endpoint HTTP response body.
+type ListUsersResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// Number of resources matching the query
+	TotalResults int `form:"totalResults" json:"totalResults" xml:"totalResults"`
+	// 1-based index of the first returned resource
+	StartIndex int `form:"startIndex" json:"startIndex" xml:"startIndex"`
+	// Number of resources returned
+	ItemsPerPage int `form:"itemsPerPage" json:"itemsPerPage" xml:"itemsPerPage"`
+	// Returned resources
+	Resources []*SCIMUserResponseBody `form:"Resources" json:"Resources" xml:"Resources"`
+}
+
+// PatchUserResponseBody is the type of the "scim" service "patch_user"
+// endpoint HTTP response body.
+type PatchUserResponseBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// Resource identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName string `form:"userName" json:"userName" xml:"userName"`
+	// Components of the user's name
+	Name *SCIMNameResponseBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses; the first is the user name
+	Emails []*SCIMEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Resource metadata
+	Meta *SCIMMetaResponseBody `form:"meta" json:"meta" xml:"meta"`
+}
+
+// CreateUserBadRequestResponseBody is the type of the "scim" service
+// "create_user" endpoint HTTP response body for the "bad_request" error.
+type CreateUserBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// CreateUserConflictResponseBody is the type of the "scim" service
+// "create_user" endpoint HTTP response body for the "conflict" error.
+type CreateUserConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// CreateUserNotFoundResponseBody is the type of the "scim" service
+// "create_user" endpoint HTTP response body for the "not_found" error.
+type CreateUserNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// CreateUserUnauthorizedResponseBody is the type of the "scim" service
+// "create_user" endpoint HTTP response body for the "unauthorized" error.
+type CreateUserUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// GetUserBadRequestResponseBody is the type of the "scim" service "get_user"
+// endpoint HTTP response body for the "bad_request" error.
+type GetUserBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// GetUserConflictResponseBody is the type of the "scim" service "get_user"
+// endpoint HTTP response body for the "conflict" error.
+type GetUserConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// GetUserNotFoundResponseBody is the type of the "scim" service "get_user"
+// endpoint HTTP response body for the "not_found" error.
+type GetUserNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// GetUserUnauthorizedResponseBody is the type of the "scim" service "get_user"
+// endpoint HTTP response body for the "unauthorized" error.
+type GetUserUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// ListUsersBadRequestResponseBody is the type of the "scim" service
+// "list_users" endpoint HTTP response body for the "bad_request" error.
+type ListUsersBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// ListUsersConflictResponseBody is the type of the "scim" service "list_users"
+// endpoint HTTP response body for the "conflict" error.
+type ListUsersConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// ListUsersNotFoundResponseBody is the type of the "scim" service "list_users"
+// endpoint HTTP response body for the "not_found" error.
+type ListUsersNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// ListUsersUnauthorizedResponseBody is the type of the "scim" service
+// "list_users" endpoint HTTP response body for the "unauthorized" error.
+type ListUsersUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// PatchUserBadRequestResponseBody is the type of the "scim" service
+// "patch_user" endpoint HTTP response body for the "bad_request" error.
+type PatchUserBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// PatchUserConflictResponseBody is the type of the "scim" service "patch_user"
+// endpoint HTTP response body for the "conflict" error.
+type PatchUserConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// PatchUserNotFoundResponseBody is the type of the "scim" service "patch_user"
+// endpoint HTTP response body for the "not_found" error.
+type PatchUserNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// PatchUserUnauthorizedResponseBody is the type of the "scim" service
+// "patch_user" endpoint HTTP response body for the "unauthorized" error.
+type PatchUserUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// DeleteUserBadRequestResponseBody is the type of the "scim" service
+// "delete_user" endpoint HTTP response body for the "bad_request" error.
+type DeleteUserBadRequestResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// DeleteUserConflictResponseBody is the type of the "scim" service
+// "delete_user" endpoint HTTP response body for the "conflict" error.
+type DeleteUserConflictResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// DeleteUserNotFoundResponseBody is the type of the "scim" service
+// "delete_user" endpoint HTTP response body for the "not_found" error.
+type DeleteUserNotFoundResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// DeleteUserUnauthorizedResponseBody is the type of the "scim" service
+// "delete_user" endpoint HTTP response body for the "unauthorized" error.
+type DeleteUserUnauthorizedResponseBody struct {
+	// Schemas the response conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// HTTP status code
+	Status string `form:"status" json:"status" xml:"status"`
+	// SCIM detail error keyword, e.g. uniqueness or invalidFilter
+	ScimType *string `form:"scimType,omitempty" json:"scimType,omitempty" xml:"scimType,omitempty"`
+	// Human-readable description of the error
+	Detail string `form:"detail" json:"detail" xml:"detail"`
+}
+
+// SCIMNameResponseBody is used to define fields on response body types.
+type SCIMNameResponseBody struct {
+	// Full name, mapped to the display name
+	Formatted *string `form:"formatted,omitempty" json:"formatted,omitempty" xml:"formatted,omitempty"`
+	// Given name
+	GivenName *string `form:"givenName,omitempty" json:"givenName,omitempty" xml:"givenName,omitempty"`
+	// Family name
+	FamilyName *string `form:"familyName,omitempty" json:"familyName,omitempty" xml:"familyName,omitempty"`
+}
+
+// SCIMEmailResponseBody is used to define fields on response body types.
+type SCIMEmailResponseBody struct {
+	// Email address
+	Value string `form:"value" json:"value" xml:"value"`
+	// Email type, e.g. work
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Whether this is the primary address
+	Primary *bool `form:"primary,omitempty" json:"primary,omitempty" xml:"primary,omitempty"`
+}
+
+// SCIMMetaResponseBody is used to define fields on response body types.
+type SCIMMetaResponseBody struct {
+	// Resource type
+	ResourceType string `form:"resourceType" json:"resourceType" xml:"resourceType"`
+	// Creation timestamp
+	Created string `form:"created" json:"created" xml:"created"`
+	// Last modification timestamp
+	LastModified string `form:"lastModified" json:"lastModified" xml:"lastModified"`
+	// URI of the resource
+	Location string `form:"location" json:"location" xml:"location"`
+}
+
+// SCIMUserResponseBody is used to define fields on response body types.
+type SCIMUserResponseBody struct {
+	// Schemas the resource conforms to
+	Schemas []string `form:"schemas" json:"schemas" xml:"schemas"`
+	// Resource identifier
+	ID string `form:"id" json:"id" xml:"id"`
+	// Identifier assigned by the provisioning client
+	ExternalID *string `form:"externalId,omitempty" json:"externalId,omitempty" xml:"externalId,omitempty"`
+	// Unique user name, mapped to the email address
+	UserName string `form:"userName" json:"userName" xml:"userName"`
+	// Components of the user's name
+	Name *SCIMNameResponseBody `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Display name
+	DisplayName *string `form:"displayName,omitempty" json:"displayName,omitempty" xml:"displayName,omitempty"`
+	// Email addresses; the first is the user name
+	Emails []*SCIMEmailResponseBody `form:"emails,omitempty" json:"emails,omitempty" xml:"emails,omitempty"`
+	// Whether the account may sign in
+	Active bool `form:"active" json:"active" xml:"active"`
+	// Resource metadata
+	Meta *SCIMMetaResponseBody `form:"meta" json:"meta" xml:"meta"`
+}
+
+// SCIMNameRequestBody is used to define fields on request body types.
+type SCIMNameRequestBody struct {
+	// Full name, mapped to the display name
+	Formatted *string `form:"formatted,omitempty" json:"formatted,omitempty" xml:"formatted,omitempty"`
+	// Given name
+	GivenName *string `form:"givenName,omitempty" json:"givenName,omitempty" xml:"givenName,omitempty"`
+	// Family name
+	FamilyName *string `form:"familyName,omitempty" json:"familyName,omitempty" xml:"familyName,omitempty"`
+}
+
+// SCIMEmailRequestBody is used to define fields on request body types.
+type SCIMEmailRequestBody struct {
+	// Email address
+	Value *string `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+	// Email type, e.g. work
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Whether this is the primary address
+	Primary *bool `form:"primary,omitempty" json:"primary,omitempty" xml:"primary,omitempty"`
+}
+
+// SCIMPatchOperationRequestBody is used to define fields on request body types.
+type SCIMPatchOperationRequestBody struct {
+	// Operation: add, replace or remove (case-insensitive)
+	Op *string `form:"op,omitempty" json:"op,omitempty" xml:"op,omitempty"`
+	// Attribute path; when omitted value must be an object of attributes
+	Path *string `form:"path,omitempty" json:"path,omitempty" xml:"path,omitempty"`
+	// New value
+	Value any `form:"value,omitempty" json:"value,omitempty" xml:"value,omitempty"`
+}
+
+// NewCreateUserResponseBody builds the HTTP response body from the result of
+// the "create_user" endpoint of the "scim" service.
+func NewCreateUserResponseBody(res *scim.SCIMUser) *CreateUserResponseBody {
+	body := &CreateUserResponseBody{
+		ID:          res.ID,
+		ExternalID:  res.ExternalID,
+		UserName:    res.UserName,
+		DisplayName: res.DisplayName,
+		Active:      res.Active,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	if res.Name != nil {
+		body.Name = marshalScimSCIMNameToSCIMNameResponseBody(res.Name)
+	}
+	if res.Emails != nil {
+		body.Emails = make([]*SCIMEmailResponseBody, len(res.Emails))
+		for i, val := range res.Emails {
+			if val == nil {
+				body.Emails[i] = nil
+				continue
+			}
+			body.Emails[i] = marshalScimSCIMEmailToSCIMEmailResponseBody(val)
+		}
+	}
+	if res.Meta != nil {
+		body.Meta = marshalScimSCIMMetaToSCIMMetaResponseBody(res.Meta)
+	}
+	return body
+}
+
+// NewGetUserResponseBody builds the HTTP response body from the result of the
+// "get_user" endpoint of the "scim" service.
+func NewGetUserResponseBody(res *scim.SCIMUser) *GetUserResponseBody {
+	body := &GetUserResponseBody{
+		ID:          res.ID,
+		ExternalID:  res.ExternalID,
+		UserName:    res.UserName,
+		DisplayName: res.DisplayName,
+		Active:      res.Active,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	if res.Name != nil {
+		body.Name = marshalScimSCIMNameToSCIMNameResponseBody(res.Name)
+	}
+	if res.Emails != nil {
+		body.Emails = make([]*SCIMEmailResponseBody, len(res.Emails))
+		for i, val := range res.Emails {
+			if val == nil {
+				body.Emails[i] = nil
+				continue
+			}
+			body.Emails[i] = marshalScimSCIMEmailToSCIMEmailResponseBody(val)
+		}
+	}
+	if res.Meta != nil {
+		body.Meta = marshalScimSCIMMetaToSCIMMetaResponseBody(res.Meta)
+	}
+	return body
+}
+
+// NewListUsersResponseBody builds the HTTP response body from the result of
+// the "list_users" endpoint of the "scim" service.
+func NewListUsersResponseBody(res *scim.SCIMListResponse) *ListUsersResponseBody {
+	body := &ListUsersResponseBody{
+		TotalResults: res.TotalResults,
+		StartIndex:   res.StartIndex,
+		ItemsPerPage: res.ItemsPerPage,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	if res.Resources != nil {
+		body.Resources = make([]*SCIMUserResponseBody, len(res.Resources))
+		for i, val := range res.Resources {
+			if val == nil {
+				body.Resources[i] = nil
+				continue
+			}
+			body.Resources[i] = marshalScimSCIMUserToSCIMUserResponseBody(val)
+		}
+	} else {
+		body.Resources = []*SCIMUserResponseBody{}
+	}
+	return body
+}
+
+// NewPatchUserResponseBody builds the HTTP response body from the result of
+// the "patch_user" endpoint of the "scim" service.
+func NewPatchUserResponseBody(res *scim.SCIMUser) *PatchUserResponseBody {
+	body := &PatchUserResponseBody{
+		ID:          res.ID,
+		ExternalID:  res.ExternalID,
+		UserName:    res.UserName,
+		DisplayName: res.DisplayName,
+		Active:      res.Active,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	if res.Name != nil {
+		body.Name = marshalScimSCIMNameToSCIMNameResponseBody(res.Name)
+	}
+	if res.Emails != nil {
+		body.Emails = make([]*SCIMEmailResponseBody, len(res.Emails))
+		for i, val := range res.Emails {
+			if val == nil {
+				body.Emails[i] = nil
+				continue
+			}
+			body.Emails[i] = marshalScimSCIMEmailToSCIMEmailResponseBody(val)
+		}
+	}
+	if res.Meta != nil {
+		body.Meta = marshalScimSCIMMetaToSCIMMetaResponseBody(res.Meta)
+	}
+	return body
+}
+
+// NewCreateUserBadRequestResponseBody builds the HTTP response body from the
+// result of the "create_user" endpoint of the "scim" service.
+func NewCreateUserBadRequestResponseBody(res *scim.SCIMBadRequest) *CreateUserBadRequestResponseBody {
+	body := &CreateUserBadRequestResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewCreateUserConflictResponseBody builds the HTTP response body from the
+// result of the "create_user" endpoint of the "scim" service.
+func NewCreateUserConflictResponseBody(res *scim.SCIMConflict) *CreateUserConflictResponseBody {
+	body := &CreateUserConflictResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewCreateUserNotFoundResponseBody builds the HTTP response body from the
+// result of the "create_user" endpoint of the "scim" service.
+func NewCreateUserNotFoundResponseBody(res *scim.SCIMNotFound) *CreateUserNotFoundResponseBody {
+	body := &CreateUserNotFoundResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewCreateUserUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "create_user" endpoint of the "scim" service.
+func NewCreateUserUnauthorizedResponseBody(res *scim.SCIMUnauthorized) *CreateUserUnauthorizedResponseBody {
+	body := &CreateUserUnauthorizedResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewGetUserBadRequestResponseBody builds the HTTP response body from the
+// result of the "get_user" endpoint of the "scim" service.
+func NewGetUserBadRequestResponseBody(res *scim.SCIMBadRequest) *GetUserBadRequestResponseBody {
+	body := &GetUserBadRequestResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewGetUserConflictResponseBody builds the HTTP response body from the result
+// of the "get_user" endpoint of the "scim" service.
+func NewGetUserConflictResponseBody(res *scim.SCIMConflict) *GetUserConflictResponseBody {
+	body := &GetUserConflictResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewGetUserNotFoundResponseBody builds the HTTP response body from the result
+// of the "get_user" endpoint of the "scim" service.
+func NewGetUserNotFoundResponseBody(res *scim.SCIMNotFound) *GetUserNotFoundResponseBody {
+	body := &GetUserNotFoundResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewGetUserUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "get_user" endpoint of the "scim" service.
+func NewGetUserUnauthorizedResponseBody(res *scim.SCIMUnauthorized) *GetUserUnauthorizedResponseBody {
+	body := &GetUserUnauthorizedResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewListUsersBadRequestResponseBody builds the HTTP response body from the
+// result of the "list_users" endpoint of the "scim" service.
+func NewListUsersBadRequestResponseBody(res *scim.SCIMBadRequest) *ListUsersBadRequestResponseBody {
+	body := &ListUsersBadRequestResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewListUsersConflictResponseBody builds the HTTP response body from the
+// result of the "list_users" endpoint of the "scim" service.
+func NewListUsersConflictResponseBody(res *scim.SCIMConflict) *ListUsersConflictResponseBody {
+	body := &ListUsersConflictResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewListUsersNotFoundResponseBody builds the HTTP response body from the
+// result of the "list_users" endpoint of the "scim" service.
+func NewListUsersNotFoundResponseBody(res *scim.SCIMNotFound) *ListUsersNotFoundResponseBody {
+	body := &ListUsersNotFoundResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewListUsersUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "list_users" endpoint of the "scim" service.
+func NewListUsersUnauthorizedResponseBody(res *scim.SCIMUnauthorized) *ListUsersUnauthorizedResponseBody {
+	body := &ListUsersUnauthorizedResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewPatchUserBadRequestResponseBody builds the HTTP response body from the
+// result of the "patch_user" endpoint of the "scim" service.
+func NewPatchUserBadRequestResponseBody(res *scim.SCIMBadRequest) *PatchUserBadRequestResponseBody {
+	body := &PatchUserBadRequestResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewPatchUserConflictResponseBody builds the HTTP response body from the
+// result of the "patch_user" endpoint of the "scim" service.
+func NewPatchUserConflictResponseBody(res *scim.SCIMConflict) *PatchUserConflictResponseBody {
+	body := &PatchUserConflictResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewPatchUserNotFoundResponseBody builds the HTTP response body from the
+// result of the "patch_user" endpoint of the "scim" service.
+func NewPatchUserNotFoundResponseBody(res *scim.SCIMNotFound) *PatchUserNotFoundResponseBody {
+	body := &PatchUserNotFoundResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewPatchUserUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "patch_user" endpoint of the "scim" service.
+func NewPatchUserUnauthorizedResponseBody(res *scim.SCIMUnauthorized) *PatchUserUnauthorizedResponseBody {
+	body := &PatchUserUnauthorizedResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewDeleteUserBadRequestResponseBody builds the HTTP response body from the
+// result of the "delete_user" endpoint of the "scim" service.
+func NewDeleteUserBadRequestResponseBody(res *scim.SCIMBadRequest) *DeleteUserBadRequestResponseBody {
+	body := &DeleteUserBadRequestResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewDeleteUserConflictResponseBody builds the HTTP response body from the
+// result of the "delete_user" endpoint of the "scim" service.
+func NewDeleteUserConflictResponseBody(res *scim.SCIMConflict) *DeleteUserConflictResponseBody {
+	body := &DeleteUserConflictResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewDeleteUserNotFoundResponseBody builds the HTTP response body from the
+// result of the "delete_user" endpoint of the "scim" service.
+func NewDeleteUserNotFoundResponseBody(res *scim.SCIMNotFound) *DeleteUserNotFoundResponseBody {
+	body := &DeleteUserNotFoundResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewDeleteUserUnauthorizedResponseBody builds the HTTP response body from the
+// result of the "delete_user" endpoint of the "scim" service.
+func NewDeleteUserUnauthorizedResponseBody(res *scim.SCIMUnauthorized) *DeleteUserUnauthorizedResponseBody {
+	body := &DeleteUserUnauthorizedResponseBody{
+		Status:   res.Status,
+		ScimType: res.ScimType,
+		Detail:   res.Detail,
+	}
+	if res.Schemas != nil {
+		body.Schemas = make([]string, len(res.Schemas))
+		for i, val := range res.Schemas {
+			body.Schemas[i] = val
+		}
+	} else {
+		body.Schemas = []string{}
+	}
+	return body
+}
+
+// NewCreateUserPayload builds a scim service create_user endpoint payload.
+func NewCreateUserPayload(body *CreateUserRequestBody, token string) *scim.CreateUserPayload {
+	v := &scim.CreateUserPayload{
+		ExternalID:  body.ExternalID,
+		UserName:    *body.UserName,
+		DisplayName: body.DisplayName,
+		Password:    body.Password,
+	}
+	if body.Active != nil {
+		v.Active = *body.Active
+	}
+	if body.Schemas != nil {
+		v.Schemas = make([]string, len(body.Schemas))
+		for i, val := range body.Schemas {
+			v.Schemas[i] = val
+		}
+	}
+	if body.Name != nil {
+		v.Name = unmarshalSCIMNameRequestBodyToScimSCIMName(body.Name)
+	}
+	if body.Emails != nil {
+		v.Emails = make([]*scim.SCIMEmail, len(body.Emails))
+		for i, val := range body.Emails {
+			if val == nil {
+				v.Emails[i] = nil
+				continue
+			}
+			v.Emails[i] = unmarshalSCIMEmailRequestBodyToScimSCIMEmail(val)
+		}
+	}
+	if body.Active == nil {
+		v.Active = true
+	}
+	v.Token = token
+
+	return v
+}
+
+// NewGetUserPayload builds a scim service get_user endpoint payload.
+func NewGetUserPayload(id string, token string) *scim.GetUserPayload {
+	v := &scim.GetUserPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewListUsersPayload builds a scim service list_users endpoint payload.
+func NewListUsersPayload(filter *string, startIndex int, count int, token string) *scim.ListUsersPayload {
+	v := &scim.ListUsersPayload{}
+	v.Filter = filter
+	v.StartIndex = startIndex
+	v.Count = count
+	v.Token = token
+
+	return v
+}
+
+// NewPatchUserPayload builds a scim service patch_user endpoint payload.
+func NewPatchUserPayload(body *PatchUserRequestBody, id string, token string) *scim.PatchUserPayload {
+	v := &scim.PatchUserPayload{}
+	if body.Schemas != nil {
+		v.Schemas = make([]string, len(body.Schemas))
+		for i, val := range body.Schemas {
+			v.Schemas[i] = val
+		}
+	}
+	v.Operations = make([]*scim.SCIMPatchOperation, len(body.Operations))
+	for i, val := range body.Operations {
+		if val == nil {
+			v.Operations[i] = nil
+			continue
+		}
+		v.Operations[i] = unmarshalSCIMPatchOperationRequestBodyToScimSCIMPatchOperation(val)
+	}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// NewDeleteUserPayload builds a scim service delete_user endpoint payload.
+func NewDeleteUserPayload(id string, token string) *scim.DeleteUserPayload {
+	v := &scim.DeleteUserPayload{}
+	v.ID = id
+	v.Token = token
+
+	return v
+}
+
+// ValidateCreateUserRequestBody runs the validations defined on
+// create_user_request_body
+func ValidateCreateUserRequestBody(body *CreateUserRequestBody) (err error) {
+	if body.UserName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("userName", "body"))
+	}
+	for _, e := range body.Emails {
+		if e != nil {
+			if err2 := ValidateSCIMEmailRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePatchUserRequestBody runs the validations defined on
+// patch_user_request_body
+func ValidatePatchUserRequestBody(body *PatchUserRequestBody) (err error) {
+	if body.Operations == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("Operations", "body"))
+	}
+	if len(body.Operations) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.Operations", body.Operations, len(body.Operations), 1, true))
+	}
+	for _, e := range body.Operations {
+		if e != nil {
+			if err2 := ValidateSCIMPatchOperationRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateSCIMEmailRequestBody runs the validations defined on
+// SCIMEmailRequestBody
+func ValidateSCIMEmailRequestBody(body *SCIMEmailRequestBody) (err error) {
+	if body.Value == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("value", "body"))
+	}
+	if body.Value != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.value", *body.Value, goa.FormatEmail))
+	}
+	return
+}
+
+// ValidateSCIMPatchOperationRequestBody runs the validations defined on
+// SCIMPatchOperationRequestBody
+func ValidateSCIMPatchOperationRequestBody(body *SCIMPatchOperationRequestBody) (err error) {
+	if body.Op == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("op", "body"))
+	}
+	return
+}
@@ -15,24 +15,73 @@ import (
 
 // Client is the "identity" service client.
 type Client struct {
-	RegisterEndpoint      goa.Endpoint
-	LoginEndpoint         goa.Endpoint
-	ValidateTokenEndpoint goa.Endpoint
+	RegisterEndpoint              goa.Endpoint
+	LoginEndpoint                 goa.Endpoint
+	SetUsernameEndpoint           goa.Endpoint
+	CheckUsernameEndpoint         goa.Endpoint
+	RequestMagicLinkEndpoint      goa.Endpoint
+	ConsumeMagicLinkEndpoint      goa.Endpoint
+	ValidateTokenEndpoint         goa.Endpoint
+	ValidateTokensEndpoint        goa.Endpoint
+	ValidateTokenStreamEndpoint   goa.Endpoint
+	ListAuthEventsEndpoint        goa.Endpoint
+	IntrospectEndpoint            goa.Endpoint
+	RevokeTokenEndpoint           goa.Endpoint
+	ExchangeTokenEndpoint         goa.Endpoint
+	InviteUserEndpoint            goa.Endpoint
+	ListInvitationsEndpoint       goa.Endpoint
+	RevokeInvitationEndpoint      goa.Endpoint
+	AcceptInvitationEndpoint      goa.Endpoint
+	DeviceAuthorizationEndpoint   goa.Endpoint
+	DeviceTokenEndpoint           goa.Endpoint
+	ApproveDeviceEndpoint         goa.Endpoint
+	CreateWebhookEndpoint         goa.Endpoint
+	ListWebhooksEndpoint          goa.Endpoint
+	DeleteWebhookEndpoint         goa.Endpoint
+	ListWebhookDeliveriesEndpoint goa.Endpoint
+	RedeliverWebhookEndpoint      goa.Endpoint
+	SetUserStatusEndpoint         goa.Endpoint
 }
 
 // NewClient initializes a "identity" service client given the endpoints.
-func NewClient(register, login, validateToken goa.Endpoint) *Client {
+func NewClient(register, login, setUsername, checkUsername, requestMagicLink, consumeMagicLink, validateToken, validateTokens, validateTokenStream, listAuthEvents, introspect, revokeToken, exchangeToken, inviteUser, listInvitations, revokeInvitation, acceptInvitation, deviceAuthorization, deviceToken, approveDevice, createWebhook, listWebhooks, deleteWebhook, listWebhookDeliveries, redeliverWebhook, setUserStatus goa.Endpoint) *Client {
 	return &Client{
-		RegisterEndpoint:      register,
-		LoginEndpoint:         login,
-		ValidateTokenEndpoint: validateToken,
+		RegisterEndpoint:              register,
+		LoginEndpoint:                 login,
+		SetUsernameEndpoint:           setUsername,
+		CheckUsernameEndpoint:         checkUsername,
+		RequestMagicLinkEndpoint:      requestMagicLink,
+		ConsumeMagicLinkEndpoint:      consumeMagicLink,
+		ValidateTokenEndpoint:         validateToken,
+		ValidateTokensEndpoint:        validateTokens,
+		ValidateTokenStreamEndpoint:   validateTokenStream,
+		ListAuthEventsEndpoint:        listAuthEvents,
+		IntrospectEndpoint:            introspect,
+		RevokeTokenEndpoint:           revokeToken,
+		ExchangeTokenEndpoint:         exchangeToken,
+		InviteUserEndpoint:            inviteUser,
+		ListInvitationsEndpoint:       listInvitations,
+		RevokeInvitationEndpoint:      revokeInvitation,
+		AcceptInvitationEndpoint:      acceptInvitation,
+		DeviceAuthorizationEndpoint:   deviceAuthorization,
+		DeviceTokenEndpoint:           deviceToken,
+		ApproveDeviceEndpoint:         approveDevice,
+		CreateWebhookEndpoint:         createWebhook,
+		ListWebhooksEndpoint:          listWebhooks,
+		DeleteWebhookEndpoint:         deleteWebhook,
+		ListWebhookDeliveriesEndpoint: listWebhookDeliveries,
+		RedeliverWebhookEndpoint:      redeliverWebhook,
+		SetUserStatusEndpoint:         setUserStatus,
 	}
 }
 
 // Register calls the "register" endpoint of the "identity" service.
 // Register may return the following errors:
+//   - "password_policy" (type *PasswordPolicyError): Password does not satisfy the password policy
+//   - "invalid_username" (type *InvalidUsernameError): Username breaks the naming rules or is reserved
 //   - "unauthorized" (type *UnauthorizedError)
 //   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
 //   - error: internal error
 func (c *Client) Register(ctx context.Context, p *RegisterPayload) (res *User, err error) {
 	var ires any
@@ -47,8 +96,9 @@ func (c *Client) Register(ctx context.Context, p *RegisterPayload) (res *User, e
 // Login may return the following errors:
 //   - "unauthorized" (type *UnauthorizedError)
 //   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
 //   - error: internal error
-func (c *Client) Login(ctx context.Context, p *Credentials) (res *TokenResult, err error) {
+func (c *Client) Login(ctx context.Context, p *LoginPayload) (res *TokenResult, err error) {
 	var ires any
 	ires, err = c.LoginEndpoint(ctx, p)
 	if err != nil {
@@ -57,10 +107,70 @@ func (c *Client) Login(ctx context.Context, p *Credentials) (res *TokenResult, e
 	return ires.(*TokenResult), nil
 }
 
+// SetUsername calls the "set_username" endpoint of the "identity" service.
+// SetUsername may return the following errors:
+//   - "invalid_username" (type *InvalidUsernameError): Username breaks the naming rules or is reserved
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) SetUsername(ctx context.Context, p *SetUsernamePayload) (res *User, err error) {
+	var ires any
+	ires, err = c.SetUsernameEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*User), nil
+}
+
+// CheckUsername calls the "check_username" endpoint of the "identity" service.
+// CheckUsername may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) CheckUsername(ctx context.Context, p *CheckUsernamePayload) (res *UsernameAvailability, err error) {
+	var ires any
+	ires, err = c.CheckUsernameEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*UsernameAvailability), nil
+}
+
+// RequestMagicLink calls the "request_magic_link" endpoint of the "identity"
+// service.
+// RequestMagicLink may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) RequestMagicLink(ctx context.Context, p *RequestMagicLinkPayload) (err error) {
+	_, err = c.RequestMagicLinkEndpoint(ctx, p)
+	return
+}
+
+// ConsumeMagicLink calls the "consume_magic_link" endpoint of the "identity"
+// service.
+// ConsumeMagicLink may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ConsumeMagicLink(ctx context.Context, p *ConsumeMagicLinkPayload) (res *TokenResult, err error) {
+	var ires any
+	ires, err = c.ConsumeMagicLinkEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TokenResult), nil
+}
+
 // ValidateToken calls the "validate_token" endpoint of the "identity" service.
 // ValidateToken may return the following errors:
 //   - "unauthorized" (type *UnauthorizedError)
 //   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
 //   - error: internal error
 func (c *Client) ValidateToken(ctx context.Context, p *ValidateTokenPayload) (res *ValidationResult, err error) {
 	var ires any
@@ -70,3 +180,292 @@ func (c *Client) ValidateToken(ctx context.Context, p *ValidateTokenPayload) (re
 	}
 	return ires.(*ValidationResult), nil
 }
+
+// ValidateTokens calls the "validate_tokens" endpoint of the "identity"
+// service.
+// ValidateTokens may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ValidateTokens(ctx context.Context, p *ValidateTokensPayload) (res *ValidationResultsCollection, err error) {
+	var ires any
+	ires, err = c.ValidateTokensEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ValidationResultsCollection), nil
+}
+
+// ValidateTokenStream calls the "validate_token_stream" endpoint of the
+// "identity" service.
+// ValidateTokenStream may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ValidateTokenStream(ctx context.Context) (res ValidateTokenStreamClientStream, err error) {
+	var ires any
+	ires, err = c.ValidateTokenStreamEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(ValidateTokenStreamClientStream), nil
+}
+
+// ListAuthEvents calls the "list_auth_events" endpoint of the "identity"
+// service.
+// ListAuthEvents may return the following errors:
+//   - "bad_request" (type *BadRequestError): A filter is malformed
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ListAuthEvents(ctx context.Context, p *ListAuthEventsPayload) (res *AuthEventsCollection, err error) {
+	var ires any
+	ires, err = c.ListAuthEventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AuthEventsCollection), nil
+}
+
+// Introspect calls the "introspect" endpoint of the "identity" service.
+// Introspect may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) Introspect(ctx context.Context, p *IntrospectPayload) (res *IntrospectionResult, err error) {
+	var ires any
+	ires, err = c.IntrospectEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*IntrospectionResult), nil
+}
+
+// RevokeToken calls the "revoke_token" endpoint of the "identity" service.
+// RevokeToken may return the following errors:
+//   - "unsupported_token_type" (type *OAuthError): The token is a JWT, which cannot be revoked
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) RevokeToken(ctx context.Context, p *RevokeTokenPayload) (err error) {
+	_, err = c.RevokeTokenEndpoint(ctx, p)
+	return
+}
+
+// ExchangeToken calls the "exchange_token" endpoint of the "identity" service.
+// ExchangeToken may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ExchangeToken(ctx context.Context, p *TokenExchangePayload) (res *TokenExchangeResult, err error) {
+	var ires any
+	ires, err = c.ExchangeTokenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TokenExchangeResult), nil
+}
+
+// InviteUser calls the "invite_user" endpoint of the "identity" service.
+// InviteUser may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) InviteUser(ctx context.Context, p *InviteUserPayload) (res *Invitation, err error) {
+	var ires any
+	ires, err = c.InviteUserEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Invitation), nil
+}
+
+// ListInvitations calls the "list_invitations" endpoint of the "identity"
+// service.
+// ListInvitations may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ListInvitations(ctx context.Context, p *ListInvitationsPayload) (res *InvitationsCollection, err error) {
+	var ires any
+	ires, err = c.ListInvitationsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*InvitationsCollection), nil
+}
+
+// RevokeInvitation calls the "revoke_invitation" endpoint of the "identity"
+// service.
+// RevokeInvitation may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) RevokeInvitation(ctx context.Context, p *RevokeInvitationPayload) (err error) {
+	_, err = c.RevokeInvitationEndpoint(ctx, p)
+	return
+}
+
+// AcceptInvitation calls the "accept_invitation" endpoint of the "identity"
+// service.
+// AcceptInvitation may return the following errors:
+//   - "password_policy" (type *PasswordPolicyError): Password does not satisfy the password policy
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) AcceptInvitation(ctx context.Context, p *AcceptInvitationPayload) (res *User, err error) {
+	var ires any
+	ires, err = c.AcceptInvitationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*User), nil
+}
+
+// DeviceAuthorization calls the "device_authorization" endpoint of the
+// "identity" service.
+// DeviceAuthorization may return the following errors:
+//   - "invalid_client" (type *OAuthError): Client is not allowed to use the device flow
+//   - "invalid_scope" (type *OAuthError): A requested scope is not allowed
+//   - "invalid_target" (type *OAuthError): A requested audience is not allowed
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) DeviceAuthorization(ctx context.Context, p *DeviceAuthorizationPayload) (res *DeviceAuthorizationResult, err error) {
+	var ires any
+	ires, err = c.DeviceAuthorizationEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeviceAuthorizationResult), nil
+}
+
+// DeviceToken calls the "device_token" endpoint of the "identity" service.
+// DeviceToken may return the following errors:
+//   - "authorization_pending" (type *OAuthError): The user has not yet approved the request
+//   - "slow_down" (type *OAuthError): The device is polling too often; the interval was increased by 5 seconds
+//   - "access_denied" (type *OAuthError): The user denied the request
+//   - "expired_token" (type *OAuthError): The device code expired
+//   - "invalid_grant" (type *OAuthError): The device code is unknown, already used or belongs to another client
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) DeviceToken(ctx context.Context, p *DeviceTokenPayload) (res *DeviceTokenResult, err error) {
+	var ires any
+	ires, err = c.DeviceTokenEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DeviceTokenResult), nil
+}
+
+// ApproveDevice calls the "approve_device" endpoint of the "identity" service.
+// ApproveDevice may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ApproveDevice(ctx context.Context, p *ApproveDevicePayload) (err error) {
+	_, err = c.ApproveDeviceEndpoint(ctx, p)
+	return
+}
+
+// CreateWebhook calls the "create_webhook" endpoint of the "identity" service.
+// CreateWebhook may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) CreateWebhook(ctx context.Context, p *CreateWebhookPayload) (res *WebhookSubscription, err error) {
+	var ires any
+	ires, err = c.CreateWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookSubscription), nil
+}
+
+// ListWebhooks calls the "list_webhooks" endpoint of the "identity" service.
+// ListWebhooks may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ListWebhooks(ctx context.Context, p *ListWebhooksPayload) (res *WebhookSubscriptionsCollection, err error) {
+	var ires any
+	ires, err = c.ListWebhooksEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookSubscriptionsCollection), nil
+}
+
+// DeleteWebhook calls the "delete_webhook" endpoint of the "identity" service.
+// DeleteWebhook may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) DeleteWebhook(ctx context.Context, p *DeleteWebhookPayload) (err error) {
+	_, err = c.DeleteWebhookEndpoint(ctx, p)
+	return
+}
+
+// ListWebhookDeliveries calls the "list_webhook_deliveries" endpoint of the
+// "identity" service.
+// ListWebhookDeliveries may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) ListWebhookDeliveries(ctx context.Context, p *ListWebhookDeliveriesPayload) (res *WebhookDeliveriesCollection, err error) {
+	var ires any
+	ires, err = c.ListWebhookDeliveriesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookDeliveriesCollection), nil
+}
+
+// RedeliverWebhook calls the "redeliver_webhook" endpoint of the "identity"
+// service.
+// RedeliverWebhook may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) RedeliverWebhook(ctx context.Context, p *RedeliverWebhookPayload) (res *WebhookDelivery, err error) {
+	var ires any
+	ires, err = c.RedeliverWebhookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*WebhookDelivery), nil
+}
+
+// SetUserStatus calls the "set_user_status" endpoint of the "identity" service.
+// SetUserStatus may return the following errors:
+//   - "unauthorized" (type *UnauthorizedError)
+//   - "not_found" (type *NotFoundError)
+//   - "conflict" (type *ConflictError): A user with this email or username already exists
+//   - error: internal error
+func (c *Client) SetUserStatus(ctx context.Context, p *SetUserStatusPayload) (res *User, err error) {
+	var ires any
+	ires, err = c.SetUserStatusEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*User), nil
+}
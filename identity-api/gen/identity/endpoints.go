@@ -11,21 +11,78 @@ import (
 	"context"
 
 	goa "goa.design/goa/v3/pkg"
+	"goa.design/goa/v3/security"
 )
 
 // Endpoints wraps the "identity" service endpoints.
 type Endpoints struct {
-	Register      goa.Endpoint
-	Login         goa.Endpoint
-	ValidateToken goa.Endpoint
+	Register              goa.Endpoint
+	Login                 goa.Endpoint
+	SetUsername           goa.Endpoint
+	CheckUsername         goa.Endpoint
+	RequestMagicLink      goa.Endpoint
+	ConsumeMagicLink      goa.Endpoint
+	ValidateToken         goa.Endpoint
+	ValidateTokens        goa.Endpoint
+	ValidateTokenStream   goa.Endpoint
+	ListAuthEvents        goa.Endpoint
+	Introspect            goa.Endpoint
+	RevokeToken           goa.Endpoint
+	ExchangeToken         goa.Endpoint
+	InviteUser            goa.Endpoint
+	ListInvitations       goa.Endpoint
+	RevokeInvitation      goa.Endpoint
+	AcceptInvitation      goa.Endpoint
+	DeviceAuthorization   goa.Endpoint
+	DeviceToken           goa.Endpoint
+	ApproveDevice         goa.Endpoint
+	CreateWebhook         goa.Endpoint
+	ListWebhooks          goa.Endpoint
+	DeleteWebhook         goa.Endpoint
+	ListWebhookDeliveries goa.Endpoint
+	RedeliverWebhook      goa.Endpoint
+	SetUserStatus         goa.Endpoint
+}
+
+// ValidateTokenStreamEndpointInput holds both the payload and the server
+// stream of the "validate_token_stream" method.
+type ValidateTokenStreamEndpointInput struct {
+	// Stream is the server stream used by the "validate_token_stream" method to
+	// send data.
+	Stream ValidateTokenStreamServerStream
 }
 
 // NewEndpoints wraps the methods of the "identity" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
+	// Casting service to Auther interface
+	a := s.(Auther)
 	return &Endpoints{
-		Register:      NewRegisterEndpoint(s),
-		Login:         NewLoginEndpoint(s),
-		ValidateToken: NewValidateTokenEndpoint(s),
+		Register:              NewRegisterEndpoint(s),
+		Login:                 NewLoginEndpoint(s),
+		SetUsername:           NewSetUsernameEndpoint(s),
+		CheckUsername:         NewCheckUsernameEndpoint(s),
+		RequestMagicLink:      NewRequestMagicLinkEndpoint(s),
+		ConsumeMagicLink:      NewConsumeMagicLinkEndpoint(s),
+		ValidateToken:         NewValidateTokenEndpoint(s),
+		ValidateTokens:        NewValidateTokensEndpoint(s),
+		ValidateTokenStream:   NewValidateTokenStreamEndpoint(s),
+		ListAuthEvents:        NewListAuthEventsEndpoint(s),
+		Introspect:            NewIntrospectEndpoint(s, a.BasicAuth),
+		RevokeToken:           NewRevokeTokenEndpoint(s, a.BasicAuth),
+		ExchangeToken:         NewExchangeTokenEndpoint(s),
+		InviteUser:            NewInviteUserEndpoint(s),
+		ListInvitations:       NewListInvitationsEndpoint(s),
+		RevokeInvitation:      NewRevokeInvitationEndpoint(s),
+		AcceptInvitation:      NewAcceptInvitationEndpoint(s),
+		DeviceAuthorization:   NewDeviceAuthorizationEndpoint(s),
+		DeviceToken:           NewDeviceTokenEndpoint(s),
+		ApproveDevice:         NewApproveDeviceEndpoint(s),
+		CreateWebhook:         NewCreateWebhookEndpoint(s),
+		ListWebhooks:          NewListWebhooksEndpoint(s),
+		DeleteWebhook:         NewDeleteWebhookEndpoint(s),
+		ListWebhookDeliveries: NewListWebhookDeliveriesEndpoint(s),
+		RedeliverWebhook:      NewRedeliverWebhookEndpoint(s),
+		SetUserStatus:         NewSetUserStatusEndpoint(s),
 	}
 }
 
@@ -33,7 +90,30 @@ func NewEndpoints(s Service) *Endpoints {
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Register = m(e.Register)
 	e.Login = m(e.Login)
+	e.SetUsername = m(e.SetUsername)
+	e.CheckUsername = m(e.CheckUsername)
+	e.RequestMagicLink = m(e.RequestMagicLink)
+	e.ConsumeMagicLink = m(e.ConsumeMagicLink)
 	e.ValidateToken = m(e.ValidateToken)
+	e.ValidateTokens = m(e.ValidateTokens)
+	e.ValidateTokenStream = m(e.ValidateTokenStream)
+	e.ListAuthEvents = m(e.ListAuthEvents)
+	e.Introspect = m(e.Introspect)
+	e.RevokeToken = m(e.RevokeToken)
+	e.ExchangeToken = m(e.ExchangeToken)
+	e.InviteUser = m(e.InviteUser)
+	e.ListInvitations = m(e.ListInvitations)
+	e.RevokeInvitation = m(e.RevokeInvitation)
+	e.AcceptInvitation = m(e.AcceptInvitation)
+	e.DeviceAuthorization = m(e.DeviceAuthorization)
+	e.DeviceToken = m(e.DeviceToken)
+	e.ApproveDevice = m(e.ApproveDevice)
+	e.CreateWebhook = m(e.CreateWebhook)
+	e.ListWebhooks = m(e.ListWebhooks)
+	e.DeleteWebhook = m(e.DeleteWebhook)
+	e.ListWebhookDeliveries = m(e.ListWebhookDeliveries)
+	e.RedeliverWebhook = m(e.RedeliverWebhook)
+	e.SetUserStatus = m(e.SetUserStatus)
 }
 
 // NewRegisterEndpoint returns an endpoint function that calls the method
@@ -54,11 +134,52 @@ func NewRegisterEndpoint(s Service) goa.Endpoint {
 // of service "identity".
 func NewLoginEndpoint(s Service) goa.Endpoint {
 	return func(ctx context.Context, req any) (any, error) {
-		p := req.(*Credentials)
+		p := req.(*LoginPayload)
 		return s.Login(ctx, p)
 	}
 }
 
+// NewSetUsernameEndpoint returns an endpoint function that calls the method
+// "set_username" of service "identity".
+func NewSetUsernameEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetUsernamePayload)
+		res, err := s.SetUsername(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedUser(res, "default")
+		return vres, nil
+	}
+}
+
+// NewCheckUsernameEndpoint returns an endpoint function that calls the method
+// "check_username" of service "identity".
+func NewCheckUsernameEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CheckUsernamePayload)
+		return s.CheckUsername(ctx, p)
+	}
+}
+
+// NewRequestMagicLinkEndpoint returns an endpoint function that calls the
+// method "request_magic_link" of service "identity".
+func NewRequestMagicLinkEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RequestMagicLinkPayload)
+		return nil, s.RequestMagicLink(ctx, p)
+	}
+}
+
+// NewConsumeMagicLinkEndpoint returns an endpoint function that calls the
+// method "consume_magic_link" of service "identity".
+func NewConsumeMagicLinkEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ConsumeMagicLinkPayload)
+		return s.ConsumeMagicLink(ctx, p)
+	}
+}
+
 // NewValidateTokenEndpoint returns an endpoint function that calls the method
 // "validate_token" of service "identity".
 func NewValidateTokenEndpoint(s Service) goa.Endpoint {
@@ -67,3 +188,204 @@ func NewValidateTokenEndpoint(s Service) goa.Endpoint {
 		return s.ValidateToken(ctx, p)
 	}
 }
+
+// NewValidateTokensEndpoint returns an endpoint function that calls the method
+// "validate_tokens" of service "identity".
+func NewValidateTokensEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ValidateTokensPayload)
+		return s.ValidateTokens(ctx, p)
+	}
+}
+
+// NewValidateTokenStreamEndpoint returns an endpoint function that calls the
+// method "validate_token_stream" of service "identity".
+func NewValidateTokenStreamEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*ValidateTokenStreamEndpointInput)
+		return nil, s.ValidateTokenStream(ctx, ep.Stream)
+	}
+}
+
+// NewListAuthEventsEndpoint returns an endpoint function that calls the method
+// "list_auth_events" of service "identity".
+func NewListAuthEventsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListAuthEventsPayload)
+		return s.ListAuthEvents(ctx, p)
+	}
+}
+
+// NewIntrospectEndpoint returns an endpoint function that calls the method
+// "introspect" of service "identity".
+func NewIntrospectEndpoint(s Service, authBasicFn security.AuthBasicFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*IntrospectPayload)
+		var err error
+		sc := security.BasicScheme{
+			Name:           "client_basic",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authBasicFn(ctx, p.ClientID, p.ClientSecret, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return s.Introspect(ctx, p)
+	}
+}
+
+// NewRevokeTokenEndpoint returns an endpoint function that calls the method
+// "revoke_token" of service "identity".
+func NewRevokeTokenEndpoint(s Service, authBasicFn security.AuthBasicFunc) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeTokenPayload)
+		var err error
+		sc := security.BasicScheme{
+			Name:           "client_basic",
+			Scopes:         []string{},
+			RequiredScopes: []string{},
+		}
+		ctx, err = authBasicFn(ctx, p.ClientID, p.ClientSecret, &sc)
+		if err != nil {
+			return nil, err
+		}
+		return nil, s.RevokeToken(ctx, p)
+	}
+}
+
+// NewExchangeTokenEndpoint returns an endpoint function that calls the method
+// "exchange_token" of service "identity".
+func NewExchangeTokenEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*TokenExchangePayload)
+		return s.ExchangeToken(ctx, p)
+	}
+}
+
+// NewInviteUserEndpoint returns an endpoint function that calls the method
+// "invite_user" of service "identity".
+func NewInviteUserEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*InviteUserPayload)
+		return s.InviteUser(ctx, p)
+	}
+}
+
+// NewListInvitationsEndpoint returns an endpoint function that calls the
+// method "list_invitations" of service "identity".
+func NewListInvitationsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListInvitationsPayload)
+		return s.ListInvitations(ctx, p)
+	}
+}
+
+// NewRevokeInvitationEndpoint returns an endpoint function that calls the
+// method "revoke_invitation" of service "identity".
+func NewRevokeInvitationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RevokeInvitationPayload)
+		return nil, s.RevokeInvitation(ctx, p)
+	}
+}
+
+// NewAcceptInvitationEndpoint returns an endpoint function that calls the
+// method "accept_invitation" of service "identity".
+func NewAcceptInvitationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AcceptInvitationPayload)
+		res, err := s.AcceptInvitation(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedUser(res, "default")
+		return vres, nil
+	}
+}
+
+// NewDeviceAuthorizationEndpoint returns an endpoint function that calls the
+// method "device_authorization" of service "identity".
+func NewDeviceAuthorizationEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeviceAuthorizationPayload)
+		return s.DeviceAuthorization(ctx, p)
+	}
+}
+
+// NewDeviceTokenEndpoint returns an endpoint function that calls the method
+// "device_token" of service "identity".
+func NewDeviceTokenEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeviceTokenPayload)
+		return s.DeviceToken(ctx, p)
+	}
+}
+
+// NewApproveDeviceEndpoint returns an endpoint function that calls the method
+// "approve_device" of service "identity".
+func NewApproveDeviceEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ApproveDevicePayload)
+		return nil, s.ApproveDevice(ctx, p)
+	}
+}
+
+// NewCreateWebhookEndpoint returns an endpoint function that calls the method
+// "create_webhook" of service "identity".
+func NewCreateWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateWebhookPayload)
+		return s.CreateWebhook(ctx, p)
+	}
+}
+
+// NewListWebhooksEndpoint returns an endpoint function that calls the method
+// "list_webhooks" of service "identity".
+func NewListWebhooksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhooksPayload)
+		return s.ListWebhooks(ctx, p)
+	}
+}
+
+// NewDeleteWebhookEndpoint returns an endpoint function that calls the method
+// "delete_webhook" of service "identity".
+func NewDeleteWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteWebhookPayload)
+		return nil, s.DeleteWebhook(ctx, p)
+	}
+}
+
+// NewListWebhookDeliveriesEndpoint returns an endpoint function that calls the
+// method "list_webhook_deliveries" of service "identity".
+func NewListWebhookDeliveriesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListWebhookDeliveriesPayload)
+		return s.ListWebhookDeliveries(ctx, p)
+	}
+}
+
+// NewRedeliverWebhookEndpoint returns an endpoint function that calls the
+// method "redeliver_webhook" of service "identity".
+func NewRedeliverWebhookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RedeliverWebhookPayload)
+		return s.RedeliverWebhook(ctx, p)
+	}
+}
+
+// NewSetUserStatusEndpoint returns an endpoint function that calls the method
+// "set_user_status" of service "identity".
+func NewSetUserStatusEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*SetUserStatusPayload)
+		res, err := s.SetUserStatus(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		vres := NewViewedUser(res, "default")
+		return vres, nil
+	}
+}
@@ -11,16 +11,81 @@ import (
 	"context"
 
 	identityviews "github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity/views"
+	"goa.design/goa/v3/security"
 )
 
 // Operations for user identities
 type Service interface {
 	// Registers a new user
 	Register(context.Context, *RegisterPayload) (res *User, err error)
-	// Authenticates a user and issues a JWT
-	Login(context.Context, *Credentials) (res *TokenResult, err error)
+	// Authenticates a user by email or username and issues a JWT or opaque access
+	// token
+	Login(context.Context, *LoginPayload) (res *TokenResult, err error)
+	// Sets or changes the username of the calling user
+	SetUsername(context.Context, *SetUsernamePayload) (res *User, err error)
+	// Reports whether a username is valid and not yet taken
+	CheckUsername(context.Context, *CheckUsernamePayload) (res *UsernameAvailability, err error)
+	// Emails a single-use login link; succeeds whether or not the account exists
+	RequestMagicLink(context.Context, *RequestMagicLinkPayload) (err error)
+	// Exchanges a login link token for an access token
+	ConsumeMagicLink(context.Context, *ConsumeMagicLinkPayload) (res *TokenResult, err error)
 	// Validates a JWT and returns the claims
 	ValidateToken(context.Context, *ValidateTokenPayload) (res *ValidationResult, err error)
+	// Validates a batch of access tokens in one call
+	ValidateTokens(context.Context, *ValidateTokensPayload) (res *ValidationResultsCollection, err error)
+	// Validates access tokens sent over a long-lived bidirectional stream; results
+	// are returned in request order
+	ValidateTokenStream(context.Context, ValidateTokenStreamServerStream) (err error)
+	// Lists security audit events; restricted to administrators
+	ListAuthEvents(context.Context, *ListAuthEventsPayload) (res *AuthEventsCollection, err error)
+	// OAuth 2.0 token introspection (RFC 7662) for JWT and opaque access tokens
+	Introspect(context.Context, *IntrospectPayload) (res *IntrospectionResult, err error)
+	// OAuth 2.0 token revocation (RFC 7009) for opaque access tokens. Unknown and
+	// already revoked tokens are accepted silently; JWTs cannot be revoked and
+	// expire on their own
+	RevokeToken(context.Context, *RevokeTokenPayload) (err error)
+	// Exchanges an administrator token for a short-lived token impersonating
+	// another user (RFC 8693)
+	ExchangeToken(context.Context, *TokenExchangePayload) (res *TokenExchangeResult, err error)
+	// Invites a colleague by email with optional pre-assigned attributes. Only
+	// administrators may pre-assign attributes and are told when the email is
+	// already registered
+	InviteUser(context.Context, *InviteUserPayload) (res *Invitation, err error)
+	// Lists the invitations created by the caller
+	ListInvitations(context.Context, *ListInvitationsPayload) (res *InvitationsCollection, err error)
+	// Revokes a pending invitation created by the caller
+	RevokeInvitation(context.Context, *RevokeInvitationPayload) (err error)
+	// Completes registration for an invited user
+	AcceptInvitation(context.Context, *AcceptInvitationPayload) (res *User, err error)
+	// Starts the OAuth 2.0 device authorization grant (RFC 8628)
+	DeviceAuthorization(context.Context, *DeviceAuthorizationPayload) (res *DeviceAuthorizationResult, err error)
+	// Polls for the access token of a device authorization (RFC 8628 section 3.4)
+	DeviceToken(context.Context, *DeviceTokenPayload) (res *DeviceTokenResult, err error)
+	// Approves or denies a device authorization on behalf of the signed-in user
+	ApproveDevice(context.Context, *ApproveDevicePayload) (err error)
+	// Subscribes an endpoint to user lifecycle events; restricted to administrators
+	CreateWebhook(context.Context, *CreateWebhookPayload) (res *WebhookSubscription, err error)
+	// Lists webhook subscriptions; restricted to administrators
+	ListWebhooks(context.Context, *ListWebhooksPayload) (res *WebhookSubscriptionsCollection, err error)
+	// Deletes a webhook subscription and its delivery log; restricted to
+	// administrators
+	DeleteWebhook(context.Context, *DeleteWebhookPayload) (err error)
+	// Lists the delivery log of a webhook subscription; restricted to
+	// administrators
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesPayload) (res *WebhookDeliveriesCollection, err error)
+	// Queues a new delivery of a previously sent event; restricted to
+	// administrators
+	RedeliverWebhook(context.Context, *RedeliverWebhookPayload) (res *WebhookDelivery, err error)
+	// Suspends, deactivates or reactivates an account; restricted to
+	// administrators. Accounts that are not active cannot sign in and their tokens
+	// stop validating
+	SetUserStatus(context.Context, *SetUserStatusPayload) (res *User, err error)
+}
+
+// Auther defines the authorization functions to be implemented by the service.
+type Auther interface {
+	// BasicAuth implements the authorization logic for the Basic security scheme.
+	BasicAuth(ctx context.Context, user, pass string, schema *security.BasicScheme) (context.Context, error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -37,12 +102,345 @@ const ServiceName = "identity"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [3]string{"register", "login", "validate_token"}
+var MethodNames = [26]string{"register", "login", "set_username", "check_username", "request_magic_link", "consume_magic_link", "validate_token", "validate_tokens", "validate_token_stream", "list_auth_events", "introspect", "revoke_token", "exchange_token", "invite_user", "list_invitations", "revoke_invitation", "accept_invitation", "device_authorization", "device_token", "approve_device", "create_webhook", "list_webhooks", "delete_webhook", "list_webhook_deliveries", "redeliver_webhook", "set_user_status"}
+
+// ValidateTokenStreamServerStream allows streaming instances of
+// *ValidateTokenStreamResponse to the client.
+type ValidateTokenStreamServerStream interface {
+	// Send streams instances of "ValidateTokenStreamResponse".
+	Send(*ValidateTokenStreamResponse) error
+	// SendWithContext streams instances of "ValidateTokenStreamResponse" with
+	// context.
+	SendWithContext(context.Context, *ValidateTokenStreamResponse) error
+	// Recv reads instances of "ValidateTokenStreamRequest" from the stream.
+	Recv() (*ValidateTokenStreamRequest, error)
+	// RecvWithContext reads instances of "ValidateTokenStreamRequest" from the
+	// stream with context.
+	RecvWithContext(context.Context) (*ValidateTokenStreamRequest, error)
+	// Close closes the stream.
+	Close() error
+}
+
+// ValidateTokenStreamClientStream allows streaming instances of
+// *ValidateTokenStreamRequest to the client.
+type ValidateTokenStreamClientStream interface {
+	// Send streams instances of "ValidateTokenStreamRequest".
+	Send(*ValidateTokenStreamRequest) error
+	// SendWithContext streams instances of "ValidateTokenStreamRequest" with
+	// context.
+	SendWithContext(context.Context, *ValidateTokenStreamRequest) error
+	// Recv reads instances of "ValidateTokenStreamResponse" from the stream.
+	Recv() (*ValidateTokenStreamResponse, error)
+	// RecvWithContext reads instances of "ValidateTokenStreamResponse" from the
+	// stream with context.
+	RecvWithContext(context.Context) (*ValidateTokenStreamResponse, error)
+	// Close closes the stream.
+	Close() error
+}
+
+// AcceptInvitationPayload is the payload type of the identity service
+// accept_invitation method.
+type AcceptInvitationPayload struct {
+	// Token from the emailed invitation link
+	InvitationToken string
+	// Password; may be omitted when magic link login is enabled
+	Password *string
+	// Overrides the pre-assigned display name
+	DisplayName *string
+}
+
+// Party acting on behalf of the token subject (RFC 8693 act claim)
+type Actor struct {
+	// Actor user identifier
+	UserID string
+	// Actor email address
+	Email *string
+}
+
+// ApproveDevicePayload is the payload type of the identity service
+// approve_device method.
+type ApproveDevicePayload struct {
+	// Bearer token of the user approving the device
+	Token string
+	// Code displayed by the device
+	UserCode string
+	// False denies the request
+	Approve bool
+}
+
+type AuthEvent struct {
+	// Event identifier
+	ID int64
+	// Event type
+	Type string
+	// Whether the operation succeeded
+	Success bool
+	// Acting user, when known
+	UserID *string
+	// Email supplied by or resolved for the actor
+	Email *string
+	// Failure reason
+	Reason *string
+	// Client IP address
+	IPAddress *string
+	// Client user agent
+	UserAgent *string
+	// Request identifier
+	RequestID *string
+	// Event timestamp
+	CreatedAt string
+}
+
+// AuthEventsCollection is the result type of the identity service
+// list_auth_events method.
+type AuthEventsCollection struct {
+	// Events ordered from newest to oldest
+	Events []*AuthEvent
+}
+
+type BadRequestError struct {
+	// description of the invalid argument
+	Message string
+	// error identifier
+	ID *string
+}
+
+// CheckUsernamePayload is the payload type of the identity service
+// check_username method.
+type CheckUsernamePayload struct {
+	// Username to check
+	Username string
+}
+
+type ConflictError struct {
+	// description of the failure
+	Message string
+	// error identifier
+	ID *string
+}
+
+// ConsumeMagicLinkPayload is the payload type of the identity service
+// consume_magic_link method.
+type ConsumeMagicLinkPayload struct {
+	// Token from the emailed login link
+	Token string
+}
+
+// CreateWebhookPayload is the payload type of the identity service
+// create_webhook method.
+type CreateWebhookPayload struct {
+	// Bearer token of an administrator
+	Token string
+	// Endpoint that receives signed POST requests
+	URL string
+	// Events to deliver; omit to receive all events
+	EventTypes []string
+}
+
+// DeleteWebhookPayload is the payload type of the identity service
+// delete_webhook method.
+type DeleteWebhookPayload struct {
+	// Bearer token of an administrator
+	Token string
+	// Subscription identifier
+	ID string
+}
+
+// DeviceAuthorizationPayload is the payload type of the identity service
+// device_authorization method.
+type DeviceAuthorizationPayload struct {
+	// Identifier of the public client starting the flow
+	ClientID string
+	// Space-separated scopes requested by the client; each must be listed in
+	// IDENTITY_DEVICE_SCOPES
+	Scope *string
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string
+}
+
+// DeviceAuthorizationResult is the result type of the identity service
+// device_authorization method.
+type DeviceAuthorizationResult struct {
+	// Code the device polls the token endpoint with
+	DeviceCode string
+	// Code the user enters on the verification page
+	UserCode string
+	// Page where the user approves the device
+	VerificationURI string
+	// Verification page with the user code filled in
+	VerificationURIComplete string
+	// Lifetime of the device and user codes in seconds
+	ExpiresIn int
+	// Minimum number of seconds between polling requests
+	Interval int
+}
+
+// DeviceTokenPayload is the payload type of the identity service device_token
+// method.
+type DeviceTokenPayload struct {
+	// OAuth 2.0 grant type
+	GrantType string
+	// Device code returned by device_authorization
+	DeviceCode string
+	// Client that started the flow
+	ClientID string
+}
+
+// DeviceTokenResult is the result type of the identity service device_token
+// method.
+type DeviceTokenResult struct {
+	// JWT access token
+	AccessToken string
+	// How the token is presented
+	TokenType string
+	// Token expiry window in seconds
+	ExpiresIn int
+	// Scopes granted to the token
+	Scope *string
+}
+
+// IntrospectPayload is the payload type of the identity service introspect
+// method.
+type IntrospectPayload struct {
+	// OAuth client identifier
+	ClientID string
+	// OAuth client secret
+	ClientSecret string
+	// Token to introspect
+	Token string
+	// Hint about the type of the submitted token
+	TokenTypeHint *string
+}
+
+// IntrospectionResult is the result type of the identity service introspect
+// method.
+type IntrospectionResult struct {
+	// Whether the token is currently active
+	Active bool
+	// Subject of the token
+	Sub *string
+	// Expiration time in seconds since the epoch
+	Exp *int64
+	// Issue time in seconds since the epoch
+	Iat *int64
+	// Space-separated scopes granted to the token
+	Scope *string
+	// Client the token was issued to
+	ClientID *string
+	// Type of the token
+	TokenType *string
+	// Email of the resource owner
+	Username *string
+	// Services the token is intended for
+	Aud []string
+	// Issuer of the token
+	Iss *string
+}
+
+type InvalidUsernameError struct {
+	// description of the broken rule
+	Message string
+	// error identifier
+	ID *string
+}
+
+// Invitation is the result type of the identity service invite_user method.
+type Invitation struct {
+	// Invitation identifier
+	ID string
+	// Email address of the invitee
+	Email string
+	// Display name pre-assigned to the invitee
+	DisplayName *string
+	// Attributes pre-assigned to the invitee
+	Attributes map[string]string
+	// User who created the invitation
+	InvitedBy string
+	// Invitation status
+	Status string
+	// Creation timestamp
+	CreatedAt string
+	// Expiry timestamp
+	ExpiresAt string
+}
+
+// InvitationsCollection is the result type of the identity service
+// list_invitations method.
+type InvitationsCollection struct {
+	// Invitations ordered from newest to oldest
+	Invitations []*Invitation
+}
+
+// InviteUserPayload is the payload type of the identity service invite_user
+// method.
+type InviteUserPayload struct {
+	// Bearer token of the inviting user
+	Token string
+	// Email address to invite
+	Email string
+	// Display name to pre-assign
+	DisplayName *string
+	// Attributes to pre-assign to the new account; restricted to administrators
+	Attributes map[string]string
+}
+
+// ListAuthEventsPayload is the payload type of the identity service
+// list_auth_events method.
+type ListAuthEventsPayload struct {
+	// Bearer token of an administrator
+	Token string
+	// Only return events for this user
+	UserID *string
+	// Only return events of this type
+	Type *string
+	// Only return events at or after this time
+	Since *string
+	// Only return events before this time
+	Until *string
+	// Only return events older than this event id
+	BeforeID *int64
+	// Maximum number of events to return
+	Limit int
+}
+
+// ListInvitationsPayload is the payload type of the identity service
+// list_invitations method.
+type ListInvitationsPayload struct {
+	// Bearer token of the inviting user
+	Token string
+}
 
-// Credentials is the payload type of the identity service login method.
-type Credentials struct {
-	Email    string
+// ListWebhookDeliveriesPayload is the payload type of the identity service
+// list_webhook_deliveries method.
+type ListWebhookDeliveriesPayload struct {
+	// Bearer token of an administrator
+	Token string
+	// Subscription identifier
+	ID string
+	// Maximum number of deliveries to return
+	Limit int
+}
+
+// ListWebhooksPayload is the payload type of the identity service
+// list_webhooks method.
+type ListWebhooksPayload struct {
+	// Bearer token of an administrator
+	Token string
+}
+
+// LoginPayload is the payload type of the identity service login method.
+type LoginPayload struct {
+	// Format of the issued access token
+	TokenFormat string
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string
+	// Deprecated: use identifier
+	Email    *string
 	Password string
+	// Email address or username
+	Identifier *string
 }
 
 type NotFoundError struct {
@@ -54,11 +452,129 @@ type NotFoundError struct {
 	Timeout   *bool
 }
 
+// OAuth 2.0 error response (RFC 6749 section 5.2)
+type OAuthError struct {
+	// Error code
+	Code string
+	// Human-readable description of the error
+	ErrorDescription *string
+}
+
+type PasswordPolicyError struct {
+	// description of the failure
+	Message string
+	// error identifier
+	ID *string
+	// every password rule that failed
+	Violations []*PolicyViolation
+}
+
+type PolicyViolation struct {
+	// identifier of the failed rule
+	Rule string
+	// description of the failed rule
+	Message string
+}
+
+// RedeliverWebhookPayload is the payload type of the identity service
+// redeliver_webhook method.
+type RedeliverWebhookPayload struct {
+	// Bearer token of an administrator
+	Token string
+	// Identifier of the delivery to send again
+	ID string
+}
+
 // RegisterPayload is the payload type of the identity service register method.
 type RegisterPayload struct {
+	Email string
+	// Password; may be omitted when magic link login is enabled
+	Password    *string
 	DisplayName string
-	Email       string
-	Password    string
+	// Optional unique handle that can be used to log in instead of the email
+	Username *string
+}
+
+// RequestMagicLinkPayload is the payload type of the identity service
+// request_magic_link method.
+type RequestMagicLinkPayload struct {
+	// Email address to send the login link to
+	Email string
+}
+
+// RevokeInvitationPayload is the payload type of the identity service
+// revoke_invitation method.
+type RevokeInvitationPayload struct {
+	// Bearer token of the inviting user
+	Token string
+	// Invitation identifier
+	ID string
+}
+
+// RevokeTokenPayload is the payload type of the identity service revoke_token
+// method.
+type RevokeTokenPayload struct {
+	// OAuth client identifier
+	ClientID string
+	// OAuth client secret
+	ClientSecret string
+	// Token to revoke
+	Token string
+	// Hint about the type of the submitted token
+	TokenTypeHint *string
+}
+
+// SetUserStatusPayload is the payload type of the identity service
+// set_user_status method.
+type SetUserStatusPayload struct {
+	// Bearer token of an administrator
+	Token string
+	// User identifier
+	ID string
+	// New account status
+	Status string
+	// Why the status is changed, e.g. the abuse report being acted on
+	Reason *string
+}
+
+// SetUsernamePayload is the payload type of the identity service set_username
+// method.
+type SetUsernamePayload struct {
+	// Bearer token of the user
+	Token string
+	// New username
+	Username string
+}
+
+// TokenExchangePayload is the payload type of the identity service
+// exchange_token method.
+type TokenExchangePayload struct {
+	// Bearer token of the administrator performing the exchange
+	Token string
+	// OAuth 2.0 grant type
+	GrantType string
+	// Identifier of the user to impersonate
+	RequestedSubject string
+	// Type of the requested token
+	RequestedTokenType string
+	// Why impersonation is needed, recorded in the audit log
+	Reason string
+	// Services the token is intended for; each must be listed in
+	// IDENTITY_JWT_AUDIENCES. Defaults to IDENTITY_JWT_AUDIENCE
+	Audience []string
+}
+
+// TokenExchangeResult is the result type of the identity service
+// exchange_token method.
+type TokenExchangeResult struct {
+	// JWT acting as the requested subject
+	AccessToken string
+	// Type of the issued token
+	IssuedTokenType string
+	// How the token is presented
+	TokenType string
+	// Token expiry window in seconds
+	ExpiresIn int
 }
 
 // TokenResult is the result type of the identity service login method.
@@ -90,6 +606,27 @@ type User struct {
 	DisplayName string
 	// Creation timestamp
 	CreatedAt string
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string
+	// Account status; only active accounts can sign in
+	Status string
+	// Reason given for the last status change
+	StatusReason *string
+	// Time of the last status change
+	StatusChangedAt *string
+	// Unique handle, if the user chose one
+	Username *string
+}
+
+// UsernameAvailability is the result type of the identity service
+// check_username method.
+type UsernameAvailability struct {
+	// Checked username
+	Username string
+	// Whether the username can be claimed
+	Available bool
+	// Why the username cannot be claimed
+	Reason *string
 }
 
 // ValidateTokenPayload is the payload type of the identity service
@@ -97,6 +634,40 @@ type User struct {
 type ValidateTokenPayload struct {
 	// JWT access token
 	Token string
+	// Audience of the calling service; when set the token must be intended for it
+	Audience *string
+}
+
+// ValidateTokenStreamRequest is the streaming payload type of the identity
+// service validate_token_stream method.
+type ValidateTokenStreamRequest struct {
+	// Caller-chosen identifier echoed in the response
+	ID *string
+	// Access token to validate
+	Token string
+	// Audience of the calling service; when set the token must be intended for it
+	Audience *string
+}
+
+// ValidateTokenStreamResponse is the result type of the identity service
+// validate_token_stream method.
+type ValidateTokenStreamResponse struct {
+	// Identifier of the request this result answers
+	ID *string
+	// Validation result; unset when error is set
+	Result *ValidationResult
+	// Set when the token could not be validated because of an internal error, in
+	// which case the request may be retried
+	Error *string
+}
+
+// ValidateTokensPayload is the payload type of the identity service
+// validate_tokens method.
+type ValidateTokensPayload struct {
+	// Access tokens to validate
+	Tokens []string
+	// Audience of the calling service; when set every token must be intended for it
+	Audience *string
 }
 
 // ValidationResult is the result type of the identity service validate_token
@@ -106,6 +677,128 @@ type ValidationResult struct {
 	UserID *string
 	Email  *string
 	Reason *string
+	// Set when the token was issued through impersonation
+	Actor *Actor
+	// Services the token is intended for
+	Audience []string
+	// Custom claims added by claims enrichers when the token was issued
+	Claims map[string]string
+}
+
+// ValidationResultsCollection is the result type of the identity service
+// validate_tokens method.
+type ValidationResultsCollection struct {
+	// One result per submitted token, in the same order
+	Results []*ValidationResult
+}
+
+// WebhookDeliveriesCollection is the result type of the identity service
+// list_webhook_deliveries method.
+type WebhookDeliveriesCollection struct {
+	// Deliveries ordered from newest to oldest
+	Deliveries []*WebhookDelivery
+}
+
+// WebhookDelivery is the result type of the identity service redeliver_webhook
+// method.
+type WebhookDelivery struct {
+	// Delivery identifier, sent in the X-Webhook-ID header
+	ID string
+	// Subscription the delivery belongs to
+	SubscriptionID string
+	// Event type
+	EventType string
+	// Delivery status
+	Status string
+	// Number of delivery attempts made
+	Attempts int
+	// HTTP status returned by the last attempt
+	ResponseStatus *int
+	// Error of the last failed attempt
+	LastError *string
+	// Creation timestamp
+	CreatedAt string
+	// Time of the last attempt
+	LastAttemptAt *string
+	// Time of the next attempt while pending
+	NextAttemptAt *string
+	// Time the endpoint acknowledged the delivery
+	DeliveredAt *string
+}
+
+// WebhookSubscription is the result type of the identity service
+// create_webhook method.
+type WebhookSubscription struct {
+	// Subscription identifier
+	ID string
+	// Endpoint that receives signed POST requests
+	URL string
+	// Events delivered to the endpoint; empty means all events
+	EventTypes []string
+	// Whether new events are delivered
+	Active bool
+	// HMAC-SHA256 signing secret; only returned when the subscription is created
+	Secret *string
+	// Creation timestamp
+	CreatedAt string
+}
+
+// WebhookSubscriptionsCollection is the result type of the identity service
+// list_webhooks method.
+type WebhookSubscriptionsCollection struct {
+	// Subscriptions ordered from newest to oldest
+	Subscriptions []*WebhookSubscription
+}
+
+// Error returns an error description.
+func (e *BadRequestError) Error() string {
+	return ""
+}
+
+// ErrorName returns "BadRequestError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *BadRequestError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "BadRequestError".
+func (e *BadRequestError) GoaErrorName() string {
+	return "bad_request"
+}
+
+// Error returns an error description.
+func (e *ConflictError) Error() string {
+	return ""
+}
+
+// ErrorName returns "ConflictError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *ConflictError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "ConflictError".
+func (e *ConflictError) GoaErrorName() string {
+	return "conflict"
+}
+
+// Error returns an error description.
+func (e *InvalidUsernameError) Error() string {
+	return ""
+}
+
+// ErrorName returns "InvalidUsernameError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *InvalidUsernameError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "InvalidUsernameError".
+func (e *InvalidUsernameError) GoaErrorName() string {
+	return "invalid_username"
 }
 
 // Error returns an error description.
@@ -125,6 +818,57 @@ func (e *NotFoundError) GoaErrorName() string {
 	return "not_found"
 }
 
+// Error returns an error description.
+func (e *OAuthError) Error() string {
+	return "OAuth 2.0 error response (RFC 6749 section 5.2)"
+}
+
+// ErrorName returns "OAuthError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *OAuthError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "OAuthError".
+func (e *OAuthError) GoaErrorName() string {
+	return e.Code
+}
+
+// Error returns an error description.
+func (e *PasswordPolicyError) Error() string {
+	return ""
+}
+
+// ErrorName returns "PasswordPolicyError".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *PasswordPolicyError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "PasswordPolicyError".
+func (e *PasswordPolicyError) GoaErrorName() string {
+	return "password_policy"
+}
+
+// Error returns an error description.
+func (e *PolicyViolation) Error() string {
+	return ""
+}
+
+// ErrorName returns "PolicyViolation".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *PolicyViolation) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "PolicyViolation".
+func (e *PolicyViolation) GoaErrorName() string {
+	return "PolicyViolation"
+}
+
 // Error returns an error description.
 func (e *UnauthorizedError) Error() string {
 	return ""
@@ -156,7 +900,11 @@ func NewViewedUser(res *User, view string) *identityviews.User {
 
 // newUser converts projected type User to service type User.
 func newUser(vres *identityviews.UserView) *User {
-	res := &User{}
+	res := &User{
+		StatusReason:    vres.StatusReason,
+		StatusChangedAt: vres.StatusChangedAt,
+		Username:        vres.Username,
+	}
 	if vres.ID != nil {
 		res.ID = *vres.ID
 	}
@@ -169,6 +917,17 @@ func newUser(vres *identityviews.UserView) *User {
 	if vres.CreatedAt != nil {
 		res.CreatedAt = *vres.CreatedAt
 	}
+	if vres.Status != nil {
+		res.Status = *vres.Status
+	}
+	if vres.Attributes != nil {
+		res.Attributes = make(map[string]string, len(vres.Attributes))
+		for key, val := range vres.Attributes {
+			tk := key
+			tv := val
+			res.Attributes[tk] = tv
+		}
+	}
 	return res
 }
 
@@ -176,10 +935,22 @@ func newUser(vres *identityviews.UserView) *User {
 // "default" view.
 func newUserView(res *User) *identityviews.UserView {
 	vres := &identityviews.UserView{
-		ID:          &res.ID,
-		Email:       &res.Email,
-		DisplayName: &res.DisplayName,
-		CreatedAt:   &res.CreatedAt,
+		ID:              &res.ID,
+		Email:           &res.Email,
+		DisplayName:     &res.DisplayName,
+		CreatedAt:       &res.CreatedAt,
+		Status:          &res.Status,
+		StatusReason:    res.StatusReason,
+		StatusChangedAt: res.StatusChangedAt,
+		Username:        res.Username,
+	}
+	if res.Attributes != nil {
+		vres.Attributes = make(map[string]string, len(res.Attributes))
+		for key, val := range res.Attributes {
+			tk := key
+			tv := val
+			vres.Attributes[tk] = tv
+		}
 	}
 	return vres
 }
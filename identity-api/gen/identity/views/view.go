@@ -29,6 +29,16 @@ type UserView struct {
 	DisplayName *string
 	// Creation timestamp
 	CreatedAt *string
+	// Attributes assigned to the user, e.g. through an invitation
+	Attributes map[string]string
+	// Account status; only active accounts can sign in
+	Status *string
+	// Reason given for the last status change
+	StatusReason *string
+	// Time of the last status change
+	StatusChangedAt *string
+	// Unique handle, if the user chose one
+	Username *string
 }
 
 var (
@@ -39,6 +49,11 @@ var (
 			"email",
 			"display_name",
 			"created_at",
+			"attributes",
+			"status",
+			"status_reason",
+			"status_changed_at",
+			"username",
 		},
 	}
 )
@@ -69,8 +84,19 @@ func ValidateUserView(result *UserView) (err error) {
 	if result.CreatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "result"))
 	}
+	if result.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "result"))
+	}
 	if result.CreatedAt != nil {
 		err = goa.MergeErrors(err, goa.ValidateFormat("result.created_at", *result.CreatedAt, goa.FormatDateTime))
 	}
+	if result.Status != nil {
+		if !(*result.Status == "active" || *result.Status == "suspended" || *result.Status == "deactivated") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("result.status", *result.Status, []any{"active", "suspended", "deactivated"}))
+		}
+	}
+	if result.StatusChangedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("result.status_changed_at", *result.StatusChangedAt, goa.FormatDateTime))
+	}
 	return
 }
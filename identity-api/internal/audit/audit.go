@@ -0,0 +1,239 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	goamiddleware "goa.design/goa/v3/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+// Event types recorded in the auth_events table.
+const (
+	EventRegister            = "register"
+	EventLogin               = "login"
+	EventValidateToken       = "validate_token"
+	EventTokenRevoked        = "token_revoked"
+	EventTokenExchange       = "token_exchange"
+	EventMagicLink           = "magic_link"
+	EventInvitation          = "invitation"
+	EventDeviceAuthorization = "device_authorization"
+	EventProvisioning        = "provisioning"
+	EventUserStatus          = "user_status"
+)
+
+// Event describes a single authentication event.
+type Event struct {
+	Type    string
+	Success bool
+	UserID  string
+	Email   string
+	Reason  string
+}
+
+// Recorder appends authentication events to the audit log.
+type Recorder struct {
+	log     *slog.Logger
+	queries *db.Queries
+}
+
+// NewRecorder creates a new Recorder instance.
+func NewRecorder(log *slog.Logger, queries *db.Queries) *Recorder {
+	return &Recorder{log: log, queries: queries}
+}
+
+// Record stores the event together with the client information and request id
+// carried by ctx. Failures are logged and never surfaced to the caller so that
+// auditing cannot break authentication.
+func (r *Recorder) Record(ctx context.Context, event Event) {
+	client := ClientFromContext(ctx)
+
+	params := db.CreateAuthEventParams{
+		EventType: event.Type,
+		Success:   event.Success,
+		Email:     optional(event.Email),
+		Reason:    optional(event.Reason),
+		IpAddress: optional(client.IP),
+		UserAgent: optional(client.UserAgent),
+		RequestID: optional(requestID(ctx)),
+	}
+	if parsed, err := uuid.Parse(event.UserID); err == nil {
+		params.UserID = pgtype.UUID{Bytes: parsed, Valid: true}
+	}
+
+	if err := r.queries.CreateAuthEvent(ctx, params); err != nil {
+		r.log.ErrorContext(ctx, "record auth event", "type", event.Type, "error", err)
+	}
+}
+
+// Client identifies the remote party of a request.
+type Client struct {
+	IP        string
+	UserAgent string
+}
+
+type clientKey struct{}
+
+// WithClient returns a copy of ctx carrying the client information.
+func WithClient(ctx context.Context, client Client) context.Context {
+	return context.WithValue(ctx, clientKey{}, client)
+}
+
+// ClientFromContext returns the client information stored in ctx, if any.
+func ClientFromContext(ctx context.Context) Client {
+	client, _ := ctx.Value(clientKey{}).(Client)
+	return client
+}
+
+// TrustedProxies lists the reverse proxies whose X-Forwarded-For header is
+// believed. The zero value trusts no proxy.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies parses addresses and CIDR ranges such as "10.0.0.1" or
+// "10.0.0.0/8".
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, fmt.Errorf("parse trusted proxy %q: %w", value, err)
+			}
+			proxies = append(proxies, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, fmt.Errorf("parse trusted proxy %q: %w", value, err)
+		}
+		proxies = append(proxies, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return proxies, nil
+}
+
+func (t TrustedProxies) trusts(ip string) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the address of the client that sent a request received
+// from peer. X-Forwarded-For is only consulted when peer is a trusted proxy,
+// and then read from the right, skipping further trusted proxies, so that a
+// client cannot choose the address recorded by prepending its own entries.
+func (t TrustedProxies) clientIP(peer string, forwarded []string) string {
+	if !t.trusts(peer) {
+		return peer
+	}
+	ip := peer
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		entries := strings.Split(forwarded[i], ",")
+		for j := len(entries) - 1; j >= 0; j-- {
+			entry := strings.TrimSpace(entries[j])
+			if entry == "" {
+				continue
+			}
+			ip = entry
+			if !t.trusts(entry) {
+				return ip
+			}
+		}
+	}
+	return ip
+}
+
+// HTTPMiddleware records the remote address and user agent of HTTP requests.
+// Requests relayed by a trusted proxy are attributed to the client named in
+// their X-Forwarded-For header.
+func HTTPMiddleware(proxies TrustedProxies) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := proxies.clientIP(remoteHost(r.RemoteAddr), r.Header.Values("X-Forwarded-For"))
+			ctx := WithClient(r.Context(), Client{IP: ip, UserAgent: r.UserAgent()})
+			h.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// UnaryServerInterceptor records the peer address and user agent of gRPC
+// requests, honouring x-forwarded-for metadata from trusted proxies.
+func UnaryServerInterceptor(proxies TrustedProxies) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		return handler(withGRPCClient(ctx, proxies), req)
+	}
+}
+
+// StreamServerInterceptor records the peer address and user agent of gRPC
+// streams, honouring x-forwarded-for metadata from trusted proxies.
+func StreamServerInterceptor(proxies TrustedProxies) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: withGRPCClient(ss.Context(), proxies)})
+	}
+}
+
+// serverStream overrides the context of a wrapped grpc.ServerStream.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func withGRPCClient(ctx context.Context, proxies TrustedProxies) context.Context {
+	var client Client
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client.IP = remoteHost(p.Addr.String())
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		client.IP = proxies.clientIP(client.IP, md.Get("x-forwarded-for"))
+		if ua := md.Get("user-agent"); len(ua) > 0 {
+			client.UserAgent = ua[0]
+		}
+	}
+	return WithClient(ctx, client)
+}
+
+func remoteHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(goamiddleware.RequestIDKey).(string)
+	return id
+}
+
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
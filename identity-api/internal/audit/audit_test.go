@@ -0,0 +1,158 @@
+package audit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func mustParseTrustedProxies(t *testing.T, values ...string) TrustedProxies {
+	t.Helper()
+	proxies, err := ParseTrustedProxies(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return proxies
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	proxies := mustParseTrustedProxies(t, " 10.0.0.0/8 ", "", "192.168.1.7", "fd00::/8", "10.1.2.3/8")
+	if len(proxies) != 4 {
+		t.Fatalf("parsed %d proxies, want 4", len(proxies))
+	}
+	if got := proxies[3].String(); got != "10.0.0.0/8" {
+		t.Errorf("prefix with host bits = %s, want it masked to 10.0.0.0/8", got)
+	}
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"10.20.30.40", true},
+		{"192.168.1.7", true},
+		{"192.168.1.8", false},
+		{"::ffff:10.0.0.1", true},
+		{"fd12::1", true},
+		{"203.0.113.9", false},
+		{"not-an-ip", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := proxies.trusts(tt.ip); got != tt.want {
+			t.Errorf("trusts(%q) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+
+	for _, bad := range []string{"10.0.0.0/33", "proxy.internal", "10.0.0"} {
+		if _, err := ParseTrustedProxies([]string{bad}); err == nil {
+			t.Errorf("ParseTrustedProxies(%q) error = nil, want an error", bad)
+		}
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	proxies := mustParseTrustedProxies(t, "10.0.0.0/8")
+
+	tests := []struct {
+		name      string
+		proxies   TrustedProxies
+		peer      string
+		forwarded []string
+		want      string
+	}{
+		{name: "no header", proxies: proxies, peer: "203.0.113.9", want: "203.0.113.9"},
+		{name: "untrusted peer with header", proxies: proxies, peer: "203.0.113.9", forwarded: []string{"198.51.100.1"}, want: "203.0.113.9"},
+		{name: "no trusted proxies", peer: "10.0.0.1", forwarded: []string{"198.51.100.1"}, want: "10.0.0.1"},
+		{name: "trusted peer", proxies: proxies, peer: "10.0.0.1", forwarded: []string{"198.51.100.1"}, want: "198.51.100.1"},
+		{name: "trusted peer without header", proxies: proxies, peer: "10.0.0.1", want: "10.0.0.1"},
+		{
+			// The client prepended a spoofed entry; the proxy appended the
+			// address it saw.
+			name: "spoofed entry is ignored", proxies: proxies, peer: "10.0.0.1",
+			forwarded: []string{"1.2.3.4, 198.51.100.1"}, want: "198.51.100.1",
+		},
+		{
+			name: "multi-hop chain read from the right", proxies: proxies, peer: "10.0.0.1",
+			forwarded: []string{"1.2.3.4, 198.51.100.1, 10.0.0.7", "10.0.0.3"}, want: "198.51.100.1",
+		},
+		{name: "blank entries are skipped", proxies: proxies, peer: "10.0.0.1", forwarded: []string{"198.51.100.1, ,", ""}, want: "198.51.100.1"},
+		{name: "only trusted proxies", proxies: proxies, peer: "10.0.0.1", forwarded: []string{"10.0.0.2, 10.0.0.3"}, want: "10.0.0.2"},
+		{name: "untrusted garbage entry", proxies: proxies, peer: "10.0.0.1", forwarded: []string{"unknown"}, want: "unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.proxies.clientIP(tt.peer, tt.forwarded); got != tt.want {
+				t.Errorf("clientIP(%q, %q) = %q, want %q", tt.peer, tt.forwarded, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPMiddleware(t *testing.T) {
+	proxies := mustParseTrustedProxies(t, "10.0.0.0/8")
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		want       string
+	}{
+		{name: "untrusted peer", remoteAddr: "203.0.113.9:5123", forwarded: []string{"198.51.100.1"}, want: "203.0.113.9"},
+		{name: "trusted proxy", remoteAddr: "10.0.0.1:5123", forwarded: []string{"1.2.3.4, 198.51.100.1", "10.0.0.2"}, want: "198.51.100.1"},
+		{name: "IPv6 peer", remoteAddr: "[2001:db8::1]:5123", want: "2001:db8::1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Client
+			h := HTTPMiddleware(proxies)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+				got = ClientFromContext(r.Context())
+			}))
+			req := httptest.NewRequest(http.MethodPost, "/v1/identity/login", nil)
+			req.RemoteAddr = tt.remoteAddr
+			req.Header.Set("User-Agent", "test-agent")
+			for _, value := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			if got.IP != tt.want || got.UserAgent != "test-agent" {
+				t.Errorf("client = %+v, want IP %s and the user agent", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGRPCClient(t *testing.T) {
+	proxies := mustParseTrustedProxies(t, "10.0.0.0/8")
+
+	tests := []struct {
+		name      string
+		peer      string
+		forwarded []string
+		want      string
+	}{
+		{name: "untrusted peer", peer: "203.0.113.9", forwarded: []string{"198.51.100.1"}, want: "203.0.113.9"},
+		{name: "trusted proxy", peer: "10.0.0.1", forwarded: []string{"1.2.3.4, 198.51.100.1"}, want: "198.51.100.1"},
+		{name: "trusted proxy without metadata", peer: "10.0.0.1", want: "10.0.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(tt.peer), Port: 5123}})
+			md := metadata.Pairs("user-agent", "grpc-go/test")
+			for _, value := range tt.forwarded {
+				md.Append("x-forwarded-for", value)
+			}
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got := ClientFromContext(withGRPCClient(ctx, proxies))
+			if got.IP != tt.want || got.UserAgent != "grpc-go/test" {
+				t.Errorf("client = %+v, want IP %s and the user agent", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 )
@@ -12,6 +13,87 @@ type Config struct {
 	GRPCAddr    string `envconfig:"IDENTITY_GRPC_ADDR" default:":9081"`
 	DatabaseURL string `envconfig:"IDENTITY_DATABASE_URL" required:"true"`
 	JWTSecret   string `envconfig:"IDENTITY_JWT_SECRET" default:"dev-secret"`
+	// JWTIssuer is the "iss" claim of issued tokens. JWTAudiences lists the
+	// services tokens may be issued for on request, and JWTAudience is the
+	// one the identity service itself requires and the audience of tokens
+	// that do not request one. JWTLeeway is the tolerated clock skew.
+	JWTIssuer    string        `envconfig:"IDENTITY_JWT_ISSUER" default:"identity-api"`
+	JWTAudiences []string      `envconfig:"IDENTITY_JWT_AUDIENCES" default:"identity-api,dummy-api"`
+	JWTAudience  string        `envconfig:"IDENTITY_JWT_AUDIENCE" default:"identity-api"`
+	JWTLeeway    time.Duration `envconfig:"IDENTITY_JWT_LEEWAY" default:"30s"`
+	AdminEmails  []string      `envconfig:"IDENTITY_ADMIN_EMAILS"`
+	// TrustedProxies lists the addresses or CIDR ranges of reverse proxies
+	// whose X-Forwarded-For header is believed when recording the client
+	// address of audit events. The header is ignored when empty.
+	TrustedProxies []string `envconfig:"IDENTITY_TRUSTED_PROXIES"`
+	// ReservedUsernames extends the built-in list of names nobody can claim.
+	ReservedUsernames []string `envconfig:"IDENTITY_RESERVED_USERNAMES"`
+	// PublicURL is the externally reachable HTTP address, used in links to
+	// resources such as SCIM locations.
+	PublicURL string `envconfig:"IDENTITY_PUBLIC_URL" default:"http://localhost:8081"`
+	// SCIMTokens lists the bearer tokens accepted by /scim/v2; SCIM is
+	// disabled when empty.
+	SCIMTokens []string `envconfig:"IDENTITY_SCIM_TOKENS"`
+	// OAuthClients maps OAuth client ids to secrets, e.g. "gateway:s3cret,proxy:s3cret".
+	OAuthClients map[string]string `envconfig:"IDENTITY_OAUTH_CLIENTS"`
+	// UserStatusCacheTTL is how long token validation caches whether an
+	// account is suspended; zero checks the database for every token.
+	UserStatusCacheTTL time.Duration `envconfig:"IDENTITY_USER_STATUS_CACHE_TTL" default:"30s"`
+	// ImpersonationTTL bounds tokens issued to administrators through exchange_token.
+	ImpersonationTTL time.Duration `envconfig:"IDENTITY_IMPERSONATION_TTL" default:"15m"`
+
+	// TokenAttributeClaims lists the user attributes copied into the custom
+	// claims of issued tokens. Each enricher gets ClaimsEnricherTimeout, and
+	// ClaimsEnricherFailurePolicy ("open" or "closed") decides whether a
+	// failing enricher is skipped or fails the login.
+	TokenAttributeClaims        []string      `envconfig:"IDENTITY_TOKEN_ATTRIBUTE_CLAIMS"`
+	ClaimsEnricherTimeout       time.Duration `envconfig:"IDENTITY_CLAIMS_ENRICHER_TIMEOUT" default:"500ms"`
+	ClaimsEnricherFailurePolicy string        `envconfig:"IDENTITY_CLAIMS_ENRICHER_FAILURE_POLICY" default:"open"`
+
+	MagicLinkEnabled bool          `envconfig:"IDENTITY_MAGIC_LINK_ENABLED" default:"false"`
+	MagicLinkTTL     time.Duration `envconfig:"IDENTITY_MAGIC_LINK_TTL" default:"15m"`
+	MagicLinkURL     string        `envconfig:"IDENTITY_MAGIC_LINK_URL" default:"http://localhost:3000/auth/magic-link"`
+
+	// OpenRegistration allows self-service sign-up; when false new accounts can
+	// only be created by accepting an invitation.
+	OpenRegistration bool          `envconfig:"IDENTITY_OPEN_REGISTRATION" default:"true"`
+	InvitationTTL    time.Duration `envconfig:"IDENTITY_INVITATION_TTL" default:"168h"`
+	InvitationURL    string        `envconfig:"IDENTITY_INVITATION_URL" default:"http://localhost:3000/auth/accept-invitation"`
+
+	// DeviceClientIDs lists the public clients allowed to use the device
+	// authorization grant; an empty list disables it.
+	DeviceClientIDs []string `envconfig:"IDENTITY_DEVICE_CLIENT_IDS" default:"cli"`
+	// DeviceScopes lists the scopes device clients may request; requests for
+	// any other scope are refused.
+	DeviceScopes          []string      `envconfig:"IDENTITY_DEVICE_SCOPES"`
+	DeviceCodeTTL         time.Duration `envconfig:"IDENTITY_DEVICE_CODE_TTL" default:"10m"`
+	DevicePollInterval    time.Duration `envconfig:"IDENTITY_DEVICE_POLL_INTERVAL" default:"5s"`
+	DeviceVerificationURL string        `envconfig:"IDENTITY_DEVICE_VERIFICATION_URL" default:"http://localhost:8081/device.html"`
+
+	// Webhook deliveries are retried with exponential backoff, starting at
+	// WebhookMinBackoff, until WebhookMaxAttempts attempts have failed.
+	WebhookPollInterval time.Duration `envconfig:"IDENTITY_WEBHOOK_POLL_INTERVAL" default:"5s"`
+	WebhookTimeout      time.Duration `envconfig:"IDENTITY_WEBHOOK_TIMEOUT" default:"10s"`
+	WebhookMaxAttempts  int           `envconfig:"IDENTITY_WEBHOOK_MAX_ATTEMPTS" default:"8"`
+	WebhookMinBackoff   time.Duration `envconfig:"IDENTITY_WEBHOOK_MIN_BACKOFF" default:"30s"`
+	WebhookMaxBackoff   time.Duration `envconfig:"IDENTITY_WEBHOOK_MAX_BACKOFF" default:"6h"`
+
+	// Mailer selects the mail transport: "file" writes messages to MailDir,
+	// "smtp" delivers them through SMTPAddr.
+	Mailer       string `envconfig:"IDENTITY_MAILER" default:"file"`
+	MailDir      string `envconfig:"IDENTITY_MAIL_DIR" default:"tmp/mail"`
+	MailFrom     string `envconfig:"IDENTITY_MAIL_FROM" default:"no-reply@localhost"`
+	SMTPAddr     string `envconfig:"IDENTITY_SMTP_ADDR" default:"localhost:25"`
+	SMTPUsername string `envconfig:"IDENTITY_SMTP_USERNAME"`
+	SMTPPassword string `envconfig:"IDENTITY_SMTP_PASSWORD"`
+
+	PasswordMinLength     int    `envconfig:"IDENTITY_PASSWORD_MIN_LENGTH" default:"8"`
+	PasswordMaxLength     int    `envconfig:"IDENTITY_PASSWORD_MAX_LENGTH" default:"72"`
+	PasswordRequireUpper  bool   `envconfig:"IDENTITY_PASSWORD_REQUIRE_UPPER" default:"false"`
+	PasswordRequireLower  bool   `envconfig:"IDENTITY_PASSWORD_REQUIRE_LOWER" default:"true"`
+	PasswordRequireDigit  bool   `envconfig:"IDENTITY_PASSWORD_REQUIRE_DIGIT" default:"true"`
+	PasswordRequireSymbol bool   `envconfig:"IDENTITY_PASSWORD_REQUIRE_SYMBOL" default:"false"`
+	BreachedPasswordsFile string `envconfig:"IDENTITY_BREACHED_PASSWORDS_FILE"`
 }
 
 // Load reads environment variables into Config.
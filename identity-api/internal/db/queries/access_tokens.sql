@@ -0,0 +1,31 @@
+-- name: CreateAccessToken :exec
+INSERT INTO access_tokens (
+    token_hash,
+    user_id,
+    expires_at,
+    audience,
+    claims
+) VALUES (
+    $1, $2, $3, $4, $5
+);
+
+-- name: GetAccessToken :one
+SELECT
+    access_tokens.token_hash,
+    access_tokens.user_id,
+    access_tokens.created_at,
+    access_tokens.expires_at,
+    access_tokens.revoked_at,
+    access_tokens.audience,
+    access_tokens.claims,
+    users.email,
+    users.status
+FROM access_tokens
+JOIN users ON users.id = access_tokens.user_id
+WHERE access_tokens.token_hash = $1;
+
+-- name: RevokeAccessToken :one
+UPDATE access_tokens
+SET revoked_at = NOW()
+WHERE token_hash = $1 AND revoked_at IS NULL
+RETURNING user_id;
@@ -0,0 +1,48 @@
+-- name: CreateDeviceAuthorization :one
+INSERT INTO device_authorizations (
+    device_code_hash,
+    user_code,
+    client_id,
+    scope,
+    poll_interval,
+    expires_at,
+    audience
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: ApproveDeviceAuthorization :one
+UPDATE device_authorizations
+SET approved_at = NOW(),
+    user_id = $2
+WHERE user_code = $1
+  AND approved_at IS NULL
+  AND denied_at IS NULL
+  AND expires_at > NOW()
+RETURNING *;
+
+-- name: DenyDeviceAuthorization :one
+UPDATE device_authorizations
+SET denied_at = NOW(),
+    user_id = $2
+WHERE user_code = $1
+  AND approved_at IS NULL
+  AND denied_at IS NULL
+  AND expires_at > NOW()
+RETURNING *;
+
+-- name: GetDeviceAuthorizationForUpdate :one
+SELECT * FROM device_authorizations
+WHERE device_code_hash = $1
+FOR UPDATE;
+
+-- name: RecordDeviceAuthorizationPoll :exec
+UPDATE device_authorizations
+SET last_polled_at = NOW(),
+    poll_interval = $2
+WHERE id = $1;
+
+-- name: ConsumeDeviceAuthorization :exec
+UPDATE device_authorizations
+SET consumed_at = NOW()
+WHERE id = $1;
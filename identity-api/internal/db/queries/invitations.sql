@@ -0,0 +1,40 @@
+-- name: CreateInvitation :one
+INSERT INTO invitations (
+    token_hash,
+    email,
+    display_name,
+    attributes,
+    invited_by,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListInvitationsByInviter :many
+SELECT * FROM invitations
+WHERE invited_by = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: RevokeInvitation :one
+UPDATE invitations
+SET revoked_at = NOW()
+WHERE id = $1
+  AND invited_by = $2
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL
+RETURNING *;
+
+-- name: GetPendingInvitationForUpdate :one
+SELECT * FROM invitations
+WHERE token_hash = $1
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL
+  AND expires_at > NOW()
+FOR UPDATE;
+
+-- name: MarkInvitationAccepted :exec
+UPDATE invitations
+SET accepted_at = NOW(),
+    accepted_user_id = $2
+WHERE id = $1;
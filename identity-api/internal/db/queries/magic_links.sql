@@ -0,0 +1,15 @@
+-- name: CreateMagicLink :one
+INSERT INTO magic_links (
+    user_id,
+    expires_at
+) VALUES (
+    $1, $2
+) RETURNING *;
+
+-- name: ConsumeMagicLink :one
+UPDATE magic_links
+SET consumed_at = NOW()
+WHERE id = $1
+  AND consumed_at IS NULL
+  AND expires_at > NOW()
+RETURNING *;
@@ -2,13 +2,18 @@
 INSERT INTO users (
     email,
     password_hash,
-    display_name
+    display_name,
+    attributes,
+    username
 ) VALUES (
-    $1, $2, $3
+    $1, $2, $3, $4, $5
 ) RETURNING *;
 
 -- name: GetUserByEmail :one
-SELECT * FROM users WHERE email = $1;
+SELECT * FROM users WHERE lower(email) = lower(sqlc.arg(email));
+
+-- name: GetUserByUsername :one
+SELECT * FROM users WHERE lower(username) = lower(sqlc.arg(username));
 
 -- name: GetUserByID :one
 SELECT * FROM users WHERE id = $1;
@@ -17,3 +22,54 @@ SELECT * FROM users WHERE id = $1;
 SELECT * FROM users
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2;
+
+-- name: CountUsers :one
+SELECT COUNT(*) FROM users;
+
+-- name: CreateProvisionedUser :one
+INSERT INTO users (
+    email,
+    password_hash,
+    display_name,
+    external_id,
+    status
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: GetUserByExternalID :one
+SELECT * FROM users WHERE external_id = $1;
+
+-- name: UpdateProvisionedUser :one
+UPDATE users
+SET email = $2,
+    display_name = $3,
+    external_id = $4,
+    status = $5,
+    status_reason = CASE WHEN status = $5 THEN status_reason ELSE $6 END,
+    status_changed_at = CASE WHEN status = $5 THEN status_changed_at ELSE NOW() END,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteUser :execrows
+DELETE FROM users WHERE id = $1;
+
+-- name: GetUserStatus :one
+SELECT status FROM users WHERE id = $1;
+
+-- name: SetUserStatus :one
+UPDATE users
+SET status = $2,
+    status_reason = $3,
+    status_changed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: SetUsername :one
+UPDATE users
+SET username = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
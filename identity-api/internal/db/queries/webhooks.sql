@@ -0,0 +1,71 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (
+    url,
+    secret,
+    event_types,
+    created_by
+) VALUES (
+    $1, $2, $3, $4
+) RETURNING *;
+
+-- name: ListWebhookSubscriptions :many
+SELECT * FROM webhook_subscriptions
+ORDER BY created_at DESC;
+
+-- name: GetWebhookSubscription :one
+SELECT * FROM webhook_subscriptions WHERE id = $1;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions WHERE id = $1;
+
+-- name: CreateWebhookDeliveries :exec
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_type,
+    payload
+)
+SELECT id, sqlc.arg(event_type)::text, sqlc.arg(payload)::jsonb
+FROM webhook_subscriptions
+WHERE active
+  AND (cardinality(event_types) = 0 OR sqlc.arg(event_type)::text = ANY(event_types));
+
+-- name: ClaimWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_at = NOW() + sqlc.arg(lease_seconds)::int * INTERVAL '1 second'
+WHERE id IN (
+    SELECT d.id FROM webhook_deliveries d
+    WHERE d.status = 'pending'
+      AND d.next_attempt_at <= NOW()
+    ORDER BY d.next_attempt_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: RecordWebhookAttempt :exec
+UPDATE webhook_deliveries
+SET status = $2,
+    attempts = attempts + 1,
+    last_attempt_at = NOW(),
+    response_status = $3,
+    last_error = $4,
+    next_attempt_at = $5,
+    delivered_at = CASE WHEN $2 = 'succeeded' THEN NOW() END
+WHERE id = $1;
+
+-- name: ListWebhookDeliveries :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: RedeliverWebhookDelivery :one
+INSERT INTO webhook_deliveries (
+    subscription_id,
+    event_type,
+    payload
+)
+SELECT subscription_id, event_type, payload
+FROM webhook_deliveries
+WHERE webhook_deliveries.id = $1
+RETURNING *;
@@ -8,10 +8,104 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AccessToken struct {
+	TokenHash []byte             `json:"token_hash"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	RevokedAt pgtype.Timestamptz `json:"revoked_at"`
+	Audience  []string           `json:"audience"`
+	Claims    []byte             `json:"claims"`
+}
+
+type AuthEvent struct {
+	ID        int64              `json:"id"`
+	EventType string             `json:"event_type"`
+	Success   bool               `json:"success"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	Email     *string            `json:"email"`
+	Reason    *string            `json:"reason"`
+	IpAddress *string            `json:"ip_address"`
+	UserAgent *string            `json:"user_agent"`
+	RequestID *string            `json:"request_id"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type DeviceAuthorization struct {
+	ID             pgtype.UUID        `json:"id"`
+	DeviceCodeHash []byte             `json:"device_code_hash"`
+	UserCode       string             `json:"user_code"`
+	ClientID       string             `json:"client_id"`
+	Scope          *string            `json:"scope"`
+	PollInterval   int32              `json:"poll_interval"`
+	UserID         pgtype.UUID        `json:"user_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	LastPolledAt   pgtype.Timestamptz `json:"last_polled_at"`
+	ApprovedAt     pgtype.Timestamptz `json:"approved_at"`
+	DeniedAt       pgtype.Timestamptz `json:"denied_at"`
+	ConsumedAt     pgtype.Timestamptz `json:"consumed_at"`
+	Audience       []string           `json:"audience"`
+}
+
+type Invitation struct {
+	ID             pgtype.UUID        `json:"id"`
+	TokenHash      []byte             `json:"token_hash"`
+	Email          string             `json:"email"`
+	DisplayName    *string            `json:"display_name"`
+	Attributes     []byte             `json:"attributes"`
+	InvitedBy      pgtype.UUID        `json:"invited_by"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	AcceptedAt     pgtype.Timestamptz `json:"accepted_at"`
+	AcceptedUserID pgtype.UUID        `json:"accepted_user_id"`
+	RevokedAt      pgtype.Timestamptz `json:"revoked_at"`
+}
+
+type MagicLink struct {
+	ID         pgtype.UUID        `json:"id"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	ConsumedAt pgtype.Timestamptz `json:"consumed_at"`
+}
+
 type User struct {
-	ID           pgtype.UUID        `json:"id"`
-	Email        string             `json:"email"`
-	PasswordHash string             `json:"password_hash"`
-	DisplayName  string             `json:"display_name"`
-	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+	ID              pgtype.UUID        `json:"id"`
+	Email           string             `json:"email"`
+	PasswordHash    *string            `json:"password_hash"`
+	DisplayName     string             `json:"display_name"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	Attributes      []byte             `json:"attributes"`
+	ExternalID      *string            `json:"external_id"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+	Status          string             `json:"status"`
+	StatusReason    *string            `json:"status_reason"`
+	StatusChangedAt pgtype.Timestamptz `json:"status_changed_at"`
+	Username        *string            `json:"username"`
+}
+
+type WebhookDelivery struct {
+	ID             pgtype.UUID        `json:"id"`
+	SubscriptionID pgtype.UUID        `json:"subscription_id"`
+	EventType      string             `json:"event_type"`
+	Payload        []byte             `json:"payload"`
+	Status         string             `json:"status"`
+	Attempts       int32              `json:"attempts"`
+	NextAttemptAt  pgtype.Timestamptz `json:"next_attempt_at"`
+	LastAttemptAt  pgtype.Timestamptz `json:"last_attempt_at"`
+	ResponseStatus *int32             `json:"response_status"`
+	LastError      *string            `json:"last_error"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	DeliveredAt    pgtype.Timestamptz `json:"delivered_at"`
+}
+
+type WebhookSubscription struct {
+	ID         pgtype.UUID        `json:"id"`
+	Url        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []string           `json:"event_types"`
+	Active     bool               `json:"active"`
+	CreatedBy  pgtype.UUID        `json:"created_by"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
 }
@@ -11,10 +11,47 @@ import (
 )
 
 type Querier interface {
+	ApproveDeviceAuthorization(ctx context.Context, arg ApproveDeviceAuthorizationParams) (DeviceAuthorization, error)
+	ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ConsumeDeviceAuthorization(ctx context.Context, id pgtype.UUID) error
+	ConsumeMagicLink(ctx context.Context, id pgtype.UUID) (MagicLink, error)
+	CountUsers(ctx context.Context) (int64, error)
+	CreateAccessToken(ctx context.Context, arg CreateAccessTokenParams) error
+	CreateAuthEvent(ctx context.Context, arg CreateAuthEventParams) error
+	CreateDeviceAuthorization(ctx context.Context, arg CreateDeviceAuthorizationParams) (DeviceAuthorization, error)
+	CreateInvitation(ctx context.Context, arg CreateInvitationParams) (Invitation, error)
+	CreateMagicLink(ctx context.Context, arg CreateMagicLinkParams) (MagicLink, error)
+	CreateProvisionedUser(ctx context.Context, arg CreateProvisionedUserParams) (User, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreateWebhookDeliveries(ctx context.Context, arg CreateWebhookDeliveriesParams) error
+	CreateWebhookSubscription(ctx context.Context, arg CreateWebhookSubscriptionParams) (WebhookSubscription, error)
+	DeleteUser(ctx context.Context, id pgtype.UUID) (int64, error)
+	DeleteWebhookSubscription(ctx context.Context, id pgtype.UUID) (int64, error)
+	DenyDeviceAuthorization(ctx context.Context, arg DenyDeviceAuthorizationParams) (DeviceAuthorization, error)
+	ExportAuthEvents(ctx context.Context, arg ExportAuthEventsParams) ([]AuthEvent, error)
+	GetAccessToken(ctx context.Context, tokenHash []byte) (GetAccessTokenRow, error)
+	GetDeviceAuthorizationForUpdate(ctx context.Context, deviceCodeHash []byte) (DeviceAuthorization, error)
+	GetPendingInvitationForUpdate(ctx context.Context, tokenHash []byte) (Invitation, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserByExternalID(ctx context.Context, externalID *string) (User, error)
 	GetUserByID(ctx context.Context, id pgtype.UUID) (User, error)
+	GetUserByUsername(ctx context.Context, username string) (User, error)
+	GetUserStatus(ctx context.Context, id pgtype.UUID) (string, error)
+	GetWebhookSubscription(ctx context.Context, id pgtype.UUID) (WebhookSubscription, error)
+	ListAuthEvents(ctx context.Context, arg ListAuthEventsParams) ([]AuthEvent, error)
+	ListInvitationsByInviter(ctx context.Context, arg ListInvitationsByInviterParams) ([]Invitation, error)
 	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]WebhookSubscription, error)
+	MarkInvitationAccepted(ctx context.Context, arg MarkInvitationAcceptedParams) error
+	RecordDeviceAuthorizationPoll(ctx context.Context, arg RecordDeviceAuthorizationPollParams) error
+	RecordWebhookAttempt(ctx context.Context, arg RecordWebhookAttemptParams) error
+	RedeliverWebhookDelivery(ctx context.Context, id pgtype.UUID) (WebhookDelivery, error)
+	RevokeAccessToken(ctx context.Context, tokenHash []byte) (pgtype.UUID, error)
+	RevokeInvitation(ctx context.Context, arg RevokeInvitationParams) (Invitation, error)
+	SetUserStatus(ctx context.Context, arg SetUserStatusParams) (User, error)
+	SetUsername(ctx context.Context, arg SetUsernameParams) (User, error)
+	UpdateProvisionedUser(ctx context.Context, arg UpdateProvisionedUserParams) (User, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -11,24 +11,91 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countUsers = `-- name: CountUsers :one
+SELECT COUNT(*) FROM users
+`
+
+func (q *Queries) CountUsers(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, countUsers)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createProvisionedUser = `-- name: CreateProvisionedUser :one
+INSERT INTO users (
+    email,
+    password_hash,
+    display_name,
+    external_id,
+    status
+) VALUES (
+    $1, $2, $3, $4, $5
+) RETURNING id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username
+`
+
+type CreateProvisionedUserParams struct {
+	Email        string  `json:"email"`
+	PasswordHash *string `json:"password_hash"`
+	DisplayName  string  `json:"display_name"`
+	ExternalID   *string `json:"external_id"`
+	Status       string  `json:"status"`
+}
+
+func (q *Queries) CreateProvisionedUser(ctx context.Context, arg CreateProvisionedUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, createProvisionedUser,
+		arg.Email,
+		arg.PasswordHash,
+		arg.DisplayName,
+		arg.ExternalID,
+		arg.Status,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
+	)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
     email,
     password_hash,
-    display_name
+    display_name,
+    attributes,
+    username
 ) VALUES (
-    $1, $2, $3
-) RETURNING id, email, password_hash, display_name, created_at
+    $1, $2, $3, $4, $5
+) RETURNING id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username
 `
 
 type CreateUserParams struct {
-	Email        string `json:"email"`
-	PasswordHash string `json:"password_hash"`
-	DisplayName  string `json:"display_name"`
+	Email        string  `json:"email"`
+	PasswordHash *string `json:"password_hash"`
+	DisplayName  string  `json:"display_name"`
+	Attributes   []byte  `json:"attributes"`
+	Username     *string `json:"username"`
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRow(ctx, createUser, arg.Email, arg.PasswordHash, arg.DisplayName)
+	row := q.db.QueryRow(ctx, createUser,
+		arg.Email,
+		arg.PasswordHash,
+		arg.DisplayName,
+		arg.Attributes,
+		arg.Username,
+	)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -36,12 +103,31 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.PasswordHash,
 		&i.DisplayName,
 		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
 	)
 	return i, err
 }
 
+const deleteUser = `-- name: DeleteUser :execrows
+DELETE FROM users WHERE id = $1
+`
+
+func (q *Queries) DeleteUser(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUser, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, display_name, created_at FROM users WHERE email = $1
+SELECT id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username FROM users WHERE lower(email) = lower($1)
 `
 
 func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error) {
@@ -53,12 +139,43 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.PasswordHash,
 		&i.DisplayName,
 		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
+	)
+	return i, err
+}
+
+const getUserByExternalID = `-- name: GetUserByExternalID :one
+SELECT id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username FROM users WHERE external_id = $1
+`
+
+func (q *Queries) GetUserByExternalID(ctx context.Context, externalID *string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByExternalID, externalID)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash, display_name, created_at FROM users WHERE id = $1
+SELECT id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username FROM users WHERE id = $1
 `
 
 func (q *Queries) GetUserByID(ctx context.Context, id pgtype.UUID) (User, error) {
@@ -70,12 +187,54 @@ func (q *Queries) GetUserByID(ctx context.Context, id pgtype.UUID) (User, error)
 		&i.PasswordHash,
 		&i.DisplayName,
 		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
 	)
 	return i, err
 }
 
+const getUserByUsername = `-- name: GetUserByUsername :one
+SELECT id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username FROM users WHERE lower(username) = lower($1)
+`
+
+func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByUsername, username)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
+	)
+	return i, err
+}
+
+const getUserStatus = `-- name: GetUserStatus :one
+SELECT status FROM users WHERE id = $1
+`
+
+func (q *Queries) GetUserStatus(ctx context.Context, id pgtype.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getUserStatus, id)
+	var status string
+	err := row.Scan(&status)
+	return status, err
+}
+
 const listUsers = `-- name: ListUsers :many
-SELECT id, email, password_hash, display_name, created_at FROM users
+SELECT id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username FROM users
 ORDER BY created_at DESC
 LIMIT $1 OFFSET $2
 `
@@ -100,6 +259,13 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 			&i.PasswordHash,
 			&i.DisplayName,
 			&i.CreatedAt,
+			&i.Attributes,
+			&i.ExternalID,
+			&i.UpdatedAt,
+			&i.Status,
+			&i.StatusReason,
+			&i.StatusChangedAt,
+			&i.Username,
 		); err != nil {
 			return nil, err
 		}
@@ -110,3 +276,121 @@ func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, e
 	}
 	return items, nil
 }
+
+const setUserStatus = `-- name: SetUserStatus :one
+UPDATE users
+SET status = $2,
+    status_reason = $3,
+    status_changed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username
+`
+
+type SetUserStatusParams struct {
+	ID           pgtype.UUID `json:"id"`
+	Status       string      `json:"status"`
+	StatusReason *string     `json:"status_reason"`
+}
+
+func (q *Queries) SetUserStatus(ctx context.Context, arg SetUserStatusParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUserStatus, arg.ID, arg.Status, arg.StatusReason)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
+	)
+	return i, err
+}
+
+const setUsername = `-- name: SetUsername :one
+UPDATE users
+SET username = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username
+`
+
+type SetUsernameParams struct {
+	ID       pgtype.UUID `json:"id"`
+	Username *string     `json:"username"`
+}
+
+func (q *Queries) SetUsername(ctx context.Context, arg SetUsernameParams) (User, error) {
+	row := q.db.QueryRow(ctx, setUsername, arg.ID, arg.Username)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
+	)
+	return i, err
+}
+
+const updateProvisionedUser = `-- name: UpdateProvisionedUser :one
+UPDATE users
+SET email = $2,
+    display_name = $3,
+    external_id = $4,
+    status = $5,
+    status_reason = CASE WHEN status = $5 THEN status_reason ELSE $6 END,
+    status_changed_at = CASE WHEN status = $5 THEN status_changed_at ELSE NOW() END,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, password_hash, display_name, created_at, attributes, external_id, updated_at, status, status_reason, status_changed_at, username
+`
+
+type UpdateProvisionedUserParams struct {
+	ID           pgtype.UUID `json:"id"`
+	Email        string      `json:"email"`
+	DisplayName  string      `json:"display_name"`
+	ExternalID   *string     `json:"external_id"`
+	Status       string      `json:"status"`
+	StatusReason *string     `json:"status_reason"`
+}
+
+func (q *Queries) UpdateProvisionedUser(ctx context.Context, arg UpdateProvisionedUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateProvisionedUser,
+		arg.ID,
+		arg.Email,
+		arg.DisplayName,
+		arg.ExternalID,
+		arg.Status,
+		arg.StatusReason,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.DisplayName,
+		&i.CreatedAt,
+		&i.Attributes,
+		&i.ExternalID,
+		&i.UpdatedAt,
+		&i.Status,
+		&i.StatusReason,
+		&i.StatusChangedAt,
+		&i.Username,
+	)
+	return i, err
+}
@@ -0,0 +1,31 @@
+package security
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+)
+
+// ClientRegistry holds the credentials of OAuth clients allowed to call
+// client-authenticated endpoints such as token introspection.
+type ClientRegistry struct {
+	secrets map[string][sha256.Size]byte
+}
+
+// NewClientRegistry builds a registry from client id to client secret pairs.
+func NewClientRegistry(secrets map[string]string) *ClientRegistry {
+	r := &ClientRegistry{secrets: make(map[string][sha256.Size]byte, len(secrets))}
+	for id, secret := range secrets {
+		r.secrets[id] = sha256.Sum256([]byte(secret))
+	}
+	return r
+}
+
+// Authenticate reports whether the secret matches the registered client.
+func (r *ClientRegistry) Authenticate(clientID, secret string) bool {
+	expected, ok := r.secrets[clientID]
+	if !ok {
+		return false
+	}
+	actual := sha256.Sum256([]byte(secret))
+	return subtle.ConstantTimeCompare(expected[:], actual[:]) == 1
+}
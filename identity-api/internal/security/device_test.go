@@ -0,0 +1,46 @@
+package security
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestNormalizeUserCode(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "WDJB-MJHT", want: "WDJB-MJHT"},
+		{input: "wdjb-mjht", want: "WDJB-MJHT"},
+		{input: "WDJBMJHT", want: "WDJB-MJHT"},
+		{input: " wdjb mjht\n", want: "WDJB-MJHT"},
+		{input: "WD.JB_MJ/HT", want: "WDJB-MJHT"},
+		// Characters outside the alphabet are dropped, so the result is
+		// left unformatted unless eight valid characters remain.
+		{input: "WDJB-MJH", want: "WDJBMJH"},
+		{input: "WDJB-MJHTX", want: "WDJBMJHTX"},
+		{input: "WDJB-MJH0", want: "WDJBMJH"},
+		{input: "AEIOU-1234", want: ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizeUserCode(tt.input); got != tt.want {
+			t.Errorf("NormalizeUserCode(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNewUserCode(t *testing.T) {
+	format := regexp.MustCompile(`^[` + userCodeAlphabet + `]{4}-[` + userCodeAlphabet + `]{4}$`)
+	for range 100 {
+		code, err := NewUserCode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !format.MatchString(code) {
+			t.Fatalf("NewUserCode() = %q, want XXXX-XXXX from %s", code, userCodeAlphabet)
+		}
+		if got := NormalizeUserCode(code); got != code {
+			t.Fatalf("NormalizeUserCode(%q) = %q, want it unchanged", code, got)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package security
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+// customClaim is the JWT claim holding the claims added by enrichers. Keeping
+// them in one object means they can never shadow registered claims.
+const customClaim = "ext"
+
+// defaultEnricherTimeout bounds an enricher registered without a timeout.
+const defaultEnricherTimeout = 500 * time.Millisecond
+
+// ClaimsEnricher contributes custom claims, such as a plan, locale or feature
+// cohort, to the access tokens of a user. Enrich must honour ctx, which is
+// cancelled when the enricher's timeout expires.
+type ClaimsEnricher interface {
+	Enrich(ctx context.Context, user db.User) (map[string]string, error)
+}
+
+// EnricherFunc adapts a function to the ClaimsEnricher interface.
+type EnricherFunc func(ctx context.Context, user db.User) (map[string]string, error)
+
+// Enrich calls f.
+func (f EnricherFunc) Enrich(ctx context.Context, user db.User) (map[string]string, error) {
+	return f(ctx, user)
+}
+
+// FailurePolicy decides what happens to a login when an enricher fails or
+// times out.
+type FailurePolicy string
+
+const (
+	// FailOpen issues the token without the enricher's claims.
+	FailOpen FailurePolicy = "open"
+	// FailClosed rejects the login.
+	FailClosed FailurePolicy = "closed"
+)
+
+// ParseFailurePolicy converts a configuration value into a FailurePolicy.
+func ParseFailurePolicy(value string) (FailurePolicy, error) {
+	switch policy := FailurePolicy(value); policy {
+	case FailOpen, FailClosed:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown enricher failure policy %q", value)
+	}
+}
+
+// EnricherOptions configures a registered enricher.
+type EnricherOptions struct {
+	Timeout time.Duration
+	Policy  FailurePolicy
+}
+
+// EnricherRegistry runs the registered enrichers when a token is issued.
+// A nil registry adds no claims.
+type EnricherRegistry struct {
+	entries []enricherEntry
+}
+
+type enricherEntry struct {
+	name     string
+	enricher ClaimsEnricher
+	opts     EnricherOptions
+}
+
+// EnrichmentError reports an enricher that failed under the FailClosed
+// policy.
+type EnrichmentError struct {
+	Enricher string
+	Err      error
+}
+
+func (e *EnrichmentError) Error() string {
+	return fmt.Sprintf("claims enricher %s: %v", e.Enricher, e.Err)
+}
+
+func (e *EnrichmentError) Unwrap() error {
+	return e.Err
+}
+
+// NewEnricherRegistry creates an empty EnricherRegistry.
+func NewEnricherRegistry() *EnricherRegistry {
+	return &EnricherRegistry{}
+}
+
+// Register adds an enricher under name. Enrichers run concurrently; when two
+// of them set the same claim the one registered last wins.
+func (r *EnricherRegistry) Register(name string, enricher ClaimsEnricher, opts EnricherOptions) {
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultEnricherTimeout
+	}
+	if opts.Policy == "" {
+		opts.Policy = FailOpen
+	}
+	r.entries = append(r.entries, enricherEntry{name: name, enricher: enricher, opts: opts})
+}
+
+// Enrich runs every enricher for user and merges their claims. Failures of
+// FailOpen enrichers are passed to onFailure and otherwise ignored; the first
+// failure of a FailClosed enricher is returned as *EnrichmentError.
+func (r *EnricherRegistry) Enrich(ctx context.Context, user db.User, onFailure func(name string, err error)) (map[string]string, error) {
+	if r == nil || len(r.entries) == 0 {
+		return nil, nil
+	}
+
+	type outcome struct {
+		claims map[string]string
+		err    error
+	}
+	outcomes := make([]chan outcome, len(r.entries))
+	for i, entry := range r.entries {
+		done := make(chan outcome, 1)
+		outcomes[i] = done
+		go func() {
+			ectx, cancel := context.WithTimeout(ctx, entry.opts.Timeout)
+			defer cancel()
+
+			result := make(chan outcome, 1)
+			go func() {
+				claims, err := entry.enricher.Enrich(ectx, user)
+				result <- outcome{claims: claims, err: err}
+			}()
+			// An enricher that ignores ctx must not hold up the login.
+			select {
+			case o := <-result:
+				done <- o
+			case <-ectx.Done():
+				done <- outcome{err: ectx.Err()}
+			}
+		}()
+	}
+
+	var merged map[string]string
+	var closedErr error
+	for i, entry := range r.entries {
+		o := <-outcomes[i]
+		if o.err != nil {
+			if entry.opts.Policy == FailClosed {
+				if closedErr == nil {
+					closedErr = &EnrichmentError{Enricher: entry.name, Err: o.err}
+				}
+			} else if onFailure != nil {
+				onFailure(entry.name, o.err)
+			}
+			continue
+		}
+		for k, v := range o.claims {
+			if merged == nil {
+				merged = make(map[string]string)
+			}
+			merged[k] = v
+		}
+	}
+	if closedErr != nil {
+		return nil, closedErr
+	}
+	return merged, nil
+}
+
+// AttributeEnricher copies the named user attributes, such as those assigned
+// through invitations, into claims of the same name. Missing attributes are
+// skipped.
+func AttributeEnricher(keys []string) ClaimsEnricher {
+	return EnricherFunc(func(_ context.Context, user db.User) (map[string]string, error) {
+		if len(user.Attributes) == 0 {
+			return nil, nil
+		}
+		var attrs map[string]string
+		if err := json.Unmarshal(user.Attributes, &attrs); err != nil {
+			return nil, fmt.Errorf("decode user attributes: %w", err)
+		}
+		claims := make(map[string]string, len(keys))
+		for _, key := range keys {
+			if value, ok := attrs[key]; ok {
+				claims[key] = value
+			}
+		}
+		return claims, nil
+	})
+}
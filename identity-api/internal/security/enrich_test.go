@@ -0,0 +1,123 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+func TestEnrichedClaimsInExt(t *testing.T) {
+	m := newTestTokenManager()
+	user := testUser()
+
+	registry := NewEnricherRegistry()
+	registry.Register("plan", EnricherFunc(func(context.Context, db.User) (map[string]string, error) {
+		return map[string]string{"plan": "pro"}, nil
+	}), EnricherOptions{})
+	// An enricher cannot take over the registered claims by naming them.
+	registry.Register("hostile", EnricherFunc(func(context.Context, db.User) (map[string]string, error) {
+		return map[string]string{"sub": "someone-else", "aud": "billing-api", "act": "admin", "iss": "evil"}, nil
+	}), EnricherOptions{})
+
+	custom, err := registry.Enrich(context.Background(), user, nil)
+	if err != nil {
+		t.Fatalf("Enrich() error = %v", err)
+	}
+	token, _, err := m.Issue(user, []string{"dummy-api"}, custom)
+	if err != nil {
+		t.Fatalf("Issue() error = %v", err)
+	}
+
+	raw, err := m.parse(token)
+	if err != nil {
+		t.Fatalf("parse() error = %v", err)
+	}
+	if raw["sub"] != user.ID.String() {
+		t.Errorf("sub = %v, want %s", raw["sub"], user.ID.String())
+	}
+	if raw["iss"] != "identity-api" {
+		t.Errorf("iss = %v, want identity-api", raw["iss"])
+	}
+	if _, ok := raw["act"]; ok {
+		t.Errorf("act = %v, want no actor", raw["act"])
+	}
+	if _, ok := raw["plan"]; ok {
+		t.Error("plan claim set at the top level, want it under ext")
+	}
+
+	claims, err := m.Validate(token, "dummy-api")
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if !slices.Equal(claims.Audience, []string{"dummy-api"}) {
+		t.Errorf("audience = %q, want [dummy-api]", claims.Audience)
+	}
+	if claims.Actor != nil {
+		t.Errorf("actor = %+v, want nil", claims.Actor)
+	}
+	want := map[string]string{"plan": "pro", "sub": "someone-else", "aud": "billing-api", "act": "admin", "iss": "evil"}
+	if len(claims.Custom) != len(want) {
+		t.Fatalf("custom claims = %v, want %v", claims.Custom, want)
+	}
+	for k, v := range want {
+		if claims.Custom[k] != v {
+			t.Errorf("ext[%q] = %q, want %q", k, claims.Custom[k], v)
+		}
+	}
+}
+
+func TestEnrichFailurePolicy(t *testing.T) {
+	failing := EnricherFunc(func(context.Context, db.User) (map[string]string, error) {
+		return nil, errors.New("profile service unavailable")
+	})
+	ignoresContext := EnricherFunc(func(context.Context, db.User) (map[string]string, error) {
+		time.Sleep(time.Second)
+		return map[string]string{"late": "true"}, nil
+	})
+	locale := EnricherFunc(func(context.Context, db.User) (map[string]string, error) {
+		return map[string]string{"locale": "en"}, nil
+	})
+
+	t.Run("fail open", func(t *testing.T) {
+		registry := NewEnricherRegistry()
+		registry.Register("profile", failing, EnricherOptions{Policy: FailOpen})
+		registry.Register("slow", ignoresContext, EnricherOptions{Timeout: 10 * time.Millisecond})
+		registry.Register("locale", locale, EnricherOptions{})
+
+		var failed []string
+		claims, err := registry.Enrich(context.Background(), testUser(), func(name string, err error) {
+			failed = append(failed, name)
+		})
+		if err != nil {
+			t.Fatalf("Enrich() error = %v", err)
+		}
+		if len(claims) != 1 || claims["locale"] != "en" {
+			t.Errorf("claims = %v, want only locale", claims)
+		}
+		if !slices.Equal(failed, []string{"profile", "slow"}) {
+			t.Errorf("failed enrichers = %q, want [profile slow]", failed)
+		}
+	})
+
+	t.Run("fail closed", func(t *testing.T) {
+		registry := NewEnricherRegistry()
+		registry.Register("locale", locale, EnricherOptions{})
+		registry.Register("slow", ignoresContext, EnricherOptions{Timeout: 10 * time.Millisecond, Policy: FailClosed})
+
+		claims, err := registry.Enrich(context.Background(), testUser(), nil)
+		var enrichErr *EnrichmentError
+		if !errors.As(err, &enrichErr) || enrichErr.Enricher != "slow" {
+			t.Fatalf("Enrich() error = %v, want an EnrichmentError for slow", err)
+		}
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Enrich() error = %v, want it to wrap context.DeadlineExceeded", err)
+		}
+		if claims != nil {
+			t.Errorf("claims = %v, want none", claims)
+		}
+	})
+}
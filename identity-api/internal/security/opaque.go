@@ -0,0 +1,35 @@
+package security
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// opaqueTokenBytes is the amount of randomness in an opaque access token.
+const opaqueTokenBytes = 32
+
+// NewOpaqueToken returns a random opaque access token together with the hash
+// that is persisted server side. The token itself is never stored.
+func NewOpaqueToken() (string, []byte, error) {
+	buf := make([]byte, opaqueTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", nil, fmt.Errorf("generate opaque token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, HashOpaqueToken(token), nil
+}
+
+// HashOpaqueToken returns the lookup hash of an opaque token.
+func HashOpaqueToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// IsJWT reports whether the token has the compact JWS shape of a JWT rather
+// than being an opaque token.
+func IsJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
@@ -0,0 +1,203 @@
+package security
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Password policy rule identifiers reported in PolicyViolation.Rule.
+const (
+	RuleRequired         = "required"
+	RuleMinLength        = "min_length"
+	RuleMaxLength        = "max_length"
+	RuleUppercase        = "uppercase"
+	RuleLowercase        = "lowercase"
+	RuleDigit            = "digit"
+	RuleSymbol           = "symbol"
+	RuleContainsEmail    = "contains_email"
+	RuleContainsName     = "contains_display_name"
+	RuleBreachedPassword = "breached"
+)
+
+// bcryptMaxBytes is the longest password bcrypt can hash. Multibyte
+// characters count several times against it, so it applies on top of
+// MaxLength, which counts characters.
+const bcryptMaxBytes = 72
+
+// PasswordPolicy describes the server-side rules a password must satisfy.
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	Breached      *BreachedCorpus
+}
+
+// PolicyViolation describes a single failed password rule.
+type PolicyViolation struct {
+	Rule    string
+	Message string
+}
+
+// Check evaluates every rule and returns all violations. The email and display
+// name are used to reject passwords that embed the user's own identifiers.
+func (p *PasswordPolicy) Check(password, email, displayName string) []PolicyViolation {
+	var violations []PolicyViolation
+	fail := func(rule, format string, args ...any) {
+		violations = append(violations, PolicyViolation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+	}
+
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		fail(RuleMinLength, "password must be at least %d characters", p.MinLength)
+	}
+	switch {
+	case p.MaxLength > 0 && length > p.MaxLength:
+		fail(RuleMaxLength, "password must be at most %d characters", p.MaxLength)
+	case len(password) > bcryptMaxBytes:
+		fail(RuleMaxLength, "password must be at most %d bytes", bcryptMaxBytes)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	if p.RequireUpper && !upper {
+		fail(RuleUppercase, "password must contain an uppercase letter")
+	}
+	if p.RequireLower && !lower {
+		fail(RuleLowercase, "password must contain a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		fail(RuleDigit, "password must contain a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		fail(RuleSymbol, "password must contain a symbol")
+	}
+
+	folded := strings.ToLower(password)
+	if containsEmail(folded, email) {
+		fail(RuleContainsEmail, "password must not contain the email address")
+	}
+	if containsName(folded, displayName) {
+		fail(RuleContainsName, "password must not contain the display name")
+	}
+
+	if p.Breached != nil && p.Breached.Contains(password) {
+		fail(RuleBreachedPassword, "password appears in a known data breach")
+	}
+
+	return violations
+}
+
+// minIdentifierLength avoids rejecting passwords because of very short names
+// such as initials that would otherwise match by accident.
+const minIdentifierLength = 3
+
+func containsEmail(folded, email string) bool {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return false
+	}
+	if strings.Contains(folded, email) {
+		return true
+	}
+	local, _, _ := strings.Cut(email, "@")
+	return len(local) >= minIdentifierLength && strings.Contains(folded, local)
+}
+
+func containsName(folded, displayName string) bool {
+	name := strings.ToLower(strings.TrimSpace(displayName))
+	if name == "" {
+		return false
+	}
+	if compact := strings.Join(strings.Fields(name), ""); len(compact) >= minIdentifierLength && strings.Contains(folded, compact) {
+		return true
+	}
+	for _, part := range strings.Fields(name) {
+		if len(part) >= minIdentifierLength && strings.Contains(folded, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// sha1PrefixLength matches the k-anonymity prefix used by breach corpora such
+// as Have I Been Pwned so that downloaded range files can be used unchanged.
+const sha1PrefixLength = 5
+
+// BreachedCorpus is an offline set of SHA-1 hashes of known breached passwords
+// indexed by hash prefix.
+type BreachedCorpus struct {
+	ranges map[string]map[string]struct{}
+}
+
+// LoadBreachedCorpus reads a corpus file containing one upper or lower case
+// hex SHA-1 hash per line, optionally followed by ":<count>". Blank lines and
+// lines starting with '#' are ignored.
+func LoadBreachedCorpus(path string) (*BreachedCorpus, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open breached password corpus: %w", err)
+	}
+	defer f.Close()
+
+	corpus := &BreachedCorpus{ranges: make(map[string]map[string]struct{})}
+	scanner := bufio.NewScanner(f)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		hash, _, _ := strings.Cut(text, ":")
+		hash = strings.ToUpper(hash)
+		if len(hash) != sha1.Size*2 {
+			return nil, fmt.Errorf("breached password corpus line %d: expected SHA-1 hex digest", line)
+		}
+		if _, err := hex.DecodeString(hash); err != nil {
+			return nil, fmt.Errorf("breached password corpus line %d: %w", line, err)
+		}
+		prefix, suffix := hash[:sha1PrefixLength], hash[sha1PrefixLength:]
+		suffixes, ok := corpus.ranges[prefix]
+		if !ok {
+			suffixes = make(map[string]struct{})
+			corpus.ranges[prefix] = suffixes
+		}
+		suffixes[suffix] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read breached password corpus: %w", err)
+	}
+	return corpus, nil
+}
+
+// Contains reports whether the password's SHA-1 hash is in the corpus.
+func (c *BreachedCorpus) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	suffixes, ok := c.ranges[hash[:sha1PrefixLength]]
+	if !ok {
+		return false
+	}
+	_, found := suffixes[hash[sha1PrefixLength:]]
+	return found
+}
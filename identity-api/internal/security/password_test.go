@@ -0,0 +1,149 @@
+package security
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPasswordPolicyCheck(t *testing.T) {
+	strict := &PasswordPolicy{
+		MinLength:     8,
+		MaxLength:     64,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+	}
+	// lenient only enforces bcrypt's limit, which applies regardless of
+	// the configured rules.
+	lenient := &PasswordPolicy{MaxLength: 128}
+
+	tests := []struct {
+		name        string
+		policy      *PasswordPolicy
+		password    string
+		email       string
+		displayName string
+		want        []string
+	}{
+		{name: "valid", policy: strict, password: "Str0ng!pass"},
+		{name: "too short", policy: strict, password: "S0!a", want: []string{RuleMinLength}},
+		{name: "short in characters but not bytes", policy: strict, password: "Äö1!ü", want: []string{RuleMinLength}},
+		{name: "too many characters", policy: strict, password: "Aa1!" + strings.Repeat("x", 61), want: []string{RuleMaxLength}},
+		{name: "at most characters", policy: strict, password: "Aa1!" + strings.Repeat("x", 60)},
+		{name: "72 bytes", policy: lenient, password: strings.Repeat("x", 72)},
+		{name: "73 bytes", policy: lenient, password: strings.Repeat("x", 73), want: []string{RuleMaxLength}},
+		{name: "over 72 bytes in fewer characters", policy: strict, password: "Aa1!" + strings.Repeat("é", 35), want: []string{RuleMaxLength}},
+		{name: "no uppercase", policy: strict, password: "lowercase1!", want: []string{RuleUppercase}},
+		{name: "no lowercase", policy: strict, password: "UPPERCASE1!", want: []string{RuleLowercase}},
+		{name: "no digit", policy: strict, password: "NoDigits!!", want: []string{RuleDigit}},
+		{name: "no symbol", policy: strict, password: "NoSymbols123", want: []string{RuleSymbol}},
+		{name: "space counts as symbol", policy: strict, password: "Has Space1"},
+		{name: "non-ASCII letters", policy: strict, password: "Ünïcödé1!"},
+		{name: "every class missing", policy: strict, password: "        ", want: []string{RuleUppercase, RuleLowercase, RuleDigit}},
+		{name: "empty", policy: strict, password: "", want: []string{RuleMinLength, RuleUppercase, RuleLowercase, RuleDigit, RuleSymbol}},
+		{
+			name: "contains email", policy: strict, password: "x-JANE.DOE@example.COM-1A", email: "Jane.Doe@Example.com",
+			want: []string{RuleContainsEmail},
+		},
+		{name: "contains email local part", policy: strict, password: "Jane.Doe2026!", email: "jane.doe@example.com", want: []string{RuleContainsEmail}},
+		{name: "short email local part", policy: strict, password: "Jo!2026abcd", email: "jo@example.com"},
+		{name: "blank email", policy: strict, password: "Str0ng!pass", email: "  "},
+		{name: "contains display name", policy: strict, password: "JaneDoe!2026", displayName: "Jane Doe", want: []string{RuleContainsName}},
+		{name: "contains part of display name", policy: strict, password: "the-DOE-family1", displayName: "Jane Doe", want: []string{RuleContainsName}},
+		{name: "display name with extra spaces", policy: strict, password: "maryann#42X", displayName: "  Mary   Ann ", want: []string{RuleContainsName}},
+		{name: "short display name parts", policy: strict, password: "Al!Bo1234x", displayName: "Al Bo"},
+		{name: "short display name parts joined", policy: strict, password: "xAlBo1234!", displayName: "Al Bo", want: []string{RuleContainsName}},
+		{name: "blank display name", policy: strict, password: "Str0ng!pass", displayName: " "},
+		{
+			name: "email and display name", policy: strict, password: "jane.doe!1A", email: "jane.doe@example.com", displayName: "Jane Doe",
+			want: []string{RuleContainsEmail, RuleContainsName},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, v := range tt.policy.Check(tt.password, tt.email, tt.displayName) {
+				got = append(got, v.Rule)
+				if v.Message == "" {
+					t.Errorf("violation %s has no message", v.Rule)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Check(%q) violations = %v, want %v", tt.password, got, tt.want)
+			}
+		})
+	}
+}
+
+func sha1Hex(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return hex.EncodeToString(sum[:])
+}
+
+func writeCorpus(t *testing.T, lines ...string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoadBreachedCorpus(t *testing.T) {
+	path := writeCorpus(t,
+		"# downloaded 2026-01-01",
+		"",
+		strings.ToUpper(sha1Hex("password1"))+":3861493",
+		"   ",
+		strings.ToLower(sha1Hex("letmein")),
+		"  "+sha1Hex("hunter2")+":2  ",
+	)
+	corpus, err := LoadBreachedCorpus(path)
+	if err != nil {
+		t.Fatalf("LoadBreachedCorpus() error = %v", err)
+	}
+
+	for _, password := range []string{"password1", "letmein", "hunter2"} {
+		if !corpus.Contains(password) {
+			t.Errorf("Contains(%q) = false, want true", password)
+		}
+	}
+	// Passwords are hashed as they are; only the hex digests are case
+	// insensitive.
+	for _, password := range []string{"Password1", "LETMEIN", "correct horse battery staple", ""} {
+		if corpus.Contains(password) {
+			t.Errorf("Contains(%q) = true, want false", password)
+		}
+	}
+
+	policy := &PasswordPolicy{Breached: corpus}
+	if got := policy.Check("letmein", "", ""); len(got) != 1 || got[0].Rule != RuleBreachedPassword {
+		t.Errorf("Check(letmein) = %v, want a breached violation", got)
+	}
+}
+
+func TestLoadBreachedCorpusErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "missing file", path: filepath.Join(t.TempDir(), "missing.txt"), want: "open breached password corpus"},
+		{name: "short digest", path: writeCorpus(t, sha1Hex("a"), "# comment", "ABCDEF"), want: "line 3"},
+		{name: "not hex", path: writeCorpus(t, strings.Repeat("Z", 40)), want: "line 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadBreachedCorpus(tt.path)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("LoadBreachedCorpus() error = %v, want it to mention %q", err, tt.want)
+			}
+		})
+	}
+}
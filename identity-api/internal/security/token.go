@@ -2,6 +2,7 @@ package security
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -9,28 +10,142 @@ import (
 	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
 )
 
+// defaultLeeway is the clock skew tolerated when validating time claims.
+const defaultLeeway = 30 * time.Second
+
 // TokenManager issues and validates JWTs.
 type TokenManager struct {
-	secret []byte
-	ttl    time.Duration
+	secret    []byte
+	ttl       time.Duration
+	issuer    string
+	audiences []string
+	// defaultAudience is the audience of tokens that do not request one.
+	defaultAudience []string
+	leeway          time.Duration
+}
+
+// TokenOptions configures the registered claims of issued tokens.
+type TokenOptions struct {
+	// Issuer is set as the "iss" claim and required when validating.
+	Issuer string
+	// Audiences lists the services tokens may be issued for on request.
+	Audiences []string
+	// DefaultAudience is the audience of tokens that do not request one. It is
+	// always allowed.
+	DefaultAudience string
+	// Leeway is the clock skew tolerated for "exp", "iat" and "nbf".
+	Leeway time.Duration
 }
 
 // Claims represent the validated JWT claims used by other services.
 type Claims struct {
+	UserID    string
+	Email     string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+	ClientID  string
+	Scope     string
+	Issuer    string
+	Audience  []string
+	// Custom holds the claims added by claims enrichers at issuance.
+	Custom map[string]string
+	// Actor is set when the token was obtained through impersonation and
+	// identifies the party acting on behalf of the subject.
+	Actor *Actor
+}
+
+// Actor identifies the party named in a token's "act" claim (RFC 8693).
+type Actor struct {
 	UserID string
 	Email  string
 }
 
 // NewTokenManager builds a new TokenManager instance.
-func NewTokenManager(secret string, ttl time.Duration) *TokenManager {
+func NewTokenManager(secret string, ttl time.Duration, opts TokenOptions) *TokenManager {
 	if ttl <= 0 {
 		ttl = time.Hour
 	}
-	return &TokenManager{secret: []byte(secret), ttl: ttl}
+	if opts.Leeway <= 0 {
+		opts.Leeway = defaultLeeway
+	}
+	audiences := slices.Clone(opts.Audiences)
+	var defaultAudience []string
+	if opts.DefaultAudience != "" {
+		defaultAudience = []string{opts.DefaultAudience}
+		if !slices.Contains(audiences, opts.DefaultAudience) {
+			audiences = append(audiences, opts.DefaultAudience)
+		}
+	}
+	return &TokenManager{
+		secret:          []byte(secret),
+		ttl:             ttl,
+		issuer:          opts.Issuer,
+		audiences:       audiences,
+		defaultAudience: defaultAudience,
+		leeway:          opts.Leeway,
+	}
+}
+
+// TTL returns the lifetime of issued access tokens.
+func (m *TokenManager) TTL() time.Duration {
+	return m.ttl
+}
+
+// Audience resolves the audience of a new token. An empty request selects
+// the default audience; otherwise each requested audience must be configured.
+func (m *TokenManager) Audience(requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return m.defaultAudience, nil
+	}
+	for _, aud := range requested {
+		if !slices.Contains(m.audiences, aud) {
+			return nil, fmt.Errorf("audience %q is not allowed", aud)
+		}
+	}
+	return requested, nil
 }
 
-// Issue creates a signed JWT for the given user.
-func (m *TokenManager) Issue(user db.User) (string, time.Duration, error) {
+// Issue creates a signed JWT for the given user, intended for the given
+// audience as resolved by Audience. custom claims are carried in the "ext"
+// claim.
+func (m *TokenManager) Issue(user db.User, audience []string, custom map[string]string) (string, time.Duration, error) {
+	extra := jwt.MapClaims{"aud": audience}
+	if len(custom) > 0 {
+		extra[customClaim] = custom
+	}
+	return m.issue(user, m.ttl, extra)
+}
+
+// IssueForClient creates a signed JWT for the given user on behalf of an
+// OAuth client, intended for the given audience as resolved by Audience. The
+// client id and granted scope are carried in the "client_id" and "scope"
+// claims.
+func (m *TokenManager) IssueForClient(user db.User, clientID, scope string, audience []string) (string, time.Duration, error) {
+	extra := jwt.MapClaims{"client_id": clientID, "aud": audience}
+	if scope != "" {
+		extra["scope"] = scope
+	}
+	return m.issue(user, m.ttl, extra)
+}
+
+// IssueImpersonation creates a signed JWT for the given user that carries an
+// "act" claim naming the actor, intended for the given audience as resolved by
+// Audience. ttl bounds the lifetime of the token and is capped at the regular
+// access token lifetime.
+func (m *TokenManager) IssueImpersonation(user db.User, actor Actor, ttl time.Duration, audience []string) (string, time.Duration, error) {
+	if actor.UserID == "" {
+		return "", 0, fmt.Errorf("actor id is empty")
+	}
+	if ttl <= 0 || ttl > m.ttl {
+		ttl = m.ttl
+	}
+	return m.issue(user, ttl, jwt.MapClaims{
+		"act": map[string]any{"sub": actor.UserID, "email": actor.Email},
+		"aud": audience,
+	})
+}
+
+func (m *TokenManager) issue(user db.User, ttl time.Duration, extra jwt.MapClaims) (string, time.Duration, error) {
 	userID := ""
 	if user.ID.Valid {
 		userID = user.ID.String()
@@ -42,32 +157,99 @@ func (m *TokenManager) Issue(user db.User) (string, time.Duration, error) {
 	claims := jwt.RegisteredClaims{
 		Subject:   userID,
 		IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
-		ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(m.ttl)),
+		ExpiresAt: jwt.NewNumericDate(time.Now().UTC().Add(ttl)),
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	mapClaims := jwt.MapClaims{
 		"sub":   claims.Subject,
 		"iat":   claims.IssuedAt.Unix(),
 		"exp":   claims.ExpiresAt.Unix(),
 		"email": user.Email,
-	})
+	}
+	if m.issuer != "" {
+		mapClaims["iss"] = m.issuer
+	}
+	for k, v := range extra {
+		mapClaims[k] = v
+	}
+	if aud, ok := mapClaims["aud"].([]string); ok && len(aud) == 0 {
+		delete(mapClaims, "aud")
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, mapClaims)
 
 	signed, err := token.SignedString(m.secret)
 	if err != nil {
 		return "", 0, fmt.Errorf("sign token: %w", err)
 	}
 
-	return signed, m.ttl, nil
+	return signed, ttl, nil
 }
 
-// Validate parses and validates a JWT string.
-func (m *TokenManager) Validate(tokenString string) (*Claims, error) {
+// Validate parses and validates a JWT access token string. When audience is
+// not empty the token's "aud" claim must contain it.
+func (m *TokenManager) Validate(tokenString, audience string) (*Claims, error) {
+	var opts []jwt.ParserOption
+	if audience != "" {
+		opts = append(opts, jwt.WithAudience(audience))
+	}
+	claims, err := m.parse(tokenString, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if purpose, ok := claims[purposeClaim]; ok {
+		return nil, fmt.Errorf("token with purpose %v is not an access token", purpose)
+	}
+
+	sub, _ := claims["sub"].(string)
+	email, _ := claims["email"].(string)
+	if sub == "" {
+		return nil, fmt.Errorf("token missing subject")
+	}
+
+	result := &Claims{UserID: sub, Email: email}
+	result.ClientID, _ = claims["client_id"].(string)
+	result.Scope, _ = claims["scope"].(string)
+	result.Issuer, _ = claims.GetIssuer()
+	result.Audience, _ = claims.GetAudience()
+	if ext, ok := claims[customClaim].(map[string]any); ok {
+		result.Custom = make(map[string]string, len(ext))
+		for k, v := range ext {
+			if value, ok := v.(string); ok {
+				result.Custom[k] = value
+			}
+		}
+	}
+	if iat, err := claims.GetIssuedAt(); err == nil && iat != nil {
+		result.IssuedAt = iat.Time
+	}
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		result.ExpiresAt = exp.Time
+	}
+	if act, ok := claims["act"].(map[string]any); ok {
+		actorID, _ := act["sub"].(string)
+		if actorID == "" {
+			return nil, fmt.Errorf("token act claim missing subject")
+		}
+		actorEmail, _ := act["email"].(string)
+		result.Actor = &Actor{UserID: actorID, Email: actorEmail}
+	}
+
+	return result, nil
+}
+
+// parse verifies the signature, issuer and standard time claims of a JWT,
+// tolerating the configured clock skew.
+func (m *TokenManager) parse(tokenString string, opts ...jwt.ParserOption) (jwt.MapClaims, error) {
+	opts = append(opts, jwt.WithLeeway(m.leeway), jwt.WithIssuedAt())
+	if m.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(m.issuer))
+	}
 	parsed, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %s", token.Header["alg"])
 		}
 		return m.secret, nil
-	})
+	}, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -79,12 +261,5 @@ func (m *TokenManager) Validate(tokenString string) (*Claims, error) {
 	if !ok {
 		return nil, fmt.Errorf("unexpected claims type")
 	}
-
-	sub, _ := claims["sub"].(string)
-	email, _ := claims["email"].(string)
-	if sub == "" {
-		return nil, fmt.Errorf("token missing subject")
-	}
-
-	return &Claims{UserID: sub, Email: email}, nil
+	return claims, nil
 }
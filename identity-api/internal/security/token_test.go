@@ -0,0 +1,122 @@
+package security
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+func newTestTokenManager() *TokenManager {
+	return NewTokenManager("test-secret", time.Hour, TokenOptions{
+		Issuer:          "identity-api",
+		Audiences:       []string{"dummy-api", "billing-api"},
+		DefaultAudience: "identity-api",
+	})
+}
+
+func testUser() db.User {
+	return db.User{
+		ID:    pgtype.UUID{Bytes: [16]byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}, Valid: true},
+		Email: "jane@example.com",
+	}
+}
+
+func TestAudience(t *testing.T) {
+	m := newTestTokenManager()
+
+	tests := []struct {
+		name      string
+		requested []string
+		want      []string
+		wantErr   bool
+	}{
+		{name: "empty request gets the default audience only", want: []string{"identity-api"}},
+		{name: "allowed audience", requested: []string{"dummy-api"}, want: []string{"dummy-api"}},
+		{name: "several allowed audiences", requested: []string{"dummy-api", "billing-api"}, want: []string{"dummy-api", "billing-api"}},
+		{name: "default audience is always allowed", requested: []string{"identity-api"}, want: []string{"identity-api"}},
+		{name: "unknown audience", requested: []string{"dummy-api", "admin-api"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.Audience(tt.requested)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Audience(%v) error = %v, wantErr %v", tt.requested, err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("Audience(%v) = %v, want %v", tt.requested, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIssuedTokenAudience(t *testing.T) {
+	m := newTestTokenManager()
+	user := testUser()
+
+	issue := map[string]func(audience []string) (string, time.Duration, error){
+		"login": func(audience []string) (string, time.Duration, error) {
+			return m.Issue(user, audience, nil)
+		},
+		"client": func(audience []string) (string, time.Duration, error) {
+			return m.IssueForClient(user, "cli", "items:read", audience)
+		},
+		"impersonation": func(audience []string) (string, time.Duration, error) {
+			return m.IssueImpersonation(user, Actor{UserID: "admin", Email: "admin@example.com"}, time.Minute, audience)
+		},
+	}
+	tests := []struct {
+		requested []string
+		accepted  string
+		rejected  string
+	}{
+		{requested: nil, accepted: "identity-api", rejected: "dummy-api"},
+		{requested: []string{"dummy-api"}, accepted: "dummy-api", rejected: "identity-api"},
+	}
+	for name, fn := range issue {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s for %v", name, tt.requested), func(t *testing.T) {
+				audience, err := m.Audience(tt.requested)
+				if err != nil {
+					t.Fatal(err)
+				}
+				token, _, err := fn(audience)
+				if err != nil {
+					t.Fatal(err)
+				}
+				claims, err := m.Validate(token, tt.accepted)
+				if err != nil {
+					t.Fatalf("Validate(%s) error = %v", tt.accepted, err)
+				}
+				if !slices.Equal(claims.Audience, []string{tt.accepted}) {
+					t.Errorf("aud = %v, want [%s]", claims.Audience, tt.accepted)
+				}
+				if _, err := m.Validate(token, tt.rejected); err == nil {
+					t.Errorf("token for %s is accepted by %s", tt.accepted, tt.rejected)
+				}
+			})
+		}
+	}
+}
+
+func TestIssueRequestedAudience(t *testing.T) {
+	m := newTestTokenManager()
+	audience, err := m.Audience([]string{"dummy-api"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, _, err := m.Issue(testUser(), audience, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Validate(token, "dummy-api"); err != nil {
+		t.Errorf("Validate(dummy-api) error = %v", err)
+	}
+	if _, err := m.Validate(token, "identity-api"); err == nil {
+		t.Error("token for dummy-api is accepted by identity-api")
+	}
+}
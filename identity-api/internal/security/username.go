@@ -0,0 +1,63 @@
+package security
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Username length limits.
+const (
+	UsernameMinLength = 3
+	UsernameMaxLength = 30
+)
+
+// usernamePattern allows ASCII letters, digits, dots, hyphens and
+// underscores, starting and ending with a letter or digit.
+var usernamePattern = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+
+// defaultReservedUsernames are refused because they could be mistaken for
+// the service, its staff or well-known routes.
+var defaultReservedUsernames = []string{
+	"abuse", "admin", "administrator", "anonymous", "api", "help", "identity",
+	"me", "moderator", "no-reply", "noreply", "null", "postmaster", "root",
+	"security", "staff", "support", "system", "undefined", "webmaster", "www",
+}
+
+// UsernamePolicy describes the rules usernames must satisfy. Usernames are
+// compared case-insensitively.
+type UsernamePolicy struct {
+	reserved map[string]struct{}
+}
+
+// NewUsernamePolicy builds a UsernamePolicy that reserves the built-in names
+// together with the given extra names.
+func NewUsernamePolicy(extraReserved []string) *UsernamePolicy {
+	reserved := make(map[string]struct{}, len(defaultReservedUsernames)+len(extraReserved))
+	for _, name := range slices.Concat(defaultReservedUsernames, extraReserved) {
+		if name = strings.ToLower(strings.TrimSpace(name)); name != "" {
+			reserved[name] = struct{}{}
+		}
+	}
+	return &UsernamePolicy{reserved: reserved}
+}
+
+// Check returns a description of the first rule username breaks, or an empty
+// string when it is acceptable.
+func (p *UsernamePolicy) Check(username string) string {
+	switch {
+	case len(username) < UsernameMinLength:
+		return fmt.Sprintf("username must be at least %d characters", UsernameMinLength)
+	case len(username) > UsernameMaxLength:
+		return fmt.Sprintf("username must be at most %d characters", UsernameMaxLength)
+	case !usernamePattern.MatchString(username):
+		return "username may only contain letters, digits, dots, hyphens and underscores, and must start and end with a letter or digit"
+	case strings.Contains(username, ".."), strings.Contains(username, "__"), strings.Contains(username, "--"):
+		return "username must not repeat dots, hyphens or underscores"
+	}
+	if _, ok := p.reserved[strings.ToLower(username)]; ok {
+		return "username is reserved"
+	}
+	return ""
+}
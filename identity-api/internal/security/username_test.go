@@ -0,0 +1,68 @@
+package security
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestUsernamePolicyCheck(t *testing.T) {
+	p := NewUsernamePolicy([]string{" Billing ", ""})
+
+	tests := []struct {
+		username string
+		// want is a part of the expected reason, or empty when the username
+		// is acceptable.
+		want string
+	}{
+		{username: "jane", want: ""},
+		{username: "jane.doe-42_x", want: ""},
+		{username: strings.Repeat("a", UsernameMaxLength), want: ""},
+		{username: "jd", want: "at least 3 characters"},
+		{username: strings.Repeat("a", UsernameMaxLength+1), want: "at most 30 characters"},
+		{username: "jane@example", want: "may only contain"},
+		{username: "jane doe", want: "may only contain"},
+		{username: "_jane", want: "may only contain"},
+		{username: "jane.", want: "may only contain"},
+		{username: "jäne", want: "may only contain"},
+		{username: "jane..doe", want: "must not repeat"},
+		{username: "jane--doe", want: "must not repeat"},
+		{username: "admin", want: "reserved"},
+		{username: "Admin", want: "reserved"},
+		{username: "NoReply", want: "reserved"},
+		{username: "billing", want: "reserved"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.username, func(t *testing.T) {
+			got := p.Check(tt.username)
+			if tt.want == "" {
+				if got != "" {
+					t.Errorf("Check(%q) = %q, want acceptable", tt.username, got)
+				}
+				return
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("Check(%q) = %q, want it to contain %q", tt.username, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsernamePolicyExtrasAreIndependent(t *testing.T) {
+	defaults := slices.Clone(defaultReservedUsernames)
+	billing := NewUsernamePolicy([]string{"billing"})
+	sales := NewUsernamePolicy([]string{"sales"})
+
+	if !slices.Equal(defaultReservedUsernames, defaults) {
+		t.Errorf("built-in reserved names changed to %q", defaultReservedUsernames)
+	}
+	if got := billing.Check("sales"); got != "" {
+		t.Errorf("billing policy Check(sales) = %q, want acceptable", got)
+	}
+	if got := sales.Check("billing"); got != "" {
+		t.Errorf("sales policy Check(billing) = %q, want acceptable", got)
+	}
+	if got := NewUsernamePolicy(nil).Check("billing"); got != "" {
+		t.Errorf("default policy Check(billing) = %q, want acceptable", got)
+	}
+}
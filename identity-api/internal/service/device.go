@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/security"
+)
+
+const (
+	// defaultDeviceCodeTTL bounds device authorizations when no lifetime is
+	// configured.
+	defaultDeviceCodeTTL = 10 * time.Minute
+	// defaultDevicePollInterval is the polling interval handed to devices when
+	// none is configured.
+	defaultDevicePollInterval = 5 * time.Second
+	// slowDownIncrement is added to the polling interval each time a device
+	// polls too early (RFC 8628 section 3.5).
+	slowDownIncrement = 5
+	// userCodeAttempts bounds retries when a generated user code collides
+	// with an existing one.
+	userCodeAttempts = 3
+)
+
+// OAuth 2.0 error codes returned by the device flow.
+const (
+	oauthInvalidClient        = "invalid_client"
+	oauthInvalidScope         = "invalid_scope"
+	oauthInvalidTarget        = "invalid_target"
+	oauthInvalidGrant         = "invalid_grant"
+	oauthAuthorizationPending = "authorization_pending"
+	oauthSlowDown             = "slow_down"
+	oauthAccessDenied         = "access_denied"
+	oauthExpiredToken         = "expired_token"
+)
+
+// DeviceOptions configures the OAuth 2.0 device authorization grant.
+type DeviceOptions struct {
+	// ClientIDs lists the public clients allowed to start the flow. The flow
+	// is disabled when empty.
+	ClientIDs []string
+	// Scopes lists the scopes device clients may request.
+	Scopes   []string
+	TTL      time.Duration
+	Interval time.Duration
+	// VerificationURL is the page where users enter the user code.
+	VerificationURL string
+}
+
+// DeviceAuthorization starts a device authorization (RFC 8628 section 3.1).
+// The device displays the user code and polls DeviceToken while the user
+// approves the request from a browser. The token is issued for the requested
+// audience or, when empty, the default one.
+func (s *Service) DeviceAuthorization(ctx context.Context, payload *identity.DeviceAuthorizationPayload) (*identity.DeviceAuthorizationResult, error) {
+	if !slices.Contains(s.device.ClientIDs, payload.ClientID) {
+		s.log.WarnContext(ctx, "device authorization from unknown client", "clientID", payload.ClientID)
+		return nil, oauthError(oauthInvalidClient, "client is not allowed to use the device flow")
+	}
+	scope, err := s.deviceScope(payload.Scope)
+	if err != nil {
+		return nil, err
+	}
+	audience, err := s.tokens.Audience(payload.Audience)
+	if err != nil {
+		return nil, oauthError(oauthInvalidTarget, err.Error())
+	}
+
+	deviceCode, hash, err := security.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	var row db.DeviceAuthorization
+	for attempt := 1; ; attempt++ {
+		userCode, err := security.NewUserCode()
+		if err != nil {
+			return nil, err
+		}
+		row, err = s.queries.CreateDeviceAuthorization(ctx, db.CreateDeviceAuthorizationParams{
+			DeviceCodeHash: hash,
+			UserCode:       userCode,
+			ClientID:       payload.ClientID,
+			Scope:          scope,
+			PollInterval:   int32(s.device.Interval.Seconds()),
+			ExpiresAt:      pgtype.Timestamptz{Time: time.Now().UTC().Add(s.device.TTL), Valid: true},
+			Audience:       audience,
+		})
+		if err == nil {
+			break
+		}
+		if attempt < userCodeAttempts && isUniqueViolation(err) {
+			continue
+		}
+		return nil, fmt.Errorf("create device authorization: %w", err)
+	}
+
+	complete, err := url.Parse(s.device.VerificationURL)
+	if err != nil {
+		return nil, fmt.Errorf("parse verification url: %w", err)
+	}
+	q := complete.Query()
+	q.Set("user_code", row.UserCode)
+	complete.RawQuery = q.Encode()
+
+	s.log.InfoContext(ctx, "started device authorization", "clientID", payload.ClientID)
+	return &identity.DeviceAuthorizationResult{
+		DeviceCode:              deviceCode,
+		UserCode:                row.UserCode,
+		VerificationURI:         s.device.VerificationURL,
+		VerificationURIComplete: complete.String(),
+		ExpiresIn:               int(s.device.TTL.Seconds()),
+		Interval:                int(row.PollInterval),
+	}, nil
+}
+
+// DeviceToken exchanges an approved device code for an access token
+// (RFC 8628 section 3.4). Until the user decides, polling yields
+// authorization_pending, and polling faster than the advertised interval
+// yields slow_down with the interval increased for subsequent requests.
+func (s *Service) DeviceToken(ctx context.Context, payload *identity.DeviceTokenPayload) (*identity.DeviceTokenResult, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	queries := s.queries.WithTx(tx)
+
+	row, err := queries.GetDeviceAuthorizationForUpdate(ctx, security.HashOpaqueToken(payload.DeviceCode))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, oauthError(oauthInvalidGrant, "unknown device code")
+		}
+		return nil, fmt.Errorf("get device authorization: %w", err)
+	}
+	now := time.Now()
+	if err := checkDeviceCode(row, payload.ClientID, now); err != nil {
+		return nil, err
+	}
+
+	// The poll is recorded before deciding the outcome so that early polls
+	// are throttled even while the request is still pending.
+	interval, pollErr := devicePollOutcome(row, now)
+	if err := queries.RecordDeviceAuthorizationPoll(ctx, db.RecordDeviceAuthorizationPollParams{ID: row.ID, PollInterval: interval}); err != nil {
+		return nil, fmt.Errorf("record device authorization poll: %w", err)
+	}
+
+	var user db.User
+	if pollErr == nil {
+		if err := queries.ConsumeDeviceAuthorization(ctx, row.ID); err != nil {
+			return nil, fmt.Errorf("consume device authorization: %w", err)
+		}
+		if user, err = queries.GetUserByID(ctx, row.UserID); err != nil {
+			return nil, fmt.Errorf("get user by id: %w", err)
+		}
+		// The account may have been disabled since it approved the
+		// request; the device code is consumed either way.
+		if user.Status != userStatusActive {
+			s.log.WarnContext(ctx, "device token refused: account "+user.Status, "userID", user.ID.String())
+			s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, UserID: user.ID.String(), Email: user.Email, Reason: "device authorization: account " + user.Status})
+			pollErr = oauthError(oauthAccessDenied, "account is "+user.Status)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+	if pollErr != nil {
+		return nil, pollErr
+	}
+
+	scope := ""
+	if row.Scope != nil {
+		scope = *row.Scope
+	}
+	// Authorizations started before audiences were recorded have none and
+	// get the default one.
+	audience, err := s.tokens.Audience(row.Audience)
+	if err != nil {
+		return nil, fmt.Errorf("resolve audience: %w", err)
+	}
+	signed, ttl, err := s.tokens.IssueForClient(user, row.ClientID, scope, audience)
+	if err != nil {
+		return nil, fmt.Errorf("issue token: %w", err)
+	}
+
+	s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, Success: true, UserID: user.ID.String(), Email: user.Email, Reason: "device authorization"})
+	return &identity.DeviceTokenResult{
+		AccessToken: signed,
+		TokenType:   tokenTypeBearer,
+		ExpiresIn:   int(ttl.Seconds()),
+		Scope:       row.Scope,
+	}, nil
+}
+
+// ApproveDevice records the signed-in user's decision for the device showing
+// the given user code.
+func (s *Service) ApproveDevice(ctx context.Context, payload *identity.ApproveDevicePayload) error {
+	claims, err := s.authenticate(ctx, payload.Token)
+	if err != nil {
+		return err
+	}
+	if claims.Actor != nil {
+		return &identity.UnauthorizedError{Message: "impersonation tokens cannot approve devices"}
+	}
+
+	var userID pgtype.UUID
+	if err := userID.Scan(claims.UserID); err != nil {
+		return &identity.UnauthorizedError{Message: "invalid subject claim"}
+	}
+	userCode := security.NormalizeUserCode(payload.UserCode)
+
+	decision := "approved"
+	if payload.Approve {
+		_, err = s.queries.ApproveDeviceAuthorization(ctx, db.ApproveDeviceAuthorizationParams{UserCode: userCode, UserID: userID})
+	} else {
+		decision = "denied"
+		_, err = s.queries.DenyDeviceAuthorization(ctx, db.DenyDeviceAuthorizationParams{UserCode: userCode, UserID: userID})
+	}
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventDeviceAuthorization, UserID: claims.UserID, Email: claims.Email, Reason: "unknown or expired user code"})
+			return &identity.NotFoundError{Message: "unknown or expired user code"}
+		}
+		return fmt.Errorf("decide device authorization: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "device authorization "+decision, "userID", claims.UserID)
+	s.audit.Record(ctx, audit.Event{Type: audit.EventDeviceAuthorization, Success: true, UserID: claims.UserID, Email: claims.Email, Reason: decision})
+	return nil
+}
+
+// deviceScope normalizes the space-separated scopes requested by a device
+// client and checks that each of them is allowed.
+func (s *Service) deviceScope(requested *string) (*string, error) {
+	if requested == nil {
+		return nil, nil
+	}
+	var scopes []string
+	for _, scope := range strings.Fields(*requested) {
+		if !slices.Contains(s.device.Scopes, scope) {
+			return nil, oauthError(oauthInvalidScope, fmt.Sprintf("scope %q is not allowed", scope))
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if len(scopes) == 0 {
+		return nil, nil
+	}
+	return ptr(strings.Join(scopes, " ")), nil
+}
+
+// checkDeviceCode rejects polls for device codes that can no longer yield a
+// token, whatever the user decided.
+func checkDeviceCode(row db.DeviceAuthorization, clientID string, now time.Time) error {
+	switch {
+	case row.ClientID != clientID:
+		return oauthError(oauthInvalidGrant, "device code was issued to another client")
+	case row.ConsumedAt.Valid:
+		return oauthError(oauthInvalidGrant, "device code already used")
+	case !row.ExpiresAt.Time.After(now):
+		return oauthError(oauthExpiredToken, "device code expired")
+	}
+	return nil
+}
+
+// devicePollOutcome decides a poll of a valid device code at now. It returns
+// the polling interval to record, increased when the device polled too early,
+// and the error to report; a nil error means the request was approved.
+func devicePollOutcome(row db.DeviceAuthorization, now time.Time) (int32, error) {
+	interval := row.PollInterval
+	if row.LastPolledAt.Valid && now.Sub(row.LastPolledAt.Time) < time.Duration(interval)*time.Second {
+		interval += slowDownIncrement
+		return interval, oauthError(oauthSlowDown, fmt.Sprintf("poll at most every %d seconds", interval))
+	}
+	switch {
+	case row.DeniedAt.Valid:
+		return interval, oauthError(oauthAccessDenied, "the user denied the request")
+	case !row.ApprovedAt.Valid:
+		return interval, oauthError(oauthAuthorizationPending, "the user has not yet approved the request")
+	}
+	return interval, nil
+}
+
+func oauthError(code, description string) *identity.OAuthError {
+	return &identity.OAuthError{Code: code, ErrorDescription: ptr(description)}
+}
@@ -0,0 +1,236 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/security"
+)
+
+// deviceStore is a db.DBTX that records the device authorizations created
+// through it.
+type deviceStore struct {
+	created []db.CreateDeviceAuthorizationParams
+}
+
+func (s *deviceStore) Exec(context.Context, string, ...any) (pgconn.CommandTag, error) {
+	return pgconn.CommandTag{}, nil
+}
+
+func (s *deviceStore) Query(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+	return nil, errors.New("unexpected query " + queryName(sql))
+}
+
+func (s *deviceStore) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	if queryName(sql) != "CreateDeviceAuthorization" {
+		return fakeRow{err: errors.New("unexpected query row " + queryName(sql))}
+	}
+	params := db.CreateDeviceAuthorizationParams{
+		DeviceCodeHash: args[0].([]byte),
+		UserCode:       args[1].(string),
+		ClientID:       args[2].(string),
+		Scope:          args[3].(*string),
+		PollInterval:   args[4].(int32),
+		ExpiresAt:      args[5].(pgtype.Timestamptz),
+		Audience:       args[6].([]string),
+	}
+	s.created = append(s.created, params)
+	return fakeRow{values: []any{
+		pgtype.UUID{Bytes: [16]byte{1}, Valid: true}, params.DeviceCodeHash, params.UserCode, params.ClientID,
+		params.Scope, params.PollInterval, pgtype.UUID{}, pgtype.Timestamptz{}, params.ExpiresAt,
+		pgtype.Timestamptz{}, pgtype.Timestamptz{}, pgtype.Timestamptz{}, pgtype.Timestamptz{}, params.Audience,
+	}}
+}
+
+func newDeviceTestService(store *deviceStore) *Service {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	tokens := security.NewTokenManager("test-secret", time.Hour, security.TokenOptions{
+		Audiences:       []string{"dummy-api"},
+		DefaultAudience: "identity-api",
+	})
+	return New(log, db.New(store), tokens, Options{
+		Device: DeviceOptions{
+			ClientIDs:       []string{"cli"},
+			Scopes:          []string{"items:read", "items:write"},
+			VerificationURL: "https://id.example.com/device.html",
+		},
+	})
+}
+
+func TestDeviceAuthorization(t *testing.T) {
+	store := &deviceStore{}
+	svc := newDeviceTestService(store)
+
+	result, err := svc.DeviceAuthorization(context.Background(), &identity.DeviceAuthorizationPayload{
+		ClientID: "cli",
+		Scope:    ptr(" items:read items:write items:read "),
+		Audience: []string{"dummy-api"},
+	})
+	if err != nil {
+		t.Fatalf("DeviceAuthorization() error = %v", err)
+	}
+	if len(store.created) != 1 {
+		t.Fatalf("created %d device authorizations, want 1", len(store.created))
+	}
+	created := store.created[0]
+	if created.Scope == nil || *created.Scope != "items:read items:write" {
+		t.Errorf("stored scope = %v, want %q", created.Scope, "items:read items:write")
+	}
+	if !slices.Equal(created.Audience, []string{"dummy-api"}) {
+		t.Errorf("stored audience = %v, want [dummy-api]", created.Audience)
+	}
+	if result.UserCode != created.UserCode {
+		t.Errorf("user_code = %q, want the stored %q", result.UserCode, created.UserCode)
+	}
+	if want := "https://id.example.com/device.html?user_code=" + created.UserCode; result.VerificationURIComplete != want {
+		t.Errorf("verification_uri_complete = %q, want %q", result.VerificationURIComplete, want)
+	}
+
+	// Without a requested audience the token gets the default one.
+	if _, err := svc.DeviceAuthorization(context.Background(), &identity.DeviceAuthorizationPayload{ClientID: "cli"}); err != nil {
+		t.Fatalf("DeviceAuthorization() error = %v", err)
+	}
+	if created := store.created[1]; created.Scope != nil || !slices.Equal(created.Audience, []string{"identity-api"}) {
+		t.Errorf("stored scope, audience = %v, %v, want nil, [identity-api]", created.Scope, created.Audience)
+	}
+}
+
+func TestDeviceAuthorizationErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		payload  *identity.DeviceAuthorizationPayload
+		wantCode string
+	}{
+		{name: "unknown client", payload: &identity.DeviceAuthorizationPayload{ClientID: "web"}, wantCode: oauthInvalidClient},
+		{name: "scope not allowed", payload: &identity.DeviceAuthorizationPayload{ClientID: "cli", Scope: ptr("items:read admin")}, wantCode: oauthInvalidScope},
+		{name: "audience not allowed", payload: &identity.DeviceAuthorizationPayload{ClientID: "cli", Audience: []string{"billing-api"}}, wantCode: oauthInvalidTarget},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &deviceStore{}
+			_, err := newDeviceTestService(store).DeviceAuthorization(context.Background(), tt.payload)
+			var oauthErr *identity.OAuthError
+			if !errors.As(err, &oauthErr) || oauthErr.Code != tt.wantCode {
+				t.Fatalf("DeviceAuthorization() error = %v, want %s", err, tt.wantCode)
+			}
+			if len(store.created) != 0 {
+				t.Error("a refused device authorization was stored")
+			}
+		})
+	}
+}
+
+func TestDevicePoll(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	at := func(d time.Duration) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: now.Add(d), Valid: true}
+	}
+	pending := db.DeviceAuthorization{ClientID: "cli", PollInterval: 5, ExpiresAt: at(time.Minute)}
+
+	tests := []struct {
+		name         string
+		change       func(*db.DeviceAuthorization)
+		clientID     string
+		wantCode     string
+		wantInterval int32
+	}{
+		{name: "first poll while pending", wantCode: oauthAuthorizationPending, wantInterval: 5},
+		{
+			name:         "poll after the interval while pending",
+			change:       func(r *db.DeviceAuthorization) { r.LastPolledAt = at(-5 * time.Second) },
+			wantCode:     oauthAuthorizationPending,
+			wantInterval: 5,
+		},
+		{
+			name:         "poll before the interval",
+			change:       func(r *db.DeviceAuthorization) { r.LastPolledAt = at(-4 * time.Second) },
+			wantCode:     oauthSlowDown,
+			wantInterval: 10,
+		},
+		{
+			name: "early poll of an approved request",
+			change: func(r *db.DeviceAuthorization) {
+				r.LastPolledAt = at(-time.Second)
+				r.ApprovedAt = at(-time.Second)
+			},
+			wantCode:     oauthSlowDown,
+			wantInterval: 10,
+		},
+		{
+			name:         "denied",
+			change:       func(r *db.DeviceAuthorization) { r.DeniedAt = at(-time.Second) },
+			wantCode:     oauthAccessDenied,
+			wantInterval: 5,
+		},
+		{
+			name:         "approved",
+			change:       func(r *db.DeviceAuthorization) { r.ApprovedAt = at(-time.Second) },
+			wantInterval: 5,
+		},
+		{
+			name:     "expired",
+			change:   func(r *db.DeviceAuthorization) { r.ExpiresAt = at(0) },
+			wantCode: oauthExpiredToken,
+		},
+		{
+			name: "expired after approval",
+			change: func(r *db.DeviceAuthorization) {
+				r.ApprovedAt = at(-2 * time.Minute)
+				r.ExpiresAt = at(-time.Minute)
+			},
+			wantCode: oauthExpiredToken,
+		},
+		{
+			name: "already used",
+			change: func(r *db.DeviceAuthorization) {
+				r.ApprovedAt = at(-time.Second)
+				r.ConsumedAt = at(-time.Second)
+			},
+			wantCode: oauthInvalidGrant,
+		},
+		{name: "another client", clientID: "other", wantCode: oauthInvalidGrant},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := pending
+			if tt.change != nil {
+				tt.change(&row)
+			}
+			clientID := "cli"
+			if tt.clientID != "" {
+				clientID = tt.clientID
+			}
+
+			err := checkDeviceCode(row, clientID, now)
+			var interval int32
+			if err == nil {
+				interval, err = devicePollOutcome(row, now)
+			}
+			code := ""
+			if err != nil {
+				var oauthErr *identity.OAuthError
+				if !errors.As(err, &oauthErr) {
+					t.Fatalf("error = %v, want an OAuth error", err)
+				}
+				code = oauthErr.Code
+			}
+			if code != tt.wantCode {
+				t.Errorf("error code = %q, want %q", code, tt.wantCode)
+			}
+			if interval != tt.wantInterval {
+				t.Errorf("interval = %d, want %d", interval, tt.wantInterval)
+			}
+		})
+	}
+}
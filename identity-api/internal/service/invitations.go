@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/mail"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/security"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
+)
+
+// defaultInvitationTTL bounds invitations when no lifetime is configured.
+const defaultInvitationTTL = 7 * 24 * time.Hour
+
+// listInvitationsLimit caps the number of invitations returned to a user.
+const listInvitationsLimit = 200
+
+// InvitationOptions configures user invitations.
+type InvitationOptions struct {
+	TTL time.Duration
+	// URL is the page the emailed invitation points to; the invitation token is
+	// appended as the "token" query parameter.
+	URL string
+}
+
+// InviteUser creates a pending invitation and emails the invitee a link to
+// accept it. Only administrators may pre-assign attributes, because they are
+// copied onto the account and can end up in its tokens as claims. Only
+// administrators learn that the email is already registered; for anyone else
+// the invitation is created as usual and fails when it is accepted.
+func (s *Service) InviteUser(ctx context.Context, payload *identity.InviteUserPayload) (*identity.Invitation, error) {
+	inviter, err := s.authenticate(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+	admin := s.isAdmin(inviter)
+	if len(payload.Attributes) > 0 && !admin {
+		s.log.WarnContext(ctx, "admin access denied", "userID", inviter.UserID)
+		s.audit.Record(ctx, audit.Event{Type: audit.EventInvitation, UserID: inviter.UserID, Email: inviter.Email, Reason: "attributes require an administrator"})
+		return nil, &identity.UnauthorizedError{Message: "administrator access required to pre-assign attributes"}
+	}
+
+	var inviterID pgtype.UUID
+	if err := inviterID.Scan(inviter.UserID); err != nil {
+		return nil, &identity.UnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	email := normalizeEmail(payload.Email)
+	if admin {
+		if _, err := s.queries.GetUserByEmail(ctx, email); err == nil {
+			return nil, emailConflictError()
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("get user by email: %w", err)
+		}
+	}
+
+	attributes, err := encodeAttributes(payload.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	token, hash, err := security.NewOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := s.queries.CreateInvitation(ctx, db.CreateInvitationParams{
+		TokenHash:   hash,
+		Email:       email,
+		DisplayName: payload.DisplayName,
+		Attributes:  attributes,
+		InvitedBy:   inviterID,
+		ExpiresAt:   pgtype.Timestamptz{Time: time.Now().UTC().Add(s.invitations.TTL), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create invitation: %w", err)
+	}
+
+	target, err := url.Parse(s.invitations.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parse invitation url: %w", err)
+	}
+	q := target.Query()
+	q.Set("token", token)
+	target.RawQuery = q.Encode()
+
+	err = s.mailer.Send(ctx, mail.Message{
+		To:      email,
+		Subject: "You have been invited",
+		Body: fmt.Sprintf("Hi,\n\n%s has invited you to create an account. Use the link below to accept; it expires in %s.\n\n%s\n",
+			inviter.Email, s.invitations.TTL, target),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("send invitation: %w", err)
+	}
+
+	result := mapInvitation(invitation)
+	s.log.InfoContext(ctx, "created invitation", "invitationID", result.ID, "invitedBy", inviter.UserID)
+	s.audit.Record(ctx, audit.Event{Type: audit.EventInvitation, Success: true, UserID: inviter.UserID, Email: inviter.Email, Reason: "invited " + payload.Email})
+	return result, nil
+}
+
+// ListInvitations returns the invitations created by the caller.
+func (s *Service) ListInvitations(ctx context.Context, payload *identity.ListInvitationsPayload) (*identity.InvitationsCollection, error) {
+	inviter, err := s.authenticate(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var inviterID pgtype.UUID
+	if err := inviterID.Scan(inviter.UserID); err != nil {
+		return nil, &identity.UnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	rows, err := s.queries.ListInvitationsByInviter(ctx, db.ListInvitationsByInviterParams{InvitedBy: inviterID, Limit: listInvitationsLimit})
+	if err != nil {
+		return nil, fmt.Errorf("list invitations: %w", err)
+	}
+
+	invitations := make([]*identity.Invitation, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, mapInvitation(row))
+	}
+	return &identity.InvitationsCollection{Invitations: invitations}, nil
+}
+
+// RevokeInvitation cancels a pending invitation created by the caller.
+func (s *Service) RevokeInvitation(ctx context.Context, payload *identity.RevokeInvitationPayload) error {
+	inviter, err := s.authenticate(ctx, payload.Token)
+	if err != nil {
+		return err
+	}
+
+	var inviterID, invitationID pgtype.UUID
+	if err := inviterID.Scan(inviter.UserID); err != nil {
+		return &identity.UnauthorizedError{Message: "invalid subject claim"}
+	}
+	if err := invitationID.Scan(payload.ID); err != nil {
+		return &identity.NotFoundError{Message: "invalid invitation id"}
+	}
+
+	if _, err := s.queries.RevokeInvitation(ctx, db.RevokeInvitationParams{ID: invitationID, InvitedBy: inviterID}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return &identity.NotFoundError{Message: "pending invitation not found"}
+		}
+		return fmt.Errorf("revoke invitation: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "revoked invitation", "invitationID", payload.ID, "invitedBy", inviter.UserID)
+	return nil
+}
+
+// AcceptInvitation creates the invited account with the pre-assigned email,
+// display name and attributes. Each invitation can be accepted once.
+func (s *Service) AcceptInvitation(ctx context.Context, payload *identity.AcceptInvitationPayload) (*identity.User, error) {
+	tx, err := s.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+	queries := s.queries.WithTx(tx)
+
+	invitation, err := queries.GetPendingInvitationForUpdate(ctx, security.HashOpaqueToken(payload.InvitationToken))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventInvitation, Reason: "invalid or expired invitation"})
+			return nil, &identity.UnauthorizedError{Message: "invalid or expired invitation"}
+		}
+		return nil, fmt.Errorf("get invitation: %w", err)
+	}
+
+	displayName := ""
+	switch {
+	case payload.DisplayName != nil:
+		displayName = *payload.DisplayName
+	case invitation.DisplayName != nil:
+		displayName = *invitation.DisplayName
+	default:
+		displayName, _, _ = strings.Cut(invitation.Email, "@")
+	}
+
+	passwordHash, err := s.hashPassword(ctx, payload.Password, invitation.Email, displayName)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := queries.CreateUser(ctx, db.CreateUserParams{
+		Email:        invitation.Email,
+		PasswordHash: passwordHash,
+		DisplayName:  displayName,
+		Attributes:   invitation.Attributes,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Email: invitation.Email, Reason: "email already exists"})
+			return nil, emailConflictError()
+		}
+		return nil, fmt.Errorf("create user: %w", err)
+	}
+
+	if err := queries.MarkInvitationAccepted(ctx, db.MarkInvitationAcceptedParams{ID: invitation.ID, AcceptedUserID: user.ID}); err != nil {
+		return nil, fmt.Errorf("mark invitation accepted: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	result := mapUser(user)
+	s.log.InfoContext(ctx, "accepted invitation", "invitationID", invitation.ID.String(), "userID", result.ID)
+	s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Success: true, UserID: result.ID, Email: result.Email, Reason: "invitation"})
+	s.publishUser(ctx, webhook.EventUserRegistered, user)
+	return result, nil
+}
+
+func encodeAttributes(attrs map[string]string) ([]byte, error) {
+	if attrs == nil {
+		attrs = map[string]string{}
+	}
+	raw, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, fmt.Errorf("encode attributes: %w", err)
+	}
+	return raw, nil
+}
+
+func mapInvitation(inv db.Invitation) *identity.Invitation {
+	status := "pending"
+	switch {
+	case inv.AcceptedAt.Valid:
+		status = "accepted"
+	case inv.RevokedAt.Valid:
+		status = "revoked"
+	case !inv.ExpiresAt.Time.After(time.Now()):
+		status = "expired"
+	}
+
+	return &identity.Invitation{
+		ID:          inv.ID.String(),
+		Email:       inv.Email,
+		DisplayName: inv.DisplayName,
+		Attributes:  decodeAttributes(inv.Attributes),
+		InvitedBy:   inv.InvitedBy.String(),
+		Status:      status,
+		CreatedAt:   inv.CreatedAt.Time.UTC().Format(time.RFC3339),
+		ExpiresAt:   inv.ExpiresAt.Time.UTC().Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/mail"
+)
+
+// defaultMagicLinkTTL bounds login links when no lifetime is configured.
+const defaultMagicLinkTTL = 15 * time.Minute
+
+// MagicLinkOptions configures passwordless login links.
+type MagicLinkOptions struct {
+	Enabled bool
+	TTL     time.Duration
+	// URL is the page the emailed link points to; the token is appended as the
+	// "token" query parameter.
+	URL string
+}
+
+// RequestMagicLink emails a single-use login link to the account. Unknown
+// emails are accepted silently so that the method cannot be used to discover
+// registered addresses.
+func (s *Service) RequestMagicLink(ctx context.Context, payload *identity.RequestMagicLinkPayload) error {
+	if !s.magicLink.Enabled {
+		return &identity.UnauthorizedError{Message: "magic link login is disabled"}
+	}
+
+	user, err := s.queries.GetUserByEmail(ctx, payload.Email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.log.InfoContext(ctx, "magic link requested for unknown email", "email", payload.Email)
+			s.audit.Record(ctx, audit.Event{Type: audit.EventMagicLink, Email: payload.Email, Reason: "user not found"})
+			return nil
+		}
+		return fmt.Errorf("get user by email: %w", err)
+	}
+
+	expiresAt := time.Now().UTC().Add(s.magicLink.TTL)
+	link, err := s.queries.CreateMagicLink(ctx, db.CreateMagicLinkParams{
+		UserID:    user.ID,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("create magic link: %w", err)
+	}
+
+	token, err := s.tokens.IssueMagicLink(link.ID.String(), expiresAt)
+	if err != nil {
+		return err
+	}
+
+	target, err := magicLinkURL(s.magicLink.URL, token)
+	if err != nil {
+		return err
+	}
+
+	err = s.mailer.Send(ctx, mail.Message{
+		To:      user.Email,
+		Subject: "Your sign-in link",
+		Body: fmt.Sprintf("Hi %s,\n\nUse the link below to sign in. It expires in %s and can only be used once.\n\n%s\n\nIf you did not request this email you can ignore it.\n",
+			user.DisplayName, s.magicLink.TTL, target),
+	})
+	if err != nil {
+		return fmt.Errorf("send magic link: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "sent magic link", "userID", user.ID.String())
+	s.audit.Record(ctx, audit.Event{Type: audit.EventMagicLink, Success: true, UserID: user.ID.String(), Email: user.Email, Reason: "link sent"})
+	return nil
+}
+
+// ConsumeMagicLink redeems a login link token for an access token. Each link
+// can be redeemed exactly once before it expires.
+func (s *Service) ConsumeMagicLink(ctx context.Context, payload *identity.ConsumeMagicLinkPayload) (*identity.TokenResult, error) {
+	if !s.magicLink.Enabled {
+		return nil, &identity.UnauthorizedError{Message: "magic link login is disabled"}
+	}
+
+	linkID, err := s.tokens.ValidateMagicLink(payload.Token)
+	if err != nil {
+		s.log.WarnContext(ctx, "magic link rejected", "error", err)
+		s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, Reason: "invalid magic link"})
+		return nil, &identity.UnauthorizedError{Message: "invalid or expired link"}
+	}
+
+	var id pgtype.UUID
+	if err := id.Scan(linkID); err != nil {
+		return nil, &identity.UnauthorizedError{Message: "invalid or expired link"}
+	}
+	link, err := s.queries.ConsumeMagicLink(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, Reason: "magic link already used or expired"})
+			return nil, &identity.UnauthorizedError{Message: "invalid or expired link"}
+		}
+		return nil, fmt.Errorf("consume magic link: %w", err)
+	}
+
+	user, err := s.queries.GetUserByID(ctx, link.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("get user by id: %w", err)
+	}
+	if user.Status != userStatusActive {
+		s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, UserID: user.ID.String(), Email: user.Email, Reason: "account " + user.Status})
+		return nil, &identity.UnauthorizedError{Message: "account is " + user.Status}
+	}
+
+	result, err := s.issueToken(ctx, user, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, Success: true, UserID: user.ID.String(), Email: user.Email, Reason: "magic link"})
+	return result, nil
+}
+
+func magicLinkURL(base, token string) (string, error) {
+	u, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("parse magic link url: %w", err)
+	}
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
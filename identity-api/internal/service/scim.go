@@ -0,0 +1,473 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	goasecurity "goa.design/goa/v3/security"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/scim"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
+)
+
+// SCIM schema URNs (RFC 7643 and RFC 7644).
+const (
+	scimUserSchema  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimListSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// scimFilterPattern matches the equality filters supported by ListUsers, e.g.
+// userName eq "jane@example.com".
+var scimFilterPattern = regexp.MustCompile(`(?i)^\s*(\w+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+
+// SCIMOptions configures the SCIM provisioning endpoint.
+type SCIMOptions struct {
+	// Tokens lists the bearer tokens accepted from provisioning clients. The
+	// endpoint rejects every request when empty.
+	Tokens []string
+	// BaseURL is the public address of the service, used to build resource
+	// locations.
+	BaseURL string
+}
+
+// SCIM implements the goa generated scim service on top of the identity
+// Service so that provisioned accounts share its password policy and audit
+// log.
+type SCIM struct {
+	svc     *Service
+	tokens  [][sha256.Size]byte
+	baseURL string
+}
+
+// NewSCIM creates a new SCIM instance.
+func NewSCIM(svc *Service, opts SCIMOptions) *SCIM {
+	tokens := make([][sha256.Size]byte, 0, len(opts.Tokens))
+	for _, token := range opts.Tokens {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, sha256.Sum256([]byte(token)))
+		}
+	}
+	return &SCIM{svc: svc, tokens: tokens, baseURL: strings.TrimSuffix(opts.BaseURL, "/")}
+}
+
+// APIKeyAuth authenticates provisioning clients by their bearer token.
+func (s *SCIM) APIKeyAuth(ctx context.Context, key string, schema *goasecurity.APIKeyScheme) (context.Context, error) {
+	actual := sha256.Sum256([]byte(key))
+	for _, expected := range s.tokens {
+		if subtle.ConstantTimeCompare(expected[:], actual[:]) == 1 {
+			return ctx, nil
+		}
+	}
+	s.svc.log.WarnContext(ctx, "scim authentication failed")
+	return ctx, scimUnauthorized("invalid bearer token")
+}
+
+// CreateUser provisions an account. The SCIM userName is the account email.
+func (s *SCIM) CreateUser(ctx context.Context, payload *scim.CreateUserPayload) (*scim.SCIMUser, error) {
+	email, err := scimEmail(payload.UserName, payload.Emails)
+	if err != nil {
+		return nil, err
+	}
+	displayName := scimDisplayName(payload.DisplayName, payload.Name, email)
+
+	var passwordHash *string
+	if payload.Password != nil {
+		passwordHash, err = s.svc.hashPassword(ctx, payload.Password, email, displayName)
+		if err != nil {
+			var policyErr *identity.PasswordPolicyError
+			if errors.As(err, &policyErr) {
+				return nil, scimBadRequest("invalidValue", passwordPolicyDetail(policyErr))
+			}
+			return nil, err
+		}
+	}
+
+	user, err := s.svc.queries.CreateProvisionedUser(ctx, db.CreateProvisionedUserParams{
+		Email:        email,
+		PasswordHash: passwordHash,
+		DisplayName:  displayName,
+		ExternalID:   payload.ExternalID,
+		Status:       scimStatus(userStatusActive, payload.Active),
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, scimConflict("a user with this userName or externalId already exists")
+		}
+		return nil, fmt.Errorf("create user: %w", err)
+	}
+
+	s.svc.log.InfoContext(ctx, "provisioned user", "userID", user.ID.String())
+	s.svc.audit.Record(ctx, audit.Event{Type: audit.EventProvisioning, Success: true, UserID: user.ID.String(), Email: user.Email, Reason: "created"})
+	s.svc.publishUser(ctx, webhook.EventUserRegistered, user)
+	return s.mapUser(user), nil
+}
+
+// GetUser returns a single account.
+func (s *SCIM) GetUser(ctx context.Context, payload *scim.GetUserPayload) (*scim.SCIMUser, error) {
+	user, err := s.getUser(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+	return s.mapUser(user), nil
+}
+
+// ListUsers pages through accounts or, with a filter, looks up the account
+// with the given userName or externalId.
+func (s *SCIM) ListUsers(ctx context.Context, payload *scim.ListUsersPayload) (*scim.SCIMListResponse, error) {
+	if payload.Filter != nil && strings.TrimSpace(*payload.Filter) != "" {
+		return s.filterUsers(ctx, *payload.Filter)
+	}
+
+	total, err := s.svc.queries.CountUsers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count users: %w", err)
+	}
+	resources := []*scim.SCIMUser{}
+	if payload.Count > 0 {
+		users, err := s.svc.queries.ListUsers(ctx, db.ListUsersParams{
+			Limit:  int32(payload.Count),
+			Offset: int32(payload.StartIndex - 1),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("list users: %w", err)
+		}
+		for _, user := range users {
+			resources = append(resources, s.mapUser(user))
+		}
+	}
+	return &scim.SCIMListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: int(total),
+		StartIndex:   payload.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+// PatchUser applies a SCIM PatchOp request. Deactivating an account prevents
+// it from signing in.
+func (s *SCIM) PatchUser(ctx context.Context, payload *scim.PatchUserPayload) (*scim.SCIMUser, error) {
+	user, err := s.getUser(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := db.UpdateProvisionedUserParams{
+		ID:          user.ID,
+		Email:       user.Email,
+		DisplayName: user.DisplayName,
+		ExternalID:  user.ExternalID,
+		Status:      user.Status,
+	}
+	for _, op := range payload.Operations {
+		if err := applySCIMPatch(&params, op); err != nil {
+			return nil, err
+		}
+	}
+
+	updated, err := s.svc.queries.UpdateProvisionedUser(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, scimNotFound("user not found")
+		}
+		if isUniqueViolation(err) {
+			return nil, scimConflict("a user with this userName or externalId already exists")
+		}
+		return nil, fmt.Errorf("update user: %w", err)
+	}
+
+	if updated.Status != user.Status {
+		s.svc.statuses.forget(updated.ID.String())
+	}
+	reason, event := "updated", webhook.EventUserUpdated
+	if user.Status == userStatusActive && updated.Status != userStatusActive {
+		reason, event = "deactivated", webhook.EventUserDisabled
+	} else if user.Status != userStatusActive && updated.Status == userStatusActive {
+		reason = "reactivated"
+	}
+	s.svc.log.InfoContext(ctx, "provisioning "+reason+" user", "userID", updated.ID.String())
+	s.svc.audit.Record(ctx, audit.Event{Type: audit.EventProvisioning, Success: true, UserID: updated.ID.String(), Email: updated.Email, Reason: reason})
+	s.svc.publishUser(ctx, event, updated)
+	return s.mapUser(updated), nil
+}
+
+// DeleteUser removes an account.
+func (s *SCIM) DeleteUser(ctx context.Context, payload *scim.DeleteUserPayload) error {
+	var id pgtype.UUID
+	if err := id.Scan(payload.ID); err != nil {
+		return scimNotFound("user not found")
+	}
+	deleted, err := s.svc.queries.DeleteUser(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete user: %w", err)
+	}
+	if deleted == 0 {
+		return scimNotFound("user not found")
+	}
+
+	s.svc.statuses.forget(id.String())
+
+	s.svc.log.InfoContext(ctx, "deprovisioned user", "userID", payload.ID)
+	s.svc.audit.Record(ctx, audit.Event{Type: audit.EventProvisioning, Success: true, UserID: payload.ID, Reason: "deleted"})
+	s.svc.webhooks.Publish(ctx, webhook.EventUserDeleted, webhook.User{ID: id.String()})
+	return nil
+}
+
+func (s *SCIM) getUser(ctx context.Context, rawID string) (db.User, error) {
+	var id pgtype.UUID
+	if err := id.Scan(rawID); err != nil {
+		return db.User{}, scimNotFound("user not found")
+	}
+	user, err := s.svc.queries.GetUserByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return db.User{}, scimNotFound("user not found")
+		}
+		return db.User{}, fmt.Errorf("get user by id: %w", err)
+	}
+	return user, nil
+}
+
+func (s *SCIM) filterUsers(ctx context.Context, filter string) (*scim.SCIMListResponse, error) {
+	m := scimFilterPattern.FindStringSubmatch(filter)
+	if m == nil {
+		return nil, scimBadRequest("invalidFilter", "only userName eq and externalId eq filters are supported")
+	}
+	value, err := strconv.Unquote(`"` + m[2] + `"`)
+	if err != nil {
+		return nil, scimBadRequest("invalidFilter", "malformed filter value")
+	}
+
+	var user db.User
+	switch strings.ToLower(m[1]) {
+	case "username":
+		user, err = s.svc.queries.GetUserByEmail(ctx, value)
+	case "externalid":
+		user, err = s.svc.queries.GetUserByExternalID(ctx, &value)
+	default:
+		return nil, scimBadRequest("invalidFilter", "unsupported filter attribute "+m[1])
+	}
+
+	resources := []*scim.SCIMUser{}
+	if err == nil {
+		resources = append(resources, s.mapUser(user))
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("filter users: %w", err)
+	}
+	return &scim.SCIMListResponse{
+		Schemas:      []string{scimListSchema},
+		TotalResults: len(resources),
+		StartIndex:   1,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}, nil
+}
+
+func (s *SCIM) mapUser(user db.User) *scim.SCIMUser {
+	id := user.ID.String()
+	return &scim.SCIMUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          id,
+		ExternalID:  user.ExternalID,
+		UserName:    user.Email,
+		Name:        &scim.SCIMName{Formatted: ptr(user.DisplayName)},
+		DisplayName: ptr(user.DisplayName),
+		Emails:      []*scim.SCIMEmail{{Value: user.Email, Type: ptr("work"), Primary: ptr(true)}},
+		Active:      user.Status == userStatusActive,
+		Meta: &scim.SCIMMeta{
+			ResourceType: "User",
+			Created:      user.CreatedAt.Time.UTC().Format(time.RFC3339),
+			LastModified: user.UpdatedAt.Time.UTC().Format(time.RFC3339),
+			Location:     s.baseURL + "/scim/v2/Users/" + id,
+		},
+	}
+}
+
+// applySCIMPatch applies one PatchOp operation. Operations without a path
+// carry an object of attribute values, as sent by Azure AD and Okta.
+func applySCIMPatch(params *db.UpdateProvisionedUserParams, op *scim.SCIMPatchOperation) error {
+	kind := strings.ToLower(op.Op)
+	if kind != "add" && kind != "replace" && kind != "remove" {
+		return scimBadRequest("invalidSyntax", "unsupported patch operation "+op.Op)
+	}
+
+	if op.Path == nil || *op.Path == "" {
+		if kind == "remove" {
+			return scimBadRequest("noTarget", "remove requires a path")
+		}
+		values, ok := op.Value.(map[string]any)
+		if !ok {
+			return scimBadRequest("invalidValue", "value must be an object when path is omitted")
+		}
+		for attr, value := range values {
+			if err := setSCIMAttribute(params, attr, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if kind == "remove" {
+		if strings.EqualFold(*op.Path, "externalId") {
+			params.ExternalID = nil
+			return nil
+		}
+		return scimBadRequest("mutability", *op.Path+" cannot be removed")
+	}
+	return setSCIMAttribute(params, *op.Path, op.Value)
+}
+
+func setSCIMAttribute(params *db.UpdateProvisionedUserParams, attr string, value any) error {
+	path := strings.ToLower(attr)
+	switch {
+	case path == "active":
+		active, ok := scimBool(value)
+		if !ok {
+			return scimBadRequest("invalidValue", "active must be a boolean")
+		}
+		params.Status = scimStatus(params.Status, active)
+		params.StatusReason = ptr("provisioning")
+	case path == "username":
+		email, ok := value.(string)
+		if !ok || !strings.Contains(email, "@") {
+			return scimBadRequest("invalidValue", "userName must be an email address")
+		}
+		params.Email = normalizeEmail(email)
+	case path == "displayname" || path == "name.formatted":
+		name, ok := value.(string)
+		if !ok || strings.TrimSpace(name) == "" {
+			return scimBadRequest("invalidValue", attr+" must be a non-empty string")
+		}
+		params.DisplayName = name
+	case path == "name":
+		name, ok := value.(map[string]any)
+		if !ok {
+			return scimBadRequest("invalidValue", "name must be an object")
+		}
+		if formatted, ok := name["formatted"]; ok {
+			return setSCIMAttribute(params, "name.formatted", formatted)
+		}
+	case path == "externalid":
+		id, ok := value.(string)
+		if !ok {
+			return scimBadRequest("invalidValue", "externalId must be a string")
+		}
+		params.ExternalID = &id
+	case strings.HasPrefix(path, "emails"):
+		// The account has a single email, which is also its userName.
+		switch v := value.(type) {
+		case string:
+			return setSCIMAttribute(params, "userName", v)
+		case []any:
+			if len(v) > 0 {
+				if email, ok := v[0].(map[string]any); ok {
+					return setSCIMAttribute(params, "userName", email["value"])
+				}
+			}
+		}
+		return scimBadRequest("invalidValue", "emails must contain an address")
+	default:
+		return scimBadRequest("invalidPath", "unsupported attribute "+attr)
+	}
+	return nil
+}
+
+// scimStatus maps the SCIM active flag onto an account status. Provisioning
+// clients only deactivate and reactivate accounts; a suspension set by an
+// administrator is kept until an administrator lifts it.
+func scimStatus(current string, active bool) string {
+	switch {
+	case !active:
+		return userStatusDeactivated
+	case current == userStatusSuspended:
+		return userStatusSuspended
+	default:
+		return userStatusActive
+	}
+}
+
+// scimBool accepts JSON booleans and the "True"/"False" strings some
+// identity providers send.
+func scimBool(value any) (bool, bool) {
+	switch v := value.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	}
+	return false, false
+}
+
+func scimEmail(userName string, emails []*scim.SCIMEmail) (string, error) {
+	if strings.Contains(userName, "@") {
+		return normalizeEmail(userName), nil
+	}
+	for _, email := range emails {
+		if email.Primary != nil && *email.Primary {
+			return normalizeEmail(email.Value), nil
+		}
+	}
+	if len(emails) > 0 {
+		return normalizeEmail(emails[0].Value), nil
+	}
+	return "", scimBadRequest("invalidValue", "userName must be an email address or emails must be provided")
+}
+
+func scimDisplayName(displayName *string, name *scim.SCIMName, email string) string {
+	switch {
+	case displayName != nil && strings.TrimSpace(*displayName) != "":
+		return *displayName
+	case name != nil && name.Formatted != nil && strings.TrimSpace(*name.Formatted) != "":
+		return *name.Formatted
+	case name != nil && (name.GivenName != nil || name.FamilyName != nil):
+		var parts []string
+		for _, part := range []*string{name.GivenName, name.FamilyName} {
+			if part != nil && *part != "" {
+				parts = append(parts, *part)
+			}
+		}
+		if len(parts) > 0 {
+			return strings.Join(parts, " ")
+		}
+	}
+	local, _, _ := strings.Cut(email, "@")
+	return local
+}
+
+func passwordPolicyDetail(err *identity.PasswordPolicyError) string {
+	messages := make([]string, 0, len(err.Violations))
+	for _, v := range err.Violations {
+		messages = append(messages, v.Message)
+	}
+	return strings.Join(messages, "; ")
+}
+
+func scimBadRequest(scimType, detail string) *scim.SCIMBadRequest {
+	return &scim.SCIMBadRequest{Schemas: []string{scimErrorSchema}, Status: "400", ScimType: ptr(scimType), Detail: detail}
+}
+
+func scimUnauthorized(detail string) *scim.SCIMUnauthorized {
+	return &scim.SCIMUnauthorized{Schemas: []string{scimErrorSchema}, Status: "401", Detail: detail}
+}
+
+func scimNotFound(detail string) *scim.SCIMNotFound {
+	return &scim.SCIMNotFound{Schemas: []string{scimErrorSchema}, Status: "404", Detail: detail}
+}
+
+func scimConflict(detail string) *scim.SCIMConflict {
+	return &scim.SCIMConflict{Schemas: []string{scimErrorSchema}, Status: "409", ScimType: ptr("uniqueness"), Detail: detail}
+}
@@ -0,0 +1,517 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	goahttp "goa.design/goa/v3/http"
+	"golang.org/x/crypto/bcrypt"
+
+	scimserver "github.com/vidwadeseram/go-boilerplate/identity-api/gen/http/scim/server"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/scim"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
+)
+
+const (
+	testSCIMToken = "scim-test-token"
+	testPassword  = "correct horse battery staple"
+)
+
+// userStore is an in-memory db.DBTX serving the user queries used by the SCIM
+// service and token validation. Audit and webhook writes are accepted and
+// discarded.
+type userStore struct {
+	mu    sync.Mutex
+	users []db.User
+	// events are returned by ListAuthEvents, whose arguments are recorded
+	// in eventQueries.
+	events       []db.AuthEvent
+	eventQueries [][]any
+	// statusLookups counts the GetUserStatus queries.
+	statusLookups int
+}
+
+func (s *userStore) Exec(_ context.Context, sql string, _ ...any) (pgconn.CommandTag, error) {
+	switch queryName(sql) {
+	case "CreateAuthEvent", "CreateWebhookDeliveries":
+		return pgconn.CommandTag{}, nil
+	}
+	return pgconn.CommandTag{}, errors.New("unexpected exec " + queryName(sql))
+}
+
+func (s *userStore) Query(_ context.Context, sql string, args ...any) (pgx.Rows, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if queryName(sql) == "ListAuthEvents" {
+		s.eventQueries = append(s.eventQueries, args)
+		rows := &fakeRows{}
+		for _, e := range s.events {
+			rows.rows = append(rows.rows, fakeRow{values: []any{
+				e.ID, e.EventType, e.Success, e.UserID, e.Email, e.Reason, e.IpAddress, e.UserAgent, e.RequestID, e.CreatedAt,
+			}})
+		}
+		return rows, nil
+	}
+	return nil, errors.New("unexpected query " + queryName(sql))
+}
+
+func (s *userStore) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch queryName(sql) {
+	case "GetUserByID":
+		id := args[0].(pgtype.UUID)
+		return s.find(func(u *db.User) bool { return u.ID == id })
+	case "GetUserByEmail":
+		email := args[0].(string)
+		return s.find(func(u *db.User) bool { return strings.EqualFold(u.Email, email) })
+	case "GetUserByUsername":
+		username := args[0].(string)
+		return s.find(func(u *db.User) bool { return u.Username != nil && strings.EqualFold(*u.Username, username) })
+	case "GetUserStatus":
+		s.statusLookups++
+		id := args[0].(pgtype.UUID)
+		for _, u := range s.users {
+			if u.ID == id {
+				return fakeRow{values: []any{u.Status}}
+			}
+		}
+		return fakeRow{err: pgx.ErrNoRows}
+	case "GetUserByExternalID":
+		externalID := args[0].(*string)
+		return s.find(func(u *db.User) bool { return u.ExternalID != nil && *u.ExternalID == *externalID })
+	case "SetUserStatus":
+		id := args[0].(pgtype.UUID)
+		for i := range s.users {
+			u := &s.users[i]
+			if u.ID == id {
+				u.Status = args[1].(string)
+				u.StatusReason = args[2].(*string)
+				return userRow(*u)
+			}
+		}
+		return fakeRow{err: pgx.ErrNoRows}
+	case "UpdateProvisionedUser":
+		id := args[0].(pgtype.UUID)
+		for i := range s.users {
+			u := &s.users[i]
+			if u.ID != id {
+				continue
+			}
+			u.Email = args[1].(string)
+			u.DisplayName = args[2].(string)
+			u.ExternalID = args[3].(*string)
+			if status := args[4].(string); status != u.Status {
+				u.Status = status
+				u.StatusReason = args[5].(*string)
+			}
+			return userRow(*u)
+		}
+		return fakeRow{err: pgx.ErrNoRows}
+	}
+	return fakeRow{err: errors.New("unexpected query row " + queryName(sql))}
+}
+
+func (s *userStore) find(match func(*db.User) bool) pgx.Row {
+	for i := range s.users {
+		if match(&s.users[i]) {
+			return userRow(s.users[i])
+		}
+	}
+	return fakeRow{err: pgx.ErrNoRows}
+}
+
+func (s *userStore) status(id pgtype.UUID) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, u := range s.users {
+		if u.ID == id {
+			return u.Status
+		}
+	}
+	return ""
+}
+
+// queryName returns X from the "-- name: X :kind" header of a sqlc query.
+func queryName(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) < 3 {
+		return ""
+	}
+	return fields[2]
+}
+
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.values[i]))
+	}
+	return nil
+}
+
+// fakeRows is a pgx.Rows over fixed rows.
+type fakeRows struct {
+	pgx.Rows
+	rows []fakeRow
+	next int
+}
+
+func (r *fakeRows) Next() bool {
+	r.next++
+	return r.next <= len(r.rows)
+}
+
+func (r *fakeRows) Scan(dest ...any) error { return r.rows[r.next-1].Scan(dest...) }
+func (r *fakeRows) Close()                 {}
+func (r *fakeRows) Err() error             { return nil }
+
+func userRow(u db.User) fakeRow {
+	return fakeRow{values: []any{
+		u.ID, u.Email, u.PasswordHash, u.DisplayName, u.CreatedAt, u.Attributes,
+		u.ExternalID, u.UpdatedAt, u.Status, u.StatusReason, u.StatusChangedAt, u.Username,
+	}}
+}
+
+func newTestUser(t *testing.T, id, email, externalID string) db.User {
+	t.Helper()
+	var uid pgtype.UUID
+	if err := uid.Scan(id); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(testPassword), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := pgtype.Timestamptz{Time: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Valid: true}
+	return db.User{
+		ID:           uid,
+		Email:        email,
+		PasswordHash: ptr(string(hash)),
+		DisplayName:  "Jane Doe",
+		CreatedAt:    now,
+		ExternalID:   ptr(externalID),
+		UpdatedAt:    now,
+		Status:       userStatusActive,
+	}
+}
+
+// newSCIMTestServer serves the SCIM API over the given users and returns the
+// server together with the Service backing it.
+func newSCIMTestServer(t *testing.T, store *userStore) (*httptest.Server, *Service) {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	queries := db.New(store)
+	svc := New(log, queries, nil, Options{
+		Audit:    audit.NewRecorder(log, queries),
+		Webhooks: webhook.NewDispatcher(log, queries),
+	})
+	scimSvc := NewSCIM(svc, SCIMOptions{Tokens: []string{testSCIMToken}, BaseURL: "https://id.example.com/"})
+
+	mux := goahttp.NewMuxer()
+	errHandler := func(_ context.Context, _ http.ResponseWriter, err error) { t.Errorf("scim server: %v", err) }
+	srv := scimserver.New(scim.NewEndpoints(scimSvc), mux, goahttp.RequestDecoder, goahttp.ResponseEncoder, errHandler, nil)
+	scimserver.Mount(mux, srv)
+
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts, svc
+}
+
+func scimRequest(t *testing.T, ts *httptest.Server, method, path, token, body string) (int, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(method, ts.URL+path, bytes.NewBufferString(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := ts.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var decoded map[string]any
+	if err := json.NewDecoder(resp.Body).Decode(&decoded); err != nil && !errors.Is(err, io.EOF) {
+		t.Fatalf("decode %s %s response: %v", method, path, err)
+	}
+	return resp.StatusCode, decoded
+}
+
+func assertSchemas(t *testing.T, body map[string]any, want string) {
+	t.Helper()
+	schemas, ok := body["schemas"].([]any)
+	if !ok || len(schemas) != 1 || schemas[0] != want {
+		t.Errorf("schemas = %v, want [%s]", body["schemas"], want)
+	}
+}
+
+func assertSCIMError(t *testing.T, body map[string]any, status, scimType string) {
+	t.Helper()
+	assertSchemas(t, body, scimErrorSchema)
+	// RFC 7644 section 3.12 defines status as a string.
+	if got, ok := body["status"].(string); !ok || got != status {
+		t.Errorf("status = %#v, want string %q", body["status"], status)
+	}
+	if scimType != "" && body["scimType"] != scimType {
+		t.Errorf("scimType = %v, want %s", body["scimType"], scimType)
+	}
+	if detail, _ := body["detail"].(string); detail == "" {
+		t.Error("detail is empty")
+	}
+}
+
+func TestSCIMUserBody(t *testing.T) {
+	user := newTestUser(t, "0b9e2c1e-6c53-4a0e-9a43-5c0f5b1f7d10", "jane@example.com", "ext-1")
+	ts, _ := newSCIMTestServer(t, &userStore{users: []db.User{user}})
+
+	code, body := scimRequest(t, ts, http.MethodGet, "/scim/v2/Users/"+user.ID.String(), testSCIMToken, "")
+	if code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %v", code, http.StatusOK, body)
+	}
+	assertSchemas(t, body, scimUserSchema)
+	for field, want := range map[string]any{
+		"id":          user.ID.String(),
+		"userName":    "jane@example.com",
+		"externalId":  "ext-1",
+		"displayName": "Jane Doe",
+		"active":      true,
+	} {
+		if body[field] != want {
+			t.Errorf("%s = %#v, want %#v", field, body[field], want)
+		}
+	}
+	meta, _ := body["meta"].(map[string]any)
+	if meta["resourceType"] != "User" {
+		t.Errorf("meta.resourceType = %v, want User", meta["resourceType"])
+	}
+	if want := "https://id.example.com/scim/v2/Users/" + user.ID.String(); meta["location"] != want {
+		t.Errorf("meta.location = %v, want %s", meta["location"], want)
+	}
+	if meta["created"] != "2026-01-02T03:04:05Z" {
+		t.Errorf("meta.created = %v, want 2026-01-02T03:04:05Z", meta["created"])
+	}
+}
+
+func TestSCIMErrorBodies(t *testing.T) {
+	ts, _ := newSCIMTestServer(t, &userStore{})
+
+	tests := []struct {
+		name     string
+		path     string
+		token    string
+		code     int
+		status   string
+		scimType string
+	}{
+		{"unauthorized", "/scim/v2/Users/0b9e2c1e-6c53-4a0e-9a43-5c0f5b1f7d10", "wrong", http.StatusUnauthorized, "401", ""},
+		{"not found", "/scim/v2/Users/0b9e2c1e-6c53-4a0e-9a43-5c0f5b1f7d10", testSCIMToken, http.StatusNotFound, "404", ""},
+		{"malformed id", "/scim/v2/Users/not-a-uuid", testSCIMToken, http.StatusNotFound, "404", ""},
+		{"unsupported filter", `/scim/v2/Users?filter=displayName+eq+%22Jane%22`, testSCIMToken, http.StatusBadRequest, "400", "invalidFilter"},
+		{"malformed filter", `/scim/v2/Users?filter=userName+co+%22jane%22`, testSCIMToken, http.StatusBadRequest, "400", "invalidFilter"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, body := scimRequest(t, ts, http.MethodGet, tt.path, tt.token, "")
+			if code != tt.code {
+				t.Fatalf("status code = %d, want %d: %v", code, tt.code, body)
+			}
+			assertSCIMError(t, body, tt.status, tt.scimType)
+		})
+	}
+}
+
+func TestSCIMListUsersFilter(t *testing.T) {
+	user := newTestUser(t, "0b9e2c1e-6c53-4a0e-9a43-5c0f5b1f7d10", "jane@example.com", "ext-1")
+	ts, _ := newSCIMTestServer(t, &userStore{users: []db.User{user}})
+
+	tests := []struct {
+		name   string
+		filter string
+		total  int
+	}{
+		{"userName", `userName eq "jane@example.com"`, 1},
+		{"userName is case-insensitive", `USERNAME eq "Jane@Example.com"`, 1},
+		{"userName without match", `userName eq "john@example.com"`, 0},
+		{"externalId", `externalId eq "ext-1"`, 1},
+		{"externalId without match", `externalId eq "ext-2"`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := "/scim/v2/Users?filter=" + strings.NewReplacer(" ", "+", `"`, "%22").Replace(tt.filter)
+			code, body := scimRequest(t, ts, http.MethodGet, path, testSCIMToken, "")
+			if code != http.StatusOK {
+				t.Fatalf("status code = %d, want %d: %v", code, http.StatusOK, body)
+			}
+			assertSchemas(t, body, scimListSchema)
+			if body["totalResults"] != float64(tt.total) || body["itemsPerPage"] != float64(tt.total) {
+				t.Errorf("totalResults = %v, itemsPerPage = %v, want %d", body["totalResults"], body["itemsPerPage"], tt.total)
+			}
+			if body["startIndex"] != float64(1) {
+				t.Errorf("startIndex = %v, want 1", body["startIndex"])
+			}
+			resources, ok := body["Resources"].([]any)
+			if !ok || len(resources) != tt.total {
+				t.Fatalf("Resources = %v, want %d resources", body["Resources"], tt.total)
+			}
+			if tt.total == 1 {
+				resource := resources[0].(map[string]any)
+				assertSchemas(t, resource, scimUserSchema)
+				if resource["id"] != user.ID.String() {
+					t.Errorf("Resources[0].id = %v, want %s", resource["id"], user.ID.String())
+				}
+			}
+		})
+	}
+}
+
+func TestSCIMPatchUser(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		displayName string
+		externalID  string
+		active      bool
+	}{
+		{
+			name:        "with path",
+			body:        `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"displayName","value":"Jane Smith"}]}`,
+			displayName: "Jane Smith",
+			externalID:  "ext-1",
+			active:      true,
+		},
+		{
+			name:        "without path",
+			body:        `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"Replace","value":{"displayName":"Jane Smith","externalId":"ext-2"}}]}`,
+			displayName: "Jane Smith",
+			externalID:  "ext-2",
+			active:      true,
+		},
+		{
+			name:        "active as string without path",
+			body:        `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","value":{"active":"False"}}]}`,
+			displayName: "Jane Doe",
+			externalID:  "ext-1",
+			active:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newTestUser(t, "0b9e2c1e-6c53-4a0e-9a43-5c0f5b1f7d10", "jane@example.com", "ext-1")
+			ts, _ := newSCIMTestServer(t, &userStore{users: []db.User{user}})
+
+			code, body := scimRequest(t, ts, http.MethodPatch, "/scim/v2/Users/"+user.ID.String(), testSCIMToken, tt.body)
+			if code != http.StatusOK {
+				t.Fatalf("status code = %d, want %d: %v", code, http.StatusOK, body)
+			}
+			assertSchemas(t, body, scimUserSchema)
+			if body["displayName"] != tt.displayName {
+				t.Errorf("displayName = %v, want %s", body["displayName"], tt.displayName)
+			}
+			if body["externalId"] != tt.externalID {
+				t.Errorf("externalId = %v, want %s", body["externalId"], tt.externalID)
+			}
+			if body["active"] != tt.active {
+				t.Errorf("active = %v, want %v", body["active"], tt.active)
+			}
+		})
+	}
+}
+
+func TestSCIMPatchUserErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		scimType string
+	}{
+		{"unsupported op", `{"Operations":[{"op":"move","path":"displayName","value":"x"}]}`, "invalidSyntax"},
+		{"remove without path", `{"Operations":[{"op":"remove"}]}`, "noTarget"},
+		{"value not an object", `{"Operations":[{"op":"replace","value":"x"}]}`, "invalidValue"},
+		{"unsupported path", `{"Operations":[{"op":"replace","path":"title","value":"x"}]}`, "invalidPath"},
+		{"remove required attribute", `{"Operations":[{"op":"remove","path":"userName"}]}`, "mutability"},
+		{"active not a boolean", `{"Operations":[{"op":"replace","path":"active","value":"maybe"}]}`, "invalidValue"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := newTestUser(t, "0b9e2c1e-6c53-4a0e-9a43-5c0f5b1f7d10", "jane@example.com", "ext-1")
+			ts, _ := newSCIMTestServer(t, &userStore{users: []db.User{user}})
+
+			code, body := scimRequest(t, ts, http.MethodPatch, "/scim/v2/Users/"+user.ID.String(), testSCIMToken, tt.body)
+			if code != http.StatusBadRequest {
+				t.Fatalf("status code = %d, want %d: %v", code, http.StatusBadRequest, body)
+			}
+			assertSCIMError(t, body, "400", tt.scimType)
+		})
+	}
+}
+
+func TestSCIMPatchActiveFalseDeactivatesAccount(t *testing.T) {
+	user := newTestUser(t, "0b9e2c1e-6c53-4a0e-9a43-5c0f5b1f7d10", "jane@example.com", "ext-1")
+	store := &userStore{users: []db.User{user}}
+	ts, svc := newSCIMTestServer(t, store)
+
+	body := `{"schemas":["urn:ietf:params:scim:api:messages:2.0:PatchOp"],"Operations":[{"op":"replace","path":"active","value":false}]}`
+	code, resp := scimRequest(t, ts, http.MethodPatch, "/scim/v2/Users/"+user.ID.String(), testSCIMToken, body)
+	if code != http.StatusOK {
+		t.Fatalf("status code = %d, want %d: %v", code, http.StatusOK, resp)
+	}
+	if resp["active"] != false {
+		t.Errorf("active = %v, want false", resp["active"])
+	}
+	if got := store.status(user.ID); got != userStatusDeactivated {
+		t.Fatalf("account status = %q, want %q", got, userStatusDeactivated)
+	}
+
+	_, err := svc.Login(context.Background(), &identity.LoginPayload{Identifier: ptr(user.Email), Password: testPassword})
+	var unauthorized *identity.UnauthorizedError
+	if !errors.As(err, &unauthorized) || unauthorized.Message != "account is "+userStatusDeactivated {
+		t.Fatalf("Login error = %v, want account is %s", err, userStatusDeactivated)
+	}
+}
+
+func TestSCIMStatus(t *testing.T) {
+	tests := []struct {
+		current string
+		active  bool
+		want    string
+	}{
+		{userStatusActive, false, userStatusDeactivated},
+		{userStatusActive, true, userStatusActive},
+		{userStatusDeactivated, true, userStatusActive},
+		{userStatusSuspended, false, userStatusDeactivated},
+		{userStatusSuspended, true, userStatusSuspended},
+	}
+	for _, tt := range tests {
+		if got := scimStatus(tt.current, tt.active); got != tt.want {
+			t.Errorf("scimStatus(%q, %v) = %q, want %q", tt.current, tt.active, got, tt.want)
+		}
+	}
+}
@@ -2,94 +2,441 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	goa "goa.design/goa/v3/pkg"
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
 	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/mail"
 	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/security"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
 )
 
 // Service implements the goa generated interface and orchestrates business logic.
 type Service struct {
-	log     *slog.Logger
-	queries *db.Queries
-	tokens  *security.TokenManager
+	log              *slog.Logger
+	queries          *db.Queries
+	tokens           *security.TokenManager
+	passwords        *security.PasswordPolicy
+	usernames        *security.UsernamePolicy
+	audit            *audit.Recorder
+	clients          *security.ClientRegistry
+	enrichers        *security.EnricherRegistry
+	mailer           mail.Mailer
+	webhooks         *webhook.Dispatcher
+	statuses         *statusCache
+	pool             *pgxpool.Pool
+	audience         string
+	admins           map[string]struct{}
+	magicLink        MagicLinkOptions
+	invitations      InvitationOptions
+	device           DeviceOptions
+	openRegistration bool
+	impersonationTTL time.Duration
+}
+
+// Options holds the policies and collaborators the Service depends on besides
+// its data store and token manager.
+type Options struct {
+	Passwords *security.PasswordPolicy
+	// Usernames holds the naming rules for usernames; the built-in rules
+	// apply when nil.
+	Usernames *security.UsernamePolicy
+	Audit     *audit.Recorder
+	Clients   *security.ClientRegistry
+	// AdminEmails lists the accounts allowed to call administrative methods.
+	AdminEmails []string
+	// ImpersonationTTL is the lifetime of tokens issued by ExchangeToken.
+	ImpersonationTTL time.Duration
+	// Mailer delivers login links and other account emails.
+	Mailer    mail.Mailer
+	MagicLink MagicLinkOptions
+	// DB runs multi-statement operations in a transaction; it must be the
+	// pool backing the queries.
+	DB *pgxpool.Pool
+	// OpenRegistration allows anyone to call Register. When false, accounts
+	// can only be created by accepting an invitation.
+	OpenRegistration bool
+	Invitations      InvitationOptions
+	Device           DeviceOptions
+	// Webhooks queues user lifecycle events for subscribed endpoints.
+	Webhooks *webhook.Dispatcher
+	// Audience is the audience JWTs must carry to call the identity service's
+	// own authenticated methods; empty accepts any audience.
+	Audience string
+	// Enrichers add custom claims to tokens issued by Login and
+	// ConsumeMagicLink.
+	Enrichers *security.EnricherRegistry
+	// StatusCacheTTL is how long account statuses are cached when validating
+	// tokens; zero looks the status up for every token.
+	StatusCacheTTL time.Duration
 }
 
 // New creates a new Service instance.
-func New(log *slog.Logger, queries *db.Queries, tokens *security.TokenManager) *Service {
-	return &Service{log: log, queries: queries, tokens: tokens}
+func New(log *slog.Logger, queries *db.Queries, tokens *security.TokenManager, opts Options) *Service {
+	admins := make(map[string]struct{}, len(opts.AdminEmails))
+	for _, email := range opts.AdminEmails {
+		if email = strings.ToLower(strings.TrimSpace(email)); email != "" {
+			admins[email] = struct{}{}
+		}
+	}
+	if opts.Usernames == nil {
+		opts.Usernames = security.NewUsernamePolicy(nil)
+	}
+	if opts.ImpersonationTTL <= 0 {
+		opts.ImpersonationTTL = defaultImpersonationTTL
+	}
+	if opts.MagicLink.TTL <= 0 {
+		opts.MagicLink.TTL = defaultMagicLinkTTL
+	}
+	if opts.Invitations.TTL <= 0 {
+		opts.Invitations.TTL = defaultInvitationTTL
+	}
+	if opts.Device.TTL <= 0 {
+		opts.Device.TTL = defaultDeviceCodeTTL
+	}
+	if opts.Device.Interval <= 0 {
+		opts.Device.Interval = defaultDevicePollInterval
+	}
+	return &Service{
+		log:              log,
+		queries:          queries,
+		tokens:           tokens,
+		passwords:        opts.Passwords,
+		usernames:        opts.Usernames,
+		audit:            opts.Audit,
+		clients:          opts.Clients,
+		enrichers:        opts.Enrichers,
+		mailer:           opts.Mailer,
+		webhooks:         opts.Webhooks,
+		statuses:         newStatusCache(opts.StatusCacheTTL),
+		pool:             opts.DB,
+		audience:         opts.Audience,
+		admins:           admins,
+		magicLink:        opts.MagicLink,
+		invitations:      opts.Invitations,
+		device:           opts.Device,
+		openRegistration: opts.OpenRegistration,
+		impersonationTTL: opts.ImpersonationTTL,
+	}
 }
 
-// Register creates a new user.
+// Register creates a new user. It is refused when open registration is
+// disabled, in which case accounts can only be created through invitations.
 func (s *Service) Register(ctx context.Context, payload *identity.RegisterPayload) (*identity.User, error) {
-	hashed, err := bcrypt.GenerateFromPassword([]byte(payload.Password), bcrypt.DefaultCost)
+	if !s.openRegistration {
+		s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Email: payload.Email, Reason: "open registration disabled"})
+		return nil, &identity.UnauthorizedError{Message: "registration is by invitation only"}
+	}
+
+	email := normalizeEmail(payload.Email)
+	if payload.Username != nil {
+		if err := s.checkUsername(*payload.Username); err != nil {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Email: email, Reason: "invalid username"})
+			return nil, err
+		}
+	}
+	passwordHash, err := s.hashPassword(ctx, payload.Password, email, payload.DisplayName)
 	if err != nil {
-		return nil, fmt.Errorf("hash password: %w", err)
+		return nil, err
 	}
 
 	user, err := s.queries.CreateUser(ctx, db.CreateUserParams{
-		Email:        payload.Email,
-		PasswordHash: string(hashed),
+		Email:        email,
+		PasswordHash: passwordHash,
 		DisplayName:  payload.DisplayName,
+		Attributes:   []byte("{}"),
+		Username:     payload.Username,
 	})
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return nil, fmt.Errorf("email already exists: %w", err)
+		if isUsernameViolation(err) {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Email: email, Reason: "username already exists"})
+			return nil, usernameConflictError()
+		}
+		if isUniqueViolation(err) {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Email: email, Reason: "email already exists"})
+			return nil, emailConflictError()
 		}
 		return nil, fmt.Errorf("create user: %w", err)
 	}
 
 	result := mapUser(user)
 	s.log.InfoContext(ctx, "registered user", "userID", result.ID)
+	s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Success: true, UserID: result.ID, Email: result.Email})
+	s.publishUser(ctx, webhook.EventUserRegistered, user)
 	return result, nil
 }
 
-// Login validates credentials and issues a token.
-func (s *Service) Login(ctx context.Context, payload *identity.Credentials) (*identity.TokenResult, error) {
-	user, err := s.queries.GetUserByEmail(ctx, payload.Email)
+// hashPassword applies the password policy and returns the bcrypt hash to
+// store. Accounts without a password are only accepted when magic link login
+// is enabled, since they have no other way to sign in.
+func (s *Service) hashPassword(ctx context.Context, password *string, email, displayName string) (*string, error) {
+	if password == nil {
+		if !s.magicLink.Enabled {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Email: email, Reason: "password required"})
+			return nil, passwordPolicyError([]security.PolicyViolation{{Rule: security.RuleRequired, Message: "password is required"}})
+		}
+		return nil, nil
+	}
+
+	if violations := s.passwords.Check(*password, email, displayName); len(violations) > 0 {
+		s.log.InfoContext(ctx, "registration rejected by password policy", "violations", len(violations))
+		s.audit.Record(ctx, audit.Event{Type: audit.EventRegister, Email: email, Reason: "password policy"})
+		return nil, passwordPolicyError(violations)
+	}
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte(*password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("hash password: %w", err)
+	}
+	return ptr(string(hashed)), nil
+}
+
+// Login validates credentials and issues a token. Users identify themselves
+// by email or username; the deprecated email field is still accepted.
+func (s *Service) Login(ctx context.Context, payload *identity.LoginPayload) (*identity.TokenResult, error) {
+	identifier := ""
+	switch {
+	case payload.Identifier != nil:
+		identifier = strings.TrimSpace(*payload.Identifier)
+	case payload.Email != nil:
+		identifier = *payload.Email
+	}
+	if identifier == "" {
+		return nil, goa.MissingFieldError("identifier", "body")
+	}
+
+	user, err := s.lookupUser(ctx, identifier)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			s.log.WarnContext(ctx, "login failed: user not found", "email", payload.Email)
+			s.log.WarnContext(ctx, "login failed: user not found", "identifier", identifier)
+			s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, Email: identifier, Reason: "user not found"})
 			return nil, &identity.UnauthorizedError{Message: "invalid credentials"}
 		}
-		return nil, fmt.Errorf("get user by email: %w", err)
+		return nil, fmt.Errorf("get user: %w", err)
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(payload.Password)); err != nil {
-		s.log.WarnContext(ctx, "login failed: password mismatch", "email", payload.Email)
+	if user.PasswordHash == nil {
+		s.log.WarnContext(ctx, "login failed: account has no password", "identifier", identifier)
+		s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, UserID: user.ID.String(), Email: user.Email, Reason: "no password set"})
+		return nil, &identity.UnauthorizedError{Message: "invalid credentials"}
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(*user.PasswordHash), []byte(payload.Password)); err != nil {
+		s.log.WarnContext(ctx, "login failed: password mismatch", "identifier", identifier)
+		s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, UserID: user.ID.String(), Email: user.Email, Reason: "password mismatch"})
 		return nil, &identity.UnauthorizedError{Message: "invalid credentials"}
 	}
+	if user.Status != userStatusActive {
+		s.log.WarnContext(ctx, "login failed: account "+user.Status, "identifier", identifier)
+		s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, UserID: user.ID.String(), Email: user.Email, Reason: "account " + user.Status})
+		return nil, &identity.UnauthorizedError{Message: "account is " + user.Status}
+	}
 
-	signed, ttl, err := s.tokens.Issue(user)
+	result, err := s.issueToken(ctx, user, payload.TokenFormat, payload.Audience)
 	if err != nil {
-		return nil, fmt.Errorf("issue token: %w", err)
+		return nil, err
 	}
+	s.audit.Record(ctx, audit.Event{Type: audit.EventLogin, Success: true, UserID: user.ID.String(), Email: user.Email})
 
-	return &identity.TokenResult{AccessToken: signed, ExpiresIn: int(ttl.Seconds())}, nil
+	return result, nil
 }
 
-// ValidateToken verifies JWTs and exposes user identity.
+// ValidateToken verifies JWTs and opaque access tokens and exposes user identity.
 func (s *Service) ValidateToken(ctx context.Context, payload *identity.ValidateTokenPayload) (*identity.ValidationResult, error) {
-	claims, err := s.tokens.Validate(payload.Token)
+	audience := ""
+	if payload.Audience != nil {
+		audience = *payload.Audience
+	}
+	return s.validate(ctx, payload.Token, audience)
+}
+
+// validate checks a single access token for ValidateToken and its batch and
+// streaming variants. Invalid tokens yield a result with a reason rather than
+// an error.
+func (s *Service) validate(ctx context.Context, token, audience string) (*identity.ValidationResult, error) {
+	claims, err := s.resolveToken(ctx, token, audience)
 	if err != nil {
+		var invalid *invalidTokenError
+		if !errors.As(err, &invalid) {
+			return nil, err
+		}
 		s.log.WarnContext(ctx, "token validation failed", "error", err)
+		s.audit.Record(ctx, audit.Event{Type: audit.EventValidateToken, Reason: err.Error()})
 		return &identity.ValidationResult{Valid: false, Reason: ptr(err.Error())}, nil
 	}
+	s.audit.Record(ctx, audit.Event{Type: audit.EventValidateToken, Success: true, UserID: claims.UserID, Email: claims.Email})
+
+	result := &identity.ValidationResult{
+		Valid:    true,
+		UserID:   ptr(claims.UserID),
+		Email:    ptr(claims.Email),
+		Audience: claims.Audience,
+		Claims:   claims.Custom,
+	}
+	if claims.Actor != nil {
+		result.Actor = &identity.Actor{UserID: claims.Actor.UserID, Email: ptr(claims.Actor.Email)}
+	}
+	return result, nil
+}
+
+// ListAuthEvents returns audit events matching the filters, newest first.
+func (s *Service) ListAuthEvents(ctx context.Context, payload *identity.ListAuthEventsPayload) (*identity.AuthEventsCollection, error) {
+	if _, err := s.authorizeAdmin(ctx, payload.Token); err != nil {
+		return nil, err
+	}
+
+	params := db.ListAuthEventsParams{
+		EventType: payload.Type,
+		BeforeID:  payload.BeforeID,
+		RowLimit:  int32(payload.Limit),
+	}
+	if payload.UserID != nil {
+		if err := params.UserID.Scan(*payload.UserID); err != nil {
+			return nil, badRequestError("invalid user_id")
+		}
+	}
+	var err error
+	if params.Since, err = parseTimestamp(payload.Since); err != nil {
+		return nil, badRequestError("invalid since")
+	}
+	if params.Until, err = parseTimestamp(payload.Until); err != nil {
+		return nil, badRequestError("invalid until")
+	}
 
-	return &identity.ValidationResult{
-		Valid:  true,
-		UserID: ptr(claims.UserID),
-		Email:  ptr(claims.Email),
-	}, nil
+	rows, err := s.queries.ListAuthEvents(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("list auth events: %w", err)
+	}
+
+	events := make([]*identity.AuthEvent, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, mapAuthEvent(row))
+	}
+	return &identity.AuthEventsCollection{Events: events}, nil
+}
+
+// authorizeAdmin validates the bearer token and checks that it belongs to a
+// configured administrator.
+func (s *Service) authorizeAdmin(ctx context.Context, raw string) (*security.Claims, error) {
+	claims, err := s.authenticate(ctx, raw)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Actor != nil {
+		return nil, &identity.UnauthorizedError{Message: "impersonation tokens cannot perform administrative actions"}
+	}
+	if !s.isAdmin(claims) {
+		s.log.WarnContext(ctx, "admin access denied", "userID", claims.UserID)
+		return nil, &identity.UnauthorizedError{Message: "administrator access required"}
+	}
+	return claims, nil
+}
+
+// isAdmin reports whether validated claims belong to a configured
+// administrator acting as themselves.
+func (s *Service) isAdmin(claims *security.Claims) bool {
+	if claims.Actor != nil {
+		return false
+	}
+	_, ok := s.admins[strings.ToLower(claims.Email)]
+	return ok
+}
+
+// authenticate validates the bearer token of the calling user.
+func (s *Service) authenticate(ctx context.Context, raw string) (*security.Claims, error) {
+	claims, err := s.resolveToken(ctx, extractToken(raw), s.audience)
+	if err != nil {
+		var invalid *invalidTokenError
+		if !errors.As(err, &invalid) {
+			return nil, err
+		}
+		return nil, &identity.UnauthorizedError{Message: err.Error()}
+	}
+	return claims, nil
+}
+
+// normalizeEmail returns the canonical form of an email address. Emails are
+// stored in this form and unique regardless of case.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func emailConflictError() *identity.ConflictError {
+	return &identity.ConflictError{
+		Message: "a user with this email already exists",
+		ID:      ptr("identity:conflict"),
+	}
+}
+
+func badRequestError(message string) *identity.BadRequestError {
+	return &identity.BadRequestError{Message: message, ID: ptr("identity:bad_request")}
+}
+
+func extractToken(value string) string {
+	value = strings.TrimSpace(value)
+	parts := strings.SplitN(value, " ", 2)
+	if len(parts) == 2 && strings.EqualFold(parts[0], "Bearer") {
+		return strings.TrimSpace(parts[1])
+	}
+	return value
+}
+
+func parseTimestamp(value *string) (pgtype.Timestamptz, error) {
+	if value == nil {
+		return pgtype.Timestamptz{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return pgtype.Timestamptz{}, fmt.Errorf("parse timestamp: %w", err)
+	}
+	return pgtype.Timestamptz{Time: t, Valid: true}, nil
+}
+
+func mapAuthEvent(e db.AuthEvent) *identity.AuthEvent {
+	event := &identity.AuthEvent{
+		ID:        e.ID,
+		Type:      e.EventType,
+		Success:   e.Success,
+		Email:     e.Email,
+		Reason:    e.Reason,
+		IPAddress: e.IpAddress,
+		UserAgent: e.UserAgent,
+		RequestID: e.RequestID,
+		CreatedAt: e.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}
+	if e.UserID.Valid {
+		event.UserID = ptr(e.UserID.String())
+	}
+	return event
+}
+
+func passwordPolicyError(violations []security.PolicyViolation) *identity.PasswordPolicyError {
+	result := &identity.PasswordPolicyError{
+		Message:    "password does not satisfy the password policy",
+		ID:         ptr("identity:password_policy"),
+		Violations: make([]*identity.PolicyViolation, 0, len(violations)),
+	}
+	for _, v := range violations {
+		result.Violations = append(result.Violations, &identity.PolicyViolation{Rule: v.Rule, Message: v.Message})
+	}
+	return result
 }
 
 func mapUser(u db.User) *identity.User {
@@ -104,11 +451,29 @@ func mapUser(u db.User) *identity.User {
 	}
 
 	return &identity.User{
-		ID:          id,
-		Email:       u.Email,
-		DisplayName: u.DisplayName,
-		CreatedAt:   createdAt.UTC().Format(time.RFC3339),
+		ID:              id,
+		Email:           u.Email,
+		DisplayName:     u.DisplayName,
+		CreatedAt:       createdAt.UTC().Format(time.RFC3339),
+		Attributes:      decodeAttributes(u.Attributes),
+		Status:          u.Status,
+		StatusReason:    u.StatusReason,
+		StatusChangedAt: formatOptionalTime(u.StatusChangedAt),
+		Username:        u.Username,
+	}
+}
+
+// decodeAttributes converts a JSONB attribute object into a map. Missing or
+// malformed values yield nil so that attributes never break user lookups.
+func decodeAttributes(raw []byte) map[string]string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var attrs map[string]string
+	if err := json.Unmarshal(raw, &attrs); err != nil || len(attrs) == 0 {
+		return nil
 	}
+	return attrs
 }
 
 func ptr[T any](v T) *T {
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+func TestListAuthEventsFilters(t *testing.T) {
+	admin := newTestUser(t, testAdminID, "admin@example.com", "admin")
+	store := &userStore{
+		users: []db.User{admin},
+		events: []db.AuthEvent{{
+			ID:        42,
+			EventType: "login",
+			Success:   true,
+			UserID:    admin.ID,
+			IpAddress: ptr("198.51.100.1"),
+			CreatedAt: pgtype.Timestamptz{Time: time.Date(2026, 3, 4, 5, 6, 7, 0, time.FixedZone("", 3600)), Valid: true},
+		}},
+	}
+	svc, tokens := newTokenTestService(t, store, 0)
+	token := issueTestToken(t, tokens, admin)
+
+	result, err := svc.ListAuthEvents(context.Background(), &identity.ListAuthEventsPayload{
+		Token:    token,
+		UserID:   ptr(testAdminID),
+		Type:     ptr("login"),
+		Since:    ptr("2026-01-01T00:00:00Z"),
+		Until:    ptr("2026-02-01T12:00:00+02:00"),
+		BeforeID: ptr(int64(100)),
+		Limit:    25,
+	})
+	if err != nil {
+		t.Fatalf("ListAuthEvents() error = %v", err)
+	}
+	if len(result.Events) != 1 {
+		t.Fatalf("got %d events, want 1", len(result.Events))
+	}
+	event := result.Events[0]
+	if event.ID != 42 || *event.UserID != testAdminID || *event.IPAddress != "198.51.100.1" || event.CreatedAt != "2026-03-04T04:06:07Z" {
+		t.Errorf("event = %+v, want event 42 of the administrator at 2026-03-04T04:06:07Z", event)
+	}
+
+	args := store.eventQueries[0]
+	if userID := args[0].(pgtype.UUID); !userID.Valid || userID.String() != testAdminID {
+		t.Errorf("user_id filter = %v, want %s", userID, testAdminID)
+	}
+	if eventType := args[1].(*string); eventType == nil || *eventType != "login" {
+		t.Errorf("type filter = %v, want login", eventType)
+	}
+	if since := args[2].(pgtype.Timestamptz); !since.Valid || !since.Time.Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("since filter = %v, want 2026-01-01T00:00:00Z", since)
+	}
+	if until := args[3].(pgtype.Timestamptz); !until.Valid || !until.Time.Equal(time.Date(2026, 2, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("until filter = %v, want 2026-02-01T10:00:00Z", until)
+	}
+	if beforeID := args[4].(*int64); beforeID == nil || *beforeID != 100 {
+		t.Errorf("before_id filter = %v, want 100", beforeID)
+	}
+	if limit := args[5].(int32); limit != 25 {
+		t.Errorf("limit = %d, want 25", limit)
+	}
+
+	if _, err := svc.ListAuthEvents(context.Background(), &identity.ListAuthEventsPayload{Token: token, Limit: 100}); err != nil {
+		t.Fatalf("ListAuthEvents() without filters error = %v", err)
+	}
+	args = store.eventQueries[1]
+	if args[0].(pgtype.UUID).Valid || args[1].(*string) != nil || args[2].(pgtype.Timestamptz).Valid ||
+		args[3].(pgtype.Timestamptz).Valid || args[4].(*int64) != nil {
+		t.Errorf("filters without a payload value = %v, want them all unset", args[:5])
+	}
+}
+
+func TestListAuthEventsErrors(t *testing.T) {
+	admin := newTestUser(t, testAdminID, "admin@example.com", "admin")
+	user := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	store := &userStore{users: []db.User{admin, user}}
+	svc, tokens := newTokenTestService(t, store, 0)
+	token := issueTestToken(t, tokens, admin)
+
+	tests := []struct {
+		name        string
+		payload     *identity.ListAuthEventsPayload
+		wantMessage string
+	}{
+		{name: "invalid user_id", payload: &identity.ListAuthEventsPayload{Token: token, UserID: ptr("jane")}, wantMessage: "invalid user_id"},
+		{name: "invalid since", payload: &identity.ListAuthEventsPayload{Token: token, Since: ptr("2026-01-01")}, wantMessage: "invalid since"},
+		{name: "invalid until", payload: &identity.ListAuthEventsPayload{Token: token, Until: ptr("yesterday")}, wantMessage: "invalid until"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ListAuthEvents(context.Background(), tt.payload)
+			var badRequest *identity.BadRequestError
+			if !errors.As(err, &badRequest) || badRequest.Message != tt.wantMessage {
+				t.Errorf("ListAuthEvents() error = %v, want bad request %q", err, tt.wantMessage)
+			}
+		})
+	}
+
+	_, err := svc.ListAuthEvents(context.Background(), &identity.ListAuthEventsPayload{Token: issueTestToken(t, tokens, user)})
+	var unauthorized *identity.UnauthorizedError
+	if !errors.As(err, &unauthorized) {
+		t.Errorf("ListAuthEvents() by a regular user error = %v, want unauthorized", err)
+	}
+	if len(store.eventQueries) != 0 {
+		t.Errorf("ran %d event queries for refused requests, want none", len(store.eventQueries))
+	}
+}
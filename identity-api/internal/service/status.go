@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
+)
+
+// Account statuses stored in users.status. Only active accounts can sign in
+// or use their tokens.
+const (
+	userStatusActive      = "active"
+	userStatusSuspended   = "suspended"
+	userStatusDeactivated = "deactivated"
+)
+
+// maxStatusCacheEntries bounds the memory used by the status cache.
+const maxStatusCacheEntries = 100_000
+
+// SetUserStatus suspends, deactivates or reactivates an account. The change
+// takes effect immediately on this instance; other instances pick it up once
+// their cached status expires.
+func (s *Service) SetUserStatus(ctx context.Context, payload *identity.SetUserStatusPayload) (*identity.User, error) {
+	admin, err := s.authorizeAdmin(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var id pgtype.UUID
+	if err := id.Scan(payload.ID); err != nil {
+		return nil, &identity.NotFoundError{Message: "invalid user id"}
+	}
+	if payload.ID == admin.UserID && payload.Status != userStatusActive {
+		return nil, &identity.UnauthorizedError{Message: "administrators cannot disable their own account"}
+	}
+
+	var reason *string
+	if payload.Reason != nil && strings.TrimSpace(*payload.Reason) != "" {
+		reason = ptr(strings.TrimSpace(*payload.Reason))
+	}
+	user, err := s.queries.SetUserStatus(ctx, db.SetUserStatusParams{
+		ID:           id,
+		Status:       payload.Status,
+		StatusReason: reason,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &identity.NotFoundError{Message: "user not found"}
+		}
+		return nil, fmt.Errorf("set user status: %w", err)
+	}
+	s.statuses.forget(payload.ID)
+
+	s.log.InfoContext(ctx, "changed user status", "userID", payload.ID, "status", user.Status, "changedBy", admin.UserID)
+	s.audit.Record(ctx, audit.Event{Type: audit.EventUserStatus, Success: true, UserID: payload.ID, Email: user.Email, Reason: statusAuditReason(user)})
+	event := webhook.EventUserUpdated
+	if user.Status != userStatusActive {
+		event = webhook.EventUserDisabled
+	}
+	s.publishUser(ctx, event, user)
+	return mapUser(user), nil
+}
+
+// checkStatus rejects tokens of accounts that are no longer active. JWTs are
+// validated without a database round trip, so the status is looked up through
+// the status cache.
+func (s *Service) checkStatus(ctx context.Context, userID string) error {
+	status, ok := s.statuses.get(userID)
+	if !ok {
+		var id pgtype.UUID
+		if err := id.Scan(userID); err != nil {
+			return &invalidTokenError{reason: "invalid subject claim"}
+		}
+		var err error
+		status, err = s.queries.GetUserStatus(ctx, id)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("get user status: %w", err)
+		}
+		// Deleted accounts are cached with an empty status.
+		s.statuses.set(userID, status)
+	}
+	if status == "" {
+		return &invalidTokenError{reason: "account not found"}
+	}
+	if status != userStatusActive {
+		return &invalidTokenError{reason: "account is " + status}
+	}
+	return nil
+}
+
+// statusAuditReason describes a status change for the audit log.
+func statusAuditReason(user db.User) string {
+	if user.StatusReason == nil {
+		return user.Status
+	}
+	return user.Status + ": " + *user.StatusReason
+}
+
+// statusCache remembers account statuses for a short time so that validating
+// a JWT does not hit the database. A zero ttl disables caching.
+type statusCache struct {
+	ttl     time.Duration
+	mu      sync.Mutex
+	entries map[string]statusEntry
+}
+
+type statusEntry struct {
+	status  string
+	expires time.Time
+}
+
+func newStatusCache(ttl time.Duration) *statusCache {
+	return &statusCache{ttl: ttl, entries: make(map[string]statusEntry)}
+}
+
+func (c *statusCache) get(userID string) (string, bool) {
+	if c.ttl <= 0 {
+		return "", false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[userID]
+	if !ok || time.Now().After(entry.expires) {
+		return "", false
+	}
+	return entry.status, true
+}
+
+func (c *statusCache) set(userID, status string) {
+	if c.ttl <= 0 {
+		return
+	}
+	now := time.Now()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= maxStatusCacheEntries {
+		for id, entry := range c.entries {
+			if now.After(entry.expires) {
+				delete(c.entries, id)
+			}
+		}
+		if len(c.entries) >= maxStatusCacheEntries {
+			clear(c.entries)
+		}
+	}
+	c.entries[userID] = statusEntry{status: status, expires: now.Add(c.ttl)}
+}
+
+func (c *statusCache) forget(userID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, userID)
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
+)
+
+func TestLoginDisabledAccount(t *testing.T) {
+	for _, status := range []string{userStatusSuspended, userStatusDeactivated} {
+		t.Run(status, func(t *testing.T) {
+			user := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+			user.Status = status
+			svc, _ := newTokenTestService(t, &userStore{users: []db.User{user}}, 0)
+
+			_, err := svc.Login(context.Background(), &identity.LoginPayload{
+				Identifier: ptr("jane@example.com"),
+				Password:   testPassword,
+			})
+			var unauthorized *identity.UnauthorizedError
+			if !errors.As(err, &unauthorized) || unauthorized.Message != "account is "+status {
+				t.Fatalf("Login() error = %v, want unauthorized with account is %s", err, status)
+			}
+		})
+	}
+}
+
+func TestValidateTokenAccountStatus(t *testing.T) {
+	admin := newTestUser(t, testAdminID, "admin@example.com", "admin")
+	user := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	store := &userStore{users: []db.User{admin, user}}
+	svc, tokens := newTokenTestService(t, store, 0)
+	svc.statuses = newStatusCache(time.Minute)
+	svc.webhooks = webhook.NewDispatcher(slog.New(slog.NewTextHandler(io.Discard, nil)), db.New(store))
+	token := issueTestToken(t, tokens, user)
+
+	validate := func() *identity.ValidationResult {
+		t.Helper()
+		result, err := svc.ValidateToken(context.Background(), &identity.ValidateTokenPayload{Token: token})
+		if err != nil {
+			t.Fatalf("ValidateToken() error = %v", err)
+		}
+		return result
+	}
+
+	if result := validate(); !result.Valid {
+		t.Fatalf("ValidateToken() = invalid (%v), want valid", result.Reason)
+	}
+	validate()
+	if store.statusLookups != 1 {
+		t.Errorf("status lookups = %d, want 1 for two validations within the cache ttl", store.statusLookups)
+	}
+
+	// A change made behind the service's back is only seen once the cached
+	// status expires.
+	store.mu.Lock()
+	store.users[1].Status = userStatusSuspended
+	store.mu.Unlock()
+	if result := validate(); !result.Valid {
+		t.Errorf("ValidateToken() = invalid (%v), want the cached active status", result.Reason)
+	}
+
+	// SetUserStatus takes effect immediately.
+	_, err := svc.SetUserStatus(context.Background(), &identity.SetUserStatusPayload{
+		Token:  issueTestToken(t, tokens, admin),
+		ID:     testSubjectID,
+		Status: userStatusDeactivated,
+	})
+	if err != nil {
+		t.Fatalf("SetUserStatus() error = %v", err)
+	}
+	result := validate()
+	if result.Valid || result.Reason == nil || *result.Reason != "account is deactivated" {
+		t.Errorf("ValidateToken() = %+v, want invalid with account is deactivated", result)
+	}
+}
+
+func TestValidateTokenWithoutStatusCache(t *testing.T) {
+	user := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	store := &userStore{users: []db.User{user}}
+	svc, tokens := newTokenTestService(t, store, 0)
+	token := issueTestToken(t, tokens, user)
+
+	for _, status := range []string{userStatusActive, userStatusSuspended} {
+		store.mu.Lock()
+		store.users[0].Status = status
+		store.mu.Unlock()
+		result, err := svc.ValidateToken(context.Background(), &identity.ValidateTokenPayload{Token: token})
+		if err != nil {
+			t.Fatalf("ValidateToken() error = %v", err)
+		}
+		if want := status == userStatusActive; result.Valid != want {
+			t.Errorf("%s: valid = %t, want %t", status, result.Valid, want)
+		}
+	}
+
+	// Deleted accounts are rejected as well.
+	store.mu.Lock()
+	store.users = nil
+	store.mu.Unlock()
+	result, err := svc.ValidateToken(context.Background(), &identity.ValidateTokenPayload{Token: token})
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if result.Valid || result.Reason == nil || *result.Reason != "account not found" {
+		t.Errorf("ValidateToken() = %+v, want invalid with account not found", result)
+	}
+	if store.statusLookups != 3 {
+		t.Errorf("status lookups = %d, want one per validation without a cache", store.statusLookups)
+	}
+}
+
+func TestStatusCacheExpiry(t *testing.T) {
+	cache := newStatusCache(20 * time.Millisecond)
+	cache.set("a", userStatusActive)
+	if status, ok := cache.get("a"); !ok || status != userStatusActive {
+		t.Fatalf("get() = %q, %t, want active", status, ok)
+	}
+	cache.forget("a")
+	if _, ok := cache.get("a"); ok {
+		t.Error("get() after forget found an entry")
+	}
+	cache.set("b", userStatusSuspended)
+	time.Sleep(30 * time.Millisecond)
+	if _, ok := cache.get("b"); ok {
+		t.Error("get() after the ttl found an entry")
+	}
+}
@@ -0,0 +1,256 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	goasecurity "goa.design/goa/v3/security"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/security"
+)
+
+// tokenFormatOpaque selects database-backed opaque access tokens in
+// LoginPayload.TokenFormat; the default format is a signed JWT.
+const tokenFormatOpaque = "opaque"
+
+// tokenTypeBearer is the OAuth 2.0 token type reported for access tokens.
+const tokenTypeBearer = "Bearer"
+
+// defaultImpersonationTTL bounds impersonation tokens when no lifetime is
+// configured.
+const defaultImpersonationTTL = 15 * time.Minute
+
+// invalidTokenError reports a token that is malformed, expired, revoked or
+// unknown, as opposed to a failure to look it up.
+type invalidTokenError struct {
+	reason string
+}
+
+func (e *invalidTokenError) Error() string {
+	return e.reason
+}
+
+// BasicAuth authenticates OAuth clients calling client-authenticated methods.
+func (s *Service) BasicAuth(ctx context.Context, user, pass string, schema *goasecurity.BasicScheme) (context.Context, error) {
+	if !s.clients.Authenticate(user, pass) {
+		s.log.WarnContext(ctx, "client authentication failed", "clientID", user)
+		return ctx, &identity.UnauthorizedError{Message: "invalid client credentials"}
+	}
+	return ctx, nil
+}
+
+// Introspect implements OAuth 2.0 token introspection (RFC 7662). Inactive,
+// unknown and malformed tokens all yield a bare {"active": false} response.
+func (s *Service) Introspect(ctx context.Context, payload *identity.IntrospectPayload) (*identity.IntrospectionResult, error) {
+	claims, err := s.resolveToken(ctx, payload.Token, "")
+	if err != nil {
+		var invalid *invalidTokenError
+		if !errors.As(err, &invalid) {
+			return nil, err
+		}
+		s.log.InfoContext(ctx, "introspected inactive token", "clientID", payload.ClientID, "reason", err)
+		return &identity.IntrospectionResult{Active: false}, nil
+	}
+	s.audit.Record(ctx, audit.Event{Type: audit.EventValidateToken, Success: true, UserID: claims.UserID, Email: claims.Email})
+
+	result := &identity.IntrospectionResult{
+		Active:    true,
+		Sub:       ptr(claims.UserID),
+		Username:  ptr(claims.Email),
+		TokenType: ptr(tokenTypeBearer),
+	}
+	if !claims.ExpiresAt.IsZero() {
+		result.Exp = ptr(claims.ExpiresAt.Unix())
+	}
+	if !claims.IssuedAt.IsZero() {
+		result.Iat = ptr(claims.IssuedAt.Unix())
+	}
+	if claims.Scope != "" {
+		result.Scope = ptr(claims.Scope)
+	}
+	if claims.ClientID != "" {
+		result.ClientID = ptr(claims.ClientID)
+	}
+	if claims.Issuer != "" {
+		result.Iss = ptr(claims.Issuer)
+	}
+	result.Aud = claims.Audience
+	return result, nil
+}
+
+// RevokeToken implements OAuth 2.0 token revocation (RFC 7009) for opaque
+// access tokens. As the RFC requires, unknown and already revoked tokens are
+// not reported to the client.
+func (s *Service) RevokeToken(ctx context.Context, payload *identity.RevokeTokenPayload) error {
+	if security.IsJWT(payload.Token) {
+		return oauthError("unsupported_token_type", "JWT access tokens cannot be revoked; they expire on their own")
+	}
+
+	userID, err := s.queries.RevokeAccessToken(ctx, security.HashOpaqueToken(payload.Token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.log.InfoContext(ctx, "revocation of unknown or revoked token", "clientID", payload.ClientID)
+			return nil
+		}
+		return fmt.Errorf("revoke access token: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "revoked access token", "clientID", payload.ClientID, "userID", userID.String())
+	s.audit.Record(ctx, audit.Event{Type: audit.EventTokenRevoked, Success: true, UserID: userID.String(), Reason: "revoked by client " + payload.ClientID})
+	return nil
+}
+
+// ExchangeToken lets an administrator obtain a short-lived token for another
+// user, intended for the requested audience or, when empty, the default one.
+// The issued token names the administrator in its "act" claim.
+func (s *Service) ExchangeToken(ctx context.Context, payload *identity.TokenExchangePayload) (*identity.TokenExchangeResult, error) {
+	admin, err := s.authorizeAdmin(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+	audience, err := s.tokens.Audience(payload.Audience)
+	if err != nil {
+		return nil, &identity.UnauthorizedError{Message: err.Error()}
+	}
+
+	var subjectID pgtype.UUID
+	if err := subjectID.Scan(payload.RequestedSubject); err != nil {
+		return nil, &identity.NotFoundError{Message: "invalid user id"}
+	}
+	subject, err := s.queries.GetUserByID(ctx, subjectID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			s.audit.Record(ctx, audit.Event{Type: audit.EventTokenExchange, UserID: admin.UserID, Email: admin.Email, Reason: "subject not found"})
+			return nil, &identity.NotFoundError{Message: "user not found"}
+		}
+		return nil, fmt.Errorf("get user by id: %w", err)
+	}
+	if subject.Status != userStatusActive {
+		s.audit.Record(ctx, audit.Event{Type: audit.EventTokenExchange, UserID: admin.UserID, Email: admin.Email, Reason: "subject " + subject.Status})
+		return nil, &identity.UnauthorizedError{Message: "account is " + subject.Status}
+	}
+
+	signed, ttl, err := s.tokens.IssueImpersonation(subject, security.Actor{UserID: admin.UserID, Email: admin.Email}, s.impersonationTTL, audience)
+	if err != nil {
+		return nil, fmt.Errorf("issue impersonation token: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "issued impersonation token", "actorID", admin.UserID, "userID", subject.ID.String(), "reason", payload.Reason)
+	s.audit.Record(ctx, audit.Event{
+		Type:    audit.EventTokenExchange,
+		Success: true,
+		UserID:  admin.UserID,
+		Email:   admin.Email,
+		Reason:  fmt.Sprintf("impersonating %s: %s", subject.ID.String(), payload.Reason),
+	})
+
+	return &identity.TokenExchangeResult{
+		AccessToken:     signed,
+		IssuedTokenType: payload.RequestedTokenType,
+		TokenType:       tokenTypeBearer,
+		ExpiresIn:       int(ttl.Seconds()),
+	}, nil
+}
+
+// issueToken creates an access token for the user in the requested format,
+// intended for the requested audience or, when empty, the default one.
+func (s *Service) issueToken(ctx context.Context, user db.User, format string, audience []string) (*identity.TokenResult, error) {
+	audience, err := s.tokens.Audience(audience)
+	if err != nil {
+		return nil, &identity.UnauthorizedError{Message: err.Error()}
+	}
+
+	custom, err := s.enrichers.Enrich(ctx, user, func(name string, err error) {
+		s.log.WarnContext(ctx, "claims enricher failed; issuing token without its claims", "enricher", name, "userID", user.ID.String(), "error", err)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("enrich claims: %w", err)
+	}
+
+	if format == tokenFormatOpaque {
+		encoded, err := encodeAttributes(custom)
+		if err != nil {
+			return nil, err
+		}
+		token, hash, err := security.NewOpaqueToken()
+		if err != nil {
+			return nil, err
+		}
+		ttl := s.tokens.TTL()
+		err = s.queries.CreateAccessToken(ctx, db.CreateAccessTokenParams{
+			TokenHash: hash,
+			UserID:    user.ID,
+			ExpiresAt: pgtype.Timestamptz{Time: time.Now().UTC().Add(ttl), Valid: true},
+			Audience:  audience,
+			Claims:    encoded,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("store access token: %w", err)
+		}
+		return &identity.TokenResult{AccessToken: token, ExpiresIn: int(ttl.Seconds())}, nil
+	}
+
+	signed, ttl, err := s.tokens.Issue(user, audience, custom)
+	if err != nil {
+		return nil, fmt.Errorf("issue token: %w", err)
+	}
+	return &identity.TokenResult{AccessToken: signed, ExpiresIn: int(ttl.Seconds())}, nil
+}
+
+// resolveToken validates a signed JWT or looks up an opaque access token.
+// When audience is not empty the token must be intended for it. Tokens that
+// are not active are reported as *invalidTokenError.
+func (s *Service) resolveToken(ctx context.Context, token, audience string) (*security.Claims, error) {
+	if token == "" {
+		return nil, &invalidTokenError{reason: "token is empty"}
+	}
+
+	if security.IsJWT(token) {
+		claims, err := s.tokens.Validate(token, audience)
+		if err != nil {
+			return nil, &invalidTokenError{reason: err.Error()}
+		}
+		if err := s.checkStatus(ctx, claims.UserID); err != nil {
+			return nil, err
+		}
+		return claims, nil
+	}
+
+	row, err := s.queries.GetAccessToken(ctx, security.HashOpaqueToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &invalidTokenError{reason: "token not found"}
+		}
+		return nil, fmt.Errorf("get access token: %w", err)
+	}
+	if row.RevokedAt.Valid {
+		return nil, &invalidTokenError{reason: "token revoked"}
+	}
+	if !row.ExpiresAt.Time.After(time.Now()) {
+		return nil, &invalidTokenError{reason: "token expired"}
+	}
+	if audience != "" && !slices.Contains(row.Audience, audience) {
+		return nil, &invalidTokenError{reason: "token has invalid audience"}
+	}
+	if row.Status != userStatusActive {
+		return nil, &invalidTokenError{reason: "account is " + row.Status}
+	}
+
+	claims := &security.Claims{
+		UserID:    row.UserID.String(),
+		Email:     row.Email,
+		IssuedAt:  row.CreatedAt.Time,
+		ExpiresAt: row.ExpiresAt.Time,
+		Audience:  row.Audience,
+		Custom:    decodeAttributes(row.Claims),
+	}
+	return claims, nil
+}
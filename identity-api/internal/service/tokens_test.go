@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/security"
+)
+
+const (
+	testAdminID   = "00000000-0000-4000-8000-0000000000a1"
+	testSubjectID = "00000000-0000-4000-8000-0000000000b2"
+)
+
+// newTokenTestService returns a Service over the given users that issues
+// tokens for identity-api by default and may issue them for dummy-api, with
+// admin@example.com as its administrator.
+func newTokenTestService(t *testing.T, store *userStore, impersonationTTL time.Duration) (*Service, *security.TokenManager) {
+	t.Helper()
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	queries := db.New(store)
+	tokens := security.NewTokenManager("test-secret", time.Hour, security.TokenOptions{
+		Issuer:          "identity-api",
+		Audiences:       []string{"dummy-api"},
+		DefaultAudience: "identity-api",
+	})
+	svc := New(log, queries, tokens, Options{
+		Audit:            audit.NewRecorder(log, queries),
+		AdminEmails:      []string{"admin@example.com"},
+		ImpersonationTTL: impersonationTTL,
+		Audience:         "identity-api",
+	})
+	return svc, tokens
+}
+
+// issueTestToken returns a token for user intended for identity-api.
+func issueTestToken(t *testing.T, tokens *security.TokenManager, user db.User) string {
+	t.Helper()
+	token, _, err := tokens.Issue(user, []string{"identity-api"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestExchangeTokenForDummyAPI(t *testing.T) {
+	admin := newTestUser(t, testAdminID, "admin@example.com", "admin")
+	subject := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	store := &userStore{users: []db.User{admin, subject}}
+	// Impersonation tokens never outlive regular access tokens.
+	svc, tokens := newTokenTestService(t, store, 2*time.Hour)
+
+	result, err := svc.ExchangeToken(context.Background(), &identity.TokenExchangePayload{
+		Token:              "Bearer " + issueTestToken(t, tokens, admin),
+		RequestedSubject:   testSubjectID,
+		RequestedTokenType: "urn:ietf:params:oauth:token-type:access_token",
+		Reason:             "Reproducing support ticket #1234",
+		Audience:           []string{"dummy-api"},
+	})
+	if err != nil {
+		t.Fatalf("ExchangeToken() error = %v", err)
+	}
+	if result.ExpiresIn != int(time.Hour.Seconds()) {
+		t.Errorf("expires_in = %d, want %d", result.ExpiresIn, int(time.Hour.Seconds()))
+	}
+
+	validation, err := svc.ValidateToken(context.Background(), &identity.ValidateTokenPayload{
+		Token:    result.AccessToken,
+		Audience: ptr("dummy-api"),
+	})
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if !validation.Valid {
+		t.Fatalf("ValidateToken(dummy-api) is invalid: %s", *validation.Reason)
+	}
+	if *validation.UserID != testSubjectID {
+		t.Errorf("user_id = %s, want %s", *validation.UserID, testSubjectID)
+	}
+	if validation.Actor == nil || validation.Actor.UserID != testAdminID || *validation.Actor.Email != "admin@example.com" {
+		t.Errorf("actor = %+v, want the administrator", validation.Actor)
+	}
+
+	// The token is for dummy-api only, so the identity service itself
+	// rejects it.
+	validation, err = svc.ValidateToken(context.Background(), &identity.ValidateTokenPayload{
+		Token:    result.AccessToken,
+		Audience: ptr("identity-api"),
+	})
+	if err != nil {
+		t.Fatalf("ValidateToken() error = %v", err)
+	}
+	if validation.Valid {
+		t.Error("impersonation token for dummy-api is accepted by identity-api")
+	}
+}
+
+func TestExchangeTokenDefaultAudienceAndTTL(t *testing.T) {
+	admin := newTestUser(t, testAdminID, "admin@example.com", "admin")
+	subject := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	svc, tokens := newTokenTestService(t, &userStore{users: []db.User{admin, subject}}, 5*time.Minute)
+
+	result, err := svc.ExchangeToken(context.Background(), &identity.TokenExchangePayload{
+		Token:            issueTestToken(t, tokens, admin),
+		RequestedSubject: testSubjectID,
+		Reason:           "support",
+	})
+	if err != nil {
+		t.Fatalf("ExchangeToken() error = %v", err)
+	}
+	if result.ExpiresIn != int((5 * time.Minute).Seconds()) {
+		t.Errorf("expires_in = %d, want %d", result.ExpiresIn, int((5 * time.Minute).Seconds()))
+	}
+	claims, err := tokens.Validate(result.AccessToken, "identity-api")
+	if err != nil {
+		t.Fatalf("Validate(identity-api) error = %v", err)
+	}
+	if claims.Actor == nil || claims.Actor.UserID != testAdminID {
+		t.Errorf("act = %+v, want the administrator", claims.Actor)
+	}
+}
+
+func TestExchangeTokenErrors(t *testing.T) {
+	admin := newTestUser(t, testAdminID, "admin@example.com", "admin")
+	subject := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	suspended := newTestUser(t, "00000000-0000-4000-8000-0000000000c3", "sam@example.com", "sam")
+	suspended.Status = userStatusSuspended
+	store := &userStore{users: []db.User{admin, subject, suspended}}
+	svc, tokens := newTokenTestService(t, store, 0)
+
+	adminToken := issueTestToken(t, tokens, admin)
+	impersonating, _, err := tokens.IssueImpersonation(admin, security.Actor{UserID: testSubjectID}, time.Minute, []string{"identity-api"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dummyToken, _, err := tokens.Issue(admin, []string{"dummy-api"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		subject  string
+		audience []string
+		wantErr  any
+	}{
+		{name: "not an administrator", token: issueTestToken(t, tokens, subject), subject: testSubjectID, wantErr: &identity.UnauthorizedError{}},
+		{name: "impersonation token", token: impersonating, subject: testSubjectID, wantErr: &identity.UnauthorizedError{}},
+		{name: "token for another audience", token: dummyToken, subject: testSubjectID, wantErr: &identity.UnauthorizedError{}},
+		{name: "unknown subject", token: adminToken, subject: "00000000-0000-4000-8000-0000000000ff", wantErr: &identity.NotFoundError{}},
+		{name: "suspended subject", token: adminToken, subject: suspended.ID.String(), wantErr: &identity.UnauthorizedError{}},
+		{name: "audience not allowed", token: adminToken, subject: testSubjectID, audience: []string{"billing-api"}, wantErr: &identity.UnauthorizedError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := svc.ExchangeToken(context.Background(), &identity.TokenExchangePayload{
+				Token:            tt.token,
+				RequestedSubject: tt.subject,
+				Reason:           "support",
+				Audience:         tt.audience,
+			})
+			switch want := tt.wantErr.(type) {
+			case *identity.UnauthorizedError:
+				if !errors.As(err, &want) {
+					t.Errorf("ExchangeToken() error = %v, want unauthorized", err)
+				}
+			case *identity.NotFoundError:
+				if !errors.As(err, &want) {
+					t.Errorf("ExchangeToken() error = %v, want not found", err)
+				}
+			}
+		})
+	}
+}
+
+func TestIssueTokenEnricherFailure(t *testing.T) {
+	user := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	svc, _ := newTokenTestService(t, &userStore{users: []db.User{user}}, 0)
+	svc.enrichers = security.NewEnricherRegistry()
+	svc.enrichers.Register("billing", security.EnricherFunc(func(context.Context, db.User) (map[string]string, error) {
+		return nil, errors.New("billing unavailable")
+	}), security.EnricherOptions{Policy: security.FailClosed})
+
+	// The opaque format would store the token through the fake store, which
+	// rejects the write, so both formats must stop before issuing anything.
+	for _, format := range []string{"jwt", tokenFormatOpaque} {
+		result, err := svc.issueToken(context.Background(), user, format, nil)
+		var enrichErr *security.EnrichmentError
+		if !errors.As(err, &enrichErr) || enrichErr.Enricher != "billing" {
+			t.Errorf("issueToken(%s) error = %v, want an EnrichmentError for billing", format, err)
+		}
+		if result != nil {
+			t.Errorf("issueToken(%s) = %+v, want no token", format, result)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
+)
+
+// usernameIndex is the unique index enforcing case-insensitive usernames.
+const usernameIndex = "users_username_lower_key"
+
+// SetUsername sets or changes the username of the calling user.
+func (s *Service) SetUsername(ctx context.Context, payload *identity.SetUsernamePayload) (*identity.User, error) {
+	claims, err := s.authenticate(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkUsername(payload.Username); err != nil {
+		return nil, err
+	}
+
+	var id pgtype.UUID
+	if err := id.Scan(claims.UserID); err != nil {
+		return nil, &identity.UnauthorizedError{Message: "invalid subject claim"}
+	}
+	user, err := s.queries.SetUsername(ctx, db.SetUsernameParams{ID: id, Username: &payload.Username})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &identity.UnauthorizedError{Message: "account not found"}
+		}
+		if isUsernameViolation(err) {
+			return nil, usernameConflictError()
+		}
+		return nil, fmt.Errorf("set username: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "changed username", "userID", claims.UserID)
+	s.publishUser(ctx, webhook.EventUserUpdated, user)
+	return mapUser(user), nil
+}
+
+// CheckUsername reports whether a username satisfies the naming rules and is
+// not taken by another account.
+func (s *Service) CheckUsername(ctx context.Context, payload *identity.CheckUsernamePayload) (*identity.UsernameAvailability, error) {
+	result := &identity.UsernameAvailability{Username: payload.Username}
+	if reason := s.usernames.Check(payload.Username); reason != "" {
+		result.Reason = &reason
+		return result, nil
+	}
+
+	_, err := s.queries.GetUserByUsername(ctx, payload.Username)
+	switch {
+	case err == nil:
+		result.Reason = ptr("username is taken")
+	case errors.Is(err, pgx.ErrNoRows):
+		result.Available = true
+	default:
+		return nil, fmt.Errorf("get user by username: %w", err)
+	}
+	return result, nil
+}
+
+// lookupUser finds the account identified by an email address or, when the
+// identifier has no "@", by username. Usernames cannot contain "@".
+func (s *Service) lookupUser(ctx context.Context, identifier string) (db.User, error) {
+	if strings.Contains(identifier, "@") {
+		return s.queries.GetUserByEmail(ctx, identifier)
+	}
+	return s.queries.GetUserByUsername(ctx, identifier)
+}
+
+func (s *Service) checkUsername(username string) error {
+	if reason := s.usernames.Check(username); reason != "" {
+		return &identity.InvalidUsernameError{Message: reason, ID: ptr("identity:invalid_username")}
+	}
+	return nil
+}
+
+func isUsernameViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == usernameIndex
+}
+
+func usernameConflictError() *identity.ConflictError {
+	return &identity.ConflictError{
+		Message: "a user with this username already exists",
+		ID:      ptr("identity:conflict"),
+	}
+}
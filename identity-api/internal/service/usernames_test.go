@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+func TestCheckUsername(t *testing.T) {
+	user := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	user.Username = ptr("jane")
+	svc, _ := newTokenTestService(t, &userStore{users: []db.User{user}}, 0)
+
+	tests := []struct {
+		username   string
+		available  bool
+		wantReason string
+	}{
+		{username: "john", available: true},
+		{username: "JANE", wantReason: "username is taken"},
+		{username: "Support", wantReason: "username is reserved"},
+		{username: "jo", wantReason: "username must be at least 3 characters"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.username, func(t *testing.T) {
+			result, err := svc.CheckUsername(context.Background(), &identity.CheckUsernamePayload{Username: tt.username})
+			if err != nil {
+				t.Fatalf("CheckUsername() error = %v", err)
+			}
+			if result.Available != tt.available {
+				t.Errorf("available = %t, want %t", result.Available, tt.available)
+			}
+			reason := ""
+			if result.Reason != nil {
+				reason = *result.Reason
+			}
+			if reason != tt.wantReason {
+				t.Errorf("reason = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestLoginWithUsername(t *testing.T) {
+	user := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	user.Username = ptr("jane.doe")
+	svc, tokens := newTokenTestService(t, &userStore{users: []db.User{user}}, 0)
+
+	result, err := svc.Login(context.Background(), &identity.LoginPayload{
+		Identifier: ptr("Jane.Doe"),
+		Password:   testPassword,
+	})
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	claims, err := tokens.Validate(result.AccessToken, "identity-api")
+	if err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if claims.UserID != testSubjectID {
+		t.Errorf("subject = %s, want %s", claims.UserID, testSubjectID)
+	}
+
+	_, err = svc.Login(context.Background(), &identity.LoginPayload{
+		Identifier: ptr("john"),
+		Password:   testPassword,
+	})
+	var unauthorized *identity.UnauthorizedError
+	if !errors.As(err, &unauthorized) {
+		t.Errorf("Login() with an unknown username error = %v, want unauthorized", err)
+	}
+}
+
+func TestSetUsernameRejectsInvalidNames(t *testing.T) {
+	user := newTestUser(t, testSubjectID, "jane@example.com", "jane")
+	svc, tokens := newTokenTestService(t, &userStore{users: []db.User{user}}, 0)
+	token := issueTestToken(t, tokens, user)
+
+	for _, username := range []string{"root", "jane@doe", "a"} {
+		_, err := svc.SetUsername(context.Background(), &identity.SetUsernamePayload{Token: token, Username: username})
+		var invalid *identity.InvalidUsernameError
+		if !errors.As(err, &invalid) {
+			t.Errorf("SetUsername(%q) error = %v, want invalid username", username, err)
+		}
+	}
+}
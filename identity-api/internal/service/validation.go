@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+)
+
+// ValidateTokens validates up to 100 tokens in one call. Results are returned
+// in the order the tokens were submitted.
+func (s *Service) ValidateTokens(ctx context.Context, payload *identity.ValidateTokensPayload) (*identity.ValidationResultsCollection, error) {
+	audience := ""
+	if payload.Audience != nil {
+		audience = *payload.Audience
+	}
+
+	results := make([]*identity.ValidationResult, 0, len(payload.Tokens))
+	for _, token := range payload.Tokens {
+		result, err := s.validate(ctx, token, audience)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+	return &identity.ValidationResultsCollection{Results: results}, nil
+}
+
+// ValidateTokenStream answers every request received on the stream with a
+// validation result carrying the request id, in request order, until the
+// client closes its side of the stream. A request that fails with an internal
+// error is answered with that error and does not end the stream.
+func (s *Service) ValidateTokenStream(ctx context.Context, stream identity.ValidateTokenStreamServerStream) error {
+	defer stream.Close()
+
+	for {
+		req, err := stream.RecvWithContext(ctx)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+
+		audience := ""
+		if req.Audience != nil {
+			audience = *req.Audience
+		}
+		resp := &identity.ValidateTokenStreamResponse{ID: req.ID}
+		resp.Result, err = s.validate(ctx, req.Token, audience)
+		if err != nil {
+			s.log.ErrorContext(ctx, "validate token", "error", err)
+			resp.Error = ptr("internal error")
+		}
+		if err := stream.SendWithContext(ctx, resp); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/audit"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+// fakeValidationStream replays requests and records the responses sent.
+type fakeValidationStream struct {
+	requests  []*identity.ValidateTokenStreamRequest
+	responses []*identity.ValidateTokenStreamResponse
+}
+
+func (s *fakeValidationStream) Send(resp *identity.ValidateTokenStreamResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func (s *fakeValidationStream) SendWithContext(_ context.Context, resp *identity.ValidateTokenStreamResponse) error {
+	return s.Send(resp)
+}
+
+func (s *fakeValidationStream) Recv() (*identity.ValidateTokenStreamRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *fakeValidationStream) RecvWithContext(context.Context) (*identity.ValidateTokenStreamRequest, error) {
+	return s.Recv()
+}
+
+func (s *fakeValidationStream) Close() error { return nil }
+
+func TestValidateTokenStreamSurvivesInternalErrors(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	// The store cannot look up opaque tokens, so validating one fails with an
+	// internal error.
+	queries := db.New(&userStore{})
+	svc := New(log, queries, nil, Options{Audit: audit.NewRecorder(log, queries)})
+
+	stream := &fakeValidationStream{requests: []*identity.ValidateTokenStreamRequest{
+		{ID: ptr("1"), Token: "opaque-token"},
+		{ID: ptr("2"), Token: ""},
+	}}
+	if err := svc.ValidateTokenStream(context.Background(), stream); err != nil {
+		t.Fatalf("ValidateTokenStream() error = %v", err)
+	}
+
+	if len(stream.responses) != 2 {
+		t.Fatalf("got %d responses, want 2", len(stream.responses))
+	}
+	failed, invalid := stream.responses[0], stream.responses[1]
+	if *failed.ID != "1" || failed.Result != nil || failed.Error == nil {
+		t.Errorf("response 1 = %+v, want an error without a result", failed)
+	}
+	if *invalid.ID != "2" || invalid.Error != nil || invalid.Result == nil || invalid.Result.Valid {
+		t.Errorf("response 2 = %+v, want an invalid result", invalid)
+	}
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/vidwadeseram/go-boilerplate/identity-api/gen/identity"
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+	"github.com/vidwadeseram/go-boilerplate/identity-api/internal/webhook"
+)
+
+// CreateWebhook subscribes an endpoint to user lifecycle events. The signing
+// secret is generated server side and only returned in this response.
+func (s *Service) CreateWebhook(ctx context.Context, payload *identity.CreateWebhookPayload) (*identity.WebhookSubscription, error) {
+	admin, err := s.authorizeAdmin(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var adminID pgtype.UUID
+	if err := adminID.Scan(admin.UserID); err != nil {
+		return nil, &identity.UnauthorizedError{Message: "invalid subject claim"}
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := payload.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	subscription, err := s.queries.CreateWebhookSubscription(ctx, db.CreateWebhookSubscriptionParams{
+		Url:        payload.URL,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedBy:  adminID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create webhook subscription: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "created webhook subscription", "subscriptionID", subscription.ID.String(), "createdBy", admin.UserID)
+	result := mapWebhookSubscription(subscription)
+	result.Secret = &subscription.Secret
+	return result, nil
+}
+
+// ListWebhooks returns every webhook subscription without its secret.
+func (s *Service) ListWebhooks(ctx context.Context, payload *identity.ListWebhooksPayload) (*identity.WebhookSubscriptionsCollection, error) {
+	if _, err := s.authorizeAdmin(ctx, payload.Token); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list webhook subscriptions: %w", err)
+	}
+
+	subscriptions := make([]*identity.WebhookSubscription, 0, len(rows))
+	for _, row := range rows {
+		subscriptions = append(subscriptions, mapWebhookSubscription(row))
+	}
+	return &identity.WebhookSubscriptionsCollection{Subscriptions: subscriptions}, nil
+}
+
+// DeleteWebhook removes a subscription together with its delivery log.
+func (s *Service) DeleteWebhook(ctx context.Context, payload *identity.DeleteWebhookPayload) error {
+	admin, err := s.authorizeAdmin(ctx, payload.Token)
+	if err != nil {
+		return err
+	}
+
+	var id pgtype.UUID
+	if err := id.Scan(payload.ID); err != nil {
+		return &identity.NotFoundError{Message: "invalid webhook id"}
+	}
+
+	deleted, err := s.queries.DeleteWebhookSubscription(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete webhook subscription: %w", err)
+	}
+	if deleted == 0 {
+		return &identity.NotFoundError{Message: "webhook not found"}
+	}
+
+	s.log.InfoContext(ctx, "deleted webhook subscription", "subscriptionID", payload.ID, "deletedBy", admin.UserID)
+	return nil
+}
+
+// ListWebhookDeliveries returns the delivery log of a subscription.
+func (s *Service) ListWebhookDeliveries(ctx context.Context, payload *identity.ListWebhookDeliveriesPayload) (*identity.WebhookDeliveriesCollection, error) {
+	if _, err := s.authorizeAdmin(ctx, payload.Token); err != nil {
+		return nil, err
+	}
+
+	var id pgtype.UUID
+	if err := id.Scan(payload.ID); err != nil {
+		return nil, &identity.NotFoundError{Message: "invalid webhook id"}
+	}
+	if _, err := s.queries.GetWebhookSubscription(ctx, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &identity.NotFoundError{Message: "webhook not found"}
+		}
+		return nil, fmt.Errorf("get webhook subscription: %w", err)
+	}
+
+	rows, err := s.queries.ListWebhookDeliveries(ctx, db.ListWebhookDeliveriesParams{
+		SubscriptionID: id,
+		Limit:          int32(payload.Limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list webhook deliveries: %w", err)
+	}
+
+	deliveries := make([]*identity.WebhookDelivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, mapWebhookDelivery(row))
+	}
+	return &identity.WebhookDeliveriesCollection{Deliveries: deliveries}, nil
+}
+
+// RedeliverWebhook queues a new delivery of the event carried by an earlier
+// delivery. The original entry is kept in the log unchanged.
+func (s *Service) RedeliverWebhook(ctx context.Context, payload *identity.RedeliverWebhookPayload) (*identity.WebhookDelivery, error) {
+	admin, err := s.authorizeAdmin(ctx, payload.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	var id pgtype.UUID
+	if err := id.Scan(payload.ID); err != nil {
+		return nil, &identity.NotFoundError{Message: "invalid delivery id"}
+	}
+
+	delivery, err := s.queries.RedeliverWebhookDelivery(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, &identity.NotFoundError{Message: "webhook delivery not found"}
+		}
+		return nil, fmt.Errorf("redeliver webhook: %w", err)
+	}
+
+	s.log.InfoContext(ctx, "queued webhook redelivery", "deliveryID", payload.ID, "newDeliveryID", delivery.ID.String(), "requestedBy", admin.UserID)
+	return mapWebhookDelivery(delivery), nil
+}
+
+// publishUser queues a user lifecycle event for the webhook subscribers.
+func (s *Service) publishUser(ctx context.Context, eventType string, u db.User) {
+	s.webhooks.Publish(ctx, eventType, webhook.User{
+		ID:          u.ID.String(),
+		Email:       u.Email,
+		Username:    u.Username,
+		DisplayName: u.DisplayName,
+		ExternalID:  u.ExternalID,
+		Active:      ptr(u.Status == userStatusActive),
+		Status:      u.Status,
+	})
+}
+
+func mapWebhookSubscription(sub db.WebhookSubscription) *identity.WebhookSubscription {
+	return &identity.WebhookSubscription{
+		ID:         sub.ID.String(),
+		URL:        sub.Url,
+		EventTypes: sub.EventTypes,
+		Active:     sub.Active,
+		CreatedAt:  sub.CreatedAt.Time.UTC().Format(time.RFC3339),
+	}
+}
+
+func mapWebhookDelivery(d db.WebhookDelivery) *identity.WebhookDelivery {
+	result := &identity.WebhookDelivery{
+		ID:             d.ID.String(),
+		SubscriptionID: d.SubscriptionID.String(),
+		EventType:      d.EventType,
+		Status:         d.Status,
+		Attempts:       int(d.Attempts),
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.Time.UTC().Format(time.RFC3339),
+		LastAttemptAt:  formatOptionalTime(d.LastAttemptAt),
+		DeliveredAt:    formatOptionalTime(d.DeliveredAt),
+	}
+	if d.ResponseStatus != nil {
+		result.ResponseStatus = ptr(int(*d.ResponseStatus))
+	}
+	if d.Status == webhook.StatusPending {
+		result.NextAttemptAt = formatOptionalTime(d.NextAttemptAt)
+	}
+	return result
+}
+
+func formatOptionalTime(t pgtype.Timestamptz) *string {
+	if !t.Valid {
+		return nil
+	}
+	return ptr(t.Time.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,117 @@
+// Package webhook delivers user lifecycle events to subscribed HTTP endpoints.
+// Events are queued in the webhook_deliveries table by a Dispatcher and sent
+// by a background Worker, so that slow or failing endpoints never delay the
+// request that produced the event.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+// Event types delivered to subscribers.
+const (
+	EventUserRegistered = "user.registered"
+	EventUserUpdated    = "user.updated"
+	EventUserDisabled   = "user.disabled"
+	EventUserDeleted    = "user.deleted"
+)
+
+// Headers sent with every delivery.
+const (
+	HeaderID        = "X-Webhook-ID"
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// secretBytes is the amount of randomness in a subscription signing secret.
+const secretBytes = 32
+
+// Envelope is the JSON body of a delivery. The same envelope, and therefore the
+// same ID, is sent to every subscriber of an event and on every retry so that
+// receivers can deduplicate.
+type Envelope struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// User is the data of user lifecycle events.
+type User struct {
+	ID          string  `json:"id"`
+	Email       string  `json:"email,omitempty"`
+	Username    *string `json:"username,omitempty"`
+	DisplayName string  `json:"display_name,omitempty"`
+	ExternalID  *string `json:"external_id,omitempty"`
+	Active      *bool   `json:"active,omitempty"`
+	Status      string  `json:"status,omitempty"`
+}
+
+// Dispatcher queues events for delivery to matching subscriptions.
+type Dispatcher struct {
+	log     *slog.Logger
+	queries *db.Queries
+}
+
+// NewDispatcher creates a new Dispatcher instance.
+func NewDispatcher(log *slog.Logger, queries *db.Queries) *Dispatcher {
+	return &Dispatcher{log: log, queries: queries}
+}
+
+// Publish queues one delivery of the event per active subscription interested
+// in eventType. Failures are logged and never surfaced to the caller so that
+// webhooks cannot break the operation that produced the event.
+func (d *Dispatcher) Publish(ctx context.Context, eventType string, data any) {
+	payload, err := json.Marshal(Envelope{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		d.log.ErrorContext(ctx, "encode webhook event", "type", eventType, "error", err)
+		return
+	}
+	if err := d.queries.CreateWebhookDeliveries(ctx, db.CreateWebhookDeliveriesParams{
+		EventType: eventType,
+		Payload:   payload,
+	}); err != nil {
+		d.log.ErrorContext(ctx, "queue webhook deliveries", "type", eventType, "error", err)
+	}
+}
+
+// NewSecret returns a random signing secret for a subscription.
+func NewSecret() (string, error) {
+	buf := make([]byte, secretBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate webhook secret: %w", err)
+	}
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// Sign returns the X-Webhook-Signature value for body sent at timestamp: the
+// hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed with the subscription
+// secret, prefixed with "sha256=". Including the timestamp lets receivers
+// reject replayed deliveries.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
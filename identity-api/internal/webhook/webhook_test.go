@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSign(t *testing.T) {
+	timestamp := time.Unix(1700000000, 0)
+	body := []byte(`{"id":"evt_1"}`)
+
+	// Computed independently as HMAC-SHA256("whsec_test", "1700000000.<body>").
+	want := "sha256=c89214b5b5da833daed6f0b8c5bb6bd58cea9022bd80ccc78230f3942d632925"
+	if got := Sign("whsec_test", timestamp, body); got != want {
+		t.Errorf("Sign() = %s, want %s", got, want)
+	}
+
+	for name, got := range map[string]string{
+		"secret":    Sign("whsec_other", timestamp, body),
+		"timestamp": Sign("whsec_test", timestamp.Add(time.Second), body),
+		"body":      Sign("whsec_test", timestamp, []byte(`{"id":"evt_2"}`)),
+	} {
+		if got == want {
+			t.Errorf("signature does not depend on the %s", name)
+		}
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	a, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(a, "whsec_") || len(a) <= len("whsec_") {
+		t.Errorf("NewSecret() = %q, want whsec_ prefix", a)
+	}
+	if a == b {
+		t.Error("NewSecret returned the same secret twice")
+	}
+}
@@ -0,0 +1,244 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+// Delivery statuses stored in the webhook_deliveries table.
+const (
+	StatusPending   = "pending"
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultBatchSize    = 20
+	defaultMaxAttempts  = 8
+	defaultTimeout      = 10 * time.Second
+	defaultMinBackoff   = 30 * time.Second
+	defaultMaxBackoff   = 6 * time.Hour
+	// maxErrorLength bounds the response excerpt kept in the delivery log.
+	maxErrorLength = 512
+)
+
+// WorkerOptions tunes delivery and retry behaviour. Zero values select the
+// defaults.
+type WorkerOptions struct {
+	// PollInterval is how often the queue is checked for due deliveries.
+	PollInterval time.Duration
+	// BatchSize is the number of deliveries claimed per query.
+	BatchSize int
+	// MaxAttempts is the number of attempts after which a delivery is marked
+	// failed.
+	MaxAttempts int
+	// Timeout bounds a single HTTP attempt.
+	Timeout time.Duration
+	// MinBackoff is the delay before the first retry; it doubles with every
+	// further attempt up to MaxBackoff.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// Worker sends queued deliveries and reschedules failed ones with
+// exponential backoff. Several workers may run against the same database;
+// claimed deliveries are leased so that each attempt is made only once.
+type Worker struct {
+	log     *slog.Logger
+	queries *db.Queries
+	client  *http.Client
+	opts    WorkerOptions
+}
+
+// NewWorker creates a new Worker instance.
+func NewWorker(log *slog.Logger, queries *db.Queries, opts WorkerOptions) *Worker {
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = defaultPollInterval
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = defaultBatchSize
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+	if opts.MinBackoff <= 0 {
+		opts.MinBackoff = defaultMinBackoff
+	}
+	if opts.MaxBackoff < opts.MinBackoff {
+		opts.MaxBackoff = max(defaultMaxBackoff, opts.MinBackoff)
+	}
+	return &Worker{
+		log:     log,
+		queries: queries,
+		client: &http.Client{
+			Timeout: opts.Timeout,
+			// Redirects are not followed so that a subscriber cannot point
+			// deliveries at an address it could not register, such as a
+			// service on the internal network. A 3xx response counts as a
+			// failed attempt.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		opts: opts,
+	}
+}
+
+// Run delivers due webhooks until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.drain(ctx)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain sends due deliveries batch by batch until the queue has none left.
+func (w *Worker) drain(ctx context.Context) {
+	// The lease must outlive every attempt in a batch, which run one after
+	// the other.
+	lease := int32((w.opts.Timeout*time.Duration(w.opts.BatchSize) + time.Minute) / time.Second)
+	for ctx.Err() == nil {
+		deliveries, err := w.queries.ClaimWebhookDeliveries(ctx, db.ClaimWebhookDeliveriesParams{
+			LeaseSeconds: lease,
+			BatchSize:    int32(w.opts.BatchSize),
+		})
+		if err != nil {
+			if ctx.Err() == nil {
+				w.log.ErrorContext(ctx, "claim webhook deliveries", "error", err)
+			}
+			return
+		}
+		for _, delivery := range deliveries {
+			w.deliver(ctx, delivery)
+		}
+		if len(deliveries) < w.opts.BatchSize {
+			return
+		}
+	}
+}
+
+func (w *Worker) deliver(ctx context.Context, delivery db.WebhookDelivery) {
+	subscription, err := w.queries.GetWebhookSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			w.log.ErrorContext(ctx, "load webhook subscription", "delivery_id", delivery.ID.String(), "error", err)
+		}
+		return
+	}
+
+	var statusCode *int32
+	var sendErr error
+	if !subscription.Active {
+		sendErr = errors.New("subscription is inactive")
+	} else {
+		statusCode, sendErr = w.send(ctx, subscription, delivery)
+	}
+	if ctx.Err() != nil {
+		// Shutting down: leave the delivery to be retried once its lease
+		// expires rather than counting an attempt.
+		return
+	}
+
+	attempts := int(delivery.Attempts) + 1
+	params := db.RecordWebhookAttemptParams{
+		ID:             delivery.ID,
+		Status:         StatusSucceeded,
+		ResponseStatus: statusCode,
+		NextAttemptAt:  pgtype.Timestamptz{Time: time.Now(), Valid: true},
+	}
+	if sendErr != nil {
+		message := truncate(sendErr.Error(), maxErrorLength)
+		params.LastError = &message
+		switch {
+		case !subscription.Active || attempts >= w.opts.MaxAttempts:
+			params.Status = StatusFailed
+		default:
+			params.Status = StatusPending
+			params.NextAttemptAt.Time = time.Now().Add(w.backoff(attempts))
+		}
+		w.log.WarnContext(ctx, "webhook delivery failed",
+			"delivery_id", delivery.ID.String(),
+			"event_type", delivery.EventType,
+			"attempt", attempts,
+			"status", params.Status,
+			"error", sendErr,
+		)
+	}
+
+	if err := w.queries.RecordWebhookAttempt(ctx, params); err != nil {
+		w.log.ErrorContext(ctx, "record webhook attempt", "delivery_id", delivery.ID.String(), "error", err)
+	}
+}
+
+// send posts the delivery and returns the response status. Any status outside
+// 2xx is reported as an error.
+func (w *Worker) send(ctx context.Context, subscription db.WebhookSubscription, delivery db.WebhookDelivery) (*int32, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "identity-api-webhooks")
+	req.Header.Set(HeaderID, delivery.ID.String())
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(subscription.Secret, now, delivery.Payload))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	code := int32(resp.StatusCode)
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return &code, nil
+	}
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+	return &code, fmt.Errorf("endpoint responded %s: %s", resp.Status, bytes.TrimSpace(excerpt))
+}
+
+// backoff returns the delay before the attempt following attempt number
+// attempts, doubling from MinBackoff up to MaxBackoff with up to 10% jitter so
+// that retries of a burst of events do not arrive together.
+func (w *Worker) backoff(attempts int) time.Duration {
+	delay := w.opts.MinBackoff
+	for i := 1; i < attempts && delay < w.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	delay = min(delay, w.opts.MaxBackoff)
+	return delay + rand.N(delay/10+1)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n]
+}
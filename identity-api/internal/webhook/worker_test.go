@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	db "github.com/vidwadeseram/go-boilerplate/identity-api/internal/db/sqlc"
+)
+
+// deliveryStore is a db.DBTX serving a single subscription and capturing the
+// attempts recorded by the worker.
+type deliveryStore struct {
+	subscription db.WebhookSubscription
+	attempts     []db.RecordWebhookAttemptParams
+}
+
+func (s *deliveryStore) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if !strings.Contains(sql, "-- name: RecordWebhookAttempt ") {
+		return pgconn.CommandTag{}, errors.New("unexpected exec: " + sql)
+	}
+	s.attempts = append(s.attempts, db.RecordWebhookAttemptParams{
+		ID:             args[0].(pgtype.UUID),
+		Status:         args[1].(string),
+		ResponseStatus: args[2].(*int32),
+		LastError:      args[3].(*string),
+		NextAttemptAt:  args[4].(pgtype.Timestamptz),
+	})
+	return pgconn.CommandTag{}, nil
+}
+
+func (s *deliveryStore) Query(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+	return nil, errors.New("unexpected query: " + sql)
+}
+
+func (s *deliveryStore) QueryRow(_ context.Context, sql string, _ ...any) pgx.Row {
+	if !strings.Contains(sql, "-- name: GetWebhookSubscription ") {
+		return fakeRow{err: errors.New("unexpected query row: " + sql)}
+	}
+	sub := s.subscription
+	return fakeRow{values: []any{sub.ID, sub.Url, sub.Secret, sub.EventTypes, sub.Active, sub.CreatedBy, sub.CreatedAt}}
+}
+
+type fakeRow struct {
+	values []any
+	err    error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(reflect.ValueOf(r.values[i]))
+	}
+	return nil
+}
+
+func newTestWorker(store *deliveryStore, opts WorkerOptions) *Worker {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return NewWorker(log, db.New(store), opts)
+}
+
+func TestBackoff(t *testing.T) {
+	w := newTestWorker(&deliveryStore{}, WorkerOptions{MinBackoff: time.Minute, MaxBackoff: 10 * time.Minute})
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 8 * time.Minute},
+		{5, 10 * time.Minute},
+		{50, 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		for range 20 {
+			got := w.backoff(tt.attempts)
+			if got < tt.want || got > tt.want+tt.want/10 {
+				t.Fatalf("backoff(%d) = %s, want %s plus at most 10%% jitter", tt.attempts, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestNewWorkerBackoffDefaults(t *testing.T) {
+	w := newTestWorker(&deliveryStore{}, WorkerOptions{MinBackoff: 12 * time.Hour})
+	if w.opts.MaxBackoff != 12*time.Hour {
+		t.Errorf("MaxBackoff = %s, want it raised to MinBackoff", w.opts.MaxBackoff)
+	}
+	w = newTestWorker(&deliveryStore{}, WorkerOptions{})
+	if w.opts.MinBackoff != defaultMinBackoff || w.opts.MaxBackoff != defaultMaxBackoff {
+		t.Errorf("backoff = %s..%s, want defaults", w.opts.MinBackoff, w.opts.MaxBackoff)
+	}
+}
+
+func TestDeliver(t *testing.T) {
+	const secret = "whsec_test"
+	payload := []byte(`{"type":"user.updated"}`)
+
+	tests := []struct {
+		name        string
+		status      int
+		active      bool
+		attempts    int32
+		wantStatus  string
+		wantRetry   bool
+		wantCode    int32
+		wantRequest bool
+	}{
+		{name: "success", status: http.StatusNoContent, active: true, wantStatus: StatusSucceeded, wantCode: 204, wantRequest: true},
+		{name: "failure is retried", status: http.StatusInternalServerError, active: true, wantStatus: StatusPending, wantRetry: true, wantCode: 500, wantRequest: true},
+		{name: "last attempt fails", status: http.StatusInternalServerError, active: true, attempts: 2, wantStatus: StatusFailed, wantCode: 500, wantRequest: true},
+		{name: "redirect is not followed", status: http.StatusFound, active: true, wantStatus: StatusPending, wantRetry: true, wantCode: 302, wantRequest: true},
+		{name: "inactive subscription", status: http.StatusNoContent, active: false, wantStatus: StatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests, redirected atomic.Int32
+			internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				redirected.Add(1)
+			}))
+			defer internal.Close()
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests.Add(1)
+				body, _ := io.ReadAll(r.Body)
+				ts, _ := strconv.ParseInt(r.Header.Get(HeaderTimestamp), 10, 64)
+				if got := r.Header.Get(HeaderSignature); got != Sign(secret, time.Unix(ts, 0), body) {
+					t.Errorf("signature = %s does not match the body", got)
+				}
+				if tt.status == http.StatusFound {
+					w.Header().Set("Location", internal.URL)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			store := &deliveryStore{subscription: db.WebhookSubscription{Url: srv.URL, Secret: secret, Active: tt.active}}
+			w := newTestWorker(store, WorkerOptions{MaxAttempts: 3, MinBackoff: time.Minute, MaxBackoff: time.Hour})
+
+			before := time.Now()
+			w.deliver(context.Background(), db.WebhookDelivery{EventType: "user.updated", Payload: payload, Attempts: tt.attempts})
+
+			if got := requests.Load() > 0; got != tt.wantRequest {
+				t.Errorf("request sent = %v, want %v", got, tt.wantRequest)
+			}
+			if redirected.Load() != 0 {
+				t.Error("worker followed the redirect")
+			}
+			if len(store.attempts) != 1 {
+				t.Fatalf("recorded %d attempts, want 1", len(store.attempts))
+			}
+			attempt := store.attempts[0]
+			if attempt.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", attempt.Status, tt.wantStatus)
+			}
+			if tt.wantCode != 0 && (attempt.ResponseStatus == nil || *attempt.ResponseStatus != tt.wantCode) {
+				t.Errorf("response status = %v, want %d", attempt.ResponseStatus, tt.wantCode)
+			}
+			if (attempt.LastError == nil) != (tt.wantStatus == StatusSucceeded) {
+				t.Errorf("last error = %v for status %s", attempt.LastError, attempt.Status)
+			}
+			if retry := attempt.NextAttemptAt.Time.Sub(before) >= time.Minute; retry != tt.wantRetry {
+				t.Errorf("next attempt at %s, retry scheduled = %v, want %v", attempt.NextAttemptAt.Time, retry, tt.wantRetry)
+			}
+		})
+	}
+}
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS magic_links;
+
+UPDATE users SET password_hash = '' WHERE password_hash IS NULL;
+ALTER TABLE users ALTER COLUMN password_hash SET NOT NULL;
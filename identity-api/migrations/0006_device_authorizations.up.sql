@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS device_authorizations (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    device_code_hash BYTEA NOT NULL UNIQUE,
+    user_code TEXT NOT NULL UNIQUE,
+    client_id TEXT NOT NULL,
+    scope TEXT,
+    poll_interval INTEGER NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    last_polled_at TIMESTAMPTZ,
+    approved_at TIMESTAMPTZ,
+    denied_at TIMESTAMPTZ,
+    consumed_at TIMESTAMPTZ
+);
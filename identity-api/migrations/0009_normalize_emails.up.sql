@@ -0,0 +1,8 @@
+-- Emails are normalized before the index is built. Fails with a unique
+-- violation if two accounts differ only in case or surrounding whitespace,
+-- such as " a@x.com" and "A@x.com"; merge them manually before running this
+-- migration.
+UPDATE users SET email = lower(btrim(email)) WHERE email <> lower(btrim(email));
+UPDATE invitations SET email = lower(btrim(email)) WHERE email <> lower(btrim(email));
+
+CREATE UNIQUE INDEX IF NOT EXISTS users_email_lower_key ON users (lower(email));
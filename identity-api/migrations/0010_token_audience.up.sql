@@ -0,0 +1 @@
+ALTER TABLE access_tokens ADD COLUMN IF NOT EXISTS audience TEXT[] NOT NULL DEFAULT '{}';
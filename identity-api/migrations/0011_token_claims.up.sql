@@ -0,0 +1 @@
+ALTER TABLE access_tokens ADD COLUMN IF NOT EXISTS claims JSONB NOT NULL DEFAULT '{}';
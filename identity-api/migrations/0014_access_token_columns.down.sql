@@ -0,0 +1,2 @@
+ALTER TABLE access_tokens ADD COLUMN IF NOT EXISTS client_id TEXT;
+ALTER TABLE access_tokens ADD COLUMN IF NOT EXISTS scope TEXT;
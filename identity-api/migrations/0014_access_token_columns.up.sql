@@ -0,0 +1,2 @@
+ALTER TABLE access_tokens DROP COLUMN IF EXISTS client_id;
+ALTER TABLE access_tokens DROP COLUMN IF EXISTS scope;
@@ -0,0 +1 @@
+ALTER TABLE device_authorizations DROP COLUMN IF EXISTS audience;
@@ -0,0 +1 @@
+ALTER TABLE device_authorizations ADD COLUMN IF NOT EXISTS audience TEXT[] NOT NULL DEFAULT '{}';